@@ -1,75 +1,254 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
-import { queryOptions, type UseMutationOptions } from "@tanstack/react-query";
+import {
+  type InfiniteData,
+  infiniteQueryOptions,
+  queryOptions,
+  type UseMutationOptions,
+} from "@tanstack/react-query";
 
 import { client } from "../client.gen";
 import {
+  deleteAccessTokensById,
   deleteAiConversations,
   deleteAiConversationsById,
+  deleteBodyMetricsById,
+  deleteCalendarFeed,
+  deleteCoachingLinksById,
+  deleteCoachingSuggestionsById,
   deleteExercisesById,
+  deleteGoalsById,
+  deleteTagsById,
+  deleteWebhooksById,
   deleteWorkoutsById,
+  deleteWorkoutsByIdShare,
+  getAccessTokens,
+  getAccountTimezone,
+  getAchievements,
   getAiConversations,
   getAiConversationsById,
+  getAnalyticsConsistency,
+  getAnalyticsPlateaus,
+  getAnalyticsTrainingLoad,
+  getAnalyticsVolume,
+  getBodyMetrics,
+  getBodyMetricsById,
+  getBodyMetricsTrend,
+  getCalendarFeed,
+  getCoachingAthletes,
+  getCoachingCoaches,
+  getCoachingInvitations,
+  getCoachingSuggestions,
+  getEquipment,
   getExercises,
   getExercisesById,
   getExercisesByIdMetricsHistory,
+  getExercisesByIdNotes,
   getExercisesByIdRecentSets,
+  getExercisesMetricsHistory,
   getFeaturesAccess,
+  getGoals,
+  getNotifications,
+  getNotificationsUnreadCount,
+  getReportsByPeriod,
+  getSearch,
+  getStrengthProfile,
+  getStrengthScores,
+  getStrengthScoresHistory,
+  getTags,
+  getToolsPlates,
   getTrainingProfile,
+  getWebhooks,
+  getWebhooksByIdDeliveries,
   getWorkouts,
   getWorkoutsById,
+  getWorkoutsCompare,
   getWorkoutsContributionData,
   getWorkoutsFocusValues,
   getWorkoutsNewWorkoutContext,
   type Options,
   patchExercisesById,
   patchExercisesByIdHistorical1Rm,
+  patchGoalsById,
+  patchTagsById,
+  patchWebhooksById,
+  postAccessTokens,
   postAiChatTelemetry,
   postAiConversations,
   postAiConversationsByIdLatestWorkoutDraftSave,
   postAiConversationsByIdMessagesRecover,
   postAiConversationsByIdRunsByRunIdStop,
+  postBodyMetrics,
+  postCalendarFeedToken,
+  postCoachingAthletesByAthleteIdSuggestions,
+  postCoachingInvitations,
+  postCoachingInvitationsAccept,
   postExercises,
+  postGoals,
+  postNotificationsByIdRead,
+  postNotificationsReadAll,
+  postTags,
+  postToolsWarmup,
+  postWebhooks,
+  postWebhooksByIdTest,
   postWorkouts,
+  postWorkoutsByIdShare,
+  putAccountTimezone,
+  putBodyMetricsById,
+  putCoachingLinksByIdScopes,
+  putEquipment,
+  putExercisesByIdNotes,
+  putStrengthProfile,
   putTrainingProfile,
   putWorkoutsById,
 } from "../sdk.gen";
 import type {
+  DeleteAccessTokensByIdData,
+  DeleteAccessTokensByIdError,
   DeleteAiConversationsByIdData,
   DeleteAiConversationsByIdError,
   DeleteAiConversationsData,
   DeleteAiConversationsError,
+  DeleteBodyMetricsByIdData,
+  DeleteBodyMetricsByIdError,
+  DeleteCalendarFeedData,
+  DeleteCalendarFeedError,
+  DeleteCoachingLinksByIdData,
+  DeleteCoachingLinksByIdError,
+  DeleteCoachingSuggestionsByIdData,
+  DeleteCoachingSuggestionsByIdError,
   DeleteExercisesByIdData,
   DeleteExercisesByIdError,
+  DeleteGoalsByIdData,
+  DeleteGoalsByIdError,
+  DeleteTagsByIdData,
+  DeleteTagsByIdError,
+  DeleteWebhooksByIdData,
+  DeleteWebhooksByIdError,
   DeleteWorkoutsByIdData,
   DeleteWorkoutsByIdError,
+  DeleteWorkoutsByIdShareData,
+  DeleteWorkoutsByIdShareError,
+  GetAccessTokensData,
+  GetAccessTokensError,
+  GetAccessTokensResponse,
+  GetAccountTimezoneData,
+  GetAccountTimezoneError,
+  GetAccountTimezoneResponse,
+  GetAchievementsData,
+  GetAchievementsError,
+  GetAchievementsResponse,
   GetAiConversationsByIdData,
   GetAiConversationsByIdError,
   GetAiConversationsByIdResponse,
   GetAiConversationsData,
   GetAiConversationsError,
   GetAiConversationsResponse,
+  GetAnalyticsConsistencyData,
+  GetAnalyticsConsistencyError,
+  GetAnalyticsConsistencyResponse,
+  GetAnalyticsPlateausData,
+  GetAnalyticsPlateausError,
+  GetAnalyticsPlateausResponse,
+  GetAnalyticsTrainingLoadData,
+  GetAnalyticsTrainingLoadError,
+  GetAnalyticsTrainingLoadResponse,
+  GetAnalyticsVolumeData,
+  GetAnalyticsVolumeError,
+  GetAnalyticsVolumeResponse,
+  GetBodyMetricsByIdData,
+  GetBodyMetricsByIdError,
+  GetBodyMetricsByIdResponse,
+  GetBodyMetricsData,
+  GetBodyMetricsError,
+  GetBodyMetricsResponse,
+  GetBodyMetricsTrendData,
+  GetBodyMetricsTrendError,
+  GetBodyMetricsTrendResponse,
+  GetCalendarFeedData,
+  GetCalendarFeedError,
+  GetCalendarFeedResponse,
+  GetCoachingAthletesData,
+  GetCoachingAthletesError,
+  GetCoachingAthletesResponse,
+  GetCoachingCoachesData,
+  GetCoachingCoachesError,
+  GetCoachingCoachesResponse,
+  GetCoachingInvitationsData,
+  GetCoachingInvitationsError,
+  GetCoachingInvitationsResponse,
+  GetCoachingSuggestionsData,
+  GetCoachingSuggestionsError,
+  GetCoachingSuggestionsResponse,
+  GetEquipmentData,
+  GetEquipmentError,
+  GetEquipmentResponse,
   GetExercisesByIdData,
   GetExercisesByIdError,
   GetExercisesByIdMetricsHistoryData,
   GetExercisesByIdMetricsHistoryError,
   GetExercisesByIdMetricsHistoryResponse,
+  GetExercisesByIdNotesData,
+  GetExercisesByIdNotesError,
+  GetExercisesByIdNotesResponse,
   GetExercisesByIdRecentSetsData,
   GetExercisesByIdRecentSetsError,
   GetExercisesByIdRecentSetsResponse,
   GetExercisesByIdResponse,
   GetExercisesData,
   GetExercisesError,
+  GetExercisesMetricsHistoryData,
+  GetExercisesMetricsHistoryError,
+  GetExercisesMetricsHistoryResponse,
   GetExercisesResponse,
   GetFeaturesAccessData,
   GetFeaturesAccessError,
   GetFeaturesAccessResponse,
+  GetGoalsData,
+  GetGoalsError,
+  GetGoalsResponse,
+  GetNotificationsData,
+  GetNotificationsError,
+  GetNotificationsResponse,
+  GetNotificationsUnreadCountData,
+  GetNotificationsUnreadCountError,
+  GetNotificationsUnreadCountResponse,
+  GetReportsByPeriodData,
+  GetReportsByPeriodError,
+  GetReportsByPeriodResponse,
+  GetSearchData,
+  GetSearchError,
+  GetSearchResponse,
+  GetStrengthProfileData,
+  GetStrengthProfileError,
+  GetStrengthProfileResponse,
+  GetStrengthScoresData,
+  GetStrengthScoresError,
+  GetStrengthScoresHistoryData,
+  GetStrengthScoresHistoryError,
+  GetStrengthScoresHistoryResponse,
+  GetStrengthScoresResponse,
+  GetTagsData,
+  GetTagsError,
+  GetTagsResponse,
+  GetToolsPlatesData,
+  GetToolsPlatesError,
+  GetToolsPlatesResponse,
   GetTrainingProfileData,
   GetTrainingProfileError,
   GetTrainingProfileResponse,
+  GetWebhooksByIdDeliveriesData,
+  GetWebhooksByIdDeliveriesError,
+  GetWebhooksByIdDeliveriesResponse,
+  GetWebhooksData,
+  GetWebhooksError,
+  GetWebhooksResponse,
   GetWorkoutsByIdData,
   GetWorkoutsByIdError,
   GetWorkoutsByIdResponse,
+  GetWorkoutsCompareData,
+  GetWorkoutsCompareError,
+  GetWorkoutsCompareResponse,
   GetWorkoutsContributionDataData,
   GetWorkoutsContributionDataError,
   GetWorkoutsContributionDataResponse,
@@ -86,6 +265,18 @@ import type {
   PatchExercisesByIdError,
   PatchExercisesByIdHistorical1RmData,
   PatchExercisesByIdHistorical1RmError,
+  PatchGoalsByIdData,
+  PatchGoalsByIdError,
+  PatchGoalsByIdResponse,
+  PatchTagsByIdData,
+  PatchTagsByIdError,
+  PatchTagsByIdResponse,
+  PatchWebhooksByIdData,
+  PatchWebhooksByIdError,
+  PatchWebhooksByIdResponse,
+  PostAccessTokensData,
+  PostAccessTokensError,
+  PostAccessTokensResponse,
   PostAiChatTelemetryData,
   PostAiChatTelemetryError,
   PostAiConversationsByIdLatestWorkoutDraftSaveData,
@@ -100,12 +291,68 @@ import type {
   PostAiConversationsData,
   PostAiConversationsError,
   PostAiConversationsResponse,
+  PostBodyMetricsData,
+  PostBodyMetricsError,
+  PostBodyMetricsResponse,
+  PostCalendarFeedTokenData,
+  PostCalendarFeedTokenError,
+  PostCalendarFeedTokenResponse,
+  PostCoachingAthletesByAthleteIdSuggestionsData,
+  PostCoachingAthletesByAthleteIdSuggestionsError,
+  PostCoachingAthletesByAthleteIdSuggestionsResponse,
+  PostCoachingInvitationsAcceptData,
+  PostCoachingInvitationsAcceptError,
+  PostCoachingInvitationsAcceptResponse,
+  PostCoachingInvitationsData,
+  PostCoachingInvitationsError,
+  PostCoachingInvitationsResponse,
   PostExercisesData,
   PostExercisesError,
   PostExercisesResponse,
+  PostGoalsData,
+  PostGoalsError,
+  PostGoalsResponse,
+  PostNotificationsByIdReadData,
+  PostNotificationsByIdReadError,
+  PostNotificationsReadAllData,
+  PostNotificationsReadAllError,
+  PostNotificationsReadAllResponse,
+  PostTagsData,
+  PostTagsError,
+  PostTagsResponse,
+  PostToolsWarmupData,
+  PostToolsWarmupError,
+  PostToolsWarmupResponse,
+  PostWebhooksByIdTestData,
+  PostWebhooksByIdTestError,
+  PostWebhooksByIdTestResponse,
+  PostWebhooksData,
+  PostWebhooksError,
+  PostWebhooksResponse,
+  PostWorkoutsByIdShareData,
+  PostWorkoutsByIdShareError,
+  PostWorkoutsByIdShareResponse,
   PostWorkoutsData,
   PostWorkoutsError,
   PostWorkoutsResponse,
+  PutAccountTimezoneData,
+  PutAccountTimezoneError,
+  PutAccountTimezoneResponse,
+  PutBodyMetricsByIdData,
+  PutBodyMetricsByIdError,
+  PutBodyMetricsByIdResponse,
+  PutCoachingLinksByIdScopesData,
+  PutCoachingLinksByIdScopesError,
+  PutCoachingLinksByIdScopesResponse,
+  PutEquipmentData,
+  PutEquipmentError,
+  PutEquipmentResponse,
+  PutExercisesByIdNotesData,
+  PutExercisesByIdNotesError,
+  PutExercisesByIdNotesResponse,
+  PutStrengthProfileData,
+  PutStrengthProfileError,
+  PutStrengthProfileResponse,
   PutTrainingProfileData,
   PutTrainingProfileError,
   PutTrainingProfileResponse,
@@ -113,6 +360,223 @@ import type {
   PutWorkoutsByIdError,
 } from "../types.gen";
 
+export type QueryKey<TOptions extends Options> = [
+  Pick<TOptions, "baseUrl" | "body" | "headers" | "path" | "query"> & {
+    _id: string;
+    _infinite?: boolean;
+    tags?: ReadonlyArray<string>;
+  },
+];
+
+const createQueryKey = <TOptions extends Options>(
+  id: string,
+  options?: TOptions,
+  infinite?: boolean,
+  tags?: ReadonlyArray<string>,
+): [QueryKey<TOptions>[0]] => {
+  const params: QueryKey<TOptions>[0] = {
+    _id: id,
+    baseUrl:
+      options?.baseUrl || (options?.client ?? client).getConfig().baseUrl,
+  } as QueryKey<TOptions>[0];
+  if (infinite) {
+    params._infinite = infinite;
+  }
+  if (tags) {
+    params.tags = tags;
+  }
+  if (options?.body) {
+    params.body = options.body;
+  }
+  if (options?.headers) {
+    params.headers = options.headers;
+  }
+  if (options?.path) {
+    params.path = options.path;
+  }
+  if (options?.query) {
+    params.query = options.query;
+  }
+  return [params];
+};
+
+export const getAccessTokensQueryKey = (
+  options?: Options<GetAccessTokensData>,
+) => createQueryKey("getAccessTokens", options, false, ["access-tokens"]);
+
+/**
+ * List personal access tokens
+ *
+ * Returns the authenticated user's tokens that have not been revoked, including expired ones. Tokens themselves are only returned when they are created.
+ */
+export const getAccessTokensQueryOptions = (
+  options?: Options<GetAccessTokensData>,
+) =>
+  queryOptions<
+    GetAccessTokensResponse,
+    GetAccessTokensError,
+    GetAccessTokensResponse,
+    ReturnType<typeof getAccessTokensQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getAccessTokens({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getAccessTokensQueryKey(options),
+  });
+
+/**
+ * Create personal access token
+ *
+ * Issues a token for the public API with workouts:read, workouts:write, profile:read, profile:write or chat:use scopes. Send it as "Authorization: Bearer <token>". The workouts scopes cover workouts, exercises, tags, equipment, tools and the reports derived from them; the profile scopes cover body metrics, goals, the strength profile and the training profile; chat:use covers the AI chat routes, and saving a chat workout draft also needs workouts:write. Tokens never reach token management, account, billing, coaching, webhook or share routes, nor deleting all chat history. The token is only returned here.
+ */
+export const postAccessTokensMutation = (
+  options?: Partial<Options<PostAccessTokensData>>,
+): UseMutationOptions<
+  PostAccessTokensResponse,
+  PostAccessTokensError,
+  Options<PostAccessTokensData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostAccessTokensResponse,
+    PostAccessTokensError,
+    Options<PostAccessTokensData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postAccessTokens({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+/**
+ * Revoke personal access token
+ *
+ * Revokes a token immediately. Requests already using it start failing with 401.
+ */
+export const deleteAccessTokensByIdMutation = (
+  options?: Partial<Options<DeleteAccessTokensByIdData>>,
+): UseMutationOptions<
+  unknown,
+  DeleteAccessTokensByIdError,
+  Options<DeleteAccessTokensByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    DeleteAccessTokensByIdError,
+    Options<DeleteAccessTokensByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await deleteAccessTokensById({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getAccountTimezoneQueryKey = (
+  options?: Options<GetAccountTimezoneData>,
+) => createQueryKey("getAccountTimezone", options, false, ["account"]);
+
+/**
+ * Get account timezone
+ *
+ * Returns the IANA timezone used to bucket workouts into days, weeks, and months. Users without a stored timezone get UTC until a client reports one.
+ */
+export const getAccountTimezoneQueryOptions = (
+  options?: Options<GetAccountTimezoneData>,
+) =>
+  queryOptions<
+    GetAccountTimezoneResponse,
+    GetAccountTimezoneError,
+    GetAccountTimezoneResponse,
+    ReturnType<typeof getAccountTimezoneQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getAccountTimezone({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getAccountTimezoneQueryKey(options),
+  });
+
+/**
+ * Update account timezone
+ *
+ * Stores the IANA timezone used to bucket workouts into days, weeks, and months. Send null to clear it so the next client request can infer it again.
+ */
+export const putAccountTimezoneMutation = (
+  options?: Partial<Options<PutAccountTimezoneData>>,
+): UseMutationOptions<
+  PutAccountTimezoneResponse,
+  PutAccountTimezoneError,
+  Options<PutAccountTimezoneData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PutAccountTimezoneResponse,
+    PutAccountTimezoneError,
+    Options<PutAccountTimezoneData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await putAccountTimezone({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getAchievementsQueryKey = (
+  options?: Options<GetAchievementsData>,
+) => createQueryKey("getAchievements", options, false, ["achievements"]);
+
+/**
+ * List achievements
+ *
+ * Returns achievements the authenticated user has earned, with the workout that unlocked each, and progress fractions toward the rest.
+ */
+export const getAchievementsQueryOptions = (
+  options?: Options<GetAchievementsData>,
+) =>
+  queryOptions<
+    GetAchievementsResponse,
+    GetAchievementsError,
+    GetAchievementsResponse,
+    ReturnType<typeof getAchievementsQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getAchievements({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getAchievementsQueryKey(options),
+  });
+
 /**
  * Record AI chat telemetry
  *
@@ -171,46 +635,6 @@ export const deleteAiConversationsMutation = (
   return mutationOptions;
 };
 
-export type QueryKey<TOptions extends Options> = [
-  Pick<TOptions, "baseUrl" | "body" | "headers" | "path" | "query"> & {
-    _id: string;
-    _infinite?: boolean;
-    tags?: ReadonlyArray<string>;
-  },
-];
-
-const createQueryKey = <TOptions extends Options>(
-  id: string,
-  options?: TOptions,
-  infinite?: boolean,
-  tags?: ReadonlyArray<string>,
-): [QueryKey<TOptions>[0]] => {
-  const params: QueryKey<TOptions>[0] = {
-    _id: id,
-    baseUrl:
-      options?.baseUrl || (options?.client ?? client).getConfig().baseUrl,
-  } as QueryKey<TOptions>[0];
-  if (infinite) {
-    params._infinite = infinite;
-  }
-  if (tags) {
-    params.tags = tags;
-  }
-  if (options?.body) {
-    params.body = options.body;
-  }
-  if (options?.headers) {
-    params.headers = options.headers;
-  }
-  if (options?.path) {
-    params.path = options.path;
-  }
-  if (options?.query) {
-    params.query = options.query;
-  }
-  return [params];
-};
-
 export const getAiConversationsQueryKey = (
   options?: Options<GetAiConversationsData>,
 ) => createQueryKey("getAiConversations", options, false, ["ai-chat"]);
@@ -416,23 +840,26 @@ export const postAiConversationsByIdRunsByRunIdStopMutation = (
   return mutationOptions;
 };
 
-export const getExercisesQueryKey = (options?: Options<GetExercisesData>) =>
-  createQueryKey("getExercises", options, false, ["exercises"]);
+export const getAnalyticsConsistencyQueryKey = (
+  options?: Options<GetAnalyticsConsistencyData>,
+) => createQueryKey("getAnalyticsConsistency", options, false, ["analytics"]);
 
 /**
- * List exercises
+ * Get training consistency
  *
- * Get all exercises for the authenticated user
+ * Returns current and longest daily and weekly streaks plus a rolling sessions-per-week average, bucketed in the requested timezone.
  */
-export const getExercisesQueryOptions = (options?: Options<GetExercisesData>) =>
+export const getAnalyticsConsistencyQueryOptions = (
+  options?: Options<GetAnalyticsConsistencyData>,
+) =>
   queryOptions<
-    GetExercisesResponse,
-    GetExercisesError,
-    GetExercisesResponse,
-    ReturnType<typeof getExercisesQueryKey>
+    GetAnalyticsConsistencyResponse,
+    GetAnalyticsConsistencyError,
+    GetAnalyticsConsistencyResponse,
+    ReturnType<typeof getAnalyticsConsistencyQueryKey>
   >({
     queryFn: async ({ queryKey, signal }) => {
-      const { data } = await getExercises({
+      const { data } = await getAnalyticsConsistency({
         ...options,
         ...queryKey[0],
         signal,
@@ -440,87 +867,1204 @@ export const getExercisesQueryOptions = (options?: Options<GetExercisesData>) =>
       });
       return data;
     },
-    queryKey: getExercisesQueryKey(options),
+    queryKey: getAnalyticsConsistencyQueryKey(options),
   });
 
+export const getAnalyticsPlateausQueryKey = (
+  options?: Options<GetAnalyticsPlateausData>,
+) => createQueryKey("getAnalyticsPlateaus", options, false, ["analytics"]);
+
 /**
- * Get or create exercise
+ * Get plateau detection
  *
- * Get an existing exercise by name or create it if it doesn't exist
+ * Fits a trend to each exercise's session-best estimated 1RM over the window and flags exercises that have stalled or regressed, with suggested interventions (deload, rep-range change, variation swap). Flagged exercises are listed first.
  */
-export const postExercisesMutation = (
-  options?: Partial<Options<PostExercisesData>>,
-): UseMutationOptions<
-  PostExercisesResponse,
-  PostExercisesError,
-  Options<PostExercisesData>
-> => {
-  const mutationOptions: UseMutationOptions<
-    PostExercisesResponse,
-    PostExercisesError,
-    Options<PostExercisesData>
-  > = {
-    mutationFn: async (fnOptions) => {
-      const { data } = await postExercises({
+export const getAnalyticsPlateausQueryOptions = (
+  options?: Options<GetAnalyticsPlateausData>,
+) =>
+  queryOptions<
+    GetAnalyticsPlateausResponse,
+    GetAnalyticsPlateausError,
+    GetAnalyticsPlateausResponse,
+    ReturnType<typeof getAnalyticsPlateausQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getAnalyticsPlateaus({
         ...options,
-        ...fnOptions,
+        ...queryKey[0],
+        signal,
         throwOnError: true,
       });
       return data;
     },
-  };
-  return mutationOptions;
-};
+    queryKey: getAnalyticsPlateausQueryKey(options),
+  });
+
+export const getAnalyticsTrainingLoadQueryKey = (
+  options?: Options<GetAnalyticsTrainingLoadData>,
+) => createQueryKey("getAnalyticsTrainingLoad", options, false, ["analytics"]);
 
 /**
- * Delete an exercise
+ * Get training load
  *
- * Delete a specific exercise and all its associated sets. Only the owner of the exercise can delete it.
+ * Returns daily training load with acute (7-day) and chronic (28-day, per week) load, the acute:chronic workload ratio, monotony and strain, plus warnings when load is ramping too fast.
  */
-export const deleteExercisesByIdMutation = (
-  options?: Partial<Options<DeleteExercisesByIdData>>,
-): UseMutationOptions<
-  unknown,
-  DeleteExercisesByIdError,
-  Options<DeleteExercisesByIdData>
-> => {
-  const mutationOptions: UseMutationOptions<
-    unknown,
-    DeleteExercisesByIdError,
-    Options<DeleteExercisesByIdData>
-  > = {
-    mutationFn: async (fnOptions) => {
-      const { data } = await deleteExercisesById({
+export const getAnalyticsTrainingLoadQueryOptions = (
+  options?: Options<GetAnalyticsTrainingLoadData>,
+) =>
+  queryOptions<
+    GetAnalyticsTrainingLoadResponse,
+    GetAnalyticsTrainingLoadError,
+    GetAnalyticsTrainingLoadResponse,
+    ReturnType<typeof getAnalyticsTrainingLoadQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getAnalyticsTrainingLoad({
         ...options,
-        ...fnOptions,
+        ...queryKey[0],
+        signal,
         throwOnError: true,
       });
       return data;
     },
-  };
-  return mutationOptions;
-};
+    queryKey: getAnalyticsTrainingLoadQueryKey(options),
+  });
+
+export const getAnalyticsVolumeQueryKey = (
+  options?: Options<GetAnalyticsVolumeData>,
+) => createQueryKey("getAnalyticsVolume", options, false, ["analytics"]);
+
+/**
+ * Get training volume
+ *
+ * Returns total tonnage, sets and reps per day, week or month across all exercises, with each period also split by set type. Periods without training are included as zeros.
+ */
+export const getAnalyticsVolumeQueryOptions = (
+  options?: Options<GetAnalyticsVolumeData>,
+) =>
+  queryOptions<
+    GetAnalyticsVolumeResponse,
+    GetAnalyticsVolumeError,
+    GetAnalyticsVolumeResponse,
+    ReturnType<typeof getAnalyticsVolumeQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getAnalyticsVolume({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getAnalyticsVolumeQueryKey(options),
+  });
+
+export const getBodyMetricsQueryKey = (options?: Options<GetBodyMetricsData>) =>
+  createQueryKey("getBodyMetrics", options, false, ["body-metrics"]);
+
+/**
+ * List body metric entries
+ *
+ * Returns the authenticated user's bodyweight, body fat and girth entries, newest first.
+ */
+export const getBodyMetricsQueryOptions = (
+  options?: Options<GetBodyMetricsData>,
+) =>
+  queryOptions<
+    GetBodyMetricsResponse,
+    GetBodyMetricsError,
+    GetBodyMetricsResponse,
+    ReturnType<typeof getBodyMetricsQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getBodyMetrics({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getBodyMetricsQueryKey(options),
+  });
+
+/**
+ * Create body metric entry
+ *
+ * Records bodyweight, body fat and/or girth measurements for a date. At least one measurement is required.
+ */
+export const postBodyMetricsMutation = (
+  options?: Partial<Options<PostBodyMetricsData>>,
+): UseMutationOptions<
+  PostBodyMetricsResponse,
+  PostBodyMetricsError,
+  Options<PostBodyMetricsData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostBodyMetricsResponse,
+    PostBodyMetricsError,
+    Options<PostBodyMetricsData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postBodyMetrics({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getBodyMetricsTrendQueryKey = (
+  options?: Options<GetBodyMetricsTrendData>,
+) => createQueryKey("getBodyMetricsTrend", options, false, ["body-metrics"]);
+
+/**
+ * Get body metric trend
+ *
+ * Returns per-day values for one metric with a trailing moving average, ending today in the account timezone.
+ */
+export const getBodyMetricsTrendQueryOptions = (
+  options?: Options<GetBodyMetricsTrendData>,
+) =>
+  queryOptions<
+    GetBodyMetricsTrendResponse,
+    GetBodyMetricsTrendError,
+    GetBodyMetricsTrendResponse,
+    ReturnType<typeof getBodyMetricsTrendQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getBodyMetricsTrend({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getBodyMetricsTrendQueryKey(options),
+  });
+
+/**
+ * Delete body metric entry
+ *
+ * Deletes one body metric entry owned by the authenticated user.
+ */
+export const deleteBodyMetricsByIdMutation = (
+  options?: Partial<Options<DeleteBodyMetricsByIdData>>,
+): UseMutationOptions<
+  unknown,
+  DeleteBodyMetricsByIdError,
+  Options<DeleteBodyMetricsByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    DeleteBodyMetricsByIdError,
+    Options<DeleteBodyMetricsByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await deleteBodyMetricsById({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getBodyMetricsByIdQueryKey = (
+  options: Options<GetBodyMetricsByIdData>,
+) => createQueryKey("getBodyMetricsById", options, false, ["body-metrics"]);
+
+/**
+ * Get body metric entry
+ *
+ * Returns one body metric entry owned by the authenticated user.
+ */
+export const getBodyMetricsByIdQueryOptions = (
+  options: Options<GetBodyMetricsByIdData>,
+) =>
+  queryOptions<
+    GetBodyMetricsByIdResponse,
+    GetBodyMetricsByIdError,
+    GetBodyMetricsByIdResponse,
+    ReturnType<typeof getBodyMetricsByIdQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getBodyMetricsById({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getBodyMetricsByIdQueryKey(options),
+  });
+
+/**
+ * Update body metric entry
+ *
+ * Replaces a body metric entry with the submitted measurements.
+ */
+export const putBodyMetricsByIdMutation = (
+  options?: Partial<Options<PutBodyMetricsByIdData>>,
+): UseMutationOptions<
+  PutBodyMetricsByIdResponse,
+  PutBodyMetricsByIdError,
+  Options<PutBodyMetricsByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PutBodyMetricsByIdResponse,
+    PutBodyMetricsByIdError,
+    Options<PutBodyMetricsByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await putBodyMetricsById({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+/**
+ * Disable calendar feed
+ *
+ * Disables the authenticated user's iCalendar feed and revokes its token.
+ */
+export const deleteCalendarFeedMutation = (
+  options?: Partial<Options<DeleteCalendarFeedData>>,
+): UseMutationOptions<
+  unknown,
+  DeleteCalendarFeedError,
+  Options<DeleteCalendarFeedData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    DeleteCalendarFeedError,
+    Options<DeleteCalendarFeedData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await deleteCalendarFeed({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getCalendarFeedQueryKey = (
+  options?: Options<GetCalendarFeedData>,
+) => createQueryKey("getCalendarFeed", options, false, ["calendar"]);
+
+/**
+ * Get calendar feed status
+ *
+ * Reports whether the authenticated user's iCalendar feed is enabled. The feed URL is only shown when a token is issued.
+ */
+export const getCalendarFeedQueryOptions = (
+  options?: Options<GetCalendarFeedData>,
+) =>
+  queryOptions<
+    GetCalendarFeedResponse,
+    GetCalendarFeedError,
+    GetCalendarFeedResponse,
+    ReturnType<typeof getCalendarFeedQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getCalendarFeed({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getCalendarFeedQueryKey(options),
+  });
+
+/**
+ * Issue or rotate calendar feed token
+ *
+ * Issues a new secret token for the authenticated user's iCalendar feed, enabling the feed if needed. Any previously issued feed URL stops working immediately.
+ */
+export const postCalendarFeedTokenMutation = (
+  options?: Partial<Options<PostCalendarFeedTokenData>>,
+): UseMutationOptions<
+  PostCalendarFeedTokenResponse,
+  PostCalendarFeedTokenError,
+  Options<PostCalendarFeedTokenData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostCalendarFeedTokenResponse,
+    PostCalendarFeedTokenError,
+    Options<PostCalendarFeedTokenData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postCalendarFeedToken({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getCoachingAthletesQueryKey = (
+  options?: Options<GetCoachingAthletesData>,
+) => createQueryKey("getCoachingAthletes", options, false, ["coaching"]);
+
+/**
+ * List coached athletes
+ *
+ * Returns the athletes linked to the authenticated coach. Send an athlete's ID in the X-Act-As header to read their workouts, exercises and metrics through the regular endpoints, within the granted scopes.
+ */
+export const getCoachingAthletesQueryOptions = (
+  options?: Options<GetCoachingAthletesData>,
+) =>
+  queryOptions<
+    GetCoachingAthletesResponse,
+    GetCoachingAthletesError,
+    GetCoachingAthletesResponse,
+    ReturnType<typeof getCoachingAthletesQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getCoachingAthletes({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getCoachingAthletesQueryKey(options),
+  });
+
+/**
+ * Suggest a workout
+ *
+ * Sends a suggested workout to an athlete who granted the authenticated coach the suggest_workouts scope.
+ */
+export const postCoachingAthletesByAthleteIdSuggestionsMutation = (
+  options?: Partial<Options<PostCoachingAthletesByAthleteIdSuggestionsData>>,
+): UseMutationOptions<
+  PostCoachingAthletesByAthleteIdSuggestionsResponse,
+  PostCoachingAthletesByAthleteIdSuggestionsError,
+  Options<PostCoachingAthletesByAthleteIdSuggestionsData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostCoachingAthletesByAthleteIdSuggestionsResponse,
+    PostCoachingAthletesByAthleteIdSuggestionsError,
+    Options<PostCoachingAthletesByAthleteIdSuggestionsData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postCoachingAthletesByAthleteIdSuggestions({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getCoachingCoachesQueryKey = (
+  options?: Options<GetCoachingCoachesData>,
+) => createQueryKey("getCoachingCoaches", options, false, ["coaching"]);
+
+/**
+ * List coaches
+ *
+ * Returns the coaches the authenticated athlete has granted access to.
+ */
+export const getCoachingCoachesQueryOptions = (
+  options?: Options<GetCoachingCoachesData>,
+) =>
+  queryOptions<
+    GetCoachingCoachesResponse,
+    GetCoachingCoachesError,
+    GetCoachingCoachesResponse,
+    ReturnType<typeof getCoachingCoachesQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getCoachingCoaches({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getCoachingCoachesQueryKey(options),
+  });
+
+export const getCoachingInvitationsQueryKey = (
+  options?: Options<GetCoachingInvitationsData>,
+) => createQueryKey("getCoachingInvitations", options, false, ["coaching"]);
+
+/**
+ * List pending coaching invitations
+ *
+ * Returns the authenticated coach's invitations that have not been accepted or expired.
+ */
+export const getCoachingInvitationsQueryOptions = (
+  options?: Options<GetCoachingInvitationsData>,
+) =>
+  queryOptions<
+    GetCoachingInvitationsResponse,
+    GetCoachingInvitationsError,
+    GetCoachingInvitationsResponse,
+    ReturnType<typeof getCoachingInvitationsQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getCoachingInvitations({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getCoachingInvitationsQueryKey(options),
+  });
+
+/**
+ * Create coaching invitation
+ *
+ * Issues a single-use invitation code, valid for seven days, that an athlete accepts to grant the authenticated coach the requested scopes. The code is only returned here.
+ */
+export const postCoachingInvitationsMutation = (
+  options?: Partial<Options<PostCoachingInvitationsData>>,
+): UseMutationOptions<
+  PostCoachingInvitationsResponse,
+  PostCoachingInvitationsError,
+  Options<PostCoachingInvitationsData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostCoachingInvitationsResponse,
+    PostCoachingInvitationsError,
+    Options<PostCoachingInvitationsData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postCoachingInvitations({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+/**
+ * Accept coaching invitation
+ *
+ * Links the authenticated user, as athlete, to the coach who issued the invitation code.
+ */
+export const postCoachingInvitationsAcceptMutation = (
+  options?: Partial<Options<PostCoachingInvitationsAcceptData>>,
+): UseMutationOptions<
+  PostCoachingInvitationsAcceptResponse,
+  PostCoachingInvitationsAcceptError,
+  Options<PostCoachingInvitationsAcceptData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostCoachingInvitationsAcceptResponse,
+    PostCoachingInvitationsAcceptError,
+    Options<PostCoachingInvitationsAcceptData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postCoachingInvitationsAccept({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+/**
+ * Delete coaching link
+ *
+ * Ends a coaching relationship from either side, or cancels a pending invitation.
+ */
+export const deleteCoachingLinksByIdMutation = (
+  options?: Partial<Options<DeleteCoachingLinksByIdData>>,
+): UseMutationOptions<
+  unknown,
+  DeleteCoachingLinksByIdError,
+  Options<DeleteCoachingLinksByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    DeleteCoachingLinksByIdError,
+    Options<DeleteCoachingLinksByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await deleteCoachingLinksById({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+/**
+ * Update coaching scopes
+ *
+ * Replaces the scopes the authenticated athlete grants a coach.
+ */
+export const putCoachingLinksByIdScopesMutation = (
+  options?: Partial<Options<PutCoachingLinksByIdScopesData>>,
+): UseMutationOptions<
+  PutCoachingLinksByIdScopesResponse,
+  PutCoachingLinksByIdScopesError,
+  Options<PutCoachingLinksByIdScopesData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PutCoachingLinksByIdScopesResponse,
+    PutCoachingLinksByIdScopesError,
+    Options<PutCoachingLinksByIdScopesData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await putCoachingLinksByIdScopes({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getCoachingSuggestionsQueryKey = (
+  options?: Options<GetCoachingSuggestionsData>,
+) => createQueryKey("getCoachingSuggestions", options, false, ["coaching"]);
+
+/**
+ * List suggested workouts
+ *
+ * Returns the workouts the authenticated athlete's coaches have suggested and that have not been dismissed, newest first.
+ */
+export const getCoachingSuggestionsQueryOptions = (
+  options?: Options<GetCoachingSuggestionsData>,
+) =>
+  queryOptions<
+    GetCoachingSuggestionsResponse,
+    GetCoachingSuggestionsError,
+    GetCoachingSuggestionsResponse,
+    ReturnType<typeof getCoachingSuggestionsQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getCoachingSuggestions({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getCoachingSuggestionsQueryKey(options),
+  });
+
+/**
+ * Dismiss suggested workout
+ *
+ * Hides a suggested workout from the authenticated athlete's list.
+ */
+export const deleteCoachingSuggestionsByIdMutation = (
+  options?: Partial<Options<DeleteCoachingSuggestionsByIdData>>,
+): UseMutationOptions<
+  unknown,
+  DeleteCoachingSuggestionsByIdError,
+  Options<DeleteCoachingSuggestionsByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    DeleteCoachingSuggestionsByIdError,
+    Options<DeleteCoachingSuggestionsByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await deleteCoachingSuggestionsById({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getEquipmentQueryKey = (options?: Options<GetEquipmentData>) =>
+  createQueryKey("getEquipment", options, false, ["equipment"]);
+
+/**
+ * Get equipment inventory
+ *
+ * Returns the bars, plate pairs, dumbbell increment and machine stack step used to round suggested weights. Users who have not saved equipment get a default commercial-gym inventory with is_default set.
+ */
+export const getEquipmentQueryOptions = (options?: Options<GetEquipmentData>) =>
+  queryOptions<
+    GetEquipmentResponse,
+    GetEquipmentError,
+    GetEquipmentResponse,
+    ReturnType<typeof getEquipmentQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getEquipment({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getEquipmentQueryKey(options),
+  });
+
+/**
+ * Update equipment inventory
+ *
+ * Replaces the equipment inventory. Weights must be positive multiples of 0.25; the first bar is the primary bar.
+ */
+export const putEquipmentMutation = (
+  options?: Partial<Options<PutEquipmentData>>,
+): UseMutationOptions<
+  PutEquipmentResponse,
+  PutEquipmentError,
+  Options<PutEquipmentData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PutEquipmentResponse,
+    PutEquipmentError,
+    Options<PutEquipmentData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await putEquipment({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getExercisesQueryKey = (options?: Options<GetExercisesData>) =>
+  createQueryKey("getExercises", options, false, ["exercises"]);
+
+/**
+ * List exercises
+ *
+ * Get all exercises for the authenticated user
+ */
+export const getExercisesQueryOptions = (options?: Options<GetExercisesData>) =>
+  queryOptions<
+    GetExercisesResponse,
+    GetExercisesError,
+    GetExercisesResponse,
+    ReturnType<typeof getExercisesQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getExercises({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getExercisesQueryKey(options),
+  });
+
+/**
+ * Get or create exercise
+ *
+ * Get an existing exercise by name or create it if it doesn't exist
+ */
+export const postExercisesMutation = (
+  options?: Partial<Options<PostExercisesData>>,
+): UseMutationOptions<
+  PostExercisesResponse,
+  PostExercisesError,
+  Options<PostExercisesData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostExercisesResponse,
+    PostExercisesError,
+    Options<PostExercisesData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postExercises({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getExercisesMetricsHistoryQueryKey = (
+  options: Options<GetExercisesMetricsHistoryData>,
+) =>
+  createQueryKey("getExercisesMetricsHistory", options, false, ["exercises"]);
+
+/**
+ * Compare metrics history across exercises
+ *
+ * Get metrics for up to 8 exercises on one shared time axis. Each series has a point per axis entry (null where the exercise has no session) with percent_of_1rm relative to the exercise's historical 1RM, or its best e1RM in the window when none is set. Range, from/to and bucket behave as on the single-exercise endpoint; range windows end at the latest session across the exercises.
+ */
+export const getExercisesMetricsHistoryQueryOptions = (
+  options: Options<GetExercisesMetricsHistoryData>,
+) =>
+  queryOptions<
+    GetExercisesMetricsHistoryResponse,
+    GetExercisesMetricsHistoryError,
+    GetExercisesMetricsHistoryResponse,
+    ReturnType<typeof getExercisesMetricsHistoryQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getExercisesMetricsHistory({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getExercisesMetricsHistoryQueryKey(options),
+  });
+
+/**
+ * Delete an exercise
+ *
+ * Delete a specific exercise and all its associated sets. Only the owner of the exercise can delete it.
+ */
+export const deleteExercisesByIdMutation = (
+  options?: Partial<Options<DeleteExercisesByIdData>>,
+): UseMutationOptions<
+  unknown,
+  DeleteExercisesByIdError,
+  Options<DeleteExercisesByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    DeleteExercisesByIdError,
+    Options<DeleteExercisesByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await deleteExercisesById({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
 
 export const getExercisesByIdQueryKey = (
   options: Options<GetExercisesByIdData>,
 ) => createQueryKey("getExercisesById", options, false, ["exercises"]);
 
 /**
- * Get exercise with sets
+ * Get exercise with sets
+ *
+ * Get a specific exercise (metadata) plus all its sets from workouts. Sets is empty when exercise exists but has no sets.
+ */
+export const getExercisesByIdQueryOptions = (
+  options: Options<GetExercisesByIdData>,
+) =>
+  queryOptions<
+    GetExercisesByIdResponse,
+    GetExercisesByIdError,
+    GetExercisesByIdResponse,
+    ReturnType<typeof getExercisesByIdQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getExercisesById({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getExercisesByIdQueryKey(options),
+  });
+
+/**
+ * Update an exercise name
+ *
+ * Update the name of an exercise by ID (must belong to authenticated user)
+ */
+export const patchExercisesByIdMutation = (
+  options?: Partial<Options<PatchExercisesByIdData>>,
+): UseMutationOptions<
+  unknown,
+  PatchExercisesByIdError,
+  Options<PatchExercisesByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    PatchExercisesByIdError,
+    Options<PatchExercisesByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await patchExercisesById({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+/**
+ * Update exercise historical 1RM
+ *
+ * Set a manual historical 1RM (clears source workout) or recompute from best working-set e1RM across history.
+ */
+export const patchExercisesByIdHistorical1RmMutation = (
+  options?: Partial<Options<PatchExercisesByIdHistorical1RmData>>,
+): UseMutationOptions<
+  unknown,
+  PatchExercisesByIdHistorical1RmError,
+  Options<PatchExercisesByIdHistorical1RmData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    PatchExercisesByIdHistorical1RmError,
+    Options<PatchExercisesByIdHistorical1RmData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await patchExercisesByIdHistorical1Rm({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getExercisesByIdMetricsHistoryQueryKey = (
+  options: Options<GetExercisesByIdMetricsHistoryData>,
+) =>
+  createQueryKey("getExercisesByIdMetricsHistory", options, false, [
+    "exercises",
+  ]);
+
+/**
+ * Get exercise metrics history
+ *
+ * Get time-series session metrics for an exercise. Use a range code for a window ending at the latest session, or from/to local dates for a custom window. bucket=week or month folds sessions together: bests take the max, volume is summed and averages are weighted by working sets.
+ */
+export const getExercisesByIdMetricsHistoryQueryOptions = (
+  options: Options<GetExercisesByIdMetricsHistoryData>,
+) =>
+  queryOptions<
+    GetExercisesByIdMetricsHistoryResponse,
+    GetExercisesByIdMetricsHistoryError,
+    GetExercisesByIdMetricsHistoryResponse,
+    ReturnType<typeof getExercisesByIdMetricsHistoryQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getExercisesByIdMetricsHistory({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getExercisesByIdMetricsHistoryQueryKey(options),
+  });
+
+export const getExercisesByIdNotesQueryKey = (
+  options: Options<GetExercisesByIdNotesData>,
+) => createQueryKey("getExercisesByIdNotes", options, false, ["exercises"]);
+
+/**
+ * Get exercise notes
+ *
+ * Get the current notes, cues and equipment settings for an exercise plus recent revisions, newest first.
+ */
+export const getExercisesByIdNotesQueryOptions = (
+  options: Options<GetExercisesByIdNotesData>,
+) =>
+  queryOptions<
+    GetExercisesByIdNotesResponse,
+    GetExercisesByIdNotesError,
+    GetExercisesByIdNotesResponse,
+    ReturnType<typeof getExercisesByIdNotesQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getExercisesByIdNotes({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getExercisesByIdNotesQueryKey(options),
+  });
+
+/**
+ * Update exercise notes
+ *
+ * Replace the notes, cues and equipment settings for an exercise. Each change is kept as a revision; blank or omitted fields are cleared.
+ */
+export const putExercisesByIdNotesMutation = (
+  options?: Partial<Options<PutExercisesByIdNotesData>>,
+): UseMutationOptions<
+  PutExercisesByIdNotesResponse,
+  PutExercisesByIdNotesError,
+  Options<PutExercisesByIdNotesData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PutExercisesByIdNotesResponse,
+    PutExercisesByIdNotesError,
+    Options<PutExercisesByIdNotesData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await putExercisesByIdNotes({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getExercisesByIdRecentSetsQueryKey = (
+  options: Options<GetExercisesByIdRecentSetsData>,
+) =>
+  createQueryKey("getExercisesByIdRecentSets", options, false, ["exercises"]);
+
+/**
+ * Get recent sets for exercise
+ *
+ * Get the 3 most recent sets for a specific exercise. Returns empty array when exercise has no sets.
+ */
+export const getExercisesByIdRecentSetsQueryOptions = (
+  options: Options<GetExercisesByIdRecentSetsData>,
+) =>
+  queryOptions<
+    GetExercisesByIdRecentSetsResponse,
+    GetExercisesByIdRecentSetsError,
+    GetExercisesByIdRecentSetsResponse,
+    ReturnType<typeof getExercisesByIdRecentSetsQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getExercisesByIdRecentSets({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getExercisesByIdRecentSetsQueryKey(options),
+  });
+
+export const getFeaturesAccessQueryKey = (
+  options?: Options<GetFeaturesAccessData>,
+) => createQueryKey("getFeaturesAccess", options, false, ["feature-access"]);
+
+/**
+ * List active feature access grants
+ *
+ * Get all active feature access grants for the authenticated user
+ */
+export const getFeaturesAccessQueryOptions = (
+  options?: Options<GetFeaturesAccessData>,
+) =>
+  queryOptions<
+    GetFeaturesAccessResponse,
+    GetFeaturesAccessError,
+    GetFeaturesAccessResponse,
+    ReturnType<typeof getFeaturesAccessQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getFeaturesAccess({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getFeaturesAccessQueryKey(options),
+  });
+
+export const getGoalsQueryKey = (options?: Options<GetGoalsData>) =>
+  createQueryKey("getGoals", options, false, ["goals"]);
+
+/**
+ * List goals
+ *
+ * Returns the authenticated user's goals with current progress, a projected attainment date fitted from recent data, and a status of achieved, on_track, behind or not_enough_data.
+ */
+export const getGoalsQueryOptions = (options?: Options<GetGoalsData>) =>
+  queryOptions<
+    GetGoalsResponse,
+    GetGoalsError,
+    GetGoalsResponse,
+    ReturnType<typeof getGoalsQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getGoals({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getGoalsQueryKey(options),
+  });
+
+/**
+ * Create goal
+ *
+ * Sets an e1rm, frequency (workouts per week) or bodyweight goal with an optional target date. Progress is measured from the value at creation.
+ */
+export const postGoalsMutation = (
+  options?: Partial<Options<PostGoalsData>>,
+): UseMutationOptions<
+  PostGoalsResponse,
+  PostGoalsError,
+  Options<PostGoalsData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostGoalsResponse,
+    PostGoalsError,
+    Options<PostGoalsData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postGoals({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+/**
+ * Delete goal
  *
- * Get a specific exercise (metadata) plus all its sets from workouts. Sets is empty when exercise exists but has no sets.
+ * Deletes one goal owned by the authenticated user.
  */
-export const getExercisesByIdQueryOptions = (
-  options: Options<GetExercisesByIdData>,
+export const deleteGoalsByIdMutation = (
+  options?: Partial<Options<DeleteGoalsByIdData>>,
+): UseMutationOptions<
+  unknown,
+  DeleteGoalsByIdError,
+  Options<DeleteGoalsByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    DeleteGoalsByIdError,
+    Options<DeleteGoalsByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await deleteGoalsById({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+/**
+ * Update goal
+ *
+ * Changes a goal's target value or target date. Omitted fields are kept and an empty target_date removes the date. The type, exercise, starting value and creation date cannot change.
+ */
+export const patchGoalsByIdMutation = (
+  options?: Partial<Options<PatchGoalsByIdData>>,
+): UseMutationOptions<
+  PatchGoalsByIdResponse,
+  PatchGoalsByIdError,
+  Options<PatchGoalsByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PatchGoalsByIdResponse,
+    PatchGoalsByIdError,
+    Options<PatchGoalsByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await patchGoalsById({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getNotificationsQueryKey = (
+  options?: Options<GetNotificationsData>,
+) => createQueryKey("getNotifications", options, false, ["notifications"]);
+
+/**
+ * List notifications
+ *
+ * Returns the authenticated user's notifications, newest first. Use next_before_id as before_id to page back.
+ */
+export const getNotificationsQueryOptions = (
+  options?: Options<GetNotificationsData>,
 ) =>
   queryOptions<
-    GetExercisesByIdResponse,
-    GetExercisesByIdError,
-    GetExercisesByIdResponse,
-    ReturnType<typeof getExercisesByIdQueryKey>
+    GetNotificationsResponse,
+    GetNotificationsError,
+    GetNotificationsResponse,
+    ReturnType<typeof getNotificationsQueryKey>
   >({
     queryFn: async ({ queryKey, signal }) => {
-      const { data } = await getExercisesById({
+      const { data } = await getNotifications({
         ...options,
         ...queryKey[0],
         signal,
@@ -528,28 +2072,408 @@ export const getExercisesByIdQueryOptions = (
       });
       return data;
     },
-    queryKey: getExercisesByIdQueryKey(options),
+    queryKey: getNotificationsQueryKey(options),
   });
 
 /**
- * Update an exercise name
+ * Mark all notifications read
  *
- * Update the name of an exercise by ID (must belong to authenticated user)
+ * Marks every unread notification read and returns how many were updated.
+ */
+export const postNotificationsReadAllMutation = (
+  options?: Partial<Options<PostNotificationsReadAllData>>,
+): UseMutationOptions<
+  PostNotificationsReadAllResponse,
+  PostNotificationsReadAllError,
+  Options<PostNotificationsReadAllData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostNotificationsReadAllResponse,
+    PostNotificationsReadAllError,
+    Options<PostNotificationsReadAllData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postNotificationsReadAll({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getNotificationsUnreadCountQueryKey = (
+  options?: Options<GetNotificationsUnreadCountData>,
+) =>
+  createQueryKey("getNotificationsUnreadCount", options, false, [
+    "notifications",
+  ]);
+
+/**
+ * Get unread notification count
+ *
+ * Returns how many of the authenticated user's notifications are unread.
+ */
+export const getNotificationsUnreadCountQueryOptions = (
+  options?: Options<GetNotificationsUnreadCountData>,
+) =>
+  queryOptions<
+    GetNotificationsUnreadCountResponse,
+    GetNotificationsUnreadCountError,
+    GetNotificationsUnreadCountResponse,
+    ReturnType<typeof getNotificationsUnreadCountQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getNotificationsUnreadCount({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getNotificationsUnreadCountQueryKey(options),
+  });
+
+/**
+ * Mark notification read
+ *
+ * Marks one notification read. Marking an already read notification keeps its original read time.
+ */
+export const postNotificationsByIdReadMutation = (
+  options?: Partial<Options<PostNotificationsByIdReadData>>,
+): UseMutationOptions<
+  unknown,
+  PostNotificationsByIdReadError,
+  Options<PostNotificationsByIdReadData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    PostNotificationsByIdReadError,
+    Options<PostNotificationsByIdReadData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postNotificationsByIdRead({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getReportsByPeriodQueryKey = (
+  options: Options<GetReportsByPeriodData>,
+) => createQueryKey("getReportsByPeriod", options, false, ["reports"]);
+
+/**
+ * Get training summary report
+ *
+ * Summarizes the week, month or year containing the given date in the user's timezone: sessions, working sets, tonnage, per-focus breakdown, new historical 1RMs, most improved exercises and the change from the previous period. Send `Accept: text/markdown` for a Markdown recap instead of JSON.
+ */
+export const getReportsByPeriodQueryOptions = (
+  options: Options<GetReportsByPeriodData>,
+) =>
+  queryOptions<
+    GetReportsByPeriodResponse,
+    GetReportsByPeriodError,
+    GetReportsByPeriodResponse,
+    ReturnType<typeof getReportsByPeriodQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getReportsByPeriod({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getReportsByPeriodQueryKey(options),
+  });
+
+export const getSearchQueryKey = (options: Options<GetSearchData>) =>
+  createQueryKey("getSearch", options, false, ["search"]);
+
+/**
+ * Search workouts, exercises and AI chats
+ *
+ * Full-text search over the authenticated user's workout notes and focus, set notes, exercise names, and AI chat conversation titles and messages. Supports quoted phrases, OR, and -excluded terms. Snippets are HTML-escaped with matches wrapped in <mark> tags.
+ */
+export const getSearchQueryOptions = (options: Options<GetSearchData>) =>
+  queryOptions<
+    GetSearchResponse,
+    GetSearchError,
+    GetSearchResponse,
+    ReturnType<typeof getSearchQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getSearch({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getSearchQueryKey(options),
+  });
+
+const createInfiniteParams = <
+  K extends Pick<QueryKey<Options>[0], "body" | "headers" | "path" | "query">,
+>(
+  queryKey: QueryKey<Options>,
+  page: K,
+) => {
+  const params = {
+    ...queryKey[0],
+  };
+  if (page.body) {
+    params.body = {
+      ...(queryKey[0].body as any),
+      ...(page.body as any),
+    };
+  }
+  if (page.headers) {
+    params.headers = {
+      ...queryKey[0].headers,
+      ...page.headers,
+    };
+  }
+  if (page.path) {
+    params.path = {
+      ...(queryKey[0].path as any),
+      ...(page.path as any),
+    };
+  }
+  if (page.query) {
+    params.query = {
+      ...(queryKey[0].query as any),
+      ...(page.query as any),
+    };
+  }
+  return params as unknown as typeof page;
+};
+
+export const getSearchInfiniteQueryKey = (
+  options: Options<GetSearchData>,
+): QueryKey<Options<GetSearchData>> =>
+  createQueryKey("getSearch", options, true, ["search"]);
+
+/**
+ * Search workouts, exercises and AI chats
+ *
+ * Full-text search over the authenticated user's workout notes and focus, set notes, exercise names, and AI chat conversation titles and messages. Supports quoted phrases, OR, and -excluded terms. Snippets are HTML-escaped with matches wrapped in <mark> tags.
+ */
+export const getSearchInfiniteOptions = (options: Options<GetSearchData>) =>
+  infiniteQueryOptions<
+    GetSearchResponse,
+    GetSearchError,
+    InfiniteData<GetSearchResponse>,
+    QueryKey<Options<GetSearchData>>,
+    | number
+    | Pick<
+        QueryKey<Options<GetSearchData>>[0],
+        "body" | "headers" | "path" | "query"
+      >
+  >(
+    // @ts-ignore
+    {
+      queryFn: async ({ pageParam, queryKey, signal }) => {
+        // @ts-ignore
+        const page: Pick<
+          QueryKey<Options<GetSearchData>>[0],
+          "body" | "headers" | "path" | "query"
+        > =
+          typeof pageParam === "object"
+            ? pageParam
+            : {
+                query: {
+                  offset: pageParam,
+                },
+              };
+        const params = createInfiniteParams(queryKey, page);
+        const { data } = await getSearch({
+          ...options,
+          ...params,
+          signal,
+          throwOnError: true,
+        });
+        return data;
+      },
+      queryKey: getSearchInfiniteQueryKey(options),
+    },
+  );
+
+export const getStrengthProfileQueryKey = (
+  options?: Options<GetStrengthProfileData>,
+) => createQueryKey("getStrengthProfile", options, false, ["strength"]);
+
+/**
+ * Get strength profile
+ *
+ * Returns the sex and designated squat, bench and deadlift exercises used for relative-strength scoring.
+ */
+export const getStrengthProfileQueryOptions = (
+  options?: Options<GetStrengthProfileData>,
+) =>
+  queryOptions<
+    GetStrengthProfileResponse,
+    GetStrengthProfileError,
+    GetStrengthProfileResponse,
+    ReturnType<typeof getStrengthProfileQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getStrengthProfile({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getStrengthProfileQueryKey(options),
+  });
+
+/**
+ * Update strength profile
+ *
+ * Replaces the strength profile. Designated exercises must belong to the authenticated user; null fields are cleared.
+ */
+export const putStrengthProfileMutation = (
+  options?: Partial<Options<PutStrengthProfileData>>,
+): UseMutationOptions<
+  PutStrengthProfileResponse,
+  PutStrengthProfileError,
+  Options<PutStrengthProfileData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PutStrengthProfileResponse,
+    PutStrengthProfileError,
+    Options<PutStrengthProfileData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await putStrengthProfile({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getStrengthScoresQueryKey = (
+  options?: Options<GetStrengthScoresData>,
+) => createQueryKey("getStrengthScores", options, false, ["strength"]);
+
+/**
+ * Get relative strength scores
+ *
+ * Returns the current powerlifting total with DOTS, Wilks and IPF GL scores from the best squat, bench and deadlift e1RMs and the latest bodyweight, plus per-lift strength-standard classifications.
+ */
+export const getStrengthScoresQueryOptions = (
+  options?: Options<GetStrengthScoresData>,
+) =>
+  queryOptions<
+    GetStrengthScoresResponse,
+    GetStrengthScoresError,
+    GetStrengthScoresResponse,
+    ReturnType<typeof getStrengthScoresQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getStrengthScores({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getStrengthScoresQueryKey(options),
+  });
+
+export const getStrengthScoresHistoryQueryKey = (
+  options?: Options<GetStrengthScoresHistoryData>,
+) => createQueryKey("getStrengthScoresHistory", options, false, ["strength"]);
+
+/**
+ * Get relative strength score history
+ *
+ * Returns DOTS, Wilks and IPF GL over time, one point per workout day once all three lifts have been logged, using the bodyweight reading nearest each day.
+ */
+export const getStrengthScoresHistoryQueryOptions = (
+  options?: Options<GetStrengthScoresHistoryData>,
+) =>
+  queryOptions<
+    GetStrengthScoresHistoryResponse,
+    GetStrengthScoresHistoryError,
+    GetStrengthScoresHistoryResponse,
+    ReturnType<typeof getStrengthScoresHistoryQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getStrengthScoresHistory({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getStrengthScoresHistoryQueryKey(options),
+  });
+
+export const getTagsQueryKey = (options?: Options<GetTagsData>) =>
+  createQueryKey("getTags", options, false, ["tags"]);
+
+/**
+ * List workout tags
+ *
+ * Returns the authenticated user's workout tags, ordered by name, with the number of workouts carrying each.
+ */
+export const getTagsQueryOptions = (options?: Options<GetTagsData>) =>
+  queryOptions<
+    GetTagsResponse,
+    GetTagsError,
+    GetTagsResponse,
+    ReturnType<typeof getTagsQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getTags({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getTagsQueryKey(options),
+  });
+
+/**
+ * Create workout tag
+ *
+ * Adds a tag to the user's vocabulary. Names are lowercased and whitespace-collapsed before saving. Tags sent with a workout are created automatically, so this is only needed to add one ahead of time.
  */
-export const patchExercisesByIdMutation = (
-  options?: Partial<Options<PatchExercisesByIdData>>,
+export const postTagsMutation = (
+  options?: Partial<Options<PostTagsData>>,
 ): UseMutationOptions<
-  unknown,
-  PatchExercisesByIdError,
-  Options<PatchExercisesByIdData>
+  PostTagsResponse,
+  PostTagsError,
+  Options<PostTagsData>
 > => {
   const mutationOptions: UseMutationOptions<
-    unknown,
-    PatchExercisesByIdError,
-    Options<PatchExercisesByIdData>
+    PostTagsResponse,
+    PostTagsError,
+    Options<PostTagsData>
   > = {
     mutationFn: async (fnOptions) => {
-      const { data } = await patchExercisesById({
+      const { data } = await postTags({
         ...options,
         ...fnOptions,
         throwOnError: true,
@@ -561,24 +2485,24 @@ export const patchExercisesByIdMutation = (
 };
 
 /**
- * Update exercise historical 1RM
+ * Delete workout tag
  *
- * Set a manual historical 1RM (clears source workout) or recompute from best working-set e1RM across history.
+ * Deletes a tag and removes it from every workout. The workouts themselves are kept.
  */
-export const patchExercisesByIdHistorical1RmMutation = (
-  options?: Partial<Options<PatchExercisesByIdHistorical1RmData>>,
+export const deleteTagsByIdMutation = (
+  options?: Partial<Options<DeleteTagsByIdData>>,
 ): UseMutationOptions<
   unknown,
-  PatchExercisesByIdHistorical1RmError,
-  Options<PatchExercisesByIdHistorical1RmData>
+  DeleteTagsByIdError,
+  Options<DeleteTagsByIdData>
 > => {
   const mutationOptions: UseMutationOptions<
     unknown,
-    PatchExercisesByIdHistorical1RmError,
-    Options<PatchExercisesByIdHistorical1RmData>
+    DeleteTagsByIdError,
+    Options<DeleteTagsByIdData>
   > = {
     mutationFn: async (fnOptions) => {
-      const { data } = await patchExercisesByIdHistorical1Rm({
+      const { data } = await deleteTagsById({
         ...options,
         ...fnOptions,
         throwOnError: true,
@@ -589,60 +2513,54 @@ export const patchExercisesByIdHistorical1RmMutation = (
   return mutationOptions;
 };
 
-export const getExercisesByIdMetricsHistoryQueryKey = (
-  options: Options<GetExercisesByIdMetricsHistoryData>,
-) =>
-  createQueryKey("getExercisesByIdMetricsHistory", options, false, [
-    "exercises",
-  ]);
-
 /**
- * Get exercise metrics history
+ * Rename workout tag
  *
- * Get time-series session metrics for an exercise. All ranges return per-workout session points filtered to the selected window.
+ * Renames a tag on every workout that carries it. Renaming onto another existing tag is rejected.
  */
-export const getExercisesByIdMetricsHistoryQueryOptions = (
-  options: Options<GetExercisesByIdMetricsHistoryData>,
-) =>
-  queryOptions<
-    GetExercisesByIdMetricsHistoryResponse,
-    GetExercisesByIdMetricsHistoryError,
-    GetExercisesByIdMetricsHistoryResponse,
-    ReturnType<typeof getExercisesByIdMetricsHistoryQueryKey>
-  >({
-    queryFn: async ({ queryKey, signal }) => {
-      const { data } = await getExercisesByIdMetricsHistory({
+export const patchTagsByIdMutation = (
+  options?: Partial<Options<PatchTagsByIdData>>,
+): UseMutationOptions<
+  PatchTagsByIdResponse,
+  PatchTagsByIdError,
+  Options<PatchTagsByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PatchTagsByIdResponse,
+    PatchTagsByIdError,
+    Options<PatchTagsByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await patchTagsById({
         ...options,
-        ...queryKey[0],
-        signal,
+        ...fnOptions,
         throwOnError: true,
       });
       return data;
     },
-    queryKey: getExercisesByIdMetricsHistoryQueryKey(options),
-  });
+  };
+  return mutationOptions;
+};
 
-export const getExercisesByIdRecentSetsQueryKey = (
-  options: Options<GetExercisesByIdRecentSetsData>,
-) =>
-  createQueryKey("getExercisesByIdRecentSets", options, false, ["exercises"]);
+export const getToolsPlatesQueryKey = (options: Options<GetToolsPlatesData>) =>
+  createQueryKey("getToolsPlates", options, false, ["tools"]);
 
 /**
- * Get recent sets for exercise
+ * Get plate breakdown
  *
- * Get the 3 most recent sets for a specific exercise. Returns empty array when exercise has no sets.
+ * Returns the plates to load on each side of the bar for the closest weight to the target that the user's equipment allows.
  */
-export const getExercisesByIdRecentSetsQueryOptions = (
-  options: Options<GetExercisesByIdRecentSetsData>,
+export const getToolsPlatesQueryOptions = (
+  options: Options<GetToolsPlatesData>,
 ) =>
   queryOptions<
-    GetExercisesByIdRecentSetsResponse,
-    GetExercisesByIdRecentSetsError,
-    GetExercisesByIdRecentSetsResponse,
-    ReturnType<typeof getExercisesByIdRecentSetsQueryKey>
+    GetToolsPlatesResponse,
+    GetToolsPlatesError,
+    GetToolsPlatesResponse,
+    ReturnType<typeof getToolsPlatesQueryKey>
   >({
     queryFn: async ({ queryKey, signal }) => {
-      const { data } = await getExercisesByIdRecentSets({
+      const { data } = await getToolsPlates({
         ...options,
         ...queryKey[0],
         signal,
@@ -650,38 +2568,37 @@ export const getExercisesByIdRecentSetsQueryOptions = (
       });
       return data;
     },
-    queryKey: getExercisesByIdRecentSetsQueryKey(options),
+    queryKey: getToolsPlatesQueryKey(options),
   });
 
-export const getFeaturesAccessQueryKey = (
-  options?: Options<GetFeaturesAccessData>,
-) => createQueryKey("getFeaturesAccess", options, false, ["feature-access"]);
-
 /**
- * List active feature access grants
+ * Generate warm-up sets
  *
- * Get all active feature access grants for the authenticated user
+ * Build warm-up sets leading to a working weight. Loads are rounded to the user's equipment, using the given bar or the primary bar for barbell lifts. Steps override the named scheme; the standard scheme is used when neither is given.
  */
-export const getFeaturesAccessQueryOptions = (
-  options?: Options<GetFeaturesAccessData>,
-) =>
-  queryOptions<
-    GetFeaturesAccessResponse,
-    GetFeaturesAccessError,
-    GetFeaturesAccessResponse,
-    ReturnType<typeof getFeaturesAccessQueryKey>
-  >({
-    queryFn: async ({ queryKey, signal }) => {
-      const { data } = await getFeaturesAccess({
+export const postToolsWarmupMutation = (
+  options?: Partial<Options<PostToolsWarmupData>>,
+): UseMutationOptions<
+  PostToolsWarmupResponse,
+  PostToolsWarmupError,
+  Options<PostToolsWarmupData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostToolsWarmupResponse,
+    PostToolsWarmupError,
+    Options<PostToolsWarmupData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postToolsWarmup({
         ...options,
-        ...queryKey[0],
-        signal,
+        ...fnOptions,
         throwOnError: true,
       });
       return data;
     },
-    queryKey: getFeaturesAccessQueryKey(options),
-  });
+  };
+  return mutationOptions;
+};
 
 export const getTrainingProfileQueryKey = (
   options?: Options<GetTrainingProfileData>,
@@ -742,13 +2659,186 @@ export const putTrainingProfileMutation = (
   return mutationOptions;
 };
 
+export const getWebhooksQueryKey = (options?: Options<GetWebhooksData>) =>
+  createQueryKey("getWebhooks", options, false, ["webhooks"]);
+
+/**
+ * List webhook endpoints
+ *
+ * Returns the authenticated user's webhook endpoints. Secrets are only returned when an endpoint is created.
+ */
+export const getWebhooksQueryOptions = (options?: Options<GetWebhooksData>) =>
+  queryOptions<
+    GetWebhooksResponse,
+    GetWebhooksError,
+    GetWebhooksResponse,
+    ReturnType<typeof getWebhooksQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getWebhooks({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getWebhooksQueryKey(options),
+  });
+
+/**
+ * Create webhook endpoint
+ *
+ * Subscribes a URL to workout.created, workout.updated, workout.deleted, record.achieved or subscription.changed events. Each delivery is a JSON POST signed in the X-FitTrack-Signature header as t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed with the secret>. The secret is only returned here.
+ */
+export const postWebhooksMutation = (
+  options?: Partial<Options<PostWebhooksData>>,
+): UseMutationOptions<
+  PostWebhooksResponse,
+  PostWebhooksError,
+  Options<PostWebhooksData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostWebhooksResponse,
+    PostWebhooksError,
+    Options<PostWebhooksData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postWebhooks({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+/**
+ * Delete webhook endpoint
+ *
+ * Deletes an endpoint along with its delivery log and any pending deliveries.
+ */
+export const deleteWebhooksByIdMutation = (
+  options?: Partial<Options<DeleteWebhooksByIdData>>,
+): UseMutationOptions<
+  unknown,
+  DeleteWebhooksByIdError,
+  Options<DeleteWebhooksByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    DeleteWebhooksByIdError,
+    Options<DeleteWebhooksByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await deleteWebhooksById({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+/**
+ * Update webhook endpoint
+ *
+ * Changes the URL, event types or active flag; omitted fields are kept. Deactivating an endpoint fails its pending deliveries.
+ */
+export const patchWebhooksByIdMutation = (
+  options?: Partial<Options<PatchWebhooksByIdData>>,
+): UseMutationOptions<
+  PatchWebhooksByIdResponse,
+  PatchWebhooksByIdError,
+  Options<PatchWebhooksByIdData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PatchWebhooksByIdResponse,
+    PatchWebhooksByIdError,
+    Options<PatchWebhooksByIdData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await patchWebhooksById({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+export const getWebhooksByIdDeliveriesQueryKey = (
+  options: Options<GetWebhooksByIdDeliveriesData>,
+) => createQueryKey("getWebhooksByIdDeliveries", options, false, ["webhooks"]);
+
+/**
+ * List webhook deliveries
+ *
+ * Returns an endpoint's delivery log, newest first. Failed attempts are retried with exponential backoff for about three hours before a delivery is marked failed.
+ */
+export const getWebhooksByIdDeliveriesQueryOptions = (
+  options: Options<GetWebhooksByIdDeliveriesData>,
+) =>
+  queryOptions<
+    GetWebhooksByIdDeliveriesResponse,
+    GetWebhooksByIdDeliveriesError,
+    GetWebhooksByIdDeliveriesResponse,
+    ReturnType<typeof getWebhooksByIdDeliveriesQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getWebhooksByIdDeliveries({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getWebhooksByIdDeliveriesQueryKey(options),
+  });
+
+/**
+ * Send test webhook
+ *
+ * Sends a signed webhook.test event to the endpoint immediately and returns the logged delivery. A failed test is retried like any other delivery.
+ */
+export const postWebhooksByIdTestMutation = (
+  options?: Partial<Options<PostWebhooksByIdTestData>>,
+): UseMutationOptions<
+  PostWebhooksByIdTestResponse,
+  PostWebhooksByIdTestError,
+  Options<PostWebhooksByIdTestData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostWebhooksByIdTestResponse,
+    PostWebhooksByIdTestError,
+    Options<PostWebhooksByIdTestData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postWebhooksByIdTest({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
 export const getWorkoutsQueryKey = (options?: Options<GetWorkoutsData>) =>
   createQueryKey("getWorkouts", options, false, ["workouts"]);
 
 /**
  * List workouts
  *
- * Get all workouts for the authenticated user
+ * Get all workouts for the authenticated user, optionally filtered by tag
  */
 export const getWorkoutsQueryOptions = (options?: Options<GetWorkoutsData>) =>
   queryOptions<
@@ -798,6 +2888,36 @@ export const postWorkoutsMutation = (
   return mutationOptions;
 };
 
+export const getWorkoutsCompareQueryKey = (
+  options: Options<GetWorkoutsCompareData>,
+) => createQueryKey("getWorkoutsCompare", options, false, ["workouts"]);
+
+/**
+ * Compare two workouts
+ *
+ * Lines up the exercises of two workouts, matching by exercise and then by similar name, and returns the change in working sets, reps, top set, volume and estimated 1RM from workout a to workout b, plus the exercises only one of them contains.
+ */
+export const getWorkoutsCompareQueryOptions = (
+  options: Options<GetWorkoutsCompareData>,
+) =>
+  queryOptions<
+    GetWorkoutsCompareResponse,
+    GetWorkoutsCompareError,
+    GetWorkoutsCompareResponse,
+    ReturnType<typeof getWorkoutsCompareQueryKey>
+  >({
+    queryFn: async ({ queryKey, signal }) => {
+      const { data } = await getWorkoutsCompare({
+        ...options,
+        ...queryKey[0],
+        signal,
+        throwOnError: true,
+      });
+      return data;
+    },
+    queryKey: getWorkoutsCompareQueryKey(options),
+  });
+
 export const getWorkoutsContributionDataQueryKey = (
   options?: Options<GetWorkoutsContributionDataData>,
 ) =>
@@ -977,3 +3097,61 @@ export const putWorkoutsByIdMutation = (
   };
   return mutationOptions;
 };
+
+/**
+ * Revoke workout share link
+ *
+ * Disables the public link to one of the authenticated user's workouts.
+ */
+export const deleteWorkoutsByIdShareMutation = (
+  options?: Partial<Options<DeleteWorkoutsByIdShareData>>,
+): UseMutationOptions<
+  unknown,
+  DeleteWorkoutsByIdShareError,
+  Options<DeleteWorkoutsByIdShareData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    unknown,
+    DeleteWorkoutsByIdShareError,
+    Options<DeleteWorkoutsByIdShareData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await deleteWorkoutsByIdShare({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
+
+/**
+ * Create workout share link
+ *
+ * Issues an unguessable public link to a read-only view of one of the authenticated user's workouts. Sharing again replaces the previous link. The request body is optional.
+ */
+export const postWorkoutsByIdShareMutation = (
+  options?: Partial<Options<PostWorkoutsByIdShareData>>,
+): UseMutationOptions<
+  PostWorkoutsByIdShareResponse,
+  PostWorkoutsByIdShareError,
+  Options<PostWorkoutsByIdShareData>
+> => {
+  const mutationOptions: UseMutationOptions<
+    PostWorkoutsByIdShareResponse,
+    PostWorkoutsByIdShareError,
+    Options<PostWorkoutsByIdShareData>
+  > = {
+    mutationFn: async (fnOptions) => {
+      const { data } = await postWorkoutsByIdShare({
+        ...options,
+        ...fnOptions,
+        throwOnError: true,
+      });
+      return data;
+    },
+  };
+  return mutationOptions;
+};
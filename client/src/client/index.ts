@@ -1,39 +1,110 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
 export {
+  deleteAccessTokensById,
   deleteAiConversations,
   deleteAiConversationsById,
+  deleteBodyMetricsById,
+  deleteCalendarFeed,
+  deleteCoachingLinksById,
+  deleteCoachingSuggestionsById,
   deleteExercisesById,
+  deleteGoalsById,
+  deleteTagsById,
+  deleteWebhooksById,
   deleteWorkoutsById,
+  deleteWorkoutsByIdShare,
+  getAccessTokens,
+  getAccountTimezone,
+  getAchievements,
   getAiConversations,
   getAiConversationsById,
   getAiConversationsByIdMessagesStreamResume,
+  getAnalyticsConsistency,
+  getAnalyticsPlateaus,
+  getAnalyticsTrainingLoad,
+  getAnalyticsVolume,
+  getBodyMetrics,
+  getBodyMetricsById,
+  getBodyMetricsTrend,
+  getCalendarFeed,
+  getCoachingAthletes,
+  getCoachingCoaches,
+  getCoachingInvitations,
+  getCoachingSuggestions,
+  getEquipment,
   getExercises,
   getExercisesById,
   getExercisesByIdMetricsHistory,
+  getExercisesByIdNotes,
   getExercisesByIdRecentSets,
+  getExercisesMetricsHistory,
   getFeaturesAccess,
+  getGoals,
+  getNotifications,
+  getNotificationsStream,
+  getNotificationsUnreadCount,
+  getReportsByPeriod,
+  getSearch,
+  getStrengthProfile,
+  getStrengthScores,
+  getStrengthScoresHistory,
+  getTags,
+  getToolsPlates,
   getTrainingProfile,
+  getWebhooks,
+  getWebhooksByIdDeliveries,
   getWorkouts,
   getWorkoutsById,
+  getWorkoutsCompare,
   getWorkoutsContributionData,
   getWorkoutsFocusValues,
   getWorkoutsNewWorkoutContext,
   type Options,
   patchExercisesById,
   patchExercisesByIdHistorical1Rm,
+  patchGoalsById,
+  patchTagsById,
+  patchWebhooksById,
+  postAccessTokens,
   postAiChatTelemetry,
   postAiConversations,
   postAiConversationsByIdLatestWorkoutDraftSave,
   postAiConversationsByIdMessagesRecover,
   postAiConversationsByIdMessagesStream,
   postAiConversationsByIdRunsByRunIdStop,
+  postBodyMetrics,
+  postCalendarFeedToken,
+  postCoachingAthletesByAthleteIdSuggestions,
+  postCoachingInvitations,
+  postCoachingInvitationsAccept,
   postExercises,
+  postGoals,
+  postNotificationsByIdRead,
+  postNotificationsReadAll,
+  postTags,
+  postToolsWarmup,
+  postWebhooks,
+  postWebhooksByIdTest,
   postWorkouts,
+  postWorkoutsByIdShare,
+  putAccountTimezone,
+  putBodyMetricsById,
+  putCoachingLinksByIdScopes,
+  putEquipment,
+  putExercisesByIdNotes,
+  putStrengthProfile,
   putTrainingProfile,
   putWorkoutsById,
 } from "./sdk.gen";
 export {
+  type AccesstokensCreatedTokenResponse,
+  type AccesstokensCreateTokenRequest,
+  type AccesstokensTokenResponse,
+  type AccountTimezoneResponse,
+  type AccountUpdateTimezoneRequest,
+  type AchievementsAchievementResponse,
+  type AchievementsAchievementsResponse,
   type AichatChatMessage,
   type AichatClientTelemetryEvent,
   type AichatConversation,
@@ -46,7 +117,38 @@ export {
   type AichatSendMessageRequest,
   type AichatStopRunResponse,
   type AichatStreamEvent,
+  type AnalyticsConsistencyResponse,
+  type AnalyticsExercisePlateau,
+  type AnalyticsPlateauIntervention,
+  type AnalyticsPlateauResponse,
+  type AnalyticsStreakSummary,
+  type AnalyticsTrainingLoadPoint,
+  type AnalyticsTrainingLoadResponse,
+  type AnalyticsTrainingLoadSummary,
+  type AnalyticsTrainingLoadWarning,
+  type AnalyticsVolumePeriod,
+  type AnalyticsVolumeResponse,
+  type AnalyticsVolumeTotals,
+  type AnalyticsWeeklySessions,
+  type BodymetricsEntryRequest,
+  type BodymetricsEntryResponse,
+  type BodymetricsTrendPoint,
+  type BodymetricsTrendResponse,
+  type CalendarFeedStatusResponse,
+  type CalendarFeedTokenResponse,
   type ClientOptions,
+  type CoachingAcceptInvitationRequest,
+  type CoachingInvitationRequest,
+  type CoachingInvitationResponse,
+  type CoachingLinkResponse,
+  type CoachingSuggestedExercise,
+  type CoachingSuggestionRequest,
+  type CoachingSuggestionResponse,
+  type CoachingUpdateScopesRequest,
+  type DeleteAccessTokensByIdData,
+  type DeleteAccessTokensByIdError,
+  type DeleteAccessTokensByIdErrors,
+  type DeleteAccessTokensByIdResponses,
   type DeleteAiConversationsByIdData,
   type DeleteAiConversationsByIdError,
   type DeleteAiConversationsByIdErrors,
@@ -55,27 +157,86 @@ export {
   type DeleteAiConversationsError,
   type DeleteAiConversationsErrors,
   type DeleteAiConversationsResponses,
+  type DeleteBodyMetricsByIdData,
+  type DeleteBodyMetricsByIdError,
+  type DeleteBodyMetricsByIdErrors,
+  type DeleteBodyMetricsByIdResponses,
+  type DeleteCalendarFeedData,
+  type DeleteCalendarFeedError,
+  type DeleteCalendarFeedErrors,
+  type DeleteCalendarFeedResponses,
+  type DeleteCoachingLinksByIdData,
+  type DeleteCoachingLinksByIdError,
+  type DeleteCoachingLinksByIdErrors,
+  type DeleteCoachingLinksByIdResponses,
+  type DeleteCoachingSuggestionsByIdData,
+  type DeleteCoachingSuggestionsByIdError,
+  type DeleteCoachingSuggestionsByIdErrors,
+  type DeleteCoachingSuggestionsByIdResponses,
   type DeleteExercisesByIdData,
   type DeleteExercisesByIdError,
   type DeleteExercisesByIdErrors,
   type DeleteExercisesByIdResponses,
+  type DeleteGoalsByIdData,
+  type DeleteGoalsByIdError,
+  type DeleteGoalsByIdErrors,
+  type DeleteGoalsByIdResponses,
+  type DeleteTagsByIdData,
+  type DeleteTagsByIdError,
+  type DeleteTagsByIdErrors,
+  type DeleteTagsByIdResponses,
+  type DeleteWebhooksByIdData,
+  type DeleteWebhooksByIdError,
+  type DeleteWebhooksByIdErrors,
+  type DeleteWebhooksByIdResponses,
   type DeleteWorkoutsByIdData,
   type DeleteWorkoutsByIdError,
   type DeleteWorkoutsByIdErrors,
   type DeleteWorkoutsByIdResponses,
+  type DeleteWorkoutsByIdShareData,
+  type DeleteWorkoutsByIdShareError,
+  type DeleteWorkoutsByIdShareErrors,
+  type DeleteWorkoutsByIdShareResponses,
+  type EquipmentInventoryResponse,
+  type EquipmentPlate,
+  type EquipmentPlateBreakdown,
+  type EquipmentPlateCount,
+  type EquipmentUpdateInventoryRequest,
   type ExerciseCreateExerciseRequest,
   type ExerciseCreateExerciseResponse,
   type ExerciseExerciseDetailExerciseResponse,
   type ExerciseExerciseDetailResponse,
+  type ExerciseExerciseMetricsComparisonPoint,
   type ExerciseExerciseMetricsHistoryPoint,
   type ExerciseExerciseMetricsHistoryResponse,
+  type ExerciseExerciseMetricsSeries,
+  type ExerciseExerciseNoteResponse,
+  type ExerciseExerciseNotesResponse,
   type ExerciseExerciseResponse,
+  type ExerciseExercisesMetricsHistoryResponse,
   type ExerciseExerciseWithSetsResponse,
+  type ExerciseMetricsHistoryAxisPoint,
   ExerciseMetricsHistoryBucket,
   type ExerciseRecentSetsResponse,
   type ExerciseUpdateExerciseHistorical1RmRequest,
   type ExerciseUpdateExerciseNameRequest,
+  type ExerciseUpdateExerciseNotesRequest,
   type FeatureaccessFeatureAccessResponse,
+  type GetAccessTokensData,
+  type GetAccessTokensError,
+  type GetAccessTokensErrors,
+  type GetAccessTokensResponse,
+  type GetAccessTokensResponses,
+  type GetAccountTimezoneData,
+  type GetAccountTimezoneError,
+  type GetAccountTimezoneErrors,
+  type GetAccountTimezoneResponse,
+  type GetAccountTimezoneResponses,
+  type GetAchievementsData,
+  type GetAchievementsError,
+  type GetAchievementsErrors,
+  type GetAchievementsResponse,
+  type GetAchievementsResponses,
   type GetAiConversationsByIdData,
   type GetAiConversationsByIdError,
   type GetAiConversationsByIdErrors,
@@ -91,6 +252,71 @@ export {
   type GetAiConversationsErrors,
   type GetAiConversationsResponse,
   type GetAiConversationsResponses,
+  type GetAnalyticsConsistencyData,
+  type GetAnalyticsConsistencyError,
+  type GetAnalyticsConsistencyErrors,
+  type GetAnalyticsConsistencyResponse,
+  type GetAnalyticsConsistencyResponses,
+  type GetAnalyticsPlateausData,
+  type GetAnalyticsPlateausError,
+  type GetAnalyticsPlateausErrors,
+  type GetAnalyticsPlateausResponse,
+  type GetAnalyticsPlateausResponses,
+  type GetAnalyticsTrainingLoadData,
+  type GetAnalyticsTrainingLoadError,
+  type GetAnalyticsTrainingLoadErrors,
+  type GetAnalyticsTrainingLoadResponse,
+  type GetAnalyticsTrainingLoadResponses,
+  type GetAnalyticsVolumeData,
+  type GetAnalyticsVolumeError,
+  type GetAnalyticsVolumeErrors,
+  type GetAnalyticsVolumeResponse,
+  type GetAnalyticsVolumeResponses,
+  type GetBodyMetricsByIdData,
+  type GetBodyMetricsByIdError,
+  type GetBodyMetricsByIdErrors,
+  type GetBodyMetricsByIdResponse,
+  type GetBodyMetricsByIdResponses,
+  type GetBodyMetricsData,
+  type GetBodyMetricsError,
+  type GetBodyMetricsErrors,
+  type GetBodyMetricsResponse,
+  type GetBodyMetricsResponses,
+  type GetBodyMetricsTrendData,
+  type GetBodyMetricsTrendError,
+  type GetBodyMetricsTrendErrors,
+  type GetBodyMetricsTrendResponse,
+  type GetBodyMetricsTrendResponses,
+  type GetCalendarFeedData,
+  type GetCalendarFeedError,
+  type GetCalendarFeedErrors,
+  type GetCalendarFeedResponse,
+  type GetCalendarFeedResponses,
+  type GetCoachingAthletesData,
+  type GetCoachingAthletesError,
+  type GetCoachingAthletesErrors,
+  type GetCoachingAthletesResponse,
+  type GetCoachingAthletesResponses,
+  type GetCoachingCoachesData,
+  type GetCoachingCoachesError,
+  type GetCoachingCoachesErrors,
+  type GetCoachingCoachesResponse,
+  type GetCoachingCoachesResponses,
+  type GetCoachingInvitationsData,
+  type GetCoachingInvitationsError,
+  type GetCoachingInvitationsErrors,
+  type GetCoachingInvitationsResponse,
+  type GetCoachingInvitationsResponses,
+  type GetCoachingSuggestionsData,
+  type GetCoachingSuggestionsError,
+  type GetCoachingSuggestionsErrors,
+  type GetCoachingSuggestionsResponse,
+  type GetCoachingSuggestionsResponses,
+  type GetEquipmentData,
+  type GetEquipmentError,
+  type GetEquipmentErrors,
+  type GetEquipmentResponse,
+  type GetEquipmentResponses,
   type GetExercisesByIdData,
   type GetExercisesByIdError,
   type GetExercisesByIdErrors,
@@ -99,6 +325,11 @@ export {
   type GetExercisesByIdMetricsHistoryErrors,
   type GetExercisesByIdMetricsHistoryResponse,
   type GetExercisesByIdMetricsHistoryResponses,
+  type GetExercisesByIdNotesData,
+  type GetExercisesByIdNotesError,
+  type GetExercisesByIdNotesErrors,
+  type GetExercisesByIdNotesResponse,
+  type GetExercisesByIdNotesResponses,
   type GetExercisesByIdRecentSetsData,
   type GetExercisesByIdRecentSetsError,
   type GetExercisesByIdRecentSetsErrors,
@@ -109,6 +340,11 @@ export {
   type GetExercisesData,
   type GetExercisesError,
   type GetExercisesErrors,
+  type GetExercisesMetricsHistoryData,
+  type GetExercisesMetricsHistoryError,
+  type GetExercisesMetricsHistoryErrors,
+  type GetExercisesMetricsHistoryResponse,
+  type GetExercisesMetricsHistoryResponses,
   type GetExercisesResponse,
   type GetExercisesResponses,
   type GetFeaturesAccessData,
@@ -116,16 +352,86 @@ export {
   type GetFeaturesAccessErrors,
   type GetFeaturesAccessResponse,
   type GetFeaturesAccessResponses,
+  type GetGoalsData,
+  type GetGoalsError,
+  type GetGoalsErrors,
+  type GetGoalsResponse,
+  type GetGoalsResponses,
+  type GetNotificationsData,
+  type GetNotificationsError,
+  type GetNotificationsErrors,
+  type GetNotificationsResponse,
+  type GetNotificationsResponses,
+  type GetNotificationsStreamData,
+  type GetNotificationsStreamError,
+  type GetNotificationsStreamErrors,
+  type GetNotificationsStreamResponse,
+  type GetNotificationsStreamResponses,
+  type GetNotificationsUnreadCountData,
+  type GetNotificationsUnreadCountError,
+  type GetNotificationsUnreadCountErrors,
+  type GetNotificationsUnreadCountResponse,
+  type GetNotificationsUnreadCountResponses,
+  type GetReportsByPeriodData,
+  type GetReportsByPeriodError,
+  type GetReportsByPeriodErrors,
+  type GetReportsByPeriodResponse,
+  type GetReportsByPeriodResponses,
+  type GetSearchData,
+  type GetSearchError,
+  type GetSearchErrors,
+  type GetSearchResponse,
+  type GetSearchResponses,
+  type GetStrengthProfileData,
+  type GetStrengthProfileError,
+  type GetStrengthProfileErrors,
+  type GetStrengthProfileResponse,
+  type GetStrengthProfileResponses,
+  type GetStrengthScoresData,
+  type GetStrengthScoresError,
+  type GetStrengthScoresErrors,
+  type GetStrengthScoresHistoryData,
+  type GetStrengthScoresHistoryError,
+  type GetStrengthScoresHistoryErrors,
+  type GetStrengthScoresHistoryResponse,
+  type GetStrengthScoresHistoryResponses,
+  type GetStrengthScoresResponse,
+  type GetStrengthScoresResponses,
+  type GetTagsData,
+  type GetTagsError,
+  type GetTagsErrors,
+  type GetTagsResponse,
+  type GetTagsResponses,
+  type GetToolsPlatesData,
+  type GetToolsPlatesError,
+  type GetToolsPlatesErrors,
+  type GetToolsPlatesResponse,
+  type GetToolsPlatesResponses,
   type GetTrainingProfileData,
   type GetTrainingProfileError,
   type GetTrainingProfileErrors,
   type GetTrainingProfileResponse,
   type GetTrainingProfileResponses,
+  type GetWebhooksByIdDeliveriesData,
+  type GetWebhooksByIdDeliveriesError,
+  type GetWebhooksByIdDeliveriesErrors,
+  type GetWebhooksByIdDeliveriesResponse,
+  type GetWebhooksByIdDeliveriesResponses,
+  type GetWebhooksData,
+  type GetWebhooksError,
+  type GetWebhooksErrors,
+  type GetWebhooksResponse,
+  type GetWebhooksResponses,
   type GetWorkoutsByIdData,
   type GetWorkoutsByIdError,
   type GetWorkoutsByIdErrors,
   type GetWorkoutsByIdResponse,
   type GetWorkoutsByIdResponses,
+  type GetWorkoutsCompareData,
+  type GetWorkoutsCompareError,
+  type GetWorkoutsCompareErrors,
+  type GetWorkoutsCompareResponse,
+  type GetWorkoutsCompareResponses,
   type GetWorkoutsContributionDataData,
   type GetWorkoutsContributionDataError,
   type GetWorkoutsContributionDataErrors,
@@ -146,6 +452,14 @@ export {
   type GetWorkoutsNewWorkoutContextResponses,
   type GetWorkoutsResponse,
   type GetWorkoutsResponses,
+  type GoalsCreateGoalRequest,
+  type GoalsGoalResponse,
+  type GoalsUpdateGoalRequest,
+  type NotificationsMarkAllReadResponse,
+  type NotificationsNotificationResponse,
+  type NotificationsNotificationsResponse,
+  type NotificationsStreamEvent,
+  type NotificationsUnreadCountResponse,
   type PatchExercisesByIdData,
   type PatchExercisesByIdError,
   type PatchExercisesByIdErrors,
@@ -154,6 +468,26 @@ export {
   type PatchExercisesByIdHistorical1RmErrors,
   type PatchExercisesByIdHistorical1RmResponses,
   type PatchExercisesByIdResponses,
+  type PatchGoalsByIdData,
+  type PatchGoalsByIdError,
+  type PatchGoalsByIdErrors,
+  type PatchGoalsByIdResponse,
+  type PatchGoalsByIdResponses,
+  type PatchTagsByIdData,
+  type PatchTagsByIdError,
+  type PatchTagsByIdErrors,
+  type PatchTagsByIdResponse,
+  type PatchTagsByIdResponses,
+  type PatchWebhooksByIdData,
+  type PatchWebhooksByIdError,
+  type PatchWebhooksByIdErrors,
+  type PatchWebhooksByIdResponse,
+  type PatchWebhooksByIdResponses,
+  type PostAccessTokensData,
+  type PostAccessTokensError,
+  type PostAccessTokensErrors,
+  type PostAccessTokensResponse,
+  type PostAccessTokensResponses,
   type PostAiChatTelemetryData,
   type PostAiChatTelemetryError,
   type PostAiChatTelemetryErrors,
@@ -183,16 +517,110 @@ export {
   type PostAiConversationsErrors,
   type PostAiConversationsResponse,
   type PostAiConversationsResponses,
+  type PostBodyMetricsData,
+  type PostBodyMetricsError,
+  type PostBodyMetricsErrors,
+  type PostBodyMetricsResponse,
+  type PostBodyMetricsResponses,
+  type PostCalendarFeedTokenData,
+  type PostCalendarFeedTokenError,
+  type PostCalendarFeedTokenErrors,
+  type PostCalendarFeedTokenResponse,
+  type PostCalendarFeedTokenResponses,
+  type PostCoachingAthletesByAthleteIdSuggestionsData,
+  type PostCoachingAthletesByAthleteIdSuggestionsError,
+  type PostCoachingAthletesByAthleteIdSuggestionsErrors,
+  type PostCoachingAthletesByAthleteIdSuggestionsResponse,
+  type PostCoachingAthletesByAthleteIdSuggestionsResponses,
+  type PostCoachingInvitationsAcceptData,
+  type PostCoachingInvitationsAcceptError,
+  type PostCoachingInvitationsAcceptErrors,
+  type PostCoachingInvitationsAcceptResponse,
+  type PostCoachingInvitationsAcceptResponses,
+  type PostCoachingInvitationsData,
+  type PostCoachingInvitationsError,
+  type PostCoachingInvitationsErrors,
+  type PostCoachingInvitationsResponse,
+  type PostCoachingInvitationsResponses,
   type PostExercisesData,
   type PostExercisesError,
   type PostExercisesErrors,
   type PostExercisesResponse,
   type PostExercisesResponses,
+  type PostGoalsData,
+  type PostGoalsError,
+  type PostGoalsErrors,
+  type PostGoalsResponse,
+  type PostGoalsResponses,
+  type PostNotificationsByIdReadData,
+  type PostNotificationsByIdReadError,
+  type PostNotificationsByIdReadErrors,
+  type PostNotificationsByIdReadResponses,
+  type PostNotificationsReadAllData,
+  type PostNotificationsReadAllError,
+  type PostNotificationsReadAllErrors,
+  type PostNotificationsReadAllResponse,
+  type PostNotificationsReadAllResponses,
+  type PostTagsData,
+  type PostTagsError,
+  type PostTagsErrors,
+  type PostTagsResponse,
+  type PostTagsResponses,
+  type PostToolsWarmupData,
+  type PostToolsWarmupError,
+  type PostToolsWarmupErrors,
+  type PostToolsWarmupResponse,
+  type PostToolsWarmupResponses,
+  type PostWebhooksByIdTestData,
+  type PostWebhooksByIdTestError,
+  type PostWebhooksByIdTestErrors,
+  type PostWebhooksByIdTestResponse,
+  type PostWebhooksByIdTestResponses,
+  type PostWebhooksData,
+  type PostWebhooksError,
+  type PostWebhooksErrors,
+  type PostWebhooksResponse,
+  type PostWebhooksResponses,
+  type PostWorkoutsByIdShareData,
+  type PostWorkoutsByIdShareError,
+  type PostWorkoutsByIdShareErrors,
+  type PostWorkoutsByIdShareResponse,
+  type PostWorkoutsByIdShareResponses,
   type PostWorkoutsData,
   type PostWorkoutsError,
   type PostWorkoutsErrors,
   type PostWorkoutsResponse,
   type PostWorkoutsResponses,
+  type PutAccountTimezoneData,
+  type PutAccountTimezoneError,
+  type PutAccountTimezoneErrors,
+  type PutAccountTimezoneResponse,
+  type PutAccountTimezoneResponses,
+  type PutBodyMetricsByIdData,
+  type PutBodyMetricsByIdError,
+  type PutBodyMetricsByIdErrors,
+  type PutBodyMetricsByIdResponse,
+  type PutBodyMetricsByIdResponses,
+  type PutCoachingLinksByIdScopesData,
+  type PutCoachingLinksByIdScopesError,
+  type PutCoachingLinksByIdScopesErrors,
+  type PutCoachingLinksByIdScopesResponse,
+  type PutCoachingLinksByIdScopesResponses,
+  type PutEquipmentData,
+  type PutEquipmentError,
+  type PutEquipmentErrors,
+  type PutEquipmentResponse,
+  type PutEquipmentResponses,
+  type PutExercisesByIdNotesData,
+  type PutExercisesByIdNotesError,
+  type PutExercisesByIdNotesErrors,
+  type PutExercisesByIdNotesResponse,
+  type PutExercisesByIdNotesResponses,
+  type PutStrengthProfileData,
+  type PutStrengthProfileError,
+  type PutStrengthProfileErrors,
+  type PutStrengthProfileResponse,
+  type PutStrengthProfileResponses,
   type PutTrainingProfileData,
   type PutTrainingProfileError,
   type PutTrainingProfileErrors,
@@ -202,22 +630,57 @@ export {
   type PutWorkoutsByIdError,
   type PutWorkoutsByIdErrors,
   type PutWorkoutsByIdResponses,
+  type ReportExerciseImprovement,
+  type ReportFocusBreakdown,
+  type ReportHistorical1RmImprovement,
+  type ReportPeriod,
+  type ReportPeriodComparison,
+  type ReportReport,
+  type ReportTotals,
   type ResponseError,
   type ResponseErrorResponse,
   type ResponseSuccessResponse,
+  type SearchHit,
+  type SearchSearchResponse,
+  type ShareCreateShareRequest,
+  type ShareShareLinkResponse,
+  type StrengthLiftClassification,
+  type StrengthLiftScore,
+  type StrengthProfileResponse,
+  type StrengthScoreHistoryPoint,
+  type StrengthScoreHistoryResponse,
+  type StrengthScoresResponse,
+  type StrengthUpdateProfileRequest,
+  type TagTagRequest,
+  type TagTagResponse,
   type TrainingprofileProfileResponse,
   type TrainingprofileUpdateProfileRequest,
+  type WebhooksCreatedEndpointResponse,
+  type WebhooksCreateEndpointRequest,
+  type WebhooksDeliveryResponse,
+  type WebhooksEndpointResponse,
+  type WebhooksUpdateEndpointRequest,
+  type WorkoutComparedWorkoutResponse,
   type WorkoutContributionDataResponse,
   type WorkoutContributionDay,
   type WorkoutCreateWorkoutRequest,
+  type WorkoutExerciseComparisonResponse,
+  type WorkoutExerciseDeltaResponse,
   type WorkoutExerciseInput,
+  type WorkoutExerciseSessionResponse,
   type WorkoutFocusTemplateResponse,
   type WorkoutLatestWorkoutNoteResponse,
   type WorkoutNewWorkoutContextResponse,
+  type WorkoutPlateauedExerciseResponse,
   type WorkoutSetInput,
+  type WorkoutTopSetResponse,
   type WorkoutUpdateExercise,
   type WorkoutUpdateSet,
   type WorkoutUpdateWorkoutRequest,
+  type WorkoutWarmupRequest,
+  type WorkoutWarmupResponse,
+  type WorkoutWarmupStep,
+  type WorkoutWorkoutComparisonResponse,
   type WorkoutWorkoutResponse,
   type WorkoutWorkoutSummary,
   type WorkoutWorkoutWithSetsResponse,
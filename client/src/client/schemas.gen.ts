@@ -1,5 +1,181 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
+export const accesstokens_CreateTokenRequestSchema = {
+  type: "object",
+  properties: {
+    expires_in_days: {
+      type: "integer",
+      maximum: 365,
+      minimum: 1,
+      example: 90,
+    },
+    name: {
+      type: "string",
+      example: "Training log sync",
+    },
+    scopes: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+      example: ["workouts:read"],
+    },
+  },
+} as const;
+
+export const accesstokens_CreatedTokenResponseSchema = {
+  type: "object",
+  properties: {
+    created_at: {
+      type: "string",
+    },
+    expires_at: {
+      type: "string",
+    },
+    id: {
+      type: "integer",
+      example: 4,
+    },
+    last_used_at: {
+      type: "string",
+    },
+    name: {
+      type: "string",
+      example: "Training log sync",
+    },
+    prefix: {
+      type: "string",
+      example: "ftpat_Qm9vb2",
+    },
+    scopes: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+      example: ["workouts:read"],
+    },
+    token: {
+      type: "string",
+      example: "ftpat_Qm9vb2xlYW5EZXZpY2VUb2tlbkV4YW1wbGVWYWx1ZTEyMzQ1Ng",
+    },
+  },
+} as const;
+
+export const accesstokens_TokenResponseSchema = {
+  type: "object",
+  properties: {
+    created_at: {
+      type: "string",
+    },
+    expires_at: {
+      type: "string",
+    },
+    id: {
+      type: "integer",
+      example: 4,
+    },
+    last_used_at: {
+      type: "string",
+    },
+    name: {
+      type: "string",
+      example: "Training log sync",
+    },
+    prefix: {
+      type: "string",
+      example: "ftpat_Qm9vb2",
+    },
+    scopes: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+      example: ["workouts:read"],
+    },
+  },
+} as const;
+
+export const account_TimezoneResponseSchema = {
+  type: "object",
+  properties: {
+    source: {
+      type: "string",
+      enum: ["stored", "default"],
+    },
+    timezone: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const account_UpdateTimezoneRequestSchema = {
+  type: "object",
+  properties: {
+    timezone: {
+      type: "string",
+      "x-nullable": true,
+    },
+  },
+} as const;
+
+export const achievements_AchievementResponseSchema = {
+  type: "object",
+  properties: {
+    current: {
+      type: "number",
+      example: 42,
+    },
+    description: {
+      type: "string",
+      example: "Log 100 workouts",
+    },
+    earned: {
+      type: "boolean",
+    },
+    key: {
+      type: "string",
+      example: "workouts_100",
+    },
+    name: {
+      type: "string",
+      example: "Centurion",
+    },
+    progress: {
+      type: "number",
+      example: 0.42,
+    },
+    target: {
+      type: "number",
+      example: 100,
+    },
+    unlocked_at: {
+      type: "string",
+    },
+    workout_id: {
+      type: "integer",
+      example: 87,
+    },
+  },
+} as const;
+
+export const achievements_AchievementsResponseSchema = {
+  type: "object",
+  properties: {
+    earned: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/achievements.AchievementResponse",
+      },
+    },
+    in_progress: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/achievements.AchievementResponse",
+      },
+    },
+  },
+} as const;
+
 export const aichat_ChatMessageSchema = {
   type: "object",
   properties: {
@@ -252,457 +428,2256 @@ export const aichat_StreamEventSchema = {
   },
 } as const;
 
-export const exercise_CreateExerciseRequestSchema = {
-  type: "object",
-  required: ["name"],
-  properties: {
-    name: {
-      type: "string",
-    },
-  },
-} as const;
-
-export const exercise_CreateExerciseResponseSchema = {
+export const analytics_ConsistencyResponseSchema = {
   type: "object",
-  required: ["created_at", "id", "name", "updated_at", "user_id"],
   properties: {
-    created_at: {
-      type: "string",
-      example: "2023-01-01T15:04:05Z",
+    allowed_rest_days: {
+      type: "integer",
     },
-    id: {
+    current_week_sessions: {
       type: "integer",
-      example: 1,
     },
-    name: {
-      type: "string",
-      example: "Bench Press",
+    current_week_target_reached: {
+      type: "boolean",
     },
-    updated_at: {
-      type: "string",
-      example: "2023-01-01T15:04:05Z",
+    daily_streak: {
+      $ref: "#/definitions/analytics.StreakSummary",
     },
-    user_id: {
+    last_workout_date: {
       type: "string",
-      example: "user-123",
-    },
-  },
-} as const;
-
-export const exercise_ExerciseDetailExerciseResponseSchema = {
-  type: "object",
-  required: ["created_at", "id", "name", "updated_at", "user_id"],
-  properties: {
-    best_e1rm: {
-      type: "number",
-      example: 305,
     },
-    created_at: {
-      type: "string",
-      example: "2023-01-01T15:04:05Z",
+    rolling_weeks: {
+      type: "integer",
     },
-    historical_1rm: {
+    sessions_per_week_avg: {
       type: "number",
-      example: 315,
     },
-    historical_1rm_source_workout_id: {
+    target_sessions_per_week: {
       type: "integer",
-      example: 42,
     },
-    historical_1rm_updated_at: {
+    timezone: {
       type: "string",
-      example: "2023-01-01T15:04:05Z",
     },
-    id: {
+    total_sessions: {
       type: "integer",
-      example: 1,
     },
-    name: {
-      type: "string",
-      example: "Bench Press",
+    training_days: {
+      type: "integer",
     },
-    updated_at: {
-      type: "string",
-      example: "2023-01-01T15:04:05Z",
+    weekly_sessions: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/analytics.WeeklySessions",
+      },
     },
-    user_id: {
-      type: "string",
-      example: "user-123",
+    weekly_streak: {
+      $ref: "#/definitions/analytics.StreakSummary",
     },
   },
 } as const;
 
-export const exercise_ExerciseDetailResponseSchema = {
+export const analytics_ExercisePlateauSchema = {
   type: "object",
-  required: ["exercise", "sets"],
   properties: {
-    exercise: {
-      $ref: "#/definitions/exercise.ExerciseDetailExerciseResponse",
+    avg_reps: {
+      type: "number",
     },
-    sets: {
+    exercise_id: {
+      type: "integer",
+    },
+    exercise_name: {
+      type: "string",
+    },
+    first_session: {
+      type: "string",
+      example: "2026-06-01",
+    },
+    interventions: {
       type: "array",
       items: {
-        $ref: "#/definitions/exercise.ExerciseWithSetsResponse",
+        $ref: "#/definitions/analytics.PlateauIntervention",
       },
     },
-  },
-} as const;
-
-export const exercise_ExerciseMetricsHistoryPointSchema = {
-  type: "object",
-  properties: {
-    date: {
+    last_session: {
       type: "string",
+      example: "2026-07-10",
     },
-    session_avg_e1rm: {
+    percent_per_week: {
       type: "number",
     },
-    session_avg_intensity: {
+    prior_best_e1rm: {
       type: "number",
     },
-    session_best_e1rm: {
-      type: "number",
+    sessions: {
+      type: "integer",
     },
-    session_best_intensity: {
+    slope_per_week: {
       type: "number",
     },
-    total_volume_working: {
+    status: {
+      type: "string",
+      example: "stalled",
+    },
+    window_best_e1rm: {
       type: "number",
     },
-    workout_id: {
-      type: "integer",
+  },
+} as const;
+
+export const analytics_PlateauInterventionSchema = {
+  type: "object",
+  properties: {
+    message: {
+      type: "string",
     },
-    x: {
+    type: {
       type: "string",
+      example: "deload",
     },
   },
 } as const;
 
-export const exercise_ExerciseMetricsHistoryResponseSchema = {
+export const analytics_PlateauResponseSchema = {
   type: "object",
   properties: {
-    bucket: {
-      $ref: "#/definitions/exercise.MetricsHistoryBucket",
-    },
-    points: {
+    exercises: {
       type: "array",
       items: {
-        $ref: "#/definitions/exercise.ExerciseMetricsHistoryPoint",
+        $ref: "#/definitions/analytics.ExercisePlateau",
       },
     },
-    range: {
-      type: "string",
+    flagged: {
+      type: "integer",
+    },
+    min_sessions: {
+      type: "integer",
+    },
+    weeks: {
+      type: "integer",
     },
   },
 } as const;
 
-export const exercise_ExerciseResponseSchema = {
+export const analytics_StreakSummarySchema = {
   type: "object",
-  required: ["created_at", "id", "name", "updated_at", "user_id"],
   properties: {
-    created_at: {
+    current: {
+      type: "integer",
+    },
+    current_start: {
       type: "string",
-      example: "2023-01-01T15:04:05Z",
     },
-    id: {
+    longest: {
       type: "integer",
-      example: 1,
     },
-    name: {
+    longest_end: {
       type: "string",
-      example: "Bench Press",
-    },
-    updated_at: {
-      type: "string",
-      example: "2023-01-01T15:04:05Z",
     },
-    user_id: {
+    longest_start: {
       type: "string",
-      example: "user-123",
     },
   },
 } as const;
 
-export const exercise_ExerciseWithSetsResponseSchema = {
+export const analytics_TrainingLoadPointSchema = {
   type: "object",
-  required: [
-    "exercise_id",
-    "exercise_name",
-    "reps",
-    "set_id",
-    "set_type",
-    "volume",
-    "workout_date",
-    "workout_id",
-  ],
   properties: {
-    exercise_id: {
-      type: "integer",
-      example: 1,
+    acute_load: {
+      type: "number",
     },
-    exercise_name: {
+    acwr: {
+      type: "number",
+    },
+    chronic_load: {
+      type: "number",
+    },
+    date: {
       type: "string",
-      example: "Bench Press",
+      example: "2026-07-01",
     },
-    exercise_order: {
-      type: "integer",
-      example: 0,
+    load: {
+      type: "number",
     },
-    reps: {
-      type: "integer",
-      example: 10,
+  },
+} as const;
+
+export const analytics_TrainingLoadResponseSchema = {
+  type: "object",
+  properties: {
+    current: {
+      $ref: "#/definitions/analytics.TrainingLoadSummary",
     },
-    set_id: {
+    days: {
       type: "integer",
-      example: 1,
     },
-    set_order: {
-      type: "integer",
-      example: 1,
+    metric: {
+      type: "string",
     },
-    set_type: {
+    points: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/analytics.TrainingLoadPoint",
+      },
+    },
+    timezone: {
       type: "string",
-      example: "working",
     },
-    volume: {
+    warnings: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/analytics.TrainingLoadWarning",
+      },
+    },
+  },
+} as const;
+
+export const analytics_TrainingLoadSummarySchema = {
+  type: "object",
+  properties: {
+    acute_load: {
       type: "number",
-      example: 2250.5,
     },
-    weight: {
+    acwr: {
       type: "number",
-      example: 225.5,
     },
-    workout_date: {
+    chronic_load: {
+      type: "number",
+    },
+    date: {
       type: "string",
-      example: "2023-01-01T15:04:05Z",
+      example: "2026-07-01",
     },
-    workout_id: {
+    monotony: {
+      type: "number",
+    },
+    sessions_28d: {
       type: "integer",
-      example: 1,
     },
-    workout_notes: {
-      type: "string",
-      example: "Great workout today",
+    sessions_7d: {
+      type: "integer",
+    },
+    strain: {
+      type: "number",
     },
   },
 } as const;
 
-export const exercise_MetricsHistoryBucketSchema = {
-  type: "string",
-  enum: ["workout"],
-  "x-enum-varnames": ["MetricsHistoryBucketWorkout"],
+export const analytics_TrainingLoadWarningSchema = {
+  type: "object",
+  properties: {
+    code: {
+      type: "string",
+      example: "acwr_high",
+    },
+    message: {
+      type: "string",
+    },
+    severity: {
+      type: "string",
+      example: "high",
+    },
+  },
 } as const;
 
-export const exercise_RecentSetsResponseSchema = {
+export const analytics_VolumePeriodSchema = {
   type: "object",
-  required: ["created_at", "reps", "set_id", "workout_date", "workout_id"],
   properties: {
-    created_at: {
-      type: "string",
-      example: "2023-01-01T15:04:05Z",
+    by_set_type: {
+      type: "object",
+      additionalProperties: {
+        $ref: "#/definitions/analytics.VolumeTotals",
+      },
     },
-    exercise_order: {
-      type: "integer",
-      example: 0,
+    period_start: {
+      type: "string",
+      example: "2026-06-29",
     },
     reps: {
       type: "integer",
-      example: 10,
-    },
-    set_id: {
-      type: "integer",
-      example: 1,
     },
-    set_order: {
+    sets: {
       type: "integer",
-      example: 2,
     },
-    weight: {
+    tonnage: {
       type: "number",
-      example: 225.5,
     },
-    workout_date: {
+  },
+} as const;
+
+export const analytics_VolumeResponseSchema = {
+  type: "object",
+  properties: {
+    bucket: {
       type: "string",
-      example: "2023-01-01T15:04:05Z",
+      example: "week",
     },
-    workout_id: {
-      type: "integer",
-      example: 1,
+    by_set_type: {
+      type: "object",
+      additionalProperties: {
+        $ref: "#/definitions/analytics.VolumeTotals",
+      },
+    },
+    exclude_tags: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+    },
+    focus: {
+      type: "string",
+    },
+    from: {
+      type: "string",
+      example: "2026-01-05",
+    },
+    periods: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/analytics.VolumePeriod",
+      },
+    },
+    tags: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+    },
+    timezone: {
+      type: "string",
+    },
+    to: {
+      type: "string",
+      example: "2026-07-01",
+    },
+    totals: {
+      $ref: "#/definitions/analytics.VolumeTotals",
     },
   },
 } as const;
 
-export const exercise_UpdateExerciseHistorical1RMRequestSchema = {
+export const analytics_VolumeTotalsSchema = {
   type: "object",
   properties: {
-    historical_1rm: {
-      type: "number",
-      maximum: 999999.99,
-      minimum: 0,
+    reps: {
+      type: "integer",
     },
-    mode: {
-      type: "string",
-      enum: ["manual", "recompute"],
+    sets: {
+      type: "integer",
+    },
+    tonnage: {
+      type: "number",
     },
   },
 } as const;
 
-export const exercise_UpdateExerciseNameRequestSchema = {
+export const analytics_WeeklySessionsSchema = {
   type: "object",
-  required: ["name"],
   properties: {
-    name: {
+    rolling_average: {
+      type: "number",
+    },
+    sessions: {
+      type: "integer",
+    },
+    target_met: {
+      type: "boolean",
+    },
+    week_start: {
       type: "string",
-      maxLength: 256,
     },
   },
 } as const;
 
-export const featureaccess_FeatureAccessResponseSchema = {
+export const bodymetrics_EntryRequestSchema = {
   type: "object",
-  required: ["created_at", "feature_key", "source", "starts_at"],
   properties: {
-    created_at: {
-      type: "string",
-      example: "2026-03-25T12:00:00Z",
+    arm: {
+      type: "number",
     },
-    expires_at: {
-      type: "string",
-      example: "2026-04-25T12:00:00Z",
+    body_fat_percent: {
+      type: "number",
+      example: 16.5,
     },
-    feature_key: {
-      type: "string",
-      example: "ai_chatbot",
+    bodyweight: {
+      type: "number",
+      example: 182.4,
     },
-    granted_by: {
-      type: "string",
-      example: "andy",
+    chest: {
+      type: "number",
     },
-    note: {
-      type: "string",
-      example: "dev demo access",
+    hips: {
+      type: "number",
     },
-    source: {
+    measured_on: {
       type: "string",
-      example: "manual",
+      example: "2026-07-01",
     },
-    source_reference: {
-      type: "string",
-      example: "sub_123",
+    neck: {
+      type: "number",
     },
-    starts_at: {
+    notes: {
       type: "string",
-      example: "2026-03-25T12:00:00Z",
+    },
+    thigh: {
+      type: "number",
+    },
+    waist: {
+      type: "number",
     },
   },
 } as const;
 
-export const response_ErrorSchema = {
+export const bodymetrics_EntryResponseSchema = {
   type: "object",
   properties: {
-    message: {
+    arm: {
+      type: "number",
+    },
+    body_fat_percent: {
+      type: "number",
+    },
+    bodyweight: {
+      type: "number",
+    },
+    chest: {
+      type: "number",
+    },
+    created_at: {
       type: "string",
     },
-    request_id: {
+    hips: {
+      type: "number",
+    },
+    id: {
+      type: "integer",
+    },
+    measured_on: {
+      type: "string",
+      example: "2026-07-01",
+    },
+    neck: {
+      type: "number",
+    },
+    notes: {
       type: "string",
     },
+    thigh: {
+      type: "number",
+    },
+    updated_at: {
+      type: "string",
+    },
+    waist: {
+      type: "number",
+    },
   },
 } as const;
 
-export const response_ErrorResponseSchema = {
+export const bodymetrics_TrendPointSchema = {
   type: "object",
   properties: {
-    message: {
+    date: {
       type: "string",
-      example: "Error message",
+      example: "2026-07-01",
     },
-    request_id: {
-      type: "string",
-      example: "550e8400-e29b-41d4-a716-446655440000",
+    moving_average: {
+      type: "number",
+    },
+    value: {
+      type: "number",
     },
   },
 } as const;
 
-export const response_SuccessResponseSchema = {
+export const bodymetrics_TrendResponseSchema = {
   type: "object",
   properties: {
-    success: {
-      type: "boolean",
-      example: true,
+    days: {
+      type: "integer",
+    },
+    latest: {
+      type: "number",
+    },
+    latest_moving_average: {
+      type: "number",
+    },
+    metric: {
+      type: "string",
+    },
+    moving_average_change: {
+      type: "number",
+    },
+    points: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/bodymetrics.TrendPoint",
+      },
+    },
+    window_days: {
+      type: "integer",
+    },
+  },
+} as const;
+
+export const calendar_FeedStatusResponseSchema = {
+  type: "object",
+  properties: {
+    created_at: {
+      type: "string",
+    },
+    enabled: {
+      type: "boolean",
+    },
+    rotated_at: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const calendar_FeedTokenResponseSchema = {
+  type: "object",
+  properties: {
+    created_at: {
+      type: "string",
+    },
+    rotated_at: {
+      type: "string",
+    },
+    token: {
+      type: "string",
+    },
+    url: {
+      type: "string",
+      example: "https://fittrack.example/calendar/abc123.ics",
+    },
+    webcal_url: {
+      type: "string",
+      example: "webcal://fittrack.example/calendar/abc123.ics",
+    },
+  },
+} as const;
+
+export const coaching_AcceptInvitationRequestSchema = {
+  type: "object",
+  properties: {
+    code: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const coaching_InvitationRequestSchema = {
+  type: "object",
+  properties: {
+    scopes: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+      example: ["read_workouts", "read_metrics"],
+    },
+  },
+} as const;
+
+export const coaching_InvitationResponseSchema = {
+  type: "object",
+  properties: {
+    code: {
+      type: "string",
+    },
+    created_at: {
+      type: "string",
+    },
+    expires_at: {
+      type: "string",
+    },
+    id: {
+      type: "integer",
+    },
+    scopes: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+    },
+  },
+} as const;
+
+export const coaching_LinkResponseSchema = {
+  type: "object",
+  properties: {
+    athlete_id: {
+      type: "string",
+    },
+    coach_id: {
+      type: "string",
+    },
+    id: {
+      type: "integer",
+    },
+    linked_at: {
+      type: "string",
+    },
+    scopes: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+    },
+  },
+} as const;
+
+export const coaching_SuggestedExerciseSchema = {
+  type: "object",
+  properties: {
+    name: {
+      type: "string",
+      example: "Back Squat",
+    },
+    reps: {
+      type: "integer",
+      example: 5,
+    },
+    sets: {
+      type: "integer",
+      example: 5,
+    },
+    weight: {
+      type: "number",
+      example: 225,
+    },
+  },
+} as const;
+
+export const coaching_SuggestionRequestSchema = {
+  type: "object",
+  properties: {
+    exercises: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/coaching.SuggestedExercise",
+      },
+    },
+    notes: {
+      type: "string",
+    },
+    scheduled_on: {
+      type: "string",
+      example: "2026-07-06",
+    },
+    title: {
+      type: "string",
+      example: "Lower body A",
+    },
+  },
+} as const;
+
+export const coaching_SuggestionResponseSchema = {
+  type: "object",
+  properties: {
+    coach_id: {
+      type: "string",
+    },
+    created_at: {
+      type: "string",
+    },
+    exercises: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/coaching.SuggestedExercise",
+      },
+    },
+    id: {
+      type: "integer",
+    },
+    notes: {
+      type: "string",
+    },
+    scheduled_on: {
+      type: "string",
+    },
+    title: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const coaching_UpdateScopesRequestSchema = {
+  type: "object",
+  properties: {
+    scopes: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+      example: ["read_workouts"],
+    },
+  },
+} as const;
+
+export const equipment_InventoryResponseSchema = {
+  type: "object",
+  properties: {
+    barbell_weights: {
+      type: "array",
+      items: {
+        type: "number",
+      },
+      example: [45, 35],
+    },
+    dumbbell_increment: {
+      type: "number",
+      example: 5,
+    },
+    is_default: {
+      type: "boolean",
+    },
+    machine_stack_step: {
+      type: "number",
+      example: 10,
+    },
+    plates: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/equipment.Plate",
+      },
+    },
+    updated_at: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const equipment_PlateSchema = {
+  type: "object",
+  properties: {
+    pairs: {
+      type: "integer",
+      example: 4,
+    },
+    weight: {
+      type: "number",
+      example: 45,
+    },
+  },
+} as const;
+
+export const equipment_PlateBreakdownSchema = {
+  type: "object",
+  properties: {
+    bar_weight: {
+      type: "number",
+      example: 45,
+    },
+    exact: {
+      type: "boolean",
+    },
+    per_side: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/equipment.PlateCount",
+      },
+    },
+    requested_weight: {
+      type: "number",
+      example: 227,
+    },
+    weight: {
+      type: "number",
+      example: 225,
+    },
+  },
+} as const;
+
+export const equipment_PlateCountSchema = {
+  type: "object",
+  properties: {
+    count: {
+      type: "integer",
+      example: 2,
+    },
+    weight: {
+      type: "number",
+      example: 45,
+    },
+  },
+} as const;
+
+export const equipment_UpdateInventoryRequestSchema = {
+  type: "object",
+  properties: {
+    barbell_weights: {
+      type: "array",
+      items: {
+        type: "number",
+      },
+      example: [45, 35],
+    },
+    dumbbell_increment: {
+      type: "number",
+      example: 5,
+    },
+    machine_stack_step: {
+      type: "number",
+      example: 10,
+    },
+    plates: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/equipment.Plate",
+      },
+    },
+  },
+} as const;
+
+export const exercise_CreateExerciseRequestSchema = {
+  type: "object",
+  required: ["name"],
+  properties: {
+    name: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const exercise_CreateExerciseResponseSchema = {
+  type: "object",
+  required: ["created_at", "id", "name", "updated_at", "user_id"],
+  properties: {
+    created_at: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    id: {
+      type: "integer",
+      example: 1,
+    },
+    name: {
+      type: "string",
+      example: "Bench Press",
+    },
+    updated_at: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    user_id: {
+      type: "string",
+      example: "user-123",
+    },
+  },
+} as const;
+
+export const exercise_ExerciseDetailExerciseResponseSchema = {
+  type: "object",
+  required: ["created_at", "id", "name", "updated_at", "user_id"],
+  properties: {
+    best_e1rm: {
+      type: "number",
+      example: 305,
+    },
+    created_at: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    historical_1rm: {
+      type: "number",
+      example: 315,
+    },
+    historical_1rm_source_workout_id: {
+      type: "integer",
+      example: 42,
+    },
+    historical_1rm_updated_at: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    id: {
+      type: "integer",
+      example: 1,
+    },
+    name: {
+      type: "string",
+      example: "Bench Press",
+    },
+    notes: {
+      description: "Notes and Settings come from the latest saved exercise note revision.",
+      type: "string",
+      example: "Grip just outside the rings",
+    },
+    notes_updated_at: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    settings: {
+      type: "string",
+      example: "Seat height 4",
+    },
+    strength_classification: {
+      description: "StrengthClassification is set when the exercise is the user's\ndesignated squat, bench or deadlift and a sex and bodyweight are known.",
+      allOf: [
+        {
+          $ref: "#/definitions/strength.LiftClassification",
+        },
+      ],
+    },
+    updated_at: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    user_id: {
+      type: "string",
+      example: "user-123",
+    },
+  },
+} as const;
+
+export const exercise_ExerciseDetailResponseSchema = {
+  type: "object",
+  required: ["exercise", "sets"],
+  properties: {
+    exercise: {
+      $ref: "#/definitions/exercise.ExerciseDetailExerciseResponse",
+    },
+    sets: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/exercise.ExerciseWithSetsResponse",
+      },
+    },
+  },
+} as const;
+
+export const exercise_ExerciseMetricsComparisonPointSchema = {
+  type: "object",
+  properties: {
+    date: {
+      type: "string",
+    },
+    percent_of_1rm: {
+      type: "number",
+    },
+    session_avg_e1rm: {
+      type: "number",
+    },
+    session_avg_intensity: {
+      type: "number",
+    },
+    session_best_e1rm: {
+      type: "number",
+    },
+    session_best_intensity: {
+      type: "number",
+    },
+    total_volume_working: {
+      type: "number",
+    },
+    workout_id: {
+      type: "integer",
+    },
+    x: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const exercise_ExerciseMetricsHistoryPointSchema = {
+  type: "object",
+  properties: {
+    date: {
+      type: "string",
+    },
+    session_avg_e1rm: {
+      type: "number",
+    },
+    session_avg_intensity: {
+      type: "number",
+    },
+    session_best_e1rm: {
+      type: "number",
+    },
+    session_best_intensity: {
+      type: "number",
+    },
+    total_volume_working: {
+      type: "number",
+    },
+    workout_id: {
+      type: "integer",
+    },
+    x: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const exercise_ExerciseMetricsHistoryResponseSchema = {
+  type: "object",
+  properties: {
+    bucket: {
+      $ref: "#/definitions/exercise.MetricsHistoryBucket",
+    },
+    from: {
+      type: "string",
+    },
+    points: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/exercise.ExerciseMetricsHistoryPoint",
+      },
+    },
+    range: {
+      type: "string",
+    },
+    to: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const exercise_ExerciseMetricsSeriesSchema = {
+  type: "object",
+  properties: {
+    exercise_id: {
+      type: "integer",
+    },
+    name: {
+      type: "string",
+    },
+    points: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/exercise.ExerciseMetricsComparisonPoint",
+      },
+    },
+    reference_1rm: {
+      type: "number",
+    },
+  },
+} as const;
+
+export const exercise_ExerciseNoteResponseSchema = {
+  type: "object",
+  required: ["created_at", "id"],
+  properties: {
+    created_at: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    id: {
+      type: "integer",
+      example: 1,
+    },
+    notes: {
+      type: "string",
+      example: "Grip just outside the rings",
+    },
+    settings: {
+      type: "string",
+      example: "Seat height 4",
+    },
+  },
+} as const;
+
+export const exercise_ExerciseNotesResponseSchema = {
+  type: "object",
+  required: ["history"],
+  properties: {
+    current: {
+      $ref: "#/definitions/exercise.ExerciseNoteResponse",
+    },
+    history: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/exercise.ExerciseNoteResponse",
+      },
+    },
+  },
+} as const;
+
+export const exercise_ExerciseResponseSchema = {
+  type: "object",
+  required: ["created_at", "id", "name", "updated_at", "user_id"],
+  properties: {
+    created_at: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    id: {
+      type: "integer",
+      example: 1,
+    },
+    name: {
+      type: "string",
+      example: "Bench Press",
+    },
+    updated_at: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    user_id: {
+      type: "string",
+      example: "user-123",
+    },
+  },
+} as const;
+
+export const exercise_ExerciseWithSetsResponseSchema = {
+  type: "object",
+  required: [
+    "exercise_id",
+    "exercise_name",
+    "reps",
+    "set_id",
+    "set_type",
+    "volume",
+    "workout_date",
+    "workout_id",
+  ],
+  properties: {
+    exercise_id: {
+      type: "integer",
+      example: 1,
+    },
+    exercise_name: {
+      type: "string",
+      example: "Bench Press",
+    },
+    exercise_order: {
+      type: "integer",
+      example: 0,
+    },
+    reps: {
+      type: "integer",
+      example: 10,
+    },
+    set_id: {
+      type: "integer",
+      example: 1,
+    },
+    set_notes: {
+      type: "string",
+      example: "Belt on, felt fast",
+    },
+    set_order: {
+      type: "integer",
+      example: 1,
+    },
+    set_type: {
+      type: "string",
+      example: "working",
+    },
+    volume: {
+      type: "number",
+      example: 2250.5,
+    },
+    weight: {
+      type: "number",
+      example: 225.5,
+    },
+    workout_date: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    workout_id: {
+      type: "integer",
+      example: 1,
+    },
+    workout_notes: {
+      type: "string",
+      example: "Great workout today",
+    },
+  },
+} as const;
+
+export const exercise_ExercisesMetricsHistoryResponseSchema = {
+  type: "object",
+  properties: {
+    axis: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/exercise.MetricsHistoryAxisPoint",
+      },
+    },
+    bucket: {
+      $ref: "#/definitions/exercise.MetricsHistoryBucket",
+    },
+    from: {
+      type: "string",
+    },
+    range: {
+      type: "string",
+    },
+    series: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/exercise.ExerciseMetricsSeries",
+      },
+    },
+    to: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const exercise_MetricsHistoryAxisPointSchema = {
+  type: "object",
+  properties: {
+    date: {
+      type: "string",
+    },
+    workout_id: {
+      type: "integer",
+    },
+    x: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const exercise_MetricsHistoryBucketSchema = {
+  type: "string",
+  enum: ["workout", "week", "month"],
+  "x-enum-varnames": [
+    "MetricsHistoryBucketWorkout",
+    "MetricsHistoryBucketWeek",
+    "MetricsHistoryBucketMonth",
+  ],
+} as const;
+
+export const exercise_RecentSetsResponseSchema = {
+  type: "object",
+  required: ["created_at", "reps", "set_id", "workout_date", "workout_id"],
+  properties: {
+    created_at: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    exercise_notes: {
+      type: "string",
+      example: "Grip just outside the rings",
+    },
+    exercise_order: {
+      type: "integer",
+      example: 0,
+    },
+    exercise_settings: {
+      type: "string",
+      example: "Seat height 4",
+    },
+    reps: {
+      type: "integer",
+      example: 10,
+    },
+    set_id: {
+      type: "integer",
+      example: 1,
+    },
+    set_order: {
+      type: "integer",
+      example: 2,
+    },
+    weight: {
+      type: "number",
+      example: 225.5,
+    },
+    workout_date: {
+      type: "string",
+      example: "2023-01-01T15:04:05Z",
+    },
+    workout_id: {
+      type: "integer",
+      example: 1,
+    },
+  },
+} as const;
+
+export const exercise_UpdateExerciseHistorical1RMRequestSchema = {
+  type: "object",
+  properties: {
+    historical_1rm: {
+      type: "number",
+      maximum: 999999.99,
+      minimum: 0,
+    },
+    mode: {
+      type: "string",
+      enum: ["manual", "recompute"],
+    },
+  },
+} as const;
+
+export const exercise_UpdateExerciseNameRequestSchema = {
+  type: "object",
+  required: ["name"],
+  properties: {
+    name: {
+      type: "string",
+      maxLength: 256,
+    },
+  },
+} as const;
+
+export const exercise_UpdateExerciseNotesRequestSchema = {
+  type: "object",
+  properties: {
+    notes: {
+      type: "string",
+      maxLength: 2000,
+    },
+    settings: {
+      type: "string",
+      maxLength: 500,
+    },
+  },
+} as const;
+
+export const featureaccess_FeatureAccessResponseSchema = {
+  type: "object",
+  required: ["created_at", "feature_key", "source", "starts_at"],
+  properties: {
+    created_at: {
+      type: "string",
+      example: "2026-03-25T12:00:00Z",
+    },
+    expires_at: {
+      type: "string",
+      example: "2026-04-25T12:00:00Z",
+    },
+    feature_key: {
+      type: "string",
+      example: "ai_chatbot",
+    },
+    granted_by: {
+      type: "string",
+      example: "andy",
+    },
+    note: {
+      type: "string",
+      example: "dev demo access",
+    },
+    source: {
+      type: "string",
+      example: "manual",
+    },
+    source_reference: {
+      type: "string",
+      example: "sub_123",
+    },
+    starts_at: {
+      type: "string",
+      example: "2026-03-25T12:00:00Z",
+    },
+  },
+} as const;
+
+export const goals_CreateGoalRequestSchema = {
+  type: "object",
+  properties: {
+    exercise_id: {
+      type: "integer",
+      example: 12,
+    },
+    target_date: {
+      type: "string",
+      example: "2027-03-01",
+    },
+    target_value: {
+      type: "number",
+      example: 180,
+    },
+    type: {
+      type: "string",
+      example: "e1rm",
+    },
+  },
+} as const;
+
+export const goals_GoalResponseSchema = {
+  type: "object",
+  properties: {
+    created_at: {
+      type: "string",
+    },
+    current_value: {
+      type: "number",
+      example: 168.5,
+    },
+    exercise_id: {
+      type: "integer",
+      example: 12,
+    },
+    exercise_name: {
+      type: "string",
+      example: "Back Squat",
+    },
+    id: {
+      type: "integer",
+    },
+    progress_percent: {
+      type: "number",
+      example: 42.5,
+    },
+    projected_date: {
+      type: "string",
+      example: "2027-01-18",
+    },
+    rate_per_week: {
+      type: "number",
+      example: 1.2,
+    },
+    start_value: {
+      type: "number",
+      example: 160,
+    },
+    status: {
+      type: "string",
+      example: "on_track",
+    },
+    target_date: {
+      type: "string",
+      example: "2027-03-01",
+    },
+    target_value: {
+      type: "number",
+      example: 180,
+    },
+    type: {
+      type: "string",
+      example: "e1rm",
+    },
+  },
+} as const;
+
+export const goals_UpdateGoalRequestSchema = {
+  type: "object",
+  properties: {
+    target_date: {
+      type: "string",
+      example: "2027-06-01",
+    },
+    target_value: {
+      type: "number",
+      example: 185,
+    },
+  },
+} as const;
+
+export const notifications_MarkAllReadResponseSchema = {
+  type: "object",
+  properties: {
+    updated: {
+      type: "integer",
+      example: 3,
+    },
+  },
+} as const;
+
+export const notifications_NotificationResponseSchema = {
+  type: "object",
+  properties: {
+    body: {
+      type: "string",
+      example: "Estimated 1RM is now 152.5, up from 147.5.",
+    },
+    created_at: {
+      type: "string",
+    },
+    data: {
+      type: "object",
+      additionalProperties: {},
+    },
+    id: {
+      type: "integer",
+      example: 42,
+    },
+    kind: {
+      type: "string",
+      example: "historical_1rm_increased",
+    },
+    read_at: {
+      type: "string",
+    },
+    title: {
+      type: "string",
+      example: "New Back Squat 1RM",
+    },
+  },
+} as const;
+
+export const notifications_NotificationsResponseSchema = {
+  type: "object",
+  properties: {
+    next_before_id: {
+      type: "integer",
+      example: 17,
+    },
+    notifications: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/notifications.NotificationResponse",
+      },
+    },
+  },
+} as const;
+
+export const notifications_StreamEventSchema = {
+  type: "object",
+  properties: {
+    notification: {
+      $ref: "#/definitions/notifications.NotificationResponse",
+    },
+    unread_count: {
+      type: "integer",
+    },
+  },
+} as const;
+
+export const notifications_UnreadCountResponseSchema = {
+  type: "object",
+  properties: {
+    count: {
+      type: "integer",
+      example: 3,
+    },
+  },
+} as const;
+
+export const report_ExerciseImprovementSchema = {
+  type: "object",
+  properties: {
+    change: {
+      type: "number",
+    },
+    change_percent: {
+      type: "number",
+    },
+    exercise_id: {
+      type: "integer",
+    },
+    exercise_name: {
+      type: "string",
+    },
+    period_best_e1rm: {
+      type: "number",
+    },
+    prior_best_e1rm: {
+      type: "number",
+    },
+  },
+} as const;
+
+export const report_FocusBreakdownSchema = {
+  type: "object",
+  properties: {
+    focus: {
+      type: "string",
+      example: "Upper Body",
+    },
+    sessions: {
+      type: "integer",
+    },
+    tonnage: {
+      type: "number",
+    },
+    working_sets: {
+      type: "integer",
+    },
+  },
+} as const;
+
+export const report_Historical1RMImprovementSchema = {
+  type: "object",
+  properties: {
+    exercise_id: {
+      type: "integer",
+    },
+    exercise_name: {
+      type: "string",
+    },
+    historical_1rm: {
+      type: "number",
+    },
+    source_workout_id: {
+      type: "integer",
+    },
+    updated_on: {
+      type: "string",
+      example: "2026-07-02",
+    },
+  },
+} as const;
+
+export const report_PeriodSchema = {
+  type: "object",
+  properties: {
+    end: {
+      type: "string",
+      example: "2026-07-05",
+    },
+    start: {
+      type: "string",
+      example: "2026-06-29",
+    },
+    timezone: {
+      type: "string",
+      example: "America/New_York",
+    },
+    type: {
+      type: "string",
+      example: "week",
+    },
+  },
+} as const;
+
+export const report_PeriodComparisonSchema = {
+  type: "object",
+  properties: {
+    previous: {
+      $ref: "#/definitions/report.Period",
+    },
+    previous_totals: {
+      $ref: "#/definitions/report.Totals",
+    },
+    sessions_change: {
+      type: "integer",
+    },
+    tonnage_change: {
+      type: "number",
+    },
+    tonnage_change_percent: {
+      type: "number",
+    },
+    working_sets_change: {
+      type: "integer",
+    },
+  },
+} as const;
+
+export const report_ReportSchema = {
+  type: "object",
+  properties: {
+    comparison: {
+      $ref: "#/definitions/report.PeriodComparison",
+    },
+    focus_breakdown: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/report.FocusBreakdown",
+      },
+    },
+    historical_1rm_improvements: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/report.Historical1RMImprovement",
+      },
+    },
+    most_improved: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/report.ExerciseImprovement",
+      },
+    },
+    period: {
+      $ref: "#/definitions/report.Period",
+    },
+    totals: {
+      $ref: "#/definitions/report.Totals",
+    },
+  },
+} as const;
+
+export const report_TotalsSchema = {
+  type: "object",
+  properties: {
+    sessions: {
+      type: "integer",
+    },
+    tonnage: {
+      type: "number",
+    },
+    working_sets: {
+      type: "integer",
+    },
+  },
+} as const;
+
+export const response_ErrorSchema = {
+  type: "object",
+  properties: {
+    message: {
+      type: "string",
+    },
+    request_id: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const response_ErrorResponseSchema = {
+  type: "object",
+  properties: {
+    message: {
+      type: "string",
+      example: "Error message",
+    },
+    request_id: {
+      type: "string",
+      example: "550e8400-e29b-41d4-a716-446655440000",
+    },
+  },
+} as const;
+
+export const response_SuccessResponseSchema = {
+  type: "object",
+  properties: {
+    success: {
+      type: "boolean",
+      example: true,
+    },
+  },
+} as const;
+
+export const search_HitSchema = {
+  type: "object",
+  properties: {
+    conversation_id: {
+      type: "integer",
+    },
+    id: {
+      type: "integer",
+    },
+    occurred_at: {
+      type: "string",
+    },
+    rank: {
+      type: "number",
+    },
+    snippet: {
+      type: "string",
+      example: "Felt strong on <mark>bench</mark> today",
+    },
+    title: {
+      type: "string",
+    },
+    type: {
+      type: "string",
+      example: "workout",
+    },
+    workout_id: {
+      type: "integer",
+    },
+  },
+} as const;
+
+export const search_SearchResponseSchema = {
+  type: "object",
+  properties: {
+    hits: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/search.Hit",
+      },
+    },
+    limit: {
+      type: "integer",
+    },
+    next_offset: {
+      type: "integer",
+    },
+    offset: {
+      type: "integer",
+    },
+    query: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const share_CreateShareRequestSchema = {
+  type: "object",
+  properties: {
+    expires_in_days: {
+      type: "integer",
+      example: 7,
+    },
+  },
+} as const;
+
+export const share_ShareLinkResponseSchema = {
+  type: "object",
+  properties: {
+    created_at: {
+      type: "string",
+    },
+    expires_at: {
+      type: "string",
+    },
+    token: {
+      type: "string",
+    },
+    url: {
+      type: "string",
+      example: "https://fittrack.example/s/abc123",
+    },
+  },
+} as const;
+
+export const strength_LiftClassificationSchema = {
+  type: "object",
+  properties: {
+    bodyweight: {
+      type: "number",
+    },
+    e1rm: {
+      type: "number",
+    },
+    level: {
+      type: "string",
+      example: "intermediate",
+    },
+    lift: {
+      type: "string",
+      example: "squat",
+    },
+    next_level: {
+      type: "string",
+      example: "advanced",
+    },
+    next_level_e1rm: {
+      type: "number",
+    },
+    ratio: {
+      type: "number",
+    },
+  },
+} as const;
+
+export const strength_LiftScoreSchema = {
+  type: "object",
+  properties: {
+    classification: {
+      $ref: "#/definitions/strength.LiftClassification",
+    },
+    e1rm: {
+      type: "number",
+    },
+    exercise_id: {
+      type: "integer",
+    },
+    lift: {
+      type: "string",
+      example: "squat",
+    },
+  },
+} as const;
+
+export const strength_ProfileResponseSchema = {
+  type: "object",
+  properties: {
+    bench_exercise_id: {
+      type: "integer",
+    },
+    deadlift_exercise_id: {
+      type: "integer",
+    },
+    sex: {
+      type: "string",
+      example: "male",
+    },
+    squat_exercise_id: {
+      type: "integer",
+    },
+  },
+} as const;
+
+export const strength_ScoreHistoryPointSchema = {
+  type: "object",
+  properties: {
+    bench: {
+      type: "number",
+    },
+    bodyweight: {
+      type: "number",
+    },
+    date: {
+      type: "string",
+      example: "2026-07-01",
+    },
+    deadlift: {
+      type: "number",
+    },
+    dots: {
+      type: "number",
+    },
+    ipf_gl: {
+      type: "number",
+    },
+    squat: {
+      type: "number",
+    },
+    total: {
+      type: "number",
+    },
+    wilks: {
+      type: "number",
+    },
+  },
+} as const;
+
+export const strength_ScoreHistoryResponseSchema = {
+  type: "object",
+  properties: {
+    missing_inputs: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+    },
+    points: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/strength.ScoreHistoryPoint",
+      },
+    },
+    sex: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const strength_ScoresResponseSchema = {
+  type: "object",
+  properties: {
+    bodyweight: {
+      type: "number",
+    },
+    bodyweight_date: {
+      type: "string",
+      example: "2026-07-01",
+    },
+    dots: {
+      type: "number",
+    },
+    ipf_gl: {
+      type: "number",
+    },
+    lifts: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/strength.LiftScore",
+      },
+    },
+    missing_inputs: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+    },
+    sex: {
+      type: "string",
+    },
+    total: {
+      type: "number",
+    },
+    wilks: {
+      type: "number",
+    },
+  },
+} as const;
+
+export const strength_UpdateProfileRequestSchema = {
+  type: "object",
+  properties: {
+    bench_exercise_id: {
+      type: "integer",
+    },
+    deadlift_exercise_id: {
+      type: "integer",
+    },
+    sex: {
+      type: "string",
+      example: "male",
+    },
+    squat_exercise_id: {
+      type: "integer",
+    },
+  },
+} as const;
+
+export const tag_TagRequestSchema = {
+  type: "object",
+  properties: {
+    name: {
+      type: "string",
+      example: "deload",
+    },
+  },
+} as const;
+
+export const tag_TagResponseSchema = {
+  type: "object",
+  properties: {
+    created_at: {
+      type: "string",
+    },
+    id: {
+      type: "integer",
+    },
+    name: {
+      type: "string",
+      example: "deload",
+    },
+    updated_at: {
+      type: "string",
+    },
+    workout_count: {
+      type: "integer",
+    },
+  },
+} as const;
+
+export const trainingprofile_ProfileResponseSchema = {
+  type: "object",
+  properties: {
+    available_equipment: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+    },
+    avoided_exercises: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+    },
+    experience_level: {
+      type: "string",
+    },
+    movement_limitations: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+      "x-nullable": true,
+    },
+    preferred_session_duration_minutes: {
+      type: "integer",
+    },
+    primary_goal: {
+      type: "string",
+    },
+    usual_training_location: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const trainingprofile_UpdateProfileRequestSchema = {
+  type: "object",
+  properties: {
+    available_equipment: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+    },
+    avoided_exercises: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+    },
+    experience_level: {
+      type: "string",
+    },
+    movement_limitations: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+      "x-nullable": true,
+    },
+    preferred_session_duration_minutes: {
+      type: "integer",
+    },
+    primary_goal: {
+      type: "string",
+    },
+    usual_training_location: {
+      type: "string",
+    },
+  },
+} as const;
+
+export const webhooks_CreateEndpointRequestSchema = {
+  type: "object",
+  properties: {
+    event_types: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+      example: ["workout.created", "record.achieved"],
+    },
+    url: {
+      type: "string",
+      example: "https://example.com/hooks/fittrack",
+    },
+  },
+} as const;
+
+export const webhooks_CreatedEndpointResponseSchema = {
+  type: "object",
+  properties: {
+    active: {
+      type: "boolean",
+      example: true,
+    },
+    created_at: {
+      type: "string",
+    },
+    event_types: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+      example: ["workout.created", "record.achieved"],
+    },
+    id: {
+      type: "integer",
+      example: 7,
+    },
+    secret: {
+      type: "string",
+      example: "whsec_2b7e151628aed2a6abf7158809cf4f3c762e7160f38b4da56a784d9045190cfe",
+    },
+    updated_at: {
+      type: "string",
+    },
+    url: {
+      type: "string",
+      example: "https://example.com/hooks/fittrack",
+    },
+  },
+} as const;
+
+export const webhooks_DeliveryResponseSchema = {
+  type: "object",
+  properties: {
+    attempt_count: {
+      type: "integer",
+      example: 1,
+    },
+    created_at: {
+      type: "string",
+    },
+    delivered_at: {
+      type: "string",
+    },
+    event_id: {
+      type: "string",
+      example: "evt_3f9a1c0b7d2e4f6a8b9c0d1e2f3a4b5c",
+    },
+    event_type: {
+      type: "string",
+      example: "workout.created",
+    },
+    id: {
+      type: "integer",
+      example: 31,
+    },
+    last_attempt_at: {
+      type: "string",
+    },
+    last_error: {
+      type: "string",
+    },
+    next_attempt_at: {
+      type: "string",
+    },
+    payload: {
+      type: "object",
+      additionalProperties: {},
+    },
+    response_status: {
+      type: "integer",
+      example: 200,
+    },
+    status: {
+      type: "string",
+      example: "succeeded",
     },
   },
 } as const;
 
-export const trainingprofile_ProfileResponseSchema = {
+export const webhooks_EndpointResponseSchema = {
   type: "object",
   properties: {
-    available_equipment: {
-      type: "array",
-      items: {
-        type: "string",
-      },
-    },
-    avoided_exercises: {
-      type: "array",
-      items: {
-        type: "string",
-      },
+    active: {
+      type: "boolean",
+      example: true,
     },
-    experience_level: {
+    created_at: {
       type: "string",
     },
-    movement_limitations: {
+    event_types: {
       type: "array",
       items: {
         type: "string",
       },
-      "x-nullable": true,
+      example: ["workout.created", "record.achieved"],
     },
-    preferred_session_duration_minutes: {
+    id: {
       type: "integer",
+      example: 7,
     },
-    primary_goal: {
+    updated_at: {
       type: "string",
     },
-    usual_training_location: {
+    url: {
       type: "string",
+      example: "https://example.com/hooks/fittrack",
     },
   },
 } as const;
 
-export const trainingprofile_UpdateProfileRequestSchema = {
+export const webhooks_UpdateEndpointRequestSchema = {
   type: "object",
   properties: {
-    available_equipment: {
-      type: "array",
-      items: {
-        type: "string",
-      },
+    active: {
+      type: "boolean",
+      example: false,
     },
-    avoided_exercises: {
+    event_types: {
       type: "array",
       items: {
         type: "string",
       },
+      example: ["workout.created"],
     },
-    experience_level: {
+    url: {
       type: "string",
+      example: "https://example.com/hooks/fittrack",
     },
-    movement_limitations: {
-      type: "array",
-      items: {
-        type: "string",
-      },
-      "x-nullable": true,
-    },
-    preferred_session_duration_minutes: {
-      type: "integer",
-    },
-    primary_goal: {
+  },
+} as const;
+
+export const workout_ComparedWorkoutResponseSchema = {
+  type: "object",
+  required: ["date", "workoutId"],
+  properties: {
+    date: {
       type: "string",
+      example: "2023-01-01T15:04:05Z",
     },
-    usual_training_location: {
+    focus: {
       type: "string",
+      example: "Upper Body",
+    },
+    workoutId: {
+      type: "integer",
+      example: 1,
     },
   },
 } as const;
@@ -756,7 +2731,19 @@ export const workout_CreateWorkoutRequestSchema = {
     },
     notes: {
       type: "string",
-      maxLength: 256,
+      maxLength: 10000,
+    },
+    tags: {
+      type: "array",
+      maxItems: 10,
+      items: {
+        type: "string",
+      },
+    },
+    warmupScheme: {
+      description: "WarmupScheme adds a warm-up ramp to each exercise that has none.",
+      type: "string",
+      enum: ["standard", "quick", "powerlifting"],
     },
     workoutFocus: {
       type: "string",
@@ -765,6 +2752,60 @@ export const workout_CreateWorkoutRequestSchema = {
   },
 } as const;
 
+export const workout_ExerciseComparisonResponseSchema = {
+  type: "object",
+  required: ["a", "b", "delta", "exerciseName", "matchedBy"],
+  properties: {
+    a: {
+      $ref: "#/definitions/workout.ExerciseSessionResponse",
+    },
+    b: {
+      $ref: "#/definitions/workout.ExerciseSessionResponse",
+    },
+    delta: {
+      $ref: "#/definitions/workout.ExerciseDeltaResponse",
+    },
+    exerciseName: {
+      type: "string",
+      example: "Bench Press",
+    },
+    matchedBy: {
+      type: "string",
+      example: "exercise_id",
+    },
+  },
+} as const;
+
+export const workout_ExerciseDeltaResponseSchema = {
+  type: "object",
+  properties: {
+    e1rm: {
+      type: "number",
+      example: 11.67,
+    },
+    reps: {
+      type: "integer",
+      example: 5,
+    },
+    sets: {
+      type: "integer",
+      example: 1,
+    },
+    topSetReps: {
+      type: "integer",
+      example: 0,
+    },
+    topSetWeight: {
+      type: "number",
+      example: 10,
+    },
+    volume: {
+      type: "number",
+      example: 650,
+    },
+  },
+} as const;
+
 export const workout_ExerciseInputSchema = {
   type: "object",
   required: ["name", "sets"],
@@ -784,6 +2825,40 @@ export const workout_ExerciseInputSchema = {
   },
 } as const;
 
+export const workout_ExerciseSessionResponseSchema = {
+  type: "object",
+  required: ["e1rm", "exerciseId", "exerciseName", "reps", "sets", "volume"],
+  properties: {
+    e1rm: {
+      type: "number",
+      example: 246.67,
+    },
+    exerciseId: {
+      type: "integer",
+      example: 1,
+    },
+    exerciseName: {
+      type: "string",
+      example: "Bench Press",
+    },
+    reps: {
+      type: "integer",
+      example: 24,
+    },
+    sets: {
+      type: "integer",
+      example: 3,
+    },
+    topSet: {
+      $ref: "#/definitions/workout.TopSetResponse",
+    },
+    volume: {
+      type: "number",
+      example: 4800,
+    },
+  },
+} as const;
+
 export const workout_FocusTemplateResponseSchema = {
   type: "object",
   required: ["date", "focus", "workoutId"],
@@ -834,6 +2909,35 @@ export const workout_NewWorkoutContextResponseSchema = {
     latestWorkoutNote: {
       $ref: "#/definitions/workout.LatestWorkoutNoteResponse",
     },
+    plateauedExercises: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/workout.PlateauedExerciseResponse",
+      },
+    },
+  },
+} as const;
+
+export const workout_PlateauedExerciseResponseSchema = {
+  type: "object",
+  required: ["exerciseId", "exerciseName", "status"],
+  properties: {
+    exerciseId: {
+      type: "integer",
+      example: 1,
+    },
+    exerciseName: {
+      type: "string",
+      example: "Bench Press",
+    },
+    status: {
+      type: "string",
+      example: "stalled",
+    },
+    suggestion: {
+      type: "string",
+      example: "Change the rep range for a 3-4 week block.",
+    },
   },
 } as const;
 
@@ -841,6 +2945,10 @@ export const workout_SetInputSchema = {
   type: "object",
   required: ["reps", "setType"],
   properties: {
+    notes: {
+      type: "string",
+      maxLength: 500,
+    },
     reps: {
       type: "integer",
       minimum: 1,
@@ -857,6 +2965,21 @@ export const workout_SetInputSchema = {
   },
 } as const;
 
+export const workout_TopSetResponseSchema = {
+  type: "object",
+  required: ["reps", "weight"],
+  properties: {
+    reps: {
+      type: "integer",
+      example: 5,
+    },
+    weight: {
+      type: "number",
+      example: 225,
+    },
+  },
+} as const;
+
 export const workout_UpdateExerciseSchema = {
   type: "object",
   required: ["name", "sets"],
@@ -880,6 +3003,10 @@ export const workout_UpdateSetSchema = {
   type: "object",
   required: ["reps", "setType"],
   properties: {
+    notes: {
+      type: "string",
+      maxLength: 500,
+    },
     reps: {
       type: "integer",
       minimum: 1,
@@ -912,7 +3039,14 @@ export const workout_UpdateWorkoutRequestSchema = {
     },
     notes: {
       type: "string",
-      maxLength: 256,
+      maxLength: 10000,
+    },
+    tags: {
+      type: "array",
+      maxItems: 10,
+      items: {
+        type: "string",
+      },
     },
     workoutFocus: {
       type: "string",
@@ -921,6 +3055,119 @@ export const workout_UpdateWorkoutRequestSchema = {
   },
 } as const;
 
+export const workout_WarmupRequestSchema = {
+  type: "object",
+  required: ["exercise", "workingReps"],
+  properties: {
+    barWeight: {
+      type: "number",
+      maximum: 100,
+      example: 45,
+    },
+    exercise: {
+      type: "string",
+      maxLength: 256,
+      minLength: 1,
+      example: "Back Squat",
+    },
+    scheme: {
+      type: "string",
+      enum: ["standard", "quick", "powerlifting"],
+      example: "standard",
+    },
+    steps: {
+      type: "array",
+      maxItems: 10,
+      items: {
+        $ref: "#/definitions/workout.WarmupStep",
+      },
+    },
+    workingReps: {
+      type: "integer",
+      maximum: 100,
+      minimum: 1,
+      example: 5,
+    },
+    workingWeight: {
+      type: "number",
+      maximum: 2000,
+      example: 225,
+    },
+  },
+} as const;
+
+export const workout_WarmupResponseSchema = {
+  type: "object",
+  properties: {
+    exercise: {
+      type: "string",
+      example: "Back Squat",
+    },
+    loadKind: {
+      type: "string",
+      example: "barbell",
+    },
+    scheme: {
+      type: "string",
+      example: "standard",
+    },
+    sets: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/workout.SetInput",
+      },
+    },
+  },
+} as const;
+
+export const workout_WarmupStepSchema = {
+  type: "object",
+  properties: {
+    percent: {
+      type: "number",
+      minimum: 0,
+      example: 60,
+    },
+    reps: {
+      type: "integer",
+      maximum: 20,
+      minimum: 1,
+      example: 3,
+    },
+  },
+} as const;
+
+export const workout_WorkoutComparisonResponseSchema = {
+  type: "object",
+  required: ["a", "b", "exercises", "onlyInA", "onlyInB"],
+  properties: {
+    a: {
+      $ref: "#/definitions/workout.ComparedWorkoutResponse",
+    },
+    b: {
+      $ref: "#/definitions/workout.ComparedWorkoutResponse",
+    },
+    exercises: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/workout.ExerciseComparisonResponse",
+      },
+    },
+    onlyInA: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/workout.ExerciseSessionResponse",
+      },
+    },
+    onlyInB: {
+      type: "array",
+      items: {
+        $ref: "#/definitions/workout.ExerciseSessionResponse",
+      },
+    },
+  },
+} as const;
+
 export const workout_WorkoutResponseSchema = {
   description: "Workout response model",
   type: "object",
@@ -966,6 +3213,12 @@ export const workout_WorkoutSummarySchema = {
     id: {
       type: "integer",
     },
+    tags: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+    },
     time: {
       type: "string",
     },
@@ -1008,6 +3261,10 @@ export const workout_WorkoutWithSetsResponseSchema = {
       type: "integer",
       example: 1,
     },
+    set_notes: {
+      type: "string",
+      example: "Belt on, felt fast",
+    },
     set_order: {
       type: "integer",
       example: 1,
@@ -1040,5 +3297,12 @@ export const workout_WorkoutWithSetsResponseSchema = {
       type: "string",
       example: "Great workout today",
     },
+    workout_tags: {
+      type: "array",
+      items: {
+        type: "string",
+      },
+      example: ["deload"],
+    },
   },
 } as const;
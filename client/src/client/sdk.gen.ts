@@ -3,18 +3,54 @@
 import type { Client, Options as Options2, TDataShape } from "./client";
 import { client } from "./client.gen";
 import type {
+  DeleteAccessTokensByIdData,
+  DeleteAccessTokensByIdErrors,
+  DeleteAccessTokensByIdResponses,
   DeleteAiConversationsByIdData,
   DeleteAiConversationsByIdErrors,
   DeleteAiConversationsByIdResponses,
   DeleteAiConversationsData,
   DeleteAiConversationsErrors,
   DeleteAiConversationsResponses,
+  DeleteBodyMetricsByIdData,
+  DeleteBodyMetricsByIdErrors,
+  DeleteBodyMetricsByIdResponses,
+  DeleteCalendarFeedData,
+  DeleteCalendarFeedErrors,
+  DeleteCalendarFeedResponses,
+  DeleteCoachingLinksByIdData,
+  DeleteCoachingLinksByIdErrors,
+  DeleteCoachingLinksByIdResponses,
+  DeleteCoachingSuggestionsByIdData,
+  DeleteCoachingSuggestionsByIdErrors,
+  DeleteCoachingSuggestionsByIdResponses,
   DeleteExercisesByIdData,
   DeleteExercisesByIdErrors,
   DeleteExercisesByIdResponses,
+  DeleteGoalsByIdData,
+  DeleteGoalsByIdErrors,
+  DeleteGoalsByIdResponses,
+  DeleteTagsByIdData,
+  DeleteTagsByIdErrors,
+  DeleteTagsByIdResponses,
+  DeleteWebhooksByIdData,
+  DeleteWebhooksByIdErrors,
+  DeleteWebhooksByIdResponses,
   DeleteWorkoutsByIdData,
   DeleteWorkoutsByIdErrors,
   DeleteWorkoutsByIdResponses,
+  DeleteWorkoutsByIdShareData,
+  DeleteWorkoutsByIdShareErrors,
+  DeleteWorkoutsByIdShareResponses,
+  GetAccessTokensData,
+  GetAccessTokensErrors,
+  GetAccessTokensResponses,
+  GetAccountTimezoneData,
+  GetAccountTimezoneErrors,
+  GetAccountTimezoneResponses,
+  GetAchievementsData,
+  GetAchievementsErrors,
+  GetAchievementsResponses,
   GetAiConversationsByIdData,
   GetAiConversationsByIdErrors,
   GetAiConversationsByIdMessagesStreamResumeData,
@@ -25,27 +61,115 @@ import type {
   GetAiConversationsData,
   GetAiConversationsErrors,
   GetAiConversationsResponses,
+  GetAnalyticsConsistencyData,
+  GetAnalyticsConsistencyErrors,
+  GetAnalyticsConsistencyResponses,
+  GetAnalyticsPlateausData,
+  GetAnalyticsPlateausErrors,
+  GetAnalyticsPlateausResponses,
+  GetAnalyticsTrainingLoadData,
+  GetAnalyticsTrainingLoadErrors,
+  GetAnalyticsTrainingLoadResponses,
+  GetAnalyticsVolumeData,
+  GetAnalyticsVolumeErrors,
+  GetAnalyticsVolumeResponses,
+  GetBodyMetricsByIdData,
+  GetBodyMetricsByIdErrors,
+  GetBodyMetricsByIdResponses,
+  GetBodyMetricsData,
+  GetBodyMetricsErrors,
+  GetBodyMetricsResponses,
+  GetBodyMetricsTrendData,
+  GetBodyMetricsTrendErrors,
+  GetBodyMetricsTrendResponses,
+  GetCalendarFeedData,
+  GetCalendarFeedErrors,
+  GetCalendarFeedResponses,
+  GetCoachingAthletesData,
+  GetCoachingAthletesErrors,
+  GetCoachingAthletesResponses,
+  GetCoachingCoachesData,
+  GetCoachingCoachesErrors,
+  GetCoachingCoachesResponses,
+  GetCoachingInvitationsData,
+  GetCoachingInvitationsErrors,
+  GetCoachingInvitationsResponses,
+  GetCoachingSuggestionsData,
+  GetCoachingSuggestionsErrors,
+  GetCoachingSuggestionsResponses,
+  GetEquipmentData,
+  GetEquipmentErrors,
+  GetEquipmentResponses,
   GetExercisesByIdData,
   GetExercisesByIdErrors,
   GetExercisesByIdMetricsHistoryData,
   GetExercisesByIdMetricsHistoryErrors,
   GetExercisesByIdMetricsHistoryResponses,
+  GetExercisesByIdNotesData,
+  GetExercisesByIdNotesErrors,
+  GetExercisesByIdNotesResponses,
   GetExercisesByIdRecentSetsData,
   GetExercisesByIdRecentSetsErrors,
   GetExercisesByIdRecentSetsResponses,
   GetExercisesByIdResponses,
   GetExercisesData,
   GetExercisesErrors,
+  GetExercisesMetricsHistoryData,
+  GetExercisesMetricsHistoryErrors,
+  GetExercisesMetricsHistoryResponses,
   GetExercisesResponses,
   GetFeaturesAccessData,
   GetFeaturesAccessErrors,
   GetFeaturesAccessResponses,
+  GetGoalsData,
+  GetGoalsErrors,
+  GetGoalsResponses,
+  GetNotificationsData,
+  GetNotificationsErrors,
+  GetNotificationsResponses,
+  GetNotificationsStreamData,
+  GetNotificationsStreamErrors,
+  GetNotificationsStreamResponse,
+  GetNotificationsStreamResponses,
+  GetNotificationsUnreadCountData,
+  GetNotificationsUnreadCountErrors,
+  GetNotificationsUnreadCountResponses,
+  GetReportsByPeriodData,
+  GetReportsByPeriodErrors,
+  GetReportsByPeriodResponses,
+  GetSearchData,
+  GetSearchErrors,
+  GetSearchResponses,
+  GetStrengthProfileData,
+  GetStrengthProfileErrors,
+  GetStrengthProfileResponses,
+  GetStrengthScoresData,
+  GetStrengthScoresErrors,
+  GetStrengthScoresHistoryData,
+  GetStrengthScoresHistoryErrors,
+  GetStrengthScoresHistoryResponses,
+  GetStrengthScoresResponses,
+  GetTagsData,
+  GetTagsErrors,
+  GetTagsResponses,
+  GetToolsPlatesData,
+  GetToolsPlatesErrors,
+  GetToolsPlatesResponses,
   GetTrainingProfileData,
   GetTrainingProfileErrors,
   GetTrainingProfileResponses,
+  GetWebhooksByIdDeliveriesData,
+  GetWebhooksByIdDeliveriesErrors,
+  GetWebhooksByIdDeliveriesResponses,
+  GetWebhooksData,
+  GetWebhooksErrors,
+  GetWebhooksResponses,
   GetWorkoutsByIdData,
   GetWorkoutsByIdErrors,
   GetWorkoutsByIdResponses,
+  GetWorkoutsCompareData,
+  GetWorkoutsCompareErrors,
+  GetWorkoutsCompareResponses,
   GetWorkoutsContributionDataData,
   GetWorkoutsContributionDataErrors,
   GetWorkoutsContributionDataResponses,
@@ -64,6 +188,18 @@ import type {
   PatchExercisesByIdHistorical1RmErrors,
   PatchExercisesByIdHistorical1RmResponses,
   PatchExercisesByIdResponses,
+  PatchGoalsByIdData,
+  PatchGoalsByIdErrors,
+  PatchGoalsByIdResponses,
+  PatchTagsByIdData,
+  PatchTagsByIdErrors,
+  PatchTagsByIdResponses,
+  PatchWebhooksByIdData,
+  PatchWebhooksByIdErrors,
+  PatchWebhooksByIdResponses,
+  PostAccessTokensData,
+  PostAccessTokensErrors,
+  PostAccessTokensResponses,
   PostAiChatTelemetryData,
   PostAiChatTelemetryErrors,
   PostAiChatTelemetryResponses,
@@ -83,12 +219,69 @@ import type {
   PostAiConversationsData,
   PostAiConversationsErrors,
   PostAiConversationsResponses,
+  PostBodyMetricsData,
+  PostBodyMetricsErrors,
+  PostBodyMetricsResponses,
+  PostCalendarFeedTokenData,
+  PostCalendarFeedTokenErrors,
+  PostCalendarFeedTokenResponses,
+  PostCoachingAthletesByAthleteIdSuggestionsData,
+  PostCoachingAthletesByAthleteIdSuggestionsErrors,
+  PostCoachingAthletesByAthleteIdSuggestionsResponses,
+  PostCoachingInvitationsAcceptData,
+  PostCoachingInvitationsAcceptErrors,
+  PostCoachingInvitationsAcceptResponses,
+  PostCoachingInvitationsData,
+  PostCoachingInvitationsErrors,
+  PostCoachingInvitationsResponses,
   PostExercisesData,
   PostExercisesErrors,
   PostExercisesResponses,
+  PostGoalsData,
+  PostGoalsErrors,
+  PostGoalsResponses,
+  PostNotificationsByIdReadData,
+  PostNotificationsByIdReadErrors,
+  PostNotificationsByIdReadResponses,
+  PostNotificationsReadAllData,
+  PostNotificationsReadAllErrors,
+  PostNotificationsReadAllResponses,
+  PostTagsData,
+  PostTagsErrors,
+  PostTagsResponses,
+  PostToolsWarmupData,
+  PostToolsWarmupErrors,
+  PostToolsWarmupResponses,
+  PostWebhooksByIdTestData,
+  PostWebhooksByIdTestErrors,
+  PostWebhooksByIdTestResponses,
+  PostWebhooksData,
+  PostWebhooksErrors,
+  PostWebhooksResponses,
+  PostWorkoutsByIdShareData,
+  PostWorkoutsByIdShareErrors,
+  PostWorkoutsByIdShareResponses,
   PostWorkoutsData,
   PostWorkoutsErrors,
   PostWorkoutsResponses,
+  PutAccountTimezoneData,
+  PutAccountTimezoneErrors,
+  PutAccountTimezoneResponses,
+  PutBodyMetricsByIdData,
+  PutBodyMetricsByIdErrors,
+  PutBodyMetricsByIdResponses,
+  PutCoachingLinksByIdScopesData,
+  PutCoachingLinksByIdScopesErrors,
+  PutCoachingLinksByIdScopesResponses,
+  PutEquipmentData,
+  PutEquipmentErrors,
+  PutEquipmentResponses,
+  PutExercisesByIdNotesData,
+  PutExercisesByIdNotesErrors,
+  PutExercisesByIdNotesResponses,
+  PutStrengthProfileData,
+  PutStrengthProfileErrors,
+  PutStrengthProfileResponses,
   PutTrainingProfileData,
   PutTrainingProfileErrors,
   PutTrainingProfileResponses,
@@ -115,6 +308,122 @@ export type Options<
   meta?: Record<string, unknown>;
 };
 
+/**
+ * List personal access tokens
+ *
+ * Returns the authenticated user's tokens that have not been revoked, including expired ones. Tokens themselves are only returned when they are created.
+ */
+export const getAccessTokens = <ThrowOnError extends boolean = false>(
+  options?: Options<GetAccessTokensData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetAccessTokensResponses,
+    GetAccessTokensErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/access-tokens",
+    ...options,
+  });
+
+/**
+ * Create personal access token
+ *
+ * Issues a token for the public API with workouts:read, workouts:write, profile:read, profile:write or chat:use scopes. Send it as "Authorization: Bearer <token>". The workouts scopes cover workouts, exercises, tags, equipment, tools and the reports derived from them; the profile scopes cover body metrics, goals, the strength profile and the training profile; chat:use covers the AI chat routes, and saving a chat workout draft also needs workouts:write. Tokens never reach token management, account, billing, coaching, webhook or share routes, nor deleting all chat history. The token is only returned here.
+ */
+export const postAccessTokens = <ThrowOnError extends boolean = false>(
+  options: Options<PostAccessTokensData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostAccessTokensResponses,
+    PostAccessTokensErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/access-tokens",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Revoke personal access token
+ *
+ * Revokes a token immediately. Requests already using it start failing with 401.
+ */
+export const deleteAccessTokensById = <ThrowOnError extends boolean = false>(
+  options: Options<DeleteAccessTokensByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    DeleteAccessTokensByIdResponses,
+    DeleteAccessTokensByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/access-tokens/{id}",
+    ...options,
+  });
+
+/**
+ * Get account timezone
+ *
+ * Returns the IANA timezone used to bucket workouts into days, weeks, and months. Users without a stored timezone get UTC until a client reports one.
+ */
+export const getAccountTimezone = <ThrowOnError extends boolean = false>(
+  options?: Options<GetAccountTimezoneData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetAccountTimezoneResponses,
+    GetAccountTimezoneErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/account/timezone",
+    ...options,
+  });
+
+/**
+ * Update account timezone
+ *
+ * Stores the IANA timezone used to bucket workouts into days, weeks, and months. Send null to clear it so the next client request can infer it again.
+ */
+export const putAccountTimezone = <ThrowOnError extends boolean = false>(
+  options: Options<PutAccountTimezoneData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    PutAccountTimezoneResponses,
+    PutAccountTimezoneErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/account/timezone",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List achievements
+ *
+ * Returns achievements the authenticated user has earned, with the workout that unlocked each, and progress fractions toward the rest.
+ */
+export const getAchievements = <ThrowOnError extends boolean = false>(
+  options?: Options<GetAchievementsData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetAchievementsResponses,
+    GetAchievementsErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/achievements",
+    ...options,
+  });
+
 /**
  * Record AI chat telemetry
  *
@@ -343,120 +652,110 @@ export const postAiConversationsByIdRunsByRunIdStop = <
   });
 
 /**
- * List exercises
+ * Get training consistency
  *
- * Get all exercises for the authenticated user
+ * Returns current and longest daily and weekly streaks plus a rolling sessions-per-week average, bucketed in the requested timezone.
  */
-export const getExercises = <ThrowOnError extends boolean = false>(
-  options?: Options<GetExercisesData, ThrowOnError>,
+export const getAnalyticsConsistency = <ThrowOnError extends boolean = false>(
+  options?: Options<GetAnalyticsConsistencyData, ThrowOnError>,
 ) =>
   (options?.client ?? client).get<
-    GetExercisesResponses,
-    GetExercisesErrors,
+    GetAnalyticsConsistencyResponses,
+    GetAnalyticsConsistencyErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/exercises",
+    url: "/analytics/consistency",
     ...options,
   });
 
 /**
- * Get or create exercise
+ * Get plateau detection
  *
- * Get an existing exercise by name or create it if it doesn't exist
+ * Fits a trend to each exercise's session-best estimated 1RM over the window and flags exercises that have stalled or regressed, with suggested interventions (deload, rep-range change, variation swap). Flagged exercises are listed first.
  */
-export const postExercises = <ThrowOnError extends boolean = false>(
-  options: Options<PostExercisesData, ThrowOnError>,
+export const getAnalyticsPlateaus = <ThrowOnError extends boolean = false>(
+  options?: Options<GetAnalyticsPlateausData, ThrowOnError>,
 ) =>
-  (options.client ?? client).post<
-    PostExercisesResponses,
-    PostExercisesErrors,
+  (options?.client ?? client).get<
+    GetAnalyticsPlateausResponses,
+    GetAnalyticsPlateausErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/exercises",
+    url: "/analytics/plateaus",
     ...options,
-    headers: {
-      "Content-Type": "application/json",
-      ...options.headers,
-    },
   });
 
 /**
- * Delete an exercise
+ * Get training load
  *
- * Delete a specific exercise and all its associated sets. Only the owner of the exercise can delete it.
+ * Returns daily training load with acute (7-day) and chronic (28-day, per week) load, the acute:chronic workload ratio, monotony and strain, plus warnings when load is ramping too fast.
  */
-export const deleteExercisesById = <ThrowOnError extends boolean = false>(
-  options: Options<DeleteExercisesByIdData, ThrowOnError>,
+export const getAnalyticsTrainingLoad = <ThrowOnError extends boolean = false>(
+  options?: Options<GetAnalyticsTrainingLoadData, ThrowOnError>,
 ) =>
-  (options.client ?? client).delete<
-    DeleteExercisesByIdResponses,
-    DeleteExercisesByIdErrors,
+  (options?.client ?? client).get<
+    GetAnalyticsTrainingLoadResponses,
+    GetAnalyticsTrainingLoadErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/exercises/{id}",
+    url: "/analytics/training-load",
     ...options,
   });
 
 /**
- * Get exercise with sets
+ * Get training volume
  *
- * Get a specific exercise (metadata) plus all its sets from workouts. Sets is empty when exercise exists but has no sets.
+ * Returns total tonnage, sets and reps per day, week or month across all exercises, with each period also split by set type. Periods without training are included as zeros.
  */
-export const getExercisesById = <ThrowOnError extends boolean = false>(
-  options: Options<GetExercisesByIdData, ThrowOnError>,
+export const getAnalyticsVolume = <ThrowOnError extends boolean = false>(
+  options?: Options<GetAnalyticsVolumeData, ThrowOnError>,
 ) =>
-  (options.client ?? client).get<
-    GetExercisesByIdResponses,
-    GetExercisesByIdErrors,
+  (options?.client ?? client).get<
+    GetAnalyticsVolumeResponses,
+    GetAnalyticsVolumeErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/exercises/{id}",
+    url: "/analytics/volume",
     ...options,
   });
 
 /**
- * Update an exercise name
+ * List body metric entries
  *
- * Update the name of an exercise by ID (must belong to authenticated user)
+ * Returns the authenticated user's bodyweight, body fat and girth entries, newest first.
  */
-export const patchExercisesById = <ThrowOnError extends boolean = false>(
-  options: Options<PatchExercisesByIdData, ThrowOnError>,
+export const getBodyMetrics = <ThrowOnError extends boolean = false>(
+  options?: Options<GetBodyMetricsData, ThrowOnError>,
 ) =>
-  (options.client ?? client).patch<
-    PatchExercisesByIdResponses,
-    PatchExercisesByIdErrors,
+  (options?.client ?? client).get<
+    GetBodyMetricsResponses,
+    GetBodyMetricsErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/exercises/{id}",
+    url: "/body-metrics",
     ...options,
-    headers: {
-      "Content-Type": "application/json",
-      ...options.headers,
-    },
   });
 
 /**
- * Update exercise historical 1RM
+ * Create body metric entry
  *
- * Set a manual historical 1RM (clears source workout) or recompute from best working-set e1RM across history.
+ * Records bodyweight, body fat and/or girth measurements for a date. At least one measurement is required.
  */
-export const patchExercisesByIdHistorical1Rm = <
-  ThrowOnError extends boolean = false,
->(
-  options: Options<PatchExercisesByIdHistorical1RmData, ThrowOnError>,
+export const postBodyMetrics = <ThrowOnError extends boolean = false>(
+  options: Options<PostBodyMetricsData, ThrowOnError>,
 ) =>
-  (options.client ?? client).patch<
-    PatchExercisesByIdHistorical1RmResponses,
-    PatchExercisesByIdHistorical1RmErrors,
+  (options.client ?? client).post<
+    PostBodyMetricsResponses,
+    PostBodyMetricsErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/exercises/{id}/historical-1rm",
+    url: "/body-metrics",
     ...options,
     headers: {
       "Content-Type": "application/json",
@@ -465,216 +764,1317 @@ export const patchExercisesByIdHistorical1Rm = <
   });
 
 /**
- * Get exercise metrics history
+ * Get body metric trend
  *
- * Get time-series session metrics for an exercise. All ranges return per-workout session points filtered to the selected window.
+ * Returns per-day values for one metric with a trailing moving average, ending today in the account timezone.
  */
-export const getExercisesByIdMetricsHistory = <
-  ThrowOnError extends boolean = false,
->(
-  options: Options<GetExercisesByIdMetricsHistoryData, ThrowOnError>,
+export const getBodyMetricsTrend = <ThrowOnError extends boolean = false>(
+  options?: Options<GetBodyMetricsTrendData, ThrowOnError>,
 ) =>
-  (options.client ?? client).get<
-    GetExercisesByIdMetricsHistoryResponses,
-    GetExercisesByIdMetricsHistoryErrors,
+  (options?.client ?? client).get<
+    GetBodyMetricsTrendResponses,
+    GetBodyMetricsTrendErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/exercises/{id}/metrics-history",
+    url: "/body-metrics/trend",
     ...options,
   });
 
 /**
- * Get recent sets for exercise
+ * Delete body metric entry
  *
- * Get the 3 most recent sets for a specific exercise. Returns empty array when exercise has no sets.
+ * Deletes one body metric entry owned by the authenticated user.
  */
-export const getExercisesByIdRecentSets = <
-  ThrowOnError extends boolean = false,
->(
-  options: Options<GetExercisesByIdRecentSetsData, ThrowOnError>,
+export const deleteBodyMetricsById = <ThrowOnError extends boolean = false>(
+  options: Options<DeleteBodyMetricsByIdData, ThrowOnError>,
 ) =>
-  (options.client ?? client).get<
-    GetExercisesByIdRecentSetsResponses,
-    GetExercisesByIdRecentSetsErrors,
+  (options.client ?? client).delete<
+    DeleteBodyMetricsByIdResponses,
+    DeleteBodyMetricsByIdErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/exercises/{id}/recent-sets",
+    url: "/body-metrics/{id}",
     ...options,
   });
 
 /**
- * List active feature access grants
+ * Get body metric entry
  *
- * Get all active feature access grants for the authenticated user
+ * Returns one body metric entry owned by the authenticated user.
  */
-export const getFeaturesAccess = <ThrowOnError extends boolean = false>(
-  options?: Options<GetFeaturesAccessData, ThrowOnError>,
+export const getBodyMetricsById = <ThrowOnError extends boolean = false>(
+  options: Options<GetBodyMetricsByIdData, ThrowOnError>,
 ) =>
-  (options?.client ?? client).get<
-    GetFeaturesAccessResponses,
-    GetFeaturesAccessErrors,
+  (options.client ?? client).get<
+    GetBodyMetricsByIdResponses,
+    GetBodyMetricsByIdErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/features/access",
+    url: "/body-metrics/{id}",
     ...options,
   });
 
 /**
- * Get training profile
+ * Update body metric entry
  *
- * Returns the authenticated user's durable AI training profile. First-time users receive an empty profile shape.
+ * Replaces a body metric entry with the submitted measurements.
  */
-export const getTrainingProfile = <ThrowOnError extends boolean = false>(
-  options?: Options<GetTrainingProfileData, ThrowOnError>,
+export const putBodyMetricsById = <ThrowOnError extends boolean = false>(
+  options: Options<PutBodyMetricsByIdData, ThrowOnError>,
 ) =>
-  (options?.client ?? client).get<
-    GetTrainingProfileResponses,
-    GetTrainingProfileErrors,
+  (options.client ?? client).put<
+    PutBodyMetricsByIdResponses,
+    PutBodyMetricsByIdErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/training-profile",
+    url: "/body-metrics/{id}",
     ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
   });
 
 /**
- * Update training profile
+ * Disable calendar feed
  *
- * Replaces the authenticated user's durable AI training profile with the full submitted document.
+ * Disables the authenticated user's iCalendar feed and revokes its token.
  */
-export const putTrainingProfile = <ThrowOnError extends boolean = false>(
-  options: Options<PutTrainingProfileData, ThrowOnError>,
+export const deleteCalendarFeed = <ThrowOnError extends boolean = false>(
+  options?: Options<DeleteCalendarFeedData, ThrowOnError>,
 ) =>
-  (options.client ?? client).put<
-    PutTrainingProfileResponses,
-    PutTrainingProfileErrors,
+  (options?.client ?? client).delete<
+    DeleteCalendarFeedResponses,
+    DeleteCalendarFeedErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/training-profile",
+    url: "/calendar/feed",
     ...options,
-    headers: {
-      "Content-Type": "application/json",
-      ...options.headers,
-    },
   });
 
 /**
- * List workouts
+ * Get calendar feed status
  *
- * Get all workouts for the authenticated user
+ * Reports whether the authenticated user's iCalendar feed is enabled. The feed URL is only shown when a token is issued.
  */
-export const getWorkouts = <ThrowOnError extends boolean = false>(
-  options?: Options<GetWorkoutsData, ThrowOnError>,
+export const getCalendarFeed = <ThrowOnError extends boolean = false>(
+  options?: Options<GetCalendarFeedData, ThrowOnError>,
 ) =>
   (options?.client ?? client).get<
-    GetWorkoutsResponses,
-    GetWorkoutsErrors,
+    GetCalendarFeedResponses,
+    GetCalendarFeedErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/workouts",
+    url: "/calendar/feed",
     ...options,
   });
 
 /**
- * Create a new workout
+ * Issue or rotate calendar feed token
  *
- * Create a new workout with exercises and sets
+ * Issues a new secret token for the authenticated user's iCalendar feed, enabling the feed if needed. Any previously issued feed URL stops working immediately.
  */
-export const postWorkouts = <ThrowOnError extends boolean = false>(
-  options: Options<PostWorkoutsData, ThrowOnError>,
+export const postCalendarFeedToken = <ThrowOnError extends boolean = false>(
+  options?: Options<PostCalendarFeedTokenData, ThrowOnError>,
 ) =>
-  (options.client ?? client).post<
-    PostWorkoutsResponses,
-    PostWorkoutsErrors,
+  (options?.client ?? client).post<
+    PostCalendarFeedTokenResponses,
+    PostCalendarFeedTokenErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/workouts",
+    url: "/calendar/feed/token",
     ...options,
-    headers: {
-      "Content-Type": "application/json",
-      ...options.headers,
-    },
   });
 
 /**
- * Get contribution graph data
+ * List coached athletes
  *
- * Get workout contribution data for the past 52 weeks, including daily working set counts and intensity levels (0-4) for visualization in a contribution graph
+ * Returns the athletes linked to the authenticated coach. Send an athlete's ID in the X-Act-As header to read their workouts, exercises and metrics through the regular endpoints, within the granted scopes.
  */
-export const getWorkoutsContributionData = <
-  ThrowOnError extends boolean = false,
->(
-  options?: Options<GetWorkoutsContributionDataData, ThrowOnError>,
+export const getCoachingAthletes = <ThrowOnError extends boolean = false>(
+  options?: Options<GetCoachingAthletesData, ThrowOnError>,
 ) =>
   (options?.client ?? client).get<
-    GetWorkoutsContributionDataResponses,
-    GetWorkoutsContributionDataErrors,
+    GetCoachingAthletesResponses,
+    GetCoachingAthletesErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/workouts/contribution-data",
+    url: "/coaching/athletes",
     ...options,
   });
 
 /**
- * List workout focus values
+ * Suggest a workout
  *
- * Get all distinct workout focus values for the authenticated user. Returns 200 OK with an empty array if no workout focus values exist.
+ * Sends a suggested workout to an athlete who granted the authenticated coach the suggest_workouts scope.
  */
-export const getWorkoutsFocusValues = <ThrowOnError extends boolean = false>(
-  options?: Options<GetWorkoutsFocusValuesData, ThrowOnError>,
+export const postCoachingAthletesByAthleteIdSuggestions = <
+  ThrowOnError extends boolean = false,
+>(
+  options: Options<
+    PostCoachingAthletesByAthleteIdSuggestionsData,
+    ThrowOnError
+  >,
 ) =>
-  (options?.client ?? client).get<
-    GetWorkoutsFocusValuesResponses,
-    GetWorkoutsFocusValuesErrors,
+  (options.client ?? client).post<
+    PostCoachingAthletesByAthleteIdSuggestionsResponses,
+    PostCoachingAthletesByAthleteIdSuggestionsErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/workouts/focus-values",
+    url: "/coaching/athletes/{athleteID}/suggestions",
     ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
   });
 
 /**
- * Get new workout context
+ * List coaches
  *
- * Get the newest reusable workout per focus area plus the latest workout note for the authenticated user.
+ * Returns the coaches the authenticated athlete has granted access to.
  */
-export const getWorkoutsNewWorkoutContext = <
-  ThrowOnError extends boolean = false,
->(
-  options?: Options<GetWorkoutsNewWorkoutContextData, ThrowOnError>,
+export const getCoachingCoaches = <ThrowOnError extends boolean = false>(
+  options?: Options<GetCoachingCoachesData, ThrowOnError>,
 ) =>
   (options?.client ?? client).get<
-    GetWorkoutsNewWorkoutContextResponses,
-    GetWorkoutsNewWorkoutContextErrors,
+    GetCoachingCoachesResponses,
+    GetCoachingCoachesErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/workouts/new-workout-context",
+    url: "/coaching/coaches",
     ...options,
   });
 
 /**
- * Delete a workout
+ * List pending coaching invitations
  *
- * Delete a specific workout and all its associated sets. Only the owner of the workout can delete it.
+ * Returns the authenticated coach's invitations that have not been accepted or expired.
  */
-export const deleteWorkoutsById = <ThrowOnError extends boolean = false>(
-  options: Options<DeleteWorkoutsByIdData, ThrowOnError>,
+export const getCoachingInvitations = <ThrowOnError extends boolean = false>(
+  options?: Options<GetCoachingInvitationsData, ThrowOnError>,
 ) =>
-  (options.client ?? client).delete<
-    DeleteWorkoutsByIdResponses,
-    DeleteWorkoutsByIdErrors,
+  (options?.client ?? client).get<
+    GetCoachingInvitationsResponses,
+    GetCoachingInvitationsErrors,
     ThrowOnError
   >({
     security: [{ name: "x-stack-access-token", type: "apiKey" }],
-    url: "/workouts/{id}",
+    url: "/coaching/invitations",
+    ...options,
+  });
+
+/**
+ * Create coaching invitation
+ *
+ * Issues a single-use invitation code, valid for seven days, that an athlete accepts to grant the authenticated coach the requested scopes. The code is only returned here.
+ */
+export const postCoachingInvitations = <ThrowOnError extends boolean = false>(
+  options: Options<PostCoachingInvitationsData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostCoachingInvitationsResponses,
+    PostCoachingInvitationsErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/coaching/invitations",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Accept coaching invitation
+ *
+ * Links the authenticated user, as athlete, to the coach who issued the invitation code.
+ */
+export const postCoachingInvitationsAccept = <
+  ThrowOnError extends boolean = false,
+>(
+  options: Options<PostCoachingInvitationsAcceptData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostCoachingInvitationsAcceptResponses,
+    PostCoachingInvitationsAcceptErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/coaching/invitations/accept",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Delete coaching link
+ *
+ * Ends a coaching relationship from either side, or cancels a pending invitation.
+ */
+export const deleteCoachingLinksById = <ThrowOnError extends boolean = false>(
+  options: Options<DeleteCoachingLinksByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    DeleteCoachingLinksByIdResponses,
+    DeleteCoachingLinksByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/coaching/links/{id}",
+    ...options,
+  });
+
+/**
+ * Update coaching scopes
+ *
+ * Replaces the scopes the authenticated athlete grants a coach.
+ */
+export const putCoachingLinksByIdScopes = <
+  ThrowOnError extends boolean = false,
+>(
+  options: Options<PutCoachingLinksByIdScopesData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    PutCoachingLinksByIdScopesResponses,
+    PutCoachingLinksByIdScopesErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/coaching/links/{id}/scopes",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List suggested workouts
+ *
+ * Returns the workouts the authenticated athlete's coaches have suggested and that have not been dismissed, newest first.
+ */
+export const getCoachingSuggestions = <ThrowOnError extends boolean = false>(
+  options?: Options<GetCoachingSuggestionsData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetCoachingSuggestionsResponses,
+    GetCoachingSuggestionsErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/coaching/suggestions",
+    ...options,
+  });
+
+/**
+ * Dismiss suggested workout
+ *
+ * Hides a suggested workout from the authenticated athlete's list.
+ */
+export const deleteCoachingSuggestionsById = <
+  ThrowOnError extends boolean = false,
+>(
+  options: Options<DeleteCoachingSuggestionsByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    DeleteCoachingSuggestionsByIdResponses,
+    DeleteCoachingSuggestionsByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/coaching/suggestions/{id}",
+    ...options,
+  });
+
+/**
+ * Get equipment inventory
+ *
+ * Returns the bars, plate pairs, dumbbell increment and machine stack step used to round suggested weights. Users who have not saved equipment get a default commercial-gym inventory with is_default set.
+ */
+export const getEquipment = <ThrowOnError extends boolean = false>(
+  options?: Options<GetEquipmentData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetEquipmentResponses,
+    GetEquipmentErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/equipment",
+    ...options,
+  });
+
+/**
+ * Update equipment inventory
+ *
+ * Replaces the equipment inventory. Weights must be positive multiples of 0.25; the first bar is the primary bar.
+ */
+export const putEquipment = <ThrowOnError extends boolean = false>(
+  options: Options<PutEquipmentData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    PutEquipmentResponses,
+    PutEquipmentErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/equipment",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List exercises
+ *
+ * Get all exercises for the authenticated user
+ */
+export const getExercises = <ThrowOnError extends boolean = false>(
+  options?: Options<GetExercisesData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetExercisesResponses,
+    GetExercisesErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/exercises",
+    ...options,
+  });
+
+/**
+ * Get or create exercise
+ *
+ * Get an existing exercise by name or create it if it doesn't exist
+ */
+export const postExercises = <ThrowOnError extends boolean = false>(
+  options: Options<PostExercisesData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostExercisesResponses,
+    PostExercisesErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/exercises",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Compare metrics history across exercises
+ *
+ * Get metrics for up to 8 exercises on one shared time axis. Each series has a point per axis entry (null where the exercise has no session) with percent_of_1rm relative to the exercise's historical 1RM, or its best e1RM in the window when none is set. Range, from/to and bucket behave as on the single-exercise endpoint; range windows end at the latest session across the exercises.
+ */
+export const getExercisesMetricsHistory = <
+  ThrowOnError extends boolean = false,
+>(
+  options: Options<GetExercisesMetricsHistoryData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    GetExercisesMetricsHistoryResponses,
+    GetExercisesMetricsHistoryErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/exercises/metrics-history",
+    ...options,
+  });
+
+/**
+ * Delete an exercise
+ *
+ * Delete a specific exercise and all its associated sets. Only the owner of the exercise can delete it.
+ */
+export const deleteExercisesById = <ThrowOnError extends boolean = false>(
+  options: Options<DeleteExercisesByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    DeleteExercisesByIdResponses,
+    DeleteExercisesByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/exercises/{id}",
+    ...options,
+  });
+
+/**
+ * Get exercise with sets
+ *
+ * Get a specific exercise (metadata) plus all its sets from workouts. Sets is empty when exercise exists but has no sets.
+ */
+export const getExercisesById = <ThrowOnError extends boolean = false>(
+  options: Options<GetExercisesByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    GetExercisesByIdResponses,
+    GetExercisesByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/exercises/{id}",
+    ...options,
+  });
+
+/**
+ * Update an exercise name
+ *
+ * Update the name of an exercise by ID (must belong to authenticated user)
+ */
+export const patchExercisesById = <ThrowOnError extends boolean = false>(
+  options: Options<PatchExercisesByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).patch<
+    PatchExercisesByIdResponses,
+    PatchExercisesByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/exercises/{id}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Update exercise historical 1RM
+ *
+ * Set a manual historical 1RM (clears source workout) or recompute from best working-set e1RM across history.
+ */
+export const patchExercisesByIdHistorical1Rm = <
+  ThrowOnError extends boolean = false,
+>(
+  options: Options<PatchExercisesByIdHistorical1RmData, ThrowOnError>,
+) =>
+  (options.client ?? client).patch<
+    PatchExercisesByIdHistorical1RmResponses,
+    PatchExercisesByIdHistorical1RmErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/exercises/{id}/historical-1rm",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Get exercise metrics history
+ *
+ * Get time-series session metrics for an exercise. Use a range code for a window ending at the latest session, or from/to local dates for a custom window. bucket=week or month folds sessions together: bests take the max, volume is summed and averages are weighted by working sets.
+ */
+export const getExercisesByIdMetricsHistory = <
+  ThrowOnError extends boolean = false,
+>(
+  options: Options<GetExercisesByIdMetricsHistoryData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    GetExercisesByIdMetricsHistoryResponses,
+    GetExercisesByIdMetricsHistoryErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/exercises/{id}/metrics-history",
+    ...options,
+  });
+
+/**
+ * Get exercise notes
+ *
+ * Get the current notes, cues and equipment settings for an exercise plus recent revisions, newest first.
+ */
+export const getExercisesByIdNotes = <ThrowOnError extends boolean = false>(
+  options: Options<GetExercisesByIdNotesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    GetExercisesByIdNotesResponses,
+    GetExercisesByIdNotesErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/exercises/{id}/notes",
+    ...options,
+  });
+
+/**
+ * Update exercise notes
+ *
+ * Replace the notes, cues and equipment settings for an exercise. Each change is kept as a revision; blank or omitted fields are cleared.
+ */
+export const putExercisesByIdNotes = <ThrowOnError extends boolean = false>(
+  options: Options<PutExercisesByIdNotesData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    PutExercisesByIdNotesResponses,
+    PutExercisesByIdNotesErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/exercises/{id}/notes",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Get recent sets for exercise
+ *
+ * Get the 3 most recent sets for a specific exercise. Returns empty array when exercise has no sets.
+ */
+export const getExercisesByIdRecentSets = <
+  ThrowOnError extends boolean = false,
+>(
+  options: Options<GetExercisesByIdRecentSetsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    GetExercisesByIdRecentSetsResponses,
+    GetExercisesByIdRecentSetsErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/exercises/{id}/recent-sets",
+    ...options,
+  });
+
+/**
+ * List active feature access grants
+ *
+ * Get all active feature access grants for the authenticated user
+ */
+export const getFeaturesAccess = <ThrowOnError extends boolean = false>(
+  options?: Options<GetFeaturesAccessData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetFeaturesAccessResponses,
+    GetFeaturesAccessErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/features/access",
+    ...options,
+  });
+
+/**
+ * List goals
+ *
+ * Returns the authenticated user's goals with current progress, a projected attainment date fitted from recent data, and a status of achieved, on_track, behind or not_enough_data.
+ */
+export const getGoals = <ThrowOnError extends boolean = false>(
+  options?: Options<GetGoalsData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetGoalsResponses,
+    GetGoalsErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/goals",
+    ...options,
+  });
+
+/**
+ * Create goal
+ *
+ * Sets an e1rm, frequency (workouts per week) or bodyweight goal with an optional target date. Progress is measured from the value at creation.
+ */
+export const postGoals = <ThrowOnError extends boolean = false>(
+  options: Options<PostGoalsData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostGoalsResponses,
+    PostGoalsErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/goals",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Delete goal
+ *
+ * Deletes one goal owned by the authenticated user.
+ */
+export const deleteGoalsById = <ThrowOnError extends boolean = false>(
+  options: Options<DeleteGoalsByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    DeleteGoalsByIdResponses,
+    DeleteGoalsByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/goals/{id}",
+    ...options,
+  });
+
+/**
+ * Update goal
+ *
+ * Changes a goal's target value or target date. Omitted fields are kept and an empty target_date removes the date. The type, exercise, starting value and creation date cannot change.
+ */
+export const patchGoalsById = <ThrowOnError extends boolean = false>(
+  options: Options<PatchGoalsByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).patch<
+    PatchGoalsByIdResponses,
+    PatchGoalsByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/goals/{id}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List notifications
+ *
+ * Returns the authenticated user's notifications, newest first. Use next_before_id as before_id to page back.
+ */
+export const getNotifications = <ThrowOnError extends boolean = false>(
+  options?: Options<GetNotificationsData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetNotificationsResponses,
+    GetNotificationsErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/notifications",
+    ...options,
+  });
+
+/**
+ * Mark all notifications read
+ *
+ * Marks every unread notification read and returns how many were updated.
+ */
+export const postNotificationsReadAll = <ThrowOnError extends boolean = false>(
+  options?: Options<PostNotificationsReadAllData, ThrowOnError>,
+) =>
+  (options?.client ?? client).post<
+    PostNotificationsReadAllResponses,
+    PostNotificationsReadAllErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/notifications/read-all",
+    ...options,
+  });
+
+/**
+ * Stream notifications
+ *
+ * Opens a Server-Sent Events stream. A ready event carries the unread count, then a notification event is sent for each new notification, and ping events keep idle connections open. Reconnect and re-list to catch up after a disconnect.
+ */
+export const getNotificationsStream = <ThrowOnError extends boolean = false>(
+  options?: Options<
+    GetNotificationsStreamData,
+    ThrowOnError,
+    GetNotificationsStreamResponse
+  >,
+) =>
+  (options?.client ?? client).sse.get<
+    GetNotificationsStreamResponses,
+    GetNotificationsStreamErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/notifications/stream",
+    ...options,
+  });
+
+/**
+ * Get unread notification count
+ *
+ * Returns how many of the authenticated user's notifications are unread.
+ */
+export const getNotificationsUnreadCount = <
+  ThrowOnError extends boolean = false,
+>(
+  options?: Options<GetNotificationsUnreadCountData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetNotificationsUnreadCountResponses,
+    GetNotificationsUnreadCountErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/notifications/unread-count",
+    ...options,
+  });
+
+/**
+ * Mark notification read
+ *
+ * Marks one notification read. Marking an already read notification keeps its original read time.
+ */
+export const postNotificationsByIdRead = <ThrowOnError extends boolean = false>(
+  options: Options<PostNotificationsByIdReadData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostNotificationsByIdReadResponses,
+    PostNotificationsByIdReadErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/notifications/{id}/read",
+    ...options,
+  });
+
+/**
+ * Get training summary report
+ *
+ * Summarizes the week, month or year containing the given date in the user's timezone: sessions, working sets, tonnage, per-focus breakdown, new historical 1RMs, most improved exercises and the change from the previous period. Send `Accept: text/markdown` for a Markdown recap instead of JSON.
+ */
+export const getReportsByPeriod = <ThrowOnError extends boolean = false>(
+  options: Options<GetReportsByPeriodData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    GetReportsByPeriodResponses,
+    GetReportsByPeriodErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/reports/{period}",
+    ...options,
+  });
+
+/**
+ * Search workouts, exercises and AI chats
+ *
+ * Full-text search over the authenticated user's workout notes and focus, set notes, exercise names, and AI chat conversation titles and messages. Supports quoted phrases, OR, and -excluded terms. Snippets are HTML-escaped with matches wrapped in <mark> tags.
+ */
+export const getSearch = <ThrowOnError extends boolean = false>(
+  options: Options<GetSearchData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    GetSearchResponses,
+    GetSearchErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/search",
+    ...options,
+  });
+
+/**
+ * Get strength profile
+ *
+ * Returns the sex and designated squat, bench and deadlift exercises used for relative-strength scoring.
+ */
+export const getStrengthProfile = <ThrowOnError extends boolean = false>(
+  options?: Options<GetStrengthProfileData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetStrengthProfileResponses,
+    GetStrengthProfileErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/strength/profile",
+    ...options,
+  });
+
+/**
+ * Update strength profile
+ *
+ * Replaces the strength profile. Designated exercises must belong to the authenticated user; null fields are cleared.
+ */
+export const putStrengthProfile = <ThrowOnError extends boolean = false>(
+  options: Options<PutStrengthProfileData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    PutStrengthProfileResponses,
+    PutStrengthProfileErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/strength/profile",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Get relative strength scores
+ *
+ * Returns the current powerlifting total with DOTS, Wilks and IPF GL scores from the best squat, bench and deadlift e1RMs and the latest bodyweight, plus per-lift strength-standard classifications.
+ */
+export const getStrengthScores = <ThrowOnError extends boolean = false>(
+  options?: Options<GetStrengthScoresData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetStrengthScoresResponses,
+    GetStrengthScoresErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/strength/scores",
+    ...options,
+  });
+
+/**
+ * Get relative strength score history
+ *
+ * Returns DOTS, Wilks and IPF GL over time, one point per workout day once all three lifts have been logged, using the bodyweight reading nearest each day.
+ */
+export const getStrengthScoresHistory = <ThrowOnError extends boolean = false>(
+  options?: Options<GetStrengthScoresHistoryData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetStrengthScoresHistoryResponses,
+    GetStrengthScoresHistoryErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/strength/scores/history",
+    ...options,
+  });
+
+/**
+ * List workout tags
+ *
+ * Returns the authenticated user's workout tags, ordered by name, with the number of workouts carrying each.
+ */
+export const getTags = <ThrowOnError extends boolean = false>(
+  options?: Options<GetTagsData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetTagsResponses,
+    GetTagsErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/tags",
+    ...options,
+  });
+
+/**
+ * Create workout tag
+ *
+ * Adds a tag to the user's vocabulary. Names are lowercased and whitespace-collapsed before saving. Tags sent with a workout are created automatically, so this is only needed to add one ahead of time.
+ */
+export const postTags = <ThrowOnError extends boolean = false>(
+  options: Options<PostTagsData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostTagsResponses,
+    PostTagsErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/tags",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Delete workout tag
+ *
+ * Deletes a tag and removes it from every workout. The workouts themselves are kept.
+ */
+export const deleteTagsById = <ThrowOnError extends boolean = false>(
+  options: Options<DeleteTagsByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    DeleteTagsByIdResponses,
+    DeleteTagsByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/tags/{id}",
+    ...options,
+  });
+
+/**
+ * Rename workout tag
+ *
+ * Renames a tag on every workout that carries it. Renaming onto another existing tag is rejected.
+ */
+export const patchTagsById = <ThrowOnError extends boolean = false>(
+  options: Options<PatchTagsByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).patch<
+    PatchTagsByIdResponses,
+    PatchTagsByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/tags/{id}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Get plate breakdown
+ *
+ * Returns the plates to load on each side of the bar for the closest weight to the target that the user's equipment allows.
+ */
+export const getToolsPlates = <ThrowOnError extends boolean = false>(
+  options: Options<GetToolsPlatesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    GetToolsPlatesResponses,
+    GetToolsPlatesErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/tools/plates",
+    ...options,
+  });
+
+/**
+ * Generate warm-up sets
+ *
+ * Build warm-up sets leading to a working weight. Loads are rounded to the user's equipment, using the given bar or the primary bar for barbell lifts. Steps override the named scheme; the standard scheme is used when neither is given.
+ */
+export const postToolsWarmup = <ThrowOnError extends boolean = false>(
+  options: Options<PostToolsWarmupData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostToolsWarmupResponses,
+    PostToolsWarmupErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/tools/warmup",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Get training profile
+ *
+ * Returns the authenticated user's durable AI training profile. First-time users receive an empty profile shape.
+ */
+export const getTrainingProfile = <ThrowOnError extends boolean = false>(
+  options?: Options<GetTrainingProfileData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetTrainingProfileResponses,
+    GetTrainingProfileErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/training-profile",
+    ...options,
+  });
+
+/**
+ * Update training profile
+ *
+ * Replaces the authenticated user's durable AI training profile with the full submitted document.
+ */
+export const putTrainingProfile = <ThrowOnError extends boolean = false>(
+  options: Options<PutTrainingProfileData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    PutTrainingProfileResponses,
+    PutTrainingProfileErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/training-profile",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List webhook endpoints
+ *
+ * Returns the authenticated user's webhook endpoints. Secrets are only returned when an endpoint is created.
+ */
+export const getWebhooks = <ThrowOnError extends boolean = false>(
+  options?: Options<GetWebhooksData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetWebhooksResponses,
+    GetWebhooksErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/webhooks",
+    ...options,
+  });
+
+/**
+ * Create webhook endpoint
+ *
+ * Subscribes a URL to workout.created, workout.updated, workout.deleted, record.achieved or subscription.changed events. Each delivery is a JSON POST signed in the X-FitTrack-Signature header as t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed with the secret>. The secret is only returned here.
+ */
+export const postWebhooks = <ThrowOnError extends boolean = false>(
+  options: Options<PostWebhooksData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostWebhooksResponses,
+    PostWebhooksErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/webhooks",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Delete webhook endpoint
+ *
+ * Deletes an endpoint along with its delivery log and any pending deliveries.
+ */
+export const deleteWebhooksById = <ThrowOnError extends boolean = false>(
+  options: Options<DeleteWebhooksByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    DeleteWebhooksByIdResponses,
+    DeleteWebhooksByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/webhooks/{id}",
+    ...options,
+  });
+
+/**
+ * Update webhook endpoint
+ *
+ * Changes the URL, event types or active flag; omitted fields are kept. Deactivating an endpoint fails its pending deliveries.
+ */
+export const patchWebhooksById = <ThrowOnError extends boolean = false>(
+  options: Options<PatchWebhooksByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).patch<
+    PatchWebhooksByIdResponses,
+    PatchWebhooksByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/webhooks/{id}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List webhook deliveries
+ *
+ * Returns an endpoint's delivery log, newest first. Failed attempts are retried with exponential backoff for about three hours before a delivery is marked failed.
+ */
+export const getWebhooksByIdDeliveries = <ThrowOnError extends boolean = false>(
+  options: Options<GetWebhooksByIdDeliveriesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    GetWebhooksByIdDeliveriesResponses,
+    GetWebhooksByIdDeliveriesErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/webhooks/{id}/deliveries",
+    ...options,
+  });
+
+/**
+ * Send test webhook
+ *
+ * Sends a signed webhook.test event to the endpoint immediately and returns the logged delivery. A failed test is retried like any other delivery.
+ */
+export const postWebhooksByIdTest = <ThrowOnError extends boolean = false>(
+  options: Options<PostWebhooksByIdTestData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostWebhooksByIdTestResponses,
+    PostWebhooksByIdTestErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/webhooks/{id}/test",
+    ...options,
+  });
+
+/**
+ * List workouts
+ *
+ * Get all workouts for the authenticated user, optionally filtered by tag
+ */
+export const getWorkouts = <ThrowOnError extends boolean = false>(
+  options?: Options<GetWorkoutsData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetWorkoutsResponses,
+    GetWorkoutsErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/workouts",
+    ...options,
+  });
+
+/**
+ * Create a new workout
+ *
+ * Create a new workout with exercises and sets
+ */
+export const postWorkouts = <ThrowOnError extends boolean = false>(
+  options: Options<PostWorkoutsData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostWorkoutsResponses,
+    PostWorkoutsErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/workouts",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Compare two workouts
+ *
+ * Lines up the exercises of two workouts, matching by exercise and then by similar name, and returns the change in working sets, reps, top set, volume and estimated 1RM from workout a to workout b, plus the exercises only one of them contains.
+ */
+export const getWorkoutsCompare = <ThrowOnError extends boolean = false>(
+  options: Options<GetWorkoutsCompareData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    GetWorkoutsCompareResponses,
+    GetWorkoutsCompareErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/workouts/compare",
+    ...options,
+  });
+
+/**
+ * Get contribution graph data
+ *
+ * Get workout contribution data for the past 52 weeks, including daily working set counts and intensity levels (0-4) for visualization in a contribution graph
+ */
+export const getWorkoutsContributionData = <
+  ThrowOnError extends boolean = false,
+>(
+  options?: Options<GetWorkoutsContributionDataData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetWorkoutsContributionDataResponses,
+    GetWorkoutsContributionDataErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/workouts/contribution-data",
+    ...options,
+  });
+
+/**
+ * List workout focus values
+ *
+ * Get all distinct workout focus values for the authenticated user. Returns 200 OK with an empty array if no workout focus values exist.
+ */
+export const getWorkoutsFocusValues = <ThrowOnError extends boolean = false>(
+  options?: Options<GetWorkoutsFocusValuesData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetWorkoutsFocusValuesResponses,
+    GetWorkoutsFocusValuesErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/workouts/focus-values",
+    ...options,
+  });
+
+/**
+ * Get new workout context
+ *
+ * Get the newest reusable workout per focus area plus the latest workout note for the authenticated user.
+ */
+export const getWorkoutsNewWorkoutContext = <
+  ThrowOnError extends boolean = false,
+>(
+  options?: Options<GetWorkoutsNewWorkoutContextData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    GetWorkoutsNewWorkoutContextResponses,
+    GetWorkoutsNewWorkoutContextErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/workouts/new-workout-context",
+    ...options,
+  });
+
+/**
+ * Delete a workout
+ *
+ * Delete a specific workout and all its associated sets. Only the owner of the workout can delete it.
+ */
+export const deleteWorkoutsById = <ThrowOnError extends boolean = false>(
+  options: Options<DeleteWorkoutsByIdData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    DeleteWorkoutsByIdResponses,
+    DeleteWorkoutsByIdErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/workouts/{id}",
     ...options,
   });
 
@@ -717,3 +2117,43 @@ export const putWorkoutsById = <ThrowOnError extends boolean = false>(
       ...options.headers,
     },
   });
+
+/**
+ * Revoke workout share link
+ *
+ * Disables the public link to one of the authenticated user's workouts.
+ */
+export const deleteWorkoutsByIdShare = <ThrowOnError extends boolean = false>(
+  options: Options<DeleteWorkoutsByIdShareData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    DeleteWorkoutsByIdShareResponses,
+    DeleteWorkoutsByIdShareErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/workouts/{id}/share",
+    ...options,
+  });
+
+/**
+ * Create workout share link
+ *
+ * Issues an unguessable public link to a read-only view of one of the authenticated user's workouts. Sharing again replaces the previous link. The request body is optional.
+ */
+export const postWorkoutsByIdShare = <ThrowOnError extends boolean = false>(
+  options: Options<PostWorkoutsByIdShareData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    PostWorkoutsByIdShareResponses,
+    PostWorkoutsByIdShareErrors,
+    ThrowOnError
+  >({
+    security: [{ name: "x-stack-access-token", type: "apiKey" }],
+    url: "/workouts/{id}/share",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
@@ -4,6 +4,59 @@ export type ClientOptions = {
   baseUrl: `${string}://${string}/api` | (string & {});
 };
 
+export type AccesstokensCreateTokenRequest = {
+  expires_in_days?: number;
+  name?: string;
+  scopes?: Array<string>;
+};
+
+export type AccesstokensCreatedTokenResponse = {
+  created_at?: string;
+  expires_at?: string;
+  id?: number;
+  last_used_at?: string;
+  name?: string;
+  prefix?: string;
+  scopes?: Array<string>;
+  token?: string;
+};
+
+export type AccesstokensTokenResponse = {
+  created_at?: string;
+  expires_at?: string;
+  id?: number;
+  last_used_at?: string;
+  name?: string;
+  prefix?: string;
+  scopes?: Array<string>;
+};
+
+export type AccountTimezoneResponse = {
+  source?: "stored" | "default";
+  timezone?: string;
+};
+
+export type AccountUpdateTimezoneRequest = {
+  timezone?: string | null;
+};
+
+export type AchievementsAchievementResponse = {
+  current?: number;
+  description?: string;
+  earned?: boolean;
+  key?: string;
+  name?: string;
+  progress?: number;
+  target?: number;
+  unlocked_at?: string;
+  workout_id?: number;
+};
+
+export type AchievementsAchievementsResponse = {
+  earned?: Array<AchievementsAchievementResponse>;
+  in_progress?: Array<AchievementsAchievementResponse>;
+};
+
 export type AichatChatMessage = {
   completed_at?: string;
   content?: string;
@@ -99,6 +152,274 @@ export type AichatStreamEvent = {
   workout_draft?: WorkoutCreateWorkoutRequest;
 };
 
+export type AnalyticsConsistencyResponse = {
+  allowed_rest_days?: number;
+  current_week_sessions?: number;
+  current_week_target_reached?: boolean;
+  daily_streak?: AnalyticsStreakSummary;
+  last_workout_date?: string;
+  rolling_weeks?: number;
+  sessions_per_week_avg?: number;
+  target_sessions_per_week?: number;
+  timezone?: string;
+  total_sessions?: number;
+  training_days?: number;
+  weekly_sessions?: Array<AnalyticsWeeklySessions>;
+  weekly_streak?: AnalyticsStreakSummary;
+};
+
+export type AnalyticsExercisePlateau = {
+  avg_reps?: number;
+  exercise_id?: number;
+  exercise_name?: string;
+  first_session?: string;
+  interventions?: Array<AnalyticsPlateauIntervention>;
+  last_session?: string;
+  percent_per_week?: number;
+  prior_best_e1rm?: number;
+  sessions?: number;
+  slope_per_week?: number;
+  status?: string;
+  window_best_e1rm?: number;
+};
+
+export type AnalyticsPlateauIntervention = {
+  message?: string;
+  type?: string;
+};
+
+export type AnalyticsPlateauResponse = {
+  exercises?: Array<AnalyticsExercisePlateau>;
+  flagged?: number;
+  min_sessions?: number;
+  weeks?: number;
+};
+
+export type AnalyticsStreakSummary = {
+  current?: number;
+  current_start?: string;
+  longest?: number;
+  longest_end?: string;
+  longest_start?: string;
+};
+
+export type AnalyticsTrainingLoadPoint = {
+  acute_load?: number;
+  acwr?: number;
+  chronic_load?: number;
+  date?: string;
+  load?: number;
+};
+
+export type AnalyticsTrainingLoadResponse = {
+  current?: AnalyticsTrainingLoadSummary;
+  days?: number;
+  metric?: string;
+  points?: Array<AnalyticsTrainingLoadPoint>;
+  timezone?: string;
+  warnings?: Array<AnalyticsTrainingLoadWarning>;
+};
+
+export type AnalyticsTrainingLoadSummary = {
+  acute_load?: number;
+  acwr?: number;
+  chronic_load?: number;
+  date?: string;
+  monotony?: number;
+  sessions_28d?: number;
+  sessions_7d?: number;
+  strain?: number;
+};
+
+export type AnalyticsTrainingLoadWarning = {
+  code?: string;
+  message?: string;
+  severity?: string;
+};
+
+export type AnalyticsVolumePeriod = {
+  by_set_type?: {
+    [key: string]: AnalyticsVolumeTotals;
+  };
+  period_start?: string;
+  reps?: number;
+  sets?: number;
+  tonnage?: number;
+};
+
+export type AnalyticsVolumeResponse = {
+  bucket?: string;
+  by_set_type?: {
+    [key: string]: AnalyticsVolumeTotals;
+  };
+  exclude_tags?: Array<string>;
+  focus?: string;
+  from?: string;
+  periods?: Array<AnalyticsVolumePeriod>;
+  tags?: Array<string>;
+  timezone?: string;
+  to?: string;
+  totals?: AnalyticsVolumeTotals;
+};
+
+export type AnalyticsVolumeTotals = {
+  reps?: number;
+  sets?: number;
+  tonnage?: number;
+};
+
+export type AnalyticsWeeklySessions = {
+  rolling_average?: number;
+  sessions?: number;
+  target_met?: boolean;
+  week_start?: string;
+};
+
+export type BodymetricsEntryRequest = {
+  arm?: number;
+  body_fat_percent?: number;
+  bodyweight?: number;
+  chest?: number;
+  hips?: number;
+  measured_on?: string;
+  neck?: number;
+  notes?: string;
+  thigh?: number;
+  waist?: number;
+};
+
+export type BodymetricsEntryResponse = {
+  arm?: number;
+  body_fat_percent?: number;
+  bodyweight?: number;
+  chest?: number;
+  created_at?: string;
+  hips?: number;
+  id?: number;
+  measured_on?: string;
+  neck?: number;
+  notes?: string;
+  thigh?: number;
+  updated_at?: string;
+  waist?: number;
+};
+
+export type BodymetricsTrendPoint = {
+  date?: string;
+  moving_average?: number;
+  value?: number;
+};
+
+export type BodymetricsTrendResponse = {
+  days?: number;
+  latest?: number;
+  latest_moving_average?: number;
+  metric?: string;
+  moving_average_change?: number;
+  points?: Array<BodymetricsTrendPoint>;
+  window_days?: number;
+};
+
+export type CalendarFeedStatusResponse = {
+  created_at?: string;
+  enabled?: boolean;
+  rotated_at?: string;
+};
+
+export type CalendarFeedTokenResponse = {
+  created_at?: string;
+  rotated_at?: string;
+  token?: string;
+  url?: string;
+  webcal_url?: string;
+};
+
+export type CoachingAcceptInvitationRequest = {
+  code?: string;
+};
+
+export type CoachingInvitationRequest = {
+  scopes?: Array<string>;
+};
+
+export type CoachingInvitationResponse = {
+  code?: string;
+  created_at?: string;
+  expires_at?: string;
+  id?: number;
+  scopes?: Array<string>;
+};
+
+export type CoachingLinkResponse = {
+  athlete_id?: string;
+  coach_id?: string;
+  id?: number;
+  linked_at?: string;
+  scopes?: Array<string>;
+};
+
+export type CoachingSuggestedExercise = {
+  name?: string;
+  reps?: number;
+  sets?: number;
+  weight?: number;
+};
+
+export type CoachingSuggestionRequest = {
+  exercises?: Array<CoachingSuggestedExercise>;
+  notes?: string;
+  scheduled_on?: string;
+  title?: string;
+};
+
+export type CoachingSuggestionResponse = {
+  coach_id?: string;
+  created_at?: string;
+  exercises?: Array<CoachingSuggestedExercise>;
+  id?: number;
+  notes?: string;
+  scheduled_on?: string;
+  title?: string;
+};
+
+export type CoachingUpdateScopesRequest = {
+  scopes?: Array<string>;
+};
+
+export type EquipmentInventoryResponse = {
+  barbell_weights?: Array<number>;
+  dumbbell_increment?: number;
+  is_default?: boolean;
+  machine_stack_step?: number;
+  plates?: Array<EquipmentPlate>;
+  updated_at?: string;
+};
+
+export type EquipmentPlate = {
+  pairs?: number;
+  weight?: number;
+};
+
+export type EquipmentPlateBreakdown = {
+  bar_weight?: number;
+  exact?: boolean;
+  per_side?: Array<EquipmentPlateCount>;
+  requested_weight?: number;
+  weight?: number;
+};
+
+export type EquipmentPlateCount = {
+  count?: number;
+  weight?: number;
+};
+
+export type EquipmentUpdateInventoryRequest = {
+  barbell_weights?: Array<number>;
+  dumbbell_increment?: number;
+  machine_stack_step?: number;
+  plates?: Array<EquipmentPlate>;
+};
+
 export type ExerciseCreateExerciseRequest = {
   name: string;
 };
@@ -119,6 +440,17 @@ export type ExerciseExerciseDetailExerciseResponse = {
   historical_1rm_updated_at?: string;
   id: number;
   name: string;
+  /**
+   * Notes and Settings come from the latest saved exercise note revision.
+   */
+  notes?: string;
+  notes_updated_at?: string;
+  settings?: string;
+  /**
+   * StrengthClassification is set when the exercise is the user's
+   * designated squat, bench or deadlift and a sex and bodyweight are known.
+   */
+  strength_classification?: StrengthLiftClassification;
   updated_at: string;
   user_id: string;
 };
@@ -128,6 +460,18 @@ export type ExerciseExerciseDetailResponse = {
   sets: Array<ExerciseExerciseWithSetsResponse>;
 };
 
+export type ExerciseExerciseMetricsComparisonPoint = {
+  date?: string;
+  percent_of_1rm?: number;
+  session_avg_e1rm?: number;
+  session_avg_intensity?: number;
+  session_best_e1rm?: number;
+  session_best_intensity?: number;
+  total_volume_working?: number;
+  workout_id?: number;
+  x?: string;
+};
+
 export type ExerciseExerciseMetricsHistoryPoint = {
   date?: string;
   session_avg_e1rm?: number;
@@ -141,8 +485,29 @@ export type ExerciseExerciseMetricsHistoryPoint = {
 
 export type ExerciseExerciseMetricsHistoryResponse = {
   bucket?: ExerciseMetricsHistoryBucket;
+  from?: string;
   points?: Array<ExerciseExerciseMetricsHistoryPoint>;
   range?: string;
+  to?: string;
+};
+
+export type ExerciseExerciseMetricsSeries = {
+  exercise_id?: number;
+  name?: string;
+  points?: Array<ExerciseExerciseMetricsComparisonPoint>;
+  reference_1rm?: number;
+};
+
+export type ExerciseExerciseNoteResponse = {
+  created_at: string;
+  id: number;
+  notes?: string;
+  settings?: string;
+};
+
+export type ExerciseExerciseNotesResponse = {
+  current?: ExerciseExerciseNoteResponse;
+  history: Array<ExerciseExerciseNoteResponse>;
 };
 
 export type ExerciseExerciseResponse = {
@@ -159,6 +524,7 @@ export type ExerciseExerciseWithSetsResponse = {
   exercise_order?: number;
   reps: number;
   set_id: number;
+  set_notes?: string;
   set_order?: number;
   set_type: string;
   volume: number;
@@ -168,11 +534,34 @@ export type ExerciseExerciseWithSetsResponse = {
   workout_notes?: string;
 };
 
+export type ExerciseExercisesMetricsHistoryResponse = {
+  axis?: Array<ExerciseMetricsHistoryAxisPoint>;
+  bucket?: ExerciseMetricsHistoryBucket;
+  from?: string;
+  range?: string;
+  series?: Array<ExerciseExerciseMetricsSeries>;
+  to?: string;
+};
+
+export type ExerciseMetricsHistoryAxisPoint = {
+  date?: string;
+  workout_id?: number;
+  x?: string;
+};
+
 export const ExerciseMetricsHistoryBucket = {
   /**
    * MetricsHistoryBucketWorkout
    */
   METRICS_HISTORY_BUCKET_WORKOUT: "workout",
+  /**
+   * MetricsHistoryBucketWeek
+   */
+  METRICS_HISTORY_BUCKET_WEEK: "week",
+  /**
+   * MetricsHistoryBucketMonth
+   */
+  METRICS_HISTORY_BUCKET_MONTH: "month",
 } as const;
 
 export type ExerciseMetricsHistoryBucket =
@@ -180,7 +569,9 @@ export type ExerciseMetricsHistoryBucket =
 
 export type ExerciseRecentSetsResponse = {
   created_at: string;
+  exercise_notes?: string;
   exercise_order?: number;
+  exercise_settings?: string;
   reps: number;
   set_id: number;
   set_order?: number;
@@ -198,6 +589,11 @@ export type ExerciseUpdateExerciseNameRequest = {
   name: string;
 };
 
+export type ExerciseUpdateExerciseNotesRequest = {
+  notes?: string;
+  settings?: string;
+};
+
 export type FeatureaccessFeatureAccessResponse = {
   created_at: string;
   expires_at?: string;
@@ -209,6 +605,119 @@ export type FeatureaccessFeatureAccessResponse = {
   starts_at: string;
 };
 
+export type GoalsCreateGoalRequest = {
+  exercise_id?: number;
+  target_date?: string;
+  target_value?: number;
+  type?: string;
+};
+
+export type GoalsGoalResponse = {
+  created_at?: string;
+  current_value?: number;
+  exercise_id?: number;
+  exercise_name?: string;
+  id?: number;
+  progress_percent?: number;
+  projected_date?: string;
+  rate_per_week?: number;
+  start_value?: number;
+  status?: string;
+  target_date?: string;
+  target_value?: number;
+  type?: string;
+};
+
+export type GoalsUpdateGoalRequest = {
+  target_date?: string;
+  target_value?: number;
+};
+
+export type NotificationsMarkAllReadResponse = {
+  updated?: number;
+};
+
+export type NotificationsNotificationResponse = {
+  body?: string;
+  created_at?: string;
+  data?: {
+    [key: string]: unknown;
+  };
+  id?: number;
+  kind?: string;
+  read_at?: string;
+  title?: string;
+};
+
+export type NotificationsNotificationsResponse = {
+  next_before_id?: number;
+  notifications?: Array<NotificationsNotificationResponse>;
+};
+
+export type NotificationsStreamEvent = {
+  notification?: NotificationsNotificationResponse;
+  unread_count?: number;
+};
+
+export type NotificationsUnreadCountResponse = {
+  count?: number;
+};
+
+export type ReportExerciseImprovement = {
+  change?: number;
+  change_percent?: number;
+  exercise_id?: number;
+  exercise_name?: string;
+  period_best_e1rm?: number;
+  prior_best_e1rm?: number;
+};
+
+export type ReportFocusBreakdown = {
+  focus?: string;
+  sessions?: number;
+  tonnage?: number;
+  working_sets?: number;
+};
+
+export type ReportHistorical1RmImprovement = {
+  exercise_id?: number;
+  exercise_name?: string;
+  historical_1rm?: number;
+  source_workout_id?: number;
+  updated_on?: string;
+};
+
+export type ReportPeriod = {
+  end?: string;
+  start?: string;
+  timezone?: string;
+  type?: string;
+};
+
+export type ReportPeriodComparison = {
+  previous?: ReportPeriod;
+  previous_totals?: ReportTotals;
+  sessions_change?: number;
+  tonnage_change?: number;
+  tonnage_change_percent?: number;
+  working_sets_change?: number;
+};
+
+export type ReportReport = {
+  comparison?: ReportPeriodComparison;
+  focus_breakdown?: Array<ReportFocusBreakdown>;
+  historical_1rm_improvements?: Array<ReportHistorical1RmImprovement>;
+  most_improved?: Array<ReportExerciseImprovement>;
+  period?: ReportPeriod;
+  totals?: ReportTotals;
+};
+
+export type ReportTotals = {
+  sessions?: number;
+  tonnage?: number;
+  working_sets?: number;
+};
+
 export type ResponseError = {
   message?: string;
   request_id?: string;
@@ -223,6 +732,109 @@ export type ResponseSuccessResponse = {
   success?: boolean;
 };
 
+export type SearchHit = {
+  conversation_id?: number;
+  id?: number;
+  occurred_at?: string;
+  rank?: number;
+  snippet?: string;
+  title?: string;
+  type?: string;
+  workout_id?: number;
+};
+
+export type SearchSearchResponse = {
+  hits?: Array<SearchHit>;
+  limit?: number;
+  next_offset?: number;
+  offset?: number;
+  query?: string;
+};
+
+export type ShareCreateShareRequest = {
+  expires_in_days?: number;
+};
+
+export type ShareShareLinkResponse = {
+  created_at?: string;
+  expires_at?: string;
+  token?: string;
+  url?: string;
+};
+
+export type StrengthLiftClassification = {
+  bodyweight?: number;
+  e1rm?: number;
+  level?: string;
+  lift?: string;
+  next_level?: string;
+  next_level_e1rm?: number;
+  ratio?: number;
+};
+
+export type StrengthLiftScore = {
+  classification?: StrengthLiftClassification;
+  e1rm?: number;
+  exercise_id?: number;
+  lift?: string;
+};
+
+export type StrengthProfileResponse = {
+  bench_exercise_id?: number;
+  deadlift_exercise_id?: number;
+  sex?: string;
+  squat_exercise_id?: number;
+};
+
+export type StrengthScoreHistoryPoint = {
+  bench?: number;
+  bodyweight?: number;
+  date?: string;
+  deadlift?: number;
+  dots?: number;
+  ipf_gl?: number;
+  squat?: number;
+  total?: number;
+  wilks?: number;
+};
+
+export type StrengthScoreHistoryResponse = {
+  missing_inputs?: Array<string>;
+  points?: Array<StrengthScoreHistoryPoint>;
+  sex?: string;
+};
+
+export type StrengthScoresResponse = {
+  bodyweight?: number;
+  bodyweight_date?: string;
+  dots?: number;
+  ipf_gl?: number;
+  lifts?: Array<StrengthLiftScore>;
+  missing_inputs?: Array<string>;
+  sex?: string;
+  total?: number;
+  wilks?: number;
+};
+
+export type StrengthUpdateProfileRequest = {
+  bench_exercise_id?: number;
+  deadlift_exercise_id?: number;
+  sex?: string;
+  squat_exercise_id?: number;
+};
+
+export type TagTagRequest = {
+  name?: string;
+};
+
+export type TagTagResponse = {
+  created_at?: string;
+  id?: number;
+  name?: string;
+  updated_at?: string;
+  workout_count?: number;
+};
+
 export type TrainingprofileProfileResponse = {
   available_equipment?: Array<string>;
   avoided_exercises?: Array<string>;
@@ -243,6 +855,59 @@ export type TrainingprofileUpdateProfileRequest = {
   usual_training_location?: string;
 };
 
+export type WebhooksCreateEndpointRequest = {
+  event_types?: Array<string>;
+  url?: string;
+};
+
+export type WebhooksCreatedEndpointResponse = {
+  active?: boolean;
+  created_at?: string;
+  event_types?: Array<string>;
+  id?: number;
+  secret?: string;
+  updated_at?: string;
+  url?: string;
+};
+
+export type WebhooksDeliveryResponse = {
+  attempt_count?: number;
+  created_at?: string;
+  delivered_at?: string;
+  event_id?: string;
+  event_type?: string;
+  id?: number;
+  last_attempt_at?: string;
+  last_error?: string;
+  next_attempt_at?: string;
+  payload?: {
+    [key: string]: unknown;
+  };
+  response_status?: number;
+  status?: string;
+};
+
+export type WebhooksEndpointResponse = {
+  active?: boolean;
+  created_at?: string;
+  event_types?: Array<string>;
+  id?: number;
+  updated_at?: string;
+  url?: string;
+};
+
+export type WebhooksUpdateEndpointRequest = {
+  active?: boolean;
+  event_types?: Array<string>;
+  url?: string;
+};
+
+export type WorkoutComparedWorkoutResponse = {
+  date: string;
+  focus?: string;
+  workoutId: number;
+};
+
 export type WorkoutContributionDataResponse = {
   days?: Array<WorkoutContributionDay>;
 };
@@ -258,7 +923,29 @@ export type WorkoutCreateWorkoutRequest = {
   date: string;
   exercises: Array<WorkoutExerciseInput>;
   notes?: string;
-  workoutFocus?: string;
+  tags?: Array<string>;
+  /**
+   * WarmupScheme adds a warm-up ramp to each exercise that has none.
+   */
+  warmupScheme?: "standard" | "quick" | "powerlifting";
+  workoutFocus?: string;
+};
+
+export type WorkoutExerciseComparisonResponse = {
+  a: WorkoutExerciseSessionResponse;
+  b: WorkoutExerciseSessionResponse;
+  delta: WorkoutExerciseDeltaResponse;
+  exerciseName: string;
+  matchedBy: string;
+};
+
+export type WorkoutExerciseDeltaResponse = {
+  e1rm?: number;
+  reps?: number;
+  sets?: number;
+  topSetReps?: number;
+  topSetWeight?: number;
+  volume?: number;
 };
 
 export type WorkoutExerciseInput = {
@@ -266,6 +953,16 @@ export type WorkoutExerciseInput = {
   sets: Array<WorkoutSetInput>;
 };
 
+export type WorkoutExerciseSessionResponse = {
+  e1rm: number;
+  exerciseId: number;
+  exerciseName: string;
+  reps: number;
+  sets: number;
+  topSet?: WorkoutTopSetResponse;
+  volume: number;
+};
+
 export type WorkoutFocusTemplateResponse = {
   date: string;
   focus: string;
@@ -281,20 +978,35 @@ export type WorkoutLatestWorkoutNoteResponse = {
 export type WorkoutNewWorkoutContextResponse = {
   focusTemplates?: Array<WorkoutFocusTemplateResponse>;
   latestWorkoutNote?: WorkoutLatestWorkoutNoteResponse;
+  plateauedExercises?: Array<WorkoutPlateauedExerciseResponse>;
+};
+
+export type WorkoutPlateauedExerciseResponse = {
+  exerciseId: number;
+  exerciseName: string;
+  status: string;
+  suggestion?: string;
 };
 
 export type WorkoutSetInput = {
+  notes?: string;
   reps: number;
   setType: "warmup" | "working";
   weight?: number;
 };
 
+export type WorkoutTopSetResponse = {
+  reps: number;
+  weight: number;
+};
+
 export type WorkoutUpdateExercise = {
   name: string;
   sets: Array<WorkoutUpdateSet>;
 };
 
 export type WorkoutUpdateSet = {
+  notes?: string;
   reps: number;
   setType: "warmup" | "working";
   weight?: number;
@@ -304,9 +1016,39 @@ export type WorkoutUpdateWorkoutRequest = {
   date: string;
   exercises: Array<WorkoutUpdateExercise>;
   notes?: string;
+  tags?: Array<string>;
   workoutFocus?: string;
 };
 
+export type WorkoutWarmupRequest = {
+  barWeight?: number;
+  exercise: string;
+  scheme?: "standard" | "quick" | "powerlifting";
+  steps?: Array<WorkoutWarmupStep>;
+  workingReps: number;
+  workingWeight?: number;
+};
+
+export type WorkoutWarmupResponse = {
+  exercise?: string;
+  loadKind?: string;
+  scheme?: string;
+  sets?: Array<WorkoutSetInput>;
+};
+
+export type WorkoutWarmupStep = {
+  percent?: number;
+  reps?: number;
+};
+
+export type WorkoutWorkoutComparisonResponse = {
+  a: WorkoutComparedWorkoutResponse;
+  b: WorkoutComparedWorkoutResponse;
+  exercises: Array<WorkoutExerciseComparisonResponse>;
+  onlyInA: Array<WorkoutExerciseSessionResponse>;
+  onlyInB: Array<WorkoutExerciseSessionResponse>;
+};
+
 /**
  * Workout response model
  */
@@ -323,6 +1065,7 @@ export type WorkoutWorkoutResponse = {
 export type WorkoutWorkoutSummary = {
   focus?: string;
   id?: number;
+  tags?: Array<string>;
   time?: string;
   volume?: number;
 };
@@ -333,6 +1076,7 @@ export type WorkoutWorkoutWithSetsResponse = {
   exercise_order?: number;
   reps: number;
   set_id: number;
+  set_notes?: string;
   set_order?: number;
   set_type: string;
   volume: number;
@@ -341,8 +1085,219 @@ export type WorkoutWorkoutWithSetsResponse = {
   workout_focus?: string;
   workout_id: number;
   workout_notes?: string;
+  workout_tags?: Array<string>;
+};
+
+export type GetAccessTokensData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/access-tokens";
+};
+
+export type GetAccessTokensErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetAccessTokensError =
+  GetAccessTokensErrors[keyof GetAccessTokensErrors];
+
+export type GetAccessTokensResponses = {
+  /**
+   * OK
+   */
+  200: Array<AccesstokensTokenResponse>;
+};
+
+export type GetAccessTokensResponse =
+  GetAccessTokensResponses[keyof GetAccessTokensResponses];
+
+export type PostAccessTokensData = {
+  /**
+   * Access token
+   */
+  body: AccesstokensCreateTokenRequest;
+  path?: never;
+  query?: never;
+  url: "/access-tokens";
+};
+
+export type PostAccessTokensErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostAccessTokensError =
+  PostAccessTokensErrors[keyof PostAccessTokensErrors];
+
+export type PostAccessTokensResponses = {
+  /**
+   * Created
+   */
+  201: AccesstokensCreatedTokenResponse;
+};
+
+export type PostAccessTokensResponse =
+  PostAccessTokensResponses[keyof PostAccessTokensResponses];
+
+export type DeleteAccessTokensByIdData = {
+  body?: never;
+  path: {
+    /**
+     * Access token ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/access-tokens/{id}";
+};
+
+export type DeleteAccessTokensByIdErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type DeleteAccessTokensByIdError =
+  DeleteAccessTokensByIdErrors[keyof DeleteAccessTokensByIdErrors];
+
+export type DeleteAccessTokensByIdResponses = {
+  /**
+   * No Content
+   */
+  204: unknown;
+};
+
+export type GetAccountTimezoneData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/account/timezone";
+};
+
+export type GetAccountTimezoneErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetAccountTimezoneError =
+  GetAccountTimezoneErrors[keyof GetAccountTimezoneErrors];
+
+export type GetAccountTimezoneResponses = {
+  /**
+   * OK
+   */
+  200: AccountTimezoneResponse;
+};
+
+export type GetAccountTimezoneResponse =
+  GetAccountTimezoneResponses[keyof GetAccountTimezoneResponses];
+
+export type PutAccountTimezoneData = {
+  /**
+   * Timezone
+   */
+  body: AccountUpdateTimezoneRequest;
+  path?: never;
+  query?: never;
+  url: "/account/timezone";
+};
+
+export type PutAccountTimezoneErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PutAccountTimezoneError =
+  PutAccountTimezoneErrors[keyof PutAccountTimezoneErrors];
+
+export type PutAccountTimezoneResponses = {
+  /**
+   * OK
+   */
+  200: AccountTimezoneResponse;
+};
+
+export type PutAccountTimezoneResponse =
+  PutAccountTimezoneResponses[keyof PutAccountTimezoneResponses];
+
+export type GetAchievementsData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/achievements";
+};
+
+export type GetAchievementsErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetAchievementsError =
+  GetAchievementsErrors[keyof GetAchievementsErrors];
+
+export type GetAchievementsResponses = {
+  /**
+   * OK
+   */
+  200: AchievementsAchievementsResponse;
 };
 
+export type GetAchievementsResponse =
+  GetAchievementsResponses[keyof GetAchievementsResponses];
+
 export type PostAiChatTelemetryData = {
   /**
    * Telemetry event
@@ -845,14 +1800,35 @@ export type PostAiConversationsByIdRunsByRunIdStopResponses = {
 export type PostAiConversationsByIdRunsByRunIdStopResponse =
   PostAiConversationsByIdRunsByRunIdStopResponses[keyof PostAiConversationsByIdRunsByRunIdStopResponses];
 
-export type GetExercisesData = {
+export type GetAnalyticsConsistencyData = {
   body?: never;
   path?: never;
-  query?: never;
-  url: "/exercises";
+  query?: {
+    /**
+     * IANA timezone used for day and week boundaries; defaults to the account timezone, then UTC
+     */
+    timezone?: string;
+    /**
+     * Sessions needed for a week to count toward the weekly streak
+     */
+    target_sessions_per_week?: number;
+    /**
+     * Untrained days allowed between sessions without breaking the daily streak
+     */
+    allowed_rest_days?: number;
+    /**
+     * Window size for the sessions-per-week rolling average
+     */
+    rolling_weeks?: number;
+  };
+  url: "/analytics/consistency";
 };
 
-export type GetExercisesErrors = {
+export type GetAnalyticsConsistencyErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
   /**
    * Unauthorized
    */
@@ -863,29 +1839,36 @@ export type GetExercisesErrors = {
   500: ResponseErrorResponse;
 };
 
-export type GetExercisesError = GetExercisesErrors[keyof GetExercisesErrors];
+export type GetAnalyticsConsistencyError =
+  GetAnalyticsConsistencyErrors[keyof GetAnalyticsConsistencyErrors];
 
-export type GetExercisesResponses = {
+export type GetAnalyticsConsistencyResponses = {
   /**
    * OK
    */
-  200: Array<ExerciseExerciseResponse>;
+  200: AnalyticsConsistencyResponse;
 };
 
-export type GetExercisesResponse =
-  GetExercisesResponses[keyof GetExercisesResponses];
+export type GetAnalyticsConsistencyResponse =
+  GetAnalyticsConsistencyResponses[keyof GetAnalyticsConsistencyResponses];
 
-export type PostExercisesData = {
-  /**
-   * Exercise data
-   */
-  body: ExerciseCreateExerciseRequest;
+export type GetAnalyticsPlateausData = {
+  body?: never;
   path?: never;
-  query?: never;
-  url: "/exercises";
+  query?: {
+    /**
+     * Analysis window in weeks
+     */
+    weeks?: number;
+    /**
+     * Sessions an exercise needs within the window to be assessed
+     */
+    min_sessions?: number;
+  };
+  url: "/analytics/plateaus";
 };
 
-export type PostExercisesErrors = {
+export type GetAnalyticsPlateausErrors = {
   /**
    * Bad Request
    */
@@ -900,72 +1883,104 @@ export type PostExercisesErrors = {
   500: ResponseErrorResponse;
 };
 
-export type PostExercisesError = PostExercisesErrors[keyof PostExercisesErrors];
+export type GetAnalyticsPlateausError =
+  GetAnalyticsPlateausErrors[keyof GetAnalyticsPlateausErrors];
 
-export type PostExercisesResponses = {
+export type GetAnalyticsPlateausResponses = {
   /**
    * OK
    */
-  200: ExerciseCreateExerciseResponse;
+  200: AnalyticsPlateauResponse;
 };
 
-export type PostExercisesResponse =
-  PostExercisesResponses[keyof PostExercisesResponses];
+export type GetAnalyticsPlateausResponse =
+  GetAnalyticsPlateausResponses[keyof GetAnalyticsPlateausResponses];
 
-export type DeleteExercisesByIdData = {
+export type GetAnalyticsTrainingLoadData = {
   body?: never;
-  path: {
+  path?: never;
+  query?: {
     /**
-     * Exercise ID
+     * IANA timezone used for day boundaries; defaults to the account timezone, then UTC
      */
-    id: number;
+    timezone?: string;
+    /**
+     * Per-session load measure
+     */
+    metric?: "tonnage" | "hard_sets";
+    /**
+     * Number of daily points to return
+     */
+    days?: number;
   };
-  query?: never;
-  url: "/exercises/{id}";
+  url: "/analytics/training-load";
 };
 
-export type DeleteExercisesByIdErrors = {
+export type GetAnalyticsTrainingLoadErrors = {
   /**
-   * Bad Request - Invalid exercise ID
+   * Bad Request
    */
   400: ResponseErrorResponse;
   /**
-   * Unauthorized - Invalid token
+   * Unauthorized
    */
   401: ResponseErrorResponse;
-  /**
-   * Not Found - Exercise not found or doesn't belong to user
-   */
-  404: ResponseErrorResponse;
   /**
    * Internal Server Error
    */
   500: ResponseErrorResponse;
 };
 
-export type DeleteExercisesByIdError =
-  DeleteExercisesByIdErrors[keyof DeleteExercisesByIdErrors];
+export type GetAnalyticsTrainingLoadError =
+  GetAnalyticsTrainingLoadErrors[keyof GetAnalyticsTrainingLoadErrors];
 
-export type DeleteExercisesByIdResponses = {
+export type GetAnalyticsTrainingLoadResponses = {
   /**
-   * No Content - Exercise deleted successfully
+   * OK
    */
-  204: unknown;
+  200: AnalyticsTrainingLoadResponse;
 };
 
-export type GetExercisesByIdData = {
+export type GetAnalyticsTrainingLoadResponse =
+  GetAnalyticsTrainingLoadResponses[keyof GetAnalyticsTrainingLoadResponses];
+
+export type GetAnalyticsVolumeData = {
   body?: never;
-  path: {
+  path?: never;
+  query?: {
     /**
-     * Exercise ID
+     * IANA timezone used for period boundaries; defaults to the account timezone, then UTC
      */
-    id: number;
+    timezone?: string;
+    /**
+     * Period size; weeks start on Monday
+     */
+    bucket?: "day" | "week" | "month";
+    /**
+     * First local day (YYYY-MM-DD); defaults to 30 days, 26 weeks or 12 months before to
+     */
+    from?: string;
+    /**
+     * Last local day (YYYY-MM-DD); defaults to today
+     */
+    to?: string;
+    /**
+     * Only count workouts with this focus (case-insensitive)
+     */
+    focus?: string;
+    /**
+     * Only count workouts carrying every tag (repeat or comma-separate)
+     */
+    tag?: Array<string>;
+    /**
+     * Skip workouts carrying any of these tags
+     */
+    excludeTag?: Array<string>;
   };
-  query?: never;
-  url: "/exercises/{id}";
+  url: "/analytics/volume";
 };
 
-export type GetExercisesByIdErrors = {
+export type GetAnalyticsVolumeErrors = {
   /**
    * Bad Request
    */
@@ -975,138 +1990,2271 @@ export type GetExercisesByIdErrors = {
    */
   401: ResponseErrorResponse;
   /**
-   * Not Found - Exercise not found or doesn't belong to user
+   * Internal Server Error
    */
-  404: ResponseErrorResponse;
+  500: ResponseErrorResponse;
+};
+
+export type GetAnalyticsVolumeError =
+  GetAnalyticsVolumeErrors[keyof GetAnalyticsVolumeErrors];
+
+export type GetAnalyticsVolumeResponses = {
+  /**
+   * OK
+   */
+  200: AnalyticsVolumeResponse;
+};
+
+export type GetAnalyticsVolumeResponse =
+  GetAnalyticsVolumeResponses[keyof GetAnalyticsVolumeResponses];
+
+export type GetBodyMetricsData = {
+  body?: never;
+  path?: never;
+  query?: {
+    /**
+     * Earliest measured date (YYYY-MM-DD)
+     */
+    from?: string;
+    /**
+     * Latest measured date (YYYY-MM-DD)
+     */
+    to?: string;
+    /**
+     * Maximum entries to return
+     */
+    limit?: number;
+  };
+  url: "/body-metrics";
+};
+
+export type GetBodyMetricsErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
   /**
    * Internal Server Error
    */
   500: ResponseErrorResponse;
 };
 
-export type GetExercisesByIdError =
-  GetExercisesByIdErrors[keyof GetExercisesByIdErrors];
+export type GetBodyMetricsError =
+  GetBodyMetricsErrors[keyof GetBodyMetricsErrors];
 
-export type GetExercisesByIdResponses = {
+export type GetBodyMetricsResponses = {
   /**
-   * Success (sets may be empty)
+   * OK
    */
-  200: ExerciseExerciseDetailResponse;
+  200: Array<BodymetricsEntryResponse>;
 };
 
-export type GetExercisesByIdResponse =
-  GetExercisesByIdResponses[keyof GetExercisesByIdResponses];
+export type GetBodyMetricsResponse =
+  GetBodyMetricsResponses[keyof GetBodyMetricsResponses];
 
-export type PatchExercisesByIdData = {
+export type PostBodyMetricsData = {
   /**
-   * Exercise name update request
+   * Body metric entry
    */
-  body: ExerciseUpdateExerciseNameRequest;
+  body: BodymetricsEntryRequest;
+  path?: never;
+  query?: never;
+  url: "/body-metrics";
+};
+
+export type PostBodyMetricsErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostBodyMetricsError =
+  PostBodyMetricsErrors[keyof PostBodyMetricsErrors];
+
+export type PostBodyMetricsResponses = {
+  /**
+   * Created
+   */
+  201: BodymetricsEntryResponse;
+};
+
+export type PostBodyMetricsResponse =
+  PostBodyMetricsResponses[keyof PostBodyMetricsResponses];
+
+export type GetBodyMetricsTrendData = {
+  body?: never;
+  path?: never;
+  query?: {
+    /**
+     * Metric to chart
+     */
+    metric?:
+      | "bodyweight"
+      | "body_fat_percent"
+      | "neck"
+      | "chest"
+      | "waist"
+      | "hips"
+      | "arm"
+      | "thigh";
+    /**
+     * Number of days to include
+     */
+    days?: number;
+    /**
+     * Moving average window in days
+     */
+    window?: number;
+  };
+  url: "/body-metrics/trend";
+};
+
+export type GetBodyMetricsTrendErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetBodyMetricsTrendError =
+  GetBodyMetricsTrendErrors[keyof GetBodyMetricsTrendErrors];
+
+export type GetBodyMetricsTrendResponses = {
+  /**
+   * OK
+   */
+  200: BodymetricsTrendResponse;
+};
+
+export type GetBodyMetricsTrendResponse =
+  GetBodyMetricsTrendResponses[keyof GetBodyMetricsTrendResponses];
+
+export type DeleteBodyMetricsByIdData = {
+  body?: never;
   path: {
     /**
-     * Exercise ID
+     * Body metric entry ID
      */
     id: number;
   };
   query?: never;
-  url: "/exercises/{id}";
+  url: "/body-metrics/{id}";
 };
 
-export type PatchExercisesByIdErrors = {
+export type DeleteBodyMetricsByIdErrors = {
   /**
-   * Bad Request - Invalid exercise ID or validation error
+   * Bad Request
    */
   400: ResponseErrorResponse;
   /**
-   * Unauthorized - Invalid token
+   * Unauthorized
    */
   401: ResponseErrorResponse;
   /**
-   * Not Found - Exercise not found or doesn't belong to user
+   * Not Found
    */
   404: ResponseErrorResponse;
   /**
-   * Conflict - Exercise name already exists
+   * Internal Server Error
    */
-  409: ResponseErrorResponse;
+  500: ResponseErrorResponse;
+};
+
+export type DeleteBodyMetricsByIdError =
+  DeleteBodyMetricsByIdErrors[keyof DeleteBodyMetricsByIdErrors];
+
+export type DeleteBodyMetricsByIdResponses = {
+  /**
+   * No Content
+   */
+  204: unknown;
+};
+
+export type GetBodyMetricsByIdData = {
+  body?: never;
+  path: {
+    /**
+     * Body metric entry ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/body-metrics/{id}";
+};
+
+export type GetBodyMetricsByIdErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
   /**
    * Internal Server Error
    */
   500: ResponseErrorResponse;
 };
 
-export type PatchExercisesByIdError =
-  PatchExercisesByIdErrors[keyof PatchExercisesByIdErrors];
+export type GetBodyMetricsByIdError =
+  GetBodyMetricsByIdErrors[keyof GetBodyMetricsByIdErrors];
 
-export type PatchExercisesByIdResponses = {
+export type GetBodyMetricsByIdResponses = {
   /**
-   * No Content - Exercise name updated successfully
+   * OK
    */
-  204: unknown;
+  200: BodymetricsEntryResponse;
 };
 
-export type PatchExercisesByIdHistorical1RmData = {
+export type GetBodyMetricsByIdResponse =
+  GetBodyMetricsByIdResponses[keyof GetBodyMetricsByIdResponses];
+
+export type PutBodyMetricsByIdData = {
   /**
-   * Historical 1RM update request
+   * Body metric entry
    */
-  body: ExerciseUpdateExerciseHistorical1RmRequest;
+  body: BodymetricsEntryRequest;
   path: {
     /**
-     * Exercise ID
+     * Body metric entry ID
      */
     id: number;
   };
   query?: never;
-  url: "/exercises/{id}/historical-1rm";
+  url: "/body-metrics/{id}";
 };
 
-export type PatchExercisesByIdHistorical1RmErrors = {
+export type PutBodyMetricsByIdErrors = {
   /**
-   * Bad Request - Invalid exercise ID or validation error
+   * Bad Request
    */
   400: ResponseErrorResponse;
   /**
-   * Unauthorized - Invalid token
+   * Unauthorized
    */
   401: ResponseErrorResponse;
   /**
-   * Not Found - Exercise not found or doesn't belong to user
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PutBodyMetricsByIdError =
+  PutBodyMetricsByIdErrors[keyof PutBodyMetricsByIdErrors];
+
+export type PutBodyMetricsByIdResponses = {
+  /**
+   * OK
+   */
+  200: BodymetricsEntryResponse;
+};
+
+export type PutBodyMetricsByIdResponse =
+  PutBodyMetricsByIdResponses[keyof PutBodyMetricsByIdResponses];
+
+export type DeleteCalendarFeedData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/calendar/feed";
+};
+
+export type DeleteCalendarFeedErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type DeleteCalendarFeedError =
+  DeleteCalendarFeedErrors[keyof DeleteCalendarFeedErrors];
+
+export type DeleteCalendarFeedResponses = {
+  /**
+   * No Content
+   */
+  204: unknown;
+};
+
+export type GetCalendarFeedData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/calendar/feed";
+};
+
+export type GetCalendarFeedErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetCalendarFeedError =
+  GetCalendarFeedErrors[keyof GetCalendarFeedErrors];
+
+export type GetCalendarFeedResponses = {
+  /**
+   * OK
+   */
+  200: CalendarFeedStatusResponse;
+};
+
+export type GetCalendarFeedResponse =
+  GetCalendarFeedResponses[keyof GetCalendarFeedResponses];
+
+export type PostCalendarFeedTokenData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/calendar/feed/token";
+};
+
+export type PostCalendarFeedTokenErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostCalendarFeedTokenError =
+  PostCalendarFeedTokenErrors[keyof PostCalendarFeedTokenErrors];
+
+export type PostCalendarFeedTokenResponses = {
+  /**
+   * Created
+   */
+  201: CalendarFeedTokenResponse;
+};
+
+export type PostCalendarFeedTokenResponse =
+  PostCalendarFeedTokenResponses[keyof PostCalendarFeedTokenResponses];
+
+export type GetCoachingAthletesData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/coaching/athletes";
+};
+
+export type GetCoachingAthletesErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetCoachingAthletesError =
+  GetCoachingAthletesErrors[keyof GetCoachingAthletesErrors];
+
+export type GetCoachingAthletesResponses = {
+  /**
+   * OK
+   */
+  200: Array<CoachingLinkResponse>;
+};
+
+export type GetCoachingAthletesResponse =
+  GetCoachingAthletesResponses[keyof GetCoachingAthletesResponses];
+
+export type PostCoachingAthletesByAthleteIdSuggestionsData = {
+  /**
+   * Suggested workout
+   */
+  body: CoachingSuggestionRequest;
+  path: {
+    /**
+     * Athlete user ID
+     */
+    athleteID: string;
+  };
+  query?: never;
+  url: "/coaching/athletes/{athleteID}/suggestions";
+};
+
+export type PostCoachingAthletesByAthleteIdSuggestionsErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostCoachingAthletesByAthleteIdSuggestionsError =
+  PostCoachingAthletesByAthleteIdSuggestionsErrors[keyof PostCoachingAthletesByAthleteIdSuggestionsErrors];
+
+export type PostCoachingAthletesByAthleteIdSuggestionsResponses = {
+  /**
+   * Created
+   */
+  201: CoachingSuggestionResponse;
+};
+
+export type PostCoachingAthletesByAthleteIdSuggestionsResponse =
+  PostCoachingAthletesByAthleteIdSuggestionsResponses[keyof PostCoachingAthletesByAthleteIdSuggestionsResponses];
+
+export type GetCoachingCoachesData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/coaching/coaches";
+};
+
+export type GetCoachingCoachesErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetCoachingCoachesError =
+  GetCoachingCoachesErrors[keyof GetCoachingCoachesErrors];
+
+export type GetCoachingCoachesResponses = {
+  /**
+   * OK
+   */
+  200: Array<CoachingLinkResponse>;
+};
+
+export type GetCoachingCoachesResponse =
+  GetCoachingCoachesResponses[keyof GetCoachingCoachesResponses];
+
+export type GetCoachingInvitationsData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/coaching/invitations";
+};
+
+export type GetCoachingInvitationsErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetCoachingInvitationsError =
+  GetCoachingInvitationsErrors[keyof GetCoachingInvitationsErrors];
+
+export type GetCoachingInvitationsResponses = {
+  /**
+   * OK
+   */
+  200: Array<CoachingInvitationResponse>;
+};
+
+export type GetCoachingInvitationsResponse =
+  GetCoachingInvitationsResponses[keyof GetCoachingInvitationsResponses];
+
+export type PostCoachingInvitationsData = {
+  /**
+   * Requested scopes
+   */
+  body: CoachingInvitationRequest;
+  path?: never;
+  query?: never;
+  url: "/coaching/invitations";
+};
+
+export type PostCoachingInvitationsErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostCoachingInvitationsError =
+  PostCoachingInvitationsErrors[keyof PostCoachingInvitationsErrors];
+
+export type PostCoachingInvitationsResponses = {
+  /**
+   * Created
+   */
+  201: CoachingInvitationResponse;
+};
+
+export type PostCoachingInvitationsResponse =
+  PostCoachingInvitationsResponses[keyof PostCoachingInvitationsResponses];
+
+export type PostCoachingInvitationsAcceptData = {
+  /**
+   * Invitation code
+   */
+  body: CoachingAcceptInvitationRequest;
+  path?: never;
+  query?: never;
+  url: "/coaching/invitations/accept";
+};
+
+export type PostCoachingInvitationsAcceptErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostCoachingInvitationsAcceptError =
+  PostCoachingInvitationsAcceptErrors[keyof PostCoachingInvitationsAcceptErrors];
+
+export type PostCoachingInvitationsAcceptResponses = {
+  /**
+   * Created
+   */
+  201: CoachingLinkResponse;
+};
+
+export type PostCoachingInvitationsAcceptResponse =
+  PostCoachingInvitationsAcceptResponses[keyof PostCoachingInvitationsAcceptResponses];
+
+export type DeleteCoachingLinksByIdData = {
+  body?: never;
+  path: {
+    /**
+     * Coaching link ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/coaching/links/{id}";
+};
+
+export type DeleteCoachingLinksByIdErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type DeleteCoachingLinksByIdError =
+  DeleteCoachingLinksByIdErrors[keyof DeleteCoachingLinksByIdErrors];
+
+export type DeleteCoachingLinksByIdResponses = {
+  /**
+   * No Content
+   */
+  204: unknown;
+};
+
+export type PutCoachingLinksByIdScopesData = {
+  /**
+   * Granted scopes
+   */
+  body: CoachingUpdateScopesRequest;
+  path: {
+    /**
+     * Coaching link ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/coaching/links/{id}/scopes";
+};
+
+export type PutCoachingLinksByIdScopesErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PutCoachingLinksByIdScopesError =
+  PutCoachingLinksByIdScopesErrors[keyof PutCoachingLinksByIdScopesErrors];
+
+export type PutCoachingLinksByIdScopesResponses = {
+  /**
+   * OK
+   */
+  200: CoachingLinkResponse;
+};
+
+export type PutCoachingLinksByIdScopesResponse =
+  PutCoachingLinksByIdScopesResponses[keyof PutCoachingLinksByIdScopesResponses];
+
+export type GetCoachingSuggestionsData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/coaching/suggestions";
+};
+
+export type GetCoachingSuggestionsErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetCoachingSuggestionsError =
+  GetCoachingSuggestionsErrors[keyof GetCoachingSuggestionsErrors];
+
+export type GetCoachingSuggestionsResponses = {
+  /**
+   * OK
+   */
+  200: Array<CoachingSuggestionResponse>;
+};
+
+export type GetCoachingSuggestionsResponse =
+  GetCoachingSuggestionsResponses[keyof GetCoachingSuggestionsResponses];
+
+export type DeleteCoachingSuggestionsByIdData = {
+  body?: never;
+  path: {
+    /**
+     * Suggestion ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/coaching/suggestions/{id}";
+};
+
+export type DeleteCoachingSuggestionsByIdErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type DeleteCoachingSuggestionsByIdError =
+  DeleteCoachingSuggestionsByIdErrors[keyof DeleteCoachingSuggestionsByIdErrors];
+
+export type DeleteCoachingSuggestionsByIdResponses = {
+  /**
+   * No Content
+   */
+  204: unknown;
+};
+
+export type GetEquipmentData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/equipment";
+};
+
+export type GetEquipmentErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetEquipmentError = GetEquipmentErrors[keyof GetEquipmentErrors];
+
+export type GetEquipmentResponses = {
+  /**
+   * OK
+   */
+  200: EquipmentInventoryResponse;
+};
+
+export type GetEquipmentResponse =
+  GetEquipmentResponses[keyof GetEquipmentResponses];
+
+export type PutEquipmentData = {
+  /**
+   * Equipment inventory
+   */
+  body: EquipmentUpdateInventoryRequest;
+  path?: never;
+  query?: never;
+  url: "/equipment";
+};
+
+export type PutEquipmentErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PutEquipmentError = PutEquipmentErrors[keyof PutEquipmentErrors];
+
+export type PutEquipmentResponses = {
+  /**
+   * OK
+   */
+  200: EquipmentInventoryResponse;
+};
+
+export type PutEquipmentResponse =
+  PutEquipmentResponses[keyof PutEquipmentResponses];
+
+export type GetExercisesData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/exercises";
+};
+
+export type GetExercisesErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetExercisesError = GetExercisesErrors[keyof GetExercisesErrors];
+
+export type GetExercisesResponses = {
+  /**
+   * OK
+   */
+  200: Array<ExerciseExerciseResponse>;
+};
+
+export type GetExercisesResponse =
+  GetExercisesResponses[keyof GetExercisesResponses];
+
+export type PostExercisesData = {
+  /**
+   * Exercise data
+   */
+  body: ExerciseCreateExerciseRequest;
+  path?: never;
+  query?: never;
+  url: "/exercises";
+};
+
+export type PostExercisesErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostExercisesError = PostExercisesErrors[keyof PostExercisesErrors];
+
+export type PostExercisesResponses = {
+  /**
+   * OK
+   */
+  200: ExerciseCreateExerciseResponse;
+};
+
+export type PostExercisesResponse =
+  PostExercisesResponses[keyof PostExercisesResponses];
+
+export type GetExercisesMetricsHistoryData = {
+  body?: never;
+  path?: never;
+  query: {
+    /**
+     * Comma-separated exercise IDs
+     */
+    ids: string;
+    /**
+     * Range selector; cannot be combined with from/to
+     */
+    range?: "W" | "M" | "6M" | "Y";
+    /**
+     * First local day to include (YYYY-MM-DD)
+     */
+    from?: string;
+    /**
+     * Last local day to include (YYYY-MM-DD)
+     */
+    to?: string;
+    /**
+     * Point grouping
+     */
+    bucket?: "workout" | "week" | "month";
+  };
+  url: "/exercises/metrics-history";
+};
+
+export type GetExercisesMetricsHistoryErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found - Exercise not found or doesn't belong to user
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetExercisesMetricsHistoryError =
+  GetExercisesMetricsHistoryErrors[keyof GetExercisesMetricsHistoryErrors];
+
+export type GetExercisesMetricsHistoryResponses = {
+  /**
+   * OK
+   */
+  200: ExerciseExercisesMetricsHistoryResponse;
+};
+
+export type GetExercisesMetricsHistoryResponse =
+  GetExercisesMetricsHistoryResponses[keyof GetExercisesMetricsHistoryResponses];
+
+export type DeleteExercisesByIdData = {
+  body?: never;
+  path: {
+    /**
+     * Exercise ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/exercises/{id}";
+};
+
+export type DeleteExercisesByIdErrors = {
+  /**
+   * Bad Request - Invalid exercise ID
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized - Invalid token
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found - Exercise not found or doesn't belong to user
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type DeleteExercisesByIdError =
+  DeleteExercisesByIdErrors[keyof DeleteExercisesByIdErrors];
+
+export type DeleteExercisesByIdResponses = {
+  /**
+   * No Content - Exercise deleted successfully
+   */
+  204: unknown;
+};
+
+export type GetExercisesByIdData = {
+  body?: never;
+  path: {
+    /**
+     * Exercise ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/exercises/{id}";
+};
+
+export type GetExercisesByIdErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found - Exercise not found or doesn't belong to user
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetExercisesByIdError =
+  GetExercisesByIdErrors[keyof GetExercisesByIdErrors];
+
+export type GetExercisesByIdResponses = {
+  /**
+   * Success (sets may be empty)
+   */
+  200: ExerciseExerciseDetailResponse;
+};
+
+export type GetExercisesByIdResponse =
+  GetExercisesByIdResponses[keyof GetExercisesByIdResponses];
+
+export type PatchExercisesByIdData = {
+  /**
+   * Exercise name update request
+   */
+  body: ExerciseUpdateExerciseNameRequest;
+  path: {
+    /**
+     * Exercise ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/exercises/{id}";
+};
+
+export type PatchExercisesByIdErrors = {
+  /**
+   * Bad Request - Invalid exercise ID or validation error
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized - Invalid token
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found - Exercise not found or doesn't belong to user
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Conflict - Exercise name already exists
+   */
+  409: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PatchExercisesByIdError =
+  PatchExercisesByIdErrors[keyof PatchExercisesByIdErrors];
+
+export type PatchExercisesByIdResponses = {
+  /**
+   * No Content - Exercise name updated successfully
+   */
+  204: unknown;
+};
+
+export type PatchExercisesByIdHistorical1RmData = {
+  /**
+   * Historical 1RM update request
+   */
+  body: ExerciseUpdateExerciseHistorical1RmRequest;
+  path: {
+    /**
+     * Exercise ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/exercises/{id}/historical-1rm";
+};
+
+export type PatchExercisesByIdHistorical1RmErrors = {
+  /**
+   * Bad Request - Invalid exercise ID or validation error
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized - Invalid token
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found - Exercise not found or doesn't belong to user
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PatchExercisesByIdHistorical1RmError =
+  PatchExercisesByIdHistorical1RmErrors[keyof PatchExercisesByIdHistorical1RmErrors];
+
+export type PatchExercisesByIdHistorical1RmResponses = {
+  /**
+   * No Content - Exercise historical 1RM updated successfully
+   */
+  204: unknown;
+};
+
+export type GetExercisesByIdMetricsHistoryData = {
+  body?: never;
+  path: {
+    /**
+     * Exercise ID
+     */
+    id: number;
+  };
+  query?: {
+    /**
+     * Range selector; cannot be combined with from/to
+     */
+    range?: "W" | "M" | "6M" | "Y";
+    /**
+     * First local day to include (YYYY-MM-DD)
+     */
+    from?: string;
+    /**
+     * Last local day to include (YYYY-MM-DD)
+     */
+    to?: string;
+    /**
+     * Point grouping
+     */
+    bucket?: "workout" | "week" | "month";
+  };
+  url: "/exercises/{id}/metrics-history";
+};
+
+export type GetExercisesByIdMetricsHistoryErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found - Exercise not found or doesn't belong to user
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetExercisesByIdMetricsHistoryError =
+  GetExercisesByIdMetricsHistoryErrors[keyof GetExercisesByIdMetricsHistoryErrors];
+
+export type GetExercisesByIdMetricsHistoryResponses = {
+  /**
+   * OK
+   */
+  200: ExerciseExerciseMetricsHistoryResponse;
+};
+
+export type GetExercisesByIdMetricsHistoryResponse =
+  GetExercisesByIdMetricsHistoryResponses[keyof GetExercisesByIdMetricsHistoryResponses];
+
+export type GetExercisesByIdNotesData = {
+  body?: never;
+  path: {
+    /**
+     * Exercise ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/exercises/{id}/notes";
+};
+
+export type GetExercisesByIdNotesErrors = {
+  /**
+   * Bad Request - Invalid exercise ID
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found - Exercise not found or doesn't belong to user
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetExercisesByIdNotesError =
+  GetExercisesByIdNotesErrors[keyof GetExercisesByIdNotesErrors];
+
+export type GetExercisesByIdNotesResponses = {
+  /**
+   * OK
+   */
+  200: ExerciseExerciseNotesResponse;
+};
+
+export type GetExercisesByIdNotesResponse =
+  GetExercisesByIdNotesResponses[keyof GetExercisesByIdNotesResponses];
+
+export type PutExercisesByIdNotesData = {
+  /**
+   * Exercise notes
+   */
+  body: ExerciseUpdateExerciseNotesRequest;
+  path: {
+    /**
+     * Exercise ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/exercises/{id}/notes";
+};
+
+export type PutExercisesByIdNotesErrors = {
+  /**
+   * Bad Request - Invalid exercise ID or validation error
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found - Exercise not found or doesn't belong to user
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PutExercisesByIdNotesError =
+  PutExercisesByIdNotesErrors[keyof PutExercisesByIdNotesErrors];
+
+export type PutExercisesByIdNotesResponses = {
+  /**
+   * OK
+   */
+  200: ExerciseExerciseNotesResponse;
+};
+
+export type PutExercisesByIdNotesResponse =
+  PutExercisesByIdNotesResponses[keyof PutExercisesByIdNotesResponses];
+
+export type GetExercisesByIdRecentSetsData = {
+  body?: never;
+  path: {
+    /**
+     * Exercise ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/exercises/{id}/recent-sets";
+};
+
+export type GetExercisesByIdRecentSetsErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetExercisesByIdRecentSetsError =
+  GetExercisesByIdRecentSetsErrors[keyof GetExercisesByIdRecentSetsErrors];
+
+export type GetExercisesByIdRecentSetsResponses = {
+  /**
+   * Success (may be empty array)
+   */
+  200: Array<ExerciseRecentSetsResponse>;
+};
+
+export type GetExercisesByIdRecentSetsResponse =
+  GetExercisesByIdRecentSetsResponses[keyof GetExercisesByIdRecentSetsResponses];
+
+export type GetFeaturesAccessData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/features/access";
+};
+
+export type GetFeaturesAccessErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetFeaturesAccessError =
+  GetFeaturesAccessErrors[keyof GetFeaturesAccessErrors];
+
+export type GetFeaturesAccessResponses = {
+  /**
+   * OK
+   */
+  200: Array<FeatureaccessFeatureAccessResponse>;
+};
+
+export type GetFeaturesAccessResponse =
+  GetFeaturesAccessResponses[keyof GetFeaturesAccessResponses];
+
+export type GetGoalsData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/goals";
+};
+
+export type GetGoalsErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetGoalsError = GetGoalsErrors[keyof GetGoalsErrors];
+
+export type GetGoalsResponses = {
+  /**
+   * OK
+   */
+  200: Array<GoalsGoalResponse>;
+};
+
+export type GetGoalsResponse = GetGoalsResponses[keyof GetGoalsResponses];
+
+export type PostGoalsData = {
+  /**
+   * Goal
+   */
+  body: GoalsCreateGoalRequest;
+  path?: never;
+  query?: never;
+  url: "/goals";
+};
+
+export type PostGoalsErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostGoalsError = PostGoalsErrors[keyof PostGoalsErrors];
+
+export type PostGoalsResponses = {
+  /**
+   * Created
+   */
+  201: GoalsGoalResponse;
+};
+
+export type PostGoalsResponse = PostGoalsResponses[keyof PostGoalsResponses];
+
+export type DeleteGoalsByIdData = {
+  body?: never;
+  path: {
+    /**
+     * Goal ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/goals/{id}";
+};
+
+export type DeleteGoalsByIdErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type DeleteGoalsByIdError =
+  DeleteGoalsByIdErrors[keyof DeleteGoalsByIdErrors];
+
+export type DeleteGoalsByIdResponses = {
+  /**
+   * No Content
+   */
+  204: unknown;
+};
+
+export type PatchGoalsByIdData = {
+  /**
+   * Goal changes
+   */
+  body: GoalsUpdateGoalRequest;
+  path: {
+    /**
+     * Goal ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/goals/{id}";
+};
+
+export type PatchGoalsByIdErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PatchGoalsByIdError =
+  PatchGoalsByIdErrors[keyof PatchGoalsByIdErrors];
+
+export type PatchGoalsByIdResponses = {
+  /**
+   * OK
+   */
+  200: GoalsGoalResponse;
+};
+
+export type PatchGoalsByIdResponse =
+  PatchGoalsByIdResponses[keyof PatchGoalsByIdResponses];
+
+export type GetNotificationsData = {
+  body?: never;
+  path?: never;
+  query?: {
+    /**
+     * Only unread notifications
+     */
+    unread?: boolean;
+    /**
+     * Only notifications older than this ID
+     */
+    before_id?: number;
+    /**
+     * Maximum notifications to return
+     */
+    limit?: number;
+  };
+  url: "/notifications";
+};
+
+export type GetNotificationsErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetNotificationsError =
+  GetNotificationsErrors[keyof GetNotificationsErrors];
+
+export type GetNotificationsResponses = {
+  /**
+   * OK
+   */
+  200: NotificationsNotificationsResponse;
+};
+
+export type GetNotificationsResponse =
+  GetNotificationsResponses[keyof GetNotificationsResponses];
+
+export type PostNotificationsReadAllData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/notifications/read-all";
+};
+
+export type PostNotificationsReadAllErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostNotificationsReadAllError =
+  PostNotificationsReadAllErrors[keyof PostNotificationsReadAllErrors];
+
+export type PostNotificationsReadAllResponses = {
+  /**
+   * OK
+   */
+  200: NotificationsMarkAllReadResponse;
+};
+
+export type PostNotificationsReadAllResponse =
+  PostNotificationsReadAllResponses[keyof PostNotificationsReadAllResponses];
+
+export type GetNotificationsStreamData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/notifications/stream";
+};
+
+export type GetNotificationsStreamErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetNotificationsStreamError =
+  GetNotificationsStreamErrors[keyof GetNotificationsStreamErrors];
+
+export type GetNotificationsStreamResponses = {
+  /**
+   * OK
+   */
+  200: NotificationsStreamEvent;
+};
+
+export type GetNotificationsStreamResponse =
+  GetNotificationsStreamResponses[keyof GetNotificationsStreamResponses];
+
+export type GetNotificationsUnreadCountData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/notifications/unread-count";
+};
+
+export type GetNotificationsUnreadCountErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetNotificationsUnreadCountError =
+  GetNotificationsUnreadCountErrors[keyof GetNotificationsUnreadCountErrors];
+
+export type GetNotificationsUnreadCountResponses = {
+  /**
+   * OK
+   */
+  200: NotificationsUnreadCountResponse;
+};
+
+export type GetNotificationsUnreadCountResponse =
+  GetNotificationsUnreadCountResponses[keyof GetNotificationsUnreadCountResponses];
+
+export type PostNotificationsByIdReadData = {
+  body?: never;
+  path: {
+    /**
+     * Notification ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/notifications/{id}/read";
+};
+
+export type PostNotificationsByIdReadErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostNotificationsByIdReadError =
+  PostNotificationsByIdReadErrors[keyof PostNotificationsByIdReadErrors];
+
+export type PostNotificationsByIdReadResponses = {
+  /**
+   * No Content
+   */
+  204: unknown;
+};
+
+export type GetReportsByPeriodData = {
+  body?: never;
+  path: {
+    /**
+     * Report period
+     */
+    period: "week" | "month" | "year";
+  };
+  query?: {
+    /**
+     * Any day inside the period (YYYY-MM-DD); defaults to today
+     */
+    date?: string;
+  };
+  url: "/reports/{period}";
+};
+
+export type GetReportsByPeriodErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetReportsByPeriodError =
+  GetReportsByPeriodErrors[keyof GetReportsByPeriodErrors];
+
+export type GetReportsByPeriodResponses = {
+  /**
+   * OK
+   */
+  200: ReportReport;
+};
+
+export type GetReportsByPeriodResponse =
+  GetReportsByPeriodResponses[keyof GetReportsByPeriodResponses];
+
+export type GetSearchData = {
+  body?: never;
+  path?: never;
+  query: {
+    /**
+     * Search terms
+     */
+    q: string;
+    /**
+     * Comma-separated hit types to include: workout, set, exercise, conversation, message
+     */
+    type?: string;
+    /**
+     * Maximum hits to return
+     */
+    limit?: number;
+    /**
+     * Hits to skip, from a previous next_offset
+     */
+    offset?: number;
+  };
+  url: "/search";
+};
+
+export type GetSearchErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetSearchError = GetSearchErrors[keyof GetSearchErrors];
+
+export type GetSearchResponses = {
+  /**
+   * OK
+   */
+  200: SearchSearchResponse;
+};
+
+export type GetSearchResponse = GetSearchResponses[keyof GetSearchResponses];
+
+export type GetStrengthProfileData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/strength/profile";
+};
+
+export type GetStrengthProfileErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetStrengthProfileError =
+  GetStrengthProfileErrors[keyof GetStrengthProfileErrors];
+
+export type GetStrengthProfileResponses = {
+  /**
+   * OK
+   */
+  200: StrengthProfileResponse;
+};
+
+export type GetStrengthProfileResponse =
+  GetStrengthProfileResponses[keyof GetStrengthProfileResponses];
+
+export type PutStrengthProfileData = {
+  /**
+   * Strength profile
+   */
+  body: StrengthUpdateProfileRequest;
+  path?: never;
+  query?: never;
+  url: "/strength/profile";
+};
+
+export type PutStrengthProfileErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PutStrengthProfileError =
+  PutStrengthProfileErrors[keyof PutStrengthProfileErrors];
+
+export type PutStrengthProfileResponses = {
+  /**
+   * OK
+   */
+  200: StrengthProfileResponse;
+};
+
+export type PutStrengthProfileResponse =
+  PutStrengthProfileResponses[keyof PutStrengthProfileResponses];
+
+export type GetStrengthScoresData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/strength/scores";
+};
+
+export type GetStrengthScoresErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetStrengthScoresError =
+  GetStrengthScoresErrors[keyof GetStrengthScoresErrors];
+
+export type GetStrengthScoresResponses = {
+  /**
+   * OK
+   */
+  200: StrengthScoresResponse;
+};
+
+export type GetStrengthScoresResponse =
+  GetStrengthScoresResponses[keyof GetStrengthScoresResponses];
+
+export type GetStrengthScoresHistoryData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/strength/scores/history";
+};
+
+export type GetStrengthScoresHistoryErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetStrengthScoresHistoryError =
+  GetStrengthScoresHistoryErrors[keyof GetStrengthScoresHistoryErrors];
+
+export type GetStrengthScoresHistoryResponses = {
+  /**
+   * OK
+   */
+  200: StrengthScoreHistoryResponse;
+};
+
+export type GetStrengthScoresHistoryResponse =
+  GetStrengthScoresHistoryResponses[keyof GetStrengthScoresHistoryResponses];
+
+export type GetTagsData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/tags";
+};
+
+export type GetTagsErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetTagsError = GetTagsErrors[keyof GetTagsErrors];
+
+export type GetTagsResponses = {
+  /**
+   * OK
+   */
+  200: Array<TagTagResponse>;
+};
+
+export type GetTagsResponse = GetTagsResponses[keyof GetTagsResponses];
+
+export type PostTagsData = {
+  /**
+   * Tag
+   */
+  body: TagTagRequest;
+  path?: never;
+  query?: never;
+  url: "/tags";
+};
+
+export type PostTagsErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostTagsError = PostTagsErrors[keyof PostTagsErrors];
+
+export type PostTagsResponses = {
+  /**
+   * Created
+   */
+  201: TagTagResponse;
+};
+
+export type PostTagsResponse = PostTagsResponses[keyof PostTagsResponses];
+
+export type DeleteTagsByIdData = {
+  body?: never;
+  path: {
+    /**
+     * Tag ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/tags/{id}";
+};
+
+export type DeleteTagsByIdErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type DeleteTagsByIdError =
+  DeleteTagsByIdErrors[keyof DeleteTagsByIdErrors];
+
+export type DeleteTagsByIdResponses = {
+  /**
+   * No Content
+   */
+  204: unknown;
+};
+
+export type PatchTagsByIdData = {
+  /**
+   * Tag
+   */
+  body: TagTagRequest;
+  path: {
+    /**
+     * Tag ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/tags/{id}";
+};
+
+export type PatchTagsByIdErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PatchTagsByIdError = PatchTagsByIdErrors[keyof PatchTagsByIdErrors];
+
+export type PatchTagsByIdResponses = {
+  /**
+   * OK
+   */
+  200: TagTagResponse;
+};
+
+export type PatchTagsByIdResponse =
+  PatchTagsByIdResponses[keyof PatchTagsByIdResponses];
+
+export type GetToolsPlatesData = {
+  body?: never;
+  path?: never;
+  query: {
+    /**
+     * Target total weight including the bar
+     */
+    weight: number;
+    /**
+     * Bar weight; defaults to the primary bar and must be one of the user's bars
+     */
+    bar?: number;
+  };
+  url: "/tools/plates";
+};
+
+export type GetToolsPlatesErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetToolsPlatesError =
+  GetToolsPlatesErrors[keyof GetToolsPlatesErrors];
+
+export type GetToolsPlatesResponses = {
+  /**
+   * OK
+   */
+  200: EquipmentPlateBreakdown;
+};
+
+export type GetToolsPlatesResponse =
+  GetToolsPlatesResponses[keyof GetToolsPlatesResponses];
+
+export type PostToolsWarmupData = {
+  /**
+   * Working set and warm-up scheme
+   */
+  body: WorkoutWarmupRequest;
+  path?: never;
+  query?: never;
+  url: "/tools/warmup";
+};
+
+export type PostToolsWarmupErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostToolsWarmupError =
+  PostToolsWarmupErrors[keyof PostToolsWarmupErrors];
+
+export type PostToolsWarmupResponses = {
+  /**
+   * OK
+   */
+  200: WorkoutWarmupResponse;
+};
+
+export type PostToolsWarmupResponse =
+  PostToolsWarmupResponses[keyof PostToolsWarmupResponses];
+
+export type GetTrainingProfileData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/training-profile";
+};
+
+export type GetTrainingProfileErrors = {
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetTrainingProfileError =
+  GetTrainingProfileErrors[keyof GetTrainingProfileErrors];
+
+export type GetTrainingProfileResponses = {
+  /**
+   * OK
+   */
+  200: TrainingprofileProfileResponse;
+};
+
+export type GetTrainingProfileResponse =
+  GetTrainingProfileResponses[keyof GetTrainingProfileResponses];
+
+export type PutTrainingProfileData = {
+  /**
+   * Training profile
+   */
+  body: TrainingprofileUpdateProfileRequest;
+  path?: never;
+  query?: never;
+  url: "/training-profile";
+};
+
+export type PutTrainingProfileErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PutTrainingProfileError =
+  PutTrainingProfileErrors[keyof PutTrainingProfileErrors];
+
+export type PutTrainingProfileResponses = {
+  /**
+   * OK
+   */
+  200: TrainingprofileProfileResponse;
+};
+
+export type PutTrainingProfileResponse =
+  PutTrainingProfileResponses[keyof PutTrainingProfileResponses];
+
+export type GetWebhooksData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/webhooks";
+};
+
+export type GetWebhooksErrors = {
+  /**
+   * Unauthorized
    */
-  404: ResponseErrorResponse;
+  401: ResponseErrorResponse;
   /**
    * Internal Server Error
    */
   500: ResponseErrorResponse;
 };
 
-export type PatchExercisesByIdHistorical1RmError =
-  PatchExercisesByIdHistorical1RmErrors[keyof PatchExercisesByIdHistorical1RmErrors];
+export type GetWebhooksError = GetWebhooksErrors[keyof GetWebhooksErrors];
 
-export type PatchExercisesByIdHistorical1RmResponses = {
+export type GetWebhooksResponses = {
   /**
-   * No Content - Exercise historical 1RM updated successfully
+   * OK
    */
-  204: unknown;
+  200: Array<WebhooksEndpointResponse>;
 };
 
-export type GetExercisesByIdMetricsHistoryData = {
-  body?: never;
-  path: {
-    /**
-     * Exercise ID
-     */
-    id: number;
-  };
-  query?: {
-    /**
-     * Range selector
-     */
-    range?: "W" | "M" | "6M" | "Y";
-  };
-  url: "/exercises/{id}/metrics-history";
+export type GetWebhooksResponse =
+  GetWebhooksResponses[keyof GetWebhooksResponses];
+
+export type PostWebhooksData = {
+  /**
+   * Webhook endpoint
+   */
+  body: WebhooksCreateEndpointRequest;
+  path?: never;
+  query?: never;
+  url: "/webhooks";
 };
 
-export type GetExercisesByIdMetricsHistoryErrors = {
+export type PostWebhooksErrors = {
   /**
    * Bad Request
    */
@@ -1115,42 +4263,37 @@ export type GetExercisesByIdMetricsHistoryErrors = {
    * Unauthorized
    */
   401: ResponseErrorResponse;
-  /**
-   * Not Found - Exercise not found or doesn't belong to user
-   */
-  404: ResponseErrorResponse;
   /**
    * Internal Server Error
    */
   500: ResponseErrorResponse;
 };
 
-export type GetExercisesByIdMetricsHistoryError =
-  GetExercisesByIdMetricsHistoryErrors[keyof GetExercisesByIdMetricsHistoryErrors];
+export type PostWebhooksError = PostWebhooksErrors[keyof PostWebhooksErrors];
 
-export type GetExercisesByIdMetricsHistoryResponses = {
+export type PostWebhooksResponses = {
   /**
-   * OK
+   * Created
    */
-  200: ExerciseExerciseMetricsHistoryResponse;
+  201: WebhooksCreatedEndpointResponse;
 };
 
-export type GetExercisesByIdMetricsHistoryResponse =
-  GetExercisesByIdMetricsHistoryResponses[keyof GetExercisesByIdMetricsHistoryResponses];
+export type PostWebhooksResponse =
+  PostWebhooksResponses[keyof PostWebhooksResponses];
 
-export type GetExercisesByIdRecentSetsData = {
+export type DeleteWebhooksByIdData = {
   body?: never;
   path: {
     /**
-     * Exercise ID
+     * Webhook ID
      */
     id: number;
   };
   query?: never;
-  url: "/exercises/{id}/recent-sets";
+  url: "/webhooks/{id}";
 };
 
-export type GetExercisesByIdRecentSetsErrors = {
+export type DeleteWebhooksByIdErrors = {
   /**
    * Bad Request
    */
@@ -1159,98 +4302,135 @@ export type GetExercisesByIdRecentSetsErrors = {
    * Unauthorized
    */
   401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
   /**
    * Internal Server Error
    */
   500: ResponseErrorResponse;
 };
 
-export type GetExercisesByIdRecentSetsError =
-  GetExercisesByIdRecentSetsErrors[keyof GetExercisesByIdRecentSetsErrors];
+export type DeleteWebhooksByIdError =
+  DeleteWebhooksByIdErrors[keyof DeleteWebhooksByIdErrors];
 
-export type GetExercisesByIdRecentSetsResponses = {
+export type DeleteWebhooksByIdResponses = {
   /**
-   * Success (may be empty array)
+   * No Content
    */
-  200: Array<ExerciseRecentSetsResponse>;
+  204: unknown;
 };
 
-export type GetExercisesByIdRecentSetsResponse =
-  GetExercisesByIdRecentSetsResponses[keyof GetExercisesByIdRecentSetsResponses];
-
-export type GetFeaturesAccessData = {
-  body?: never;
-  path?: never;
+export type PatchWebhooksByIdData = {
+  /**
+   * Fields to change
+   */
+  body: WebhooksUpdateEndpointRequest;
+  path: {
+    /**
+     * Webhook ID
+     */
+    id: number;
+  };
   query?: never;
-  url: "/features/access";
+  url: "/webhooks/{id}";
 };
 
-export type GetFeaturesAccessErrors = {
+export type PatchWebhooksByIdErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
   /**
    * Unauthorized
    */
   401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
   /**
    * Internal Server Error
    */
   500: ResponseErrorResponse;
 };
 
-export type GetFeaturesAccessError =
-  GetFeaturesAccessErrors[keyof GetFeaturesAccessErrors];
+export type PatchWebhooksByIdError =
+  PatchWebhooksByIdErrors[keyof PatchWebhooksByIdErrors];
 
-export type GetFeaturesAccessResponses = {
+export type PatchWebhooksByIdResponses = {
   /**
    * OK
    */
-  200: Array<FeatureaccessFeatureAccessResponse>;
+  200: WebhooksEndpointResponse;
 };
 
-export type GetFeaturesAccessResponse =
-  GetFeaturesAccessResponses[keyof GetFeaturesAccessResponses];
+export type PatchWebhooksByIdResponse =
+  PatchWebhooksByIdResponses[keyof PatchWebhooksByIdResponses];
 
-export type GetTrainingProfileData = {
+export type GetWebhooksByIdDeliveriesData = {
   body?: never;
-  path?: never;
-  query?: never;
-  url: "/training-profile";
+  path: {
+    /**
+     * Webhook ID
+     */
+    id: number;
+  };
+  query?: {
+    /**
+     * Maximum deliveries to return
+     */
+    limit?: number;
+  };
+  url: "/webhooks/{id}/deliveries";
 };
 
-export type GetTrainingProfileErrors = {
+export type GetWebhooksByIdDeliveriesErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
   /**
    * Unauthorized
    */
   401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
   /**
    * Internal Server Error
    */
   500: ResponseErrorResponse;
 };
 
-export type GetTrainingProfileError =
-  GetTrainingProfileErrors[keyof GetTrainingProfileErrors];
+export type GetWebhooksByIdDeliveriesError =
+  GetWebhooksByIdDeliveriesErrors[keyof GetWebhooksByIdDeliveriesErrors];
 
-export type GetTrainingProfileResponses = {
+export type GetWebhooksByIdDeliveriesResponses = {
   /**
    * OK
    */
-  200: TrainingprofileProfileResponse;
+  200: Array<WebhooksDeliveryResponse>;
 };
 
-export type GetTrainingProfileResponse =
-  GetTrainingProfileResponses[keyof GetTrainingProfileResponses];
+export type GetWebhooksByIdDeliveriesResponse =
+  GetWebhooksByIdDeliveriesResponses[keyof GetWebhooksByIdDeliveriesResponses];
 
-export type PutTrainingProfileData = {
-  /**
-   * Training profile
-   */
-  body: TrainingprofileUpdateProfileRequest;
-  path?: never;
+export type PostWebhooksByIdTestData = {
+  body?: never;
+  path: {
+    /**
+     * Webhook ID
+     */
+    id: number;
+  };
   query?: never;
-  url: "/training-profile";
+  url: "/webhooks/{id}/test";
 };
 
-export type PutTrainingProfileErrors = {
+export type PostWebhooksByIdTestErrors = {
   /**
    * Bad Request
    */
@@ -1259,29 +4439,42 @@ export type PutTrainingProfileErrors = {
    * Unauthorized
    */
   401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
   /**
    * Internal Server Error
    */
   500: ResponseErrorResponse;
 };
 
-export type PutTrainingProfileError =
-  PutTrainingProfileErrors[keyof PutTrainingProfileErrors];
+export type PostWebhooksByIdTestError =
+  PostWebhooksByIdTestErrors[keyof PostWebhooksByIdTestErrors];
 
-export type PutTrainingProfileResponses = {
+export type PostWebhooksByIdTestResponses = {
   /**
    * OK
    */
-  200: TrainingprofileProfileResponse;
+  200: WebhooksDeliveryResponse;
 };
 
-export type PutTrainingProfileResponse =
-  PutTrainingProfileResponses[keyof PutTrainingProfileResponses];
+export type PostWebhooksByIdTestResponse =
+  PostWebhooksByIdTestResponses[keyof PostWebhooksByIdTestResponses];
 
 export type GetWorkoutsData = {
   body?: never;
   path?: never;
-  query?: never;
+  query?: {
+    /**
+     * Only workouts carrying every listed tag (repeatable or comma-separated)
+     */
+    tag?: Array<string>;
+    /**
+     * Skip workouts carrying any listed tag (repeatable or comma-separated)
+     */
+    excludeTag?: Array<string>;
+  };
   url: "/workouts";
 };
 
@@ -1345,10 +4538,67 @@ export type PostWorkoutsResponses = {
 export type PostWorkoutsResponse =
   PostWorkoutsResponses[keyof PostWorkoutsResponses];
 
+export type GetWorkoutsCompareData = {
+  body?: never;
+  path?: never;
+  query: {
+    /**
+     * Baseline workout ID
+     */
+    a: number;
+    /**
+     * Workout ID to compare against the baseline
+     */
+    b: number;
+  };
+  url: "/workouts/compare";
+};
+
+export type GetWorkoutsCompareErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type GetWorkoutsCompareError =
+  GetWorkoutsCompareErrors[keyof GetWorkoutsCompareErrors];
+
+export type GetWorkoutsCompareResponses = {
+  /**
+   * OK
+   */
+  200: WorkoutWorkoutComparisonResponse;
+};
+
+export type GetWorkoutsCompareResponse =
+  GetWorkoutsCompareResponses[keyof GetWorkoutsCompareResponses];
+
 export type GetWorkoutsContributionDataData = {
   body?: never;
   path?: never;
-  query?: never;
+  query?: {
+    /**
+     * Only workouts carrying every listed tag (repeatable or comma-separated)
+     */
+    tag?: Array<string>;
+    /**
+     * Skip workouts carrying any listed tag (repeatable or comma-separated)
+     */
+    excludeTag?: Array<string>;
+  };
   url: "/workouts/contribution-data";
 };
 
@@ -1562,3 +4812,91 @@ export type PutWorkoutsByIdResponses = {
    */
   204: unknown;
 };
+
+export type DeleteWorkoutsByIdShareData = {
+  body?: never;
+  path: {
+    /**
+     * Workout ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/workouts/{id}/share";
+};
+
+export type DeleteWorkoutsByIdShareErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type DeleteWorkoutsByIdShareError =
+  DeleteWorkoutsByIdShareErrors[keyof DeleteWorkoutsByIdShareErrors];
+
+export type DeleteWorkoutsByIdShareResponses = {
+  /**
+   * No Content
+   */
+  204: unknown;
+};
+
+export type PostWorkoutsByIdShareData = {
+  /**
+   * Share options
+   */
+  body?: ShareCreateShareRequest;
+  path: {
+    /**
+     * Workout ID
+     */
+    id: number;
+  };
+  query?: never;
+  url: "/workouts/{id}/share";
+};
+
+export type PostWorkoutsByIdShareErrors = {
+  /**
+   * Bad Request
+   */
+  400: ResponseErrorResponse;
+  /**
+   * Unauthorized
+   */
+  401: ResponseErrorResponse;
+  /**
+   * Not Found
+   */
+  404: ResponseErrorResponse;
+  /**
+   * Internal Server Error
+   */
+  500: ResponseErrorResponse;
+};
+
+export type PostWorkoutsByIdShareError =
+  PostWorkoutsByIdShareErrors[keyof PostWorkoutsByIdShareErrors];
+
+export type PostWorkoutsByIdShareResponses = {
+  /**
+   * Created
+   */
+  201: ShareShareLinkResponse;
+};
+
+export type PostWorkoutsByIdShareResponse =
+  PostWorkoutsByIdShareResponses[keyof PostWorkoutsByIdShareResponses];
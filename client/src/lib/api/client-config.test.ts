@@ -49,12 +49,30 @@ async function loadRequestInterceptor(): Promise<RequestInterceptor> {
 describe("client auth request interceptor", () => {
   beforeEach(() => {
     vi.resetModules();
+    vi.restoreAllMocks();
     mocks.applyLocalDevAuthHeader.mockClear();
     mocks.getUser.mockReset();
     mocks.setConfig.mockClear();
   });
 
-  it("configures the generated client with the local test API base URL", async () => {
+  it("configures the generated client with the local test API base URL and browser timezone", async () => {
+    vi.spyOn(Intl, "DateTimeFormat").mockReturnValue({
+      resolvedOptions: () => ({ timeZone: "Europe/Berlin" }),
+    } as Intl.DateTimeFormat);
+
+    await loadRequestInterceptor();
+
+    expect(mocks.setConfig).toHaveBeenCalledWith({
+      baseUrl: "http://localhost/api",
+      headers: { "X-Timezone": "Europe/Berlin" },
+    });
+  });
+
+  it("omits the timezone header when the browser cannot report one", async () => {
+    vi.spyOn(Intl, "DateTimeFormat").mockImplementation(() => {
+      throw new RangeError("Intl unavailable");
+    });
+
     await loadRequestInterceptor();
 
     expect(mocks.setConfig).toHaveBeenCalledWith({
@@ -11,8 +11,21 @@ const BASE_URL =
   import.meta.env.VITE_API_BASE_URL ||
   (import.meta.env.MODE === "test" ? "http://localhost/api" : "/api");
 
+// Until a user has a stored timezone, the API adopts the one the browser
+// reports here, so local days and weeks match the user's calendar.
+function browserTimezone(): string | undefined {
+  try {
+    return Intl.DateTimeFormat().resolvedOptions().timeZone || undefined;
+  } catch {
+    return undefined;
+  }
+}
+
+const TIMEZONE = browserTimezone();
+
 client.setConfig({
   baseUrl: BASE_URL,
+  ...(TIMEZONE ? { headers: { "X-Timezone": TIMEZONE } } : {}),
 });
 
 client.interceptors.request.use(async (request) => {
@@ -30,6 +30,23 @@ var agentOperationAllowlist = []agentOperationPolicy{
 	{Method: "get", Path: "/training-profile", OperationID: "getTrainingProfile"},
 }
 
+// agentTokenSecurityName names the personal access token scheme the agent
+// contract accepts alongside StackAuth. Every allowlisted operation is a read
+// that a token with the workouts:read scope can make.
+const agentTokenSecurityName = "PersonalAccessToken"
+
+var agentTokenSecurityDefinition = map[string]string{
+	"type":        "apiKey",
+	"name":        "Authorization",
+	"in":          "header",
+	"description": `Personal access token with the workouts:read scope, sent as "Bearer <token>".`,
+}
+
+var agentOperationSecurity = []map[string][]string{
+	{"StackAuth": {}},
+	{agentTokenSecurityName: {}},
+}
+
 var agentSwaggerJSON = mustBuildAgentSwaggerJSON([]byte(swaggerJSON))
 
 // AgentSwaggerJSON returns the deterministic read-only agent contract derived
@@ -60,6 +77,11 @@ func buildAgentSwaggerJSON(canonical []byte) ([]byte, error) {
 	if err := validateStackAuthDefinition(document["securityDefinitions"]); err != nil {
 		return nil, err
 	}
+	securityDefinitions, err := withAgentTokenSecurityDefinition(document["securityDefinitions"])
+	if err != nil {
+		return nil, err
+	}
+	document["securityDefinitions"] = securityDefinitions
 
 	var canonicalPaths map[string]map[string]json.RawMessage
 	if err := json.Unmarshal(document["paths"], &canonicalPaths); err != nil {
@@ -90,6 +112,7 @@ func buildAgentSwaggerJSON(canonical []byte) ([]byte, error) {
 			return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(policy.Method), policy.Path, err)
 		}
 		operationObject["operationId"] = mustMarshalRaw(policy.OperationID)
+		operationObject["security"] = mustMarshalRaw(agentOperationSecurity)
 		filteredOperation, err := json.Marshal(operationObject)
 		if err != nil {
 			return nil, fmt.Errorf("encode %s %s: %w", strings.ToUpper(policy.Method), policy.Path, err)
@@ -145,6 +168,24 @@ func validateStackAuthDefinition(raw json.RawMessage) error {
 	return nil
 }
 
+// withAgentTokenSecurityDefinition adds the personal access token scheme to
+// the canonical security definitions.
+func withAgentTokenSecurityDefinition(raw json.RawMessage) (json.RawMessage, error) {
+	var definitions map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &definitions); err != nil {
+		return nil, fmt.Errorf("decode security definitions: %w", err)
+	}
+	if _, exists := definitions[agentTokenSecurityName]; exists {
+		return nil, fmt.Errorf("%s security definition already exists", agentTokenSecurityName)
+	}
+	definitions[agentTokenSecurityName] = mustMarshalRaw(agentTokenSecurityDefinition)
+	encoded, err := json.Marshal(definitions)
+	if err != nil {
+		return nil, fmt.Errorf("encode security definitions: %w", err)
+	}
+	return encoded, nil
+}
+
 func validateOperationSecurity(raw json.RawMessage) error {
 	var requirements []map[string][]string
 	if err := json.Unmarshal(raw, &requirements); err != nil {
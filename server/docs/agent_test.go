@@ -65,11 +65,23 @@ func TestAgentSwaggerJSONHasExactReadOnlyContract(t *testing.T) {
 			t.Errorf("duplicate operationId %q", operationID)
 		}
 		operationIDs[operationID] = struct{}{}
-		if err := validateOperationSecurity(operation["security"]); err != nil {
-			t.Errorf("%s %s: %v", strings.ToUpper(policy.Method), policy.Path, err)
+		if !bytes.Equal(operation["security"], mustMarshalRaw(agentOperationSecurity)) {
+			t.Errorf("%s %s security = %s, want StackAuth or %s", strings.ToUpper(policy.Method), policy.Path, operation["security"], agentTokenSecurityName)
 		}
 	}
 
+	var securityDefinitions map[string]map[string]string
+	if err := json.Unmarshal(document["securityDefinitions"], &securityDefinitions); err != nil {
+		t.Fatalf("decode security definitions: %v", err)
+	}
+	if err := validateStackAuthDefinition(document["securityDefinitions"]); err != nil {
+		t.Error(err)
+	}
+	token := securityDefinitions[agentTokenSecurityName]
+	if token["type"] != "apiKey" || token["name"] != "Authorization" || token["in"] != "header" {
+		t.Errorf("%s security definition = %v, want Authorization header apiKey", agentTokenSecurityName, token)
+	}
+
 	var definitions map[string]json.RawMessage
 	if err := json.Unmarshal(document["definitions"], &definitions); err != nil {
 		t.Fatalf("decode definitions: %v", err)
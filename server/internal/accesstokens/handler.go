@@ -0,0 +1,125 @@
+package accesstokens
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type accessTokensService interface {
+	ListTokens(ctx context.Context) ([]TokenResponse, error)
+	CreateToken(ctx context.Context, req CreateTokenRequest) (*CreatedTokenResponse, error)
+	RevokeToken(ctx context.Context, id int32) error
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service accessTokensService
+}
+
+func NewHandler(logger *slog.Logger, service accessTokensService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// ListTokens godoc
+// @Summary List personal access tokens
+// @Description Returns the authenticated user's tokens that have not been revoked, including expired ones. Tokens themselves are only returned when they are created.
+// @Tags access-tokens
+// @Produce json
+// @Security StackAuth
+// @Success 200 {array} accesstokens.TokenResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /access-tokens [get]
+func (h *Handler) ListTokens(w http.ResponseWriter, r *http.Request) {
+	tokens, err := h.service.ListTokens(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list access tokens")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, tokens); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// CreateToken godoc
+// @Summary Create personal access token
+// @Description Issues a token for the public API with workouts:read, workouts:write, profile:read, profile:write or chat:use scopes. Send it as "Authorization: Bearer <token>". The workouts scopes cover workouts, exercises, tags, equipment, tools and the reports derived from them; the profile scopes cover body metrics, goals, the strength profile and the training profile; chat:use covers the AI chat routes, and saving a chat workout draft also needs workouts:write. Tokens never reach token management, account, billing, coaching, webhook or share routes, nor deleting all chat history. The token is only returned here.
+// @Tags access-tokens
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param request body accesstokens.CreateTokenRequest true "Access token"
+// @Success 201 {object} accesstokens.CreatedTokenResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /access-tokens [post]
+func (h *Handler) CreateToken(w http.ResponseWriter, r *http.Request) {
+	var req CreateTokenRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	token, err := h.service.CreateToken(r.Context(), req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to create access token")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusCreated, token); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// RevokeToken godoc
+// @Summary Revoke personal access token
+// @Description Revokes a token immediately. Requests already using it start failing with 401.
+// @Tags access-tokens
+// @Security StackAuth
+// @Param id path int true "Access token ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /access-tokens/{id} [delete]
+func (h *Handler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeTokenID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RevokeToken(r.Context(), id); err != nil {
+		h.writeServiceError(w, r, err, "failed to revoke access token")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errNotFound *apperrors.NotFound
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errNotFound):
+		response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
@@ -0,0 +1,119 @@
+package accesstokens
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubAccessTokensService struct {
+	created CreateTokenRequest
+	id      int32
+	err     error
+}
+
+func (s *stubAccessTokensService) ListTokens(context.Context) ([]TokenResponse, error) {
+	return []TokenResponse{}, s.err
+}
+
+func (s *stubAccessTokensService) CreateToken(_ context.Context, req CreateTokenRequest) (*CreatedTokenResponse, error) {
+	s.created = req
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &CreatedTokenResponse{TokenResponse: TokenResponse{ID: 4, Name: req.Name}, Token: "ftpat_test"}, nil
+}
+
+func (s *stubAccessTokensService) RevokeToken(_ context.Context, id int32) error {
+	s.id = id
+	return s.err
+}
+
+func TestHandlerCreateToken(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("returns the token", func(t *testing.T) {
+		service := &stubAccessTokensService{}
+		body := `{"name":"Sync","scopes":["workouts:read"],"expires_in_days":30}`
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).CreateToken(rr, httptest.NewRequest(http.MethodPost, "/api/access-tokens", strings.NewReader(body)))
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"token":"ftpat_test"`)
+		assert.Equal(t, []string{ScopeWorkoutsRead}, service.created.Scopes)
+		require.NotNil(t, service.created.ExpiresInDays)
+		assert.Equal(t, 30, *service.created.ExpiresInDays)
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubAccessTokensService{}).CreateToken(rr, httptest.NewRequest(http.MethodPost, "/api/access-tokens", strings.NewReader(`{"name":"Sync","token":"mine"}`)))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps validation errors", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		service := &stubAccessTokensService{err: &ValidationError{Field: "scopes", Message: "must include at least one scope"}}
+
+		NewHandler(logger, service).CreateToken(rr, httptest.NewRequest(http.MethodPost, "/api/access-tokens", strings.NewReader(`{"name":"Sync"}`)))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandlerListTokens(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	rr := httptest.NewRecorder()
+	service := &stubAccessTokensService{err: &apperrors.Unauthorized{Resource: tokenResource}}
+
+	NewHandler(logger, service).ListTokens(rr, httptest.NewRequest(http.MethodGet, "/api/access-tokens", nil))
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestHandlerRevokeToken(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("revokes the token", func(t *testing.T) {
+		service := &stubAccessTokensService{}
+		req := httptest.NewRequest(http.MethodDelete, "/api/access-tokens/4", nil)
+		req.SetPathValue("id", "4")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).RevokeToken(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, int32(4), service.id)
+	})
+
+	t.Run("maps missing tokens", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/api/access-tokens/4", nil)
+		req.SetPathValue("id", "4")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubAccessTokensService{err: &apperrors.NotFound{Resource: tokenResource, ID: "4"}}).RevokeToken(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("rejects an invalid id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/api/access-tokens/abc", nil)
+		req.SetPathValue("id", "abc")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubAccessTokensService{}).RevokeToken(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,80 @@
+package accesstokens
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	tokenResource      = "access token"
+	maxActiveTokens    = 25
+	maxNameLength      = 100
+	defaultExpiryDays  = 90
+	maxExpiryDays      = 365
+	tokenBytes         = 32
+	tokenPrefix        = "ftpat_"
+	displayPrefixChars = 6
+)
+
+// Scopes a personal access token can be granted. Tokens never reach the
+// token management, account or billing routes, whatever their scopes.
+const (
+	ScopeWorkoutsRead  = "workouts:read"
+	ScopeWorkoutsWrite = "workouts:write"
+	ScopeProfileRead   = "profile:read"
+	ScopeProfileWrite  = "profile:write"
+	ScopeChatUse       = "chat:use"
+)
+
+// validScopes lists the grantable scopes in the order they are documented.
+var validScopes = []string{
+	ScopeWorkoutsRead,
+	ScopeWorkoutsWrite,
+	ScopeProfileRead,
+	ScopeProfileWrite,
+	ScopeChatUse,
+}
+
+// ErrInvalidToken is returned when a bearer token is malformed, unknown,
+// expired or revoked.
+var ErrInvalidToken = errors.New("invalid access token")
+
+// CreateTokenRequest names a new token and picks its scopes. ExpiresInDays
+// defaults to 90.
+type CreateTokenRequest struct {
+	Name          string   `json:"name" example:"Training log sync"`
+	Scopes        []string `json:"scopes" example:"workouts:read"`
+	ExpiresInDays *int     `json:"expires_in_days,omitempty" example:"90" minimum:"1" maximum:"365"`
+}
+
+// TokenResponse describes a token without the secret. Prefix is the start of
+// the token so users can tell their tokens apart.
+type TokenResponse struct {
+	ID         int32      `json:"id" example:"4"`
+	Name       string     `json:"name" example:"Training log sync"`
+	Prefix     string     `json:"prefix" example:"ftpat_Qm9vb2"`
+	Scopes     []string   `json:"scopes" example:"workouts:read"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreatedTokenResponse is only returned on creation; the token is not shown
+// again. Send it as "Authorization: Bearer <token>".
+type CreatedTokenResponse struct {
+	TokenResponse
+	Token string `json:"token" example:"ftpat_Qm9vb2xlYW5EZXZpY2VUb2tlbkV4YW1wbGVWYWx1ZTEyMzQ1Ng"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
@@ -0,0 +1,174 @@
+package accesstokens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type tokenValues struct {
+	Name        string
+	TokenHash   string
+	TokenPrefix string
+	Scopes      []string
+	ExpiresAt   time.Time
+}
+
+type Repository interface {
+	ListTokens(ctx context.Context, userID string) ([]db.PersonalAccessToken, error)
+	CountActiveTokens(ctx context.Context, userID string) (int64, error)
+	CreateToken(ctx context.Context, userID string, values tokenValues) (db.PersonalAccessToken, error)
+	RevokeToken(ctx context.Context, userID string, id int32) (bool, error)
+	// GetActiveTokenByHash returns nil when the token is unknown, expired or
+	// revoked.
+	GetActiveTokenByHash(ctx context.Context, tokenHash string) (*db.GetActivePersonalAccessTokenByHashRow, error)
+	TouchToken(ctx context.Context, userID string, id int32) error
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	conn    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		conn:    conn,
+	}
+}
+
+func (r *repository) ListTokens(ctx context.Context, userID string) ([]db.PersonalAccessToken, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	tokens, err := r.queries.ListPersonalAccessTokens(ctx, userID)
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list access tokens failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list access tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+func (r *repository) CountActiveTokens(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	count, err := r.queries.CountActivePersonalAccessTokens(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("count access tokens: %w", err)
+	}
+	return count, nil
+}
+
+func (r *repository) CreateToken(ctx context.Context, userID string, values tokenValues) (db.PersonalAccessToken, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	token, err := r.queries.CreatePersonalAccessToken(ctx, db.CreatePersonalAccessTokenParams{
+		UserID:      userID,
+		Name:        values.Name,
+		TokenHash:   values.TokenHash,
+		TokenPrefix: values.TokenPrefix,
+		Scopes:      values.Scopes,
+		ExpiresAt:   pgtype.Timestamptz{Time: values.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("create access token failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return db.PersonalAccessToken{}, fmt.Errorf("create access token: %w", err)
+	}
+	return token, nil
+}
+
+func (r *repository) RevokeToken(ctx context.Context, userID string, id int32) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.RevokePersonalAccessToken(ctx, db.RevokePersonalAccessTokenParams{ID: id, UserID: userID})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("revoke access token failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"token_id", id,
+				"error_type", "rls_violation")
+		}
+		return false, fmt.Errorf("revoke access token: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// GetActiveTokenByHash resolves a bearer token to its owner before the auth
+// middleware has set up any user context. There is no RLS user yet, so the
+// token hash is set for a read-only transaction and the select policy exposes
+// only the matching token.
+func (r *repository) GetActiveTokenByHash(ctx context.Context, tokenHash string) (*db.GetActivePersonalAccessTokenByHashRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	tx, err := r.conn.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("begin access token lookup transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT set_config('app.current_token_hash', $1, true)", tokenHash); err != nil {
+		return nil, fmt.Errorf("set access token hash: %w", err)
+	}
+
+	token, err := r.queries.WithTx(tx).GetActivePersonalAccessTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get access token by hash: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit access token lookup transaction: %w", err)
+	}
+	return &token, nil
+}
+
+// TouchToken records that a token was used. It runs before the middleware has
+// set the session user, so the RLS user is set for its own transaction.
+func (r *repository) TouchToken(ctx context.Context, userID string, id int32) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin access token touch transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT set_config('app.current_user_id', $1, true)", userID); err != nil {
+		return fmt.Errorf("set access token rls user: %w", err)
+	}
+	if err := r.queries.WithTx(tx).TouchPersonalAccessToken(ctx, id); err != nil {
+		return fmt.Errorf("touch access token: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit access token touch transaction: %w", err)
+	}
+	return nil
+}
+
+var _ Repository = (*repository)(nil)
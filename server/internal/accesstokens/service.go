@@ -0,0 +1,196 @@
+package accesstokens
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger *slog.Logger
+	repo   Repository
+	now    func() time.Time
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+		now:    time.Now,
+	}
+}
+
+// ListTokens returns the user's tokens that have not been revoked, including
+// expired ones, without their secrets. Tokens act for the user, so delegated
+// coach requests are refused.
+func (s *Service) ListTokens(ctx context.Context) ([]TokenResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.repo.ListTokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list access tokens: %w", err)
+	}
+
+	resp := make([]TokenResponse, 0, len(tokens))
+	for _, token := range tokens {
+		resp = append(resp, tokenResponse(token))
+	}
+	return resp, nil
+}
+
+// CreateToken issues a token and returns it once; only its hash is kept.
+func (s *Service) CreateToken(ctx context.Context, req CreateTokenRequest) (*CreatedTokenResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name, err := validateName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := validateScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	expiresInDays := defaultExpiryDays
+	if req.ExpiresInDays != nil {
+		expiresInDays = *req.ExpiresInDays
+		if expiresInDays < 1 || expiresInDays > maxExpiryDays {
+			return nil, &ValidationError{Field: "expires_in_days", Message: fmt.Sprintf("must be between 1 and %d", maxExpiryDays)}
+		}
+	}
+
+	count, err := s.repo.CountActiveTokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count access tokens: %w", err)
+	}
+	if count >= maxActiveTokens {
+		return nil, &ValidationError{Message: fmt.Sprintf("you can have at most %d active access tokens", maxActiveTokens)}
+	}
+
+	secret, hash, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.repo.CreateToken(ctx, userID, tokenValues{
+		Name:        name,
+		TokenHash:   hash,
+		TokenPrefix: displayPrefix(secret),
+		Scopes:      scopes,
+		ExpiresAt:   s.now().AddDate(0, 0, expiresInDays),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create access token: %w", err)
+	}
+
+	return &CreatedTokenResponse{TokenResponse: tokenResponse(token), Token: secret}, nil
+}
+
+// RevokeToken stops a token from authenticating. Revoked tokens are kept so
+// their hashes can never be reissued, but they are no longer listed.
+func (s *Service) RevokeToken(ctx context.Context, id int32) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	revoked, err := s.repo.RevokeToken(ctx, userID, id)
+	if err != nil {
+		return fmt.Errorf("failed to revoke access token: %w", err)
+	}
+	if !revoked {
+		return &apperrors.NotFound{Resource: tokenResource, ID: fmt.Sprint(id)}
+	}
+	return nil
+}
+
+// ResolveAccessToken returns the owner and scopes of a bearer token, or
+// ErrInvalidToken. Failing to record the token's use is logged rather than
+// failing the request.
+func (s *Service) ResolveAccessToken(ctx context.Context, token string) (string, []string, error) {
+	if !validToken(token) {
+		return "", nil, ErrInvalidToken
+	}
+
+	row, err := s.repo.GetActiveTokenByHash(ctx, hashToken(token))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to resolve access token: %w", err)
+	}
+	if row == nil {
+		return "", nil, ErrInvalidToken
+	}
+
+	if err := s.repo.TouchToken(ctx, row.UserID, row.ID); err != nil {
+		s.logger.Warn("failed to record access token use",
+			"error", err,
+			"user_id", row.UserID,
+			"token_id", row.ID)
+	}
+	return row.UserID, row.Scopes, nil
+}
+
+func currentUserID(ctx context.Context) (string, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || user.IsDelegated(ctx) {
+		return "", &apperrors.Unauthorized{Resource: tokenResource, UserID: ""}
+	}
+	return userID, nil
+}
+
+func validateName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if name == "" {
+		return "", &ValidationError{Field: "name", Message: "is required"}
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return "", &ValidationError{Field: "name", Message: fmt.Sprintf("must be at most %d characters", maxNameLength)}
+	}
+	return name, nil
+}
+
+// validateScopes drops duplicates and returns the scopes in documented order.
+func validateScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, &ValidationError{Field: "scopes", Message: "must include at least one scope"}
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(validScopes, scope) {
+			return nil, &ValidationError{Field: "scopes", Message: fmt.Sprintf("unknown scope %q; must be one of %s", scope, strings.Join(validScopes, ", "))}
+		}
+	}
+
+	granted := make([]string, 0, len(scopes))
+	for _, scope := range validScopes {
+		if slices.Contains(scopes, scope) {
+			granted = append(granted, scope)
+		}
+	}
+	return granted, nil
+}
+
+func tokenResponse(row db.PersonalAccessToken) TokenResponse {
+	resp := TokenResponse{
+		ID:        row.ID,
+		Name:      row.Name,
+		Prefix:    row.TokenPrefix,
+		Scopes:    row.Scopes,
+		ExpiresAt: row.ExpiresAt.Time,
+		CreatedAt: row.CreatedAt.Time,
+	}
+	if row.LastUsedAt.Valid {
+		lastUsedAt := row.LastUsedAt.Time
+		resp.LastUsedAt = &lastUsedAt
+	}
+	return resp
+}
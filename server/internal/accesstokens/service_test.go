@@ -0,0 +1,220 @@
+package accesstokens
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRepository struct {
+	tokens   []db.PersonalAccessToken
+	count    int64
+	created  *tokenValues
+	revoked  bool
+	byHash   map[string]db.GetActivePersonalAccessTokenByHashRow
+	touched  []int32
+	touchErr error
+}
+
+func (r *stubRepository) ListTokens(context.Context, string) ([]db.PersonalAccessToken, error) {
+	return r.tokens, nil
+}
+
+func (r *stubRepository) CountActiveTokens(context.Context, string) (int64, error) {
+	return r.count, nil
+}
+
+func (r *stubRepository) CreateToken(_ context.Context, userID string, values tokenValues) (db.PersonalAccessToken, error) {
+	r.created = &values
+	return db.PersonalAccessToken{
+		ID:          4,
+		UserID:      userID,
+		Name:        values.Name,
+		TokenHash:   values.TokenHash,
+		TokenPrefix: values.TokenPrefix,
+		Scopes:      values.Scopes,
+		ExpiresAt:   pgtype.Timestamptz{Time: values.ExpiresAt, Valid: true},
+	}, nil
+}
+
+func (r *stubRepository) RevokeToken(context.Context, string, int32) (bool, error) {
+	return r.revoked, nil
+}
+
+func (r *stubRepository) GetActiveTokenByHash(_ context.Context, tokenHash string) (*db.GetActivePersonalAccessTokenByHashRow, error) {
+	row, ok := r.byHash[tokenHash]
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+func (r *stubRepository) TouchToken(_ context.Context, _ string, id int32) error {
+	r.touched = append(r.touched, id)
+	return r.touchErr
+}
+
+func newTestService(repo Repository) *Service {
+	service := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+	service.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+	return service
+}
+
+func TestServiceCreateToken(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	t.Run("stores only the hash", func(t *testing.T) {
+		repo := &stubRepository{}
+
+		created, err := newTestService(repo).CreateToken(ctx, CreateTokenRequest{
+			Name:   "  Sync  ",
+			Scopes: []string{ScopeChatUse, ScopeWorkoutsRead, ScopeChatUse},
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.created)
+		assert.True(t, validToken(created.Token))
+		assert.Equal(t, hashToken(created.Token), repo.created.TokenHash)
+		assert.NotContains(t, repo.created.TokenHash, created.Token)
+		assert.True(t, strings.HasPrefix(created.Token, created.Prefix))
+		assert.Equal(t, "Sync", created.Name)
+		assert.Equal(t, []string{ScopeWorkoutsRead, ScopeChatUse}, created.Scopes)
+		assert.Equal(t, time.Date(2026, 5, 30, 12, 0, 0, 0, time.UTC), created.ExpiresAt)
+	})
+
+	t.Run("uses the requested expiry", func(t *testing.T) {
+		repo := &stubRepository{}
+		days := 7
+
+		_, err := newTestService(repo).CreateToken(ctx, CreateTokenRequest{Name: "CI", Scopes: []string{ScopeWorkoutsWrite}, ExpiresInDays: &days})
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC), repo.created.ExpiresAt)
+	})
+
+	t.Run("validates the request", func(t *testing.T) {
+		tooLong := maxExpiryDays + 1
+		tests := []struct {
+			name  string
+			req   CreateTokenRequest
+			field string
+		}{
+			{name: "missing name", req: CreateTokenRequest{Name: " ", Scopes: []string{ScopeWorkoutsRead}}, field: "name"},
+			{name: "no scopes", req: CreateTokenRequest{Name: "Sync"}, field: "scopes"},
+			{name: "unknown scope", req: CreateTokenRequest{Name: "Sync", Scopes: []string{"billing:manage"}}, field: "scopes"},
+			{name: "expiry too long", req: CreateTokenRequest{Name: "Sync", Scopes: []string{ScopeWorkoutsRead}, ExpiresInDays: &tooLong}, field: "expires_in_days"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := newTestService(&stubRepository{}).CreateToken(ctx, tt.req)
+
+				var validationErr *ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.field, validationErr.Field)
+			})
+		}
+	})
+
+	t.Run("limits active tokens", func(t *testing.T) {
+		repo := &stubRepository{count: maxActiveTokens}
+
+		_, err := newTestService(repo).CreateToken(ctx, CreateTokenRequest{Name: "Sync", Scopes: []string{ScopeWorkoutsRead}})
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Nil(t, repo.created)
+	})
+
+	t.Run("refuses delegated requests", func(t *testing.T) {
+		delegated := user.WithDelegation(ctx, user.Delegation{ActorID: "coach-1", Scopes: []string{user.ScopeReadWorkouts}})
+
+		_, err := newTestService(&stubRepository{}).CreateToken(delegated, CreateTokenRequest{Name: "Sync", Scopes: []string{ScopeWorkoutsRead}})
+
+		var unauthorized *apperrors.Unauthorized
+		assert.ErrorAs(t, err, &unauthorized)
+	})
+}
+
+func TestServiceListTokens(t *testing.T) {
+	lastUsed := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	repo := &stubRepository{tokens: []db.PersonalAccessToken{
+		{ID: 1, Name: "Sync", TokenHash: strings.Repeat("a", 64), TokenPrefix: "ftpat_abcdef", Scopes: []string{ScopeWorkoutsRead}, LastUsedAt: pgtype.Timestamptz{Time: lastUsed, Valid: true}},
+		{ID: 2, Name: "CI", TokenPrefix: "ftpat_ghijkl", Scopes: []string{ScopeWorkoutsWrite}},
+	}}
+
+	tokens, err := newTestService(repo).ListTokens(user.WithContext(context.Background(), "user-1"))
+
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "ftpat_abcdef", tokens[0].Prefix)
+	require.NotNil(t, tokens[0].LastUsedAt)
+	assert.Equal(t, lastUsed, *tokens[0].LastUsedAt)
+	assert.Nil(t, tokens[1].LastUsedAt)
+}
+
+func TestServiceRevokeToken(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	assert.NoError(t, newTestService(&stubRepository{revoked: true}).RevokeToken(ctx, 4))
+
+	var notFound *apperrors.NotFound
+	assert.ErrorAs(t, newTestService(&stubRepository{}).RevokeToken(ctx, 4), &notFound)
+}
+
+func TestServiceResolveAccessToken(t *testing.T) {
+	token, hash, err := newToken()
+	require.NoError(t, err)
+
+	t.Run("returns the owner and scopes", func(t *testing.T) {
+		repo := &stubRepository{byHash: map[string]db.GetActivePersonalAccessTokenByHashRow{
+			hash: {ID: 9, UserID: "user-1", Scopes: []string{ScopeWorkoutsRead}},
+		}}
+
+		userID, scopes, err := newTestService(repo).ResolveAccessToken(context.Background(), token)
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", userID)
+		assert.Equal(t, []string{ScopeWorkoutsRead}, scopes)
+		assert.Equal(t, []int32{9}, repo.touched)
+	})
+
+	t.Run("ignores failures to record use", func(t *testing.T) {
+		repo := &stubRepository{
+			byHash:   map[string]db.GetActivePersonalAccessTokenByHashRow{hash: {ID: 9, UserID: "user-1", Scopes: []string{ScopeChatUse}}},
+			touchErr: errors.New("connection reset"),
+		}
+
+		userID, _, err := newTestService(repo).ResolveAccessToken(context.Background(), token)
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", userID)
+	})
+
+	t.Run("rejects unknown tokens", func(t *testing.T) {
+		repo := &stubRepository{}
+
+		_, _, err := newTestService(repo).ResolveAccessToken(context.Background(), token)
+
+		assert.ErrorIs(t, err, ErrInvalidToken)
+		assert.Empty(t, repo.touched)
+	})
+
+	t.Run("rejects malformed tokens", func(t *testing.T) {
+		for _, malformed := range []string{"", "ftpat_short", strings.Repeat("x", len(token)), "ftpat_" + strings.Repeat("!", len(token)-len(tokenPrefix))} {
+			_, _, err := newTestService(&stubRepository{}).ResolveAccessToken(context.Background(), malformed)
+
+			assert.ErrorIs(t, err, ErrInvalidToken, malformed)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package accesstokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+var tokenLength = len(tokenPrefix) + base64.RawURLEncoding.EncodedLen(tokenBytes)
+
+// newToken returns a random token and the hash that is stored in its place.
+func newToken() (string, string, error) {
+	raw := make([]byte, tokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", fmt.Errorf("generate access token: %w", err)
+	}
+	token := tokenPrefix + base64.RawURLEncoding.EncodeToString(raw)
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// displayPrefix is the part of a token that is kept in the clear.
+func displayPrefix(token string) string {
+	return token[:len(tokenPrefix)+displayPrefixChars]
+}
+
+// validToken rejects malformed tokens before they reach the database.
+func validToken(token string) bool {
+	if len(token) != tokenLength || !strings.HasPrefix(token, tokenPrefix) {
+		return false
+	}
+	_, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(token, tokenPrefix))
+	return err == nil
+}
@@ -7,11 +7,16 @@ import (
 	"net/http"
 
 	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/request"
 	"github.com/Andrewy-gh/fittrack/server/internal/response"
 )
 
+const maxAccountJSONBodyBytes = 4 << 10
+
 type accountService interface {
 	DeleteCurrentUser(ctx context.Context) error
+	GetTimezone(ctx context.Context) (*TimezoneResponse, error)
+	UpdateTimezone(ctx context.Context, req UpdateTimezoneRequest) (*TimezoneResponse, error)
 }
 
 type Handler struct {
@@ -29,20 +34,76 @@ func NewHandler(logger *slog.Logger, service accountService) *Handler {
 func (h *Handler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
 	err := h.service.DeleteCurrentUser(r.Context())
 	if err != nil {
-		h.writeServiceError(w, r, err)
+		h.writeServiceError(w, r, err, "failed to delete account")
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error) {
+// GetTimezone godoc
+// @Summary Get account timezone
+// @Description Returns the IANA timezone used to bucket workouts into days, weeks, and months. Users without a stored timezone get UTC until a client reports one.
+// @Tags account
+// @Produce json
+// @Security StackAuth
+// @Success 200 {object} account.TimezoneResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /account/timezone [get]
+func (h *Handler) GetTimezone(w http.ResponseWriter, r *http.Request) {
+	timezone, err := h.service.GetTimezone(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get account timezone")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, timezone); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// UpdateTimezone godoc
+// @Summary Update account timezone
+// @Description Stores the IANA timezone used to bucket workouts into days, weeks, and months. Send null to clear it so the next client request can infer it again.
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param request body account.UpdateTimezoneRequest true "Timezone"
+// @Success 200 {object} account.TimezoneResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /account/timezone [put]
+func (h *Handler) UpdateTimezone(w http.ResponseWriter, r *http.Request) {
+	var req UpdateTimezoneRequest
+	if err := request.DecodeStrictJSON(w, r, &req, maxAccountJSONBodyBytes); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	timezone, err := h.service.UpdateTimezone(r.Context(), req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to update account timezone")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, timezone); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
 	var errUnauthorized *apperrors.Unauthorized
+	var errValidation *ValidationError
 
 	switch {
 	case errors.As(err, &errUnauthorized):
 		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
 	default:
-		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to delete account", err)
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
 	}
 }
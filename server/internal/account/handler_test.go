@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,13 +14,27 @@ import (
 )
 
 type stubService struct {
-	err error
+	err         error
+	timezone    *TimezoneResponse
+	timezoneErr error
+	timezoneReq *UpdateTimezoneRequest
 }
 
 func (s stubService) DeleteCurrentUser(ctx context.Context) error {
 	return s.err
 }
 
+func (s stubService) GetTimezone(ctx context.Context) (*TimezoneResponse, error) {
+	return s.timezone, s.timezoneErr
+}
+
+func (s stubService) UpdateTimezone(ctx context.Context, req UpdateTimezoneRequest) (*TimezoneResponse, error) {
+	if s.timezoneReq != nil {
+		*s.timezoneReq = req
+	}
+	return s.timezone, s.timezoneErr
+}
+
 func TestHandlerDeleteAccount_ReturnsNoContent(t *testing.T) {
 	handler := NewHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), stubService{})
 	req := httptest.NewRequest(http.MethodDelete, "/api/account", nil)
@@ -41,3 +56,46 @@ func TestHandlerDeleteAccount_ServiceFailureReturnsInternalServerError(t *testin
 	require.Equal(t, http.StatusInternalServerError, rr.Code)
 	assert.Contains(t, rr.Body.String(), "failed to delete account")
 }
+
+func TestHandlerGetTimezone_ReturnsTimezone(t *testing.T) {
+	handler := NewHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), stubService{
+		timezone: &TimezoneResponse{Timezone: "UTC", Source: TimezoneSourceDefault},
+	})
+	req := httptest.NewRequest(http.MethodGet, "/api/account/timezone", nil)
+	rr := httptest.NewRecorder()
+
+	handler.GetTimezone(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"timezone":"UTC","source":"default"}`, rr.Body.String())
+}
+
+func TestHandlerUpdateTimezone_DecodesRequest(t *testing.T) {
+	var captured UpdateTimezoneRequest
+	handler := NewHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), stubService{
+		timezone:    &TimezoneResponse{Timezone: "America/Chicago", Source: TimezoneSourceStored},
+		timezoneReq: &captured,
+	})
+	req := httptest.NewRequest(http.MethodPut, "/api/account/timezone", strings.NewReader(`{"timezone":"America/Chicago"}`))
+	rr := httptest.NewRecorder()
+
+	handler.UpdateTimezone(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NotNil(t, captured.Timezone)
+	assert.Equal(t, "America/Chicago", *captured.Timezone)
+	assert.Contains(t, rr.Body.String(), `"source":"stored"`)
+}
+
+func TestHandlerUpdateTimezone_ValidationErrorReturnsBadRequest(t *testing.T) {
+	handler := NewHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), stubService{
+		timezoneErr: &ValidationError{Field: "timezone", Message: "must be a valid IANA timezone"},
+	})
+	req := httptest.NewRequest(http.MethodPut, "/api/account/timezone", strings.NewReader(`{"timezone":"Nowhere"}`))
+	rr := httptest.NewRecorder()
+
+	handler.UpdateTimezone(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "timezone")
+}
@@ -0,0 +1,39 @@
+package account
+
+import "strings"
+
+const (
+	TimezoneSourceStored  = "stored"
+	TimezoneSourceDefault = "default"
+)
+
+type TimezoneResponse struct {
+	Timezone string `json:"timezone"`
+	Source   string `json:"source" enums:"stored,default"`
+}
+
+// UpdateTimezoneRequest sets the IANA timezone used for day, week, and month
+// bucketing. A null timezone clears the stored value so the next client
+// request can infer it again.
+type UpdateTimezoneRequest struct {
+	Timezone *string `json:"timezone" extensions:"x-nullable"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+func timezoneResponse(stored *string) *TimezoneResponse {
+	if stored == nil {
+		return &TimezoneResponse{Timezone: "UTC", Source: TimezoneSourceDefault}
+	}
+	return &TimezoneResponse{Timezone: *stored, Source: TimezoneSourceStored}
+}
@@ -8,10 +8,13 @@ import (
 	"time"
 
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Repository interface {
 	DeleteUser(ctx context.Context, userID string) error
+	GetTimezone(ctx context.Context, userID string) (*string, error)
+	UpdateTimezone(ctx context.Context, userID string, timezone *string) (*string, error)
 }
 
 var ErrUserNotDeleted = errors.New("user account was not deleted")
@@ -44,4 +47,42 @@ func (r *repository) DeleteUser(ctx context.Context, userID string) error {
 	return nil
 }
 
+func (r *repository) GetTimezone(ctx context.Context, userID string) (*string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	row, err := r.queries.GetUserByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user timezone: %w", err)
+	}
+	return timezonePtr(row.Timezone), nil
+}
+
+func (r *repository) UpdateTimezone(ctx context.Context, userID string, timezone *string) (*string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	param := pgtype.Text{}
+	if timezone != nil {
+		param = pgtype.Text{String: *timezone, Valid: true}
+	}
+	row, err := r.queries.UpdateUserTimezone(ctx, db.UpdateUserTimezoneParams{
+		UserID:   userID,
+		Timezone: param,
+	})
+	if err != nil {
+		r.logger.Error("database error updating user timezone", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("update user timezone: %w", err)
+	}
+	return timezonePtr(row.Timezone), nil
+}
+
+func timezonePtr(value pgtype.Text) *string {
+	if !value.Valid {
+		return nil
+	}
+	timezone := value.String
+	return &timezone
+}
+
 var _ Repository = (*repository)(nil)
@@ -65,3 +65,38 @@ func (s *Service) deleteLocalAccountData(ctx context.Context, userID string, sub
 	}
 	return nil
 }
+
+func (s *Service) GetTimezone(ctx context.Context) (*TimezoneResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" {
+		return nil, apperrors.NewUnauthorized("account", "")
+	}
+
+	stored, err := s.repo.GetTimezone(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get account timezone: %w", err)
+	}
+	return timezoneResponse(stored), nil
+}
+
+func (s *Service) UpdateTimezone(ctx context.Context, req UpdateTimezoneRequest) (*TimezoneResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" {
+		return nil, apperrors.NewUnauthorized("account", "")
+	}
+
+	var timezone *string
+	if req.Timezone != nil {
+		normalized, err := user.NormalizeTimezone(*req.Timezone)
+		if err != nil {
+			return nil, &ValidationError{Field: "timezone", Message: "must be a valid IANA timezone such as America/New_York, or null"}
+		}
+		timezone = &normalized
+	}
+
+	stored, err := s.repo.UpdateTimezone(ctx, userID, timezone)
+	if err != nil {
+		return nil, fmt.Errorf("update account timezone: %w", err)
+	}
+	return timezoneResponse(stored), nil
+}
@@ -13,9 +13,13 @@ import (
 )
 
 type stubRepository struct {
-	deleteErr     error
-	deleteCalled  bool
-	deletedUserID string
+	deleteErr       error
+	deleteCalled    bool
+	deletedUserID   string
+	timezone        *string
+	timezoneErr     error
+	updatedTimezone *string
+	updateCalled    bool
 }
 
 func (s *stubRepository) DeleteUser(ctx context.Context, userID string) error {
@@ -24,6 +28,16 @@ func (s *stubRepository) DeleteUser(ctx context.Context, userID string) error {
 	return s.deleteErr
 }
 
+func (s *stubRepository) GetTimezone(ctx context.Context, userID string) (*string, error) {
+	return s.timezone, s.timezoneErr
+}
+
+func (s *stubRepository) UpdateTimezone(ctx context.Context, userID string, timezone *string) (*string, error) {
+	s.updateCalled = true
+	s.updatedTimezone = timezone
+	return timezone, s.timezoneErr
+}
+
 type stubBillingCanceler struct {
 	err    error
 	called bool
@@ -109,3 +123,40 @@ func TestServiceDeleteCurrentUser_ReturnsLocalDeletionFailureAfterSubscriptionCa
 	assert.True(t, repo.deleteCalled)
 	assert.Equal(t, "user-123", repo.deletedUserID)
 }
+
+func TestServiceGetTimezone_DefaultsToUTCWhenUnset(t *testing.T) {
+	service := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), &stubRepository{}, nil)
+	ctx := user.WithContext(context.Background(), "user-123")
+
+	timezone, err := service.GetTimezone(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, &TimezoneResponse{Timezone: "UTC", Source: TimezoneSourceDefault}, timezone)
+}
+
+func TestServiceUpdateTimezone_NormalizesAndStores(t *testing.T) {
+	repo := &stubRepository{}
+	service := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, nil)
+	ctx := user.WithContext(context.Background(), "user-123")
+	value := " Europe/Paris "
+
+	timezone, err := service.UpdateTimezone(ctx, UpdateTimezoneRequest{Timezone: &value})
+
+	require.NoError(t, err)
+	require.NotNil(t, repo.updatedTimezone)
+	assert.Equal(t, "Europe/Paris", *repo.updatedTimezone)
+	assert.Equal(t, TimezoneSourceStored, timezone.Source)
+}
+
+func TestServiceUpdateTimezone_RejectsInvalidTimezone(t *testing.T) {
+	repo := &stubRepository{}
+	service := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, nil)
+	ctx := user.WithContext(context.Background(), "user-123")
+	value := "Mars/Olympus_Mons"
+
+	_, err := service.UpdateTimezone(ctx, UpdateTimezoneRequest{Timezone: &value})
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.False(t, repo.updateCalled)
+}
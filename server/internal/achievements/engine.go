@@ -0,0 +1,201 @@
+package achievements
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
+)
+
+// metricTimeline replays the history in workout order and records every
+// metric after each workout.
+func metricTimeline(data historyData) []snapshot {
+	timeline := make([]snapshot, 0, len(data.Workouts))
+	fiveRepBests := map[int32]float64{}
+	fiveRepPRs := 0
+	streak := 0
+	var lastWeek time.Time
+
+	for i, workout := range data.Workouts {
+		week := weekStart(workout.Day)
+		switch {
+		case i > 0 && week.Equal(lastWeek):
+		case i > 0 && week.Equal(lastWeek.AddDate(0, 0, 7)):
+			streak++
+		default:
+			streak = 1
+		}
+		lastWeek = week
+
+		volume, benchTop := 0.0, 0.0
+		setFiveRepPR := false
+		for _, exercise := range workout.Exercises {
+			volume += exercise.Volume
+			if isBench(exercise, data.BenchExerciseID) {
+				benchTop = math.Max(benchTop, exercise.TopWeight)
+			}
+			if exercise.TopFiveRepWeight > 0 {
+				best, seen := fiveRepBests[exercise.ExerciseID]
+				if seen && exercise.TopFiveRepWeight > best {
+					setFiveRepPR = true
+				}
+				fiveRepBests[exercise.ExerciseID] = math.Max(best, exercise.TopFiveRepWeight)
+			}
+		}
+		if setFiveRepPR {
+			fiveRepPRs++
+		}
+
+		benchRatio := 0.0
+		if bodyweight := bodyweightOn(data.Bodyweights, workout.Day); bodyweight > 0 {
+			benchRatio = benchTop / bodyweight
+		}
+
+		timeline = append(timeline, snapshot{
+			WorkoutID: workout.ID,
+			Day:       workout.Day,
+			Values: map[string]float64{
+				MetricWorkoutCount:         float64(i + 1),
+				MetricWeeklyStreak:         float64(streak),
+				MetricSessionVolume:        volume,
+				MetricBenchBodyweightRatio: benchRatio,
+				MetricFiveRepMaxPRs:        float64(fiveRepPRs),
+			},
+		})
+	}
+	return timeline
+}
+
+// newlyUnlocked returns the rules not yet earned that the timeline meets,
+// each with the first workout that met it.
+func newlyUnlocked(catalog []Rule, timeline []snapshot, earned map[string]bool) []unlock {
+	var unlocks []unlock
+	for _, rule := range catalog {
+		if earned[rule.Key] {
+			continue
+		}
+		for _, snap := range timeline {
+			if snap.Values[rule.Metric] >= rule.Threshold {
+				unlocks = append(unlocks, unlock{Rule: rule, WorkoutID: snap.WorkoutID})
+				break
+			}
+		}
+	}
+	return unlocks
+}
+
+// buildAchievements reports every rule in catalog as earned or in progress.
+// Stored unlocks for keys no longer in the catalog are ignored.
+func buildAchievements(catalog []Rule, timeline []snapshot, earned []earnedAchievement, today time.Time) AchievementsResponse {
+	resp := AchievementsResponse{
+		Earned:     []AchievementResponse{},
+		InProgress: []AchievementResponse{},
+	}
+
+	byKey := make(map[string]Rule, len(catalog))
+	for _, rule := range catalog {
+		byKey[rule.Key] = rule
+	}
+	earnedKeys := make(map[string]bool, len(earned))
+	for _, e := range earned {
+		rule, ok := byKey[e.Key]
+		if !ok || earnedKeys[e.Key] {
+			continue
+		}
+		earnedKeys[e.Key] = true
+		achievement := achievementFor(rule, currentValue(rule.Metric, timeline, today))
+		achievement.Earned = true
+		achievement.Progress = 1
+		achievement.WorkoutID = e.WorkoutID
+		unlockedAt := e.UnlockedAt
+		achievement.UnlockedAt = &unlockedAt
+		resp.Earned = append(resp.Earned, achievement)
+	}
+
+	for _, rule := range catalog {
+		if earnedKeys[rule.Key] {
+			continue
+		}
+		resp.InProgress = append(resp.InProgress, achievementFor(rule, currentValue(rule.Metric, timeline, today)))
+	}
+	return resp
+}
+
+func achievementFor(rule Rule, current float64) AchievementResponse {
+	progress := 0.0
+	if rule.Threshold > 0 {
+		progress = math.Min(1, current/rule.Threshold)
+	}
+	return AchievementResponse{
+		Key:         rule.Key,
+		Name:        rule.Name,
+		Description: rule.Description,
+		Progress:    roundTo(progress, 2),
+		Current:     roundTo(current, 2),
+		Target:      rule.Threshold,
+	}
+}
+
+// currentValue is how far the user has got toward a metric as of today:
+// the running count for counters, the streak still alive this week or last,
+// and the best single workout for per-workout metrics.
+func currentValue(metric string, timeline []snapshot, today time.Time) float64 {
+	if len(timeline) == 0 {
+		return 0
+	}
+	last := timeline[len(timeline)-1]
+
+	switch metric {
+	case MetricWorkoutCount, MetricFiveRepMaxPRs:
+		return last.Values[metric]
+	case MetricWeeklyStreak:
+		if weekStart(last.Day).Before(weekStart(today).AddDate(0, 0, -7)) {
+			return 0
+		}
+		return last.Values[metric]
+	default:
+		best := 0.0
+		for _, snap := range timeline {
+			best = math.Max(best, snap.Values[metric])
+		}
+		return best
+	}
+}
+
+// isBench reports whether the exercise is the user's bench press: their
+// designated bench when set, otherwise any barbell exercise named like one.
+func isBench(exercise exerciseTotals, benchExerciseID *int32) bool {
+	if benchExerciseID != nil {
+		return exercise.ExerciseID == *benchExerciseID
+	}
+	return strings.Contains(strings.ToLower(exercise.Name), "bench press") &&
+		equipment.KindForExercise(exercise.Name) == equipment.LoadBarbell
+}
+
+// bodyweightOn returns the latest bodyweight logged on or before day, or the
+// first one logged when none precede it. readings must be sorted by day.
+func bodyweightOn(readings []point, day time.Time) float64 {
+	if len(readings) == 0 {
+		return 0
+	}
+	value := readings[0].Value
+	for _, reading := range readings {
+		if reading.Day.After(day) {
+			break
+		}
+		value = reading.Value
+	}
+	return value
+}
+
+// weekStart returns the Monday on or before day.
+func weekStart(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
@@ -0,0 +1,151 @@
+package achievements
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var monday = time.Date(2026, 7, 6, 0, 0, 0, 0, time.UTC)
+
+func day(offset int) time.Time {
+	return monday.AddDate(0, 0, offset)
+}
+
+func TestMetricTimeline(t *testing.T) {
+	data := historyData{
+		Workouts: []workoutTotals{
+			{ID: 1, Day: day(0), Exercises: []exerciseTotals{
+				{ExerciseID: 10, Name: "Bench Press", Volume: 900, TopWeight: 60, TopFiveRepWeight: 60},
+			}},
+			{ID: 2, Day: day(3), Exercises: []exerciseTotals{
+				{ExerciseID: 10, Name: "Bench Press", Volume: 700, TopWeight: 65, TopFiveRepWeight: 55},
+				{ExerciseID: 11, Name: "Back Squat", Volume: 500, TopWeight: 100, TopFiveRepWeight: 100},
+			}},
+			{ID: 3, Day: day(8), Exercises: []exerciseTotals{
+				{ExerciseID: 10, Name: "Bench Press", Volume: 600, TopWeight: 82, TopFiveRepWeight: 62.5},
+			}},
+			{ID: 4, Day: day(22)},
+		},
+		Bodyweights: []point{{Day: day(2), Value: 80}, {Day: day(7), Value: 82}},
+	}
+
+	timeline := metricTimeline(data)
+
+	require.Len(t, timeline, 4)
+	assert.Equal(t, []float64{1, 2, 3, 4}, metricValues(timeline, MetricWorkoutCount))
+	assert.Equal(t, []float64{1, 1, 2, 1}, metricValues(timeline, MetricWeeklyStreak), "a skipped week resets the streak")
+	assert.Equal(t, []float64{900, 1200, 600, 0}, metricValues(timeline, MetricSessionVolume))
+	assert.Equal(t, []float64{0, 0, 1, 1}, metricValues(timeline, MetricFiveRepMaxPRs), "first sets and lighter sets are not records")
+	assert.Equal(t, []float64{0.75, 65.0 / 80, 1, 0}, metricValues(timeline, MetricBenchBodyweightRatio), "the first bodyweight stands in before any reading")
+}
+
+func TestMetricTimelineUsesDesignatedBench(t *testing.T) {
+	benchID := int32(12)
+	data := historyData{
+		Workouts: []workoutTotals{{ID: 1, Day: day(0), Exercises: []exerciseTotals{
+			{ExerciseID: 10, Name: "Bench Press", TopWeight: 100},
+			{ExerciseID: 12, Name: "Paused Bench", TopWeight: 70},
+			{ExerciseID: 13, Name: "Dumbbell Bench Press", TopWeight: 90},
+		}}},
+		Bodyweights:     []point{{Day: day(0), Value: 70}},
+		BenchExerciseID: &benchID,
+	}
+
+	assert.Equal(t, []float64{1}, metricValues(metricTimeline(data), MetricBenchBodyweightRatio))
+
+	data.BenchExerciseID = nil
+	assert.Equal(t, []float64{100.0 / 70}, metricValues(metricTimeline(data), MetricBenchBodyweightRatio), "dumbbell benches are not counted by name")
+}
+
+func TestNewlyUnlocked(t *testing.T) {
+	timeline := []snapshot{
+		{WorkoutID: 1, Values: map[string]float64{MetricWorkoutCount: 1, MetricSessionVolume: 400}},
+		{WorkoutID: 2, Values: map[string]float64{MetricWorkoutCount: 2, MetricSessionVolume: 1100}},
+		{WorkoutID: 3, Values: map[string]float64{MetricWorkoutCount: 3, MetricSessionVolume: 1500}},
+	}
+	catalog := []Rule{
+		{Key: "first_workout", Metric: MetricWorkoutCount, Threshold: 1},
+		{Key: "session_volume_1000", Metric: MetricSessionVolume, Threshold: 1000},
+		{Key: "workouts_100", Metric: MetricWorkoutCount, Threshold: 100},
+	}
+
+	unlocks := newlyUnlocked(catalog, timeline, map[string]bool{"first_workout": true})
+
+	require.Len(t, unlocks, 1)
+	assert.Equal(t, "session_volume_1000", unlocks[0].Rule.Key)
+	assert.Equal(t, int32(2), unlocks[0].WorkoutID, "the first workout to meet the rule triggers it")
+}
+
+func TestBuildAchievements(t *testing.T) {
+	timeline := []snapshot{
+		{WorkoutID: 1, Day: day(0), Values: map[string]float64{MetricWorkoutCount: 1, MetricWeeklyStreak: 1, MetricSessionVolume: 800}},
+		{WorkoutID: 2, Day: day(7), Values: map[string]float64{MetricWorkoutCount: 2, MetricWeeklyStreak: 2, MetricSessionVolume: 300}},
+	}
+	workoutID := int32(1)
+	unlockedAt := day(0).Add(18 * time.Hour)
+	earned := []earnedAchievement{
+		{Key: "first_workout", WorkoutID: &workoutID, UnlockedAt: unlockedAt},
+		{Key: "retired_rule", UnlockedAt: unlockedAt},
+	}
+
+	t.Run("reports earned and in-progress achievements", func(t *testing.T) {
+		resp := buildAchievements(rules, timeline, earned, day(9))
+
+		require.Len(t, resp.Earned, 1)
+		assert.Equal(t, "first_workout", resp.Earned[0].Key)
+		assert.True(t, resp.Earned[0].Earned)
+		assert.Equal(t, 1.0, resp.Earned[0].Progress)
+		assert.Equal(t, &workoutID, resp.Earned[0].WorkoutID)
+
+		require.Len(t, resp.InProgress, len(rules)-1)
+		progress := map[string]AchievementResponse{}
+		for _, achievement := range resp.InProgress {
+			progress[achievement.Key] = achievement
+		}
+		assert.Equal(t, 0.02, progress["workouts_100"].Progress)
+		assert.Equal(t, 0.2, progress["weekly_streak_10"].Progress)
+		assert.Equal(t, 800.0, progress["session_volume_1000"].Current, "the best session counts")
+		assert.Equal(t, 0.8, progress["session_volume_1000"].Progress)
+	})
+
+	t.Run("lapsed streaks restart from zero", func(t *testing.T) {
+		resp := buildAchievements(rules, timeline, earned, day(21))
+
+		for _, achievement := range resp.InProgress {
+			if achievement.Key == "weekly_streak_10" {
+				assert.Equal(t, 0.0, achievement.Current)
+				return
+			}
+		}
+		t.Fatal("weekly_streak_10 missing from in-progress achievements")
+	})
+}
+
+func TestRulesAreWellFormed(t *testing.T) {
+	metrics := map[string]bool{
+		MetricWorkoutCount:         true,
+		MetricWeeklyStreak:         true,
+		MetricSessionVolume:        true,
+		MetricBenchBodyweightRatio: true,
+		MetricFiveRepMaxPRs:        true,
+	}
+	keys := map[string]bool{}
+	for _, rule := range rules {
+		assert.False(t, keys[rule.Key], "duplicate rule key %s", rule.Key)
+		keys[rule.Key] = true
+		assert.LessOrEqual(t, len(rule.Key), 64, "rule key %s exceeds the stored length", rule.Key)
+		assert.True(t, metrics[rule.Metric], "rule %s uses unknown metric %s", rule.Key, rule.Metric)
+		assert.Positive(t, rule.Threshold, "rule %s needs a positive threshold", rule.Key)
+	}
+}
+
+func metricValues(timeline []snapshot, metric string) []float64 {
+	values := make([]float64, 0, len(timeline))
+	for _, snap := range timeline {
+		values = append(values, snap.Values[metric])
+	}
+	return values
+}
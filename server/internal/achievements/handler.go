@@ -0,0 +1,60 @@
+package achievements
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type achievementsService interface {
+	List(ctx context.Context) (*AchievementsResponse, error)
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service achievementsService
+}
+
+func NewHandler(logger *slog.Logger, service achievementsService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// ListAchievements godoc
+// @Summary List achievements
+// @Description Returns achievements the authenticated user has earned, with the workout that unlocked each, and progress fractions toward the rest.
+// @Tags achievements
+// @Produce json
+// @Security StackAuth
+// @Success 200 {object} achievements.AchievementsResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /achievements [get]
+func (h *Handler) ListAchievements(w http.ResponseWriter, r *http.Request) {
+	achievements, err := h.service.List(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list achievements")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, achievements); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
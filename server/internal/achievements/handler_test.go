@@ -0,0 +1,50 @@
+package achievements
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubAchievementsService struct {
+	resp *AchievementsResponse
+	err  error
+}
+
+func (s *stubAchievementsService) List(context.Context) (*AchievementsResponse, error) {
+	return s.resp, s.err
+}
+
+func TestHandlerListAchievements(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("lists achievements", func(t *testing.T) {
+		handler := NewHandler(logger, &stubAchievementsService{resp: &AchievementsResponse{
+			Earned:     []AchievementResponse{},
+			InProgress: []AchievementResponse{{Key: "workouts_100", Progress: 0.42, Current: 42, Target: 100}},
+		}})
+		rr := httptest.NewRecorder()
+
+		handler.ListAchievements(rr, httptest.NewRequest(http.MethodGet, "/api/achievements", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"earned":[]`)
+		assert.Contains(t, rr.Body.String(), `"progress":0.42`)
+	})
+
+	t.Run("maps unauthorized", func(t *testing.T) {
+		handler := NewHandler(logger, &stubAchievementsService{err: &apperrors.Unauthorized{Resource: achievementsResource}})
+		rr := httptest.NewRecorder()
+
+		handler.ListAchievements(rr, httptest.NewRequest(http.MethodGet, "/api/achievements", nil))
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
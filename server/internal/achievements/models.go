@@ -0,0 +1,107 @@
+package achievements
+
+import "time"
+
+const achievementsResource = "achievements"
+
+// Metrics are the values rules are evaluated against. Each is recomputed
+// after every workout in date order; see metricTimeline.
+const (
+	// MetricWorkoutCount is the number of workouts logged so far.
+	MetricWorkoutCount = "workout_count"
+	// MetricWeeklyStreak is the run of consecutive Monday-start weeks with at
+	// least one workout, ending at the workout's week.
+	MetricWeeklyStreak = "weekly_streak"
+	// MetricSessionVolume is the workout's working-set weight x reps, in the
+	// units the user logs lifts in.
+	MetricSessionVolume = "session_volume"
+	// MetricBenchBodyweightRatio is the workout's heaviest working barbell
+	// bench set divided by the user's bodyweight on that day.
+	MetricBenchBodyweightRatio = "bench_bodyweight_ratio"
+	// MetricFiveRepMaxPRs counts workouts so far that beat an exercise's
+	// previous best weight for a working set of at least 5 reps.
+	MetricFiveRepMaxPRs = "five_rep_max_prs"
+)
+
+// Rule unlocks an achievement the first time Metric reaches Threshold after
+// a workout. Rules live in code and are keyed by Key in storage, so adding a
+// rule needs no schema change.
+type Rule struct {
+	Key         string
+	Name        string
+	Description string
+	Metric      string
+	Threshold   float64
+}
+
+// AchievementResponse is one achievement with the user's progress toward it.
+// Progress is Current over Target, capped at 1. WorkoutID is the workout that
+// unlocked it and is omitted once that workout is deleted.
+type AchievementResponse struct {
+	Key         string     `json:"key" example:"workouts_100"`
+	Name        string     `json:"name" example:"Centurion"`
+	Description string     `json:"description" example:"Log 100 workouts"`
+	Earned      bool       `json:"earned"`
+	Progress    float64    `json:"progress" example:"0.42"`
+	Current     float64    `json:"current" example:"42"`
+	Target      float64    `json:"target" example:"100"`
+	WorkoutID   *int32     `json:"workout_id,omitempty" example:"87"`
+	UnlockedAt  *time.Time `json:"unlocked_at,omitempty"`
+}
+
+// AchievementsResponse lists earned achievements in unlock order and the
+// rest in catalog order.
+type AchievementsResponse struct {
+	Earned     []AchievementResponse `json:"earned"`
+	InProgress []AchievementResponse `json:"in_progress"`
+}
+
+// exerciseTotals are one exercise's working sets in one workout.
+type exerciseTotals struct {
+	ExerciseID       int32
+	Name             string
+	Volume           float64
+	TopWeight        float64
+	TopFiveRepWeight float64
+}
+
+// workoutTotals is one workout on its local civil day at UTC midnight.
+type workoutTotals struct {
+	ID        int32
+	Day       time.Time
+	Exercises []exerciseTotals
+}
+
+type point struct {
+	Day   time.Time
+	Value float64
+}
+
+// historyData is everything rules are evaluated against. Workouts and
+// Bodyweights are sorted by day. BenchExerciseID is the user's designated
+// bench from their strength profile, if any.
+type historyData struct {
+	Workouts        []workoutTotals
+	Bodyweights     []point
+	BenchExerciseID *int32
+}
+
+// earnedAchievement is a stored unlock.
+type earnedAchievement struct {
+	Key        string
+	WorkoutID  *int32
+	UnlockedAt time.Time
+}
+
+// snapshot is every metric's value right after one workout.
+type snapshot struct {
+	WorkoutID int32
+	Day       time.Time
+	Values    map[string]float64
+}
+
+// unlock is a rule met by the history, with the workout that first met it.
+type unlock struct {
+	Rule      Rule
+	WorkoutID int32
+}
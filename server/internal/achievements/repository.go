@@ -0,0 +1,148 @@
+package achievements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	History(ctx context.Context, userID string) (*historyData, error)
+	ListEarned(ctx context.Context, userID string) ([]earnedAchievement, error)
+	// Unlock stores an achievement and reports whether it was new.
+	Unlock(ctx context.Context, userID string, key string, workoutID int32) (bool, error)
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	conn    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		conn:    conn,
+	}
+}
+
+// History reads workouts, bodyweights and the designated bench. Workout days
+// are bucketed in the request timezone.
+func (r *repository) History(ctx context.Context, userID string) (*historyData, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListAchievementWorkoutExercises(ctx, userID)
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list achievement workouts failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list achievement workouts: %w", err)
+	}
+
+	data := &historyData{}
+	loc := user.Location(ctx)
+	for _, row := range rows {
+		if len(data.Workouts) == 0 || data.Workouts[len(data.Workouts)-1].ID != row.WorkoutID {
+			local := row.Date.Time.In(loc)
+			data.Workouts = append(data.Workouts, workoutTotals{
+				ID:  row.WorkoutID,
+				Day: time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC),
+			})
+		}
+		if !row.ExerciseID.Valid {
+			continue
+		}
+		workout := &data.Workouts[len(data.Workouts)-1]
+		workout.Exercises = append(workout.Exercises, exerciseTotals{
+			ExerciseID:       row.ExerciseID.Int32,
+			Name:             row.ExerciseName.String,
+			Volume:           row.Volume,
+			TopWeight:        row.TopWeight,
+			TopFiveRepWeight: row.TopFiveRepWeight,
+		})
+	}
+
+	readings, err := r.queries.ListBodyweightReadings(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list bodyweight readings: %w", err)
+	}
+	for _, reading := range readings {
+		if !reading.MeasuredOn.Valid || !reading.Bodyweight.Valid {
+			continue
+		}
+		value, err := reading.Bodyweight.Float64Value()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert numeric to float64: %w", err)
+		}
+		data.Bodyweights = append(data.Bodyweights, point{Day: reading.MeasuredOn.Time, Value: value.Float64})
+	}
+
+	profile, err := r.queries.GetUserStrengthProfile(ctx, userID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("get strength profile: %w", err)
+	}
+	if err == nil && profile.BenchExerciseID.Valid {
+		benchID := profile.BenchExerciseID.Int32
+		data.BenchExerciseID = &benchID
+	}
+
+	return data, nil
+}
+
+func (r *repository) ListEarned(ctx context.Context, userID string) ([]earnedAchievement, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListUserAchievements(ctx, userID)
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list achievements failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list achievements: %w", err)
+	}
+
+	earned := make([]earnedAchievement, 0, len(rows))
+	for _, row := range rows {
+		e := earnedAchievement{Key: row.AchievementKey, UnlockedAt: row.UnlockedAt.Time}
+		if row.WorkoutID.Valid {
+			workoutID := row.WorkoutID.Int32
+			e.WorkoutID = &workoutID
+		}
+		earned = append(earned, e)
+	}
+	return earned, nil
+}
+
+func (r *repository) Unlock(ctx context.Context, userID string, key string, workoutID int32) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rowsAffected, err := r.queries.CreateUserAchievement(ctx, db.CreateUserAchievementParams{
+		UserID:         userID,
+		AchievementKey: key,
+		WorkoutID:      pgtype.Int4{Int32: workoutID, Valid: true},
+	})
+	if err != nil {
+		r.logger.Error("database error unlocking achievement", "error", err, "user_id", userID, "achievement", key)
+		return false, fmt.Errorf("unlock achievement %s: %w", key, err)
+	}
+	return rowsAffected > 0, nil
+}
+
+var _ Repository = (*repository)(nil)
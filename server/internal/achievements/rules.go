@@ -0,0 +1,48 @@
+package achievements
+
+// rules is the achievement catalog in display order. New achievements are
+// added here; keys are stored, so never rename one.
+var rules = []Rule{
+	{
+		Key:         "first_workout",
+		Name:        "First Rep",
+		Description: "Log your first workout",
+		Metric:      MetricWorkoutCount,
+		Threshold:   1,
+	},
+	{
+		Key:         "workouts_100",
+		Name:        "Centurion",
+		Description: "Log 100 workouts",
+		Metric:      MetricWorkoutCount,
+		Threshold:   100,
+	},
+	{
+		Key:         "weekly_streak_10",
+		Name:        "Ten-Week Streak",
+		Description: "Train at least once a week for 10 weeks in a row",
+		Metric:      MetricWeeklyStreak,
+		Threshold:   10,
+	},
+	{
+		Key:         "session_volume_1000",
+		Name:        "Ton Session",
+		Description: "Move 1000 kg of working-set volume in one workout",
+		Metric:      MetricSessionVolume,
+		Threshold:   1000,
+	},
+	{
+		Key:         "bodyweight_bench",
+		Name:        "Bodyweight Bench",
+		Description: "Bench press your bodyweight for a working set",
+		Metric:      MetricBenchBodyweightRatio,
+		Threshold:   1,
+	},
+	{
+		Key:         "new_5rm",
+		Name:        "New 5RM",
+		Description: "Beat your best weight for 5 or more reps on any exercise",
+		Metric:      MetricFiveRepMaxPRs,
+		Threshold:   1,
+	},
+}
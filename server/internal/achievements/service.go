@@ -0,0 +1,91 @@
+package achievements
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger *slog.Logger
+	repo   Repository
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// List returns earned achievements and progress toward the rest.
+func (s *Service) List(ctx context.Context) (*AchievementsResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadWorkouts) {
+		return nil, &apperrors.Unauthorized{Resource: achievementsResource, UserID: ""}
+	}
+
+	history, err := s.repo.History(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load achievement history: %w", err)
+	}
+	earned, err := s.repo.ListEarned(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list achievements: %w", err)
+	}
+
+	resp := buildAchievements(rules, metricTimeline(*history), earned, user.Today(ctx))
+	return &resp, nil
+}
+
+// Evaluate runs every rule against the user's history and stores the ones
+// newly met, returning them. It is called after each workout write; the whole
+// history is replayed so edits to older workouts are credited too.
+func (s *Service) Evaluate(ctx context.Context) ([]AchievementResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return nil, &apperrors.Unauthorized{Resource: achievementsResource, UserID: ""}
+	}
+
+	earned, err := s.repo.ListEarned(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list achievements: %w", err)
+	}
+	earnedKeys := make(map[string]bool, len(earned))
+	for _, e := range earned {
+		earnedKeys[e.Key] = true
+	}
+	if len(earnedKeys) >= len(rules) {
+		return nil, nil
+	}
+
+	history, err := s.repo.History(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load achievement history: %w", err)
+	}
+	timeline := metricTimeline(*history)
+
+	var unlocked []AchievementResponse
+	for _, u := range newlyUnlocked(rules, timeline, earnedKeys) {
+		created, err := s.repo.Unlock(ctx, userID, u.Rule.Key, u.WorkoutID)
+		if err != nil {
+			return unlocked, fmt.Errorf("failed to unlock achievement: %w", err)
+		}
+		if !created {
+			continue
+		}
+		achievement := achievementFor(u.Rule, currentValue(u.Rule.Metric, timeline, user.Today(ctx)))
+		achievement.Earned = true
+		achievement.Progress = 1
+		workoutID := u.WorkoutID
+		achievement.WorkoutID = &workoutID
+		unlockedAt := time.Now().UTC()
+		achievement.UnlockedAt = &unlockedAt
+		unlocked = append(unlocked, achievement)
+	}
+	return unlocked, nil
+}
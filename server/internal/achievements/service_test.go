@@ -0,0 +1,78 @@
+package achievements
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRepository struct {
+	history  historyData
+	earned   []earnedAchievement
+	unlocked map[string]int32
+}
+
+func (r *stubRepository) History(context.Context, string) (*historyData, error) {
+	return &r.history, nil
+}
+
+func (r *stubRepository) ListEarned(context.Context, string) ([]earnedAchievement, error) {
+	return r.earned, nil
+}
+
+func (r *stubRepository) Unlock(_ context.Context, _ string, key string, workoutID int32) (bool, error) {
+	if _, ok := r.unlocked[key]; ok {
+		return false, nil
+	}
+	r.unlocked[key] = workoutID
+	return true, nil
+}
+
+func TestServiceEvaluate(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := user.WithContext(context.Background(), "user-1")
+	history := historyData{Workouts: []workoutTotals{
+		{ID: 7, Day: day(0), Exercises: []exerciseTotals{{ExerciseID: 1, Name: "Back Squat", Volume: 1200, TopWeight: 120}}},
+	}}
+
+	t.Run("unlocks newly met rules with the triggering workout", func(t *testing.T) {
+		repo := &stubRepository{history: history, unlocked: map[string]int32{}}
+
+		unlocked, err := NewService(logger, repo).Evaluate(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int32{"first_workout": 7, "session_volume_1000": 7}, repo.unlocked)
+		require.Len(t, unlocked, 2)
+		assert.True(t, unlocked[0].Earned)
+		assert.Equal(t, int32(7), *unlocked[0].WorkoutID)
+	})
+
+	t.Run("skips rules already earned", func(t *testing.T) {
+		repo := &stubRepository{
+			history:  history,
+			earned:   []earnedAchievement{{Key: "first_workout"}},
+			unlocked: map[string]int32{},
+		}
+
+		unlocked, err := NewService(logger, repo).Evaluate(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, unlocked, 1)
+		assert.Equal(t, "session_volume_1000", unlocked[0].Key)
+	})
+
+	t.Run("refuses delegated requests", func(t *testing.T) {
+		delegated := user.WithDelegation(ctx, user.Delegation{ActorID: "coach-1", Scopes: []string{user.ScopeReadWorkouts}})
+
+		_, err := NewService(logger, &stubRepository{}).Evaluate(delegated)
+
+		var unauthorized *apperrors.Unauthorized
+		require.ErrorAs(t, err, &unauthorized)
+	})
+}
@@ -107,13 +107,13 @@ func (h *Handler) StreamValidate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	sse, err := h.startSSE(w)
+	sse, err := response.StartSSE(w)
 	if err != nil {
 		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to start ai chat validation stream", err)
 		return
 	}
 
-	if err := sse.write("start", StreamEvent{
+	if err := sse.Write("start", StreamEvent{
 		Type:      "start",
 		RequestID: request.GetRequestID(r.Context()),
 	}); err != nil {
@@ -122,7 +122,7 @@ func (h *Handler) StreamValidate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	done, err := h.service.StreamValidate(r.Context(), req.Prompt, func(delta string) error {
-		return sse.write("delta", StreamEvent{
+		return sse.Write("delta", StreamEvent{
 			Type:  "delta",
 			Delta: delta,
 		})
@@ -134,7 +134,7 @@ func (h *Handler) StreamValidate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := sse.write("done", StreamEvent{
+	if err := sse.Write("done", StreamEvent{
 		Type:  "done",
 		Model: done.Model,
 		Text:  done.Text,
@@ -405,7 +405,7 @@ func (h *Handler) StreamMessage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	sse, err := h.startSSE(w)
+	sse, err := response.StartSSE(w)
 	if err != nil {
 		if abortErr := h.service.AbortPreparedMessageStream(r.Context(), prepared, ErrStreamNotStarted); abortErr != nil {
 			h.logServiceFailure("failed to abort ai chat run after stream preflight error", r, abortErr, "conversation_id", prepared.Conversation.ID, "run_id", prepared.Run.ID)
@@ -414,7 +414,7 @@ func (h *Handler) StreamMessage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := sse.write("start", StreamEvent{
+	if err := sse.Write("start", StreamEvent{
 		Type:           "start",
 		RequestID:      request.GetRequestID(r.Context()),
 		ConversationID: prepared.Conversation.ID,
@@ -456,7 +456,7 @@ func (h *Handler) StreamMessage(w http.ResponseWriter, r *http.Request) {
 
 	firstDeltaWritten := false
 	done, err := h.service.ResumeMessageStream(r.Context(), resumePrepared, func(chunk StreamChunk) error {
-		err := sse.write("delta", StreamEvent{
+		err := sse.Write("delta", StreamEvent{
 			Type:     "delta",
 			Delta:    chunk.Delta,
 			Sequence: chunk.Sequence,
@@ -485,7 +485,7 @@ func (h *Handler) StreamMessage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := sse.write("done", StreamEvent{
+	if err := sse.Write("done", StreamEvent{
 		Type:           "done",
 		Status:         done.Status,
 		ConversationID: done.ConversationID,
@@ -544,13 +544,13 @@ func (h *Handler) ResumeMessageStream(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	sse, err := h.startSSE(w)
+	sse, err := response.StartSSE(w)
 	if err != nil {
 		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to start ai chat resume stream", err)
 		return
 	}
 
-	if err := sse.write("start", StreamEvent{
+	if err := sse.Write("start", StreamEvent{
 		Type:           "start",
 		RequestID:      request.GetRequestID(r.Context()),
 		ConversationID: prepared.Conversation.ID,
@@ -564,7 +564,7 @@ func (h *Handler) ResumeMessageStream(w http.ResponseWriter, r *http.Request) {
 	}
 
 	done, err := h.service.ResumeMessageStream(r.Context(), prepared, func(chunk StreamChunk) error {
-		return sse.write("delta", StreamEvent{
+		return sse.Write("delta", StreamEvent{
 			Type:     "delta",
 			Delta:    chunk.Delta,
 			Sequence: chunk.Sequence,
@@ -580,7 +580,7 @@ func (h *Handler) ResumeMessageStream(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := sse.write("done", StreamEvent{
+	if err := sse.Write("done", StreamEvent{
 		Type:           "done",
 		Status:         done.Status,
 		ConversationID: done.ConversationID,
@@ -105,15 +105,6 @@ func (h *Handler) decodeResumeStreamQuery(w http.ResponseWriter, r *http.Request
 	return int32(parsedRunID), int32(parsedAfterSequence), true
 }
 
-func (h *Handler) startSSE(w http.ResponseWriter) (*sseWriter, error) {
-	sse := newSSEWriter(w)
-	sse.prepareHeaders()
-	if err := sse.disableWriteTimeout(); err != nil {
-		return nil, err
-	}
-	return sse, nil
-}
-
 func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, unexpectedStatus int, unexpectedMessage string) {
 	var errUnauthorized *apperrors.Unauthorized
 	var errNotFound *apperrors.NotFound
@@ -144,7 +135,7 @@ func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err
 	}
 }
 
-func (h *Handler) writeStreamError(sse *sseWriter, r *http.Request, conversationID int32, runID int32, messageID int32, fallbackMessage string, err error) bool {
+func (h *Handler) writeStreamError(sse *response.SSEWriter, r *http.Request, conversationID int32, runID int32, messageID int32, fallbackMessage string, err error) bool {
 	var errUnauthorized *apperrors.Unauthorized
 
 	event := StreamEvent{
@@ -169,7 +160,7 @@ func (h *Handler) writeStreamError(sse *sseWriter, r *http.Request, conversation
 		event.Message = fallbackMessage
 	}
 
-	return sse.write("error", event) == nil
+	return sse.Write("error", event) == nil
 }
 
 func (h *Handler) logStreamWriteFailure(message string, r *http.Request, err error) {
@@ -1,9 +1,12 @@
 package aichat
 
 import (
+	"context"
 	"errors"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/analytics"
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	"github.com/Andrewy-gh/fittrack/server/internal/workout"
 )
 
@@ -145,6 +148,9 @@ type PreparedMessageStream struct {
 	Run              *ChatRun
 	Prompt           string
 	LastSequence     int32
+
+	// onCompleted, when set, runs inside the CompleteRun transaction.
+	onCompleted func(ctx context.Context, qtx *db.Queries) error
 }
 
 type PreparedResumeStream struct {
@@ -211,6 +217,51 @@ type ExerciseStatsTrendPoint struct {
 	WorkoutID int32   `json:"workout_id,omitempty"`
 }
 
+// TrainingLoadView is the compact training-load summary handed to the chat
+// model: today's load ratios, warnings and the last two weeks of daily load.
+type TrainingLoadView struct {
+	Metric      string                          `json:"metric"`
+	Date        string                          `json:"date"`
+	AcuteLoad   float64                         `json:"acute_load"`
+	ChronicLoad float64                         `json:"chronic_load"`
+	ACWR        *float64                        `json:"acwr,omitempty"`
+	Monotony    *float64                        `json:"monotony,omitempty"`
+	Strain      *float64                        `json:"strain,omitempty"`
+	Sessions7d  int                             `json:"sessions_7d"`
+	Sessions28d int                             `json:"sessions_28d"`
+	DailyLoads  []TrainingLoadDayView           `json:"daily_loads,omitempty"`
+	Warnings    []analytics.TrainingLoadWarning `json:"warnings,omitempty"`
+}
+
+type TrainingLoadDayView struct {
+	Date string  `json:"date"`
+	Load float64 `json:"load"`
+}
+
+// WorkoutComparisonFilter selects the two workouts to compare. Dates are
+// midnight of the requested day; with neither set the two most recent
+// workouts are used.
+type WorkoutComparisonFilter struct {
+	DateA        *time.Time
+	DateB        *time.Time
+	WorkoutFocus string
+}
+
+// WorkoutComparisonView is the computed comparison handed to the chat model.
+// Deltas are workout B minus workout A.
+type WorkoutComparisonView struct {
+	A         ComparedWorkoutView                  `json:"a"`
+	B         ComparedWorkoutView                  `json:"b"`
+	Exercises []workout.ExerciseComparisonResponse `json:"exercises"`
+	OnlyInA   []workout.ExerciseSessionResponse    `json:"only_in_a,omitempty"`
+	OnlyInB   []workout.ExerciseSessionResponse    `json:"only_in_b,omitempty"`
+}
+
+type ComparedWorkoutView struct {
+	Date  string `json:"date"`
+	Focus string `json:"focus,omitempty"`
+}
+
 type TrainingProfile struct {
 	PrimaryGoal                     string   `json:"primary_goal,omitempty"`
 	ExperienceLevel                 string   `json:"experience_level,omitempty"`
@@ -222,6 +273,13 @@ type TrainingProfile struct {
 	MovementLimitationsRecorded     bool     `json:"movement_limitations_recorded,omitempty"`
 }
 
+// ExerciseNoteView is the user's saved cues and equipment settings for one exercise.
+type ExerciseNoteView struct {
+	ExerciseName string `json:"exercise_name"`
+	Notes        string `json:"notes,omitempty"`
+	Settings     string `json:"settings,omitempty"`
+}
+
 type TrainingProfileUpdate struct {
 	PrimaryGoal                     *string   `json:"primary_goal,omitempty"`
 	ExperienceLevel                 *string   `json:"experience_level,omitempty"`
@@ -235,9 +293,14 @@ type TrainingProfileUpdate struct {
 }
 
 type TrainingSnapshot struct {
+	Timezone        string   `json:"timezone,omitempty"`
 	LastWorkoutDate string   `json:"last_workout_date,omitempty"`
 	WorkoutsLast30D int64    `json:"workouts_last_30d"`
 	TopExercises    []string `json:"top_exercises,omitempty"`
+	// LatestBodyweight is the most recent logged bodyweight, in the same units
+	// the user logs lifts in.
+	LatestBodyweight     *float64 `json:"latest_bodyweight,omitempty"`
+	LatestBodyweightDate string   `json:"latest_bodyweight_date,omitempty"`
 }
 
 type StreamDone struct {
@@ -7,7 +7,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/analytics"
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
+	"github.com/Andrewy-gh/fittrack/server/internal/goals"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/Andrewy-gh/fittrack/server/internal/workout"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -17,6 +22,10 @@ const (
 	maxChatWorkoutLimit         = 20
 	defaultExerciseStatsWindow  = "3m"
 	maxExerciseStatsTrendPoints = 8
+	chatTrainingLoadDays        = 14
+	maxChatExerciseNotes        = 25
+	maxChatWorkoutNotesLength   = 1000
+	maxChatSetNotesLength       = 120
 	chatDateLayout              = "2006-01-02"
 )
 
@@ -25,8 +34,13 @@ type ChatDataReader interface {
 	ResolveExerciseNames(ctx context.Context, userID string, query string) ([]string, error)
 	ExerciseStats(ctx context.Context, userID string, exerciseName string, window string) (*ExerciseStatsView, error)
 	TrainingSnapshot(ctx context.Context, userID string) (*TrainingSnapshot, error)
+	TrainingLoad(ctx context.Context, userID string, metric string) (*TrainingLoadView, error)
+	CompareWorkouts(ctx context.Context, userID string, filter WorkoutComparisonFilter) (*WorkoutComparisonView, error)
 	TrainingProfile(ctx context.Context, userID string) (*TrainingProfile, error)
 	UpdateTrainingProfile(ctx context.Context, userID string, update TrainingProfileUpdate) (*TrainingProfile, error)
+	ExerciseNotes(ctx context.Context, userID string) ([]ExerciseNoteView, error)
+	EquipmentInventory(ctx context.Context, userID string) (*equipment.Inventory, error)
+	ActiveGoals(ctx context.Context, userID string) ([]goals.GoalResponse, error)
 }
 
 func (r *repository) ListWorkoutsWithSets(ctx context.Context, userID string, filter WorkoutHistoryFilter) ([]ChatWorkoutView, error) {
@@ -46,7 +60,7 @@ func (r *repository) ListWorkoutsWithSets(ctx context.Context, userID string, fi
 		return nil, fmt.Errorf("list workouts with sets for ai chat: %w", err)
 	}
 
-	workouts, err := mapChatWorkoutRows(rows)
+	workouts, err := mapChatWorkoutRows(rows, r.userLocation(ctx, userID))
 	if err != nil {
 		return nil, err
 	}
@@ -85,17 +99,34 @@ func (r *repository) TrainingSnapshot(ctx context.Context, userID string) (*Trai
 		return nil, fmt.Errorf("list top exercises by frequency for ai chat: %w", err)
 	}
 
+	loc := r.userLocation(ctx, userID)
 	snapshot := &TrainingSnapshot{
+		Timezone:        loc.String(),
 		WorkoutsLast30D: stats.WorkoutsLast30d,
 		TopExercises:    make([]string, 0, len(topRows)),
 	}
 	if stats.LastWorkoutDate.Valid {
-		snapshot.LastWorkoutDate = formatChatWorkoutDate(stats.LastWorkoutDate.Time)
+		snapshot.LastWorkoutDate = formatChatWorkoutDate(stats.LastWorkoutDate.Time, loc)
 	}
 	for _, row := range topRows {
 		snapshot.TopExercises = append(snapshot.TopExercises, row.Name)
 	}
 
+	bodyweight, err := r.queries.GetLatestBodyweight(ctx, userID)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("get latest bodyweight for ai chat: %w", err)
+	}
+	if err == nil && bodyweight.Bodyweight.Valid {
+		value, err := bodyweight.Bodyweight.Float64Value()
+		if err != nil {
+			return nil, fmt.Errorf("convert ai chat latest bodyweight: %w", err)
+		}
+		snapshot.LatestBodyweight = &value.Float64
+		if bodyweight.MeasuredOn.Valid {
+			snapshot.LatestBodyweightDate = formatChatWorkoutDate(bodyweight.MeasuredOn.Time, time.UTC)
+		}
+	}
+
 	return snapshot, nil
 }
 
@@ -122,6 +153,62 @@ func (r *repository) TrainingProfile(ctx context.Context, userID string) (*Train
 	return profile, nil
 }
 
+func (r *repository) ExerciseNotes(ctx context.Context, userID string) ([]ExerciseNoteView, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListCurrentExerciseNotes(ctx, db.ListCurrentExerciseNotesParams{
+		UserID:   userID,
+		RowLimit: maxChatExerciseNotes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list current exercise notes for ai chat: %w", err)
+	}
+
+	notes := make([]ExerciseNoteView, 0, len(rows))
+	for _, row := range rows {
+		notes = append(notes, ExerciseNoteView{
+			ExerciseName: row.ExerciseName,
+			Notes:        row.Notes.String,
+			Settings:     row.Settings.String,
+		})
+	}
+	return notes, nil
+}
+
+func (r *repository) EquipmentInventory(ctx context.Context, userID string) (*equipment.Inventory, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	inv, err := equipment.LoadInventory(ctx, r.queries, userID)
+	if err != nil {
+		return nil, fmt.Errorf("load equipment for ai chat: %w", err)
+	}
+	return &inv.Inventory, nil
+}
+
+// ActiveGoals returns the user's goals that are not yet achieved, evaluated
+// as of today in the request timezone.
+func (r *repository) ActiveGoals(ctx context.Context, userID string) ([]goals.GoalResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	now := time.Now().In(user.Location(ctx))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	all, err := goals.LoadGoals(ctx, r.queries, userID, today)
+	if err != nil {
+		return nil, fmt.Errorf("load goals for ai chat: %w", err)
+	}
+
+	active := make([]goals.GoalResponse, 0, len(all))
+	for _, goal := range all {
+		if goal.Status != goals.StatusAchieved {
+			active = append(active, goal)
+		}
+	}
+	return active, nil
+}
+
 func (r *repository) UpdateTrainingProfile(ctx context.Context, userID string, update TrainingProfileUpdate) (*TrainingProfile, error) {
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
@@ -219,7 +306,7 @@ func (r *repository) ExerciseStats(ctx context.Context, userID string, exerciseN
 	}
 	if len(recentSets) > 0 {
 		if recentSets[0].WorkoutDate.Valid {
-			stats.LastSessionDate = formatChatWorkoutDate(recentSets[0].WorkoutDate.Time)
+			stats.LastSessionDate = formatChatWorkoutDate(recentSets[0].WorkoutDate.Time, r.userLocation(ctx, userID))
 		}
 		for _, row := range recentSets {
 			setText, err := formatRecentExerciseStatSet(row.Weight, row.Reps)
@@ -354,7 +441,165 @@ func (r *repository) workoutDate(ctx context.Context, userID string, workoutID i
 	if !row.Date.Valid {
 		return "", nil
 	}
-	return formatChatWorkoutDate(row.Date.Time), nil
+	return formatChatWorkoutDate(row.Date.Time, r.userLocation(ctx, userID)), nil
+}
+
+// TrainingLoad computes the same training-load model as the analytics
+// endpoint, bucketed in the user's timezone, with two weeks of daily loads.
+func (r *repository) TrainingLoad(ctx context.Context, userID string, metric string) (*TrainingLoadView, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	loc := r.userLocation(ctx, userID)
+	opts, loc, err := analytics.ValidateTrainingLoadOptions(analytics.TrainingLoadOptions{
+		Timezone: loc.String(),
+		Metric:   metric,
+		Days:     chatTrainingLoadDays,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	rows, err := r.queries.ListWorkoutLoadsSince(ctx, db.ListWorkoutLoadsSinceParams{
+		UserID: userID,
+		Date:   pgtype.Timestamptz{Time: analytics.TrainingLoadWindowStart(opts, loc, now), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list workout loads for ai chat: %w", err)
+	}
+
+	load := analytics.BuildTrainingLoad(analytics.SessionLoadsFromRows(rows), opts, loc, now)
+	return trainingLoadView(load), nil
+}
+
+func trainingLoadView(load *analytics.TrainingLoadResponse) *TrainingLoadView {
+	view := &TrainingLoadView{
+		Metric:      load.Metric,
+		Date:        load.Current.Date,
+		AcuteLoad:   load.Current.AcuteLoad,
+		ChronicLoad: load.Current.ChronicLoad,
+		ACWR:        load.Current.ACWR,
+		Monotony:    load.Current.Monotony,
+		Strain:      load.Current.Strain,
+		Sessions7d:  load.Current.Sessions7d,
+		Sessions28d: load.Current.Sessions28d,
+		Warnings:    load.Warnings,
+	}
+	for _, point := range load.Points {
+		view.DailyLoads = append(view.DailyLoads, TrainingLoadDayView{Date: point.Date, Load: point.Load})
+	}
+	return view
+}
+
+// CompareWorkouts picks two workouts by date, or the most recent ones, and
+// compares them with the same alignment as the workout compare endpoint. It
+// returns nil when fewer than two workouts match.
+func (r *repository) CompareWorkouts(ctx context.Context, userID string, filter WorkoutComparisonFilter) (*WorkoutComparisonView, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	ids, err := r.comparisonWorkoutIDs(ctx, userID, filter)
+	if err != nil || len(ids) < 2 {
+		return nil, err
+	}
+
+	workouts := make([]workout.ComparisonWorkout, 0, len(ids))
+	for _, id := range ids {
+		rows, err := r.queries.GetWorkoutWithSets(ctx, db.GetWorkoutWithSetsParams{ID: id, UserID: userID})
+		if err != nil {
+			return nil, fmt.Errorf("get workout with sets for ai chat comparison: %w", err)
+		}
+		comparisonWorkout, err := workout.ComparisonWorkoutFromRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		if comparisonWorkout == nil {
+			return nil, nil
+		}
+		workouts = append(workouts, *comparisonWorkout)
+	}
+
+	comparison := workout.CompareWorkouts(workouts[0], workouts[1])
+	return workoutComparisonView(comparison, r.userLocation(ctx, userID)), nil
+}
+
+// comparisonWorkoutIDs returns the baseline workout first. With both dates it
+// takes the latest workout on each day; otherwise it pairs the latest workout
+// up to the given date, or overall, with the one before it.
+func (r *repository) comparisonWorkoutIDs(ctx context.Context, userID string, filter WorkoutComparisonFilter) ([]int32, error) {
+	find := func(start *time.Time, end *time.Time, limit int32) ([]int32, error) {
+		ids, err := r.queries.ListWorkoutIDsForComparison(ctx, db.ListWorkoutIDsForComparisonParams{
+			UserID:       userID,
+			StartDate:    timePtrToPg(start),
+			EndDate:      timePtrToPg(end),
+			WorkoutFocus: textToPg(strings.TrimSpace(filter.WorkoutFocus)),
+			RowLimit:     limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list workouts for ai chat comparison: %w", err)
+		}
+		return ids, nil
+	}
+
+	if filter.DateA != nil && filter.DateB != nil {
+		var ids []int32
+		for _, date := range []time.Time{*filter.DateA, *filter.DateB} {
+			end := date.Add(24*time.Hour - time.Nanosecond)
+			found, err := find(&date, &end, 1)
+			if err != nil || len(found) == 0 {
+				return nil, err
+			}
+			ids = append(ids, found[0])
+		}
+		return ids, nil
+	}
+
+	var end *time.Time
+	for _, date := range []*time.Time{filter.DateA, filter.DateB} {
+		if date != nil {
+			endOfDay := date.Add(24*time.Hour - time.Nanosecond)
+			end = &endOfDay
+		}
+	}
+	ids, err := find(nil, end, 2)
+	if err != nil || len(ids) < 2 {
+		return nil, err
+	}
+	return []int32{ids[1], ids[0]}, nil
+}
+
+func workoutComparisonView(comparison *workout.WorkoutComparisonResponse, loc *time.Location) *WorkoutComparisonView {
+	view := &WorkoutComparisonView{
+		A:         comparedWorkoutView(comparison.A, loc),
+		B:         comparedWorkoutView(comparison.B, loc),
+		Exercises: comparison.Exercises,
+		OnlyInA:   comparison.OnlyInA,
+		OnlyInB:   comparison.OnlyInB,
+	}
+	return view
+}
+
+func comparedWorkoutView(compared workout.ComparedWorkoutResponse, loc *time.Location) ComparedWorkoutView {
+	view := ComparedWorkoutView{Date: compared.Date.In(loc).Format(chatDateLayout)}
+	if compared.Focus != nil {
+		view.Focus = *compared.Focus
+	}
+	return view
+}
+
+// userLocation prefers the timezone attached by the auth middleware and falls
+// back to the stored account timezone for background work such as run
+// recovery, where only the user id is on the context.
+func (r *repository) userLocation(ctx context.Context, userID string) *time.Location {
+	if _, ok := user.Timezone(ctx); ok {
+		return user.Location(ctx)
+	}
+	row, err := r.queries.GetUserByUserID(ctx, userID)
+	if err != nil || !row.Timezone.Valid {
+		return time.UTC
+	}
+	return user.Location(user.WithTimezone(ctx, row.Timezone.String))
 }
 
 type exerciseStatsTrendRow struct {
@@ -366,26 +611,27 @@ type exerciseStatsTrendRow struct {
 }
 
 func (r *repository) exerciseStatsTrendRows(ctx context.Context, userID string, exerciseID int32, window string) ([]exerciseStatsTrendRow, error) {
-	switch normalizeExerciseStatsWindow(window) {
+	params := db.GetExerciseMetricsHistoryRawParams{ExerciseIds: []int32{exerciseID}, UserID: userID}
+	normalized := normalizeExerciseStatsWindow(window)
+	switch normalized {
 	case "1y":
-		rows, err := r.queries.GetExerciseMetricsHistoryRawYear(ctx, db.GetExerciseMetricsHistoryRawYearParams{ExerciseID: exerciseID, UserID: userID})
-		if err != nil {
-			return nil, fmt.Errorf("get exercise metrics history year for ai chat stats: %w", err)
-		}
-		return mapExerciseMetricsYearRows(rows), nil
+		params.Lookback = pgtype.Interval{Months: 12, Valid: true}
 	case "all":
-		rows, err := r.queries.GetExerciseMetricsHistoryRawAll(ctx, db.GetExerciseMetricsHistoryRawAllParams{ExerciseID: exerciseID, UserID: userID})
-		if err != nil {
-			return nil, fmt.Errorf("get exercise metrics history all for ai chat stats: %w", err)
-		}
-		return mapExerciseMetricsAllRows(rows), nil
+		// No lookback: every logged session.
 	default:
-		rows, err := r.queries.GetExerciseMetricsHistoryRaw6M(ctx, db.GetExerciseMetricsHistoryRaw6MParams{ExerciseID: exerciseID, UserID: userID})
-		if err != nil {
-			return nil, fmt.Errorf("get exercise metrics history 6m for ai chat stats: %w", err)
-		}
-		return filterLastThreeMonths(mapExerciseMetrics6MRows(rows), time.Now()), nil
+		// 3m is trimmed against today below, so read a wider window first.
+		params.Lookback = pgtype.Interval{Months: 6, Valid: true}
+	}
+
+	rows, err := r.queries.GetExerciseMetricsHistoryRaw(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("get exercise metrics history %s for ai chat stats: %w", normalized, err)
+	}
+	points := mapExerciseMetricsRows(rows)
+	if normalized == "3m" {
+		return filterLastThreeMonths(points, time.Now()), nil
 	}
+	return points, nil
 }
 
 func normalizeWorkoutHistoryFilter(filter WorkoutHistoryFilter) WorkoutHistoryFilter {
@@ -411,7 +657,7 @@ func normalizeExerciseStatsWindow(window string) string {
 	}
 }
 
-func mapChatWorkoutRows(rows []db.ListWorkoutsWithSetsForChatRow) ([]ChatWorkoutView, error) {
+func mapChatWorkoutRows(rows []db.ListWorkoutsWithSetsForChatRow, loc *time.Location) ([]ChatWorkoutView, error) {
 	workouts := make([]ChatWorkoutView, 0)
 	workoutIndexes := make(map[int32]int)
 	exerciseIndexes := make(map[int32]map[string]int)
@@ -420,13 +666,13 @@ func mapChatWorkoutRows(rows []db.ListWorkoutsWithSetsForChatRow) ([]ChatWorkout
 		workoutIndex, ok := workoutIndexes[row.WorkoutID]
 		if !ok {
 			workout := ChatWorkoutView{
-				Date: formatChatWorkoutDate(row.Date.Time),
+				Date: formatChatWorkoutDate(row.Date.Time, loc),
 			}
 			if row.WorkoutFocus.Valid {
 				workout.Focus = row.WorkoutFocus.String
 			}
 			if row.Notes.Valid {
-				workout.Notes = row.Notes.String
+				workout.Notes = truncateWithEllipsis(row.Notes.String, maxChatWorkoutNotesLength)
 			}
 			workoutIndexes[row.WorkoutID] = len(workouts)
 			exerciseIndexes[row.WorkoutID] = make(map[string]int)
@@ -451,6 +697,11 @@ func mapChatWorkoutRows(rows []db.ListWorkoutsWithSetsForChatRow) ([]ChatWorkout
 			if err != nil {
 				return nil, err
 			}
+			if row.SetNotes.Valid {
+				if note := truncateWithEllipsis(row.SetNotes.String, maxChatSetNotesLength); note != "" {
+					setText += " (note: " + note + ")"
+				}
+			}
 			workouts[workoutIndex].Exercises[exerciseIndex].Sets = append(workouts[workoutIndex].Exercises[exerciseIndex].Sets, setText)
 		}
 	}
@@ -458,41 +709,7 @@ func mapChatWorkoutRows(rows []db.ListWorkoutsWithSetsForChatRow) ([]ChatWorkout
 	return workouts, nil
 }
 
-func mapExerciseMetrics6MRows(rows []db.GetExerciseMetricsHistoryRaw6MRow) []exerciseStatsTrendRow {
-	points := make([]exerciseStatsTrendRow, 0, len(rows))
-	for _, row := range rows {
-		if !row.WorkoutDay.Valid {
-			continue
-		}
-		points = append(points, exerciseStatsTrendRow{
-			WorkoutID:          row.WorkoutID,
-			WorkoutDay:         row.WorkoutDay.Time,
-			SessionBestE1RM:    row.SessionBestE1rm,
-			SessionAvgE1RM:     row.SessionAvgE1rm,
-			TotalVolumeWorking: row.TotalVolumeWorking,
-		})
-	}
-	return points
-}
-
-func mapExerciseMetricsYearRows(rows []db.GetExerciseMetricsHistoryRawYearRow) []exerciseStatsTrendRow {
-	points := make([]exerciseStatsTrendRow, 0, len(rows))
-	for _, row := range rows {
-		if !row.WorkoutDay.Valid {
-			continue
-		}
-		points = append(points, exerciseStatsTrendRow{
-			WorkoutID:          row.WorkoutID,
-			WorkoutDay:         row.WorkoutDay.Time,
-			SessionBestE1RM:    row.SessionBestE1rm,
-			SessionAvgE1RM:     row.SessionAvgE1rm,
-			TotalVolumeWorking: row.TotalVolumeWorking,
-		})
-	}
-	return points
-}
-
-func mapExerciseMetricsAllRows(rows []db.GetExerciseMetricsHistoryRawAllRow) []exerciseStatsTrendRow {
+func mapExerciseMetricsRows(rows []db.GetExerciseMetricsHistoryRawRow) []exerciseStatsTrendRow {
 	points := make([]exerciseStatsTrendRow, 0, len(rows))
 	for _, row := range rows {
 		if !row.WorkoutDay.Valid {
@@ -547,7 +764,9 @@ func mapExerciseStatsTrendPoints(points []exerciseStatsTrendRow) []ExerciseStats
 	mapped := make([]ExerciseStatsTrendPoint, 0, len(points))
 	for _, point := range points {
 		mapped = append(mapped, ExerciseStatsTrendPoint{
-			Date:      formatChatWorkoutDate(point.WorkoutDay),
+			// Trend days are already bucketed in the user's timezone by the
+			// metrics queries, so they are formatted as-is.
+			Date:      formatChatWorkoutDate(point.WorkoutDay, time.UTC),
 			BestE1RM:  point.SessionBestE1RM,
 			AvgE1RM:   point.SessionAvgE1RM,
 			Volume:    point.TotalVolumeWorking,
@@ -585,8 +804,11 @@ func formatSetWeight(weight float64) string {
 	return strings.TrimSuffix(strings.TrimSuffix(text, "0"), ".")
 }
 
-func formatChatWorkoutDate(value time.Time) string {
-	return value.UTC().Format(chatDateLayout)
+func formatChatWorkoutDate(value time.Time, loc *time.Location) string {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return value.In(loc).Format(chatDateLayout)
 }
 
 var _ ChatDataReader = (*repository)(nil)
@@ -19,7 +19,7 @@ func TestMapChatWorkoutRowsRegroupsFlatRows(t *testing.T) {
 		chatWorkoutRow(1, "2026-06-30", "lower", "Back Squat", 1, 1, pgtype.Numeric{}, 10, "warmup"),
 	}
 
-	workouts, err := mapChatWorkoutRows(rows)
+	workouts, err := mapChatWorkoutRows(rows, time.UTC)
 	if err != nil {
 		t.Fatalf("mapChatWorkoutRows() error = %v", err)
 	}
@@ -43,13 +43,47 @@ func TestMapChatWorkoutRowsRegroupsFlatRows(t *testing.T) {
 	}
 }
 
+func TestMapChatWorkoutRowsIncludesNotes(t *testing.T) {
+	withNote := chatWorkoutRow(1, "2026-07-03", "upper", "Bench Press", 1, 1, numericWeight(t, "185"), 5, "working")
+	withNote.Notes = pgtype.Text{String: strings.Repeat("a", maxChatWorkoutNotesLength+50), Valid: true}
+	withNote.SetNotes = pgtype.Text{String: "  belt on ", Valid: true}
+	blankNote := chatWorkoutRow(1, "2026-07-03", "upper", "Bench Press", 1, 2, numericWeight(t, "195"), 3, "working")
+	blankNote.SetNotes = pgtype.Text{String: " ", Valid: true}
+
+	workouts, err := mapChatWorkoutRows([]db.ListWorkoutsWithSetsForChatRow{withNote, blankNote}, time.UTC)
+	if err != nil {
+		t.Fatalf("mapChatWorkoutRows() error = %v", err)
+	}
+	if got := len([]rune(workouts[0].Notes)); got != maxChatWorkoutNotesLength {
+		t.Fatalf("workout notes length = %d, want %d", got, maxChatWorkoutNotesLength)
+	}
+	if got := workouts[0].Exercises[0].Sets; len(got) != 2 || got[0] != "185x5 working (note: belt on)" || got[1] != "195x3 working" {
+		t.Fatalf("bench sets = %#v", got)
+	}
+}
+
 func TestFormatChatWorkoutDateUsesUTCCalendarDay(t *testing.T) {
 	utcMinusFour := time.FixedZone("UTC-4", -4*60*60)
 	scannedLocalTime := time.Date(2026, 6, 30, 20, 0, 0, 0, utcMinusFour)
 
-	if got := formatChatWorkoutDate(scannedLocalTime); got != "2026-07-01" {
+	if got := formatChatWorkoutDate(scannedLocalTime, time.UTC); got != "2026-07-01" {
 		t.Fatalf("formatChatWorkoutDate() = %q, want 2026-07-01", got)
 	}
+	if got := formatChatWorkoutDate(scannedLocalTime, nil); got != "2026-07-01" {
+		t.Fatalf("formatChatWorkoutDate(nil location) = %q, want 2026-07-01", got)
+	}
+}
+
+func TestFormatChatWorkoutDateUsesUserTimezone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+	lateEvening := time.Date(2026, 7, 1, 2, 30, 0, 0, time.UTC)
+
+	if got := formatChatWorkoutDate(lateEvening, newYork); got != "2026-06-30" {
+		t.Fatalf("formatChatWorkoutDate() = %q, want 2026-06-30", got)
+	}
 }
 
 func TestNormalizeWorkoutHistoryFilterCapsLastN(t *testing.T) {
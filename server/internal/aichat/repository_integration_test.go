@@ -523,7 +523,7 @@ func TestRepositorySaveLatestWorkoutDraft_ConcurrentCallsCreateOneWorkout(t *tes
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	queries := db.New(pool)
 	exerciseRepo := exercise.NewRepository(logger, queries, pool)
-	workoutSaver := workout.NewTxSaver(logger, exerciseRepo)
+	workoutSaver := workout.NewTxSaver(logger, exerciseRepo, nil, nil)
 	repo := NewRepository(logger, queries, pool)
 
 	conversation, err := repo.CreateConversation(ctx, userID)
@@ -221,7 +221,7 @@ func marshalWorkoutDraft(draft *workout.CreateWorkoutRequest) ([]byte, error) {
 	if draft == nil {
 		return nil, nil
 	}
-	normalizeWorkoutDraft(draft)
+	normalizeWorkoutDraft(draft, nil)
 	return json.Marshal(draft)
 }
 
@@ -235,7 +235,7 @@ func parseStoredWorkoutDraft(payload []byte) (*workout.CreateWorkoutRequest, err
 		return nil, fmt.Errorf("decode stored workout draft: %w", err)
 	}
 
-	normalizeWorkoutDraft(&draft)
+	normalizeWorkoutDraft(&draft, nil)
 	if err := validateWorkoutDraft(&draft); err != nil {
 		return nil, fmt.Errorf("validate stored workout draft: %w", err)
 	}
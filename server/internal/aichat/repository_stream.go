@@ -498,6 +498,12 @@ func (r *repository) CompleteRun(ctx context.Context, prepared *PreparedMessageS
 		return nil, nil, fmt.Errorf("touch ai chat conversation after completion: %w", err)
 	}
 
+	if prepared.onCompleted != nil {
+		if err := prepared.onCompleted(ctx, qtx); err != nil {
+			return nil, nil, fmt.Errorf("run ai chat completion hook: %w", err)
+		}
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, nil, fmt.Errorf("commit ai chat completion transaction: %w", err)
 	}
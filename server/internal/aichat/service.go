@@ -6,6 +6,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/achievements"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
 	"github.com/Andrewy-gh/fittrack/server/internal/workout"
 )
 
@@ -25,6 +27,11 @@ type recoveryDispatcher interface {
 	EnqueueRunRecovery(ctx context.Context, request RunRecoveryRequest) error
 }
 
+// achievementEvaluator unlocks achievements newly earned by saved workouts.
+type achievementEvaluator interface {
+	Evaluate(ctx context.Context) ([]achievements.AchievementResponse, error)
+}
+
 type Service struct {
 	logger            *slog.Logger
 	featureAccess     featureAccessService
@@ -32,6 +39,8 @@ type Service struct {
 	repo              Repository
 	recovery          recoveryDispatcher
 	workoutDraftSaver workout.TxSaver
+	achievements      achievementEvaluator
+	notifier          notifications.Producer
 	cancelMu          sync.Mutex
 	runCancels        map[int32]runCancellation
 }
@@ -62,3 +71,13 @@ func NewService(logger *slog.Logger, featureAccess featureAccessService, runtime
 func (s *Service) SetRecoveryDispatcher(dispatcher recoveryDispatcher) {
 	s.recovery = dispatcher
 }
+
+func (s *Service) SetAchievementEvaluator(evaluator achievementEvaluator) {
+	s.achievements = evaluator
+}
+
+// SetNotifier enables a notification when a recovered run completes, since
+// the user's stream had dropped and they may have left the conversation.
+func (s *Service) SetNotifier(notifier notifications.Producer) {
+	s.notifier = notifier
+}
@@ -212,6 +212,15 @@ func (s *Service) SaveLatestWorkoutDraft(ctx context.Context, conversationID int
 		}
 		return nil, err
 	}
+	if s.achievements != nil {
+		if _, err := s.achievements.Evaluate(ctx); err != nil {
+			s.logger.Warn("failed to evaluate achievements after saving ai chat workout draft",
+				"error", err,
+				"workout_id", saved.WorkoutID,
+				"request_id", request.GetRequestID(ctx),
+			)
+		}
+	}
 
 	return &SaveLatestWorkoutDraftResponse{
 		Conversation: saved.Conversation,
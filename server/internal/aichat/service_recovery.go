@@ -3,10 +3,13 @@ package aichat
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
 	"github.com/Andrewy-gh/fittrack/server/internal/user"
 	"github.com/jackc/pgx/v5"
 )
@@ -114,6 +117,10 @@ func (s *Service) RecoverStreamingRun(ctx context.Context, request RunRecoveryRe
 		)
 	}
 
+	if s.notifier != nil {
+		prepared.onCompleted = s.recoveredRunNotification(prepared)
+	}
+
 	owner := newInngestRunOwner(prepared.Run.ID)
 	claimCtx, claimCancel := s.registerRunCancellation(ctx, prepared.Run.ID, owner)
 	if err := s.repo.ClaimRunGeneration(claimCtx, prepared.Run, owner, now); err != nil {
@@ -134,6 +141,28 @@ func (s *Service) RecoverStreamingRun(ctx context.Context, request RunRecoveryRe
 	return err
 }
 
+// recoveredRunNotification tells the user that a reply whose stream dropped
+// has finished in the background.
+func (s *Service) recoveredRunNotification(prepared *PreparedMessageStream) func(context.Context, *db.Queries) error {
+	body := "The reply that was interrupted has finished."
+	if prepared.Conversation.Title != nil && strings.TrimSpace(*prepared.Conversation.Title) != "" {
+		body = fmt.Sprintf("The interrupted reply in %q has finished.", strings.TrimSpace(*prepared.Conversation.Title))
+	}
+	return func(ctx context.Context, qtx *db.Queries) error {
+		return s.notifier.Notify(ctx, qtx, notifications.Notification{
+			UserID: prepared.Run.UserID,
+			Kind:   notifications.KindAIRunRecovered,
+			Title:  "Your AI coach reply is ready",
+			Body:   body,
+			Data: map[string]any{
+				"conversation_id": prepared.Conversation.ID,
+				"run_id":          prepared.Run.ID,
+				"message_id":      prepared.AssistantMessage.ID,
+			},
+		})
+	}
+}
+
 func shouldRecoverRun(run *ChatRun, now time.Time) bool {
 	return newChatRunLifecycle(run, now).ShouldRecover()
 }
@@ -11,7 +11,10 @@ import (
 
 	"github.com/Andrewy-gh/fittrack/server/internal/billing"
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
 	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/goals"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
 	"github.com/Andrewy-gh/fittrack/server/internal/request"
 	"github.com/Andrewy-gh/fittrack/server/internal/user"
 	"github.com/Andrewy-gh/fittrack/server/internal/workout"
@@ -84,6 +87,18 @@ func (m *mockRepository) TrainingSnapshot(ctx context.Context, userID string) (*
 	return snapshot, args.Error(1)
 }
 
+func (m *mockRepository) TrainingLoad(ctx context.Context, userID string, metric string) (*TrainingLoadView, error) {
+	args := m.Called(ctx, userID, metric)
+	load, _ := args.Get(0).(*TrainingLoadView)
+	return load, args.Error(1)
+}
+
+func (m *mockRepository) CompareWorkouts(ctx context.Context, userID string, filter WorkoutComparisonFilter) (*WorkoutComparisonView, error) {
+	args := m.Called(ctx, userID, filter)
+	comparison, _ := args.Get(0).(*WorkoutComparisonView)
+	return comparison, args.Error(1)
+}
+
 func (m *mockRepository) TrainingProfile(ctx context.Context, userID string) (*TrainingProfile, error) {
 	args := m.Called(ctx, userID)
 	profile, _ := args.Get(0).(*TrainingProfile)
@@ -96,6 +111,24 @@ func (m *mockRepository) UpdateTrainingProfile(ctx context.Context, userID strin
 	return profile, args.Error(1)
 }
 
+func (m *mockRepository) ExerciseNotes(ctx context.Context, userID string) ([]ExerciseNoteView, error) {
+	args := m.Called(ctx, userID)
+	notes, _ := args.Get(0).([]ExerciseNoteView)
+	return notes, args.Error(1)
+}
+
+func (m *mockRepository) EquipmentInventory(ctx context.Context, userID string) (*equipment.Inventory, error) {
+	args := m.Called(ctx, userID)
+	inv, _ := args.Get(0).(*equipment.Inventory)
+	return inv, args.Error(1)
+}
+
+func (m *mockRepository) ActiveGoals(ctx context.Context, userID string) ([]goals.GoalResponse, error) {
+	args := m.Called(ctx, userID)
+	active, _ := args.Get(0).([]goals.GoalResponse)
+	return active, args.Error(1)
+}
+
 func (m *mockRepository) ExerciseStats(ctx context.Context, userID string, exerciseName string, window string) (*ExerciseStatsView, error) {
 	args := m.Called(ctx, userID, exerciseName, window)
 	stats, _ := args.Get(0).(*ExerciseStatsView)
@@ -1347,6 +1380,8 @@ func TestServiceRecoverStreamingRun_CompletesActiveRun(t *testing.T) {
 	runtime := new(mockRuntime)
 	repo := new(mockRepository)
 	service := NewService(logger, featureAccess, runtime, repo, nil)
+	notifier := &recordingProducer{}
+	service.SetNotifier(notifier)
 	now := time.Date(2026, 3, 26, 17, 30, 0, 0, time.UTC)
 	expiredLease := now.Add(-time.Second)
 	prepared := &PreparedMessageStream{
@@ -1393,7 +1428,11 @@ func TestServiceRecoverStreamingRun_CompletesActiveRun(t *testing.T) {
 	}, nil).Once()
 	repo.On("AppendStreamChunk", mock.Anything, prepared, "hello ", "hello", mock.AnythingOfType("time.Time")).Return(int32(1), nil).Once()
 	repo.On("AppendStreamChunk", mock.Anything, prepared, "world", "hello world", mock.AnythingOfType("time.Time")).Return(int32(2), nil).Once()
-	repo.On("CompleteRun", mock.Anything, prepared, "hello world", (*workout.CreateWorkoutRequest)(nil), mock.AnythingOfType("time.Time")).Return(&ChatMessage{
+	repo.On("CompleteRun", mock.Anything, prepared, "hello world", (*workout.CreateWorkoutRequest)(nil), mock.AnythingOfType("time.Time")).Run(func(args mock.Arguments) {
+		completed := args.Get(1).(*PreparedMessageStream)
+		require.NotNil(t, completed.onCompleted)
+		require.NoError(t, completed.onCompleted(args.Get(0).(context.Context), nil))
+	}).Return(&ChatMessage{
 		ID:             61,
 		ConversationID: 41,
 		UserID:         "user-123",
@@ -1424,6 +1463,19 @@ func TestServiceRecoverStreamingRun_CompletesActiveRun(t *testing.T) {
 	require.NoError(t, err)
 	runtime.AssertExpectations(t)
 	repo.AssertExpectations(t)
+	require.Len(t, notifier.notifications, 1)
+	assert.Equal(t, "user-123", notifier.notifications[0].UserID)
+	assert.Equal(t, notifications.KindAIRunRecovered, notifier.notifications[0].Kind)
+	assert.Equal(t, int32(41), notifier.notifications[0].Data["conversation_id"])
+}
+
+type recordingProducer struct {
+	notifications []notifications.Notification
+}
+
+func (p *recordingProducer) Notify(ctx context.Context, q *db.Queries, n notifications.Notification) error {
+	p.notifications = append(p.notifications, n)
+	return nil
 }
 
 func TestServiceRecoverStreamingRun_InterruptsStalePartialRun(t *testing.T) {
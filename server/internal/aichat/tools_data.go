@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/analytics"
 	"github.com/Andrewy-gh/fittrack/server/internal/request"
 	"github.com/Andrewy-gh/fittrack/server/internal/user"
 	"github.com/firebase/genkit/go/ai"
@@ -18,6 +19,10 @@ const (
 	getWorkoutsToolDescription      = "Reads the authenticated user's logged FitTrack workouts. Use this for questions about their personal workout history, recent sessions, exercises performed, or logged training data. Do not use it for general fitness knowledge or to create a new workout draft."
 	getExerciseStatsToolName        = "get_exercise_stats"
 	getExerciseStatsToolDescription = "Reads compact stats for one of the authenticated user's exercises: all-time best estimated 1RM, recent trend points, last-session sets, and session count. Use this only for all-time bests or long-range single-exercise trends, or before drafting when the user explicitly wants the draft based on past performance."
+	getTrainingLoadToolName         = "get_training_load"
+	getTrainingLoadToolDescription  = "Reads the authenticated user's training load: daily load, acute (7-day) and chronic (28-day) load, the acute:chronic workload ratio, monotony, strain, and load warnings. Use this when the user asks if they are overtraining, ramping volume too fast, or how fatigued their recent training is."
+	compareWorkoutsToolName         = "compare_workouts"
+	compareWorkoutsToolDescription  = "Compares two of the authenticated user's logged workouts exercise by exercise: working sets, reps, top set, volume, and estimated 1RM changes from workout A to workout B, plus exercises done in only one of them. Use this when the user asks how one session compared with another, such as today versus the last push day."
 	updateTrainingProfileToolName   = "update_training_profile"
 	updateTrainingProfileToolDesc   = "Updates durable training profile facts for the authenticated user. Use only when the user states a lasting preference, limitation, usual training setup, goal, or asks to forget/clear profile facts. Do not use for one-off details about today's workout."
 )
@@ -47,6 +52,26 @@ type GetExerciseStatsToolResult struct {
 	CandidateExercises []string           `json:"candidateExercises,omitempty"`
 }
 
+type GetTrainingLoadToolInput struct {
+	Metric string `json:"metric,omitempty" jsonschema:"description=Per-session load measure: tonnage or hard_sets. Default tonnage."`
+}
+
+type GetTrainingLoadToolResult struct {
+	Load    *TrainingLoadView `json:"load,omitempty"`
+	Message string            `json:"message,omitempty"`
+}
+
+type CompareWorkoutsToolInput struct {
+	DateA        string `json:"dateA,omitempty" jsonschema:"description=ISO date (YYYY-MM-DD) of the earlier, baseline workout. With only one date, that day's workout is compared with the one before it."`
+	DateB        string `json:"dateB,omitempty" jsonschema:"description=ISO date (YYYY-MM-DD) of the later workout. Omit both dates to compare the two most recent workouts."`
+	WorkoutFocus string `json:"workoutFocus,omitempty" jsonschema:"description=Optional workout focus filter such as push, pull, or legs, e.g. to compare the last two push days."`
+}
+
+type CompareWorkoutsToolResult struct {
+	Comparison *WorkoutComparisonView `json:"comparison,omitempty"`
+	Message    string                 `json:"message,omitempty"`
+}
+
 type UpdateTrainingProfileToolInput struct {
 	PrimaryGoal                     *string   `json:"primaryGoal,omitempty" jsonschema:"description=Durable primary goal. Allowed: strength, hypertrophy, endurance, general_fitness, weight_loss, mobility. Empty string clears it."`
 	ExperienceLevel                 *string   `json:"experienceLevel,omitempty" jsonschema:"description=Durable experience level. Allowed: beginner, intermediate, advanced. Empty string clears it."`
@@ -112,6 +137,49 @@ func defineGetExerciseStatsTool(g *genkit.Genkit, reader ChatDataReader) ai.Tool
 	)
 }
 
+func defineGetTrainingLoadTool(g *genkit.Genkit, reader ChatDataReader) ai.Tool {
+	return genkit.DefineTool(g, getTrainingLoadToolName,
+		getTrainingLoadToolDescription,
+		func(ctx *ai.ToolContext, input GetTrainingLoadToolInput) (*GetTrainingLoadToolResult, error) {
+			startedAt := time.Now()
+			result := runGetTrainingLoadTool(ctx, reader, input)
+			warningCount := 0
+			if result.Load != nil {
+				warningCount = len(result.Load.Warnings)
+			}
+			logAIChatTraceContext(ctx, "get_training_load_tool_finished",
+				"elapsed_ms", time.Since(startedAt).Milliseconds(),
+				"metric", strings.TrimSpace(input.Metric),
+				"warning_count", warningCount,
+				"request_id", request.GetRequestID(ctx),
+			)
+			return result, nil
+		},
+	)
+}
+
+func defineCompareWorkoutsTool(g *genkit.Genkit, reader ChatDataReader) ai.Tool {
+	return genkit.DefineTool(g, compareWorkoutsToolName,
+		compareWorkoutsToolDescription,
+		func(ctx *ai.ToolContext, input CompareWorkoutsToolInput) (*CompareWorkoutsToolResult, error) {
+			startedAt := time.Now()
+			result := runCompareWorkoutsTool(ctx, reader, input)
+			exerciseCount := 0
+			if result.Comparison != nil {
+				exerciseCount = len(result.Comparison.Exercises)
+			}
+			logAIChatTraceContext(ctx, "compare_workouts_tool_finished",
+				"elapsed_ms", time.Since(startedAt).Milliseconds(),
+				"has_date_a", strings.TrimSpace(input.DateA) != "",
+				"has_date_b", strings.TrimSpace(input.DateB) != "",
+				"exercise_count", exerciseCount,
+				"request_id", request.GetRequestID(ctx),
+			)
+			return result, nil
+		},
+	)
+}
+
 func defineUpdateTrainingProfileTool(g *genkit.Genkit, reader ChatDataReader) ai.Tool {
 	return genkit.DefineTool(g, updateTrainingProfileToolName,
 		updateTrainingProfileToolDesc,
@@ -189,6 +257,73 @@ func runGetWorkoutsTool(ctx context.Context, reader ChatDataReader, input GetWor
 	}
 }
 
+func runGetTrainingLoadTool(ctx context.Context, reader ChatDataReader, input GetTrainingLoadToolInput) *GetTrainingLoadToolResult {
+	if reader == nil {
+		return &GetTrainingLoadToolResult{Message: "Training load is not available in this chat."}
+	}
+	userID, ok := user.Current(ctx)
+	if !ok || strings.TrimSpace(userID) == "" {
+		return &GetTrainingLoadToolResult{Message: "Training load is not available because this chat has no authenticated user."}
+	}
+
+	var notes []string
+	metric := strings.ToLower(strings.TrimSpace(input.Metric))
+	if metric != "" && metric != analytics.LoadMetricTonnage && metric != analytics.LoadMetricHardSets {
+		notes = append(notes, fmt.Sprintf("Ignored unsupported metric %q; using tonnage.", strings.TrimSpace(input.Metric)))
+		metric = ""
+	}
+
+	load, err := reader.TrainingLoad(ctx, userID, metric)
+	if err != nil {
+		return &GetTrainingLoadToolResult{Message: appendToolMessage(notes, "I couldn't read training load right now.")}
+	}
+	if load == nil || load.Sessions28d == 0 {
+		return &GetTrainingLoadToolResult{
+			Load:    load,
+			Message: appendToolMessage(notes, "No workouts were logged in the last 28 days, so there is no training load to assess."),
+		}
+	}
+	return &GetTrainingLoadToolResult{
+		Load:    load,
+		Message: strings.Join(notes, " "),
+	}
+}
+
+func runCompareWorkoutsTool(ctx context.Context, reader ChatDataReader, input CompareWorkoutsToolInput) *CompareWorkoutsToolResult {
+	if reader == nil {
+		return &CompareWorkoutsToolResult{Message: "Workout comparison is not available in this chat."}
+	}
+	userID, ok := user.Current(ctx)
+	if !ok || strings.TrimSpace(userID) == "" {
+		return &CompareWorkoutsToolResult{Message: "Workout comparison is not available because this chat has no authenticated user."}
+	}
+
+	var notes []string
+	filter := WorkoutComparisonFilter{WorkoutFocus: strings.TrimSpace(input.WorkoutFocus)}
+	dateA, note := parseChatToolDate(input.DateA, false)
+	if note != "" {
+		notes = append(notes, note)
+	}
+	dateB, note := parseChatToolDate(input.DateB, false)
+	if note != "" {
+		notes = append(notes, note)
+	}
+	filter.DateA = dateA
+	filter.DateB = dateB
+
+	comparison, err := reader.CompareWorkouts(ctx, userID, filter)
+	if err != nil {
+		return &CompareWorkoutsToolResult{Message: appendToolMessage(notes, "I couldn't compare workouts right now.")}
+	}
+	if comparison == nil {
+		return &CompareWorkoutsToolResult{Message: appendToolMessage(notes, "I couldn't find two logged workouts matching that request to compare.")}
+	}
+	return &CompareWorkoutsToolResult{
+		Comparison: comparison,
+		Message:    strings.Join(notes, " "),
+	}
+}
+
 func runUpdateTrainingProfileTool(ctx context.Context, reader ChatDataReader, input UpdateTrainingProfileToolInput) *UpdateTrainingProfileToolResult {
 	if reader == nil {
 		return &UpdateTrainingProfileToolResult{Message: "Training profile updates are not available in this chat."}
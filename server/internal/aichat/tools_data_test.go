@@ -8,6 +8,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/analytics"
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
+	"github.com/Andrewy-gh/fittrack/server/internal/goals"
 	"github.com/Andrewy-gh/fittrack/server/internal/user"
 )
 
@@ -118,6 +121,102 @@ func TestRunGetExerciseStatsToolUsesResolvedExercise(t *testing.T) {
 	}
 }
 
+func TestRunGetTrainingLoadTool(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+	acwr := 1.62
+
+	t.Run("returns load and normalizes metric", func(t *testing.T) {
+		reader := &stubChatDataReader{trainingLoad: &TrainingLoadView{
+			Metric:      "hard_sets",
+			ACWR:        &acwr,
+			Sessions28d: 12,
+			Warnings:    []analytics.TrainingLoadWarning{{Code: "acwr_high", Severity: analytics.WarningSeverityHigh}},
+		}}
+
+		result := runGetTrainingLoadTool(ctx, reader, GetTrainingLoadToolInput{Metric: " Hard_Sets "})
+
+		if reader.loadMetric != "hard_sets" {
+			t.Fatalf("TrainingLoad metric = %q, want hard_sets", reader.loadMetric)
+		}
+		if result.Load == nil || len(result.Load.Warnings) != 1 || result.Message != "" {
+			t.Fatalf("result = %#v, want load with one warning and no message", result)
+		}
+	})
+
+	t.Run("ignores unsupported metric", func(t *testing.T) {
+		reader := &stubChatDataReader{trainingLoad: &TrainingLoadView{Sessions28d: 4}}
+
+		result := runGetTrainingLoadTool(ctx, reader, GetTrainingLoadToolInput{Metric: "rpe"})
+
+		if reader.loadMetric != "" || !strings.Contains(result.Message, `Ignored unsupported metric "rpe"`) {
+			t.Fatalf("metric = %q, message = %q", reader.loadMetric, result.Message)
+		}
+	})
+
+	t.Run("explains missing recent training", func(t *testing.T) {
+		reader := &stubChatDataReader{trainingLoad: &TrainingLoadView{}}
+
+		result := runGetTrainingLoadTool(ctx, reader, GetTrainingLoadToolInput{})
+
+		if !strings.Contains(result.Message, "No workouts were logged in the last 28 days") {
+			t.Fatalf("Message = %q", result.Message)
+		}
+	})
+
+	t.Run("reports read failures", func(t *testing.T) {
+		reader := &stubChatDataReader{trainingLoadErr: errors.New("database unavailable")}
+
+		result := runGetTrainingLoadTool(ctx, reader, GetTrainingLoadToolInput{})
+
+		if result.Load != nil || result.Message != "I couldn't read training load right now." {
+			t.Fatalf("result = %#v", result)
+		}
+	})
+}
+
+func TestRunCompareWorkoutsTool(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	t.Run("passes parsed dates and focus", func(t *testing.T) {
+		reader := &stubChatDataReader{comparison: &WorkoutComparisonView{
+			A: ComparedWorkoutView{Date: "2026-07-01", Focus: "push"},
+			B: ComparedWorkoutView{Date: "2026-07-08", Focus: "push"},
+		}}
+
+		result := runCompareWorkoutsTool(ctx, reader, CompareWorkoutsToolInput{DateB: "2026-07-08", WorkoutFocus: " push "})
+
+		if reader.comparisonFilter.DateA != nil || reader.comparisonFilter.DateB == nil ||
+			!reader.comparisonFilter.DateB.Equal(time.Date(2026, 7, 8, 0, 0, 0, 0, time.UTC)) ||
+			reader.comparisonFilter.WorkoutFocus != "push" {
+			t.Fatalf("filter = %#v", reader.comparisonFilter)
+		}
+		if result.Comparison == nil || result.Message != "" {
+			t.Fatalf("result = %#v, want comparison and no message", result)
+		}
+	})
+
+	t.Run("explains when fewer than two workouts match", func(t *testing.T) {
+		reader := &stubChatDataReader{}
+
+		result := runCompareWorkoutsTool(ctx, reader, CompareWorkoutsToolInput{DateA: "not a date"})
+
+		if reader.comparisonFilter.DateA != nil {
+			t.Fatalf("DateA = %v, want invalid date ignored", reader.comparisonFilter.DateA)
+		}
+		if result.Comparison != nil || !strings.Contains(result.Message, "couldn't find two logged workouts") {
+			t.Fatalf("result = %#v", result)
+		}
+	})
+
+	t.Run("requires an authenticated user", func(t *testing.T) {
+		result := runCompareWorkoutsTool(context.Background(), &stubChatDataReader{}, CompareWorkoutsToolInput{})
+
+		if !strings.Contains(result.Message, "no authenticated user") {
+			t.Fatalf("Message = %q", result.Message)
+		}
+	})
+}
+
 func TestRunGetExerciseStatsToolReturnsAmbiguousCandidates(t *testing.T) {
 	reader := &stubChatDataReader{names: []string{"Barbell Row", "Cable Row"}}
 	ctx := user.WithContext(context.Background(), "user-1")
@@ -201,6 +300,15 @@ type stubChatDataReader struct {
 	listErr           error
 	listCalls         int
 	statsExerciseName string
+	trainingLoad      *TrainingLoadView
+	trainingLoadErr   error
+	loadMetric        string
+	comparison        *WorkoutComparisonView
+	comparisonFilter  WorkoutComparisonFilter
+	exerciseNotes     []ExerciseNoteView
+	equipment         *equipment.Inventory
+	equipmentErr      error
+	goals             []goals.GoalResponse
 }
 
 func (s *stubChatDataReader) ListWorkoutsWithSets(ctx context.Context, userID string, filter WorkoutHistoryFilter) ([]ChatWorkoutView, error) {
@@ -224,12 +332,44 @@ func (s *stubChatDataReader) TrainingSnapshot(ctx context.Context, userID string
 	return s.snapshot, nil
 }
 
+func (s *stubChatDataReader) TrainingLoad(ctx context.Context, userID string, metric string) (*TrainingLoadView, error) {
+	_ = ctx
+	_ = userID
+	s.loadMetric = metric
+	return s.trainingLoad, s.trainingLoadErr
+}
+
+func (s *stubChatDataReader) CompareWorkouts(ctx context.Context, userID string, filter WorkoutComparisonFilter) (*WorkoutComparisonView, error) {
+	_ = ctx
+	_ = userID
+	s.comparisonFilter = filter
+	return s.comparison, nil
+}
+
 func (s *stubChatDataReader) TrainingProfile(ctx context.Context, userID string) (*TrainingProfile, error) {
 	_ = ctx
 	_ = userID
 	return s.profile, nil
 }
 
+func (s *stubChatDataReader) ExerciseNotes(ctx context.Context, userID string) ([]ExerciseNoteView, error) {
+	_ = ctx
+	_ = userID
+	return s.exerciseNotes, nil
+}
+
+func (s *stubChatDataReader) EquipmentInventory(ctx context.Context, userID string) (*equipment.Inventory, error) {
+	_ = ctx
+	_ = userID
+	return s.equipment, s.equipmentErr
+}
+
+func (s *stubChatDataReader) ActiveGoals(ctx context.Context, userID string) ([]goals.GoalResponse, error) {
+	_ = ctx
+	_ = userID
+	return s.goals, nil
+}
+
 func (s *stubChatDataReader) UpdateTrainingProfile(ctx context.Context, userID string, update TrainingProfileUpdate) (*TrainingProfile, error) {
 	_ = ctx
 	_ = userID
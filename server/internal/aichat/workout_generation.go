@@ -7,7 +7,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
 	"github.com/Andrewy-gh/fittrack/server/internal/request"
+	"github.com/Andrewy-gh/fittrack/server/internal/tag"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
 	"github.com/Andrewy-gh/fittrack/server/internal/workout"
 	"github.com/firebase/genkit/go/ai"
 	"github.com/firebase/genkit/go/genkit"
@@ -19,6 +22,7 @@ const (
 	workoutDraftToolDescription        = "Creates a FitTrack workout draft. Call this immediately once you know the user's workout focus, session duration, enough equipment or workout context to choose feasible exercises, and injury status. After one follow-up answer, if workout focus, duration, equipment or location context, and usable injury details are present, optional details like fitness level, preferred exercises, or other movements to avoid should not block tool use. Treat stated equipment, location, and space constraints as sufficient and closed by default: use only that context unless the user explicitly mentions more. Do not ask what other equipment they have unless the requested workout is unsafe, contradictory, or not reasonably buildable from those constraints. Do not assume unmentioned accessories or equipment such as a bench, rack, cable, or machine. Equipment is optional for mobility, rehab, prehab, stretching, or warm-up requests because they can default to no-equipment bodyweight work. Use injuries=none only when the user explicitly reports no injuries or continues without answering after one injury-status question. Fitness level improves weight assumptions but is not required."
 	workoutDraftSummaryMessage         = "I put together a structured workout draft for you."
 	workoutChatFollowUpQuestionCeiling = 3
+	maxWorkoutDraftTags                = 3
 )
 
 var workoutDraftValidator = validator.New()
@@ -41,9 +45,15 @@ type WorkoutGenerationToolInput struct {
 	Injuries          string `json:"injuries" jsonschema:"description=Current injuries, pain, or movement limitations. Use none when the user reports no injuries."`
 	WorkoutDate       string `json:"workoutDate,omitempty" jsonschema:"description=Optional requested workout date when the user specified one. May be an ISO value or a relative phrase like tomorrow."`
 	RecentPerformance string `json:"recentPerformance,omitempty" jsonschema:"description=Optional concise summary from workout-history tools when the user asked for the draft to be based on past training, such as last-session sets, best e1RM, or recent trend."`
+	WarmupScheme      string `json:"warmupScheme,omitempty" jsonschema:"description=Optional warm-up ramp to add before each weighted exercise when the user wants warm-up sets: standard, quick, or powerlifting. Omit when the user did not ask for warm-ups."`
+
+	// SavedExerciseNotes is loaded from the user's exercise notes, not supplied by the chat model.
+	SavedExerciseNotes []ExerciseNoteView `json:"-"`
+	// LoadingEquipment is the user's equipment inventory; draft weights are rounded to it.
+	LoadingEquipment *equipment.Inventory `json:"-"`
 }
 
-func defineWorkoutDraftTool(g *genkit.Genkit, modelName string) ai.Tool {
+func defineWorkoutDraftTool(g *genkit.Genkit, modelName string, reader ChatDataReader) ai.Tool {
 	return genkit.DefineTool(g, workoutDraftToolName,
 		workoutDraftToolDescription,
 		func(ctx *ai.ToolContext, input WorkoutGenerationToolInput) (*workout.CreateWorkoutRequest, error) {
@@ -58,6 +68,8 @@ func defineWorkoutDraftTool(g *genkit.Genkit, modelName string) ai.Tool {
 			if err := validateWorkoutGenerationToolInput(input); err != nil {
 				return nil, err
 			}
+			input.SavedExerciseNotes = loadSavedExerciseNotes(ctx, reader)
+			input.LoadingEquipment = loadLoadingEquipment(ctx, reader)
 
 			draft, err := generateWorkoutDraft(ctx, g, modelName, input, time.Now())
 			logAIChatTraceContext(ctx, "workout_draft_tool_finished",
@@ -74,6 +86,50 @@ func defineWorkoutDraftTool(g *genkit.Genkit, modelName string) ai.Tool {
 	)
 }
 
+// loadSavedExerciseNotes is best effort: a draft without saved cues is still useful.
+func loadSavedExerciseNotes(ctx context.Context, reader ChatDataReader) []ExerciseNoteView {
+	if reader == nil {
+		return nil
+	}
+	userID, ok := user.Current(ctx)
+	if !ok || strings.TrimSpace(userID) == "" {
+		return nil
+	}
+
+	notes, err := reader.ExerciseNotes(ctx, userID)
+	if err != nil {
+		logAIChatTraceContext(ctx, "workout_draft_exercise_notes_failed",
+			"error", traceError(err),
+			"request_id", request.GetRequestID(ctx),
+		)
+		return nil
+	}
+	return notes
+}
+
+// loadLoadingEquipment falls back to the default inventory when the user's
+// equipment cannot be read, so draft weights are still loadable in a typical gym.
+func loadLoadingEquipment(ctx context.Context, reader ChatDataReader) *equipment.Inventory {
+	if reader == nil {
+		return nil
+	}
+	userID, ok := user.Current(ctx)
+	if !ok || strings.TrimSpace(userID) == "" {
+		return nil
+	}
+
+	inv, err := reader.EquipmentInventory(ctx, userID)
+	if err != nil || inv == nil {
+		logAIChatTraceContext(ctx, "workout_draft_equipment_failed",
+			"error", traceError(err),
+			"request_id", request.GetRequestID(ctx),
+		)
+		fallback := equipment.DefaultInventory()
+		return &fallback
+	}
+	return inv
+}
+
 func validateWorkoutGenerationToolInput(input WorkoutGenerationToolInput) error {
 	missing := make([]string, 0, 4)
 
@@ -96,6 +152,11 @@ func validateWorkoutGenerationToolInput(input WorkoutGenerationToolInput) error
 	if input.SessionDuration > 300 {
 		return fmt.Errorf("sessionDuration must be between 1 and 300 minutes")
 	}
+	if scheme := strings.TrimSpace(input.WarmupScheme); scheme != "" {
+		if _, ok := workout.WarmupSchemeSteps(strings.ToLower(scheme)); !ok {
+			return fmt.Errorf("warmupScheme must be standard, quick, or powerlifting")
+		}
+	}
 
 	return nil
 }
@@ -180,7 +241,10 @@ func generateWorkoutDraftWith(
 			"request_id", request.GetRequestID(ctx),
 		)
 
-		normalizeWorkoutDraft(output)
+		if scheme := strings.TrimSpace(input.WarmupScheme); scheme != "" {
+			output.WarmupScheme = scheme
+		}
+		normalizeWorkoutDraft(output, input.LoadingEquipment)
 		if err := validateWorkoutDraft(output); err != nil {
 			return nil, fmt.Errorf("validate workout draft: %w", err)
 		}
@@ -237,6 +301,7 @@ Return structured data only. The output must match FitTrack's current workout cr
   "date": "RFC3339 timestamp",
   "notes": "optional string",
   "workoutFocus": "optional string",
+  "tags": ["optional lowercase tag"],
   "exercises": [
     {
       "name": "exercise name",
@@ -261,9 +326,11 @@ Rules:
 - Adjust density by goal: strength can use fewer exercises with longer rests and enough sets; hypertrophy should use moderate rests and enough total working sets; endurance or circuit work should use shorter rests and higher density; rehab, mobility, and beginner sessions can use lower volume when appropriate.
 - Do not invent equipment the user does not have.
 - Use real, established exercise names only.
+- When saved exercise notes are supplied, respect those cues, grips, and machine settings for any of those exercises you include. Do not add an exercise just because it has saved notes.
 - When recent performance is supplied, use it to choose conservative weights and progressions; do not exceed past bests aggressively unless the user explicitly asks for testing.
 - Add weights only when they are reasonably known from the user's context. If fitness level is unknown, prefer omitting weights instead of guessing aggressively.
 - Keep notes brief and practical. Use notes for rest, effort, or injury reminders when helpful.
+- Suggest up to 3 short lowercase tags only when they clearly apply, such as "deload" for a planned lighter week, "travel" for hotel or limited-equipment sessions, "competition prep", or "sick" for a reduced session while unwell. Otherwise omit "tags".
 - Place compound lifts before accessories when that fits the request.
 - %s`, dateInstruction)
 }
@@ -285,6 +352,18 @@ func buildWorkoutGenerationUserPrompt(input WorkoutGenerationToolInput) string {
 	if recentPerformance := strings.TrimSpace(input.RecentPerformance); recentPerformance != "" {
 		builder.WriteString(fmt.Sprintf("- Recent performance to respect: %s\n", recentPerformance))
 	}
+	if input.LoadingEquipment != nil {
+		builder.WriteString(fmt.Sprintf("- Loadable weights: %s\n", formatLoadingEquipment(*input.LoadingEquipment)))
+	}
+	if strings.TrimSpace(input.WarmupScheme) != "" {
+		builder.WriteString("- Warm-up sets: added automatically before weighted exercises, so include only working sets for them\n")
+	}
+	if len(input.SavedExerciseNotes) > 0 {
+		builder.WriteString("- Saved exercise notes (apply only to exercises you include):\n")
+		for _, note := range input.SavedExerciseNotes {
+			builder.WriteString(fmt.Sprintf("  - %s: %s\n", note.ExerciseName, formatSavedExerciseNote(note)))
+		}
+	}
 
 	return builder.String()
 }
@@ -307,6 +386,34 @@ func buildWorkoutGenerationRepairPrompt(input WorkoutGenerationToolInput, qualit
 	return builder.String()
 }
 
+func formatSavedExerciseNote(note ExerciseNoteView) string {
+	parts := make([]string, 0, 2)
+	if notes := strings.TrimSpace(note.Notes); notes != "" {
+		parts = append(parts, "cues: "+notes)
+	}
+	if settings := strings.TrimSpace(note.Settings); settings != "" {
+		parts = append(parts, "settings: "+settings)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func formatLoadingEquipment(inv equipment.Inventory) string {
+	bars := make([]string, 0, len(inv.BarbellWeights))
+	for _, bar := range inv.BarbellWeights {
+		bars = append(bars, formatSetWeight(bar))
+	}
+	plates := make([]string, 0, len(inv.Plates))
+	for _, plate := range inv.Plates {
+		plates = append(plates, formatSetWeight(plate.Weight))
+	}
+	return fmt.Sprintf("bars %s; plates %s (in pairs); dumbbells in %s steps; machine stacks in %s steps",
+		strings.Join(bars, ", "),
+		strings.Join(plates, ", "),
+		formatSetWeight(inv.DumbbellIncrement),
+		formatSetWeight(inv.MachineStackStep),
+	)
+}
+
 func workoutPromptValue(value string) string {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
@@ -324,7 +431,10 @@ func validateWorkoutDraft(draft *workout.CreateWorkoutRequest) error {
 	return workoutDraftValidator.Struct(*draft)
 }
 
-func normalizeWorkoutDraft(draft *workout.CreateWorkoutRequest) {
+// normalizeWorkoutDraft cleans draft text and, when inv is set, rounds each
+// set weight to the closest load the user's equipment allows. A warm-up
+// scheme on the draft is expanded into warm-up sets and then cleared.
+func normalizeWorkoutDraft(draft *workout.CreateWorkoutRequest, inv *equipment.Inventory) {
 	if draft == nil {
 		return
 	}
@@ -332,14 +442,31 @@ func normalizeWorkoutDraft(draft *workout.CreateWorkoutRequest) {
 	draft.Date = cleanWorkoutDraftText(draft.Date)
 	draft.Notes = cleanOptionalWorkoutDraftText(draft.Notes)
 	draft.WorkoutFocus = cleanOptionalWorkoutDraftText(draft.WorkoutFocus)
+	draft.Tags = tag.NormalizeList(draft.Tags)
+	if len(draft.Tags) > maxWorkoutDraftTags {
+		draft.Tags = draft.Tags[:maxWorkoutDraftTags]
+	}
 
 	for exerciseIndex := range draft.Exercises {
 		draft.Exercises[exerciseIndex].Name = cleanWorkoutDraftText(draft.Exercises[exerciseIndex].Name)
 		for setIndex := range draft.Exercises[exerciseIndex].Sets {
-			setType := cleanWorkoutDraftText(draft.Exercises[exerciseIndex].Sets[setIndex].SetType)
-			draft.Exercises[exerciseIndex].Sets[setIndex].SetType = strings.ToLower(setType)
+			set := &draft.Exercises[exerciseIndex].Sets[setIndex]
+			set.SetType = strings.ToLower(cleanWorkoutDraftText(set.SetType))
+			if inv != nil && set.Weight != nil {
+				weight := inv.RoundForExercise(draft.Exercises[exerciseIndex].Name, *set.Weight)
+				set.Weight = &weight
+			}
+		}
+	}
+
+	if steps, ok := workout.WarmupSchemeSteps(strings.ToLower(cleanWorkoutDraftText(draft.WarmupScheme))); ok {
+		warmupInventory := equipment.DefaultInventory()
+		if inv != nil {
+			warmupInventory = *inv
 		}
+		draft.Exercises = workout.WithWarmupSets(draft.Exercises, steps, warmupInventory)
 	}
+	draft.WarmupScheme = ""
 }
 
 func extractWorkoutDraftFromHistory(history []*ai.Message) (*workout.CreateWorkoutRequest, error) {
@@ -367,7 +494,7 @@ func extractWorkoutDraftFromHistory(history []*ai.Message) (*workout.CreateWorko
 				return nil, fmt.Errorf("decode workout draft tool output: %w", err)
 			}
 
-			normalizeWorkoutDraft(&draft)
+			normalizeWorkoutDraft(&draft, nil)
 			if err := validateWorkoutDraft(&draft); err != nil {
 				return nil, err
 			}
@@ -8,13 +8,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
 	"github.com/Andrewy-gh/fittrack/server/internal/workout"
 	"github.com/firebase/genkit/go/ai"
 	"github.com/firebase/genkit/go/genkit"
 )
 
 func TestBuildChatSystemPromptIncludesWorkoutGuardrails(t *testing.T) {
-	prompt := buildChatSystemPrompt(nil, nil, time.Date(2026, 7, 6, 12, 0, 0, 0, time.UTC), true)
+	prompt := buildChatSystemPrompt(nil, nil, nil, time.Date(2026, 7, 6, 12, 0, 0, 0, time.UTC), true)
 
 	requiredSnippets := []string{
 		"Ask at most 3 short, focused follow-up questions",
@@ -207,6 +209,7 @@ func TestBuildWorkoutGenerationPromptIncludesFitTrackContract(t *testing.T) {
 	requiredSnippets := []string{
 		`"date": "RFC3339 timestamp"`,
 		`"workoutFocus": "optional string"`,
+		`"tags": ["optional lowercase tag"]`,
 		`"setType": "warmup" | "working"`,
 		`"date" is always required and must be RFC3339.`,
 		`If fitness level is unknown, prefer omitting weights instead of guessing aggressively.`,
@@ -224,6 +227,115 @@ func TestBuildWorkoutGenerationPromptIncludesFitTrackContract(t *testing.T) {
 	}
 }
 
+func TestNormalizeWorkoutDraftCleansSuggestedTags(t *testing.T) {
+	draft := &workout.CreateWorkoutRequest{
+		Tags: []string{" Travel ", "travel", "", "Deload", "sick", "competition prep"},
+	}
+
+	normalizeWorkoutDraft(draft, nil)
+
+	want := []string{"travel", "deload", "sick"}
+	if strings.Join(draft.Tags, ",") != strings.Join(want, ",") {
+		t.Fatalf("normalizeWorkoutDraft() tags = %v, want %v", draft.Tags, want)
+	}
+}
+
+func TestNormalizeWorkoutDraftRoundsWeightsToEquipment(t *testing.T) {
+	squat, curl, bodyweight := 83.7, 27.0, 0.0
+	draft := &workout.CreateWorkoutRequest{
+		Exercises: []workout.ExerciseInput{
+			{Name: "Back Squat", Sets: []workout.SetInput{{Weight: &squat, Reps: 5, SetType: "working"}}},
+			{Name: "Dumbbell Curl", Sets: []workout.SetInput{{Weight: &curl, Reps: 10, SetType: "working"}}},
+			{Name: "Pull-Up", Sets: []workout.SetInput{{Weight: &bodyweight, Reps: 8, SetType: "working"}, {Reps: 8, SetType: "working"}}},
+		},
+	}
+	inv := equipment.DefaultInventory()
+
+	normalizeWorkoutDraft(draft, &inv)
+
+	if got := *draft.Exercises[0].Sets[0].Weight; got != 85 {
+		t.Fatalf("squat weight = %v, want 85", got)
+	}
+	if got := *draft.Exercises[1].Sets[0].Weight; got != 25 {
+		t.Fatalf("curl weight = %v, want 25", got)
+	}
+	if got := *draft.Exercises[2].Sets[0].Weight; got != 0 {
+		t.Fatalf("bodyweight weight = %v, want 0", got)
+	}
+	if draft.Exercises[2].Sets[1].Weight != nil {
+		t.Fatalf("unloaded set weight = %v, want nil", *draft.Exercises[2].Sets[1].Weight)
+	}
+}
+
+func TestNormalizeWorkoutDraftAddsWarmupSets(t *testing.T) {
+	squat, warmup, curl := 225.0, 20.0, 30.0
+	draft := &workout.CreateWorkoutRequest{
+		WarmupScheme: " Quick ",
+		Exercises: []workout.ExerciseInput{
+			{Name: "Back Squat", Sets: []workout.SetInput{{Weight: &squat, Reps: 5, SetType: "working"}}},
+			{Name: "Dumbbell Curl", Sets: []workout.SetInput{{Weight: &warmup, Reps: 10, SetType: "warmup"}, {Weight: &curl, Reps: 10, SetType: "working"}}},
+		},
+	}
+	inv := equipment.DefaultInventory()
+
+	normalizeWorkoutDraft(draft, &inv)
+
+	if draft.WarmupScheme != "" {
+		t.Fatalf("WarmupScheme = %q, want cleared", draft.WarmupScheme)
+	}
+	squatSets := draft.Exercises[0].Sets
+	if len(squatSets) != 3 || squatSets[0].SetType != "warmup" || *squatSets[0].Weight != 110 || *squatSets[1].Weight != 170 || squatSets[2].SetType != "working" {
+		t.Fatalf("squat sets = %+v, want quick ramp 110, 170 before the working set", squatSets)
+	}
+	if len(draft.Exercises[1].Sets) != 2 {
+		t.Fatalf("curl sets = %+v, want existing warm-up kept as is", draft.Exercises[1].Sets)
+	}
+}
+
+func TestValidateWorkoutGenerationToolInputRejectsUnknownWarmupScheme(t *testing.T) {
+	input := WorkoutGenerationToolInput{
+		Equipment:       "full gym",
+		SessionDuration: 45,
+		WorkoutFocus:    "legs",
+		Injuries:        "none",
+		WarmupScheme:    "pyramid",
+	}
+
+	if err := validateWorkoutGenerationToolInput(input); err == nil {
+		t.Fatalf("validateWorkoutGenerationToolInput() error = nil, want warmupScheme error")
+	}
+	input.WarmupScheme = "Powerlifting"
+	if err := validateWorkoutGenerationToolInput(input); err != nil {
+		t.Fatalf("validateWorkoutGenerationToolInput() error = %v, want nil", err)
+	}
+}
+
+func TestLoadLoadingEquipment(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	if inv := loadLoadingEquipment(context.Background(), &stubChatDataReader{}); inv != nil {
+		t.Fatalf("loadLoadingEquipment() without user = %v, want nil", inv)
+	}
+	saved := equipment.Inventory{BarbellWeights: []float64{20}, Plates: []equipment.Plate{{Weight: 20, Pairs: 4}}, DumbbellIncrement: 2, MachineStackStep: 5}
+	if inv := loadLoadingEquipment(ctx, &stubChatDataReader{equipment: &saved}); inv == nil || inv.BarbellWeights[0] != 20 {
+		t.Fatalf("loadLoadingEquipment() = %v, want saved inventory", inv)
+	}
+	inv := loadLoadingEquipment(ctx, &stubChatDataReader{equipmentErr: errors.New("db down")})
+	if inv == nil || inv.BarbellWeights[0] != 45 {
+		t.Fatalf("loadLoadingEquipment() after error = %v, want default inventory", inv)
+	}
+}
+
+func TestBuildWorkoutGenerationUserPromptIncludesLoadableWeights(t *testing.T) {
+	inv := equipment.DefaultInventory()
+	prompt := buildWorkoutGenerationUserPrompt(WorkoutGenerationToolInput{SessionDuration: 45, LoadingEquipment: &inv})
+
+	want := "- Loadable weights: bars 45; plates 45, 35, 25, 10, 5, 2.5 (in pairs); dumbbells in 5 steps; machine stacks in 10 steps"
+	if !strings.Contains(prompt, want) {
+		t.Fatalf("buildWorkoutGenerationUserPrompt() = %q, want %q", prompt, want)
+	}
+}
+
 func TestBuildWorkoutGenerationUserPromptLabelsMissingFitnessLevelAsUnknown(t *testing.T) {
 	prompt := buildWorkoutGenerationUserPrompt(WorkoutGenerationToolInput{
 		Equipment:         "full gym",
@@ -244,6 +356,49 @@ func TestBuildWorkoutGenerationUserPromptLabelsMissingFitnessLevelAsUnknown(t *t
 	}
 }
 
+func TestBuildWorkoutGenerationUserPromptIncludesSavedExerciseNotes(t *testing.T) {
+	input := WorkoutGenerationToolInput{
+		Equipment:       "full gym",
+		SessionDuration: 45,
+		WorkoutFocus:    "pull",
+		Injuries:        "none",
+	}
+	if prompt := buildWorkoutGenerationUserPrompt(input); strings.Contains(prompt, "Saved exercise notes") {
+		t.Fatalf("buildWorkoutGenerationUserPrompt() = %q, want no saved notes section", prompt)
+	}
+
+	input.SavedExerciseNotes = []ExerciseNoteView{
+		{ExerciseName: "Lat Pulldown", Notes: "Thumbless grip", Settings: "Thigh pad 3"},
+		{ExerciseName: "Seated Cable Row", Settings: "Seat 5"},
+	}
+	prompt := buildWorkoutGenerationUserPrompt(input)
+
+	for _, want := range []string{
+		"- Saved exercise notes (apply only to exercises you include):",
+		"  - Lat Pulldown: cues: Thumbless grip; settings: Thigh pad 3",
+		"  - Seated Cable Row: settings: Seat 5",
+	} {
+		if !strings.Contains(prompt, want) {
+			t.Fatalf("buildWorkoutGenerationUserPrompt() = %q, want %q", prompt, want)
+		}
+	}
+}
+
+func TestLoadSavedExerciseNotes(t *testing.T) {
+	reader := &stubChatDataReader{exerciseNotes: []ExerciseNoteView{{ExerciseName: "Leg Press", Settings: "Sled 6"}}}
+
+	if notes := loadSavedExerciseNotes(context.Background(), reader); notes != nil {
+		t.Fatalf("loadSavedExerciseNotes() without user = %v, want nil", notes)
+	}
+	if notes := loadSavedExerciseNotes(user.WithContext(context.Background(), "user-1"), nil); notes != nil {
+		t.Fatalf("loadSavedExerciseNotes() without reader = %v, want nil", notes)
+	}
+	notes := loadSavedExerciseNotes(user.WithContext(context.Background(), "user-1"), reader)
+	if len(notes) != 1 || notes[0].Settings != "Sled 6" {
+		t.Fatalf("loadSavedExerciseNotes() = %v, want saved leg press settings", notes)
+	}
+}
+
 func TestBuildWorkoutGenerationPromptUsesUserLocalLanguageForRelativeDates(t *testing.T) {
 	loc := time.FixedZone("EDT", -4*60*60)
 	prompt := buildWorkoutGenerationPrompt(
@@ -9,6 +9,10 @@ import (
 	"time"
 
 	"github.com/Andrewy-gh/fittrack/server/internal/aichat"
+	"github.com/Andrewy-gh/fittrack/server/internal/analytics"
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
+	"github.com/Andrewy-gh/fittrack/server/internal/goals"
+	"github.com/Andrewy-gh/fittrack/server/internal/workout"
 )
 
 const FixtureUserID = "ai-chat-fixture-user"
@@ -114,6 +118,31 @@ func (r *fixtureChatDataReader) TrainingSnapshot(ctx context.Context, userID str
 	}, nil
 }
 
+func (r *fixtureChatDataReader) TrainingLoad(ctx context.Context, userID string, metric string) (*aichat.TrainingLoadView, error) {
+	_ = ctx
+	if userID != r.userID {
+		return nil, nil
+	}
+	if metric == "" {
+		metric = analytics.LoadMetricTonnage
+	}
+	acwr := 1.12
+	monotony := 0.94
+	strain := 24816.0
+	return &aichat.TrainingLoadView{
+		Metric:      metric,
+		Date:        "2026-07-06",
+		AcuteLoad:   26400,
+		ChronicLoad: 23580,
+		ACWR:        &acwr,
+		Monotony:    &monotony,
+		Strain:      &strain,
+		Sessions7d:  3,
+		Sessions28d: 11,
+		Warnings:    []analytics.TrainingLoadWarning{},
+	}, nil
+}
+
 func (r *fixtureChatDataReader) TrainingProfile(ctx context.Context, userID string) (*aichat.TrainingProfile, error) {
 	_ = ctx
 	if userID != r.userID {
@@ -122,6 +151,29 @@ func (r *fixtureChatDataReader) TrainingProfile(ctx context.Context, userID stri
 	return cloneTrainingProfile(r.profile), nil
 }
 
+// ExerciseNotes returns nothing so eval drafts are not steered by saved cues.
+func (r *fixtureChatDataReader) ExerciseNotes(ctx context.Context, userID string) ([]aichat.ExerciseNoteView, error) {
+	_ = ctx
+	_ = userID
+	return nil, nil
+}
+
+// EquipmentInventory returns the default inventory so eval drafts are
+// rounded the same way for every fixture.
+func (r *fixtureChatDataReader) EquipmentInventory(ctx context.Context, userID string) (*equipment.Inventory, error) {
+	_ = ctx
+	_ = userID
+	inv := equipment.DefaultInventory()
+	return &inv, nil
+}
+
+// ActiveGoals returns nothing so eval prompts match across fixtures.
+func (r *fixtureChatDataReader) ActiveGoals(ctx context.Context, userID string) ([]goals.GoalResponse, error) {
+	_ = ctx
+	_ = userID
+	return nil, nil
+}
+
 func (r *fixtureChatDataReader) UpdateTrainingProfile(ctx context.Context, userID string, update aichat.TrainingProfileUpdate) (*aichat.TrainingProfile, error) {
 	_ = ctx
 	if userID != r.userID {
@@ -209,6 +261,69 @@ func (r *fixtureChatDataReader) ExerciseStats(ctx context.Context, userID string
 	return stats, nil
 }
 
+func (r *fixtureChatDataReader) CompareWorkouts(ctx context.Context, userID string, filter aichat.WorkoutComparisonFilter) (*aichat.WorkoutComparisonView, error) {
+	_ = ctx
+	if userID != r.userID {
+		return nil, nil
+	}
+
+	var candidates []aichat.ChatWorkoutView
+	for _, workout := range r.workouts {
+		if filter.WorkoutFocus != "" && !strings.Contains(strings.ToLower(workout.Focus), strings.ToLower(filter.WorkoutFocus)) {
+			continue
+		}
+		candidates = append(candidates, workout)
+	}
+
+	onOrBefore := func(date time.Time) []aichat.ChatWorkoutView {
+		var matches []aichat.ChatWorkoutView
+		for _, workout := range candidates {
+			workoutDate, _ := time.Parse("2006-01-02", workout.Date)
+			if !workoutDate.After(endOfDay(date)) {
+				matches = append(matches, workout)
+			}
+		}
+		return matches
+	}
+
+	var pair []aichat.ChatWorkoutView
+	switch {
+	case filter.DateA != nil && filter.DateB != nil:
+		for _, date := range []time.Time{*filter.DateA, *filter.DateB} {
+			matches := onOrBefore(date)
+			if len(matches) == 0 || matches[0].Date != startOfDay(date).Format("2006-01-02") {
+				return nil, nil
+			}
+			pair = append(pair, matches[0])
+		}
+	default:
+		matches := candidates
+		if filter.DateA != nil {
+			matches = onOrBefore(*filter.DateA)
+		}
+		if filter.DateB != nil {
+			matches = onOrBefore(*filter.DateB)
+		}
+		if len(matches) < 2 {
+			return nil, nil
+		}
+		pair = []aichat.ChatWorkoutView{matches[1], matches[0]}
+	}
+
+	exerciseIDs := make(map[string]int32)
+	comparison := workout.CompareWorkouts(
+		fixtureComparisonWorkout(1, pair[0], exerciseIDs),
+		fixtureComparisonWorkout(2, pair[1], exerciseIDs),
+	)
+	return &aichat.WorkoutComparisonView{
+		A:         aichat.ComparedWorkoutView{Date: pair[0].Date, Focus: pair[0].Focus},
+		B:         aichat.ComparedWorkoutView{Date: pair[1].Date, Focus: pair[1].Focus},
+		Exercises: comparison.Exercises,
+		OnlyInA:   comparison.OnlyInA,
+		OnlyInB:   comparison.OnlyInB,
+	}, nil
+}
+
 func fixtureWorkouts() []aichat.ChatWorkoutView {
 	return []aichat.ChatWorkoutView{
 		fixtureWorkout("2026-07-03", "upper body", "Top bench single felt smooth.", fixtureExercise("Bench Press", "185x5 working", "195x3 working"), fixtureExercise("Barbell Row", "155x8 working", "155x8 working")),
@@ -224,6 +339,37 @@ func fixtureWorkouts() []aichat.ChatWorkoutView {
 	}
 }
 
+func fixtureComparisonWorkout(id int32, view aichat.ChatWorkoutView, exerciseIDs map[string]int32) workout.ComparisonWorkout {
+	date, _ := time.Parse("2006-01-02", view.Date)
+	focus := view.Focus
+	comparison := workout.ComparisonWorkout{ID: id, Date: date, Focus: &focus}
+	for _, exercise := range view.Exercises {
+		exerciseID, ok := exerciseIDs[exercise.Name]
+		if !ok {
+			exerciseID = int32(len(exerciseIDs) + 1)
+			exerciseIDs[exercise.Name] = exerciseID
+		}
+		for _, set := range exercise.Sets {
+			weight, reps, ok := parseFixtureSet(set)
+			if !ok {
+				continue
+			}
+			setType := "working"
+			if fields := strings.Fields(set); len(fields) > 1 {
+				setType = fields[1]
+			}
+			comparison.Sets = append(comparison.Sets, workout.ComparisonSet{
+				ExerciseID:   exerciseID,
+				ExerciseName: exercise.Name,
+				Weight:       &weight,
+				Reps:         int32(reps),
+				SetType:      setType,
+			})
+		}
+	}
+	return comparison
+}
+
 func cloneTrainingProfile(profile *aichat.TrainingProfile) *aichat.TrainingProfile {
 	if profile == nil {
 		return nil
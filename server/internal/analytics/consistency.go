@@ -0,0 +1,202 @@
+package analytics
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type streakRun struct {
+	start time.Time
+	end   time.Time
+	count int
+}
+
+// buildConsistency derives streaks and weekly frequency from raw workout
+// timestamps. Days are civil dates in loc, represented as UTC midnights so
+// day arithmetic is unaffected by DST transitions.
+func buildConsistency(workoutTimes []time.Time, opts ConsistencyOptions, loc *time.Location, now time.Time) *ConsistencyResponse {
+	resp := &ConsistencyResponse{
+		Timezone:              opts.Timezone,
+		TargetSessionsPerWeek: opts.TargetSessionsPerWeek,
+		AllowedRestDays:       opts.AllowedRestDays,
+		RollingWeeks:          opts.RollingWeeks,
+		TotalSessions:         len(workoutTimes),
+		WeeklySessions:        []WeeklySessions{},
+	}
+
+	today := user.CivilDay(now, loc)
+	currentWeek := weekStart(today)
+
+	dayCounts := make(map[time.Time]int)
+	weekCounts := make(map[time.Time]int)
+	for _, workoutTime := range workoutTimes {
+		day := user.CivilDay(workoutTime, loc)
+		dayCounts[day]++
+		weekCounts[weekStart(day)]++
+	}
+
+	days := make([]time.Time, 0, len(dayCounts))
+	for day := range dayCounts {
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	resp.TrainingDays = len(days)
+	resp.CurrentWeekSessions = weekCounts[currentWeek]
+	resp.CurrentWeekTargetReached = resp.CurrentWeekSessions >= opts.TargetSessionsPerWeek
+	if len(days) == 0 {
+		return resp
+	}
+	resp.LastWorkoutDate = formatDayPtr(days[len(days)-1])
+
+	resp.DailyStreak = dailyStreak(days, opts.AllowedRestDays, today)
+	resp.WeeklyStreak = weeklyStreak(weekCounts, weekStart(days[0]), currentWeek, opts.TargetSessionsPerWeek)
+
+	firstWeek := weekStart(days[0])
+	historyStart := currentWeek.AddDate(0, 0, -7*(consistencyHistoryWeeks-1))
+	for week := historyStart; !week.After(currentWeek); week = week.AddDate(0, 0, 7) {
+		sessions := weekCounts[week]
+		resp.WeeklySessions = append(resp.WeeklySessions, WeeklySessions{
+			WeekStart:      formatDay(week),
+			Sessions:       sessions,
+			TargetMet:      sessions >= opts.TargetSessionsPerWeek,
+			RollingAverage: rollingWeeklyAverage(weekCounts, firstWeek, week, opts.RollingWeeks),
+		})
+	}
+
+	// The in-progress week would drag the headline average down, so it only
+	// covers completed weeks.
+	resp.SessionsPerWeekAvg = rollingWeeklyAverage(weekCounts, firstWeek, currentWeek.AddDate(0, 0, -7), opts.RollingWeeks)
+
+	return resp
+}
+
+// dailyStreak treats training days separated by at most allowedRestDays
+// untrained days as one streak. The current streak stays alive until the
+// allowance has been used up relative to today.
+func dailyStreak(days []time.Time, allowedRestDays int, today time.Time) StreakSummary {
+	maxGap := allowedRestDays + 1
+	runs := make([]streakRun, 0)
+	for _, day := range days {
+		if len(runs) > 0 && daysBetween(runs[len(runs)-1].end, day) <= maxGap {
+			runs[len(runs)-1].end = day
+			runs[len(runs)-1].count++
+			continue
+		}
+		runs = append(runs, streakRun{start: day, end: day, count: 1})
+	}
+
+	summary := StreakSummary{}
+	longest := longestRun(runs)
+	if longest != nil {
+		summary.Longest = longest.count
+		summary.LongestStart = formatDayPtr(longest.start)
+		summary.LongestEnd = formatDayPtr(longest.end)
+	}
+
+	last := runs[len(runs)-1]
+	if daysBetween(last.end, today) <= maxGap {
+		summary.Current = last.count
+		summary.CurrentStart = formatDayPtr(last.start)
+	}
+	return summary
+}
+
+// weeklyStreak counts consecutive weeks that reached the session target. The
+// current week only extends the streak once it reaches the target; until then
+// it does not break a streak carried over from the previous week.
+func weeklyStreak(weekCounts map[time.Time]int, firstWeek time.Time, currentWeek time.Time, target int) StreakSummary {
+	runs := make([]streakRun, 0)
+	open := false
+	for week := firstWeek; !week.After(currentWeek); week = week.AddDate(0, 0, 7) {
+		if weekCounts[week] < target {
+			open = false
+			continue
+		}
+		if open {
+			runs[len(runs)-1].end = week
+			runs[len(runs)-1].count++
+			continue
+		}
+		runs = append(runs, streakRun{start: week, end: week, count: 1})
+		open = true
+	}
+
+	summary := StreakSummary{}
+	longest := longestRun(runs)
+	if longest == nil {
+		return summary
+	}
+	summary.Longest = longest.count
+	summary.LongestStart = formatDayPtr(longest.start)
+	summary.LongestEnd = formatDayPtr(longest.end)
+
+	last := runs[len(runs)-1]
+	if last.end.Equal(currentWeek) || last.end.Equal(currentWeek.AddDate(0, 0, -7)) {
+		summary.Current = last.count
+		summary.CurrentStart = formatDayPtr(last.start)
+	}
+	return summary
+}
+
+func longestRun(runs []streakRun) *streakRun {
+	var longest *streakRun
+	for i := range runs {
+		// Ties go to the most recent run.
+		if longest == nil || runs[i].count >= longest.count {
+			longest = &runs[i]
+		}
+	}
+	return longest
+}
+
+// rollingWeeklyAverage averages sessions over the window of weeks ending at
+// endWeek. Weeks before the user's first workout are excluded so new users are
+// not penalized for history they do not have.
+func rollingWeeklyAverage(weekCounts map[time.Time]int, firstWeek time.Time, endWeek time.Time, window int) float64 {
+	if endWeek.Before(firstWeek) || window <= 0 {
+		return 0
+	}
+	start := endWeek.AddDate(0, 0, -7*(window-1))
+	if start.Before(firstWeek) {
+		start = firstWeek
+	}
+
+	total := 0
+	weeks := 0
+	for week := start; !week.After(endWeek); week = week.AddDate(0, 0, 7) {
+		total += weekCounts[week]
+		weeks++
+	}
+	if weeks == 0 {
+		return 0
+	}
+	return roundTo(float64(total)/float64(weeks), 2)
+}
+
+// weekStart returns the Monday that starts day's ISO week.
+func weekStart(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+func daysBetween(from time.Time, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func formatDay(day time.Time) string {
+	return day.Format(analyticsDateLayout)
+}
+
+func formatDayPtr(day time.Time) *string {
+	formatted := formatDay(day)
+	return &formatted
+}
+
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
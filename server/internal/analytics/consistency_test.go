@@ -0,0 +1,168 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func consistencyTestTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	return parsed
+}
+
+func consistencyTestOptions(target int, restDays int) ConsistencyOptions {
+	return ConsistencyOptions{
+		Timezone:              "UTC",
+		TargetSessionsPerWeek: target,
+		AllowedRestDays:       restDays,
+		RollingWeeks:          defaultRollingWeeks,
+	}
+}
+
+func TestBuildConsistencyDailyAndWeeklyStreaks(t *testing.T) {
+	now := consistencyTestTime(t, "2026-03-18T12:00:00Z")
+	workouts := []time.Time{
+		consistencyTestTime(t, "2026-03-02T10:00:00Z"),
+		consistencyTestTime(t, "2026-03-03T10:00:00Z"),
+		consistencyTestTime(t, "2026-03-04T10:00:00Z"),
+		consistencyTestTime(t, "2026-03-16T10:00:00Z"),
+		consistencyTestTime(t, "2026-03-17T10:00:00Z"),
+		consistencyTestTime(t, "2026-03-17T18:00:00Z"),
+	}
+
+	resp := buildConsistency(workouts, consistencyTestOptions(2, 0), time.UTC, now)
+
+	assert.Equal(t, 6, resp.TotalSessions)
+	assert.Equal(t, 5, resp.TrainingDays)
+	require.NotNil(t, resp.LastWorkoutDate)
+	assert.Equal(t, "2026-03-17", *resp.LastWorkoutDate)
+
+	assert.Equal(t, 2, resp.DailyStreak.Current)
+	require.NotNil(t, resp.DailyStreak.CurrentStart)
+	assert.Equal(t, "2026-03-16", *resp.DailyStreak.CurrentStart)
+	assert.Equal(t, 3, resp.DailyStreak.Longest)
+	assert.Equal(t, "2026-03-02", *resp.DailyStreak.LongestStart)
+	assert.Equal(t, "2026-03-04", *resp.DailyStreak.LongestEnd)
+
+	assert.Equal(t, 1, resp.WeeklyStreak.Current)
+	assert.Equal(t, 1, resp.WeeklyStreak.Longest)
+	assert.Equal(t, "2026-03-16", *resp.WeeklyStreak.LongestStart)
+	assert.Equal(t, 3, resp.CurrentWeekSessions)
+	assert.True(t, resp.CurrentWeekTargetReached)
+
+	require.Len(t, resp.WeeklySessions, consistencyHistoryWeeks)
+	assert.Equal(t, "2026-03-16", resp.WeeklySessions[len(resp.WeeklySessions)-1].WeekStart)
+	// Completed weeks since the first workout: 3 sessions, then 0.
+	assert.Equal(t, 1.5, resp.SessionsPerWeekAvg)
+}
+
+func TestBuildConsistencyAllowedRestDays(t *testing.T) {
+	now := consistencyTestTime(t, "2026-03-16T08:00:00Z")
+	workouts := []time.Time{
+		consistencyTestTime(t, "2026-03-10T10:00:00Z"),
+		consistencyTestTime(t, "2026-03-12T10:00:00Z"),
+		consistencyTestTime(t, "2026-03-14T10:00:00Z"),
+	}
+
+	t.Run("rest days keep the streak alive", func(t *testing.T) {
+		resp := buildConsistency(workouts, consistencyTestOptions(3, 1), time.UTC, now)
+
+		assert.Equal(t, 3, resp.DailyStreak.Current)
+		assert.Equal(t, 3, resp.DailyStreak.Longest)
+	})
+
+	t.Run("strict daily streak breaks on every gap", func(t *testing.T) {
+		resp := buildConsistency(workouts, consistencyTestOptions(3, 0), time.UTC, now)
+
+		assert.Equal(t, 0, resp.DailyStreak.Current)
+		assert.Nil(t, resp.DailyStreak.CurrentStart)
+		assert.Equal(t, 1, resp.DailyStreak.Longest)
+	})
+}
+
+func TestBuildConsistencyWeeklyStreakSurvivesInProgressWeek(t *testing.T) {
+	now := consistencyTestTime(t, "2026-03-17T08:00:00Z")
+	workouts := []time.Time{
+		consistencyTestTime(t, "2026-03-02T10:00:00Z"),
+		consistencyTestTime(t, "2026-03-05T10:00:00Z"),
+		consistencyTestTime(t, "2026-03-09T10:00:00Z"),
+		consistencyTestTime(t, "2026-03-12T10:00:00Z"),
+	}
+
+	resp := buildConsistency(workouts, consistencyTestOptions(2, 0), time.UTC, now)
+
+	assert.Equal(t, 2, resp.WeeklyStreak.Current)
+	require.NotNil(t, resp.WeeklyStreak.CurrentStart)
+	assert.Equal(t, "2026-03-02", *resp.WeeklyStreak.CurrentStart)
+	assert.Equal(t, 0, resp.CurrentWeekSessions)
+	assert.False(t, resp.CurrentWeekTargetReached)
+}
+
+func TestBuildConsistencyUsesTimezoneForDayBoundaries(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	now := consistencyTestTime(t, "2026-03-17T15:00:00Z")
+	workouts := []time.Time{
+		// 11:30 PM on Monday in New York, already Tuesday in UTC.
+		consistencyTestTime(t, "2026-03-17T03:30:00Z"),
+	}
+
+	resp := buildConsistency(workouts, ConsistencyOptions{
+		Timezone:              "America/New_York",
+		TargetSessionsPerWeek: 1,
+		RollingWeeks:          defaultRollingWeeks,
+	}, loc, now)
+
+	require.NotNil(t, resp.LastWorkoutDate)
+	assert.Equal(t, "2026-03-16", *resp.LastWorkoutDate)
+	assert.Equal(t, "America/New_York", resp.Timezone)
+	assert.Equal(t, 1, resp.DailyStreak.Current)
+}
+
+func TestBuildConsistencyWithoutWorkouts(t *testing.T) {
+	resp := buildConsistency(nil, consistencyTestOptions(3, 0), time.UTC, time.Now())
+
+	assert.Equal(t, 0, resp.TotalSessions)
+	assert.Nil(t, resp.LastWorkoutDate)
+	assert.Equal(t, StreakSummary{}, resp.DailyStreak)
+	assert.Equal(t, StreakSummary{}, resp.WeeklyStreak)
+	assert.Empty(t, resp.WeeklySessions)
+}
+
+func TestValidateConsistencyOptions(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		opts, loc, err := validateConsistencyOptions(ConsistencyOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", opts.Timezone)
+		assert.Equal(t, time.UTC, loc)
+		assert.Equal(t, defaultTargetSessionsPerWeek, opts.TargetSessionsPerWeek)
+		assert.Equal(t, defaultRollingWeeks, opts.RollingWeeks)
+	})
+
+	tests := []struct {
+		name  string
+		opts  ConsistencyOptions
+		field string
+	}{
+		{name: "target too high", opts: ConsistencyOptions{TargetSessionsPerWeek: 15}, field: "target_sessions_per_week"},
+		{name: "negative rest days", opts: ConsistencyOptions{AllowedRestDays: -1}, field: "allowed_rest_days"},
+		{name: "rolling window too long", opts: ConsistencyOptions{RollingWeeks: 53}, field: "rolling_weeks"},
+		{name: "unknown timezone", opts: ConsistencyOptions{Timezone: "Mars/Olympus_Mons"}, field: "timezone"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := validateConsistencyOptions(tt.opts)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
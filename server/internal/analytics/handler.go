@@ -0,0 +1,216 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+	"github.com/Andrewy-gh/fittrack/server/internal/tag"
+)
+
+type analyticsService interface {
+	GetConsistency(ctx context.Context, opts ConsistencyOptions) (*ConsistencyResponse, error)
+	GetTrainingLoad(ctx context.Context, opts TrainingLoadOptions) (*TrainingLoadResponse, error)
+	GetPlateaus(ctx context.Context, opts PlateauOptions) (*PlateauResponse, error)
+	GetVolume(ctx context.Context, opts VolumeOptions) (*VolumeResponse, error)
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service analyticsService
+}
+
+func NewHandler(logger *slog.Logger, service analyticsService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// GetConsistency godoc
+// @Summary Get training consistency
+// @Description Returns current and longest daily and weekly streaks plus a rolling sessions-per-week average, bucketed in the requested timezone.
+// @Tags analytics
+// @Produce json
+// @Security StackAuth
+// @Param timezone query string false "IANA timezone used for day and week boundaries; defaults to the account timezone, then UTC"
+// @Param target_sessions_per_week query int false "Sessions needed for a week to count toward the weekly streak" default(3) minimum(1) maximum(14)
+// @Param allowed_rest_days query int false "Untrained days allowed between sessions without breaking the daily streak" default(0) minimum(0) maximum(6)
+// @Param rolling_weeks query int false "Window size for the sessions-per-week rolling average" default(4) minimum(1) maximum(52)
+// @Success 200 {object} analytics.ConsistencyResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /analytics/consistency [get]
+func (h *Handler) GetConsistency(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	opts := ConsistencyOptions{Timezone: strings.TrimSpace(query.Get("timezone"))}
+
+	var err error
+	if opts.TargetSessionsPerWeek, err = intQueryParam(query.Get("target_sessions_per_week"), defaultTargetSessionsPerWeek); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "target_sessions_per_week must be an integer", err)
+		return
+	}
+	if opts.AllowedRestDays, err = intQueryParam(query.Get("allowed_rest_days"), defaultAllowedRestDays); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "allowed_rest_days must be an integer", err)
+		return
+	}
+	if opts.RollingWeeks, err = intQueryParam(query.Get("rolling_weeks"), defaultRollingWeeks); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "rolling_weeks must be an integer", err)
+		return
+	}
+
+	consistency, err := h.service.GetConsistency(r.Context(), opts)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get consistency analytics")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, consistency); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// GetTrainingLoad godoc
+// @Summary Get training load
+// @Description Returns daily training load with acute (7-day) and chronic (28-day, per week) load, the acute:chronic workload ratio, monotony and strain, plus warnings when load is ramping too fast.
+// @Tags analytics
+// @Produce json
+// @Security StackAuth
+// @Param timezone query string false "IANA timezone used for day boundaries; defaults to the account timezone, then UTC"
+// @Param metric query string false "Per-session load measure" Enums(tonnage, hard_sets) default(tonnage)
+// @Param days query int false "Number of daily points to return" default(28) minimum(1) maximum(365)
+// @Success 200 {object} analytics.TrainingLoadResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /analytics/training-load [get]
+func (h *Handler) GetTrainingLoad(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	opts := TrainingLoadOptions{
+		Timezone: strings.TrimSpace(query.Get("timezone")),
+		Metric:   strings.TrimSpace(query.Get("metric")),
+	}
+
+	var err error
+	if opts.Days, err = intQueryParam(query.Get("days"), defaultTrainingLoadDays); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "days must be an integer", err)
+		return
+	}
+
+	load, err := h.service.GetTrainingLoad(r.Context(), opts)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get training load analytics")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, load); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// GetPlateaus godoc
+// @Summary Get plateau detection
+// @Description Fits a trend to each exercise's session-best estimated 1RM over the window and flags exercises that have stalled or regressed, with suggested interventions (deload, rep-range change, variation swap). Flagged exercises are listed first.
+// @Tags analytics
+// @Produce json
+// @Security StackAuth
+// @Param weeks query int false "Analysis window in weeks" default(6) minimum(3) maximum(26)
+// @Param min_sessions query int false "Sessions an exercise needs within the window to be assessed" default(4) minimum(3)
+// @Success 200 {object} analytics.PlateauResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /analytics/plateaus [get]
+func (h *Handler) GetPlateaus(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var opts PlateauOptions
+
+	var err error
+	if opts.Weeks, err = intQueryParam(query.Get("weeks"), defaultPlateauWeeks); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "weeks must be an integer", err)
+		return
+	}
+	if opts.MinSessions, err = intQueryParam(query.Get("min_sessions"), defaultPlateauMinSessions); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "min_sessions must be an integer", err)
+		return
+	}
+
+	plateaus, err := h.service.GetPlateaus(r.Context(), opts)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get plateau analytics")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, plateaus); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// GetVolume godoc
+// @Summary Get training volume
+// @Description Returns total tonnage, sets and reps per day, week or month across all exercises, with each period also split by set type. Periods without training are included as zeros.
+// @Tags analytics
+// @Produce json
+// @Security StackAuth
+// @Param timezone query string false "IANA timezone used for period boundaries; defaults to the account timezone, then UTC"
+// @Param bucket query string false "Period size; weeks start on Monday" Enums(day, week, month) default(week)
+// @Param from query string false "First local day (YYYY-MM-DD); defaults to 30 days, 26 weeks or 12 months before to"
+// @Param to query string false "Last local day (YYYY-MM-DD); defaults to today"
+// @Param focus query string false "Only count workouts with this focus (case-insensitive)"
+// @Param tag query []string false "Only count workouts carrying every tag (repeat or comma-separate)" collectionFormat(multi)
+// @Param excludeTag query []string false "Skip workouts carrying any of these tags" collectionFormat(multi)
+// @Success 200 {object} analytics.VolumeResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /analytics/volume [get]
+func (h *Handler) GetVolume(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	opts := VolumeOptions{
+		Timezone:    strings.TrimSpace(query.Get("timezone")),
+		Bucket:      strings.TrimSpace(query.Get("bucket")),
+		From:        strings.TrimSpace(query.Get("from")),
+		To:          strings.TrimSpace(query.Get("to")),
+		Focus:       strings.TrimSpace(query.Get("focus")),
+		Tags:        tag.ParseQuery(query["tag"]),
+		ExcludeTags: tag.ParseQuery(query["excludeTag"]),
+	}
+
+	volume, err := h.service.GetVolume(r.Context(), opts)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get volume analytics")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, volume); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
+
+func intQueryParam(raw string, fallback int) (int, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return fallback, nil
+	}
+	return strconv.Atoi(raw)
+}
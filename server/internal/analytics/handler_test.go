@@ -0,0 +1,255 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubAnalyticsService struct {
+	consistency      *ConsistencyResponse
+	consistencyErr   error
+	consistencyOpts  ConsistencyOptions
+	trainingLoad     *TrainingLoadResponse
+	trainingLoadErr  error
+	trainingLoadOpts TrainingLoadOptions
+	plateaus         *PlateauResponse
+	plateausErr      error
+	plateauOpts      PlateauOptions
+	volume           *VolumeResponse
+	volumeErr        error
+	volumeOpts       VolumeOptions
+}
+
+func (s *stubAnalyticsService) GetConsistency(_ context.Context, opts ConsistencyOptions) (*ConsistencyResponse, error) {
+	s.consistencyOpts = opts
+	return s.consistency, s.consistencyErr
+}
+
+func (s *stubAnalyticsService) GetTrainingLoad(_ context.Context, opts TrainingLoadOptions) (*TrainingLoadResponse, error) {
+	s.trainingLoadOpts = opts
+	return s.trainingLoad, s.trainingLoadErr
+}
+
+func (s *stubAnalyticsService) GetPlateaus(_ context.Context, opts PlateauOptions) (*PlateauResponse, error) {
+	s.plateauOpts = opts
+	return s.plateaus, s.plateausErr
+}
+
+func (s *stubAnalyticsService) GetVolume(_ context.Context, opts VolumeOptions) (*VolumeResponse, error) {
+	s.volumeOpts = opts
+	return s.volume, s.volumeErr
+}
+
+func TestHandlerGetConsistency(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("parses query options and writes response", func(t *testing.T) {
+		service := &stubAnalyticsService{
+			consistency: &ConsistencyResponse{Timezone: "Europe/Berlin", WeeklySessions: []WeeklySessions{}},
+		}
+		handler := NewHandler(logger, service)
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/consistency?timezone=Europe/Berlin&target_sessions_per_week=4&allowed_rest_days=2&rolling_weeks=8", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetConsistency(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, ConsistencyOptions{
+			Timezone:              "Europe/Berlin",
+			TargetSessionsPerWeek: 4,
+			AllowedRestDays:       2,
+			RollingWeeks:          8,
+		}, service.consistencyOpts)
+		assert.Contains(t, rr.Body.String(), `"timezone":"Europe/Berlin"`)
+	})
+
+	t.Run("uses defaults when options are omitted", func(t *testing.T) {
+		service := &stubAnalyticsService{consistency: &ConsistencyResponse{}}
+		handler := NewHandler(logger, service)
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/consistency", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetConsistency(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, defaultTargetSessionsPerWeek, service.consistencyOpts.TargetSessionsPerWeek)
+		assert.Equal(t, defaultAllowedRestDays, service.consistencyOpts.AllowedRestDays)
+		assert.Equal(t, defaultRollingWeeks, service.consistencyOpts.RollingWeeks)
+	})
+
+	t.Run("rejects non-integer options", func(t *testing.T) {
+		handler := NewHandler(logger, &stubAnalyticsService{})
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/consistency?allowed_rest_days=some", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetConsistency(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps validation errors to bad request", func(t *testing.T) {
+		handler := NewHandler(logger, &stubAnalyticsService{
+			consistencyErr: &ValidationError{Field: "timezone", Message: "must be a valid IANA timezone"},
+		})
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/consistency?timezone=nowhere", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetConsistency(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "timezone")
+	})
+
+	t.Run("maps unauthorized", func(t *testing.T) {
+		handler := NewHandler(logger, &stubAnalyticsService{
+			consistencyErr: &apperrors.Unauthorized{Resource: "consistency analytics"},
+		})
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/consistency", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetConsistency(rr, req)
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("maps service failures", func(t *testing.T) {
+		handler := NewHandler(logger, &stubAnalyticsService{
+			consistencyErr: errors.New("database unavailable"),
+		})
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/consistency", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetConsistency(rr, req)
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestHandlerGetTrainingLoad(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("parses query options and writes response", func(t *testing.T) {
+		service := &stubAnalyticsService{
+			trainingLoad: &TrainingLoadResponse{Metric: LoadMetricHardSets, Points: []TrainingLoadPoint{}, Warnings: []TrainingLoadWarning{}},
+		}
+		handler := NewHandler(logger, service)
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/training-load?metric=hard_sets&days=56&timezone=Europe/Berlin", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetTrainingLoad(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, TrainingLoadOptions{Timezone: "Europe/Berlin", Metric: LoadMetricHardSets, Days: 56}, service.trainingLoadOpts)
+		assert.Contains(t, rr.Body.String(), `"metric":"hard_sets"`)
+	})
+
+	t.Run("rejects non-integer days", func(t *testing.T) {
+		handler := NewHandler(logger, &stubAnalyticsService{})
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/training-load?days=month", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetTrainingLoad(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps validation errors to bad request", func(t *testing.T) {
+		handler := NewHandler(logger, &stubAnalyticsService{
+			trainingLoadErr: &ValidationError{Field: "metric", Message: "must be tonnage or hard_sets"},
+		})
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/training-load?metric=rpe", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetTrainingLoad(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "metric")
+	})
+}
+
+func TestHandlerGetPlateaus(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("parses query options and writes response", func(t *testing.T) {
+		service := &stubAnalyticsService{
+			plateaus: &PlateauResponse{Weeks: 8, MinSessions: 5, Exercises: []ExercisePlateau{}},
+		}
+		handler := NewHandler(logger, service)
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/plateaus?weeks=8&min_sessions=5", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetPlateaus(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, PlateauOptions{Weeks: 8, MinSessions: 5}, service.plateauOpts)
+		assert.Contains(t, rr.Body.String(), `"weeks":8`)
+	})
+
+	t.Run("uses defaults when options are omitted", func(t *testing.T) {
+		service := &stubAnalyticsService{plateaus: &PlateauResponse{Exercises: []ExercisePlateau{}}}
+		handler := NewHandler(logger, service)
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/plateaus", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetPlateaus(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, PlateauOptions{Weeks: defaultPlateauWeeks, MinSessions: defaultPlateauMinSessions}, service.plateauOpts)
+	})
+
+	t.Run("rejects non-integer weeks", func(t *testing.T) {
+		handler := NewHandler(logger, &stubAnalyticsService{})
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/plateaus?weeks=six", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetPlateaus(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandlerGetVolume(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("parses filters and writes response", func(t *testing.T) {
+		service := &stubAnalyticsService{volume: &VolumeResponse{Bucket: VolumeBucketMonth, Periods: []VolumePeriod{}}}
+		handler := NewHandler(logger, service)
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/volume?bucket=month&from=2024-01-01&to=2026-06-30&focus=Legs&tag=Comp+Prep,travel&excludeTag=deload", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetVolume(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, VolumeOptions{
+			Bucket:      VolumeBucketMonth,
+			From:        "2024-01-01",
+			To:          "2026-06-30",
+			Focus:       "Legs",
+			Tags:        []string{"comp prep", "travel"},
+			ExcludeTags: []string{"deload"},
+		}, service.volumeOpts)
+		assert.Contains(t, rr.Body.String(), `"bucket":"month"`)
+	})
+
+	t.Run("maps validation errors to bad request", func(t *testing.T) {
+		handler := NewHandler(logger, &stubAnalyticsService{
+			volumeErr: &ValidationError{Field: "bucket", Message: "must be day, week or month"},
+		})
+		req := httptest.NewRequest(http.MethodGet, "/api/analytics/volume?bucket=year", nil)
+		rr := httptest.NewRecorder()
+
+		handler.GetVolume(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "bucket")
+	})
+}
@@ -0,0 +1,277 @@
+package analytics
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	defaultTargetSessionsPerWeek = 3
+	maxTargetSessionsPerWeek     = 14
+	defaultAllowedRestDays       = 0
+	maxAllowedRestDays           = 6
+	defaultRollingWeeks          = 4
+	maxRollingWeeks              = 52
+	consistencyHistoryWeeks      = 12
+	defaultTrainingLoadDays      = 28
+	maxTrainingLoadDays          = 365
+	defaultPlateauWeeks          = 6
+	minPlateauWeeks              = 3
+	maxPlateauWeeks              = 26
+	acuteLoadDays                = 7
+	defaultVolumeDays            = 30
+	defaultVolumeWeeks           = 26
+	defaultVolumeMonths          = 12
+	maxVolumeDailyDays           = 366
+	maxVolumeYears               = 10
+	maxVolumeFocusLength         = 256
+	chronicLoadDays              = 28
+	analyticsDateLayout          = "2006-01-02"
+)
+
+// ConsistencyOptions controls how streaks are counted. All calendar math runs
+// in Timezone so late-evening sessions land on the user's local day.
+type ConsistencyOptions struct {
+	Timezone              string
+	TargetSessionsPerWeek int
+	AllowedRestDays       int
+	RollingWeeks          int
+}
+
+type StreakSummary struct {
+	Current      int     `json:"current"`
+	Longest      int     `json:"longest"`
+	CurrentStart *string `json:"current_start"`
+	LongestStart *string `json:"longest_start"`
+	LongestEnd   *string `json:"longest_end"`
+}
+
+type WeeklySessions struct {
+	WeekStart      string  `json:"week_start"`
+	Sessions       int     `json:"sessions"`
+	TargetMet      bool    `json:"target_met"`
+	RollingAverage float64 `json:"rolling_average"`
+}
+
+type ConsistencyResponse struct {
+	Timezone                 string           `json:"timezone"`
+	TargetSessionsPerWeek    int              `json:"target_sessions_per_week"`
+	AllowedRestDays          int              `json:"allowed_rest_days"`
+	RollingWeeks             int              `json:"rolling_weeks"`
+	TotalSessions            int              `json:"total_sessions"`
+	TrainingDays             int              `json:"training_days"`
+	LastWorkoutDate          *string          `json:"last_workout_date"`
+	DailyStreak              StreakSummary    `json:"daily_streak"`
+	WeeklyStreak             StreakSummary    `json:"weekly_streak"`
+	SessionsPerWeekAvg       float64          `json:"sessions_per_week_avg"`
+	WeeklySessions           []WeeklySessions `json:"weekly_sessions"`
+	CurrentWeekSessions      int              `json:"current_week_sessions"`
+	CurrentWeekTargetReached bool             `json:"current_week_target_reached"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+const (
+	LoadMetricTonnage  = "tonnage"
+	LoadMetricHardSets = "hard_sets"
+)
+
+const (
+	WarningSeverityInfo     = "info"
+	WarningSeverityModerate = "moderate"
+	WarningSeverityHigh     = "high"
+)
+
+// TrainingLoadOptions selects the per-session load measure and how many days
+// of daily points to return. Days are bucketed in Timezone.
+type TrainingLoadOptions struct {
+	Timezone string
+	Metric   string
+	Days     int
+}
+
+// SessionLoad is one logged workout's working-set tonnage and hard-set count.
+type SessionLoad struct {
+	Date     time.Time
+	Tonnage  float64
+	HardSets int
+}
+
+// TrainingLoadPoint is one calendar day. AcuteLoad is the trailing 7-day
+// total and ChronicLoad the trailing 28-day total expressed per week, so the
+// two are directly comparable.
+type TrainingLoadPoint struct {
+	Date        string   `json:"date" example:"2026-07-01"`
+	Load        float64  `json:"load"`
+	AcuteLoad   float64  `json:"acute_load"`
+	ChronicLoad float64  `json:"chronic_load"`
+	ACWR        *float64 `json:"acwr"`
+}
+
+// TrainingLoadSummary describes today. Monotony is the 7-day mean daily load
+// divided by its standard deviation; Strain is the 7-day load times monotony.
+type TrainingLoadSummary struct {
+	Date        string   `json:"date" example:"2026-07-01"`
+	AcuteLoad   float64  `json:"acute_load"`
+	ChronicLoad float64  `json:"chronic_load"`
+	ACWR        *float64 `json:"acwr"`
+	Monotony    *float64 `json:"monotony"`
+	Strain      *float64 `json:"strain"`
+	Sessions7d  int      `json:"sessions_7d"`
+	Sessions28d int      `json:"sessions_28d"`
+}
+
+type TrainingLoadWarning struct {
+	Code     string `json:"code" example:"acwr_high"`
+	Severity string `json:"severity" example:"high"`
+	Message  string `json:"message"`
+}
+
+type TrainingLoadResponse struct {
+	Timezone string                `json:"timezone"`
+	Metric   string                `json:"metric"`
+	Days     int                   `json:"days"`
+	Current  TrainingLoadSummary   `json:"current"`
+	Points   []TrainingLoadPoint   `json:"points"`
+	Warnings []TrainingLoadWarning `json:"warnings"`
+}
+
+const (
+	PlateauStatusProgressing = "progressing"
+	PlateauStatusStalled     = "stalled"
+	PlateauStatusRegressing  = "regressing"
+)
+
+const (
+	InterventionDeload         = "deload"
+	InterventionRepRangeChange = "rep_range_change"
+	InterventionVariationSwap  = "variation_swap"
+)
+
+// PlateauOptions sets the analysis window. Exercises need at least
+// MinSessions sessions in the last Weeks weeks to be assessed.
+type PlateauOptions struct {
+	Weeks       int
+	MinSessions int
+}
+
+// ExerciseSession is one workout's session-best e1RM for an exercise, on the
+// user's local calendar day.
+type ExerciseSession struct {
+	ExerciseID   int32
+	ExerciseName string
+	WorkoutID    int32
+	Date         time.Time
+	BestE1RM     float64
+	AvgReps      float64
+}
+
+type PlateauIntervention struct {
+	Type    string `json:"type" example:"deload"`
+	Message string `json:"message"`
+}
+
+// ExercisePlateau is the trend fitted to one exercise's session-best e1RM
+// over the window. SlopePerWeek is in the user's logged units; PercentPerWeek
+// is relative to the window's mean e1RM.
+type ExercisePlateau struct {
+	ExerciseID     int32                 `json:"exercise_id"`
+	ExerciseName   string                `json:"exercise_name"`
+	Status         string                `json:"status" example:"stalled"`
+	Sessions       int                   `json:"sessions"`
+	FirstSession   string                `json:"first_session" example:"2026-06-01"`
+	LastSession    string                `json:"last_session" example:"2026-07-10"`
+	SlopePerWeek   float64               `json:"slope_per_week"`
+	PercentPerWeek float64               `json:"percent_per_week"`
+	WindowBestE1RM float64               `json:"window_best_e1rm"`
+	PriorBestE1RM  *float64              `json:"prior_best_e1rm"`
+	AvgReps        float64               `json:"avg_reps"`
+	Interventions  []PlateauIntervention `json:"interventions"`
+}
+
+// PlateauResponse lists flagged (stalled or regressing) exercises first,
+// then progressing ones.
+type PlateauResponse struct {
+	Weeks       int               `json:"weeks"`
+	MinSessions int               `json:"min_sessions"`
+	Flagged     int               `json:"flagged"`
+	Exercises   []ExercisePlateau `json:"exercises"`
+}
+
+const (
+	VolumeBucketDay   = "day"
+	VolumeBucketWeek  = "week"
+	VolumeBucketMonth = "month"
+)
+
+// VolumeOptions selects the period size and the local-day window; From and
+// To are inclusive YYYY-MM-DD days in Timezone. Focus, Tags and ExcludeTags
+// narrow the workouts counted, matching the workout list filters.
+type VolumeOptions struct {
+	Timezone    string
+	Bucket      string
+	From        string
+	To          string
+	Focus       string
+	Tags        []string
+	ExcludeTags []string
+}
+
+// VolumeQuery is a validated volume request resolved to instants.
+type VolumeQuery struct {
+	Bucket      string
+	Timezone    string
+	Start       time.Time
+	End         time.Time
+	Focus       string
+	Tags        []string
+	ExcludeTags []string
+}
+
+// PeriodSetVolume is the total for one set type within one period.
+type PeriodSetVolume struct {
+	PeriodStart time.Time
+	SetType     string
+	Sets        int
+	Reps        int
+	Tonnage     float64
+}
+
+type VolumeTotals struct {
+	Tonnage float64 `json:"tonnage"`
+	Sets    int     `json:"sets"`
+	Reps    int     `json:"reps"`
+}
+
+// VolumePeriod totals every set in the period; BySetType splits the same
+// totals by set type (working, warmup, ...).
+type VolumePeriod struct {
+	PeriodStart string                  `json:"period_start" example:"2026-06-29"`
+	Tonnage     float64                 `json:"tonnage"`
+	Sets        int                     `json:"sets"`
+	Reps        int                     `json:"reps"`
+	BySetType   map[string]VolumeTotals `json:"by_set_type"`
+}
+
+type VolumeResponse struct {
+	Timezone    string                  `json:"timezone"`
+	Bucket      string                  `json:"bucket" example:"week"`
+	From        string                  `json:"from" example:"2026-01-05"`
+	To          string                  `json:"to" example:"2026-07-01"`
+	Focus       *string                 `json:"focus"`
+	Tags        []string                `json:"tags"`
+	ExcludeTags []string                `json:"exclude_tags"`
+	Totals      VolumeTotals            `json:"totals"`
+	BySetType   map[string]VolumeTotals `json:"by_set_type"`
+	Periods     []VolumePeriod          `json:"periods"`
+}
@@ -0,0 +1,199 @@
+package analytics
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	defaultPlateauMinSessions = 4
+	// plateauActiveDays drops exercises the user has stopped training; a lift
+	// that hasn't been done in three weeks is abandoned, not stalled.
+	plateauActiveDays = 21
+	// Fitted trends within ±0.5% of the mean e1RM per week count as flat.
+	plateauProgressPercentPerWeek = 0.5
+	plateauRegressPercentPerWeek  = -0.5
+)
+
+// PlateauWindowStart returns the first day whose sessions are fetched: the
+// analysis window plus an equally long prior period used for PriorBestE1RM.
+func PlateauWindowStart(opts PlateauOptions, today time.Time) time.Time {
+	return today.AddDate(0, 0, -2*7*opts.Weeks+1)
+}
+
+// BuildPlateaus fits a least-squares trend to each exercise's session-best
+// e1RM over the last opts.Weeks weeks and flags stalled or regressing lifts
+// with suggested interventions. today is the user's local civil date as a UTC
+// midnight; sessions must be sorted by exercise then date.
+func BuildPlateaus(sessions []ExerciseSession, opts PlateauOptions, today time.Time) *PlateauResponse {
+	resp := &PlateauResponse{
+		Weeks:       opts.Weeks,
+		MinSessions: opts.MinSessions,
+		Exercises:   []ExercisePlateau{},
+	}
+
+	windowStart := today.AddDate(0, 0, -7*opts.Weeks+1)
+	activeSince := today.AddDate(0, 0, -plateauActiveDays+1)
+
+	for start := 0; start < len(sessions); {
+		end := start
+		for end < len(sessions) && sessions[end].ExerciseID == sessions[start].ExerciseID {
+			end++
+		}
+		if plateau, ok := assessPlateau(sessions[start:end], opts, windowStart, activeSince); ok {
+			resp.Exercises = append(resp.Exercises, plateau)
+		}
+		start = end
+	}
+
+	sort.SliceStable(resp.Exercises, func(i, j int) bool {
+		left, right := resp.Exercises[i], resp.Exercises[j]
+		if plateauRank(left.Status) != plateauRank(right.Status) {
+			return plateauRank(left.Status) < plateauRank(right.Status)
+		}
+		return left.PercentPerWeek < right.PercentPerWeek
+	})
+	for _, exercise := range resp.Exercises {
+		if exercise.Status != PlateauStatusProgressing {
+			resp.Flagged++
+		}
+	}
+	return resp
+}
+
+func assessPlateau(sessions []ExerciseSession, opts PlateauOptions, windowStart time.Time, activeSince time.Time) (ExercisePlateau, bool) {
+	var window []ExerciseSession
+	var priorBest *float64
+	for _, session := range sessions {
+		if session.Date.Before(windowStart) {
+			if priorBest == nil || session.BestE1RM > *priorBest {
+				best := session.BestE1RM
+				priorBest = &best
+			}
+			continue
+		}
+		if session.BestE1RM > 0 {
+			window = append(window, session)
+		}
+	}
+	if len(window) < opts.MinSessions || window[len(window)-1].Date.Before(activeSince) {
+		return ExercisePlateau{}, false
+	}
+
+	slopePerDay, mean := fitTrend(window, windowStart)
+	slopePerWeek := slopePerDay * 7
+	percentPerWeek := 0.0
+	if mean > 0 {
+		percentPerWeek = slopePerWeek / mean * 100
+	}
+
+	windowBest, repsTotal := 0.0, 0.0
+	for _, session := range window {
+		windowBest = max(windowBest, session.BestE1RM)
+		repsTotal += session.AvgReps
+	}
+	if priorBest != nil {
+		rounded := roundTo(*priorBest, 1)
+		priorBest = &rounded
+	}
+
+	plateau := ExercisePlateau{
+		ExerciseID:     window[0].ExerciseID,
+		ExerciseName:   window[0].ExerciseName,
+		Status:         plateauStatus(percentPerWeek),
+		Sessions:       len(window),
+		FirstSession:   formatDay(window[0].Date),
+		LastSession:    formatDay(window[len(window)-1].Date),
+		SlopePerWeek:   roundTo(slopePerWeek, 2),
+		PercentPerWeek: roundTo(percentPerWeek, 2),
+		WindowBestE1RM: roundTo(windowBest, 1),
+		PriorBestE1RM:  priorBest,
+		AvgReps:        roundTo(repsTotal/float64(len(window)), 1),
+	}
+	sessionsPerWeek := float64(len(window)) / float64(opts.Weeks)
+	plateau.Interventions = plateauInterventions(plateau, sessionsPerWeek)
+	return plateau, true
+}
+
+// fitTrend returns the ordinary least-squares slope of e1RM against days
+// since origin, and the mean e1RM.
+func fitTrend(sessions []ExerciseSession, origin time.Time) (float64, float64) {
+	n := float64(len(sessions))
+	var sumX, sumY float64
+	for _, session := range sessions {
+		sumX += float64(daysBetween(origin, session.Date))
+		sumY += session.BestE1RM
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var covariance, variance float64
+	for _, session := range sessions {
+		dx := float64(daysBetween(origin, session.Date)) - meanX
+		covariance += dx * (session.BestE1RM - meanY)
+		variance += dx * dx
+	}
+	if variance == 0 {
+		return 0, meanY
+	}
+	return covariance / variance, meanY
+}
+
+func plateauStatus(percentPerWeek float64) string {
+	switch {
+	case percentPerWeek <= plateauRegressPercentPerWeek:
+		return PlateauStatusRegressing
+	case percentPerWeek < plateauProgressPercentPerWeek:
+		return PlateauStatusStalled
+	default:
+		return PlateauStatusProgressing
+	}
+}
+
+func plateauRank(status string) int {
+	switch status {
+	case PlateauStatusRegressing:
+		return 0
+	case PlateauStatusStalled:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func plateauInterventions(plateau ExercisePlateau, sessionsPerWeek float64) []PlateauIntervention {
+	deload := PlateauIntervention{
+		Type:    InterventionDeload,
+		Message: "Take a deload week: drop the load about 10% and cut a set, then build back up.",
+	}
+	if sessionsPerWeek >= 2 {
+		deload.Message = fmt.Sprintf("Take a deload week: drop the load about 10%% and cut a set. At %.1f sessions a week, fatigue may be outpacing recovery.", sessionsPerWeek)
+	}
+
+	repRange := PlateauIntervention{Type: InterventionRepRangeChange}
+	switch {
+	case plateau.AvgReps <= 5:
+		repRange.Message = "You've mostly trained this in low reps. Run a 3-4 week block of 6-8 reps to build volume, then return to heavier sets."
+	case plateau.AvgReps >= 10:
+		repRange.Message = "You've mostly trained this in high reps. Run a 3-4 week block of heavier 3-6 rep sets to push strength."
+	default:
+		repRange.Message = "Change the rep range for a 3-4 week block, either heavier 3-5 rep sets or 8-12 reps for volume, then retest."
+	}
+
+	swap := PlateauIntervention{
+		Type:    InterventionVariationSwap,
+		Message: fmt.Sprintf("Swap %s for a close variation (paused, tempo, or a different grip or stance) for 3-4 weeks, then retest.", plateau.ExerciseName),
+	}
+
+	switch plateau.Status {
+	case PlateauStatusRegressing:
+		return []PlateauIntervention{deload, repRange, swap}
+	case PlateauStatusStalled:
+		if sessionsPerWeek >= 2 {
+			return []PlateauIntervention{repRange, swap, deload}
+		}
+		return []PlateauIntervention{repRange, swap}
+	default:
+		return []PlateauIntervention{}
+	}
+}
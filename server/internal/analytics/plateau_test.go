@@ -0,0 +1,125 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func plateauSession(t *testing.T, exerciseID int32, name string, day string, e1rm float64, avgReps float64) ExerciseSession {
+	t.Helper()
+	return ExerciseSession{
+		ExerciseID:   exerciseID,
+		ExerciseName: name,
+		Date:         consistencyTestTime(t, day+"T00:00:00Z"),
+		BestE1RM:     e1rm,
+		AvgReps:      avgReps,
+	}
+}
+
+func TestBuildPlateausClassifiesTrends(t *testing.T) {
+	today := consistencyTestTime(t, "2026-06-30T00:00:00Z")
+	sessions := []ExerciseSession{
+		// Bench: prior best 250, flat at ~240 in the window, low reps, twice a week.
+		plateauSession(t, 1, "Bench Press", "2026-05-10", 250, 3),
+		plateauSession(t, 1, "Bench Press", "2026-05-20", 241, 3),
+		plateauSession(t, 1, "Bench Press", "2026-05-23", 240, 3),
+		plateauSession(t, 1, "Bench Press", "2026-05-27", 240, 3),
+		plateauSession(t, 1, "Bench Press", "2026-05-30", 241, 3),
+		plateauSession(t, 1, "Bench Press", "2026-06-03", 240, 3),
+		plateauSession(t, 1, "Bench Press", "2026-06-06", 240, 3),
+		plateauSession(t, 1, "Bench Press", "2026-06-10", 241, 3),
+		plateauSession(t, 1, "Bench Press", "2026-06-13", 240, 3),
+		plateauSession(t, 1, "Bench Press", "2026-06-17", 240, 3),
+		plateauSession(t, 1, "Bench Press", "2026-06-20", 241, 3),
+		plateauSession(t, 1, "Bench Press", "2026-06-24", 240, 3),
+		plateauSession(t, 1, "Bench Press", "2026-06-27", 240, 3),
+		// Squat: climbing steadily.
+		plateauSession(t, 2, "Squat", "2026-05-25", 300, 5),
+		plateauSession(t, 2, "Squat", "2026-06-04", 310, 5),
+		plateauSession(t, 2, "Squat", "2026-06-14", 320, 5),
+		plateauSession(t, 2, "Squat", "2026-06-24", 330, 5),
+		// Row: dropping.
+		plateauSession(t, 3, "Barbell Row", "2026-05-25", 220, 10),
+		plateauSession(t, 3, "Barbell Row", "2026-06-04", 212, 10),
+		plateauSession(t, 3, "Barbell Row", "2026-06-14", 205, 10),
+		plateauSession(t, 3, "Barbell Row", "2026-06-24", 198, 10),
+		// Curl: too few sessions to assess.
+		plateauSession(t, 4, "Curl", "2026-06-20", 80, 12),
+		plateauSession(t, 4, "Curl", "2026-06-27", 80, 12),
+	}
+
+	resp := BuildPlateaus(sessions, PlateauOptions{Weeks: 6, MinSessions: 4}, today)
+
+	require.Len(t, resp.Exercises, 3)
+	assert.Equal(t, 2, resp.Flagged)
+
+	row := resp.Exercises[0]
+	assert.Equal(t, "Barbell Row", row.ExerciseName)
+	assert.Equal(t, PlateauStatusRegressing, row.Status)
+	assert.Less(t, row.PercentPerWeek, -0.5)
+	require.NotEmpty(t, row.Interventions)
+	assert.Equal(t, InterventionDeload, row.Interventions[0].Type)
+
+	bench := resp.Exercises[1]
+	assert.Equal(t, "Bench Press", bench.ExerciseName)
+	assert.Equal(t, PlateauStatusStalled, bench.Status)
+	assert.Equal(t, 12, bench.Sessions)
+	assert.Equal(t, "2026-05-20", bench.FirstSession)
+	assert.Equal(t, "2026-06-27", bench.LastSession)
+	assert.Equal(t, 241.0, bench.WindowBestE1RM)
+	require.NotNil(t, bench.PriorBestE1RM)
+	assert.Equal(t, 250.0, *bench.PriorBestE1RM)
+	var types []string
+	for _, intervention := range bench.Interventions {
+		types = append(types, intervention.Type)
+	}
+	assert.Equal(t, []string{InterventionRepRangeChange, InterventionVariationSwap, InterventionDeload}, types)
+	assert.Contains(t, bench.Interventions[0].Message, "6-8 reps")
+
+	squat := resp.Exercises[2]
+	assert.Equal(t, PlateauStatusProgressing, squat.Status)
+	assert.Nil(t, squat.PriorBestE1RM)
+	assert.NotNil(t, squat.Interventions)
+	assert.Empty(t, squat.Interventions)
+}
+
+func TestBuildPlateausSkipsAbandonedExercises(t *testing.T) {
+	today := consistencyTestTime(t, "2026-06-30T00:00:00Z")
+	sessions := []ExerciseSession{
+		plateauSession(t, 1, "Bench Press", "2026-05-20", 240, 5),
+		plateauSession(t, 1, "Bench Press", "2026-05-25", 240, 5),
+		plateauSession(t, 1, "Bench Press", "2026-05-30", 240, 5),
+		plateauSession(t, 1, "Bench Press", "2026-06-04", 240, 5),
+	}
+
+	resp := BuildPlateaus(sessions, PlateauOptions{Weeks: 6, MinSessions: 4}, today)
+
+	assert.NotNil(t, resp.Exercises)
+	assert.Empty(t, resp.Exercises)
+	assert.Zero(t, resp.Flagged)
+}
+
+func TestPlateauWindowStartIncludesPriorPeriod(t *testing.T) {
+	today := consistencyTestTime(t, "2026-06-30T00:00:00Z")
+
+	start := PlateauWindowStart(PlateauOptions{Weeks: 6}, today)
+
+	assert.Equal(t, consistencyTestTime(t, "2026-04-08T00:00:00Z"), start)
+}
+
+func TestValidatePlateauOptions(t *testing.T) {
+	normalized, err := validatePlateauOptions(PlateauOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, PlateauOptions{Weeks: defaultPlateauWeeks, MinSessions: defaultPlateauMinSessions}, normalized)
+
+	var validationErr *ValidationError
+	_, err = validatePlateauOptions(PlateauOptions{Weeks: 2})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "weeks", validationErr.Field)
+
+	_, err = validatePlateauOptions(PlateauOptions{Weeks: 4, MinSessions: 2})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "min_sessions", validationErr.Field)
+}
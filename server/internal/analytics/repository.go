@@ -0,0 +1,171 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	ListWorkoutDates(ctx context.Context, userID string) ([]time.Time, error)
+	ListSessionLoads(ctx context.Context, userID string, since time.Time) ([]SessionLoad, error)
+	ListExerciseSessions(ctx context.Context, userID string, since time.Time) ([]ExerciseSession, error)
+	ListPeriodVolumes(ctx context.Context, userID string, query VolumeQuery) ([]PeriodSetVolume, error)
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	conn    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		conn:    conn,
+	}
+}
+
+func (r *repository) ListWorkoutDates(ctx context.Context, userID string) ([]time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListWorkoutDatesForConsistency(ctx, userID)
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list workout dates failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list workout dates for consistency: %w", err)
+	}
+
+	dates := make([]time.Time, 0, len(rows))
+	for _, row := range rows {
+		if row.Valid {
+			dates = append(dates, row.Time)
+		}
+	}
+	return dates, nil
+}
+
+func (r *repository) ListSessionLoads(ctx context.Context, userID string, since time.Time) ([]SessionLoad, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListWorkoutLoadsSince(ctx, db.ListWorkoutLoadsSinceParams{
+		UserID: userID,
+		Date:   pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list workout loads failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list workout loads since %s: %w", since.Format(time.RFC3339), err)
+	}
+
+	return SessionLoadsFromRows(rows), nil
+}
+
+// SessionLoadsFromRows maps ListWorkoutLoadsSince rows to session loads.
+func SessionLoadsFromRows(rows []db.ListWorkoutLoadsSinceRow) []SessionLoad {
+	sessions := make([]SessionLoad, 0, len(rows))
+	for _, row := range rows {
+		if !row.Date.Valid {
+			continue
+		}
+		sessions = append(sessions, SessionLoad{
+			Date:     row.Date.Time,
+			Tonnage:  row.Tonnage,
+			HardSets: int(row.WorkingSets),
+		})
+	}
+	return sessions
+}
+
+func (r *repository) ListExerciseSessions(ctx context.Context, userID string, since time.Time) ([]ExerciseSession, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListExerciseSessionBestsSince(ctx, db.ListExerciseSessionBestsSinceParams{
+		UserID: userID,
+		Date:   pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list exercise session bests failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list exercise session bests since %s: %w", since.Format(time.RFC3339), err)
+	}
+
+	sessions := make([]ExerciseSession, 0, len(rows))
+	for _, row := range rows {
+		if !row.WorkoutDay.Valid {
+			continue
+		}
+		sessions = append(sessions, ExerciseSession{
+			ExerciseID:   row.ExerciseID,
+			ExerciseName: row.ExerciseName,
+			WorkoutID:    row.WorkoutID,
+			Date:         row.WorkoutDay.Time,
+			BestE1RM:     row.SessionBestE1rm,
+			AvgReps:      row.AvgReps,
+		})
+	}
+	return sessions, nil
+}
+
+func (r *repository) ListPeriodVolumes(ctx context.Context, userID string, query VolumeQuery) ([]PeriodSetVolume, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListVolumeByPeriod(ctx, db.ListVolumeByPeriodParams{
+		Period:      query.Bucket,
+		Timezone:    query.Timezone,
+		UserID:      userID,
+		StartAt:     pgtype.Timestamptz{Time: query.Start, Valid: true},
+		EndAt:       pgtype.Timestamptz{Time: query.End, Valid: true},
+		Focus:       pgtype.Text{String: query.Focus, Valid: query.Focus != ""},
+		Tags:        query.Tags,
+		ExcludeTags: query.ExcludeTags,
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list volume by period failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list volume by %s: %w", query.Bucket, err)
+	}
+
+	volumes := make([]PeriodSetVolume, 0, len(rows))
+	for _, row := range rows {
+		if !row.PeriodStart.Valid {
+			continue
+		}
+		volumes = append(volumes, PeriodSetVolume{
+			PeriodStart: row.PeriodStart.Time,
+			SetType:     row.SetType,
+			Sets:        int(row.Sets),
+			Reps:        int(row.Reps),
+			Tonnage:     row.Tonnage,
+		})
+	}
+	return volumes, nil
+}
+
+var _ Repository = (*repository)(nil)
@@ -0,0 +1,207 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger *slog.Logger
+	repo   Repository
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+func (s *Service) GetConsistency(ctx context.Context, opts ConsistencyOptions) (*ConsistencyResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: "consistency analytics", UserID: ""}
+	}
+
+	if strings.TrimSpace(opts.Timezone) == "" {
+		if timezone, ok := user.Timezone(ctx); ok {
+			opts.Timezone = timezone
+		}
+	}
+	normalized, loc, err := validateConsistencyOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := s.repo.ListWorkoutDates(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consistency analytics: %w", err)
+	}
+	return buildConsistency(dates, normalized, loc, time.Now()), nil
+}
+
+// GetTrainingLoad reports acute and chronic training load, the acute:chronic
+// workload ratio and monotony/strain ending today in the user's timezone.
+func (s *Service) GetTrainingLoad(ctx context.Context, opts TrainingLoadOptions) (*TrainingLoadResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: "training load analytics", UserID: ""}
+	}
+
+	if strings.TrimSpace(opts.Timezone) == "" {
+		if timezone, ok := user.Timezone(ctx); ok {
+			opts.Timezone = timezone
+		}
+	}
+	normalized, loc, err := ValidateTrainingLoadOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	sessions, err := s.repo.ListSessionLoads(ctx, userID, TrainingLoadWindowStart(normalized, loc, now))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training load analytics: %w", err)
+	}
+	return BuildTrainingLoad(sessions, normalized, loc, now), nil
+}
+
+// GetPlateaus fits a trend to each exercise's session-best e1RM over the
+// requested window and flags lifts that have stalled or regressed.
+func (s *Service) GetPlateaus(ctx context.Context, opts PlateauOptions) (*PlateauResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: "plateau analytics", UserID: ""}
+	}
+
+	normalized, err := validatePlateauOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	today := user.CivilDay(time.Now(), user.Location(ctx))
+	sessions, err := s.repo.ListExerciseSessions(ctx, userID, PlateauWindowStart(normalized, today))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plateau analytics: %w", err)
+	}
+	return BuildPlateaus(sessions, normalized, today), nil
+}
+
+// GetVolume totals tonnage, sets and reps per day, week or month across all
+// exercises, split by set type.
+func (s *Service) GetVolume(ctx context.Context, opts VolumeOptions) (*VolumeResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: "volume analytics", UserID: ""}
+	}
+
+	if strings.TrimSpace(opts.Timezone) == "" {
+		if timezone, ok := user.Timezone(ctx); ok {
+			opts.Timezone = timezone
+		}
+	}
+	normalized, query, err := ValidateVolumeOptions(opts, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.ListPeriodVolumes(ctx, userID, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volume analytics: %w", err)
+	}
+	return BuildVolume(rows, normalized), nil
+}
+
+// FlaggedPlateaus returns only the stalled or regressing exercises using the
+// default window, for surfacing alongside new workout suggestions.
+func (s *Service) FlaggedPlateaus(ctx context.Context) ([]ExercisePlateau, error) {
+	plateaus, err := s.GetPlateaus(ctx, PlateauOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return plateaus.Exercises[:plateaus.Flagged], nil
+}
+
+func validateConsistencyOptions(opts ConsistencyOptions) (ConsistencyOptions, *time.Location, error) {
+	normalized := opts
+	normalized.Timezone = strings.TrimSpace(opts.Timezone)
+	if normalized.Timezone == "" {
+		normalized.Timezone = user.DefaultTimezone
+	}
+	if normalized.TargetSessionsPerWeek == 0 {
+		normalized.TargetSessionsPerWeek = defaultTargetSessionsPerWeek
+	}
+	if normalized.RollingWeeks == 0 {
+		normalized.RollingWeeks = defaultRollingWeeks
+	}
+
+	if normalized.TargetSessionsPerWeek < 1 || normalized.TargetSessionsPerWeek > maxTargetSessionsPerWeek {
+		return ConsistencyOptions{}, nil, &ValidationError{Field: "target_sessions_per_week", Message: fmt.Sprintf("must be between 1 and %d", maxTargetSessionsPerWeek)}
+	}
+	if normalized.AllowedRestDays < 0 || normalized.AllowedRestDays > maxAllowedRestDays {
+		return ConsistencyOptions{}, nil, &ValidationError{Field: "allowed_rest_days", Message: fmt.Sprintf("must be between 0 and %d", maxAllowedRestDays)}
+	}
+	if normalized.RollingWeeks < 1 || normalized.RollingWeeks > maxRollingWeeks {
+		return ConsistencyOptions{}, nil, &ValidationError{Field: "rolling_weeks", Message: fmt.Sprintf("must be between 1 and %d", maxRollingWeeks)}
+	}
+
+	loc, err := time.LoadLocation(normalized.Timezone)
+	if err != nil {
+		return ConsistencyOptions{}, nil, &ValidationError{Field: "timezone", Message: "must be a valid IANA timezone"}
+	}
+	return normalized, loc, nil
+}
+
+// ValidateTrainingLoadOptions applies defaults and resolves the timezone.
+func ValidateTrainingLoadOptions(opts TrainingLoadOptions) (TrainingLoadOptions, *time.Location, error) {
+	normalized := opts
+	normalized.Timezone = strings.TrimSpace(opts.Timezone)
+	if normalized.Timezone == "" {
+		normalized.Timezone = user.DefaultTimezone
+	}
+	normalized.Metric = strings.ToLower(strings.TrimSpace(opts.Metric))
+	if normalized.Metric == "" {
+		normalized.Metric = LoadMetricTonnage
+	}
+	if normalized.Days == 0 {
+		normalized.Days = defaultTrainingLoadDays
+	}
+
+	if normalized.Metric != LoadMetricTonnage && normalized.Metric != LoadMetricHardSets {
+		return TrainingLoadOptions{}, nil, &ValidationError{Field: "metric", Message: fmt.Sprintf("must be %s or %s", LoadMetricTonnage, LoadMetricHardSets)}
+	}
+	if normalized.Days < 1 || normalized.Days > maxTrainingLoadDays {
+		return TrainingLoadOptions{}, nil, &ValidationError{Field: "days", Message: fmt.Sprintf("must be between 1 and %d", maxTrainingLoadDays)}
+	}
+
+	loc, err := time.LoadLocation(normalized.Timezone)
+	if err != nil {
+		return TrainingLoadOptions{}, nil, &ValidationError{Field: "timezone", Message: "must be a valid IANA timezone"}
+	}
+	return normalized, loc, nil
+}
+
+func validatePlateauOptions(opts PlateauOptions) (PlateauOptions, error) {
+	normalized := opts
+	if normalized.Weeks == 0 {
+		normalized.Weeks = defaultPlateauWeeks
+	}
+	if normalized.MinSessions == 0 {
+		normalized.MinSessions = defaultPlateauMinSessions
+	}
+
+	if normalized.Weeks < minPlateauWeeks || normalized.Weeks > maxPlateauWeeks {
+		return PlateauOptions{}, &ValidationError{Field: "weeks", Message: fmt.Sprintf("must be between %d and %d", minPlateauWeeks, maxPlateauWeeks)}
+	}
+	// A trend needs at least three points to distinguish a stall from noise.
+	if normalized.MinSessions < 3 || normalized.MinSessions > 7*normalized.Weeks {
+		return PlateauOptions{}, &ValidationError{Field: "min_sessions", Message: fmt.Sprintf("must be between 3 and %d", 7*normalized.Weeks)}
+	}
+	return normalized, nil
+}
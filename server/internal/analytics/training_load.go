@@ -0,0 +1,179 @@
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+// Thresholds follow the commonly cited ACWR "sweet spot" of 0.8-1.3, with
+// spikes above 1.5 associated with elevated injury risk, and Foster's
+// monotony cut-off of 2.0.
+const (
+	acwrLowThreshold      = 0.8
+	acwrElevatedThreshold = 1.3
+	acwrHighThreshold     = 1.5
+	monotonyHighThreshold = 2.0
+)
+
+// TrainingLoadWindowStart returns the first instant whose sessions affect the
+// response: the chronic window preceding the first returned day, in loc.
+func TrainingLoadWindowStart(opts TrainingLoadOptions, loc *time.Location, now time.Time) time.Time {
+	first := user.CivilDay(now, loc).AddDate(0, 0, -(opts.Days-1)-(chronicLoadDays-1))
+	return time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc)
+}
+
+// BuildTrainingLoad derives daily load, acute and chronic load, the
+// acute:chronic workload ratio and monotony/strain from session loads. opts
+// must already be normalized.
+func BuildTrainingLoad(sessions []SessionLoad, opts TrainingLoadOptions, loc *time.Location, now time.Time) *TrainingLoadResponse {
+	resp := &TrainingLoadResponse{
+		Timezone: opts.Timezone,
+		Metric:   opts.Metric,
+		Days:     opts.Days,
+		Points:   make([]TrainingLoadPoint, 0, opts.Days),
+		Warnings: []TrainingLoadWarning{},
+	}
+
+	today := user.CivilDay(now, loc)
+	firstDay := today.AddDate(0, 0, -(opts.Days - 1))
+	windowStart := firstDay.AddDate(0, 0, -(chronicLoadDays - 1))
+
+	totalDays := daysBetween(windowStart, today) + 1
+	daily := make([]float64, totalDays)
+	sessionCounts := make([]int, totalDays)
+	for _, session := range sessions {
+		idx := daysBetween(windowStart, user.CivilDay(session.Date, loc))
+		if idx < 0 || idx >= totalDays {
+			continue
+		}
+		daily[idx] += sessionLoadValue(session, opts.Metric)
+		sessionCounts[idx]++
+	}
+
+	for idx := chronicLoadDays - 1; idx < totalDays; idx++ {
+		acute := windowSum(daily, idx, acuteLoadDays)
+		chronic := windowSum(daily, idx, chronicLoadDays) * acuteLoadDays / chronicLoadDays
+		resp.Points = append(resp.Points, TrainingLoadPoint{
+			Date:        formatDay(windowStart.AddDate(0, 0, idx)),
+			Load:        roundTo(daily[idx], 1),
+			AcuteLoad:   roundTo(acute, 1),
+			ChronicLoad: roundTo(chronic, 1),
+			ACWR:        workloadRatio(acute, chronic),
+		})
+	}
+
+	last := totalDays - 1
+	current := resp.Points[len(resp.Points)-1]
+	resp.Current = TrainingLoadSummary{
+		Date:        current.Date,
+		AcuteLoad:   current.AcuteLoad,
+		ChronicLoad: current.ChronicLoad,
+		ACWR:        current.ACWR,
+		Sessions7d:  windowCount(sessionCounts, last, acuteLoadDays),
+		Sessions28d: windowCount(sessionCounts, last, chronicLoadDays),
+	}
+	resp.Current.Monotony, resp.Current.Strain = monotonyAndStrain(daily[last-acuteLoadDays+1 : last+1])
+	resp.Warnings = trainingLoadWarnings(resp.Current)
+
+	return resp
+}
+
+func sessionLoadValue(session SessionLoad, metric string) float64 {
+	if metric == LoadMetricHardSets {
+		return float64(session.HardSets)
+	}
+	return session.Tonnage
+}
+
+// windowSum totals the window days ending at end (inclusive).
+func windowSum(values []float64, end int, window int) float64 {
+	total := 0.0
+	for i := max(0, end-window+1); i <= end; i++ {
+		total += values[i]
+	}
+	return total
+}
+
+func windowCount(values []int, end int, window int) int {
+	total := 0
+	for i := max(0, end-window+1); i <= end; i++ {
+		total += values[i]
+	}
+	return total
+}
+
+func workloadRatio(acute float64, chronic float64) *float64 {
+	if chronic <= 0 {
+		return nil
+	}
+	ratio := roundTo(acute/chronic, 2)
+	return &ratio
+}
+
+// monotonyAndStrain uses Foster's definitions over the given daily loads.
+// Both are undefined when the loads do not vary (including a week off).
+func monotonyAndStrain(week []float64) (*float64, *float64) {
+	total := 0.0
+	for _, load := range week {
+		total += load
+	}
+	mean := total / float64(len(week))
+
+	variance := 0.0
+	for _, load := range week {
+		variance += (load - mean) * (load - mean)
+	}
+	stddev := math.Sqrt(variance / float64(len(week)))
+	if total <= 0 || stddev == 0 {
+		return nil, nil
+	}
+
+	monotony := roundTo(mean/stddev, 2)
+	strain := roundTo(total*(mean/stddev), 1)
+	return &monotony, &strain
+}
+
+func trainingLoadWarnings(current TrainingLoadSummary) []TrainingLoadWarning {
+	warnings := []TrainingLoadWarning{}
+
+	switch {
+	case current.ACWR == nil:
+		if current.Sessions28d > 0 {
+			warnings = append(warnings, TrainingLoadWarning{
+				Code:     "insufficient_history",
+				Severity: WarningSeverityInfo,
+				Message:  "Not enough logged load in the last 28 days to compute an acute:chronic ratio.",
+			})
+		}
+	case *current.ACWR > acwrHighThreshold:
+		warnings = append(warnings, TrainingLoadWarning{
+			Code:     "acwr_high",
+			Severity: WarningSeverityHigh,
+			Message:  fmt.Sprintf("This week's load is %.2fx the 4-week average. Ramping this fast is linked to higher injury risk; consider holding or reducing load.", *current.ACWR),
+		})
+	case *current.ACWR > acwrElevatedThreshold:
+		warnings = append(warnings, TrainingLoadWarning{
+			Code:     "acwr_elevated",
+			Severity: WarningSeverityModerate,
+			Message:  fmt.Sprintf("This week's load is %.2fx the 4-week average, above the 0.8-1.3 range. Avoid increasing further this week.", *current.ACWR),
+		})
+	case *current.ACWR < acwrLowThreshold:
+		warnings = append(warnings, TrainingLoadWarning{
+			Code:     "acwr_low",
+			Severity: WarningSeverityInfo,
+			Message:  fmt.Sprintf("This week's load is %.2fx the 4-week average. That is fine for a deload, but a sustained drop loses conditioning.", *current.ACWR),
+		})
+	}
+
+	if current.Monotony != nil && *current.Monotony > monotonyHighThreshold {
+		warnings = append(warnings, TrainingLoadWarning{
+			Code:     "monotony_high",
+			Severity: WarningSeverityModerate,
+			Message:  fmt.Sprintf("Training monotony is %.2f. Daily loads are very similar; vary hard and easy days.", *current.Monotony),
+		})
+	}
+	return warnings
+}
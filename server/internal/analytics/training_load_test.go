@@ -0,0 +1,97 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func trainingLoadSession(t *testing.T, value string, tonnage float64, hardSets int) SessionLoad {
+	t.Helper()
+	return SessionLoad{Date: consistencyTestTime(t, value), Tonnage: tonnage, HardSets: hardSets}
+}
+
+func TestBuildTrainingLoadFlagsRampingVolume(t *testing.T) {
+	now := consistencyTestTime(t, "2026-03-28T12:00:00Z")
+	var sessions []SessionLoad
+	for _, day := range []string{"02", "04", "06", "09", "11", "13", "16", "18", "20"} {
+		sessions = append(sessions, trainingLoadSession(t, "2026-03-"+day+"T10:00:00Z", 1000, 10))
+	}
+	for _, day := range []string{"23", "25", "27"} {
+		sessions = append(sessions, trainingLoadSession(t, "2026-03-"+day+"T10:00:00Z", 2000, 15))
+	}
+	opts := TrainingLoadOptions{Timezone: "UTC", Metric: LoadMetricTonnage, Days: 1}
+
+	resp := BuildTrainingLoad(sessions, opts, time.UTC, now)
+
+	require.Len(t, resp.Points, 1)
+	assert.Equal(t, "2026-03-28", resp.Current.Date)
+	assert.Equal(t, 6000.0, resp.Current.AcuteLoad)
+	assert.Equal(t, 3750.0, resp.Current.ChronicLoad)
+	require.NotNil(t, resp.Current.ACWR)
+	assert.Equal(t, 1.6, *resp.Current.ACWR)
+	require.NotNil(t, resp.Current.Monotony)
+	assert.InDelta(t, 0.87, *resp.Current.Monotony, 0.001)
+	require.NotNil(t, resp.Current.Strain)
+	assert.InDelta(t, 5196.2, *resp.Current.Strain, 0.1)
+	assert.Equal(t, 3, resp.Current.Sessions7d)
+	assert.Equal(t, 12, resp.Current.Sessions28d)
+	require.Len(t, resp.Warnings, 1)
+	assert.Equal(t, "acwr_high", resp.Warnings[0].Code)
+	assert.Equal(t, WarningSeverityHigh, resp.Warnings[0].Severity)
+}
+
+func TestBuildTrainingLoadUsesTimezoneAndHardSets(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	now := consistencyTestTime(t, "2026-03-28T12:00:00Z")
+	sessions := []SessionLoad{trainingLoadSession(t, "2026-03-28T02:00:00Z", 5000, 5)}
+	opts := TrainingLoadOptions{Timezone: "America/New_York", Metric: LoadMetricHardSets, Days: 2}
+
+	resp := BuildTrainingLoad(sessions, opts, loc, now)
+
+	require.Len(t, resp.Points, 2)
+	assert.Equal(t, TrainingLoadPoint{Date: "2026-03-27", Load: 5, AcuteLoad: 5, ChronicLoad: 1.3, ACWR: resp.Points[0].ACWR}, resp.Points[0])
+	require.NotNil(t, resp.Points[0].ACWR)
+	assert.Equal(t, 4.0, *resp.Points[0].ACWR)
+	assert.Equal(t, "2026-03-28", resp.Points[1].Date)
+	assert.Equal(t, 0.0, resp.Points[1].Load)
+}
+
+func TestBuildTrainingLoadWithoutSessions(t *testing.T) {
+	now := consistencyTestTime(t, "2026-03-28T12:00:00Z")
+
+	resp := BuildTrainingLoad(nil, TrainingLoadOptions{Timezone: "UTC", Metric: LoadMetricTonnage, Days: 7}, time.UTC, now)
+
+	assert.Len(t, resp.Points, 7)
+	assert.Nil(t, resp.Current.ACWR)
+	assert.Nil(t, resp.Current.Monotony)
+	assert.Empty(t, resp.Warnings)
+	assert.NotNil(t, resp.Warnings)
+}
+
+func TestTrainingLoadWindowStartCoversChronicWindow(t *testing.T) {
+	now := consistencyTestTime(t, "2026-03-28T12:00:00Z")
+
+	start := TrainingLoadWindowStart(TrainingLoadOptions{Days: 1}, time.UTC, now)
+
+	assert.Equal(t, consistencyTestTime(t, "2026-03-01T00:00:00Z"), start)
+}
+
+func TestValidateTrainingLoadOptions(t *testing.T) {
+	normalized, loc, err := ValidateTrainingLoadOptions(TrainingLoadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, TrainingLoadOptions{Timezone: "UTC", Metric: LoadMetricTonnage, Days: defaultTrainingLoadDays}, normalized)
+	assert.Equal(t, time.UTC, loc)
+
+	_, _, err = ValidateTrainingLoadOptions(TrainingLoadOptions{Metric: "rpe"})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "metric", validationErr.Field)
+
+	_, _, err = ValidateTrainingLoadOptions(TrainingLoadOptions{Days: maxTrainingLoadDays + 1})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "days", validationErr.Field)
+}
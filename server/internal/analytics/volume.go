@@ -0,0 +1,189 @@
+package analytics
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+// ValidateVolumeOptions applies defaults, resolves the timezone and turns the
+// local-day window into the instants to query. Without From the window covers
+// the last 30 days, 26 weeks or 12 months ending at To (default today).
+func ValidateVolumeOptions(opts VolumeOptions, now time.Time) (VolumeOptions, VolumeQuery, error) {
+	normalized := opts
+	normalized.Timezone = strings.TrimSpace(opts.Timezone)
+	if normalized.Timezone == "" {
+		normalized.Timezone = user.DefaultTimezone
+	}
+	normalized.Bucket = strings.ToLower(strings.TrimSpace(opts.Bucket))
+	if normalized.Bucket == "" {
+		normalized.Bucket = VolumeBucketWeek
+	}
+	normalized.Focus = strings.TrimSpace(opts.Focus)
+
+	switch normalized.Bucket {
+	case VolumeBucketDay, VolumeBucketWeek, VolumeBucketMonth:
+	default:
+		return VolumeOptions{}, VolumeQuery{}, &ValidationError{Field: "bucket", Message: fmt.Sprintf("must be %s, %s or %s", VolumeBucketDay, VolumeBucketWeek, VolumeBucketMonth)}
+	}
+	if utf8.RuneCountInString(normalized.Focus) > maxVolumeFocusLength {
+		return VolumeOptions{}, VolumeQuery{}, &ValidationError{Field: "focus", Message: fmt.Sprintf("must be at most %d characters", maxVolumeFocusLength)}
+	}
+	loc, err := time.LoadLocation(normalized.Timezone)
+	if err != nil {
+		return VolumeOptions{}, VolumeQuery{}, &ValidationError{Field: "timezone", Message: "must be a valid IANA timezone"}
+	}
+
+	to := user.CivilDay(now, loc)
+	if raw := strings.TrimSpace(opts.To); raw != "" {
+		if to, err = time.Parse(analyticsDateLayout, raw); err != nil {
+			return VolumeOptions{}, VolumeQuery{}, &ValidationError{Field: "to", Message: "must be a date in YYYY-MM-DD format"}
+		}
+	}
+	from := defaultVolumeFrom(to, normalized.Bucket)
+	if raw := strings.TrimSpace(opts.From); raw != "" {
+		if from, err = time.Parse(analyticsDateLayout, raw); err != nil {
+			return VolumeOptions{}, VolumeQuery{}, &ValidationError{Field: "from", Message: "must be a date in YYYY-MM-DD format"}
+		}
+	}
+
+	if to.Before(from) {
+		return VolumeOptions{}, VolumeQuery{}, &ValidationError{Field: "to", Message: "must not be before from"}
+	}
+	if normalized.Bucket == VolumeBucketDay && daysBetween(from, to) >= maxVolumeDailyDays {
+		return VolumeOptions{}, VolumeQuery{}, &ValidationError{Field: "from", Message: fmt.Sprintf("daily buckets cover at most %d days", maxVolumeDailyDays)}
+	}
+	if from.Before(to.AddDate(-maxVolumeYears, 0, 0)) {
+		return VolumeOptions{}, VolumeQuery{}, &ValidationError{Field: "from", Message: fmt.Sprintf("the window covers at most %d years", maxVolumeYears)}
+	}
+
+	normalized.From = formatDay(from)
+	normalized.To = formatDay(to)
+	query := VolumeQuery{
+		Bucket:      normalized.Bucket,
+		Timezone:    normalized.Timezone,
+		Start:       time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc),
+		End:         time.Date(to.Year(), to.Month(), to.Day()+1, 0, 0, 0, 0, loc),
+		Focus:       normalized.Focus,
+		Tags:        normalized.Tags,
+		ExcludeTags: normalized.ExcludeTags,
+	}
+	return normalized, query, nil
+}
+
+// BuildVolume lays the per-period set-type totals onto a continuous run of
+// periods from From to To, so untrained periods appear as zeros. opts must
+// already be normalized.
+func BuildVolume(rows []PeriodSetVolume, opts VolumeOptions) *VolumeResponse {
+	resp := &VolumeResponse{
+		Timezone:    opts.Timezone,
+		Bucket:      opts.Bucket,
+		From:        opts.From,
+		To:          opts.To,
+		Tags:        nonNilStrings(opts.Tags),
+		ExcludeTags: nonNilStrings(opts.ExcludeTags),
+		BySetType:   map[string]VolumeTotals{},
+		Periods:     []VolumePeriod{},
+	}
+	if opts.Focus != "" {
+		focus := opts.Focus
+		resp.Focus = &focus
+	}
+
+	from, errFrom := time.Parse(analyticsDateLayout, opts.From)
+	to, errTo := time.Parse(analyticsDateLayout, opts.To)
+	if errFrom != nil || errTo != nil {
+		return resp
+	}
+
+	index := make(map[string]int)
+	last := volumePeriodStart(to, opts.Bucket)
+	for period := volumePeriodStart(from, opts.Bucket); !period.After(last); period = nextVolumePeriod(period, opts.Bucket) {
+		index[formatDay(period)] = len(resp.Periods)
+		resp.Periods = append(resp.Periods, VolumePeriod{
+			PeriodStart: formatDay(period),
+			BySetType:   map[string]VolumeTotals{},
+		})
+	}
+
+	for _, row := range rows {
+		idx, ok := index[formatDay(row.PeriodStart)]
+		if !ok {
+			continue
+		}
+		period := &resp.Periods[idx]
+		period.Tonnage += row.Tonnage
+		period.Sets += row.Sets
+		period.Reps += row.Reps
+		period.BySetType[row.SetType] = addVolume(period.BySetType[row.SetType], row)
+
+		resp.Totals = addVolume(resp.Totals, row)
+		resp.BySetType[row.SetType] = addVolume(resp.BySetType[row.SetType], row)
+	}
+
+	for i := range resp.Periods {
+		resp.Periods[i].Tonnage = roundTo(resp.Periods[i].Tonnage, 1)
+		roundVolumeTonnage(resp.Periods[i].BySetType)
+	}
+	resp.Totals.Tonnage = roundTo(resp.Totals.Tonnage, 1)
+	roundVolumeTonnage(resp.BySetType)
+	return resp
+}
+
+func defaultVolumeFrom(to time.Time, bucket string) time.Time {
+	switch bucket {
+	case VolumeBucketDay:
+		return to.AddDate(0, 0, -(defaultVolumeDays - 1))
+	case VolumeBucketMonth:
+		return volumePeriodStart(to, bucket).AddDate(0, -(defaultVolumeMonths - 1), 0)
+	default:
+		return weekStart(to).AddDate(0, 0, -7*(defaultVolumeWeeks-1))
+	}
+}
+
+// volumePeriodStart matches Postgres date_trunc: weeks start on Monday.
+func volumePeriodStart(day time.Time, bucket string) time.Time {
+	switch bucket {
+	case VolumeBucketWeek:
+		return weekStart(day)
+	case VolumeBucketMonth:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+func nextVolumePeriod(period time.Time, bucket string) time.Time {
+	switch bucket {
+	case VolumeBucketWeek:
+		return period.AddDate(0, 0, 7)
+	case VolumeBucketMonth:
+		return period.AddDate(0, 1, 0)
+	default:
+		return period.AddDate(0, 0, 1)
+	}
+}
+
+func addVolume(totals VolumeTotals, row PeriodSetVolume) VolumeTotals {
+	totals.Tonnage += row.Tonnage
+	totals.Sets += row.Sets
+	totals.Reps += row.Reps
+	return totals
+}
+
+func roundVolumeTonnage(bySetType map[string]VolumeTotals) {
+	for setType, totals := range bySetType {
+		totals.Tonnage = roundTo(totals.Tonnage, 1)
+		bySetType[setType] = totals
+	}
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
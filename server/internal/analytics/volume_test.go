@@ -0,0 +1,93 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func volumeTestDay(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(analyticsDateLayout, value)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestValidateVolumeOptionsDefaults(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	// 02:00 UTC on Wednesday is still Tuesday evening in New York.
+	now := consistencyTestTime(t, "2026-07-01T02:00:00Z")
+
+	t.Run("weekly window starts on a monday", func(t *testing.T) {
+		opts, query, err := ValidateVolumeOptions(VolumeOptions{Timezone: "America/New_York"}, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, VolumeBucketWeek, opts.Bucket)
+		assert.Equal(t, "2026-06-30", opts.To)
+		assert.Equal(t, "2026-01-05", opts.From)
+		assert.Equal(t, time.Date(2026, 1, 5, 0, 0, 0, 0, loc).Unix(), query.Start.Unix())
+		assert.Equal(t, time.Date(2026, 7, 1, 0, 0, 0, 0, loc).Unix(), query.End.Unix())
+	})
+
+	t.Run("daily and monthly windows", func(t *testing.T) {
+		daily, _, err := ValidateVolumeOptions(VolumeOptions{Timezone: "UTC", Bucket: "DAY"}, now)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-06-02", daily.From)
+
+		monthly, _, err := ValidateVolumeOptions(VolumeOptions{Timezone: "UTC", Bucket: VolumeBucketMonth}, now)
+		require.NoError(t, err)
+		assert.Equal(t, "2025-08-01", monthly.From)
+	})
+}
+
+func TestValidateVolumeOptionsRejects(t *testing.T) {
+	now := consistencyTestTime(t, "2026-07-01T12:00:00Z")
+	tests := map[string]struct {
+		opts  VolumeOptions
+		field string
+	}{
+		"unknown bucket":    {VolumeOptions{Bucket: "year"}, "bucket"},
+		"bad timezone":      {VolumeOptions{Timezone: "Mars/Olympus"}, "timezone"},
+		"bad date":          {VolumeOptions{From: "07/01/2026"}, "from"},
+		"reversed window":   {VolumeOptions{From: "2026-07-01", To: "2026-06-01"}, "to"},
+		"long daily window": {VolumeOptions{Bucket: VolumeBucketDay, From: "2025-01-01", To: "2026-06-30"}, "from"},
+		"over ten years":    {VolumeOptions{Bucket: VolumeBucketMonth, From: "2010-01-01", To: "2026-06-30"}, "from"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := ValidateVolumeOptions(tc.opts, now)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tc.field, validationErr.Field)
+		})
+	}
+}
+
+func TestBuildVolumeFillsPeriodsAndSplitsSetTypes(t *testing.T) {
+	opts := VolumeOptions{Timezone: "UTC", Bucket: VolumeBucketWeek, From: "2026-06-10", To: "2026-06-30"}
+	rows := []PeriodSetVolume{
+		{PeriodStart: volumeTestDay(t, "2026-06-08"), SetType: "warmup", Sets: 4, Reps: 40, Tonnage: 2000},
+		{PeriodStart: volumeTestDay(t, "2026-06-08"), SetType: "working", Sets: 10, Reps: 50, Tonnage: 9000.04},
+		{PeriodStart: volumeTestDay(t, "2026-06-22"), SetType: "working", Sets: 6, Reps: 30, Tonnage: 6000},
+	}
+
+	resp := BuildVolume(rows, opts)
+
+	require.Len(t, resp.Periods, 4)
+	assert.Equal(t, []string{"2026-06-08", "2026-06-15", "2026-06-22", "2026-06-29"}, []string{
+		resp.Periods[0].PeriodStart, resp.Periods[1].PeriodStart, resp.Periods[2].PeriodStart, resp.Periods[3].PeriodStart,
+	})
+	assert.Equal(t, 11000.0, resp.Periods[0].Tonnage)
+	assert.Equal(t, 14, resp.Periods[0].Sets)
+	assert.Equal(t, VolumeTotals{Tonnage: 9000, Sets: 10, Reps: 50}, resp.Periods[0].BySetType["working"])
+	assert.Zero(t, resp.Periods[1].Sets)
+	assert.Empty(t, resp.Periods[1].BySetType)
+	assert.Equal(t, VolumeTotals{Tonnage: 17000, Sets: 20, Reps: 120}, resp.Totals)
+	assert.Equal(t, VolumeTotals{Tonnage: 15000, Sets: 16, Reps: 80}, resp.BySetType["working"])
+	assert.Nil(t, resp.Focus)
+	assert.NotNil(t, resp.Tags)
+}
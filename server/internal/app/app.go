@@ -10,19 +10,34 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/accesstokens"
 	"github.com/Andrewy-gh/fittrack/server/internal/account"
+	"github.com/Andrewy-gh/fittrack/server/internal/achievements"
 	"github.com/Andrewy-gh/fittrack/server/internal/aichat"
+	"github.com/Andrewy-gh/fittrack/server/internal/analytics"
 	"github.com/Andrewy-gh/fittrack/server/internal/auth"
 	"github.com/Andrewy-gh/fittrack/server/internal/billing"
+	"github.com/Andrewy-gh/fittrack/server/internal/bodymetrics"
+	"github.com/Andrewy-gh/fittrack/server/internal/calendar"
+	"github.com/Andrewy-gh/fittrack/server/internal/coaching"
 	"github.com/Andrewy-gh/fittrack/server/internal/config"
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	"github.com/Andrewy-gh/fittrack/server/internal/e2eauth"
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
 	"github.com/Andrewy-gh/fittrack/server/internal/exercise"
 	"github.com/Andrewy-gh/fittrack/server/internal/featureaccess"
+	"github.com/Andrewy-gh/fittrack/server/internal/goals"
 	"github.com/Andrewy-gh/fittrack/server/internal/health"
 	"github.com/Andrewy-gh/fittrack/server/internal/middleware"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
+	"github.com/Andrewy-gh/fittrack/server/internal/report"
+	"github.com/Andrewy-gh/fittrack/server/internal/search"
+	"github.com/Andrewy-gh/fittrack/server/internal/share"
+	"github.com/Andrewy-gh/fittrack/server/internal/strength"
+	"github.com/Andrewy-gh/fittrack/server/internal/tag"
 	"github.com/Andrewy-gh/fittrack/server/internal/trainingprofile"
 	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/Andrewy-gh/fittrack/server/internal/webhooks"
 	"github.com/Andrewy-gh/fittrack/server/internal/workout"
 	"github.com/go-playground/validator/v10"
 	"github.com/jackc/pgx/v5"
@@ -31,10 +46,11 @@ import (
 
 // App owns the API's constructed dependencies and process lifecycle.
 type App struct {
-	logger        *slog.Logger
-	pool          *pgxpool.Pool
-	apiServer     *http.Server
-	metricsServer *http.Server
+	logger            *slog.Logger
+	pool              *pgxpool.Pool
+	apiServer         *http.Server
+	metricsServer     *http.Server
+	webhookDispatcher *webhooks.Dispatcher
 }
 
 type api struct {
@@ -62,17 +78,18 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 		return nil, err
 	}
 
-	apiHandler, metricsHandler, err := buildHandlers(ctx, cfg, logger, pool)
+	apiHandler, metricsHandler, webhookDispatcher, err := buildHandlers(ctx, cfg, logger, pool)
 	if err != nil {
 		pool.Close()
 		return nil, err
 	}
 
 	return &App{
-		logger:        logger,
-		pool:          pool,
-		apiServer:     newHTTPServer(cfg, apiHandler),
-		metricsServer: newMetricsServer(cfg, metricsHandler),
+		logger:            logger,
+		pool:              pool,
+		apiServer:         newHTTPServer(cfg, apiHandler),
+		metricsServer:     newMetricsServer(cfg, metricsHandler),
+		webhookDispatcher: webhookDispatcher,
 	}, nil
 }
 
@@ -133,18 +150,38 @@ func openDatabase(ctx context.Context, cfg *config.Config, logger *slog.Logger)
 	return pool, nil
 }
 
-func buildHandlers(ctx context.Context, cfg *config.Config, logger *slog.Logger, pool *pgxpool.Pool) (http.Handler, http.Handler, error) {
+func buildHandlers(ctx context.Context, cfg *config.Config, logger *slog.Logger, pool *pgxpool.Pool) (http.Handler, http.Handler, *webhooks.Dispatcher, error) {
 	queries := db.New(pool)
 	validate := validator.New()
 
 	exerciseRepo := exercise.NewRepository(logger, queries, pool)
 	featureAccessRepo := featureaccess.NewRepository(logger, queries)
 	accountRepo := account.NewRepository(logger, queries)
-	billingRepo := billing.NewRepository(logger, queries, pool)
+	// 1RM increases and subscription changes are also webhook events, so
+	// the producer queues them for the user's endpoints too.
+	notificationsProducer := webhooks.NewNotificationRelay(logger, notifications.NewProducer(logger))
+	// Workout writes queue their webhook events in the same transaction.
+	webhookPublisher := webhooks.NewPublisher(logger)
+	billingRepo := billing.NewRepository(logger, queries, pool, notificationsProducer)
 	trainingProfileRepo := trainingprofile.NewRepository(logger, queries, pool)
-	workoutRepo := workout.NewRepository(logger, queries, pool, exerciseRepo)
+	workoutRepo := workout.NewRepository(logger, queries, pool, exerciseRepo, notificationsProducer, webhookPublisher)
 	userRepo := user.NewRepository(logger, queries, pool)
-	workoutTxSaver := workout.NewTxSaver(logger, exerciseRepo)
+	analyticsRepo := analytics.NewRepository(logger, queries, pool)
+	bodyMetricsRepo := bodymetrics.NewRepository(logger, queries, pool)
+	strengthRepo := strength.NewRepository(logger, queries, pool)
+	reportRepo := report.NewRepository(logger, queries, pool)
+	calendarRepo := calendar.NewRepository(logger, queries, pool)
+	shareRepo := share.NewRepository(logger, queries, pool)
+	coachingRepo := coaching.NewRepository(logger, queries, pool)
+	searchRepo := search.NewRepository(logger, queries, pool)
+	tagRepo := tag.NewRepository(logger, queries, pool)
+	equipmentRepo := equipment.NewRepository(logger, queries, pool)
+	goalsRepo := goals.NewRepository(logger, queries, pool)
+	achievementsRepo := achievements.NewRepository(logger, queries, pool)
+	notificationsRepo := notifications.NewRepository(logger, queries, pool)
+	webhooksRepo := webhooks.NewRepository(logger, queries, pool)
+	accessTokensRepo := accesstokens.NewRepository(logger, queries, pool)
+	workoutTxSaver := workout.NewTxSaver(logger, exerciseRepo, notificationsProducer, webhookPublisher)
 
 	workoutService := workout.NewService(logger, workoutRepo)
 	exerciseService := exercise.NewService(logger, exerciseRepo)
@@ -161,9 +198,31 @@ func buildHandlers(ctx context.Context, cfg *config.Config, logger *slog.Logger,
 	trainingProfileService := trainingprofile.NewService(logger, trainingProfileRepo)
 	accountService := account.NewService(logger, accountRepo, billingService)
 	userService := user.NewService(logger, userRepo)
+	analyticsService := analytics.NewService(logger, analyticsRepo)
+	bodyMetricsService := bodymetrics.NewService(logger, bodyMetricsRepo)
+	strengthService := strength.NewService(logger, strengthRepo)
+	exerciseService.SetStrengthClassifier(strengthService)
+	workoutService.SetPlateauDetector(analyticsService)
+	reportService := report.NewService(logger, reportRepo)
+	calendarService := calendar.NewService(logger, calendarRepo, cfg.AppBaseURL)
+	shareService := share.NewService(logger, shareRepo, cfg.AppBaseURL)
+	coachingService := coaching.NewService(logger, coachingRepo)
+	searchService := search.NewService(logger, searchRepo)
+	tagService := tag.NewService(logger, tagRepo)
+	equipmentService := equipment.NewService(logger, equipmentRepo)
+	workoutService.SetInventoryLoader(equipmentService)
+	goalsService := goals.NewService(logger, goalsRepo)
+	achievementsService := achievements.NewService(logger, achievementsRepo)
+	workoutService.SetAchievementEvaluator(achievementsService)
 	aiChatRepo := aichat.NewRepository(logger, queries, pool, cfg.AIChatTrialPromptCap)
 	aiChatRuntime := aichat.NewGenkitRuntime(ctx, aiChatRepo)
 	aiChatService := aichat.NewService(logger, featureAccessService, aiChatRuntime, aiChatRepo, workoutTxSaver)
+	aiChatService.SetAchievementEvaluator(achievementsService)
+	aiChatService.SetNotifier(notificationsProducer)
+	notificationsService := notifications.NewService(logger, notificationsRepo)
+	webhookDispatcher := webhooks.NewDispatcher(logger, webhooksRepo)
+	webhooksService := webhooks.NewService(logger, webhooksRepo, webhookDispatcher)
+	accessTokensService := accesstokens.NewService(logger, accessTokensRepo)
 
 	var inngestRecovery *aichat.InngestRecovery
 	var err error
@@ -171,7 +230,7 @@ func buildHandlers(ctx context.Context, cfg *config.Config, logger *slog.Logger,
 	case cfg.AIChatRecoveryConfigured():
 		inngestRecovery, err = aichat.NewInngestRecovery(logger, aiChatService)
 		if err != nil {
-			return nil, nil, fmt.Errorf("initialize Inngest recovery: %w", err)
+			return nil, nil, nil, fmt.Errorf("initialize Inngest recovery: %w", err)
 		}
 		aiChatService.SetRecoveryDispatcher(inngestRecovery)
 	case strings.TrimSpace(cfg.InngestEventKey) != "" || strings.TrimSpace(cfg.InngestSigningKey) != "":
@@ -188,6 +247,21 @@ func buildHandlers(ctx context.Context, cfg *config.Config, logger *slog.Logger,
 	trainingProfileHandler := trainingprofile.NewHandler(logger, trainingProfileService)
 	healthHandler := health.NewHandler(logger, pool)
 	aiChatHandler := aichat.NewHandler(logger, aiChatService)
+	analyticsHandler := analytics.NewHandler(logger, analyticsService)
+	bodyMetricsHandler := bodymetrics.NewHandler(logger, bodyMetricsService)
+	strengthHandler := strength.NewHandler(logger, strengthService)
+	reportHandler := report.NewHandler(logger, reportService)
+	calendarHandler := calendar.NewHandler(logger, calendarService)
+	shareHandler := share.NewHandler(logger, shareService)
+	coachingHandler := coaching.NewHandler(logger, coachingService)
+	searchHandler := search.NewHandler(logger, searchService)
+	tagHandler := tag.NewHandler(logger, tagService)
+	equipmentHandler := equipment.NewHandler(logger, equipmentService)
+	goalsHandler := goals.NewHandler(logger, goalsService)
+	achievementsHandler := achievements.NewHandler(logger, achievementsService)
+	notificationsHandler := notifications.NewHandler(logger, notificationsService)
+	webhooksHandler := webhooks.NewHandler(logger, webhooksService)
+	accessTokensHandler := accesstokens.NewHandler(logger, accessTokensService)
 
 	var e2eAuthHandler *e2eauth.Handler
 	if cfg.LocalE2EAuthConfigured() {
@@ -214,9 +288,9 @@ func buildHandlers(ctx context.Context, cfg *config.Config, logger *slog.Logger,
 
 	jwks, err := auth.NewJWKSCache(ctx, cfg.ProjectID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("create JWKS cache: %w", err)
+		return nil, nil, nil, fmt.Errorf("create JWKS cache: %w", err)
 	}
-	authenticator := auth.NewAuthenticator(logger, jwks, userService, pool)
+	authenticator := auth.NewAuthenticator(logger, jwks, userService, pool).WithDelegation(coachingService).WithAccessTokens(accessTokensService)
 	if cfg.LocalE2EAuthConfigured() {
 		authenticator.WithLocalE2EAuth(auth.LocalE2EAuthConfig{
 			Enabled: true,
@@ -224,17 +298,32 @@ func buildHandlers(ctx context.Context, cfg *config.Config, logger *slog.Logger,
 		})
 	}
 
-	router := api.routes(
-		workoutHandler,
-		exerciseHandler,
-		featureAccessHandler,
-		healthHandler,
-		aiChatHandler,
-		billingHandler,
-		trainingProfileHandler,
-		accountHandler,
-		e2eAuthHandler,
-	)
+	router := api.routes(handlers{
+		workout:         workoutHandler,
+		exercise:        exerciseHandler,
+		featureAccess:   featureAccessHandler,
+		health:          healthHandler,
+		aiChat:          aiChatHandler,
+		billing:         billingHandler,
+		trainingProfile: trainingProfileHandler,
+		account:         accountHandler,
+		e2eAuth:         e2eAuthHandler,
+		analytics:       analyticsHandler,
+		bodyMetrics:     bodyMetricsHandler,
+		strength:        strengthHandler,
+		report:          reportHandler,
+		calendar:        calendarHandler,
+		share:           shareHandler,
+		coaching:        coachingHandler,
+		search:          searchHandler,
+		tag:             tagHandler,
+		equipment:       equipmentHandler,
+		goals:           goalsHandler,
+		achievements:    achievementsHandler,
+		notifications:   notificationsHandler,
+		webhooks:        webhooksHandler,
+		accessTokens:    accessTokensHandler,
+	})
 
 	var handler http.Handler = router
 	handler = middleware.RateLimit(logger, int64(cfg.RateLimitRPM))(handler)
@@ -245,7 +334,7 @@ func buildHandlers(ctx context.Context, cfg *config.Config, logger *slog.Logger,
 	handler = middleware.CORS(cfg.GetAllowedOrigins(), logger)(handler)
 	handler = middleware.SecurityHeaders()(handler)
 
-	return handler, api.metricsHandler(), nil
+	return handler, api.metricsHandler(), webhookDispatcher, nil
 }
 
 func newHTTPServer(cfg *config.Config, handler http.Handler) *http.Server {
@@ -274,6 +363,8 @@ func newMetricsServer(cfg *config.Config, handler http.Handler) *http.Server {
 // Run starts all application servers and blocks until cancellation or failure.
 func (a *App) Run(ctx context.Context) error {
 	serverErrors := make(chan error, 2)
+	dispatchCtx, stopDispatch := context.WithCancel(ctx)
+	defer stopDispatch()
 
 	go func() {
 		a.logger.Info("starting server", "addr", a.apiServer.Addr)
@@ -283,6 +374,7 @@ func (a *App) Run(ctx context.Context) error {
 		a.logger.Info("starting metrics server", "addr", a.metricsServer.Addr)
 		serverErrors <- a.metricsServer.ListenAndServe()
 	}()
+	go a.webhookDispatcher.Run(dispatchCtx)
 
 	select {
 	case <-ctx.Done():
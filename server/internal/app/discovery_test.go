@@ -25,14 +25,13 @@ import (
 func TestRoutes_APICatalogAdvertisesPublicProductAPI(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	api := &api{logger: logger, cfg: &config.Config{}}
-	mux := api.routes(
-		&workout.WorkoutHandler{},
-		&exercise.ExerciseHandler{},
-		&featureaccess.Handler{},
-		health.NewHandler(logger, nil),
-		aichat.NewHandler(logger, nil),
-		nil, nil, nil, nil,
-	)
+	mux := api.routes(handlers{
+		workout:       &workout.WorkoutHandler{},
+		exercise:      &exercise.ExerciseHandler{},
+		featureAccess: &featureaccess.Handler{},
+		health:        health.NewHandler(logger, nil),
+		aiChat:        aichat.NewHandler(logger, nil),
+	})
 
 	req := httptest.NewRequest(http.MethodGet, "https://fittrack.example/.well-known/api-catalog", nil)
 	rr := httptest.NewRecorder()
@@ -71,14 +70,13 @@ func TestRoutes_APICatalogAdvertisesPublicProductAPI(t *testing.T) {
 func TestRoutes_AgentSwaggerArtifactSupportsGetAndHead(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	api := &api{logger: logger, cfg: &config.Config{}}
-	mux := api.routes(
-		&workout.WorkoutHandler{},
-		&exercise.ExerciseHandler{},
-		&featureaccess.Handler{},
-		health.NewHandler(logger, nil),
-		aichat.NewHandler(logger, nil),
-		nil, nil, nil, nil,
-	)
+	mux := api.routes(handlers{
+		workout:       &workout.WorkoutHandler{},
+		exercise:      &exercise.ExerciseHandler{},
+		featureAccess: &featureaccess.Handler{},
+		health:        health.NewHandler(logger, nil),
+		aiChat:        aichat.NewHandler(logger, nil),
+	})
 
 	for _, method := range []string{http.MethodGet, http.MethodHead} {
 		t.Run(method, func(t *testing.T) {
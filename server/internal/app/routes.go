@@ -2,35 +2,90 @@ package app
 
 import (
 	"encoding/json"
-	"mime"
 	"net/http"
 	"path"
 	"strconv"
 	"strings"
 
 	"github.com/Andrewy-gh/fittrack/server/docs"
+	"github.com/Andrewy-gh/fittrack/server/internal/accesstokens"
 	"github.com/Andrewy-gh/fittrack/server/internal/account"
+	"github.com/Andrewy-gh/fittrack/server/internal/achievements"
 	"github.com/Andrewy-gh/fittrack/server/internal/aichat"
+	"github.com/Andrewy-gh/fittrack/server/internal/analytics"
 	"github.com/Andrewy-gh/fittrack/server/internal/billing"
+	"github.com/Andrewy-gh/fittrack/server/internal/bodymetrics"
+	"github.com/Andrewy-gh/fittrack/server/internal/calendar"
+	"github.com/Andrewy-gh/fittrack/server/internal/coaching"
 	"github.com/Andrewy-gh/fittrack/server/internal/e2eauth"
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
 	"github.com/Andrewy-gh/fittrack/server/internal/exercise"
 	"github.com/Andrewy-gh/fittrack/server/internal/featureaccess"
+	"github.com/Andrewy-gh/fittrack/server/internal/goals"
 	"github.com/Andrewy-gh/fittrack/server/internal/health"
 	"github.com/Andrewy-gh/fittrack/server/internal/middleware"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
+	"github.com/Andrewy-gh/fittrack/server/internal/report"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+	"github.com/Andrewy-gh/fittrack/server/internal/search"
+	"github.com/Andrewy-gh/fittrack/server/internal/share"
+	"github.com/Andrewy-gh/fittrack/server/internal/strength"
+	"github.com/Andrewy-gh/fittrack/server/internal/tag"
 	"github.com/Andrewy-gh/fittrack/server/internal/trainingprofile"
+	"github.com/Andrewy-gh/fittrack/server/internal/webhooks"
 	"github.com/Andrewy-gh/fittrack/server/internal/workout"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
-func (api *api) routes(wh *workout.WorkoutHandler, eh *exercise.ExerciseHandler, fh *featureaccess.Handler, hh *health.Handler, ah *aichat.Handler, bh *billing.Handler, tph *trainingprofile.Handler, accountHandler *account.Handler, e2eh *e2eauth.Handler) *http.ServeMux {
+// handlers holds the HTTP handlers routes registers. The workout, exercise,
+// feature access, health and AI chat handlers are required; routes for any
+// other handler left nil are not registered.
+type handlers struct {
+	workout         *workout.WorkoutHandler
+	exercise        *exercise.ExerciseHandler
+	featureAccess   *featureaccess.Handler
+	health          *health.Handler
+	aiChat          *aichat.Handler
+	billing         *billing.Handler
+	trainingProfile *trainingprofile.Handler
+	account         *account.Handler
+	e2eAuth         *e2eauth.Handler
+	analytics       *analytics.Handler
+	bodyMetrics     *bodymetrics.Handler
+	strength        *strength.Handler
+	report          *report.Handler
+	calendar        *calendar.Handler
+	share           *share.Handler
+	coaching        *coaching.Handler
+	search          *search.Handler
+	tag             *tag.Handler
+	equipment       *equipment.Handler
+	goals           *goals.Handler
+	achievements    *achievements.Handler
+	notifications   *notifications.Handler
+	webhooks        *webhooks.Handler
+	accessTokens    *accesstokens.Handler
+}
+
+func (api *api) routes(h handlers) *http.ServeMux {
 	mux := http.NewServeMux()
 
 	// Health endpoints (no authentication required)
-	mux.HandleFunc("GET /health", hh.Health)
-	mux.HandleFunc("GET /ready", hh.Ready)
-	if bh != nil {
-		mux.HandleFunc("POST /stripe/webhook", bh.Webhook)
+	mux.HandleFunc("GET /health", h.health.Health)
+	mux.HandleFunc("GET /ready", h.health.Ready)
+	if h.billing != nil {
+		mux.HandleFunc("POST /stripe/webhook", h.billing.Webhook)
+	}
+	// Calendar feeds authenticate with the secret token in the path, since
+	// calendar apps cannot send auth headers.
+	if h.calendar != nil {
+		mux.HandleFunc("GET /calendar/{token}", h.calendar.ServeFeed)
+	}
+	// Share links work the same way: the token grants read-only access to a
+	// single workout.
+	if h.share != nil {
+		mux.HandleFunc("GET /s/{token}", h.share.ServeShare)
 	}
 
 	// Wrap with basic auth if credentials are configured
@@ -38,55 +93,149 @@ func (api *api) routes(wh *workout.WorkoutHandler, eh *exercise.ExerciseHandler,
 	mux.Handle("GET /metrics", protectedMetrics)
 
 	// API endpoints (authentication required)
-	mux.HandleFunc("GET /api/workouts", wh.ListWorkouts)
-	mux.HandleFunc("POST /api/workouts", wh.CreateWorkout)
-	mux.HandleFunc("GET /api/workouts/{id}", wh.GetWorkoutWithSets)
-	mux.HandleFunc("PUT /api/workouts/{id}", wh.UpdateWorkout)
-	mux.HandleFunc("DELETE /api/workouts/{id}", wh.DeleteWorkout)
-	mux.HandleFunc("GET /api/workouts/new-workout-context", wh.GetNewWorkoutContext)
-	mux.HandleFunc("GET /api/workouts/focus-values", wh.ListWorkoutFocusValues)
-	mux.HandleFunc("GET /api/workouts/contribution-data", wh.GetContributionData)
-	mux.HandleFunc("GET /api/exercises", eh.ListExercises)
-	mux.HandleFunc("GET /api/features/access", fh.ListActiveFeatureAccess)
-	if tph != nil {
-		mux.HandleFunc("GET /api/training-profile", tph.Get)
-		mux.HandleFunc("PUT /api/training-profile", tph.Upsert)
+	mux.HandleFunc("GET /api/workouts", h.workout.ListWorkouts)
+	mux.HandleFunc("POST /api/workouts", h.workout.CreateWorkout)
+	mux.HandleFunc("GET /api/workouts/{id}", h.workout.GetWorkoutWithSets)
+	mux.HandleFunc("PUT /api/workouts/{id}", h.workout.UpdateWorkout)
+	mux.HandleFunc("DELETE /api/workouts/{id}", h.workout.DeleteWorkout)
+	mux.HandleFunc("GET /api/workouts/new-workout-context", h.workout.GetNewWorkoutContext)
+	mux.HandleFunc("GET /api/workouts/focus-values", h.workout.ListWorkoutFocusValues)
+	mux.HandleFunc("GET /api/workouts/contribution-data", h.workout.GetContributionData)
+	mux.HandleFunc("GET /api/workouts/compare", h.workout.CompareWorkouts)
+	mux.HandleFunc("POST /api/tools/warmup", h.workout.GenerateWarmup)
+	if h.share != nil {
+		mux.HandleFunc("POST /api/workouts/{id}/share", h.share.CreateShare)
+		mux.HandleFunc("DELETE /api/workouts/{id}/share", h.share.RevokeShare)
+	}
+	mux.HandleFunc("GET /api/exercises", h.exercise.ListExercises)
+	mux.HandleFunc("GET /api/features/access", h.featureAccess.ListActiveFeatureAccess)
+	if h.trainingProfile != nil {
+		mux.HandleFunc("GET /api/training-profile", h.trainingProfile.Get)
+		mux.HandleFunc("PUT /api/training-profile", h.trainingProfile.Upsert)
+	}
+	if h.account != nil {
+		mux.HandleFunc("DELETE /api/account", h.account.DeleteAccount)
+		mux.HandleFunc("GET /api/account/timezone", h.account.GetTimezone)
+		mux.HandleFunc("PUT /api/account/timezone", h.account.UpdateTimezone)
+	}
+	if h.analytics != nil {
+		mux.HandleFunc("GET /api/analytics/consistency", h.analytics.GetConsistency)
+		mux.HandleFunc("GET /api/analytics/training-load", h.analytics.GetTrainingLoad)
+		mux.HandleFunc("GET /api/analytics/plateaus", h.analytics.GetPlateaus)
+		mux.HandleFunc("GET /api/analytics/volume", h.analytics.GetVolume)
+	}
+	if h.bodyMetrics != nil {
+		mux.HandleFunc("GET /api/body-metrics", h.bodyMetrics.ListEntries)
+		mux.HandleFunc("POST /api/body-metrics", h.bodyMetrics.CreateEntry)
+		mux.HandleFunc("GET /api/body-metrics/trend", h.bodyMetrics.GetTrend)
+		mux.HandleFunc("GET /api/body-metrics/{id}", h.bodyMetrics.GetEntry)
+		mux.HandleFunc("PUT /api/body-metrics/{id}", h.bodyMetrics.UpdateEntry)
+		mux.HandleFunc("DELETE /api/body-metrics/{id}", h.bodyMetrics.DeleteEntry)
+	}
+	if h.strength != nil {
+		mux.HandleFunc("GET /api/strength/profile", h.strength.GetProfile)
+		mux.HandleFunc("PUT /api/strength/profile", h.strength.UpdateProfile)
+		mux.HandleFunc("GET /api/strength/scores", h.strength.GetScores)
+		mux.HandleFunc("GET /api/strength/scores/history", h.strength.GetScoreHistory)
+	}
+	if h.report != nil {
+		mux.HandleFunc("GET /api/reports/{period}", h.report.GetReport)
+	}
+	if h.calendar != nil {
+		mux.HandleFunc("GET /api/calendar/feed", h.calendar.GetFeed)
+		mux.HandleFunc("DELETE /api/calendar/feed", h.calendar.DeleteFeed)
+		mux.HandleFunc("POST /api/calendar/feed/token", h.calendar.RotateFeedToken)
+	}
+	if h.coaching != nil {
+		mux.HandleFunc("POST /api/coaching/invitations", h.coaching.CreateInvitation)
+		mux.HandleFunc("GET /api/coaching/invitations", h.coaching.ListInvitations)
+		mux.HandleFunc("POST /api/coaching/invitations/accept", h.coaching.AcceptInvitation)
+		mux.HandleFunc("GET /api/coaching/athletes", h.coaching.ListAthletes)
+		mux.HandleFunc("POST /api/coaching/athletes/{athleteID}/suggestions", h.coaching.CreateSuggestion)
+		mux.HandleFunc("GET /api/coaching/coaches", h.coaching.ListCoaches)
+		mux.HandleFunc("PUT /api/coaching/links/{id}/scopes", h.coaching.UpdateScopes)
+		mux.HandleFunc("DELETE /api/coaching/links/{id}", h.coaching.DeleteLink)
+		mux.HandleFunc("GET /api/coaching/suggestions", h.coaching.ListSuggestions)
+		mux.HandleFunc("DELETE /api/coaching/suggestions/{id}", h.coaching.DismissSuggestion)
+	}
+	if h.search != nil {
+		mux.HandleFunc("GET /api/search", h.search.Search)
+	}
+	if h.tag != nil {
+		mux.HandleFunc("GET /api/tags", h.tag.ListTags)
+		mux.HandleFunc("POST /api/tags", h.tag.CreateTag)
+		mux.HandleFunc("PATCH /api/tags/{id}", h.tag.RenameTag)
+		mux.HandleFunc("DELETE /api/tags/{id}", h.tag.DeleteTag)
+	}
+	if h.equipment != nil {
+		mux.HandleFunc("GET /api/equipment", h.equipment.GetInventory)
+		mux.HandleFunc("PUT /api/equipment", h.equipment.UpdateInventory)
+		mux.HandleFunc("GET /api/tools/plates", h.equipment.GetPlateBreakdown)
+	}
+	if h.goals != nil {
+		mux.HandleFunc("GET /api/goals", h.goals.ListGoals)
+		mux.HandleFunc("POST /api/goals", h.goals.CreateGoal)
+		mux.HandleFunc("PATCH /api/goals/{id}", h.goals.UpdateGoal)
+		mux.HandleFunc("DELETE /api/goals/{id}", h.goals.DeleteGoal)
+	}
+	if h.achievements != nil {
+		mux.HandleFunc("GET /api/achievements", h.achievements.ListAchievements)
+	}
+	if h.notifications != nil {
+		mux.HandleFunc("GET /api/notifications", h.notifications.ListNotifications)
+		mux.HandleFunc("GET /api/notifications/unread-count", h.notifications.GetUnreadCount)
+		mux.HandleFunc("GET /api/notifications/stream", h.notifications.StreamNotifications)
+		mux.HandleFunc("POST /api/notifications/{id}/read", h.notifications.MarkNotificationRead)
+		mux.HandleFunc("POST /api/notifications/read-all", h.notifications.MarkAllNotificationsRead)
+	}
+	if h.webhooks != nil {
+		mux.HandleFunc("GET /api/webhooks", h.webhooks.ListEndpoints)
+		mux.HandleFunc("POST /api/webhooks", h.webhooks.CreateEndpoint)
+		mux.HandleFunc("PATCH /api/webhooks/{id}", h.webhooks.UpdateEndpoint)
+		mux.HandleFunc("DELETE /api/webhooks/{id}", h.webhooks.DeleteEndpoint)
+		mux.HandleFunc("GET /api/webhooks/{id}/deliveries", h.webhooks.ListDeliveries)
+		mux.HandleFunc("POST /api/webhooks/{id}/test", h.webhooks.SendTestEvent)
 	}
-	if accountHandler != nil {
-		mux.HandleFunc("DELETE /api/account", accountHandler.DeleteAccount)
+	if h.accessTokens != nil {
+		mux.HandleFunc("GET /api/access-tokens", h.accessTokens.ListTokens)
+		mux.HandleFunc("POST /api/access-tokens", h.accessTokens.CreateToken)
+		mux.HandleFunc("DELETE /api/access-tokens/{id}", h.accessTokens.RevokeToken)
 	}
-	if bh != nil {
-		mux.HandleFunc("POST /api/billing/checkout-session", bh.CreateCheckoutSession)
-		mux.HandleFunc("POST /api/billing/customer-portal-session", bh.CreateCustomerPortalSession)
-		mux.HandleFunc("POST /api/billing/subscription-cancel-portal-session", bh.CreateSubscriptionCancelPortalSession)
-		mux.HandleFunc("GET /api/billing/status", bh.CurrentStatus)
+	if h.billing != nil {
+		mux.HandleFunc("POST /api/billing/checkout-session", h.billing.CreateCheckoutSession)
+		mux.HandleFunc("POST /api/billing/customer-portal-session", h.billing.CreateCustomerPortalSession)
+		mux.HandleFunc("POST /api/billing/subscription-cancel-portal-session", h.billing.CreateSubscriptionCancelPortalSession)
+		mux.HandleFunc("GET /api/billing/status", h.billing.CurrentStatus)
 	}
-	mux.HandleFunc("POST /api/exercises", eh.GetOrCreateExercise)
-	mux.HandleFunc("GET /api/exercises/{id}", eh.GetExerciseWithSets)
-	mux.HandleFunc("GET /api/exercises/{id}/recent-sets", eh.GetRecentSetsForExercise)
-	mux.HandleFunc("GET /api/exercises/{id}/metrics-history", eh.GetExerciseMetricsHistory)
-	mux.HandleFunc("PATCH /api/exercises/{id}", eh.UpdateExerciseName)
-	mux.HandleFunc("PATCH /api/exercises/{id}/historical-1rm", eh.UpdateExerciseHistorical1RM)
-	mux.HandleFunc("DELETE /api/exercises/{id}", eh.DeleteExercise)
-	mux.HandleFunc("POST /api/ai/conversations", ah.CreateConversation)
-	mux.HandleFunc("GET /api/ai/conversations", ah.ListConversations)
-	mux.HandleFunc("DELETE /api/ai/conversations", ah.DeleteAllConversations)
-	mux.HandleFunc("GET /api/ai/conversations/{id}", ah.GetConversation)
-	mux.HandleFunc("DELETE /api/ai/conversations/{id}", ah.DeleteConversation)
-	mux.HandleFunc("POST /api/ai/conversations/{id}/latest-workout-draft/save", ah.SaveLatestWorkoutDraft)
-	mux.HandleFunc("POST /api/ai/conversations/{id}/messages/stream", ah.StreamMessage)
-	mux.HandleFunc("GET /api/ai/conversations/{id}/messages/stream/resume", ah.ResumeMessageStream)
-	mux.HandleFunc("POST /api/ai/conversations/{id}/messages/recover", ah.RecoverMessage)
-	mux.HandleFunc("POST /api/ai/conversations/{id}/runs/{runID}/stop", ah.StopRun)
-	mux.HandleFunc("POST /api/ai/chat/telemetry", ah.RecordTelemetry)
+	mux.HandleFunc("POST /api/exercises", h.exercise.GetOrCreateExercise)
+	mux.HandleFunc("GET /api/exercises/{id}", h.exercise.GetExerciseWithSets)
+	mux.HandleFunc("GET /api/exercises/{id}/recent-sets", h.exercise.GetRecentSetsForExercise)
+	mux.HandleFunc("GET /api/exercises/metrics-history", h.exercise.GetExercisesMetricsHistory)
+	mux.HandleFunc("GET /api/exercises/{id}/metrics-history", h.exercise.GetExerciseMetricsHistory)
+	mux.HandleFunc("GET /api/exercises/{id}/notes", h.exercise.GetExerciseNotes)
+	mux.HandleFunc("PATCH /api/exercises/{id}", h.exercise.UpdateExerciseName)
+	mux.HandleFunc("PATCH /api/exercises/{id}/historical-1rm", h.exercise.UpdateExerciseHistorical1RM)
+	mux.HandleFunc("PUT /api/exercises/{id}/notes", h.exercise.UpdateExerciseNotes)
+	mux.HandleFunc("DELETE /api/exercises/{id}", h.exercise.DeleteExercise)
+	mux.HandleFunc("POST /api/ai/conversations", h.aiChat.CreateConversation)
+	mux.HandleFunc("GET /api/ai/conversations", h.aiChat.ListConversations)
+	mux.HandleFunc("DELETE /api/ai/conversations", h.aiChat.DeleteAllConversations)
+	mux.HandleFunc("GET /api/ai/conversations/{id}", h.aiChat.GetConversation)
+	mux.HandleFunc("DELETE /api/ai/conversations/{id}", h.aiChat.DeleteConversation)
+	mux.HandleFunc("POST /api/ai/conversations/{id}/latest-workout-draft/save", h.aiChat.SaveLatestWorkoutDraft)
+	mux.HandleFunc("POST /api/ai/conversations/{id}/messages/stream", h.aiChat.StreamMessage)
+	mux.HandleFunc("GET /api/ai/conversations/{id}/messages/stream/resume", h.aiChat.ResumeMessageStream)
+	mux.HandleFunc("POST /api/ai/conversations/{id}/messages/recover", h.aiChat.RecoverMessage)
+	mux.HandleFunc("POST /api/ai/conversations/{id}/runs/{runID}/stop", h.aiChat.StopRun)
+	mux.HandleFunc("POST /api/ai/chat/telemetry", h.aiChat.RecordTelemetry)
 	if api.inngestHandler != nil {
 		mux.Handle("GET /inngest", api.inngestHandler)
 		mux.Handle("PUT /inngest", api.inngestHandler)
 		mux.Handle("POST /inngest", api.inngestHandler)
 	}
-	if e2eh != nil {
-		mux.HandleFunc("POST /dev/e2e/auth/bootstrap", e2eh.Bootstrap)
-		mux.HandleFunc("POST /dev/e2e/ai-chat/conversations", e2eh.SeedConversation)
+	if h.e2eAuth != nil {
+		mux.HandleFunc("POST /dev/e2e/auth/bootstrap", h.e2eAuth.Bootstrap)
+		mux.HandleFunc("POST /dev/e2e/ai-chat/conversations", h.e2eAuth.SeedConversation)
 	}
 	// Public API discovery and documentation
 	mux.HandleFunc("GET /.well-known/api-catalog", api.handleAPICatalog)
@@ -162,7 +311,7 @@ func (api *api) handleStaticFiles() http.HandlerFunc {
 		if r.URL.Path == "/" {
 			setAPIDiscoveryLinks(w)
 			w.Header().Add("Vary", "Accept")
-			if prefersMarkdown(r.Header.Get("Accept")) {
+			if response.PrefersMarkdown(r.Header.Get("Accept")) {
 				setStaticCacheHeader(w, "/")
 				w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
 				_, _ = w.Write([]byte(fitTrackMarkdownOverview))
@@ -218,61 +367,6 @@ func setAPIDiscoveryLinks(w http.ResponseWriter) {
 	w.Header().Add("Link", `</swagger/>; rel="service-doc"; type="text/html"`)
 }
 
-func prefersMarkdown(accept string) bool {
-	markdownQuality, markdownExplicit := representationQuality(accept, "text/markdown")
-	if !markdownExplicit || markdownQuality <= 0 {
-		return false
-	}
-
-	htmlQuality, _ := representationQuality(accept, "text/html")
-	return markdownQuality >= htmlQuality
-}
-
-func representationQuality(accept, representation string) (float64, bool) {
-	quality := 0.0
-	bestSpecificity := -1
-	explicit := false
-	representationParts := strings.SplitN(representation, "/", 2)
-
-	for _, item := range strings.Split(accept, ",") {
-		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(item))
-		if err != nil {
-			continue
-		}
-		mediaParts := strings.SplitN(mediaType, "/", 2)
-		if len(mediaParts) != 2 || (mediaParts[0] != "*" && mediaParts[0] != representationParts[0]) || (mediaParts[1] != "*" && mediaParts[1] != representationParts[1]) {
-			continue
-		}
-
-		specificity := 0
-		if mediaParts[0] != "*" {
-			specificity++
-		}
-		if mediaParts[1] != "*" {
-			specificity++
-		}
-		if mediaType == representation {
-			explicit = true
-		}
-		if specificity < bestSpecificity {
-			continue
-		}
-
-		itemQuality := 1.0
-		if rawQuality, ok := params["q"]; ok {
-			parsedQuality, err := strconv.ParseFloat(rawQuality, 64)
-			if err != nil || parsedQuality < 0 || parsedQuality > 1 {
-				continue
-			}
-			itemQuality = parsedQuality
-		}
-		bestSpecificity = specificity
-		quality = itemQuality
-	}
-
-	return quality, explicit
-}
-
 func setStaticCacheHeader(w http.ResponseWriter, requestPath string) {
 	switch {
 	case requestPath == "/" || requestPath == "/index.html" || requestPath == "/sw.js" || requestPath == "/manifest.webmanifest" || requestPath == "/manifest.json":
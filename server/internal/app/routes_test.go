@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
@@ -11,13 +12,28 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/accesstokens"
 	"github.com/Andrewy-gh/fittrack/server/internal/account"
+	"github.com/Andrewy-gh/fittrack/server/internal/achievements"
 	"github.com/Andrewy-gh/fittrack/server/internal/aichat"
+	"github.com/Andrewy-gh/fittrack/server/internal/analytics"
 	"github.com/Andrewy-gh/fittrack/server/internal/billing"
+	"github.com/Andrewy-gh/fittrack/server/internal/bodymetrics"
+	"github.com/Andrewy-gh/fittrack/server/internal/calendar"
+	"github.com/Andrewy-gh/fittrack/server/internal/coaching"
 	"github.com/Andrewy-gh/fittrack/server/internal/config"
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
 	"github.com/Andrewy-gh/fittrack/server/internal/exercise"
 	"github.com/Andrewy-gh/fittrack/server/internal/featureaccess"
+	"github.com/Andrewy-gh/fittrack/server/internal/goals"
 	"github.com/Andrewy-gh/fittrack/server/internal/health"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
+	"github.com/Andrewy-gh/fittrack/server/internal/report"
+	"github.com/Andrewy-gh/fittrack/server/internal/search"
+	"github.com/Andrewy-gh/fittrack/server/internal/share"
+	"github.com/Andrewy-gh/fittrack/server/internal/strength"
+	"github.com/Andrewy-gh/fittrack/server/internal/tag"
+	"github.com/Andrewy-gh/fittrack/server/internal/webhooks"
 	"github.com/Andrewy-gh/fittrack/server/internal/workout"
 )
 
@@ -25,6 +41,12 @@ type routeBillingService struct{}
 
 type routeAccountService struct{}
 
+type routeAnalyticsService struct{}
+
+type routeBodyMetricsService struct{}
+
+type routeStrengthService struct{}
+
 func (routeBillingService) CreateCheckoutSession(context.Context) (*billing.CheckoutSessionResponse, error) {
 	return &billing.CheckoutSessionResponse{URL: "https://checkout.stripe.test/session"}, nil
 }
@@ -49,6 +71,108 @@ func (routeAccountService) DeleteCurrentUser(context.Context) error {
 	return nil
 }
 
+func (routeAccountService) GetTimezone(context.Context) (*account.TimezoneResponse, error) {
+	return &account.TimezoneResponse{Timezone: "UTC", Source: account.TimezoneSourceDefault}, nil
+}
+
+func (routeAccountService) UpdateTimezone(context.Context, account.UpdateTimezoneRequest) (*account.TimezoneResponse, error) {
+	return &account.TimezoneResponse{Timezone: "UTC", Source: account.TimezoneSourceStored}, nil
+}
+
+func (routeAnalyticsService) GetConsistency(context.Context, analytics.ConsistencyOptions) (*analytics.ConsistencyResponse, error) {
+	return &analytics.ConsistencyResponse{Timezone: "UTC"}, nil
+}
+
+func (routeAnalyticsService) GetTrainingLoad(context.Context, analytics.TrainingLoadOptions) (*analytics.TrainingLoadResponse, error) {
+	return &analytics.TrainingLoadResponse{Metric: analytics.LoadMetricTonnage, Points: []analytics.TrainingLoadPoint{}, Warnings: []analytics.TrainingLoadWarning{}}, nil
+}
+
+func (routeAnalyticsService) GetPlateaus(context.Context, analytics.PlateauOptions) (*analytics.PlateauResponse, error) {
+	return &analytics.PlateauResponse{Exercises: []analytics.ExercisePlateau{}}, nil
+}
+
+func (routeAnalyticsService) GetVolume(context.Context, analytics.VolumeOptions) (*analytics.VolumeResponse, error) {
+	return &analytics.VolumeResponse{Periods: []analytics.VolumePeriod{}}, nil
+}
+
+func (routeBodyMetricsService) List(context.Context, bodymetrics.ListOptions) ([]bodymetrics.EntryResponse, error) {
+	return []bodymetrics.EntryResponse{}, nil
+}
+
+func (routeBodyMetricsService) Get(_ context.Context, id int32) (*bodymetrics.EntryResponse, error) {
+	return &bodymetrics.EntryResponse{ID: id}, nil
+}
+
+func (routeBodyMetricsService) Create(context.Context, bodymetrics.EntryRequest) (*bodymetrics.EntryResponse, error) {
+	return &bodymetrics.EntryResponse{ID: 1}, nil
+}
+
+func (routeBodyMetricsService) Update(_ context.Context, id int32, _ bodymetrics.EntryRequest) (*bodymetrics.EntryResponse, error) {
+	return &bodymetrics.EntryResponse{ID: id}, nil
+}
+
+func (routeBodyMetricsService) Delete(context.Context, int32) error {
+	return nil
+}
+
+func (routeBodyMetricsService) Trend(context.Context, bodymetrics.TrendOptions) (*bodymetrics.TrendResponse, error) {
+	return &bodymetrics.TrendResponse{Metric: bodymetrics.MetricBodyweight, Points: []bodymetrics.TrendPoint{}}, nil
+}
+
+func (routeStrengthService) GetProfile(context.Context) (*strength.ProfileResponse, error) {
+	return &strength.ProfileResponse{}, nil
+}
+
+func (routeStrengthService) UpdateProfile(context.Context, strength.UpdateProfileRequest) (*strength.ProfileResponse, error) {
+	return &strength.ProfileResponse{}, nil
+}
+
+func (routeStrengthService) GetScores(context.Context) (*strength.ScoresResponse, error) {
+	return &strength.ScoresResponse{Lifts: []strength.LiftScore{}, MissingInputs: []string{strength.MissingSex}}, nil
+}
+
+func (routeStrengthService) GetScoreHistory(context.Context) (*strength.ScoreHistoryResponse, error) {
+	return &strength.ScoreHistoryResponse{Points: []strength.ScoreHistoryPoint{}, MissingInputs: []string{}}, nil
+}
+
+type routeCalendarService struct{}
+
+func (routeCalendarService) GetFeed(context.Context) (*calendar.FeedStatusResponse, error) {
+	return &calendar.FeedStatusResponse{Enabled: false}, nil
+}
+
+func (routeCalendarService) RotateFeedToken(context.Context) (*calendar.FeedTokenResponse, error) {
+	return &calendar.FeedTokenResponse{Token: "token"}, nil
+}
+
+func (routeCalendarService) DeleteFeed(context.Context) error {
+	return nil
+}
+
+func (routeCalendarService) RenderFeed(_ context.Context, token string) (string, error) {
+	return "BEGIN:VCALENDAR\r\nX-TOKEN:" + token + "\r\nEND:VCALENDAR\r\n", nil
+}
+
+type routeShareService struct{}
+
+func (routeShareService) CreateShare(_ context.Context, workoutID int32, _ share.CreateShareRequest) (*share.ShareLinkResponse, error) {
+	return &share.ShareLinkResponse{Token: fmt.Sprintf("token-%d", workoutID)}, nil
+}
+
+func (routeShareService) RevokeShare(context.Context, int32) error {
+	return nil
+}
+
+func (routeShareService) GetSharedWorkout(_ context.Context, token string) (*share.SharedWorkoutResponse, error) {
+	return &share.SharedWorkoutResponse{Date: token, Exercises: []share.SharedExercise{}}, nil
+}
+
+type routeReportService struct{}
+
+func (routeReportService) GetReport(_ context.Context, opts report.ReportOptions) (*report.Report, error) {
+	return &report.Report{Period: report.Period{Type: opts.Period, Start: "2026-06-29", End: "2026-07-05", Timezone: "UTC"}}, nil
+}
+
 func TestRoutes_AllowsInngestHandlerAlongsideStaticFallback(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	api := &api{
@@ -71,7 +195,13 @@ func TestRoutes_AllowsInngestHandlerAlongsideStaticFallback(t *testing.T) {
 		}
 	}()
 
-	_ = api.routes(wh, eh, fh, hh, ah, nil, nil, nil, nil)
+	_ = api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+	})
 }
 
 func TestRoutes_RegistersPutForInngestHandler(t *testing.T) {
@@ -96,7 +226,13 @@ func TestRoutes_RegistersPutForInngestHandler(t *testing.T) {
 	hh := health.NewHandler(logger, nil)
 	ah := aichat.NewHandler(logger, nil)
 
-	mux := api.routes(wh, eh, fh, hh, ah, nil, nil, nil, nil)
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+	})
 	req := httptest.NewRequest(http.MethodPut, "/inngest", nil)
 	rr := httptest.NewRecorder()
 
@@ -124,7 +260,13 @@ func TestRoutes_DoesNotExposeAIChatValidationEndpoints(t *testing.T) {
 	hh := health.NewHandler(logger, nil)
 	ah := aichat.NewHandler(logger, nil)
 
-	mux := api.routes(wh, eh, fh, hh, ah, nil, nil, nil, nil)
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+	})
 
 	for _, path := range []string{"/api/ai/chat/validate", "/api/ai/chat/validate/stream"} {
 		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"prompt":"prove the slice"}`))
@@ -155,7 +297,13 @@ func TestRoutes_ProtectsPublicMetricsWhenCredentialsConfigured(t *testing.T) {
 	hh := health.NewHandler(logger, nil)
 	ah := aichat.NewHandler(logger, nil)
 
-	mux := api.routes(wh, eh, fh, hh, ah, nil, nil, nil, nil)
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+	})
 	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
 	rr := httptest.NewRecorder()
 
@@ -181,7 +329,14 @@ func TestRoutes_RegistersBillingCustomerPortalSession(t *testing.T) {
 	ah := aichat.NewHandler(logger, nil)
 	bh := billing.NewHandler(logger, routeBillingService{})
 
-	mux := api.routes(wh, eh, fh, hh, ah, bh, nil, nil, nil)
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+		billing:       bh,
+	})
 	req := httptest.NewRequest(http.MethodPost, "/api/billing/customer-portal-session", nil)
 	rr := httptest.NewRecorder()
 
@@ -210,7 +365,14 @@ func TestRoutes_RegistersBillingSubscriptionCancelPortalSession(t *testing.T) {
 	ah := aichat.NewHandler(logger, nil)
 	bh := billing.NewHandler(logger, routeBillingService{})
 
-	mux := api.routes(wh, eh, fh, hh, ah, bh, nil, nil, nil)
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+		billing:       bh,
+	})
 	req := httptest.NewRequest(http.MethodPost, "/api/billing/subscription-cancel-portal-session", nil)
 	rr := httptest.NewRecorder()
 
@@ -239,7 +401,14 @@ func TestRoutes_RegistersAccountDeletion(t *testing.T) {
 	ah := aichat.NewHandler(logger, nil)
 	accountHandler := account.NewHandler(logger, routeAccountService{})
 
-	mux := api.routes(wh, eh, fh, hh, ah, nil, nil, accountHandler, nil)
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+		account:       accountHandler,
+	})
 	req := httptest.NewRequest(http.MethodDelete, "/api/account", nil)
 	rr := httptest.NewRecorder()
 
@@ -250,6 +419,560 @@ func TestRoutes_RegistersAccountDeletion(t *testing.T) {
 	}
 }
 
+func TestRoutes_RegistersAnalytics(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	wh := &workout.WorkoutHandler{}
+	eh := &exercise.ExerciseHandler{}
+	fh := &featureaccess.Handler{}
+	hh := health.NewHandler(logger, nil)
+	ah := aichat.NewHandler(logger, nil)
+	analyticsHandler := analytics.NewHandler(logger, routeAnalyticsService{})
+
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+		analytics:     analyticsHandler,
+	})
+	for _, path := range []string{"/api/analytics/consistency", "/api/analytics/training-load", "/api/analytics/plateaus", "/api/analytics/volume"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("GET %s: expected status %d, got %d with body %s", path, http.StatusOK, rr.Code, rr.Body.String())
+		}
+	}
+}
+
+func TestRoutes_RegistersBodyMetrics(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	wh := &workout.WorkoutHandler{}
+	eh := &exercise.ExerciseHandler{}
+	fh := &featureaccess.Handler{}
+	hh := health.NewHandler(logger, nil)
+	ah := aichat.NewHandler(logger, nil)
+	bodyMetricsHandler := bodymetrics.NewHandler(logger, routeBodyMetricsService{})
+
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+		bodyMetrics:   bodyMetricsHandler,
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+		body   string
+	}{
+		{method: http.MethodGet, path: "/api/body-metrics", want: http.StatusOK},
+		{method: http.MethodGet, path: "/api/body-metrics/trend", want: http.StatusOK, body: `"metric":"bodyweight"`},
+		{method: http.MethodGet, path: "/api/body-metrics/7", want: http.StatusOK, body: `"id":7`},
+		{method: http.MethodDelete, path: "/api/body-metrics/7", want: http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != tt.want {
+			t.Fatalf("%s %s: expected status %d, got %d with body %s", tt.method, tt.path, tt.want, rr.Code, rr.Body.String())
+		}
+		if tt.body != "" && !strings.Contains(rr.Body.String(), tt.body) {
+			t.Fatalf("%s %s: expected body to contain %s, got %s", tt.method, tt.path, tt.body, rr.Body.String())
+		}
+	}
+}
+
+func TestRoutes_RegistersStrength(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	wh := &workout.WorkoutHandler{}
+	eh := &exercise.ExerciseHandler{}
+	fh := &featureaccess.Handler{}
+	hh := health.NewHandler(logger, nil)
+	ah := aichat.NewHandler(logger, nil)
+	strengthHandler := strength.NewHandler(logger, routeStrengthService{})
+
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+		strength:      strengthHandler,
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+		body   string
+	}{
+		{method: http.MethodGet, path: "/api/strength/profile", want: http.StatusOK},
+		{method: http.MethodGet, path: "/api/strength/scores", want: http.StatusOK, body: `"missing_inputs":["sex"]`},
+		{method: http.MethodGet, path: "/api/strength/scores/history", want: http.StatusOK, body: `"points":[]`},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != tt.want {
+			t.Fatalf("%s %s: expected status %d, got %d with body %s", tt.method, tt.path, tt.want, rr.Code, rr.Body.String())
+		}
+		if tt.body != "" && !strings.Contains(rr.Body.String(), tt.body) {
+			t.Fatalf("%s %s: expected body to contain %s, got %s", tt.method, tt.path, tt.body, rr.Body.String())
+		}
+	}
+}
+
+func TestRoutes_RegistersReports(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	wh := &workout.WorkoutHandler{}
+	eh := &exercise.ExerciseHandler{}
+	fh := &featureaccess.Handler{}
+	hh := health.NewHandler(logger, nil)
+	ah := aichat.NewHandler(logger, nil)
+	reportHandler := report.NewHandler(logger, routeReportService{})
+
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+		report:        reportHandler,
+	})
+
+	tests := []struct {
+		accept      string
+		contentType string
+		body        string
+	}{
+		{accept: "application/json", contentType: "application/json", body: `"type":"week"`},
+		{accept: "text/markdown", contentType: "text/markdown; charset=utf-8", body: "# Week of June 29, 2026"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/api/reports/week", nil)
+		req.Header.Set("Accept", tt.accept)
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Accept %s: expected status %d, got %d with body %s", tt.accept, http.StatusOK, rr.Code, rr.Body.String())
+		}
+		if got := rr.Header().Get("Content-Type"); got != tt.contentType {
+			t.Fatalf("Accept %s: expected content type %q, got %q", tt.accept, tt.contentType, got)
+		}
+		if !strings.Contains(rr.Body.String(), tt.body) {
+			t.Fatalf("Accept %s: expected body to contain %s, got %s", tt.accept, tt.body, rr.Body.String())
+		}
+	}
+}
+
+func TestRoutes_RegistersCalendar(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	wh := &workout.WorkoutHandler{}
+	eh := &exercise.ExerciseHandler{}
+	fh := &featureaccess.Handler{}
+	hh := health.NewHandler(logger, nil)
+	ah := aichat.NewHandler(logger, nil)
+	calendarHandler := calendar.NewHandler(logger, routeCalendarService{})
+
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+		calendar:      calendarHandler,
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+		body   string
+	}{
+		{method: http.MethodGet, path: "/calendar/secret.ics", want: http.StatusOK, body: "X-TOKEN:secret.ics"},
+		{method: http.MethodGet, path: "/api/calendar/feed", want: http.StatusOK, body: `"enabled":false`},
+		{method: http.MethodPost, path: "/api/calendar/feed/token", want: http.StatusCreated, body: `"token":"token"`},
+		{method: http.MethodDelete, path: "/api/calendar/feed", want: http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != tt.want {
+			t.Fatalf("%s %s: expected status %d, got %d with body %s", tt.method, tt.path, tt.want, rr.Code, rr.Body.String())
+		}
+		if tt.body != "" && !strings.Contains(rr.Body.String(), tt.body) {
+			t.Fatalf("%s %s: expected body to contain %s, got %s", tt.method, tt.path, tt.body, rr.Body.String())
+		}
+	}
+}
+
+func TestRoutes_RegistersWorkoutShares(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	wh := &workout.WorkoutHandler{}
+	eh := &exercise.ExerciseHandler{}
+	fh := &featureaccess.Handler{}
+	hh := health.NewHandler(logger, nil)
+	ah := aichat.NewHandler(logger, nil)
+	shareHandler := share.NewHandler(logger, routeShareService{})
+
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+		share:         shareHandler,
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+		body   string
+	}{
+		{method: http.MethodGet, path: "/s/secret", want: http.StatusOK, body: `"date":"secret"`},
+		{method: http.MethodPost, path: "/api/workouts/7/share", want: http.StatusCreated, body: `"token":"token-7"`},
+		{method: http.MethodDelete, path: "/api/workouts/7/share", want: http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != tt.want {
+			t.Fatalf("%s %s: expected status %d, got %d with body %s", tt.method, tt.path, tt.want, rr.Code, rr.Body.String())
+		}
+		if tt.body != "" && !strings.Contains(rr.Body.String(), tt.body) {
+			t.Fatalf("%s %s: expected body to contain %s, got %s", tt.method, tt.path, tt.body, rr.Body.String())
+		}
+	}
+}
+
+func TestRoutes_RegistersCoaching(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	wh := &workout.WorkoutHandler{}
+	eh := &exercise.ExerciseHandler{}
+	fh := &featureaccess.Handler{}
+	hh := health.NewHandler(logger, nil)
+	ah := aichat.NewHandler(logger, nil)
+	// Without an authenticated user the service rejects every request before
+	// touching the repository, which is enough to prove each route is mounted.
+	coachingHandler := coaching.NewHandler(logger, coaching.NewService(logger, nil))
+
+	mux := api.routes(handlers{
+		workout:       wh,
+		exercise:      eh,
+		featureAccess: fh,
+		health:        hh,
+		aiChat:        ah,
+		coaching:      coachingHandler,
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{method: http.MethodPost, path: "/api/coaching/invitations", body: `{"scopes":["read_workouts"]}`},
+		{method: http.MethodGet, path: "/api/coaching/invitations"},
+		{method: http.MethodPost, path: "/api/coaching/invitations/accept", body: `{"code":"abc"}`},
+		{method: http.MethodGet, path: "/api/coaching/athletes"},
+		{method: http.MethodPost, path: "/api/coaching/athletes/athlete-1/suggestions", body: `{"title":"Day A","exercises":[]}`},
+		{method: http.MethodGet, path: "/api/coaching/coaches"},
+		{method: http.MethodPut, path: "/api/coaching/links/3/scopes", body: `{"scopes":["read_metrics"]}`},
+		{method: http.MethodDelete, path: "/api/coaching/links/3"},
+		{method: http.MethodGet, path: "/api/coaching/suggestions"},
+		{method: http.MethodDelete, path: "/api/coaching/suggestions/5"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusUnauthorized {
+			t.Fatalf("%s %s: expected status %d, got %d with body %s", tt.method, tt.path, http.StatusUnauthorized, rr.Code, rr.Body.String())
+		}
+	}
+}
+
+func TestRoutes_RegistersSearch(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	searchHandler := search.NewHandler(logger, search.NewService(logger, nil))
+	mux := api.routes(handlers{
+		workout:       &workout.WorkoutHandler{},
+		exercise:      &exercise.ExerciseHandler{},
+		featureAccess: &featureaccess.Handler{},
+		health:        health.NewHandler(logger, nil),
+		aiChat:        aichat.NewHandler(logger, nil),
+		search:        searchHandler,
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/search?q=bench", nil)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusUnauthorized {
+		t.Fatalf("GET /api/search: expected status %d, got %d with body %s", http.StatusUnauthorized, rr.Code, rr.Body.String())
+	}
+}
+
+func TestRoutes_RegistersTags(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	tagHandler := tag.NewHandler(logger, tag.NewService(logger, nil))
+	mux := api.routes(handlers{
+		workout:       &workout.WorkoutHandler{},
+		exercise:      &exercise.ExerciseHandler{},
+		featureAccess: &featureaccess.Handler{},
+		health:        health.NewHandler(logger, nil),
+		aiChat:        aichat.NewHandler(logger, nil),
+		tag:           tagHandler,
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{method: http.MethodGet, path: "/api/tags"},
+		{method: http.MethodPost, path: "/api/tags", body: `{"name":"deload"}`},
+		{method: http.MethodPatch, path: "/api/tags/3", body: `{"name":"travel"}`},
+		{method: http.MethodDelete, path: "/api/tags/3"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusUnauthorized {
+			t.Fatalf("%s %s: expected status %d, got %d with body %s", tt.method, tt.path, http.StatusUnauthorized, rr.Code, rr.Body.String())
+		}
+	}
+}
+
+func TestRoutes_RegistersEquipment(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	equipmentHandler := equipment.NewHandler(logger, equipment.NewService(logger, nil))
+	mux := api.routes(handlers{
+		workout:       &workout.WorkoutHandler{},
+		exercise:      &exercise.ExerciseHandler{},
+		featureAccess: &featureaccess.Handler{},
+		health:        health.NewHandler(logger, nil),
+		aiChat:        aichat.NewHandler(logger, nil),
+		equipment:     equipmentHandler,
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{method: http.MethodGet, path: "/api/equipment"},
+		{method: http.MethodPut, path: "/api/equipment", body: `{"barbell_weights":[45],"plates":[{"weight":45,"pairs":2}],"dumbbell_increment":5,"machine_stack_step":10}`},
+		{method: http.MethodGet, path: "/api/tools/plates?weight=225"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusUnauthorized {
+			t.Fatalf("%s %s: expected status %d, got %d with body %s", tt.method, tt.path, http.StatusUnauthorized, rr.Code, rr.Body.String())
+		}
+	}
+}
+
+func TestRoutes_RegistersGoals(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	goalsHandler := goals.NewHandler(logger, goals.NewService(logger, nil))
+	mux := api.routes(handlers{
+		workout:       &workout.WorkoutHandler{},
+		exercise:      &exercise.ExerciseHandler{},
+		featureAccess: &featureaccess.Handler{},
+		health:        health.NewHandler(logger, nil),
+		aiChat:        aichat.NewHandler(logger, nil),
+		goals:         goalsHandler,
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{method: http.MethodGet, path: "/api/goals"},
+		{method: http.MethodPost, path: "/api/goals", body: `{"type":"frequency","target_value":4}`},
+		{method: http.MethodDelete, path: "/api/goals/3"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusUnauthorized {
+			t.Fatalf("%s %s: expected status %d, got %d with body %s", tt.method, tt.path, http.StatusUnauthorized, rr.Code, rr.Body.String())
+		}
+	}
+}
+
+func TestRoutes_RegistersAchievements(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	achievementsHandler := achievements.NewHandler(logger, achievements.NewService(logger, nil))
+	mux := api.routes(handlers{
+		workout:       &workout.WorkoutHandler{},
+		exercise:      &exercise.ExerciseHandler{},
+		featureAccess: &featureaccess.Handler{},
+		health:        health.NewHandler(logger, nil),
+		aiChat:        aichat.NewHandler(logger, nil),
+		achievements:  achievementsHandler,
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/achievements", nil)
+	rr := httptest.NewRecorder()
+
+	mux.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusUnauthorized {
+		t.Fatalf("GET /api/achievements: expected status %d, got %d with body %s", http.StatusUnauthorized, rr.Code, rr.Body.String())
+	}
+}
+
+func TestRoutes_RegistersNotifications(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	notificationsHandler := notifications.NewHandler(logger, notifications.NewService(logger, nil))
+	mux := api.routes(handlers{
+		workout:       &workout.WorkoutHandler{},
+		exercise:      &exercise.ExerciseHandler{},
+		featureAccess: &featureaccess.Handler{},
+		health:        health.NewHandler(logger, nil),
+		aiChat:        aichat.NewHandler(logger, nil),
+		notifications: notificationsHandler,
+	})
+
+	for _, route := range []struct {
+		method string
+		path   string
+	}{
+		{http.MethodGet, "/api/notifications"},
+		{http.MethodGet, "/api/notifications/unread-count"},
+		{http.MethodGet, "/api/notifications/stream"},
+		{http.MethodPost, "/api/notifications/7/read"},
+		{http.MethodPost, "/api/notifications/read-all"},
+	} {
+		req := httptest.NewRequest(route.method, route.path, nil)
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusUnauthorized {
+			t.Fatalf("%s %s: expected status %d, got %d with body %s", route.method, route.path, http.StatusUnauthorized, rr.Code, rr.Body.String())
+		}
+	}
+}
+
 func TestStaticFiles_SetCacheHeadersForPWAUpdates(t *testing.T) {
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -371,3 +1094,82 @@ func writeStaticTestFile(t *testing.T, relativePath string, contents string) {
 		t.Fatalf("write static test file: %v", err)
 	}
 }
+
+func TestRoutes_RegistersWebhooks(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	webhooksHandler := webhooks.NewHandler(logger, webhooks.NewService(logger, nil, nil))
+	mux := api.routes(handlers{
+		workout:       &workout.WorkoutHandler{},
+		exercise:      &exercise.ExerciseHandler{},
+		featureAccess: &featureaccess.Handler{},
+		health:        health.NewHandler(logger, nil),
+		aiChat:        aichat.NewHandler(logger, nil),
+		webhooks:      webhooksHandler,
+	})
+
+	for _, route := range []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodGet, "/api/webhooks", ""},
+		{http.MethodPost, "/api/webhooks", `{}`},
+		{http.MethodPatch, "/api/webhooks/7", `{}`},
+		{http.MethodDelete, "/api/webhooks/7", ""},
+		{http.MethodGet, "/api/webhooks/7/deliveries", ""},
+		{http.MethodPost, "/api/webhooks/7/test", ""},
+	} {
+		req := httptest.NewRequest(route.method, route.path, strings.NewReader(route.body))
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusUnauthorized {
+			t.Fatalf("%s %s: expected status %d, got %d with body %s", route.method, route.path, http.StatusUnauthorized, rr.Code, rr.Body.String())
+		}
+	}
+}
+
+func TestRoutes_RegistersAccessTokens(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := &api{
+		logger: logger,
+		cfg:    &config.Config{},
+		pool:   nil,
+	}
+
+	accessTokensHandler := accesstokens.NewHandler(logger, accesstokens.NewService(logger, nil))
+	mux := api.routes(handlers{
+		workout:       &workout.WorkoutHandler{},
+		exercise:      &exercise.ExerciseHandler{},
+		featureAccess: &featureaccess.Handler{},
+		health:        health.NewHandler(logger, nil),
+		aiChat:        aichat.NewHandler(logger, nil),
+		accessTokens:  accessTokensHandler,
+	})
+
+	for _, route := range []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodGet, "/api/access-tokens", ""},
+		{http.MethodPost, "/api/access-tokens", `{}`},
+		{http.MethodDelete, "/api/access-tokens/7", ""},
+	} {
+		req := httptest.NewRequest(route.method, route.path, strings.NewReader(route.body))
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusUnauthorized {
+			t.Fatalf("%s %s: expected status %d, got %d with body %s", route.method, route.path, http.StatusUnauthorized, rr.Code, rr.Body.String())
+		}
+	}
+}
@@ -2,12 +2,17 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"path"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/accesstokens"
+	"github.com/Andrewy-gh/fittrack/server/internal/coaching"
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	"github.com/Andrewy-gh/fittrack/server/internal/e2eauth"
 	"github.com/Andrewy-gh/fittrack/server/internal/request"
@@ -30,14 +35,128 @@ type JWKSProvider interface {
 
 type UserServiceProvider interface {
 	EnsureUser(ctx context.Context, userID string) (db.Users, error)
+	InferTimezone(ctx context.Context, userID string, timezone string) (string, bool)
+}
+
+// DelegationProvider resolves the scopes an athlete granted a coach, and the
+// athlete's timezone, for requests that carry the X-Act-As header. Scopes are
+// nil when the two are not linked.
+type DelegationProvider interface {
+	ResolveDelegation(ctx context.Context, coachID string, athleteID string) ([]string, string, error)
+}
+
+// AccessTokenResolver resolves a personal access token sent as
+// "Authorization: Bearer <token>" to its owner and scopes. It returns
+// accesstokens.ErrInvalidToken for unknown, expired or revoked tokens.
+type AccessTokenResolver interface {
+	ResolveAccessToken(ctx context.Context, token string) (string, []string, error)
 }
 
 type Authenticator struct {
-	logger      *slog.Logger
-	jwkCache    JWKSProvider
-	userService UserServiceProvider
-	dbPool      db.DBTX
-	localE2E    *LocalE2EAuthConfig
+	logger       *slog.Logger
+	jwkCache     JWKSProvider
+	userService  UserServiceProvider
+	dbPool       db.DBTX
+	localE2E     *LocalE2EAuthConfig
+	delegation   DelegationProvider
+	accessTokens AccessTokenResolver
+}
+
+// delegatedRoutes maps the API sections a coach can read on an athlete's
+// behalf to the scopes the athlete must have granted. Workout, exercise and
+// set rows are only visible to coaches with read_workouts, so sections that
+// derive metrics from sets need it alongside read_metrics.
+var delegatedRoutes = []struct {
+	prefix string
+	scopes []string
+}{
+	{prefix: "/api/workouts", scopes: []string{user.ScopeReadWorkouts}},
+	{prefix: "/api/exercises", scopes: []string{user.ScopeReadWorkouts}},
+	{prefix: "/api/tags", scopes: []string{user.ScopeReadWorkouts}},
+	{prefix: "/api/equipment", scopes: []string{user.ScopeReadWorkouts}},
+	{prefix: "/api/tools", scopes: []string{user.ScopeReadWorkouts}},
+	{prefix: "/api/achievements", scopes: []string{user.ScopeReadWorkouts}},
+	{prefix: "/api/analytics", scopes: []string{user.ScopeReadMetrics, user.ScopeReadWorkouts}},
+	{prefix: "/api/body-metrics", scopes: []string{user.ScopeReadMetrics}},
+	{prefix: "/api/strength", scopes: []string{user.ScopeReadMetrics, user.ScopeReadWorkouts}},
+	{prefix: "/api/reports", scopes: []string{user.ScopeReadMetrics, user.ScopeReadWorkouts}},
+	{prefix: "/api/goals", scopes: []string{user.ScopeReadMetrics, user.ScopeReadWorkouts}},
+}
+
+// accessTokenRoutes lists the requests a personal access token can make and
+// the scopes it needs for each, matched by method and path pattern. Token
+// management, account, billing, coaching, webhook and share routes are
+// deliberately absent, so a leaked token cannot mint more tokens or send the
+// user's data elsewhere. GET routes also match HEAD.
+var accessTokenRoutes = []struct {
+	method  string
+	pattern string
+	scopes  []string
+}{
+	{http.MethodGet, "/api/workouts", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodPost, "/api/workouts", []string{accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodGet, "/api/workouts/{id}", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodPut, "/api/workouts/{id}", []string{accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodDelete, "/api/workouts/{id}", []string{accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodGet, "/api/workouts/new-workout-context", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/workouts/focus-values", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/workouts/contribution-data", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/workouts/compare", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/exercises", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodPost, "/api/exercises", []string{accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodGet, "/api/exercises/metrics-history", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/exercises/{id}", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodPatch, "/api/exercises/{id}", []string{accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodDelete, "/api/exercises/{id}", []string{accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodGet, "/api/exercises/{id}/recent-sets", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/exercises/{id}/metrics-history", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/exercises/{id}/notes", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodPut, "/api/exercises/{id}/notes", []string{accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodPatch, "/api/exercises/{id}/historical-1rm", []string{accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodGet, "/api/tags", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodPost, "/api/tags", []string{accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodPatch, "/api/tags/{id}", []string{accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodDelete, "/api/tags/{id}", []string{accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodGet, "/api/equipment", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodPut, "/api/equipment", []string{accesstokens.ScopeWorkoutsWrite}},
+	// The tools only compute from the request and saved equipment.
+	{http.MethodGet, "/api/tools/plates", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodPost, "/api/tools/warmup", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/achievements", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/analytics/consistency", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/analytics/training-load", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/analytics/plateaus", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/analytics/volume", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/reports/{period}", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/search", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/features/access", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/strength/scores", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/strength/scores/history", []string{accesstokens.ScopeWorkoutsRead}},
+	{http.MethodGet, "/api/strength/profile", []string{accesstokens.ScopeProfileRead}},
+	{http.MethodPut, "/api/strength/profile", []string{accesstokens.ScopeProfileWrite}},
+	{http.MethodGet, "/api/body-metrics", []string{accesstokens.ScopeProfileRead}},
+	{http.MethodPost, "/api/body-metrics", []string{accesstokens.ScopeProfileWrite}},
+	{http.MethodGet, "/api/body-metrics/trend", []string{accesstokens.ScopeProfileRead}},
+	{http.MethodGet, "/api/body-metrics/{id}", []string{accesstokens.ScopeProfileRead}},
+	{http.MethodPut, "/api/body-metrics/{id}", []string{accesstokens.ScopeProfileWrite}},
+	{http.MethodDelete, "/api/body-metrics/{id}", []string{accesstokens.ScopeProfileWrite}},
+	{http.MethodGet, "/api/goals", []string{accesstokens.ScopeProfileRead}},
+	{http.MethodPost, "/api/goals", []string{accesstokens.ScopeProfileWrite}},
+	{http.MethodPatch, "/api/goals/{id}", []string{accesstokens.ScopeProfileWrite}},
+	{http.MethodDelete, "/api/goals/{id}", []string{accesstokens.ScopeProfileWrite}},
+	{http.MethodGet, "/api/training-profile", []string{accesstokens.ScopeProfileRead}},
+	{http.MethodPut, "/api/training-profile", []string{accesstokens.ScopeProfileWrite}},
+	// Deleting all chat history is left to the signed-in app.
+	{http.MethodGet, "/api/ai/conversations", []string{accesstokens.ScopeChatUse}},
+	{http.MethodPost, "/api/ai/conversations", []string{accesstokens.ScopeChatUse}},
+	{http.MethodGet, "/api/ai/conversations/{id}", []string{accesstokens.ScopeChatUse}},
+	{http.MethodDelete, "/api/ai/conversations/{id}", []string{accesstokens.ScopeChatUse}},
+	{http.MethodPost, "/api/ai/conversations/{id}/latest-workout-draft/save", []string{accesstokens.ScopeChatUse, accesstokens.ScopeWorkoutsWrite}},
+	{http.MethodPost, "/api/ai/conversations/{id}/messages/stream", []string{accesstokens.ScopeChatUse}},
+	{http.MethodGet, "/api/ai/conversations/{id}/messages/stream/resume", []string{accesstokens.ScopeChatUse}},
+	{http.MethodPost, "/api/ai/conversations/{id}/messages/recover", []string{accesstokens.ScopeChatUse}},
+	{http.MethodPost, "/api/ai/conversations/{id}/runs/{runID}/stop", []string{accesstokens.ScopeChatUse}},
+	{http.MethodPost, "/api/ai/chat/telemetry", []string{accesstokens.ScopeChatUse}},
 }
 
 type LocalE2EAuthConfig struct {
@@ -62,6 +181,20 @@ func (a *Authenticator) WithLocalE2EAuth(config LocalE2EAuthConfig) *Authenticat
 	return a
 }
 
+// WithDelegation enables the X-Act-As header, letting coaches read the data
+// of athletes who granted them access.
+func (a *Authenticator) WithDelegation(provider DelegationProvider) *Authenticator {
+	a.delegation = provider
+	return a
+}
+
+// WithAccessTokens enables personal access tokens sent as
+// "Authorization: Bearer <token>".
+func (a *Authenticator) WithAccessTokens(resolver AccessTokenResolver) *Authenticator {
+	a.accessTokens = resolver
+	return a
+}
+
 func (a *Authenticator) setSessionUserID(ctx context.Context, userID string) error {
 	if a.dbPool == nil {
 		return nil
@@ -94,7 +227,7 @@ func (a *Authenticator) setSessionUserID(ctx context.Context, userID string) err
 
 func (a *Authenticator) Middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if !strings.HasPrefix(r.URL.Path, "/api/") {
+		if !requiresAuthentication(r.URL.Path) {
 			next.ServeHTTP(w, r)
 			return
 		}
@@ -110,6 +243,11 @@ func (a *Authenticator) Middleware(next http.Handler) http.Handler {
 			return
 		}
 
+		if bearerToken, ok := a.bearerToken(r); ok {
+			a.authenticateAccessToken(w, r, next, bearerToken)
+			return
+		}
+
 		accessToken := r.Header.Get("x-stack-access-token")
 		if accessToken == "" {
 			a.logger.Warn("missing access token", "path", r.URL.Path, "method", r.Method, "request_id", request.GetRequestID(r.Context()))
@@ -135,6 +273,111 @@ func (a *Authenticator) Middleware(next http.Handler) http.Handler {
 	})
 }
 
+// requiresAuthentication reports whether a request path addresses the API.
+// Paths are cleaned first, so dot segments cannot carry a request past this
+// check, and the bare /api path is covered too. Everything public, such as
+// calendar feeds and share links, lives outside /api.
+func requiresAuthentication(requestPath string) bool {
+	cleaned := path.Clean("/" + requestPath)
+	return cleaned == "/api" || strings.HasPrefix(cleaned, "/api/")
+}
+
+// bearerToken returns the personal access token from the Authorization
+// header when access tokens are enabled.
+func (a *Authenticator) bearerToken(r *http.Request) (string, bool) {
+	if a.accessTokens == nil {
+		return "", false
+	}
+	scheme, token, ok := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// authenticateAccessToken authenticates a request made with a personal access
+// token. Tokens only reach accessTokenRoutes, need the matching scope and
+// cannot be combined with X-Act-As.
+func (a *Authenticator) authenticateAccessToken(w http.ResponseWriter, r *http.Request, next http.Handler, token string) {
+	userID, scopes, err := a.accessTokens.ResolveAccessToken(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, accesstokens.ErrInvalidToken) {
+			a.logger.Warn("invalid personal access token", "path", r.URL.Path, "method", r.Method, "request_id", request.GetRequestID(r.Context()))
+			response.ErrorJSON(w, r, a.logger, http.StatusUnauthorized, "invalid access token", nil)
+			return
+		}
+		a.logger.Error("failed to resolve personal access token",
+			"path", r.URL.Path,
+			"method", r.Method,
+			"status", http.StatusInternalServerError,
+			"request_id", request.GetRequestID(r.Context()),
+			"error_category", "database",
+			"error_present", true,
+			"error_type", fmt.Sprintf("%T", err))
+		response.ErrorJSON(w, r, a.logger, http.StatusInternalServerError, "failed to resolve access token", err)
+		return
+	}
+
+	if athleteID := strings.TrimSpace(r.Header.Get(user.ActAsHeader)); athleteID != "" && athleteID != userID {
+		a.logger.Warn("delegated request with personal access token", "userID", userID, "path", r.URL.Path, "request_id", request.GetRequestID(r.Context()))
+		response.ErrorJSON(w, r, a.logger, http.StatusForbidden, "personal access tokens cannot act on behalf of another user", nil)
+		return
+	}
+
+	required, ok := accessTokenScopes(r.Method, r.URL.Path)
+	if !ok {
+		a.logger.Warn("route not available to personal access tokens", "userID", userID, "path", r.URL.Path, "method", r.Method, "request_id", request.GetRequestID(r.Context()))
+		response.ErrorJSON(w, r, a.logger, http.StatusForbidden, "this request cannot be made with a personal access token", nil)
+		return
+	}
+	for _, scope := range required {
+		if !slices.Contains(scopes, scope) {
+			a.logger.Warn("personal access token missing scope", "userID", userID, "path", r.URL.Path, "scope", scope, "request_id", request.GetRequestID(r.Context()))
+			response.ErrorJSON(w, r, a.logger, http.StatusForbidden, "access token does not have the "+scope+" scope", nil)
+			return
+		}
+	}
+
+	a.authenticateUser(w, r, next, userID)
+}
+
+// accessTokenScopes returns the scopes a personal access token needs for a
+// request, or false when tokens cannot make the request at all.
+func accessTokenScopes(method string, requestPath string) ([]string, bool) {
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	segments := strings.Split(path.Clean("/"+requestPath), "/")
+	for _, route := range accessTokenRoutes {
+		if route.method == method && matchesPattern(route.pattern, segments) {
+			return route.scopes, true
+		}
+	}
+	return nil, false
+}
+
+// matchesPattern reports whether the path segments match a route pattern,
+// where a {name} segment matches any one non-empty segment.
+func matchesPattern(pattern string, segments []string) bool {
+	parts := strings.Split(pattern, "/")
+	if len(parts) != len(segments) {
+		return false
+	}
+	for i, part := range parts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if segments[i] == "" {
+				return false
+			}
+			continue
+		}
+		if part != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (a *Authenticator) authenticateUser(w http.ResponseWriter, r *http.Request, next http.Handler, userID string) bool {
 	dbUser, err := a.userService.EnsureUser(r.Context(), userID)
 	if err != nil {
@@ -172,10 +415,95 @@ func (a *Authenticator) authenticateUser(w http.ResponseWriter, r *http.Request,
 	}
 
 	ctx := user.WithContext(r.Context(), dbUser.UserID)
+	timezone := a.resolveTimezone(r, dbUser)
+
+	// The RLS session user stays the coach, so the database only exposes
+	// what the athlete's grants allow even if a service check is missed.
+	if athleteID := strings.TrimSpace(r.Header.Get(user.ActAsHeader)); athleteID != "" && athleteID != dbUser.UserID {
+		delegation, athleteTimezone, ok := a.resolveDelegation(w, r, dbUser.UserID, athleteID)
+		if !ok {
+			return false
+		}
+		ctx = user.WithDelegation(user.WithContext(r.Context(), athleteID), delegation)
+		if athleteTimezone != "" {
+			timezone = athleteTimezone
+		}
+	}
+
+	if timezone != "" {
+		ctx = user.WithTimezone(ctx, timezone)
+	}
 	next.ServeHTTP(w, r.WithContext(ctx))
 	return true
 }
 
+// resolveDelegation checks that the coach may make this request for the
+// athlete. Delegated requests are read-only and limited to delegatedRoutes.
+func (a *Authenticator) resolveDelegation(w http.ResponseWriter, r *http.Request, coachID string, athleteID string) (user.Delegation, string, bool) {
+	required, ok := delegatedScopes(r.Method, r.URL.Path)
+	if a.delegation == nil || !ok {
+		a.logger.Warn("delegated request not allowed", "path", r.URL.Path, "method", r.Method, "request_id", request.GetRequestID(r.Context()))
+		response.ErrorJSON(w, r, a.logger, http.StatusForbidden, "this request cannot be made on behalf of another user", nil)
+		return user.Delegation{}, "", false
+	}
+
+	scopes, timezone, err := a.delegation.ResolveDelegation(r.Context(), coachID, athleteID)
+	if err != nil {
+		a.logger.Error("failed to resolve delegation",
+			"userID", coachID,
+			"path", r.URL.Path,
+			"method", r.Method,
+			"status", http.StatusInternalServerError,
+			"request_id", request.GetRequestID(r.Context()),
+			"error_category", "database",
+			"error_present", true,
+			"error_type", fmt.Sprintf("%T", err))
+		response.ErrorJSON(w, r, a.logger, http.StatusInternalServerError, "failed to resolve delegated access", err)
+		return user.Delegation{}, "", false
+	}
+	for _, scope := range required {
+		if !slices.Contains(scopes, scope) {
+			a.logger.Warn("delegated access not granted", "userID", coachID, "path", r.URL.Path, "scope", scope, "request_id", request.GetRequestID(r.Context()))
+			response.ErrorJSON(w, r, a.logger, http.StatusForbidden, "athlete has not granted "+scope+" access", nil)
+			return user.Delegation{}, "", false
+		}
+	}
+
+	return user.Delegation{ActorID: coachID, Scopes: scopes}, timezone, true
+}
+
+// delegatedScopes returns the scopes a delegated request needs, or false when
+// the request cannot be delegated.
+func delegatedScopes(method string, requestPath string) ([]string, bool) {
+	if method != http.MethodGet && method != http.MethodHead {
+		return nil, false
+	}
+	cleaned := path.Clean("/" + requestPath)
+	for _, route := range delegatedRoutes {
+		if cleaned == route.prefix || strings.HasPrefix(cleaned, route.prefix+"/") {
+			return route.scopes, true
+		}
+	}
+	return nil, false
+}
+
+// resolveTimezone prefers the user's stored timezone and otherwise adopts the
+// client's X-Timezone header, persisting it for later background work.
+func (a *Authenticator) resolveTimezone(r *http.Request, dbUser db.Users) string {
+	if dbUser.Timezone.Valid && strings.TrimSpace(dbUser.Timezone.String) != "" {
+		return dbUser.Timezone.String
+	}
+	headerValue := strings.TrimSpace(r.Header.Get(user.TimezoneHeader))
+	if headerValue == "" {
+		return ""
+	}
+	timezone, ok := a.userService.InferTimezone(r.Context(), dbUser.UserID, headerValue)
+	if !ok {
+		return ""
+	}
+	return timezone
+}
+
 func (a *Authenticator) resolveLocalE2EUserID(r *http.Request) (string, bool, error) {
 	headerValue := strings.TrimSpace(r.Header.Get(e2eauth.DevAuthHeaderName))
 	if headerValue == "" {
@@ -240,4 +568,6 @@ func (j *JWKSCache) GetUserIDFromToken(tokenString string) (string, error) {
 var (
 	_ JWKSProvider        = (*JWKSCache)(nil)
 	_ UserServiceProvider = (*user.Service)(nil)
+	_ DelegationProvider  = (*coaching.Service)(nil)
+	_ AccessTokenResolver = (*accesstokens.Service)(nil)
 )
@@ -14,11 +14,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/accesstokens"
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	"github.com/Andrewy-gh/fittrack/server/internal/response"
 	"github.com/Andrewy-gh/fittrack/server/internal/user"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/lestrrat-go/jwx/v3/jwa"
 	"github.com/lestrrat-go/jwx/v3/jwk"
 	"github.com/lestrrat-go/jwx/v3/jws"
@@ -45,6 +47,11 @@ func (m *MockUserService) EnsureUser(ctx context.Context, userID string) (db.Use
 	return args.Get(0).(db.Users), args.Error(1)
 }
 
+func (m *MockUserService) InferTimezone(ctx context.Context, userID string, timezone string) (string, bool) {
+	args := m.Called(ctx, userID, timezone)
+	return args.String(0), args.Bool(1)
+}
+
 type MockDBTX struct {
 	mock.Mock
 }
@@ -62,6 +69,26 @@ func (m *MockDBTX) QueryRow(ctx context.Context, sql string, args ...interface{}
 	return nil
 }
 
+type MockDelegationProvider struct {
+	mock.Mock
+}
+
+func (m *MockDelegationProvider) ResolveDelegation(ctx context.Context, coachID string, athleteID string) ([]string, string, error) {
+	args := m.Called(ctx, coachID, athleteID)
+	scopes, _ := args.Get(0).([]string)
+	return scopes, args.String(1), args.Error(2)
+}
+
+type MockAccessTokenResolver struct {
+	mock.Mock
+}
+
+func (m *MockAccessTokenResolver) ResolveAccessToken(ctx context.Context, token string) (string, []string, error) {
+	args := m.Called(ctx, token)
+	scopes, _ := args.Get(1).([]string)
+	return args.String(0), scopes, args.Error(2)
+}
+
 func TestAuthenticator_Middleware(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 
@@ -87,6 +114,36 @@ func TestAuthenticator_Middleware(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectContext:  false,
 		},
+		{
+			name:    "bypass public share paths",
+			path:    "/s/share-token",
+			headers: map[string]string{},
+			setupMocks: func(jwkCache *MockJWKSCache, userService *MockUserService) {
+				// No mocks needed as middleware should bypass
+			},
+			expectedStatus: http.StatusOK,
+			expectContext:  false,
+		},
+		{
+			name:    "dot segments cannot reach API paths unauthenticated",
+			path:    "/s/../api/workouts",
+			headers: map[string]string{},
+			setupMocks: func(jwkCache *MockJWKSCache, userService *MockUserService) {
+				// No mocks needed as middleware should return early
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectContext:  false,
+		},
+		{
+			name:    "bare API path requires authentication",
+			path:    "/api",
+			headers: map[string]string{},
+			setupMocks: func(jwkCache *MockJWKSCache, userService *MockUserService) {
+				// No mocks needed as middleware should return early
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectContext:  false,
+		},
 		{
 			name: "successful local e2e authentication",
 			path: "/api/test",
@@ -211,6 +268,541 @@ func TestAuthenticator_Middleware(t *testing.T) {
 	}
 }
 
+func TestAuthenticator_Middleware_Timezone(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name             string
+		storedTimezone   pgtype.Text
+		headerTimezone   string
+		setupMocks       func(userService *MockUserService)
+		expectedTimezone string
+	}{
+		{
+			name:             "stored timezone wins over client header",
+			storedTimezone:   pgtype.Text{String: "Europe/Berlin", Valid: true},
+			headerTimezone:   "America/New_York",
+			setupMocks:       func(userService *MockUserService) {},
+			expectedTimezone: "Europe/Berlin",
+		},
+		{
+			name:           "client header is inferred when no timezone is stored",
+			headerTimezone: "America/New_York",
+			setupMocks: func(userService *MockUserService) {
+				userService.On("InferTimezone", mock.Anything, "user-123", "America/New_York").Return("America/New_York", true)
+			},
+			expectedTimezone: "America/New_York",
+		},
+		{
+			name:           "invalid client header leaves timezone unset",
+			headerTimezone: "Nowhere/Special",
+			setupMocks: func(userService *MockUserService) {
+				userService.On("InferTimezone", mock.Anything, "user-123", "Nowhere/Special").Return("", false)
+			},
+		},
+		{
+			name:       "no stored timezone and no header",
+			setupMocks: func(userService *MockUserService) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockJWKSCache := &MockJWKSCache{}
+			mockUserService := &MockUserService{}
+			mockJWKSCache.On("GetUserIDFromToken", "valid-token").Return("user-123", nil)
+			mockUserService.On("EnsureUser", mock.Anything, "user-123").Return(db.Users{UserID: "user-123", Timezone: tt.storedTimezone}, nil)
+			tt.setupMocks(mockUserService)
+
+			auth := &Authenticator{
+				logger:      logger,
+				jwkCache:    mockJWKSCache,
+				userService: mockUserService,
+			}
+
+			var capturedContext context.Context
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				capturedContext = r.Context()
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest("GET", "/api/test", nil)
+			req.Header.Set("x-stack-access-token", "valid-token")
+			if tt.headerTimezone != "" {
+				req.Header.Set(user.TimezoneHeader, tt.headerTimezone)
+			}
+			w := httptest.NewRecorder()
+
+			auth.Middleware(nextHandler).ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			timezone, ok := user.Timezone(capturedContext)
+			assert.Equal(t, tt.expectedTimezone != "", ok)
+			assert.Equal(t, tt.expectedTimezone, timezone)
+			mockUserService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthenticator_Middleware_Delegation(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name             string
+		method           string
+		path             string
+		actAs            string
+		withProvider     bool
+		setupMocks       func(provider *MockDelegationProvider)
+		expectedStatus   int
+		expectedUserID   string
+		expectedTimezone string
+		expectDelegation bool
+	}{
+		{
+			name:         "coach reads athlete workouts with granted scope",
+			method:       http.MethodGet,
+			path:         "/api/workouts/12",
+			actAs:        "athlete-1",
+			withProvider: true,
+			setupMocks: func(provider *MockDelegationProvider) {
+				provider.On("ResolveDelegation", mock.Anything, "coach-1", "athlete-1").Return([]string{user.ScopeReadWorkouts}, "Asia/Tokyo", nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedUserID:   "athlete-1",
+			expectedTimezone: "Asia/Tokyo",
+			expectDelegation: true,
+		},
+		{
+			name:         "coach without metrics scope is forbidden",
+			method:       http.MethodGet,
+			path:         "/api/body-metrics",
+			actAs:        "athlete-1",
+			withProvider: true,
+			setupMocks: func(provider *MockDelegationProvider) {
+				provider.On("ResolveDelegation", mock.Anything, "coach-1", "athlete-1").Return([]string{user.ScopeReadWorkouts}, "", nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:         "metrics derived from sets need read_workouts too",
+			method:       http.MethodGet,
+			path:         "/api/analytics/consistency",
+			actAs:        "athlete-1",
+			withProvider: true,
+			setupMocks: func(provider *MockDelegationProvider) {
+				provider.On("ResolveDelegation", mock.Anything, "coach-1", "athlete-1").Return([]string{user.ScopeReadMetrics}, "", nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:         "coach with both read scopes reads analytics",
+			method:       http.MethodGet,
+			path:         "/api/analytics/consistency",
+			actAs:        "athlete-1",
+			withProvider: true,
+			setupMocks: func(provider *MockDelegationProvider) {
+				provider.On("ResolveDelegation", mock.Anything, "coach-1", "athlete-1").Return([]string{user.ScopeReadWorkouts, user.ScopeReadMetrics}, "", nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedUserID:   "athlete-1",
+			expectDelegation: true,
+		},
+		{
+			name:         "metrics scope alone reads body metrics",
+			method:       http.MethodGet,
+			path:         "/api/body-metrics",
+			actAs:        "athlete-1",
+			withProvider: true,
+			setupMocks: func(provider *MockDelegationProvider) {
+				provider.On("ResolveDelegation", mock.Anything, "coach-1", "athlete-1").Return([]string{user.ScopeReadMetrics}, "", nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedUserID:   "athlete-1",
+			expectDelegation: true,
+		},
+		{
+			name:         "suggest scope does not allow delegated reads",
+			method:       http.MethodGet,
+			path:         "/api/workouts",
+			actAs:        "athlete-1",
+			withProvider: true,
+			setupMocks: func(provider *MockDelegationProvider) {
+				provider.On("ResolveDelegation", mock.Anything, "coach-1", "athlete-1").Return([]string{user.ScopeSuggestWorkouts}, "", nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:         "unlinked athlete is forbidden",
+			method:       http.MethodGet,
+			path:         "/api/workouts",
+			actAs:        "stranger",
+			withProvider: true,
+			setupMocks: func(provider *MockDelegationProvider) {
+				provider.On("ResolveDelegation", mock.Anything, "coach-1", "stranger").Return(nil, "", nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "writes are never delegated",
+			method:         http.MethodPost,
+			path:           "/api/workouts",
+			actAs:          "athlete-1",
+			withProvider:   true,
+			setupMocks:     func(provider *MockDelegationProvider) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "routes outside delegated sections are forbidden",
+			method:         http.MethodGet,
+			path:           "/api/workouts/../account/timezone",
+			actAs:          "athlete-1",
+			withProvider:   true,
+			setupMocks:     func(provider *MockDelegationProvider) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "header is rejected when delegation is disabled",
+			method:         http.MethodGet,
+			path:           "/api/workouts",
+			actAs:          "athlete-1",
+			setupMocks:     func(provider *MockDelegationProvider) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:         "resolution failure is an internal error",
+			method:       http.MethodGet,
+			path:         "/api/workouts",
+			actAs:        "athlete-1",
+			withProvider: true,
+			setupMocks: func(provider *MockDelegationProvider) {
+				provider.On("ResolveDelegation", mock.Anything, "coach-1", "athlete-1").Return(nil, "", fmt.Errorf("connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "acting as yourself is a normal request",
+			method:         http.MethodPost,
+			path:           "/api/workouts",
+			actAs:          "coach-1",
+			withProvider:   true,
+			setupMocks:     func(provider *MockDelegationProvider) {},
+			expectedStatus: http.StatusOK,
+			expectedUserID: "coach-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockJWKSCache := &MockJWKSCache{}
+			mockUserService := &MockUserService{}
+			mockProvider := &MockDelegationProvider{}
+			mockJWKSCache.On("GetUserIDFromToken", "valid-token").Return("coach-1", nil)
+			mockUserService.On("EnsureUser", mock.Anything, "coach-1").Return(db.Users{UserID: "coach-1"}, nil)
+			tt.setupMocks(mockProvider)
+
+			auth := &Authenticator{
+				logger:      logger,
+				jwkCache:    mockJWKSCache,
+				userService: mockUserService,
+			}
+			if tt.withProvider {
+				auth.WithDelegation(mockProvider)
+			}
+
+			var capturedContext context.Context
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				capturedContext = r.Context()
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("x-stack-access-token", "valid-token")
+			req.Header.Set(user.ActAsHeader, tt.actAs)
+			w := httptest.NewRecorder()
+
+			auth.Middleware(nextHandler).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockProvider.AssertExpectations(t)
+			if tt.expectedStatus != http.StatusOK {
+				assert.Nil(t, capturedContext)
+				return
+			}
+
+			userID, _ := user.Current(capturedContext)
+			assert.Equal(t, tt.expectedUserID, userID)
+			delegation, delegated := user.CurrentDelegation(capturedContext)
+			assert.Equal(t, tt.expectDelegation, delegated)
+			if tt.expectDelegation {
+				assert.Equal(t, "coach-1", delegation.ActorID)
+				timezone, _ := user.Timezone(capturedContext)
+				assert.Equal(t, tt.expectedTimezone, timezone)
+			}
+		})
+	}
+}
+
+func TestAuthenticator_Middleware_AccessTokens(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	readOnly := []string{accesstokens.ScopeWorkoutsRead}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		authorization  string
+		actAs          string
+		withResolver   bool
+		setupMocks     func(resolver *MockAccessTokenResolver)
+		expectedStatus int
+		expectedUserID string
+	}{
+		{
+			name:          "read scope allows reads",
+			method:        http.MethodGet,
+			path:          "/api/workouts",
+			authorization: "Bearer ftpat_read",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_read").Return("user-1", readOnly, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedUserID: "user-1",
+		},
+		{
+			name:          "scheme is case insensitive",
+			method:        http.MethodGet,
+			path:          "/api/exercises/3",
+			authorization: "bearer ftpat_read",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_read").Return("user-1", readOnly, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedUserID: "user-1",
+		},
+		{
+			name:          "read scope cannot write",
+			method:        http.MethodPost,
+			path:          "/api/workouts",
+			authorization: "Bearer ftpat_read",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_read").Return("user-1", readOnly, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "write scope allows writes",
+			method:        http.MethodDelete,
+			path:          "/api/workouts/12",
+			authorization: "Bearer ftpat_write",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_write").Return("user-1", []string{accesstokens.ScopeWorkoutsWrite}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedUserID: "user-1",
+		},
+		{
+			name:          "chat needs the chat scope",
+			method:        http.MethodPost,
+			path:          "/api/ai/conversations",
+			authorization: "Bearer ftpat_read",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_read").Return("user-1", []string{accesstokens.ScopeWorkoutsRead, accesstokens.ScopeWorkoutsWrite}, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "chat scope allows chat",
+			method:        http.MethodPost,
+			path:          "/api/ai/conversations",
+			authorization: "Bearer ftpat_chat",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_chat").Return("user-1", []string{accesstokens.ScopeChatUse}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedUserID: "user-1",
+		},
+		{
+			name:          "saving a chat draft also needs the write scope",
+			method:        http.MethodPost,
+			path:          "/api/ai/conversations/41/latest-workout-draft/save",
+			authorization: "Bearer ftpat_chat",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_chat").Return("user-1", []string{accesstokens.ScopeChatUse}, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "tokens cannot manage tokens",
+			method:        http.MethodPost,
+			path:          "/api/access-tokens",
+			authorization: "Bearer ftpat_all",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_all").Return("user-1", []string{accesstokens.ScopeWorkoutsRead, accesstokens.ScopeWorkoutsWrite, accesstokens.ScopeChatUse}, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "dot segments cannot reach other routes",
+			method:        http.MethodGet,
+			path:          "/api/workouts/../billing/subscription",
+			authorization: "Bearer ftpat_read",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_read").Return("user-1", readOnly, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "tokens cannot act for another user",
+			method:        http.MethodGet,
+			path:          "/api/workouts",
+			authorization: "Bearer ftpat_read",
+			actAs:         "athlete-1",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_read").Return("user-1", readOnly, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "invalid token is unauthorized",
+			method:        http.MethodGet,
+			path:          "/api/workouts",
+			authorization: "Bearer ftpat_revoked",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_revoked").Return("", nil, accesstokens.ErrInvalidToken)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "resolution failure is an internal error",
+			method:        http.MethodGet,
+			path:          "/api/workouts",
+			authorization: "Bearer ftpat_read",
+			withResolver:  true,
+			setupMocks: func(resolver *MockAccessTokenResolver) {
+				resolver.On("ResolveAccessToken", mock.Anything, "ftpat_read").Return("", nil, fmt.Errorf("connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "bearer tokens are ignored when disabled",
+			method:         http.MethodGet,
+			path:           "/api/workouts",
+			authorization:  "Bearer ftpat_read",
+			setupMocks:     func(resolver *MockAccessTokenResolver) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "other schemes are ignored",
+			method:         http.MethodGet,
+			path:           "/api/workouts",
+			authorization:  "Basic dXNlcjpwYXNz",
+			withResolver:   true,
+			setupMocks:     func(resolver *MockAccessTokenResolver) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserService := &MockUserService{}
+			mockResolver := &MockAccessTokenResolver{}
+			mockUserService.On("EnsureUser", mock.Anything, "user-1").Return(db.Users{UserID: "user-1"}, nil)
+			tt.setupMocks(mockResolver)
+
+			auth := &Authenticator{
+				logger:      logger,
+				jwkCache:    &MockJWKSCache{},
+				userService: mockUserService,
+			}
+			if tt.withResolver {
+				auth.WithAccessTokens(mockResolver)
+			}
+
+			var capturedContext context.Context
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				capturedContext = r.Context()
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Authorization", tt.authorization)
+			if tt.actAs != "" {
+				req.Header.Set(user.ActAsHeader, tt.actAs)
+			}
+			w := httptest.NewRecorder()
+
+			auth.Middleware(nextHandler).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockResolver.AssertExpectations(t)
+			if tt.expectedStatus != http.StatusOK {
+				assert.Nil(t, capturedContext)
+				return
+			}
+
+			userID, _ := user.Current(capturedContext)
+			assert.Equal(t, tt.expectedUserID, userID)
+		})
+	}
+}
+
+func TestAccessTokenScopes(t *testing.T) {
+	tests := []struct {
+		method   string
+		path     string
+		expected []string
+	}{
+		{http.MethodGet, "/api/workouts", []string{accesstokens.ScopeWorkoutsRead}},
+		{http.MethodHead, "/api/workouts/12", []string{accesstokens.ScopeWorkoutsRead}},
+		{http.MethodPut, "/api/workouts/12", []string{accesstokens.ScopeWorkoutsWrite}},
+		{http.MethodGet, "/api/workouts/compare", []string{accesstokens.ScopeWorkoutsRead}},
+		{http.MethodPost, "/api/tools/warmup", []string{accesstokens.ScopeWorkoutsRead}},
+		{http.MethodGet, "/api/tools/plates", []string{accesstokens.ScopeWorkoutsRead}},
+		{http.MethodGet, "/api/body-metrics", []string{accesstokens.ScopeProfileRead}},
+		{http.MethodPost, "/api/body-metrics", []string{accesstokens.ScopeProfileWrite}},
+		{http.MethodPut, "/api/body-metrics/5", []string{accesstokens.ScopeProfileWrite}},
+		{http.MethodDelete, "/api/body-metrics/5", []string{accesstokens.ScopeProfileWrite}},
+		{http.MethodPost, "/api/goals", []string{accesstokens.ScopeProfileWrite}},
+		{http.MethodPatch, "/api/goals/3", []string{accesstokens.ScopeProfileWrite}},
+		{http.MethodDelete, "/api/goals/3", []string{accesstokens.ScopeProfileWrite}},
+		{http.MethodPut, "/api/training-profile", []string{accesstokens.ScopeProfileWrite}},
+		{http.MethodPut, "/api/strength/profile", []string{accesstokens.ScopeProfileWrite}},
+		{http.MethodGet, "/api/strength/scores", []string{accesstokens.ScopeWorkoutsRead}},
+		{http.MethodPost, "/api/ai/conversations", []string{accesstokens.ScopeChatUse}},
+		{http.MethodDelete, "/api/ai/conversations/41", []string{accesstokens.ScopeChatUse}},
+		{http.MethodPost, "/api/ai/conversations/41/latest-workout-draft/save", []string{accesstokens.ScopeChatUse, accesstokens.ScopeWorkoutsWrite}},
+		{http.MethodPost, "/api/ai/conversations/41/runs/7/stop", []string{accesstokens.ScopeChatUse}},
+		{http.MethodDelete, "/api/ai/conversations", nil},
+		{http.MethodPost, "/api/workouts/12/share", nil},
+		{http.MethodGet, "/api/workouts/12/sets", nil},
+		{http.MethodPatch, "/api/workouts/12", nil},
+		{http.MethodGet, "/api/webhooks", nil},
+		{http.MethodGet, "/api/access-tokens", nil},
+		{http.MethodGet, "/api/workouts//", []string{accesstokens.ScopeWorkoutsRead}},
+		{http.MethodGet, "/api/workouts/../coaching/athletes", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			scopes, ok := accessTokenScopes(tt.method, tt.path)
+
+			assert.Equal(t, tt.expected != nil, ok)
+			assert.Equal(t, tt.expected, scopes)
+		})
+	}
+}
+
 func TestAuthenticator_Middleware_SessionUserID(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -26,16 +27,20 @@ type Repository interface {
 }
 
 type repository struct {
-	logger  *slog.Logger
-	queries *db.Queries
-	pool    *pgxpool.Pool
+	logger   *slog.Logger
+	queries  *db.Queries
+	pool     *pgxpool.Pool
+	notifier notifications.Producer
 }
 
-func NewRepository(logger *slog.Logger, queries *db.Queries, pool *pgxpool.Pool) Repository {
+// NewRepository returns a billing Repository. notifier may be nil, in which
+// case subscription changes are stored without notifying the user.
+func NewRepository(logger *slog.Logger, queries *db.Queries, pool *pgxpool.Pool, notifier notifications.Producer) Repository {
 	return &repository{
-		logger:  logger,
-		queries: queries,
-		pool:    pool,
+		logger:   logger,
+		queries:  queries,
+		pool:     pool,
+		notifier: notifier,
 	}
 }
 
@@ -137,6 +142,17 @@ func (r *repository) UpsertSubscriptionFromWebhook(ctx context.Context, snapshot
 		return db.StripeSubscriptions{}, fmt.Errorf("upsert stripe customer from subscription: %w", err)
 	}
 
+	var previous *db.StripeSubscriptions
+	if r.notifier != nil {
+		existing, err := qtx.GetStripeSubscription(ctx, snapshot.StripeSubscriptionID)
+		switch {
+		case err == nil:
+			previous = &existing
+		case !errors.Is(err, pgx.ErrNoRows):
+			return db.StripeSubscriptions{}, fmt.Errorf("get stripe subscription before upsert: %w", err)
+		}
+	}
+
 	row, err := qtx.UpsertStripeSubscription(ctx, db.UpsertStripeSubscriptionParams{
 		StripeSubscriptionID: snapshot.StripeSubscriptionID,
 		UserID:               snapshot.UserID,
@@ -179,6 +195,14 @@ func (r *repository) UpsertSubscriptionFromWebhook(ctx context.Context, snapshot
 		}
 	}
 
+	if r.notifier != nil {
+		if n, ok := subscriptionChangeNotification(previous, row); ok {
+			if err := r.notifier.Notify(ctx, qtx, n); err != nil {
+				return db.StripeSubscriptions{}, fmt.Errorf("notify stripe subscription change: %w", err)
+			}
+		}
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return db.StripeSubscriptions{}, fmt.Errorf("commit stripe subscription transaction: %w", err)
 	}
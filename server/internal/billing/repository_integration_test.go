@@ -40,7 +40,7 @@ func TestRepositoryUpsertSubscriptionFromWebhook_SourceScopedFeatureAccess(t *te
 
 	seedLocalE2EFeatureAccess(t, pool, userID)
 
-	repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool)
+	repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool, nil)
 	periodStart := time.Now().UTC().Add(-time.Hour)
 	periodEnd := periodStart.Add(30 * 24 * time.Hour)
 	activeEventCreatedAt := periodStart.Add(time.Minute)
@@ -100,7 +100,7 @@ func TestRepositoryUpsertSubscriptionFromWebhook_GrantExpiresAtCancelAtWhenEarli
 	defer cleanupBillingSourceScopeTest(t, pool, userID, subscriptionID, customerID)
 	seedBillingUser(t, pool, userID)
 
-	repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool)
+	repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool, nil)
 	periodStart := time.Now().UTC().Add(-time.Hour)
 	cancelAt := periodStart.Add(24 * time.Hour)
 	periodEnd := periodStart.Add(30 * 24 * time.Hour)
@@ -163,7 +163,7 @@ func TestRepositoryUpsertSubscriptionFromWebhook_IgnoresOlderGrantingEvents(t *t
 			defer cleanupBillingSourceScopeTest(t, pool, userID, subscriptionID, customerID)
 			seedBillingUser(t, pool, userID)
 
-			repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool)
+			repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool, nil)
 			periodStart := time.Now().UTC().Add(-time.Hour)
 			periodEnd := periodStart.Add(30 * 24 * time.Hour)
 			olderEventCreatedAt := periodStart.Add(time.Minute)
@@ -221,7 +221,7 @@ func TestRepositoryUpsertSubscriptionFromWebhook_AppliesSameSecondEvent(t *testi
 	defer cleanupBillingSourceScopeTest(t, pool, userID, subscriptionID, customerID)
 	seedBillingUser(t, pool, userID)
 
-	repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool)
+	repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool, nil)
 	periodStart := time.Now().UTC().Add(-time.Hour)
 	periodEnd := periodStart.Add(30 * 24 * time.Hour)
 	eventCreatedAt := periodStart.Add(time.Minute).Truncate(time.Second)
@@ -293,7 +293,7 @@ func TestRepositoryUpsertSubscriptionFromWebhook_SameSecondRevocationBeatsGranti
 			defer cleanupBillingSourceScopeTest(t, pool, userID, subscriptionID, customerID)
 			seedBillingUser(t, pool, userID)
 
-			repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool)
+			repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool, nil)
 			periodStart := time.Now().UTC().Add(-time.Hour)
 			periodEnd := periodStart.Add(30 * 24 * time.Hour)
 			eventCreatedAt := periodStart.Add(time.Minute).Truncate(time.Second)
@@ -372,7 +372,7 @@ func TestRepositoryUpsertSubscriptionFromWebhook_DoesNotGrantWithoutPremiumPrice
 			defer cleanupBillingSourceScopeTest(t, pool, tt.userID, tt.subscription, tt.customerID)
 			seedBillingUser(t, pool, tt.userID)
 
-			repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool)
+			repo := NewRepository(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(pool), pool, nil)
 			periodStart := time.Now().UTC().Add(-time.Hour)
 			periodEnd := periodStart.Add(30 * 24 * time.Hour)
 			eventCreatedAt := periodStart.Add(time.Minute)
@@ -421,7 +421,7 @@ func TestServiceHandleWebhook_ConcurrentSameEventLeavesOneActiveStripeGrant(t *t
 
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	repo := &concurrentWebhookRepository{
-		Repository: NewRepository(logger, db.New(pool), pool),
+		Repository: NewRepository(logger, db.New(pool), pool, nil),
 		targetID:   eventID,
 		ready:      make(chan struct{}, 2),
 		release:    make(chan struct{}),
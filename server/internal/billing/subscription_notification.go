@@ -0,0 +1,61 @@
+package billing
+
+import (
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
+)
+
+const notificationDateLayout = "Jan 2, 2006"
+
+// subscriptionChangeNotification describes a subscription change for the
+// user's inbox. previous is the stored row before the webhook was applied, or
+// nil for a new subscription. Webhooks that leave the status and scheduled
+// cancellation unchanged, such as renewals, are not worth a notification.
+func subscriptionChangeNotification(previous *db.StripeSubscriptions, current db.StripeSubscriptions) (notifications.Notification, bool) {
+	if previous != nil &&
+		previous.Status == current.Status &&
+		subscriptionCancelScheduled(*previous) == subscriptionCancelScheduled(current) {
+		return notifications.Notification{}, false
+	}
+
+	n := notifications.Notification{
+		UserID: current.UserID,
+		Kind:   notifications.KindSubscriptionChanged,
+		Data: map[string]any{
+			"stripe_subscription_id": current.StripeSubscriptionID,
+			"status":                 current.Status,
+		},
+	}
+	accessEnd := subscriptionAccessEnd(timePtrFromPg(current.CancelAt), timePtrFromPg(current.CurrentPeriodEnd))
+
+	switch {
+	case statusAllowsAccess(current.Status) && subscriptionCancelScheduled(current):
+		n.Title = "Your premium subscription will end"
+		n.Body = "It will not renew."
+		if accessEnd != nil {
+			n.Body = "Premium access continues until " + accessEnd.Format(notificationDateLayout) + "."
+		}
+	case current.Status == subscriptionStatusTrialing:
+		n.Title = "Your premium trial has started"
+		n.Body = "AI chat is included during the trial."
+		if trialEnd := timePtrFromPg(current.TrialEnd); trialEnd != nil {
+			n.Body = "AI chat is included until " + trialEnd.Format(notificationDateLayout) + "."
+		}
+	case current.Status == subscriptionStatusActive:
+		n.Title = "Your premium subscription is active"
+		n.Body = "It renews automatically."
+	case current.Status == subscriptionStatusPastDue || current.Status == subscriptionStatusUnpaid:
+		n.Title = "Your subscription payment failed"
+		n.Body = "Update your payment method to keep premium access."
+	case current.Status == subscriptionStatusPaused:
+		n.Title = "Your premium subscription is paused"
+		n.Body = "Premium features are unavailable until it resumes."
+	case current.Status == subscriptionStatusCanceled || current.Status == subscriptionStatusIncompleteExpired:
+		n.Title = "Your premium subscription has ended"
+		n.Body = "You can subscribe again from billing settings."
+	default:
+		// incomplete: checkout has not finished, so there is nothing to tell.
+		return notifications.Notification{}, false
+	}
+	return n, true
+}
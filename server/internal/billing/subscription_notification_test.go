@@ -0,0 +1,84 @@
+package billing
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func subscriptionRow(status string, cancelAtPeriodEnd bool) db.StripeSubscriptions {
+	return db.StripeSubscriptions{
+		StripeSubscriptionID: "sub_123",
+		UserID:               "user-1",
+		StripeCustomerID:     "cus_123",
+		Status:               status,
+		CancelAtPeriodEnd:    cancelAtPeriodEnd,
+		CurrentPeriodEnd:     pgtype.Timestamptz{Time: time.Date(2026, 11, 19, 0, 0, 0, 0, time.UTC), Valid: true},
+	}
+}
+
+func TestSubscriptionChangeNotification_NewActiveSubscription(t *testing.T) {
+	n, ok := subscriptionChangeNotification(nil, subscriptionRow(subscriptionStatusActive, false))
+
+	require.True(t, ok)
+	assert.Equal(t, "user-1", n.UserID)
+	assert.Equal(t, notifications.KindSubscriptionChanged, n.Kind)
+	assert.Equal(t, "Your premium subscription is active", n.Title)
+	assert.Equal(t, "sub_123", n.Data["stripe_subscription_id"])
+	assert.Equal(t, subscriptionStatusActive, n.Data["status"])
+}
+
+func TestSubscriptionChangeNotification_SkipsUnchangedState(t *testing.T) {
+	previous := subscriptionRow(subscriptionStatusActive, false)
+	current := subscriptionRow(subscriptionStatusActive, false)
+	current.CurrentPeriodEnd = pgtype.Timestamptz{Time: time.Date(2026, 12, 19, 0, 0, 0, 0, time.UTC), Valid: true}
+
+	_, ok := subscriptionChangeNotification(&previous, current)
+
+	assert.False(t, ok)
+}
+
+func TestSubscriptionChangeNotification_ScheduledCancellationIncludesAccessEnd(t *testing.T) {
+	previous := subscriptionRow(subscriptionStatusActive, false)
+
+	n, ok := subscriptionChangeNotification(&previous, subscriptionRow(subscriptionStatusActive, true))
+
+	require.True(t, ok)
+	assert.Equal(t, "Your premium subscription will end", n.Title)
+	assert.Equal(t, "Premium access continues until Nov 19, 2026.", n.Body)
+}
+
+func TestSubscriptionChangeNotification_StatusTitles(t *testing.T) {
+	tests := []struct {
+		status string
+		title  string
+	}{
+		{subscriptionStatusTrialing, "Your premium trial has started"},
+		{subscriptionStatusPastDue, "Your subscription payment failed"},
+		{subscriptionStatusUnpaid, "Your subscription payment failed"},
+		{subscriptionStatusPaused, "Your premium subscription is paused"},
+		{subscriptionStatusCanceled, "Your premium subscription has ended"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			previous := subscriptionRow(subscriptionStatusActive, false)
+
+			n, ok := subscriptionChangeNotification(&previous, subscriptionRow(tt.status, false))
+
+			require.True(t, ok)
+			assert.Equal(t, tt.title, n.Title)
+		})
+	}
+}
+
+func TestSubscriptionChangeNotification_SkipsIncompleteCheckout(t *testing.T) {
+	_, ok := subscriptionChangeNotification(nil, subscriptionRow(subscriptionStatusIncomplete, false))
+
+	assert.False(t, ok)
+}
@@ -0,0 +1,248 @@
+package bodymetrics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type bodyMetricsService interface {
+	List(ctx context.Context, opts ListOptions) ([]EntryResponse, error)
+	Get(ctx context.Context, id int32) (*EntryResponse, error)
+	Create(ctx context.Context, req EntryRequest) (*EntryResponse, error)
+	Update(ctx context.Context, id int32, req EntryRequest) (*EntryResponse, error)
+	Delete(ctx context.Context, id int32) error
+	Trend(ctx context.Context, opts TrendOptions) (*TrendResponse, error)
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service bodyMetricsService
+}
+
+func NewHandler(logger *slog.Logger, service bodyMetricsService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// ListEntries godoc
+// @Summary List body metric entries
+// @Description Returns the authenticated user's bodyweight, body fat and girth entries, newest first.
+// @Tags body-metrics
+// @Produce json
+// @Security StackAuth
+// @Param from query string false "Earliest measured date (YYYY-MM-DD)"
+// @Param to query string false "Latest measured date (YYYY-MM-DD)"
+// @Param limit query int false "Maximum entries to return" default(50) minimum(1) maximum(366)
+// @Success 200 {array} bodymetrics.EntryResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /body-metrics [get]
+func (h *Handler) ListEntries(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	opts := ListOptions{From: query.Get("from"), To: query.Get("to")}
+
+	var err error
+	if opts.Limit, err = intQueryParam(query.Get("limit"), defaultListLimit); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "limit must be an integer", err)
+		return
+	}
+
+	entries, err := h.service.List(r.Context(), opts)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list body metric entries")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, entries); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// GetEntry godoc
+// @Summary Get body metric entry
+// @Description Returns one body metric entry owned by the authenticated user.
+// @Tags body-metrics
+// @Produce json
+// @Security StackAuth
+// @Param id path int true "Body metric entry ID"
+// @Success 200 {object} bodymetrics.EntryResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /body-metrics/{id} [get]
+func (h *Handler) GetEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeEntryID(w, r)
+	if !ok {
+		return
+	}
+
+	entry, err := h.service.Get(r.Context(), id)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get body metric entry")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, entry); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// CreateEntry godoc
+// @Summary Create body metric entry
+// @Description Records bodyweight, body fat and/or girth measurements for a date. At least one measurement is required.
+// @Tags body-metrics
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param request body bodymetrics.EntryRequest true "Body metric entry"
+// @Success 201 {object} bodymetrics.EntryResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /body-metrics [post]
+func (h *Handler) CreateEntry(w http.ResponseWriter, r *http.Request) {
+	var req EntryRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	entry, err := h.service.Create(r.Context(), req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to create body metric entry")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusCreated, entry); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// UpdateEntry godoc
+// @Summary Update body metric entry
+// @Description Replaces a body metric entry with the submitted measurements.
+// @Tags body-metrics
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param id path int true "Body metric entry ID"
+// @Param request body bodymetrics.EntryRequest true "Body metric entry"
+// @Success 200 {object} bodymetrics.EntryResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /body-metrics/{id} [put]
+func (h *Handler) UpdateEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeEntryID(w, r)
+	if !ok {
+		return
+	}
+
+	var req EntryRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	entry, err := h.service.Update(r.Context(), id, req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to update body metric entry")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, entry); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// DeleteEntry godoc
+// @Summary Delete body metric entry
+// @Description Deletes one body metric entry owned by the authenticated user.
+// @Tags body-metrics
+// @Security StackAuth
+// @Param id path int true "Body metric entry ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /body-metrics/{id} [delete]
+func (h *Handler) DeleteEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeEntryID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		h.writeServiceError(w, r, err, "failed to delete body metric entry")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetTrend godoc
+// @Summary Get body metric trend
+// @Description Returns per-day values for one metric with a trailing moving average, ending today in the account timezone.
+// @Tags body-metrics
+// @Produce json
+// @Security StackAuth
+// @Param metric query string false "Metric to chart" Enums(bodyweight, body_fat_percent, neck, chest, waist, hips, arm, thigh) default(bodyweight)
+// @Param days query int false "Number of days to include" default(90) minimum(1) maximum(730)
+// @Param window query int false "Moving average window in days" default(7) minimum(1) maximum(30)
+// @Success 200 {object} bodymetrics.TrendResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /body-metrics/trend [get]
+func (h *Handler) GetTrend(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	opts := TrendOptions{Metric: query.Get("metric")}
+
+	var err error
+	if opts.Days, err = intQueryParam(query.Get("days"), defaultTrendDays); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "days must be an integer", err)
+		return
+	}
+	if opts.WindowDays, err = intQueryParam(query.Get("window"), defaultTrendWindowDays); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "window must be an integer", err)
+		return
+	}
+
+	trend, err := h.service.Trend(r.Context(), opts)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get body metric trend")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, trend); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errNotFound *apperrors.NotFound
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errNotFound):
+		response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
@@ -0,0 +1,40 @@
+package bodymetrics
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/request"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+const maxBodyMetricsJSONBodyBytes = 4 << 10
+
+func (h *Handler) decodeEntryID(w http.ResponseWriter, r *http.Request) (int32, bool) {
+	raw := strings.TrimSpace(r.PathValue("id"))
+	if raw == "" {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Missing body metric entry ID", nil)
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || parsed <= 0 {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Invalid body metric entry ID", err)
+		return 0, false
+	}
+
+	return int32(parsed), true
+}
+
+func decodeStrictJSON(w http.ResponseWriter, r *http.Request, dst any) error {
+	return request.DecodeStrictJSON(w, r, dst, maxBodyMetricsJSONBodyBytes)
+}
+
+func intQueryParam(raw string, fallback int) (int, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return fallback, nil
+	}
+	return strconv.Atoi(raw)
+}
@@ -0,0 +1,207 @@
+package bodymetrics
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubBodyMetricsService struct {
+	entries   []EntryResponse
+	entry     *EntryResponse
+	trend     *TrendResponse
+	err       error
+	listOpts  ListOptions
+	trendOpts TrendOptions
+	request   EntryRequest
+	id        int32
+}
+
+func (s *stubBodyMetricsService) List(_ context.Context, opts ListOptions) ([]EntryResponse, error) {
+	s.listOpts = opts
+	return s.entries, s.err
+}
+
+func (s *stubBodyMetricsService) Get(_ context.Context, id int32) (*EntryResponse, error) {
+	s.id = id
+	return s.entry, s.err
+}
+
+func (s *stubBodyMetricsService) Create(_ context.Context, req EntryRequest) (*EntryResponse, error) {
+	s.request = req
+	return s.entry, s.err
+}
+
+func (s *stubBodyMetricsService) Update(_ context.Context, id int32, req EntryRequest) (*EntryResponse, error) {
+	s.id = id
+	s.request = req
+	return s.entry, s.err
+}
+
+func (s *stubBodyMetricsService) Delete(_ context.Context, id int32) error {
+	s.id = id
+	return s.err
+}
+
+func (s *stubBodyMetricsService) Trend(_ context.Context, opts TrendOptions) (*TrendResponse, error) {
+	s.trendOpts = opts
+	return s.trend, s.err
+}
+
+func newBodyMetricsRequest(method string, target string, body string, id string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if id != "" {
+		req.SetPathValue("id", id)
+	}
+	return req
+}
+
+func TestHandlerListEntries(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("parses filters", func(t *testing.T) {
+		service := &stubBodyMetricsService{entries: []EntryResponse{}}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.ListEntries(rr, newBodyMetricsRequest(http.MethodGet, "/api/body-metrics?from=2026-06-01&to=2026-07-01&limit=10", "", ""))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, ListOptions{From: "2026-06-01", To: "2026-07-01", Limit: 10}, service.listOpts)
+		assert.JSONEq(t, `[]`, rr.Body.String())
+	})
+
+	t.Run("rejects non-integer limit", func(t *testing.T) {
+		handler := NewHandler(logger, &stubBodyMetricsService{})
+		rr := httptest.NewRecorder()
+
+		handler.ListEntries(rr, newBodyMetricsRequest(http.MethodGet, "/api/body-metrics?limit=lots", "", ""))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandlerCreateEntry(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("creates entry", func(t *testing.T) {
+		bodyweight := 182.4
+		service := &stubBodyMetricsService{entry: &EntryResponse{ID: 3, MeasuredOn: "2026-07-01", Bodyweight: &bodyweight}}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.CreateEntry(rr, newBodyMetricsRequest(http.MethodPost, "/api/body-metrics", `{"measured_on":"2026-07-01","bodyweight":182.4}`, ""))
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		require.NotNil(t, service.request.Bodyweight)
+		assert.Equal(t, 182.4, *service.request.Bodyweight)
+		assert.Contains(t, rr.Body.String(), `"bodyweight":182.4`)
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		handler := NewHandler(logger, &stubBodyMetricsService{})
+		rr := httptest.NewRecorder()
+
+		handler.CreateEntry(rr, newBodyMetricsRequest(http.MethodPost, "/api/body-metrics", `{"measured_on":"2026-07-01","weight":182}`, ""))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps validation errors to bad request", func(t *testing.T) {
+		handler := NewHandler(logger, &stubBodyMetricsService{err: &ValidationError{Message: "at least one measurement is required"}})
+		rr := httptest.NewRecorder()
+
+		handler.CreateEntry(rr, newBodyMetricsRequest(http.MethodPost, "/api/body-metrics", `{"measured_on":"2026-07-01"}`, ""))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "at least one measurement is required")
+	})
+}
+
+func TestHandlerEntryByID(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("rejects invalid id", func(t *testing.T) {
+		handler := NewHandler(logger, &stubBodyMetricsService{})
+		rr := httptest.NewRecorder()
+
+		handler.GetEntry(rr, newBodyMetricsRequest(http.MethodGet, "/api/body-metrics/abc", "", "abc"))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps not found", func(t *testing.T) {
+		service := &stubBodyMetricsService{err: &apperrors.NotFound{Resource: "body metric entry", ID: "9"}}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.UpdateEntry(rr, newBodyMetricsRequest(http.MethodPut, "/api/body-metrics/9", `{"measured_on":"2026-07-01","waist":33}`, "9"))
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Equal(t, int32(9), service.id)
+	})
+
+	t.Run("deletes entry", func(t *testing.T) {
+		service := &stubBodyMetricsService{}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.DeleteEntry(rr, newBodyMetricsRequest(http.MethodDelete, "/api/body-metrics/4", "", "4"))
+
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, int32(4), service.id)
+	})
+
+	t.Run("maps unauthorized", func(t *testing.T) {
+		handler := NewHandler(logger, &stubBodyMetricsService{err: &apperrors.Unauthorized{Resource: "body metrics"}})
+		rr := httptest.NewRecorder()
+
+		handler.DeleteEntry(rr, newBodyMetricsRequest(http.MethodDelete, "/api/body-metrics/4", "", "4"))
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestHandlerGetTrend(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("parses trend options", func(t *testing.T) {
+		service := &stubBodyMetricsService{trend: &TrendResponse{Metric: MetricWaist, Points: []TrendPoint{}}}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.GetTrend(rr, newBodyMetricsRequest(http.MethodGet, "/api/body-metrics/trend?metric=waist&days=30&window=3", "", ""))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, TrendOptions{Metric: "waist", Days: 30, WindowDays: 3}, service.trendOpts)
+	})
+
+	t.Run("uses defaults", func(t *testing.T) {
+		service := &stubBodyMetricsService{trend: &TrendResponse{Points: []TrendPoint{}}}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.GetTrend(rr, newBodyMetricsRequest(http.MethodGet, "/api/body-metrics/trend", "", ""))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, defaultTrendDays, service.trendOpts.Days)
+		assert.Equal(t, defaultTrendWindowDays, service.trendOpts.WindowDays)
+	})
+
+	t.Run("rejects non-integer window", func(t *testing.T) {
+		handler := NewHandler(logger, &stubBodyMetricsService{})
+		rr := httptest.NewRecorder()
+
+		handler.GetTrend(rr, newBodyMetricsRequest(http.MethodGet, "/api/body-metrics/trend?window=week", "", ""))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
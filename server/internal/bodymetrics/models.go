@@ -0,0 +1,150 @@
+package bodymetrics
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	bodyMetricsDateLayout  = "2006-01-02"
+	defaultListLimit       = 50
+	maxListLimit           = 366
+	defaultTrendDays       = 90
+	maxTrendDays           = 730
+	defaultTrendWindowDays = 7
+	maxTrendWindowDays     = 30
+	maxBodyweight          = 1500
+	minBodyFatPercent      = 1
+	maxBodyFatPercent      = 75
+	maxGirth               = 300
+	maxNotesLength         = 256
+)
+
+const (
+	MetricBodyweight     = "bodyweight"
+	MetricBodyFatPercent = "body_fat_percent"
+	MetricNeck           = "neck"
+	MetricChest          = "chest"
+	MetricWaist          = "waist"
+	MetricHips           = "hips"
+	MetricArm            = "arm"
+	MetricThigh          = "thigh"
+)
+
+// EntryRequest records one dated set of body measurements. Weights and girths
+// are stored in whatever units the user logs lifts in; at least one
+// measurement is required.
+type EntryRequest struct {
+	MeasuredOn     string   `json:"measured_on" example:"2026-07-01"`
+	Bodyweight     *float64 `json:"bodyweight" example:"182.4"`
+	BodyFatPercent *float64 `json:"body_fat_percent" example:"16.5"`
+	Neck           *float64 `json:"neck"`
+	Chest          *float64 `json:"chest"`
+	Waist          *float64 `json:"waist"`
+	Hips           *float64 `json:"hips"`
+	Arm            *float64 `json:"arm"`
+	Thigh          *float64 `json:"thigh"`
+	Notes          *string  `json:"notes"`
+}
+
+type EntryResponse struct {
+	ID             int32     `json:"id"`
+	MeasuredOn     string    `json:"measured_on" example:"2026-07-01"`
+	Bodyweight     *float64  `json:"bodyweight"`
+	BodyFatPercent *float64  `json:"body_fat_percent"`
+	Neck           *float64  `json:"neck"`
+	Chest          *float64  `json:"chest"`
+	Waist          *float64  `json:"waist"`
+	Hips           *float64  `json:"hips"`
+	Arm            *float64  `json:"arm"`
+	Thigh          *float64  `json:"thigh"`
+	Notes          *string   `json:"notes"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type ListOptions struct {
+	From  string
+	To    string
+	Limit int
+}
+
+type TrendOptions struct {
+	Metric     string
+	Days       int
+	WindowDays int
+}
+
+type TrendPoint struct {
+	Date          string  `json:"date" example:"2026-07-01"`
+	Value         float64 `json:"value"`
+	MovingAverage float64 `json:"moving_average"`
+}
+
+// TrendResponse reports one value per measured day (the mean of that day's
+// entries) alongside a trailing moving average over WindowDays calendar days.
+type TrendResponse struct {
+	Metric              string       `json:"metric"`
+	Days                int          `json:"days"`
+	WindowDays          int          `json:"window_days"`
+	Points              []TrendPoint `json:"points"`
+	Latest              *float64     `json:"latest"`
+	LatestMovingAverage *float64     `json:"latest_moving_average"`
+	MovingAverageChange *float64     `json:"moving_average_change"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// entryValues is a validated EntryRequest ready to be written.
+type entryValues struct {
+	MeasuredOn     time.Time
+	Bodyweight     *float64
+	BodyFatPercent *float64
+	Neck           *float64
+	Chest          *float64
+	Waist          *float64
+	Hips           *float64
+	Arm            *float64
+	Thigh          *float64
+	Notes          *string
+}
+
+type listParams struct {
+	From  *time.Time
+	To    *time.Time
+	Limit int
+}
+
+// metricValue returns the requested measurement from an entry, if recorded.
+func metricValue(entry EntryResponse, metric string) *float64 {
+	switch metric {
+	case MetricBodyweight:
+		return entry.Bodyweight
+	case MetricBodyFatPercent:
+		return entry.BodyFatPercent
+	case MetricNeck:
+		return entry.Neck
+	case MetricChest:
+		return entry.Chest
+	case MetricWaist:
+		return entry.Waist
+	case MetricHips:
+		return entry.Hips
+	case MetricArm:
+		return entry.Arm
+	case MetricThigh:
+		return entry.Thigh
+	default:
+		return nil
+	}
+}
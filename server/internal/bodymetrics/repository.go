@@ -0,0 +1,270 @@
+package bodymetrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	List(ctx context.Context, userID string, params listParams) ([]EntryResponse, error)
+	ListSince(ctx context.Context, userID string, since time.Time) ([]EntryResponse, error)
+	Get(ctx context.Context, userID string, id int32) (*EntryResponse, error)
+	Create(ctx context.Context, userID string, values entryValues) (*EntryResponse, error)
+	Update(ctx context.Context, userID string, id int32, values entryValues) (*EntryResponse, error)
+	Delete(ctx context.Context, userID string, id int32) error
+}
+
+var ErrEntryNotFound = errors.New("body metric entry not found")
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	conn    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		conn:    conn,
+	}
+}
+
+func (r *repository) List(ctx context.Context, userID string, params listParams) ([]EntryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListBodyMetricEntries(ctx, db.ListBodyMetricEntriesParams{
+		UserID:    userID,
+		StartDate: optionalDate(params.From),
+		EndDate:   optionalDate(params.To),
+		RowLimit:  int32(params.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list body metric entries: %w", err)
+	}
+	return entryResponsesFromRows(rows)
+}
+
+func (r *repository) ListSince(ctx context.Context, userID string, since time.Time) ([]EntryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListBodyMetricEntriesSince(ctx, db.ListBodyMetricEntriesSinceParams{
+		UserID:     userID,
+		MeasuredOn: pgtype.Date{Time: since, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list body metric entries since %s: %w", since.Format(bodyMetricsDateLayout), err)
+	}
+	return entryResponsesFromRows(rows)
+}
+
+func (r *repository) Get(ctx context.Context, userID string, id int32) (*EntryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	row, err := r.queries.GetBodyMetricEntry(ctx, db.GetBodyMetricEntryParams{ID: id, UserID: userID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrEntryNotFound
+		}
+		return nil, fmt.Errorf("get body metric entry: %w", err)
+	}
+	return entryResponseFromRow(row)
+}
+
+func (r *repository) Create(ctx context.Context, userID string, values entryValues) (*EntryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	params, err := numericParams(values)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.queries.CreateBodyMetricEntry(ctx, db.CreateBodyMetricEntryParams{
+		UserID:         userID,
+		MeasuredOn:     pgtype.Date{Time: values.MeasuredOn, Valid: true},
+		Bodyweight:     params[0],
+		BodyFatPercent: params[1],
+		Neck:           params[2],
+		Chest:          params[3],
+		Waist:          params[4],
+		Hips:           params[5],
+		Arm:            params[6],
+		Thigh:          params[7],
+		Notes:          optionalText(values.Notes),
+	})
+	if err != nil {
+		r.logger.Error("database error creating body metric entry", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("create body metric entry: %w", err)
+	}
+	return entryResponseFromRow(row)
+}
+
+func (r *repository) Update(ctx context.Context, userID string, id int32, values entryValues) (*EntryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	params, err := numericParams(values)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.queries.UpdateBodyMetricEntry(ctx, db.UpdateBodyMetricEntryParams{
+		ID:             id,
+		UserID:         userID,
+		MeasuredOn:     pgtype.Date{Time: values.MeasuredOn, Valid: true},
+		Bodyweight:     params[0],
+		BodyFatPercent: params[1],
+		Neck:           params[2],
+		Chest:          params[3],
+		Waist:          params[4],
+		Hips:           params[5],
+		Arm:            params[6],
+		Thigh:          params[7],
+		Notes:          optionalText(values.Notes),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrEntryNotFound
+		}
+		r.logger.Error("database error updating body metric entry", "error", err, "user_id", userID, "entry_id", id)
+		return nil, fmt.Errorf("update body metric entry: %w", err)
+	}
+	return entryResponseFromRow(row)
+}
+
+func (r *repository) Delete(ctx context.Context, userID string, id int32) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rowsAffected, err := r.queries.DeleteBodyMetricEntry(ctx, db.DeleteBodyMetricEntryParams{ID: id, UserID: userID})
+	if err != nil {
+		r.logger.Error("database error deleting body metric entry", "error", err, "user_id", userID, "entry_id", id)
+		return fmt.Errorf("delete body metric entry: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrEntryNotFound
+	}
+	return nil
+}
+
+func entryResponsesFromRows(rows []db.BodyMetricEntry) ([]EntryResponse, error) {
+	entries := make([]EntryResponse, 0, len(rows))
+	for _, row := range rows {
+		entry, err := entryResponseFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+func entryResponseFromRow(row db.BodyMetricEntry) (*EntryResponse, error) {
+	entry := &EntryResponse{
+		ID:        row.ID,
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+	if row.MeasuredOn.Valid {
+		entry.MeasuredOn = row.MeasuredOn.Time.Format(bodyMetricsDateLayout)
+	}
+	if row.Notes.Valid {
+		notes := row.Notes.String
+		entry.Notes = &notes
+	}
+
+	targets := []struct {
+		dst   **float64
+		value pgtype.Numeric
+	}{
+		{&entry.Bodyweight, row.Bodyweight},
+		{&entry.BodyFatPercent, row.BodyFatPercent},
+		{&entry.Neck, row.Neck},
+		{&entry.Chest, row.Chest},
+		{&entry.Waist, row.Waist},
+		{&entry.Hips, row.Hips},
+		{&entry.Arm, row.Arm},
+		{&entry.Thigh, row.Thigh},
+	}
+	for _, target := range targets {
+		value, err := floatPtrFromNumeric(target.value)
+		if err != nil {
+			return nil, err
+		}
+		*target.dst = value
+	}
+	return entry, nil
+}
+
+// numericParams converts the measurements in column order: bodyweight, body
+// fat, neck, chest, waist, hips, arm, thigh.
+func numericParams(values entryValues) ([8]pgtype.Numeric, error) {
+	var params [8]pgtype.Numeric
+	measurements := [8]*float64{
+		values.Bodyweight,
+		values.BodyFatPercent,
+		values.Neck,
+		values.Chest,
+		values.Waist,
+		values.Hips,
+		values.Arm,
+		values.Thigh,
+	}
+	for i, measurement := range measurements {
+		n, err := numericFromFloat(measurement)
+		if err != nil {
+			return params, err
+		}
+		params[i] = n
+	}
+	return params, nil
+}
+
+func numericFromFloat(val *float64) (pgtype.Numeric, error) {
+	if val == nil {
+		return pgtype.Numeric{Valid: false}, nil
+	}
+
+	var n pgtype.Numeric
+	if err := n.Scan(fmt.Sprintf("%.2f", *val)); err != nil {
+		return pgtype.Numeric{}, fmt.Errorf("failed to convert float to numeric: %w", err)
+	}
+	return n, nil
+}
+
+func floatPtrFromNumeric(n pgtype.Numeric) (*float64, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	f64, err := n.Float64Value()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert numeric to float64: %w", err)
+	}
+	return &f64.Float64, nil
+}
+
+func optionalDate(value *time.Time) pgtype.Date {
+	if value == nil {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{Time: *value, Valid: true}
+}
+
+func optionalText(value *string) pgtype.Text {
+	if value == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *value, Valid: true}
+}
+
+var _ Repository = (*repository)(nil)
@@ -0,0 +1,130 @@
+package bodymetrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger *slog.Logger
+	repo   Repository
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+func (s *Service) List(ctx context.Context, opts ListOptions) ([]EntryResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: "body metrics", UserID: ""}
+	}
+
+	params, err := validateListOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repo.List(ctx, userID, *params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list body metric entries: %w", err)
+	}
+	return entries, nil
+}
+
+func (s *Service) Get(ctx context.Context, id int32) (*EntryResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: "body metrics", UserID: ""}
+	}
+
+	entry, err := s.repo.Get(ctx, userID, id)
+	if err != nil {
+		return nil, entryError(err, id, "failed to get body metric entry")
+	}
+	return entry, nil
+}
+
+func (s *Service) Create(ctx context.Context, req EntryRequest) (*EntryResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return nil, &apperrors.Unauthorized{Resource: "body metrics", UserID: ""}
+	}
+
+	values, err := validateEntryRequest(req, user.Today(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.repo.Create(ctx, userID, *values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create body metric entry: %w", err)
+	}
+	return entry, nil
+}
+
+func (s *Service) Update(ctx context.Context, id int32, req EntryRequest) (*EntryResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return nil, &apperrors.Unauthorized{Resource: "body metrics", UserID: ""}
+	}
+
+	values, err := validateEntryRequest(req, user.Today(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.repo.Update(ctx, userID, id, *values)
+	if err != nil {
+		return nil, entryError(err, id, "failed to update body metric entry")
+	}
+	return entry, nil
+}
+
+func (s *Service) Delete(ctx context.Context, id int32) error {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return &apperrors.Unauthorized{Resource: "body metrics", UserID: ""}
+	}
+
+	if err := s.repo.Delete(ctx, userID, id); err != nil {
+		return entryError(err, id, "failed to delete body metric entry")
+	}
+	return nil
+}
+
+// Trend returns daily values and a moving average for one metric over the
+// last opts.Days days, ending today in the user's timezone.
+func (s *Service) Trend(ctx context.Context, opts TrendOptions) (*TrendResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: "body metrics", UserID: ""}
+	}
+
+	normalized, err := validateTrendOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	start := user.Today(ctx).AddDate(0, 0, -(normalized.Days - 1))
+	entries, err := s.repo.ListSince(ctx, userID, start.AddDate(0, 0, -(normalized.WindowDays-1)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get body metric trend: %w", err)
+	}
+	return buildTrend(entries, normalized, start), nil
+}
+
+func entryError(err error, id int32, message string) error {
+	if errors.Is(err, ErrEntryNotFound) {
+		return &apperrors.NotFound{Resource: "body metric entry", ID: fmt.Sprintf("%d", id)}
+	}
+	return fmt.Errorf("%s: %w", message, err)
+}
@@ -0,0 +1,80 @@
+package bodymetrics
+
+import (
+	"math"
+	"time"
+)
+
+// buildTrend averages same-day entries and computes a trailing moving average
+// over windowDays calendar days, so gaps in logging shrink the window instead
+// of stretching it. entries must be sorted by measured date ascending and may
+// start up to windowDays-1 days before start to seed the first averages.
+func buildTrend(entries []EntryResponse, opts TrendOptions, start time.Time) *TrendResponse {
+	resp := &TrendResponse{
+		Metric:     opts.Metric,
+		Days:       opts.Days,
+		WindowDays: opts.WindowDays,
+		Points:     []TrendPoint{},
+	}
+
+	type daily struct {
+		day   time.Time
+		total float64
+		count int
+	}
+	days := make([]daily, 0)
+	for _, entry := range entries {
+		value := metricValue(entry, opts.Metric)
+		if value == nil {
+			continue
+		}
+		day, err := time.Parse(bodyMetricsDateLayout, entry.MeasuredOn)
+		if err != nil {
+			continue
+		}
+		if len(days) > 0 && days[len(days)-1].day.Equal(day) {
+			days[len(days)-1].total += *value
+			days[len(days)-1].count++
+			continue
+		}
+		days = append(days, daily{day: day, total: *value, count: 1})
+	}
+
+	windowStart := 0
+	windowTotal := 0.0
+	for i, current := range days {
+		mean := current.total / float64(current.count)
+		windowTotal += mean
+		cutoff := current.day.AddDate(0, 0, -opts.WindowDays)
+		for !days[windowStart].day.After(cutoff) {
+			windowTotal -= days[windowStart].total / float64(days[windowStart].count)
+			windowStart++
+		}
+		if current.day.Before(start) {
+			continue
+		}
+		resp.Points = append(resp.Points, TrendPoint{
+			Date:          current.day.Format(bodyMetricsDateLayout),
+			Value:         roundTo(mean, 2),
+			MovingAverage: roundTo(windowTotal/float64(i-windowStart+1), 2),
+		})
+	}
+
+	if len(resp.Points) == 0 {
+		return resp
+	}
+	first := resp.Points[0]
+	last := resp.Points[len(resp.Points)-1]
+	latest := last.Value
+	latestAverage := last.MovingAverage
+	change := roundTo(last.MovingAverage-first.MovingAverage, 2)
+	resp.Latest = &latest
+	resp.LatestMovingAverage = &latestAverage
+	resp.MovingAverageChange = &change
+	return resp
+}
+
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
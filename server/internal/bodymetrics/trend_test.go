@@ -0,0 +1,50 @@
+package bodymetrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bodyweightEntry(day string, value float64) EntryResponse {
+	return EntryResponse{MeasuredOn: day, Bodyweight: &value}
+}
+
+func TestBuildTrendAveragesSameDayEntriesAndWindow(t *testing.T) {
+	entries := []EntryResponse{
+		bodyweightEntry("2026-06-28", 184),
+		bodyweightEntry("2026-07-01", 182),
+		bodyweightEntry("2026-07-01", 183),
+		{MeasuredOn: "2026-07-02"},
+		bodyweightEntry("2026-07-03", 181),
+		bodyweightEntry("2026-07-10", 180),
+	}
+	opts := TrendOptions{Metric: MetricBodyweight, Days: 10, WindowDays: 7}
+	start := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	trend := buildTrend(entries, opts, start)
+
+	require.Len(t, trend.Points, 3)
+	assert.Equal(t, TrendPoint{Date: "2026-07-01", Value: 182.5, MovingAverage: 183.25}, trend.Points[0])
+	assert.Equal(t, TrendPoint{Date: "2026-07-03", Value: 181, MovingAverage: 182.5}, trend.Points[1])
+	// 2026-07-03 is exactly seven days back, so it falls out of the window.
+	assert.Equal(t, TrendPoint{Date: "2026-07-10", Value: 180, MovingAverage: 180}, trend.Points[2])
+	require.NotNil(t, trend.Latest)
+	assert.Equal(t, 180.0, *trend.Latest)
+	require.NotNil(t, trend.MovingAverageChange)
+	assert.Equal(t, -3.25, *trend.MovingAverageChange)
+}
+
+func TestBuildTrendWithoutValues(t *testing.T) {
+	waist := 32.0
+	entries := []EntryResponse{{MeasuredOn: "2026-07-01", Waist: &waist}}
+
+	trend := buildTrend(entries, TrendOptions{Metric: MetricBodyweight, Days: 30, WindowDays: 7}, time.Date(2026, 6, 10, 0, 0, 0, 0, time.UTC))
+
+	assert.Empty(t, trend.Points)
+	assert.NotNil(t, trend.Points)
+	assert.Nil(t, trend.Latest)
+	assert.Nil(t, trend.MovingAverageChange)
+}
@@ -0,0 +1,156 @@
+package bodymetrics
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+var allowedMetrics = map[string]struct{}{
+	MetricBodyweight:     {},
+	MetricBodyFatPercent: {},
+	MetricNeck:           {},
+	MetricChest:          {},
+	MetricWaist:          {},
+	MetricHips:           {},
+	MetricArm:            {},
+	MetricThigh:          {},
+}
+
+// validateEntryRequest normalizes an entry and rejects dates after today in
+// the user's timezone.
+func validateEntryRequest(req EntryRequest, today time.Time) (*entryValues, error) {
+	measuredOn, err := parseDate("measured_on", req.MeasuredOn)
+	if err != nil {
+		return nil, err
+	}
+	if measuredOn == nil {
+		return nil, &ValidationError{Field: "measured_on", Message: "is required"}
+	}
+	if measuredOn.After(today) {
+		return nil, &ValidationError{Field: "measured_on", Message: "cannot be in the future"}
+	}
+
+	values := &entryValues{
+		MeasuredOn:     *measuredOn,
+		Bodyweight:     req.Bodyweight,
+		BodyFatPercent: req.BodyFatPercent,
+		Neck:           req.Neck,
+		Chest:          req.Chest,
+		Waist:          req.Waist,
+		Hips:           req.Hips,
+		Arm:            req.Arm,
+		Thigh:          req.Thigh,
+	}
+
+	if err := validateRange("bodyweight", values.Bodyweight, 0, maxBodyweight); err != nil {
+		return nil, err
+	}
+	if values.BodyFatPercent != nil && (*values.BodyFatPercent < minBodyFatPercent || *values.BodyFatPercent > maxBodyFatPercent) {
+		return nil, &ValidationError{Field: "body_fat_percent", Message: fmt.Sprintf("must be between %d and %d", minBodyFatPercent, maxBodyFatPercent)}
+	}
+	girths := []struct {
+		field string
+		value *float64
+	}{
+		{MetricNeck, values.Neck},
+		{MetricChest, values.Chest},
+		{MetricWaist, values.Waist},
+		{MetricHips, values.Hips},
+		{MetricArm, values.Arm},
+		{MetricThigh, values.Thigh},
+	}
+	for _, girth := range girths {
+		if err := validateRange(girth.field, girth.value, 0, maxGirth); err != nil {
+			return nil, err
+		}
+	}
+
+	if values.Bodyweight == nil && values.BodyFatPercent == nil && values.Neck == nil && values.Chest == nil &&
+		values.Waist == nil && values.Hips == nil && values.Arm == nil && values.Thigh == nil {
+		return nil, &ValidationError{Message: "at least one measurement is required"}
+	}
+
+	if req.Notes != nil {
+		notes := strings.TrimSpace(*req.Notes)
+		if len(notes) > maxNotesLength {
+			return nil, &ValidationError{Field: "notes", Message: fmt.Sprintf("must be at most %d characters", maxNotesLength)}
+		}
+		if notes != "" {
+			values.Notes = &notes
+		}
+	}
+
+	return values, nil
+}
+
+func validateListOptions(opts ListOptions) (*listParams, error) {
+	from, err := parseDate("from", opts.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseDate("to", opts.To)
+	if err != nil {
+		return nil, err
+	}
+	if from != nil && to != nil && from.After(*to) {
+		return nil, &ValidationError{Field: "from", Message: "must be on or before to"}
+	}
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if limit < 1 || limit > maxListLimit {
+		return nil, &ValidationError{Field: "limit", Message: fmt.Sprintf("must be between 1 and %d", maxListLimit)}
+	}
+	return &listParams{From: from, To: to, Limit: limit}, nil
+}
+
+func validateTrendOptions(opts TrendOptions) (TrendOptions, error) {
+	normalized := opts
+	normalized.Metric = strings.ToLower(strings.TrimSpace(opts.Metric))
+	if normalized.Metric == "" {
+		normalized.Metric = MetricBodyweight
+	}
+	if normalized.Days == 0 {
+		normalized.Days = defaultTrendDays
+	}
+	if normalized.WindowDays == 0 {
+		normalized.WindowDays = defaultTrendWindowDays
+	}
+
+	if _, ok := allowedMetrics[normalized.Metric]; !ok {
+		return TrendOptions{}, &ValidationError{Field: "metric", Message: "must be one of bodyweight, body_fat_percent, neck, chest, waist, hips, arm, thigh"}
+	}
+	if normalized.Days < 1 || normalized.Days > maxTrendDays {
+		return TrendOptions{}, &ValidationError{Field: "days", Message: fmt.Sprintf("must be between 1 and %d", maxTrendDays)}
+	}
+	if normalized.WindowDays < 1 || normalized.WindowDays > maxTrendWindowDays {
+		return TrendOptions{}, &ValidationError{Field: "window", Message: fmt.Sprintf("must be between 1 and %d", maxTrendWindowDays)}
+	}
+	return normalized, nil
+}
+
+func validateRange(field string, value *float64, exclusiveMin float64, max float64) error {
+	if value == nil {
+		return nil
+	}
+	if math.IsNaN(*value) || *value <= exclusiveMin || *value > max {
+		return &ValidationError{Field: field, Message: fmt.Sprintf("must be greater than %g and at most %g", exclusiveMin, max)}
+	}
+	return nil
+}
+
+func parseDate(field string, raw string) (*time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(bodyMetricsDateLayout, raw)
+	if err != nil {
+		return nil, &ValidationError{Field: field, Message: "must be a date formatted as YYYY-MM-DD"}
+	}
+	return &parsed, nil
+}
@@ -0,0 +1,102 @@
+package bodymetrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func floatPtr(value float64) *float64 {
+	return &value
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+func TestValidateEntryRequest(t *testing.T) {
+	today := time.Date(2026, 7, 6, 0, 0, 0, 0, time.UTC)
+
+	t.Run("normalizes a valid entry", func(t *testing.T) {
+		values, err := validateEntryRequest(EntryRequest{
+			MeasuredOn: " 2026-07-06 ",
+			Bodyweight: floatPtr(182.4),
+			Waist:      floatPtr(33),
+			Notes:      stringPtr("  morning, fasted "),
+		}, today)
+
+		require.NoError(t, err)
+		assert.Equal(t, today, values.MeasuredOn)
+		assert.Equal(t, 182.4, *values.Bodyweight)
+		assert.Equal(t, "morning, fasted", *values.Notes)
+	})
+
+	t.Run("drops blank notes", func(t *testing.T) {
+		values, err := validateEntryRequest(EntryRequest{MeasuredOn: "2026-07-01", Bodyweight: floatPtr(180), Notes: stringPtr("  ")}, today)
+
+		require.NoError(t, err)
+		assert.Nil(t, values.Notes)
+	})
+
+	tests := []struct {
+		name  string
+		req   EntryRequest
+		field string
+	}{
+		{name: "missing date", req: EntryRequest{Bodyweight: floatPtr(180)}, field: "measured_on"},
+		{name: "malformed date", req: EntryRequest{MeasuredOn: "07/01/2026", Bodyweight: floatPtr(180)}, field: "measured_on"},
+		{name: "future date", req: EntryRequest{MeasuredOn: "2026-07-07", Bodyweight: floatPtr(180)}, field: "measured_on"},
+		{name: "no measurements", req: EntryRequest{MeasuredOn: "2026-07-01"}, field: ""},
+		{name: "non-positive bodyweight", req: EntryRequest{MeasuredOn: "2026-07-01", Bodyweight: floatPtr(0)}, field: "bodyweight"},
+		{name: "body fat out of range", req: EntryRequest{MeasuredOn: "2026-07-01", BodyFatPercent: floatPtr(80)}, field: "body_fat_percent"},
+		{name: "girth out of range", req: EntryRequest{MeasuredOn: "2026-07-01", Thigh: floatPtr(400)}, field: "thigh"},
+		{name: "notes too long", req: EntryRequest{MeasuredOn: "2026-07-01", Bodyweight: floatPtr(180), Notes: stringPtr(strings.Repeat("a", maxNotesLength+1))}, field: "notes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validateEntryRequest(tt.req, today)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestValidateListOptions(t *testing.T) {
+	params, err := validateListOptions(ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, defaultListLimit, params.Limit)
+	assert.Nil(t, params.From)
+
+	_, err = validateListOptions(ListOptions{From: "2026-07-02", To: "2026-07-01"})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "from", validationErr.Field)
+
+	_, err = validateListOptions(ListOptions{Limit: maxListLimit + 1})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "limit", validationErr.Field)
+}
+
+func TestValidateTrendOptions(t *testing.T) {
+	opts, err := validateTrendOptions(TrendOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, TrendOptions{Metric: MetricBodyweight, Days: defaultTrendDays, WindowDays: defaultTrendWindowDays}, opts)
+
+	opts, err = validateTrendOptions(TrendOptions{Metric: " Waist ", Days: 30, WindowDays: 3})
+	require.NoError(t, err)
+	assert.Equal(t, MetricWaist, opts.Metric)
+
+	var validationErr *ValidationError
+	_, err = validateTrendOptions(TrendOptions{Metric: "calves"})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "metric", validationErr.Field)
+
+	_, err = validateTrendOptions(TrendOptions{WindowDays: maxTrendWindowDays + 1})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "window", validationErr.Field)
+}
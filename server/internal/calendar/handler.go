@@ -0,0 +1,127 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type calendarService interface {
+	GetFeed(ctx context.Context) (*FeedStatusResponse, error)
+	RotateFeedToken(ctx context.Context) (*FeedTokenResponse, error)
+	DeleteFeed(ctx context.Context) error
+	RenderFeed(ctx context.Context, token string) (string, error)
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service calendarService
+}
+
+func NewHandler(logger *slog.Logger, service calendarService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// GetFeed godoc
+// @Summary Get calendar feed status
+// @Description Reports whether the authenticated user's iCalendar feed is enabled. The feed URL is only shown when a token is issued.
+// @Tags calendar
+// @Produce json
+// @Security StackAuth
+// @Success 200 {object} calendar.FeedStatusResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /calendar/feed [get]
+func (h *Handler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	feed, err := h.service.GetFeed(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get calendar feed")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, feed); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// RotateFeedToken godoc
+// @Summary Issue or rotate calendar feed token
+// @Description Issues a new secret token for the authenticated user's iCalendar feed, enabling the feed if needed. Any previously issued feed URL stops working immediately.
+// @Tags calendar
+// @Produce json
+// @Security StackAuth
+// @Success 201 {object} calendar.FeedTokenResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /calendar/feed/token [post]
+func (h *Handler) RotateFeedToken(w http.ResponseWriter, r *http.Request) {
+	token, err := h.service.RotateFeedToken(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to rotate calendar feed token")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	if err := response.JSON(w, http.StatusCreated, token); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// DeleteFeed godoc
+// @Summary Disable calendar feed
+// @Description Disables the authenticated user's iCalendar feed and revokes its token.
+// @Tags calendar
+// @Security StackAuth
+// @Success 204 "No Content"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /calendar/feed [delete]
+func (h *Handler) DeleteFeed(w http.ResponseWriter, r *http.Request) {
+	if err := h.service.DeleteFeed(r.Context()); err != nil {
+		h.writeServiceError(w, r, err, "failed to delete calendar feed")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ServeFeed publishes the iCalendar document for the token in the path. It
+// is mounted outside /api because calendar apps cannot send auth headers;
+// the token itself is the credential.
+func (h *Handler) ServeFeed(w http.ResponseWriter, r *http.Request) {
+	body, err := h.service.RenderFeed(r.Context(), r.PathValue("token"))
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to render calendar feed")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="fittrack.ics"`)
+	w.Header().Set("Cache-Control", "private, max-age=900")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(body)); err != nil {
+		h.logger.Error("failed to write calendar feed", "error", err)
+	}
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errNotFound *apperrors.NotFound
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errNotFound):
+		response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
@@ -0,0 +1,107 @@
+package calendar
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubCalendarService struct {
+	status *FeedStatusResponse
+	token  *FeedTokenResponse
+	body   string
+	err    error
+	path   string
+}
+
+func (s *stubCalendarService) GetFeed(context.Context) (*FeedStatusResponse, error) {
+	return s.status, s.err
+}
+
+func (s *stubCalendarService) RotateFeedToken(context.Context) (*FeedTokenResponse, error) {
+	return s.token, s.err
+}
+
+func (s *stubCalendarService) DeleteFeed(context.Context) error {
+	return s.err
+}
+
+func (s *stubCalendarService) RenderFeed(_ context.Context, token string) (string, error) {
+	s.path = token
+	return s.body, s.err
+}
+
+func TestHandlerRotateFeedToken(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	service := &stubCalendarService{token: &FeedTokenResponse{
+		Token:     "abc",
+		URL:       "https://fittrack.example/calendar/abc.ics",
+		CreatedAt: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+	}}
+	handler := NewHandler(logger, service)
+	rr := httptest.NewRecorder()
+
+	handler.RotateFeedToken(rr, httptest.NewRequest(http.MethodPost, "/api/calendar/feed/token", nil))
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+	assert.Contains(t, rr.Body.String(), `"url":"https://fittrack.example/calendar/abc.ics"`)
+}
+
+func TestHandlerDeleteFeed(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("deletes feed", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubCalendarService{}).DeleteFeed(rr, httptest.NewRequest(http.MethodDelete, "/api/calendar/feed", nil))
+
+		require.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("maps not found", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		service := &stubCalendarService{err: &apperrors.NotFound{Resource: "calendar feed"}}
+
+		NewHandler(logger, service).DeleteFeed(rr, httptest.NewRequest(http.MethodDelete, "/api/calendar/feed", nil))
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestHandlerServeFeed(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("writes calendar", func(t *testing.T) {
+		service := &stubCalendarService{body: "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"}
+		req := httptest.NewRequest(http.MethodGet, "/calendar/abc.ics", nil)
+		req.SetPathValue("token", "abc.ics")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).ServeFeed(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "abc.ics", service.path)
+		assert.Equal(t, "text/calendar; charset=utf-8", rr.Header().Get("Content-Type"))
+		assert.Equal(t, service.body, rr.Body.String())
+	})
+
+	t.Run("unknown token is not found", func(t *testing.T) {
+		service := &stubCalendarService{err: &apperrors.NotFound{Resource: "calendar feed"}}
+		req := httptest.NewRequest(http.MethodGet, "/calendar/nope.ics", nil)
+		req.SetPathValue("token", "nope.ics")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).ServeFeed(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
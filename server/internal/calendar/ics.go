@@ -0,0 +1,115 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icsTimeLayout = "20060102T150405Z"
+	// icsLineLimit is the RFC 5545 maximum line length in octets, excluding
+	// the CRLF.
+	icsLineLimit = 75
+)
+
+// renderFeed writes workouts as an RFC 5545 calendar. Each event's UID is
+// derived from the workout ID so calendar apps update edited workouts in
+// place instead of adding duplicates.
+func renderFeed(workouts []feedWorkout, now time.Time) string {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//FitTrack//Workout Feed//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	writeLine(&b, "X-WR-CALNAME:FitTrack workouts")
+	writeLine(&b, "REFRESH-INTERVAL;VALUE=DURATION:PT1H")
+	writeLine(&b, "X-PUBLISHED-TTL:PT1H")
+
+	stamp := now.UTC().Format(icsTimeLayout)
+	for _, workout := range workouts {
+		start := workout.date.UTC()
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, fmt.Sprintf("UID:workout-%d@fittrack", workout.id))
+		writeLine(&b, "DTSTAMP:"+stamp)
+		writeLine(&b, "DTSTART:"+start.Format(icsTimeLayout))
+		writeLine(&b, "DTEND:"+start.Add(feedEventDuration).Format(icsTimeLayout))
+		writeLine(&b, "LAST-MODIFIED:"+workout.modifiedAt.UTC().Format(icsTimeLayout))
+		writeLine(&b, "SUMMARY:"+escapeText(eventSummary(workout)))
+		writeLine(&b, "DESCRIPTION:"+escapeText(eventDescription(workout)))
+		writeLine(&b, "CATEGORIES:Workout")
+		writeLine(&b, "TRANSP:TRANSPARENT")
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+func eventSummary(workout feedWorkout) string {
+	if focus := strings.TrimSpace(workout.focus); focus != "" {
+		return focus + " workout"
+	}
+	return "Workout"
+}
+
+func eventDescription(workout feedWorkout) string {
+	lines := make([]string, 0, len(workout.exercises)+4)
+	for _, exercise := range workout.exercises {
+		line := fmt.Sprintf("%s: %d working sets", exercise.name, exercise.workingSets)
+		if exercise.workingSets == 0 {
+			line = fmt.Sprintf("%s: %d sets", exercise.name, exercise.totalSets)
+		}
+		if exercise.topWeight > 0 {
+			line += ", top set " + formatWeight(exercise.topWeight) + " lb"
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) > 0 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, fmt.Sprintf("Volume: %s lb across %d working sets", formatWeight(workout.tonnage), workout.workingSets))
+	if notes := strings.TrimSpace(workout.notes); notes != "" {
+		lines = append(lines, "", "Notes: "+notes)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatWeight(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// escapeText applies RFC 5545 TEXT escaping.
+func escapeText(value string) string {
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(value)
+}
+
+// writeLine appends a content line with CRLF, folding lines longer than 75
+// octets without splitting a UTF-8 sequence.
+func writeLine(b *strings.Builder, line string) {
+	limit := icsLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines spend one octet on the leading space.
+		limit = icsLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
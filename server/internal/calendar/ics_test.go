@@ -0,0 +1,84 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderFeed(t *testing.T) {
+	now := time.Date(2026, 7, 10, 12, 0, 0, 0, time.UTC)
+	workouts := []feedWorkout{
+		{
+			id:          42,
+			date:        time.Date(2026, 7, 1, 14, 30, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			focus:       "Push",
+			notes:       "Felt strong; shoulder fine, no pain",
+			modifiedAt:  time.Date(2026, 7, 2, 9, 0, 0, 0, time.UTC),
+			tonnage:     12500,
+			workingSets: 9,
+			exercises: []feedExercise{
+				{name: "Bench Press", totalSets: 5, workingSets: 4, topWeight: 225},
+				{name: "Face Pull", totalSets: 2},
+			},
+		},
+		{
+			id:         43,
+			date:       time.Date(2026, 7, 3, 10, 0, 0, 0, time.UTC),
+			modifiedAt: time.Date(2026, 7, 3, 11, 0, 0, 0, time.UTC),
+		},
+	}
+
+	feed := renderFeed(workouts, now)
+
+	assert.True(t, strings.HasPrefix(feed, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(feed, "END:VCALENDAR\r\n"))
+	assert.Equal(t, 2, strings.Count(feed, "BEGIN:VEVENT\r\n"))
+
+	unfolded := strings.ReplaceAll(feed, "\r\n ", "")
+	assert.Contains(t, unfolded, "UID:workout-42@fittrack\r\n")
+	assert.Contains(t, unfolded, "DTSTAMP:20260710T120000Z\r\n")
+	assert.Contains(t, unfolded, "DTSTART:20260701T183000Z\r\n")
+	assert.Contains(t, unfolded, "DTEND:20260701T193000Z\r\n")
+	assert.Contains(t, unfolded, "LAST-MODIFIED:20260702T090000Z\r\n")
+	assert.Contains(t, unfolded, "SUMMARY:Push workout\r\n")
+	assert.Contains(t, unfolded, `DESCRIPTION:Bench Press: 4 working sets\, top set 225 lb\nFace Pull: 2 sets\n\nVolume: 12500 lb across 9 working sets\n\nNotes: Felt strong\; shoulder fine\, no pain`+"\r\n")
+	assert.Contains(t, unfolded, "UID:workout-43@fittrack\r\nDTSTAMP")
+	assert.Contains(t, unfolded, "SUMMARY:Workout\r\n")
+	assert.Contains(t, unfolded, `DESCRIPTION:Volume: 0 lb across 0 working sets`+"\r\n")
+}
+
+func TestRenderFeedWithoutWorkouts(t *testing.T) {
+	feed := renderFeed(nil, time.Date(2026, 7, 10, 12, 0, 0, 0, time.UTC))
+
+	assert.NotContains(t, feed, "BEGIN:VEVENT")
+	assert.Contains(t, feed, "X-WR-CALNAME:FitTrack workouts\r\n")
+}
+
+func TestWriteLineFoldsLongLinesOnRuneBoundaries(t *testing.T) {
+	var b strings.Builder
+	line := "DESCRIPTION:" + strings.Repeat("é", 80)
+
+	writeLine(&b, line)
+
+	out := b.String()
+	require.True(t, strings.HasSuffix(out, "\r\n"))
+	physical := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+	require.Greater(t, len(physical), 1)
+	for i, part := range physical {
+		assert.LessOrEqual(t, len(part), icsLineLimit)
+		assert.True(t, utf8.ValidString(part))
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(part, " "))
+		}
+	}
+	assert.Equal(t, line, strings.ReplaceAll(strings.TrimSuffix(out, "\r\n"), "\r\n ", ""))
+}
+
+func TestEscapeText(t *testing.T) {
+	assert.Equal(t, `a\\b\;c\,d\ne\nf`, escapeText("a\\b;c,d\r\ne\nf"))
+}
@@ -0,0 +1,50 @@
+package calendar
+
+import "time"
+
+const (
+	// feedTokenBytes of randomness encode to a 43-character URL-safe token.
+	feedTokenBytes  = 32
+	feedTokenLength = 43
+	feedPathPrefix  = "/calendar/"
+	feedFileSuffix  = ".ics"
+	// feedEventDuration is a nominal length; workouts don't record an end time.
+	feedEventDuration = time.Hour
+)
+
+// FeedStatusResponse reports whether the user has an active calendar feed.
+// The feed URL is only returned when a token is issued, because only a hash
+// of the token is stored.
+type FeedStatusResponse struct {
+	Enabled   bool       `json:"enabled"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	RotatedAt *time.Time `json:"rotated_at,omitempty"`
+}
+
+// FeedTokenResponse carries a newly issued feed token. Any previous token
+// stops working as soon as this one is issued.
+type FeedTokenResponse struct {
+	Token     string    `json:"token"`
+	URL       string    `json:"url" example:"https://fittrack.example/calendar/abc123.ics"`
+	WebcalURL string    `json:"webcal_url" example:"webcal://fittrack.example/calendar/abc123.ics"`
+	CreatedAt time.Time `json:"created_at"`
+	RotatedAt time.Time `json:"rotated_at"`
+}
+
+type feedWorkout struct {
+	id          int32
+	date        time.Time
+	focus       string
+	notes       string
+	modifiedAt  time.Time
+	tonnage     float64
+	workingSets int
+	exercises   []feedExercise
+}
+
+type feedExercise struct {
+	name        string
+	totalSets   int
+	workingSets int
+	topWeight   float64
+}
@@ -0,0 +1,181 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	GetFeed(ctx context.Context, userID string) (*db.CalendarFeed, error)
+	UpsertFeed(ctx context.Context, userID string, tokenHash string) (db.CalendarFeed, error)
+	DeleteFeed(ctx context.Context, userID string) (bool, error)
+	GetFeedByTokenHash(ctx context.Context, tokenHash string) (*db.CalendarFeed, error)
+	ListFeedWorkouts(ctx context.Context, userID string) ([]feedWorkout, error)
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, pool *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		pool:    pool,
+	}
+}
+
+// GetFeed returns the user's feed, or nil when none has been issued.
+func (r *repository) GetFeed(ctx context.Context, userID string) (*db.CalendarFeed, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	feed, err := r.queries.GetCalendarFeed(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get calendar feed: %w", err)
+	}
+	return &feed, nil
+}
+
+func (r *repository) UpsertFeed(ctx context.Context, userID string, tokenHash string) (db.CalendarFeed, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	feed, err := r.queries.UpsertCalendarFeed(ctx, db.UpsertCalendarFeedParams{
+		UserID:    userID,
+		TokenHash: tokenHash,
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("upsert calendar feed failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return db.CalendarFeed{}, fmt.Errorf("upsert calendar feed: %w", err)
+	}
+	return feed, nil
+}
+
+func (r *repository) DeleteFeed(ctx context.Context, userID string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.DeleteCalendarFeed(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("delete calendar feed: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// GetFeedByTokenHash resolves an unauthenticated feed request to its owner,
+// or nil when the token is unknown or has been rotated away. There is no RLS
+// user yet, so the token hash is set for a read-only transaction and the
+// select policy exposes only the matching feed.
+func (r *repository) GetFeedByTokenHash(ctx context.Context, tokenHash string) (*db.CalendarFeed, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("begin calendar feed lookup transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT set_config('app.current_token_hash', $1, true)", tokenHash); err != nil {
+		return nil, fmt.Errorf("set calendar feed token hash: %w", err)
+	}
+
+	feed, err := r.queries.WithTx(tx).GetCalendarFeedByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get calendar feed by token: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit calendar feed lookup transaction: %w", err)
+	}
+	return &feed, nil
+}
+
+// ListFeedWorkouts loads every workout with its exercise summary. Feed
+// requests bypass the auth middleware, so the RLS user is set for the
+// duration of a read-only transaction.
+func (r *repository) ListFeedWorkouts(ctx context.Context, userID string) ([]feedWorkout, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("begin calendar feed transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT set_config('app.current_user_id', $1, true)", userID); err != nil {
+		return nil, fmt.Errorf("set calendar feed rls user: %w", err)
+	}
+
+	qtx := r.queries.WithTx(tx)
+	workoutRows, err := qtx.ListCalendarFeedWorkouts(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list calendar feed workouts: %w", err)
+	}
+	exerciseRows, err := qtx.ListCalendarFeedExercises(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list calendar feed exercises: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit calendar feed transaction: %w", err)
+	}
+
+	return feedWorkoutsFromRows(workoutRows, exerciseRows), nil
+}
+
+func feedWorkoutsFromRows(workoutRows []db.ListCalendarFeedWorkoutsRow, exerciseRows []db.ListCalendarFeedExercisesRow) []feedWorkout {
+	exercisesByWorkout := make(map[int32][]feedExercise)
+	for _, row := range exerciseRows {
+		exercisesByWorkout[row.WorkoutID] = append(exercisesByWorkout[row.WorkoutID], feedExercise{
+			name:        row.ExerciseName,
+			totalSets:   int(row.TotalSets),
+			workingSets: int(row.WorkingSets),
+			topWeight:   row.TopWeight,
+		})
+	}
+
+	workouts := make([]feedWorkout, 0, len(workoutRows))
+	for _, row := range workoutRows {
+		if !row.Date.Valid {
+			continue
+		}
+		modifiedAt := row.CreatedAt.Time
+		if row.UpdatedAt.Valid {
+			modifiedAt = row.UpdatedAt.Time
+		}
+		workouts = append(workouts, feedWorkout{
+			id:          row.ID,
+			date:        row.Date.Time,
+			focus:       row.WorkoutFocus.String,
+			notes:       row.Notes.String,
+			modifiedAt:  modifiedAt,
+			tonnage:     row.Tonnage,
+			workingSets: int(row.WorkingSets),
+			exercises:   exercisesByWorkout[row.ID],
+		})
+	}
+	return workouts
+}
+
+var _ Repository = (*repository)(nil)
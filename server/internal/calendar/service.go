@@ -0,0 +1,124 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger  *slog.Logger
+	repo    Repository
+	baseURL string
+}
+
+// NewService builds feed URLs from baseURL, the public origin the app is
+// served from.
+func NewService(logger *slog.Logger, repo Repository, baseURL string) *Service {
+	return &Service{
+		logger:  logger,
+		repo:    repo,
+		baseURL: strings.TrimRight(strings.TrimSpace(baseURL), "/"),
+	}
+}
+
+func (s *Service) GetFeed(ctx context.Context) (*FeedStatusResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" {
+		return nil, &apperrors.Unauthorized{Resource: "calendar feed", UserID: ""}
+	}
+
+	feed, err := s.repo.GetFeed(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+	if feed == nil {
+		return &FeedStatusResponse{Enabled: false}, nil
+	}
+	createdAt, rotatedAt := feed.CreatedAt.Time, feed.RotatedAt.Time
+	return &FeedStatusResponse{Enabled: true, CreatedAt: &createdAt, RotatedAt: &rotatedAt}, nil
+}
+
+// RotateFeedToken issues a new feed token, enabling the feed if needed and
+// invalidating any previous token.
+func (s *Service) RotateFeedToken(ctx context.Context) (*FeedTokenResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" {
+		return nil, &apperrors.Unauthorized{Resource: "calendar feed", UserID: ""}
+	}
+
+	token, tokenHash, err := newFeedToken()
+	if err != nil {
+		return nil, err
+	}
+	feed, err := s.repo.UpsertFeed(ctx, userID, tokenHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate calendar feed token: %w", err)
+	}
+	return s.tokenResponse(token, feed), nil
+}
+
+func (s *Service) DeleteFeed(ctx context.Context) error {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" {
+		return &apperrors.Unauthorized{Resource: "calendar feed", UserID: ""}
+	}
+
+	deleted, err := s.repo.DeleteFeed(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+	if !deleted {
+		return &apperrors.NotFound{Resource: "calendar feed", ID: ""}
+	}
+	return nil
+}
+
+// RenderFeed authenticates a feed request by its secret token and returns the
+// owner's workouts as an iCalendar document. Unknown, malformed and rotated
+// tokens are all reported as not found.
+func (s *Service) RenderFeed(ctx context.Context, token string) (string, error) {
+	token = strings.TrimSuffix(strings.TrimSpace(token), feedFileSuffix)
+	if !validFeedToken(token) {
+		return "", &apperrors.NotFound{Resource: "calendar feed", ID: ""}
+	}
+
+	feed, err := s.repo.GetFeedByTokenHash(ctx, hashFeedToken(token))
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve calendar feed: %w", err)
+	}
+	if feed == nil {
+		return "", &apperrors.NotFound{Resource: "calendar feed", ID: ""}
+	}
+
+	workouts, err := s.repo.ListFeedWorkouts(ctx, feed.UserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load calendar feed workouts: %w", err)
+	}
+	return renderFeed(workouts, time.Now()), nil
+}
+
+func (s *Service) tokenResponse(token string, feed db.CalendarFeed) *FeedTokenResponse {
+	path := feedPathPrefix + token + feedFileSuffix
+	url := s.baseURL + path
+	webcalURL := url
+	for _, scheme := range []string{"https://", "http://"} {
+		if strings.HasPrefix(url, scheme) {
+			webcalURL = "webcal://" + strings.TrimPrefix(url, scheme)
+			break
+		}
+	}
+	return &FeedTokenResponse{
+		Token:     token,
+		URL:       url,
+		WebcalURL: webcalURL,
+		CreatedAt: feed.CreatedAt.Time,
+		RotatedAt: feed.RotatedAt.Time,
+	}
+}
@@ -0,0 +1,156 @@
+package calendar
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRepository struct {
+	feed         *db.CalendarFeed
+	upsertedHash string
+	lookedUpHash string
+	workoutsFor  string
+	workouts     []feedWorkout
+	deleted      bool
+	upsertErr    error
+	lookupErr    error
+	listErr      error
+}
+
+func (r *stubRepository) GetFeed(context.Context, string) (*db.CalendarFeed, error) {
+	return r.feed, nil
+}
+
+func (r *stubRepository) UpsertFeed(_ context.Context, userID string, tokenHash string) (db.CalendarFeed, error) {
+	r.upsertedHash = tokenHash
+	now := pgtype.Timestamptz{Time: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	return db.CalendarFeed{UserID: userID, TokenHash: tokenHash, CreatedAt: now, RotatedAt: now}, r.upsertErr
+}
+
+func (r *stubRepository) DeleteFeed(context.Context, string) (bool, error) {
+	return r.deleted, nil
+}
+
+func (r *stubRepository) GetFeedByTokenHash(_ context.Context, tokenHash string) (*db.CalendarFeed, error) {
+	r.lookedUpHash = tokenHash
+	if r.feed == nil || r.feed.TokenHash != tokenHash {
+		return nil, r.lookupErr
+	}
+	return r.feed, r.lookupErr
+}
+
+func (r *stubRepository) ListFeedWorkouts(_ context.Context, userID string) ([]feedWorkout, error) {
+	r.workoutsFor = userID
+	return r.workouts, r.listErr
+}
+
+func TestServiceRotateFeedToken(t *testing.T) {
+	repo := &stubRepository{}
+	service := NewService(nil, repo, "https://fittrack.example/")
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	token, err := service.RotateFeedToken(ctx)
+
+	require.NoError(t, err)
+	assert.Len(t, token.Token, feedTokenLength)
+	assert.True(t, validFeedToken(token.Token))
+	assert.Equal(t, hashFeedToken(token.Token), repo.upsertedHash)
+	assert.NotContains(t, repo.upsertedHash, token.Token)
+	assert.Equal(t, "https://fittrack.example/calendar/"+token.Token+".ics", token.URL)
+	assert.Equal(t, "webcal://fittrack.example/calendar/"+token.Token+".ics", token.WebcalURL)
+
+	second, err := service.RotateFeedToken(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, token.Token, second.Token)
+}
+
+func TestServiceRequiresUser(t *testing.T) {
+	service := NewService(nil, &stubRepository{}, "")
+	var errUnauthorized *apperrors.Unauthorized
+
+	_, err := service.GetFeed(context.Background())
+	assert.ErrorAs(t, err, &errUnauthorized)
+	_, err = service.RotateFeedToken(context.Background())
+	assert.ErrorAs(t, err, &errUnauthorized)
+	assert.ErrorAs(t, service.DeleteFeed(context.Background()), &errUnauthorized)
+}
+
+func TestServiceGetFeed(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	status, err := NewService(nil, &stubRepository{}, "").GetFeed(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &FeedStatusResponse{Enabled: false}, status)
+
+	createdAt := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	repo := &stubRepository{feed: &db.CalendarFeed{
+		UserID:    "user-1",
+		CreatedAt: pgtype.Timestamptz{Time: createdAt, Valid: true},
+		RotatedAt: pgtype.Timestamptz{Time: createdAt, Valid: true},
+	}}
+	status, err = NewService(nil, repo, "").GetFeed(ctx)
+	require.NoError(t, err)
+	assert.True(t, status.Enabled)
+	require.NotNil(t, status.CreatedAt)
+	assert.Equal(t, createdAt, *status.CreatedAt)
+}
+
+func TestServiceDeleteFeedNotFound(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	err := NewService(nil, &stubRepository{}, "").DeleteFeed(ctx)
+
+	var errNotFound *apperrors.NotFound
+	assert.ErrorAs(t, err, &errNotFound)
+}
+
+func TestServiceRenderFeed(t *testing.T) {
+	token, tokenHash, err := newFeedToken()
+	require.NoError(t, err)
+	repo := &stubRepository{
+		feed: &db.CalendarFeed{UserID: "user-1", TokenHash: tokenHash},
+		workouts: []feedWorkout{{
+			id:         7,
+			date:       time.Date(2026, 7, 1, 10, 0, 0, 0, time.UTC),
+			modifiedAt: time.Date(2026, 7, 1, 11, 0, 0, 0, time.UTC),
+		}},
+	}
+	service := NewService(nil, repo, "")
+
+	t.Run("accepts token with .ics suffix", func(t *testing.T) {
+		body, err := service.RenderFeed(context.Background(), token+".ics")
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", repo.workoutsFor)
+		assert.True(t, strings.Contains(body, "UID:workout-7@fittrack"))
+	})
+
+	t.Run("rejects malformed token without lookup", func(t *testing.T) {
+		repo.lookedUpHash = ""
+
+		_, err := service.RenderFeed(context.Background(), "short.ics")
+
+		var errNotFound *apperrors.NotFound
+		assert.ErrorAs(t, err, &errNotFound)
+		assert.Empty(t, repo.lookedUpHash)
+	})
+
+	t.Run("rejects rotated token", func(t *testing.T) {
+		oldToken, _, err := newFeedToken()
+		require.NoError(t, err)
+
+		_, err = service.RenderFeed(context.Background(), oldToken)
+
+		var errNotFound *apperrors.NotFound
+		assert.ErrorAs(t, err, &errNotFound)
+	})
+}
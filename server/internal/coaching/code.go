@@ -0,0 +1,34 @@
+package coaching
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// newInviteCode returns a random URL-safe invitation code and the hash that
+// is stored in its place.
+func newInviteCode() (string, string, error) {
+	raw := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", fmt.Errorf("generate coaching invite code: %w", err)
+	}
+	code := base64.RawURLEncoding.EncodeToString(raw)
+	return code, hashInviteCode(code), nil
+}
+
+func hashInviteCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// validInviteCode rejects malformed codes before they reach the database.
+func validInviteCode(code string) bool {
+	if len(code) != inviteCodeLength {
+		return false
+	}
+	_, err := base64.RawURLEncoding.DecodeString(code)
+	return err == nil
+}
@@ -0,0 +1,340 @@
+package coaching
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/request"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+const maxCoachingJSONBodyBytes = 64 << 10
+
+type coachingService interface {
+	CreateInvitation(ctx context.Context, req InvitationRequest) (*InvitationResponse, error)
+	ListInvitations(ctx context.Context) ([]InvitationResponse, error)
+	AcceptInvitation(ctx context.Context, req AcceptInvitationRequest) (*LinkResponse, error)
+	ListAthletes(ctx context.Context) ([]LinkResponse, error)
+	ListCoaches(ctx context.Context) ([]LinkResponse, error)
+	UpdateScopes(ctx context.Context, linkID int32, req UpdateScopesRequest) (*LinkResponse, error)
+	DeleteLink(ctx context.Context, linkID int32) error
+	CreateSuggestion(ctx context.Context, athleteID string, req SuggestionRequest) (*SuggestionResponse, error)
+	ListSuggestions(ctx context.Context) ([]SuggestionResponse, error)
+	DismissSuggestion(ctx context.Context, suggestionID int32) error
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service coachingService
+}
+
+func NewHandler(logger *slog.Logger, service coachingService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// CreateInvitation godoc
+// @Summary Create coaching invitation
+// @Description Issues a single-use invitation code, valid for seven days, that an athlete accepts to grant the authenticated coach the requested scopes. The code is only returned here.
+// @Tags coaching
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param request body coaching.InvitationRequest true "Requested scopes"
+// @Success 201 {object} coaching.InvitationResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /coaching/invitations [post]
+func (h *Handler) CreateInvitation(w http.ResponseWriter, r *http.Request) {
+	var req InvitationRequest
+	if err := request.DecodeStrictJSON(w, r, &req, maxCoachingJSONBodyBytes); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	invitation, err := h.service.CreateInvitation(r.Context(), req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to create coaching invitation")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	if err := response.JSON(w, http.StatusCreated, invitation); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// ListInvitations godoc
+// @Summary List pending coaching invitations
+// @Description Returns the authenticated coach's invitations that have not been accepted or expired.
+// @Tags coaching
+// @Produce json
+// @Security StackAuth
+// @Success 200 {array} coaching.InvitationResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /coaching/invitations [get]
+func (h *Handler) ListInvitations(w http.ResponseWriter, r *http.Request) {
+	invitations, err := h.service.ListInvitations(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list coaching invitations")
+		return
+	}
+	if err := response.JSON(w, http.StatusOK, invitations); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// AcceptInvitation godoc
+// @Summary Accept coaching invitation
+// @Description Links the authenticated user, as athlete, to the coach who issued the invitation code.
+// @Tags coaching
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param request body coaching.AcceptInvitationRequest true "Invitation code"
+// @Success 201 {object} coaching.LinkResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /coaching/invitations/accept [post]
+func (h *Handler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	var req AcceptInvitationRequest
+	if err := request.DecodeStrictJSON(w, r, &req, maxCoachingJSONBodyBytes); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	link, err := h.service.AcceptInvitation(r.Context(), req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to accept coaching invitation")
+		return
+	}
+	if err := response.JSON(w, http.StatusCreated, link); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// ListAthletes godoc
+// @Summary List coached athletes
+// @Description Returns the athletes linked to the authenticated coach. Send an athlete's ID in the X-Act-As header to read their workouts, exercises and metrics through the regular endpoints, within the granted scopes.
+// @Tags coaching
+// @Produce json
+// @Security StackAuth
+// @Success 200 {array} coaching.LinkResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /coaching/athletes [get]
+func (h *Handler) ListAthletes(w http.ResponseWriter, r *http.Request) {
+	athletes, err := h.service.ListAthletes(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list coaching athletes")
+		return
+	}
+	if err := response.JSON(w, http.StatusOK, athletes); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// ListCoaches godoc
+// @Summary List coaches
+// @Description Returns the coaches the authenticated athlete has granted access to.
+// @Tags coaching
+// @Produce json
+// @Security StackAuth
+// @Success 200 {array} coaching.LinkResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /coaching/coaches [get]
+func (h *Handler) ListCoaches(w http.ResponseWriter, r *http.Request) {
+	coaches, err := h.service.ListCoaches(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list coaching coaches")
+		return
+	}
+	if err := response.JSON(w, http.StatusOK, coaches); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// UpdateScopes godoc
+// @Summary Update coaching scopes
+// @Description Replaces the scopes the authenticated athlete grants a coach.
+// @Tags coaching
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param id path int true "Coaching link ID"
+// @Param request body coaching.UpdateScopesRequest true "Granted scopes"
+// @Success 200 {object} coaching.LinkResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /coaching/links/{id}/scopes [put]
+func (h *Handler) UpdateScopes(w http.ResponseWriter, r *http.Request) {
+	linkID, ok := h.decodeID(w, r, "coaching link")
+	if !ok {
+		return
+	}
+
+	var req UpdateScopesRequest
+	if err := request.DecodeStrictJSON(w, r, &req, maxCoachingJSONBodyBytes); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	link, err := h.service.UpdateScopes(r.Context(), linkID, req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to update coaching scopes")
+		return
+	}
+	if err := response.JSON(w, http.StatusOK, link); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// DeleteLink godoc
+// @Summary Delete coaching link
+// @Description Ends a coaching relationship from either side, or cancels a pending invitation.
+// @Tags coaching
+// @Security StackAuth
+// @Param id path int true "Coaching link ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /coaching/links/{id} [delete]
+func (h *Handler) DeleteLink(w http.ResponseWriter, r *http.Request) {
+	linkID, ok := h.decodeID(w, r, "coaching link")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteLink(r.Context(), linkID); err != nil {
+		h.writeServiceError(w, r, err, "failed to delete coaching link")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateSuggestion godoc
+// @Summary Suggest a workout
+// @Description Sends a suggested workout to an athlete who granted the authenticated coach the suggest_workouts scope.
+// @Tags coaching
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param athleteID path string true "Athlete user ID"
+// @Param request body coaching.SuggestionRequest true "Suggested workout"
+// @Success 201 {object} coaching.SuggestionResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /coaching/athletes/{athleteID}/suggestions [post]
+func (h *Handler) CreateSuggestion(w http.ResponseWriter, r *http.Request) {
+	var req SuggestionRequest
+	if err := request.DecodeStrictJSON(w, r, &req, maxCoachingJSONBodyBytes); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	suggestion, err := h.service.CreateSuggestion(r.Context(), r.PathValue("athleteID"), req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to create coaching suggestion")
+		return
+	}
+	if err := response.JSON(w, http.StatusCreated, suggestion); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// ListSuggestions godoc
+// @Summary List suggested workouts
+// @Description Returns the workouts the authenticated athlete's coaches have suggested and that have not been dismissed, newest first.
+// @Tags coaching
+// @Produce json
+// @Security StackAuth
+// @Success 200 {array} coaching.SuggestionResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /coaching/suggestions [get]
+func (h *Handler) ListSuggestions(w http.ResponseWriter, r *http.Request) {
+	suggestions, err := h.service.ListSuggestions(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list coaching suggestions")
+		return
+	}
+	if err := response.JSON(w, http.StatusOK, suggestions); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// DismissSuggestion godoc
+// @Summary Dismiss suggested workout
+// @Description Hides a suggested workout from the authenticated athlete's list.
+// @Tags coaching
+// @Security StackAuth
+// @Param id path int true "Suggestion ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /coaching/suggestions/{id} [delete]
+func (h *Handler) DismissSuggestion(w http.ResponseWriter, r *http.Request) {
+	suggestionID, ok := h.decodeID(w, r, "suggestion")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DismissSuggestion(r.Context(), suggestionID); err != nil {
+		h.writeServiceError(w, r, err, "failed to dismiss coaching suggestion")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) decodeID(w http.ResponseWriter, r *http.Request, resource string) (int32, bool) {
+	raw := strings.TrimSpace(r.PathValue("id"))
+	if raw == "" {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Missing "+resource+" ID", nil)
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || parsed <= 0 {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Invalid "+resource+" ID", err)
+		return 0, false
+	}
+
+	return int32(parsed), true
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errNotFound *apperrors.NotFound
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errNotFound):
+		response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
@@ -0,0 +1,191 @@
+package coaching
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubCoachingService struct {
+	invitationReq InvitationRequest
+	linkID        int32
+	athleteID     string
+	err           error
+}
+
+func (s *stubCoachingService) CreateInvitation(_ context.Context, req InvitationRequest) (*InvitationResponse, error) {
+	s.invitationReq = req
+	return &InvitationResponse{ID: 1, Code: "code", Scopes: req.Scopes}, s.err
+}
+
+func (s *stubCoachingService) ListInvitations(context.Context) ([]InvitationResponse, error) {
+	return []InvitationResponse{}, s.err
+}
+
+func (s *stubCoachingService) AcceptInvitation(context.Context, AcceptInvitationRequest) (*LinkResponse, error) {
+	return &LinkResponse{ID: 1}, s.err
+}
+
+func (s *stubCoachingService) ListAthletes(context.Context) ([]LinkResponse, error) {
+	return []LinkResponse{{ID: 1, CoachID: "coach-1", AthleteID: "athlete-1"}}, s.err
+}
+
+func (s *stubCoachingService) ListCoaches(context.Context) ([]LinkResponse, error) {
+	return []LinkResponse{}, s.err
+}
+
+func (s *stubCoachingService) UpdateScopes(_ context.Context, linkID int32, req UpdateScopesRequest) (*LinkResponse, error) {
+	s.linkID = linkID
+	return &LinkResponse{ID: linkID, Scopes: req.Scopes}, s.err
+}
+
+func (s *stubCoachingService) DeleteLink(_ context.Context, linkID int32) error {
+	s.linkID = linkID
+	return s.err
+}
+
+func (s *stubCoachingService) CreateSuggestion(_ context.Context, athleteID string, _ SuggestionRequest) (*SuggestionResponse, error) {
+	s.athleteID = athleteID
+	return &SuggestionResponse{ID: 1}, s.err
+}
+
+func (s *stubCoachingService) ListSuggestions(context.Context) ([]SuggestionResponse, error) {
+	return []SuggestionResponse{}, s.err
+}
+
+func (s *stubCoachingService) DismissSuggestion(context.Context, int32) error {
+	return s.err
+}
+
+func TestHandlerCreateInvitation(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("decodes scopes and returns created invitation", func(t *testing.T) {
+		service := &stubCoachingService{}
+		req := httptest.NewRequest(http.MethodPost, "/api/coaching/invitations", strings.NewReader(`{"scopes":["read_workouts"]}`))
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).CreateInvitation(rr, req)
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, []string{"read_workouts"}, service.invitationReq.Scopes)
+		assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+		assert.Contains(t, rr.Body.String(), `"code":"code"`)
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/coaching/invitations", strings.NewReader(`{"scopes":["read_workouts"],"admin":true}`))
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubCoachingService{}).CreateInvitation(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps validation errors to bad request", func(t *testing.T) {
+		service := &stubCoachingService{err: &ValidationError{Field: "scopes", Message: "at least one scope is required"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/coaching/invitations", strings.NewReader(`{"scopes":[]}`))
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).CreateInvitation(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "scopes")
+	})
+}
+
+func TestHandlerUpdateScopes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("passes link ID from path", func(t *testing.T) {
+		service := &stubCoachingService{}
+		req := httptest.NewRequest(http.MethodPut, "/api/coaching/links/3/scopes", strings.NewReader(`{"scopes":["read_metrics"]}`))
+		req.SetPathValue("id", "3")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).UpdateScopes(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, int32(3), service.linkID)
+	})
+
+	t.Run("rejects invalid link ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/coaching/links/abc/scopes", strings.NewReader(`{"scopes":["read_metrics"]}`))
+		req.SetPathValue("id", "abc")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubCoachingService{}).UpdateScopes(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps not found", func(t *testing.T) {
+		service := &stubCoachingService{err: &apperrors.NotFound{Resource: "coaching link", ID: "3"}}
+		req := httptest.NewRequest(http.MethodPut, "/api/coaching/links/3/scopes", strings.NewReader(`{"scopes":["read_metrics"]}`))
+		req.SetPathValue("id", "3")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).UpdateScopes(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestHandlerDeleteLink(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("returns no content", func(t *testing.T) {
+		service := &stubCoachingService{}
+		req := httptest.NewRequest(http.MethodDelete, "/api/coaching/links/8", nil)
+		req.SetPathValue("id", "8")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).DeleteLink(rr, req)
+
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, int32(8), service.linkID)
+	})
+
+	t.Run("maps service failures", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/api/coaching/links/8", nil)
+		req.SetPathValue("id", "8")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubCoachingService{err: errors.New("database unavailable")}).DeleteLink(rr, req)
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestHandlerCreateSuggestion(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	service := &stubCoachingService{}
+	req := httptest.NewRequest(http.MethodPost, "/api/coaching/athletes/athlete-1/suggestions", strings.NewReader(`{"title":"Day A","exercises":[{"name":"Squat","sets":3,"reps":5}]}`))
+	req.SetPathValue("athleteID", "athlete-1")
+	rr := httptest.NewRecorder()
+
+	NewHandler(logger, service).CreateSuggestion(rr, req)
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, "athlete-1", service.athleteID)
+}
+
+func TestHandlerListAthletesMapsUnauthorized(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	service := &stubCoachingService{err: &apperrors.Unauthorized{Resource: "coaching athletes"}}
+	req := httptest.NewRequest(http.MethodGet, "/api/coaching/athletes", nil)
+	rr := httptest.NewRecorder()
+
+	NewHandler(logger, service).ListAthletes(rr, req)
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
@@ -0,0 +1,91 @@
+package coaching
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// inviteCodeBytes of randomness encode to a 22-character URL-safe code.
+	inviteCodeBytes          = 16
+	inviteCodeLength         = 22
+	inviteLifetime           = 7 * 24 * time.Hour
+	maxUserIDLength          = 256
+	maxSuggestionTitleLength = 256
+	maxSuggestionNotesLength = 4000
+	maxSuggestedExercises    = 30
+	maxSuggestedSets         = 20
+	maxSuggestedReps         = 100
+	maxSuggestedWeight       = 2000
+	suggestionDateLayout     = "2006-01-02"
+)
+
+// InvitationRequest lists the scopes a coach asks an athlete to grant.
+type InvitationRequest struct {
+	Scopes []string `json:"scopes" example:"read_workouts,read_metrics"`
+}
+
+// InvitationResponse describes a pending invitation. The code is only
+// returned when the invitation is created, because only its hash is stored.
+type InvitationResponse struct {
+	ID        int32     `json:"id"`
+	Code      string    `json:"code,omitempty"`
+	Scopes    []string  `json:"scopes"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AcceptInvitationRequest struct {
+	Code string `json:"code"`
+}
+
+// LinkResponse is an accepted coach/athlete relationship.
+type LinkResponse struct {
+	ID        int32     `json:"id"`
+	CoachID   string    `json:"coach_id"`
+	AthleteID string    `json:"athlete_id"`
+	Scopes    []string  `json:"scopes"`
+	LinkedAt  time.Time `json:"linked_at"`
+}
+
+type UpdateScopesRequest struct {
+	Scopes []string `json:"scopes" example:"read_workouts"`
+}
+
+// SuggestionRequest is a workout a coach programs for an athlete. Weights
+// use the same units the athlete logs lifts in.
+type SuggestionRequest struct {
+	Title       string              `json:"title" example:"Lower body A"`
+	Notes       *string             `json:"notes"`
+	ScheduledOn *string             `json:"scheduled_on" example:"2026-07-06"`
+	Exercises   []SuggestedExercise `json:"exercises"`
+}
+
+type SuggestedExercise struct {
+	Name   string   `json:"name" example:"Back Squat"`
+	Sets   int      `json:"sets" example:"5"`
+	Reps   int      `json:"reps" example:"5"`
+	Weight *float64 `json:"weight,omitempty" example:"225"`
+}
+
+type SuggestionResponse struct {
+	ID          int32               `json:"id"`
+	CoachID     string              `json:"coach_id"`
+	Title       string              `json:"title"`
+	Notes       *string             `json:"notes,omitempty"`
+	ScheduledOn *string             `json:"scheduled_on,omitempty"`
+	Exercises   []SuggestedExercise `json:"exercises"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
@@ -0,0 +1,255 @@
+package coaching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// errAlreadyLinked is returned when an athlete accepts a second invitation
+// from a coach they are already linked with.
+var errAlreadyLinked = errors.New("already linked")
+
+type Repository interface {
+	CreateInvitation(ctx context.Context, coachID string, scopes []string, codeHash string, expiresAt time.Time) (*db.CoachingLink, error)
+	ListPendingInvitations(ctx context.Context, coachID string) ([]db.CoachingLink, error)
+	AcceptInvitation(ctx context.Context, athleteID string, codeHash string) (*db.CoachingLink, error)
+	ListAthletes(ctx context.Context, coachID string) ([]db.CoachingLink, error)
+	ListCoaches(ctx context.Context, athleteID string) ([]db.CoachingLink, error)
+	UpdateScopes(ctx context.Context, athleteID string, linkID int32, scopes []string) (*db.CoachingLink, error)
+	DeleteLink(ctx context.Context, userID string, linkID int32) (bool, error)
+	GetGrant(ctx context.Context, coachID string, athleteID string) (*db.GetCoachingGrantRow, error)
+	CreateSuggestion(ctx context.Context, params db.CreateCoachingSuggestionParams) (*db.CoachingSuggestion, error)
+	ListSuggestions(ctx context.Context, athleteID string) ([]db.CoachingSuggestion, error)
+	DismissSuggestion(ctx context.Context, athleteID string, suggestionID int32) (bool, error)
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, pool *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		pool:    pool,
+	}
+}
+
+func (r *repository) CreateInvitation(ctx context.Context, coachID string, scopes []string, codeHash string, expiresAt time.Time) (*db.CoachingLink, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	link, err := r.queries.CreateCoachingInvitation(ctx, db.CreateCoachingInvitationParams{
+		CoachUserID:     coachID,
+		Scopes:          scopes,
+		InviteCodeHash:  codeHash,
+		InviteExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("create coaching invitation failed - RLS policy violation",
+				"error", err,
+				"user_id", coachID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("create coaching invitation: %w", err)
+	}
+	return &link, nil
+}
+
+func (r *repository) ListPendingInvitations(ctx context.Context, coachID string) ([]db.CoachingLink, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	links, err := r.queries.ListPendingCoachingInvitations(ctx, coachID)
+	if err != nil {
+		return nil, fmt.Errorf("list coaching invitations: %w", err)
+	}
+	return links, nil
+}
+
+// AcceptInvitation links the athlete to the invitation's coach. It returns
+// nil when the code is unknown, expired or already used, and
+// errAlreadyLinked when the pair is linked through another invitation. A
+// pending invitation has no athlete yet, so the code hash is set for the
+// transaction to let the policies expose that one row.
+func (r *repository) AcceptInvitation(ctx context.Context, athleteID string, codeHash string) (*db.CoachingLink, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin coaching invitation transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT set_config('app.current_user_id', $1, true), set_config('app.current_token_hash', $2, true)", athleteID, codeHash); err != nil {
+		return nil, fmt.Errorf("set coaching invitation rls context: %w", err)
+	}
+
+	link, err := r.queries.WithTx(tx).AcceptCoachingInvitation(ctx, db.AcceptCoachingInvitationParams{
+		AthleteUserID:  athleteID,
+		InviteCodeHash: codeHash,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		if db.IsUniqueConstraintError(err) {
+			return nil, errAlreadyLinked
+		}
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("accept coaching invitation failed - RLS policy violation",
+				"error", err,
+				"user_id", athleteID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("accept coaching invitation: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit coaching invitation transaction: %w", err)
+	}
+	return &link, nil
+}
+
+func (r *repository) ListAthletes(ctx context.Context, coachID string) ([]db.CoachingLink, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	links, err := r.queries.ListCoachingAthletes(ctx, coachID)
+	if err != nil {
+		return nil, fmt.Errorf("list coaching athletes: %w", err)
+	}
+	return links, nil
+}
+
+func (r *repository) ListCoaches(ctx context.Context, athleteID string) ([]db.CoachingLink, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	links, err := r.queries.ListCoachingCoaches(ctx, athleteID)
+	if err != nil {
+		return nil, fmt.Errorf("list coaching coaches: %w", err)
+	}
+	return links, nil
+}
+
+// UpdateScopes replaces the scopes an athlete grants on one of their links,
+// returning nil when the link is not theirs.
+func (r *repository) UpdateScopes(ctx context.Context, athleteID string, linkID int32, scopes []string) (*db.CoachingLink, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	link, err := r.queries.UpdateCoachingLinkScopes(ctx, db.UpdateCoachingLinkScopesParams{
+		Scopes:        scopes,
+		ID:            linkID,
+		AthleteUserID: athleteID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("update coaching scopes failed - RLS policy violation",
+				"error", err,
+				"user_id", athleteID,
+				"link_id", linkID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("update coaching scopes: %w", err)
+	}
+	return &link, nil
+}
+
+func (r *repository) DeleteLink(ctx context.Context, userID string, linkID int32) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.DeleteCoachingLink(ctx, db.DeleteCoachingLinkParams{
+		ID:     linkID,
+		UserID: userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("delete coaching link: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// GetGrant returns the scopes and timezone behind an X-Act-As request, or nil
+// when the coach has no accepted link with the athlete.
+func (r *repository) GetGrant(ctx context.Context, coachID string, athleteID string) (*db.GetCoachingGrantRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	grant, err := r.queries.GetCoachingGrant(ctx, db.GetCoachingGrantParams{
+		CoachUserID:   coachID,
+		AthleteUserID: athleteID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get coaching grant: %w", err)
+	}
+	return &grant, nil
+}
+
+// CreateSuggestion stores a suggested workout, returning nil when the coach
+// lacks the suggest_workouts scope for the athlete.
+func (r *repository) CreateSuggestion(ctx context.Context, params db.CreateCoachingSuggestionParams) (*db.CoachingSuggestion, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	suggestion, err := r.queries.CreateCoachingSuggestion(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("create coaching suggestion failed - RLS policy violation",
+				"error", err,
+				"user_id", params.CoachUserID,
+				"athlete_id", params.AthleteUserID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("create coaching suggestion: %w", err)
+	}
+	return &suggestion, nil
+}
+
+func (r *repository) ListSuggestions(ctx context.Context, athleteID string) ([]db.CoachingSuggestion, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	suggestions, err := r.queries.ListCoachingSuggestions(ctx, athleteID)
+	if err != nil {
+		return nil, fmt.Errorf("list coaching suggestions: %w", err)
+	}
+	return suggestions, nil
+}
+
+func (r *repository) DismissSuggestion(ctx context.Context, athleteID string, suggestionID int32) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.DismissCoachingSuggestion(ctx, db.DismissCoachingSuggestionParams{
+		ID:            suggestionID,
+		AthleteUserID: athleteID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("dismiss coaching suggestion: %w", err)
+	}
+	return rows > 0, nil
+}
+
+var _ Repository = (*repository)(nil)
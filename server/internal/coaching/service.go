@@ -0,0 +1,398 @@
+package coaching
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Service struct {
+	logger *slog.Logger
+	repo   Repository
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// CreateInvitation issues a single-use code the coach hands to an athlete.
+// Accepting it grants the coach the requested scopes.
+func (s *Service) CreateInvitation(ctx context.Context, req InvitationRequest) (*InvitationResponse, error) {
+	coachID, err := currentUser(ctx, "coaching invitation")
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := normalizeScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	code, codeHash, err := newInviteCode()
+	if err != nil {
+		return nil, err
+	}
+	link, err := s.repo.CreateInvitation(ctx, coachID, scopes, codeHash, time.Now().UTC().Add(inviteLifetime))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create coaching invitation: %w", err)
+	}
+
+	resp := invitationResponse(*link)
+	resp.Code = code
+	return &resp, nil
+}
+
+// ListInvitations returns the coach's pending, unexpired invitations. Codes
+// are not recoverable, so they are omitted.
+func (s *Service) ListInvitations(ctx context.Context) ([]InvitationResponse, error) {
+	coachID, err := currentUser(ctx, "coaching invitations")
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.repo.ListPendingInvitations(ctx, coachID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list coaching invitations: %w", err)
+	}
+	invitations := make([]InvitationResponse, 0, len(links))
+	for _, link := range links {
+		invitations = append(invitations, invitationResponse(link))
+	}
+	return invitations, nil
+}
+
+// AcceptInvitation links the current user, as athlete, to the coach who
+// issued the code.
+func (s *Service) AcceptInvitation(ctx context.Context, req AcceptInvitationRequest) (*LinkResponse, error) {
+	athleteID, err := currentUser(ctx, "coaching invitation")
+	if err != nil {
+		return nil, err
+	}
+	code := strings.TrimSpace(req.Code)
+	if code == "" {
+		return nil, &ValidationError{Field: "code", Message: "is required"}
+	}
+	if !validInviteCode(code) {
+		return nil, &apperrors.NotFound{Resource: "coaching invitation", ID: code}
+	}
+
+	link, err := s.repo.AcceptInvitation(ctx, athleteID, hashInviteCode(code))
+	if err != nil {
+		if errors.Is(err, errAlreadyLinked) {
+			return nil, &ValidationError{Field: "code", Message: "you are already linked with this coach"}
+		}
+		return nil, fmt.Errorf("failed to accept coaching invitation: %w", err)
+	}
+	if link == nil {
+		return nil, &apperrors.NotFound{Resource: "coaching invitation", ID: code}
+	}
+
+	resp := linkResponse(*link)
+	return &resp, nil
+}
+
+// ListAthletes returns the athletes who accepted the current user's
+// invitations, with the scopes each one granted.
+func (s *Service) ListAthletes(ctx context.Context) ([]LinkResponse, error) {
+	coachID, err := currentUser(ctx, "coaching athletes")
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.repo.ListAthletes(ctx, coachID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list coaching athletes: %w", err)
+	}
+	return linkResponses(links), nil
+}
+
+// ListCoaches returns the coaches the current user has granted access to.
+func (s *Service) ListCoaches(ctx context.Context) ([]LinkResponse, error) {
+	athleteID, err := currentUser(ctx, "coaching coaches")
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.repo.ListCoaches(ctx, athleteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list coaching coaches: %w", err)
+	}
+	return linkResponses(links), nil
+}
+
+// UpdateScopes changes what a coach may do for the current user. Only the
+// athlete side of a link can change its scopes.
+func (s *Service) UpdateScopes(ctx context.Context, linkID int32, req UpdateScopesRequest) (*LinkResponse, error) {
+	athleteID, err := currentUser(ctx, "coaching link")
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := normalizeScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := s.repo.UpdateScopes(ctx, athleteID, linkID, scopes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update coaching scopes: %w", err)
+	}
+	if link == nil {
+		return nil, &apperrors.NotFound{Resource: "coaching link", ID: fmt.Sprintf("%d", linkID)}
+	}
+
+	resp := linkResponse(*link)
+	return &resp, nil
+}
+
+// DeleteLink ends a coaching relationship from either side, or cancels one of
+// the coach's pending invitations.
+func (s *Service) DeleteLink(ctx context.Context, linkID int32) error {
+	userID, err := currentUser(ctx, "coaching link")
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.repo.DeleteLink(ctx, userID, linkID)
+	if err != nil {
+		return fmt.Errorf("failed to delete coaching link: %w", err)
+	}
+	if !deleted {
+		return &apperrors.NotFound{Resource: "coaching link", ID: fmt.Sprintf("%d", linkID)}
+	}
+	return nil
+}
+
+// CreateSuggestion sends a suggested workout to an athlete who granted the
+// current user the suggest_workouts scope.
+func (s *Service) CreateSuggestion(ctx context.Context, athleteID string, req SuggestionRequest) (*SuggestionResponse, error) {
+	coachID, err := currentUser(ctx, "coaching suggestion")
+	if err != nil {
+		return nil, err
+	}
+	athleteID = strings.TrimSpace(athleteID)
+	if athleteID == "" || len(athleteID) > maxUserIDLength {
+		return nil, &ValidationError{Field: "athlete_id", Message: "is invalid"}
+	}
+
+	params, err := suggestionParams(req)
+	if err != nil {
+		return nil, err
+	}
+	params.CoachUserID = coachID
+	params.AthleteUserID = athleteID
+
+	suggestion, err := s.repo.CreateSuggestion(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create coaching suggestion: %w", err)
+	}
+	if suggestion == nil {
+		return nil, &apperrors.NotFound{Resource: "athlete", ID: athleteID}
+	}
+	return suggestionResponse(*suggestion)
+}
+
+// ListSuggestions returns the current user's undismissed suggestions, newest
+// first.
+func (s *Service) ListSuggestions(ctx context.Context) ([]SuggestionResponse, error) {
+	athleteID, err := currentUser(ctx, "coaching suggestions")
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, err := s.repo.ListSuggestions(ctx, athleteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list coaching suggestions: %w", err)
+	}
+	resp := make([]SuggestionResponse, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		item, err := suggestionResponse(suggestion)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, *item)
+	}
+	return resp, nil
+}
+
+func (s *Service) DismissSuggestion(ctx context.Context, suggestionID int32) error {
+	athleteID, err := currentUser(ctx, "coaching suggestion")
+	if err != nil {
+		return err
+	}
+
+	dismissed, err := s.repo.DismissSuggestion(ctx, athleteID, suggestionID)
+	if err != nil {
+		return fmt.Errorf("failed to dismiss coaching suggestion: %w", err)
+	}
+	if !dismissed {
+		return &apperrors.NotFound{Resource: "coaching suggestion", ID: fmt.Sprintf("%d", suggestionID)}
+	}
+	return nil
+}
+
+// ResolveDelegation returns the scopes an athlete granted a coach and the
+// athlete's timezone, for the auth middleware to build a delegated request.
+// Scopes are nil when the two are not linked.
+func (s *Service) ResolveDelegation(ctx context.Context, coachID string, athleteID string) ([]string, string, error) {
+	grant, err := s.repo.GetGrant(ctx, coachID, athleteID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve coaching delegation: %w", err)
+	}
+	if grant == nil {
+		return nil, "", nil
+	}
+	return grant.Scopes, grant.Timezone.String, nil
+}
+
+// currentUser returns the authenticated user. Coaching relationships are
+// managed by each party directly, never through a delegated request.
+func currentUser(ctx context.Context, resource string) (string, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return "", &apperrors.Unauthorized{Resource: resource, UserID: ""}
+	}
+	return userID, nil
+}
+
+// normalizeScopes validates requested scopes and returns them deduplicated in
+// display order.
+func normalizeScopes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nil, &ValidationError{Field: "scopes", Message: "at least one scope is required"}
+	}
+	for _, scope := range requested {
+		if !slices.Contains(user.Scopes, strings.TrimSpace(scope)) {
+			return nil, &ValidationError{Field: "scopes", Message: "must be read_workouts, read_metrics or suggest_workouts"}
+		}
+	}
+
+	scopes := make([]string, 0, len(user.Scopes))
+	for _, scope := range user.Scopes {
+		for _, candidate := range requested {
+			if strings.TrimSpace(candidate) == scope {
+				scopes = append(scopes, scope)
+				break
+			}
+		}
+	}
+	return scopes, nil
+}
+
+func suggestionParams(req SuggestionRequest) (db.CreateCoachingSuggestionParams, error) {
+	var params db.CreateCoachingSuggestionParams
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return params, &ValidationError{Field: "title", Message: "is required"}
+	}
+	if utf8.RuneCountInString(title) > maxSuggestionTitleLength {
+		return params, &ValidationError{Field: "title", Message: fmt.Sprintf("must be at most %d characters", maxSuggestionTitleLength)}
+	}
+	params.Title = title
+
+	if req.Notes != nil {
+		notes := strings.TrimSpace(*req.Notes)
+		if utf8.RuneCountInString(notes) > maxSuggestionNotesLength {
+			return params, &ValidationError{Field: "notes", Message: fmt.Sprintf("must be at most %d characters", maxSuggestionNotesLength)}
+		}
+		params.Notes = pgtype.Text{String: notes, Valid: notes != ""}
+	}
+
+	if req.ScheduledOn != nil && strings.TrimSpace(*req.ScheduledOn) != "" {
+		scheduledOn, err := time.Parse(suggestionDateLayout, strings.TrimSpace(*req.ScheduledOn))
+		if err != nil {
+			return params, &ValidationError{Field: "scheduled_on", Message: "must be a date in YYYY-MM-DD format"}
+		}
+		params.ScheduledOn = pgtype.Date{Time: scheduledOn, Valid: true}
+	}
+
+	if len(req.Exercises) == 0 || len(req.Exercises) > maxSuggestedExercises {
+		return params, &ValidationError{Field: "exercises", Message: fmt.Sprintf("must contain between 1 and %d exercises", maxSuggestedExercises)}
+	}
+	exercises := make([]SuggestedExercise, 0, len(req.Exercises))
+	for i, exercise := range req.Exercises {
+		field := fmt.Sprintf("exercises[%d]", i)
+		exercise.Name = strings.TrimSpace(exercise.Name)
+		switch {
+		case exercise.Name == "" || utf8.RuneCountInString(exercise.Name) > maxSuggestionTitleLength:
+			return params, &ValidationError{Field: field + ".name", Message: fmt.Sprintf("must be between 1 and %d characters", maxSuggestionTitleLength)}
+		case exercise.Sets < 1 || exercise.Sets > maxSuggestedSets:
+			return params, &ValidationError{Field: field + ".sets", Message: fmt.Sprintf("must be between 1 and %d", maxSuggestedSets)}
+		case exercise.Reps < 1 || exercise.Reps > maxSuggestedReps:
+			return params, &ValidationError{Field: field + ".reps", Message: fmt.Sprintf("must be between 1 and %d", maxSuggestedReps)}
+		case exercise.Weight != nil && (*exercise.Weight < 0 || *exercise.Weight > maxSuggestedWeight):
+			return params, &ValidationError{Field: field + ".weight", Message: fmt.Sprintf("must be between 0 and %d", maxSuggestedWeight)}
+		}
+		exercises = append(exercises, exercise)
+	}
+	encoded, err := json.Marshal(exercises)
+	if err != nil {
+		return params, fmt.Errorf("encode suggested exercises: %w", err)
+	}
+	params.Exercises = encoded
+	return params, nil
+}
+
+func invitationResponse(link db.CoachingLink) InvitationResponse {
+	return InvitationResponse{
+		ID:        link.ID,
+		Scopes:    link.Scopes,
+		ExpiresAt: link.InviteExpiresAt.Time,
+		CreatedAt: link.CreatedAt.Time,
+	}
+}
+
+func linkResponse(link db.CoachingLink) LinkResponse {
+	return LinkResponse{
+		ID:        link.ID,
+		CoachID:   link.CoachUserID,
+		AthleteID: link.AthleteUserID.String,
+		Scopes:    link.Scopes,
+		LinkedAt:  link.AcceptedAt.Time,
+	}
+}
+
+func linkResponses(links []db.CoachingLink) []LinkResponse {
+	resp := make([]LinkResponse, 0, len(links))
+	for _, link := range links {
+		resp = append(resp, linkResponse(link))
+	}
+	return resp
+}
+
+func suggestionResponse(suggestion db.CoachingSuggestion) (*SuggestionResponse, error) {
+	resp := &SuggestionResponse{
+		ID:        suggestion.ID,
+		CoachID:   suggestion.CoachUserID,
+		Title:     suggestion.Title,
+		Exercises: []SuggestedExercise{},
+		CreatedAt: suggestion.CreatedAt.Time,
+	}
+	if suggestion.Notes.Valid {
+		notes := suggestion.Notes.String
+		resp.Notes = &notes
+	}
+	if suggestion.ScheduledOn.Valid {
+		scheduledOn := suggestion.ScheduledOn.Time.Format(suggestionDateLayout)
+		resp.ScheduledOn = &scheduledOn
+	}
+	if len(suggestion.Exercises) > 0 {
+		if err := json.Unmarshal(suggestion.Exercises, &resp.Exercises); err != nil {
+			return nil, fmt.Errorf("decode suggested exercises: %w", err)
+		}
+	}
+	return resp, nil
+}
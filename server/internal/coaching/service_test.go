@@ -0,0 +1,240 @@
+package coaching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRepository struct {
+	createdScopes   []string
+	createdHash     string
+	acceptedHash    string
+	accepted        *db.CoachingLink
+	acceptErr       error
+	updated         *db.CoachingLink
+	deleted         bool
+	grant           *db.GetCoachingGrantRow
+	suggestion      *db.CoachingSuggestion
+	suggestionInput db.CreateCoachingSuggestionParams
+	suggestions     []db.CoachingSuggestion
+}
+
+func (r *stubRepository) CreateInvitation(_ context.Context, coachID string, scopes []string, codeHash string, expiresAt time.Time) (*db.CoachingLink, error) {
+	r.createdScopes = scopes
+	r.createdHash = codeHash
+	return &db.CoachingLink{
+		ID:              1,
+		CoachUserID:     coachID,
+		Scopes:          scopes,
+		InviteCodeHash:  pgtype.Text{String: codeHash, Valid: true},
+		InviteExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	}, nil
+}
+
+func (r *stubRepository) ListPendingInvitations(context.Context, string) ([]db.CoachingLink, error) {
+	return nil, nil
+}
+
+func (r *stubRepository) AcceptInvitation(_ context.Context, _ string, codeHash string) (*db.CoachingLink, error) {
+	r.acceptedHash = codeHash
+	return r.accepted, r.acceptErr
+}
+
+func (r *stubRepository) ListAthletes(context.Context, string) ([]db.CoachingLink, error) {
+	return nil, nil
+}
+
+func (r *stubRepository) ListCoaches(context.Context, string) ([]db.CoachingLink, error) {
+	return nil, nil
+}
+
+func (r *stubRepository) UpdateScopes(context.Context, string, int32, []string) (*db.CoachingLink, error) {
+	return r.updated, nil
+}
+
+func (r *stubRepository) DeleteLink(context.Context, string, int32) (bool, error) {
+	return r.deleted, nil
+}
+
+func (r *stubRepository) GetGrant(context.Context, string, string) (*db.GetCoachingGrantRow, error) {
+	return r.grant, nil
+}
+
+func (r *stubRepository) CreateSuggestion(_ context.Context, params db.CreateCoachingSuggestionParams) (*db.CoachingSuggestion, error) {
+	r.suggestionInput = params
+	return r.suggestion, nil
+}
+
+func (r *stubRepository) ListSuggestions(context.Context, string) ([]db.CoachingSuggestion, error) {
+	return r.suggestions, nil
+}
+
+func (r *stubRepository) DismissSuggestion(context.Context, string, int32) (bool, error) {
+	return false, nil
+}
+
+func TestServiceCreateInvitation(t *testing.T) {
+	repo := &stubRepository{}
+	service := NewService(nil, repo)
+	ctx := user.WithContext(context.Background(), "coach-1")
+
+	invitation, err := service.CreateInvitation(ctx, InvitationRequest{
+		Scopes: []string{user.ScopeSuggestWorkouts, " read_workouts ", user.ScopeSuggestWorkouts},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, invitation.Code, inviteCodeLength)
+	assert.Equal(t, hashInviteCode(invitation.Code), repo.createdHash)
+	assert.Equal(t, []string{user.ScopeReadWorkouts, user.ScopeSuggestWorkouts}, repo.createdScopes)
+	assert.WithinDuration(t, time.Now().Add(inviteLifetime), invitation.ExpiresAt, time.Minute)
+}
+
+func TestServiceCreateInvitationRejectsInvalidScopes(t *testing.T) {
+	service := NewService(nil, &stubRepository{})
+	ctx := user.WithContext(context.Background(), "coach-1")
+
+	for _, scopes := range [][]string{nil, {"write_workouts"}} {
+		_, err := service.CreateInvitation(ctx, InvitationRequest{Scopes: scopes})
+
+		var errValidation *ValidationError
+		assert.ErrorAs(t, err, &errValidation)
+	}
+}
+
+func TestServiceRejectsDelegatedRequests(t *testing.T) {
+	service := NewService(nil, &stubRepository{})
+	ctx := user.WithDelegation(user.WithContext(context.Background(), "athlete-1"), user.Delegation{
+		ActorID: "coach-1",
+		Scopes:  user.Scopes,
+	})
+	var errUnauthorized *apperrors.Unauthorized
+
+	_, err := service.ListCoaches(ctx)
+	assert.ErrorAs(t, err, &errUnauthorized)
+	_, err = service.UpdateScopes(ctx, 1, UpdateScopesRequest{Scopes: user.Scopes})
+	assert.ErrorAs(t, err, &errUnauthorized)
+	assert.ErrorAs(t, service.DeleteLink(ctx, 1), &errUnauthorized)
+}
+
+func TestServiceAcceptInvitation(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "athlete-1")
+	code, _, err := newInviteCode()
+	require.NoError(t, err)
+
+	t.Run("links athlete to coach", func(t *testing.T) {
+		repo := &stubRepository{accepted: &db.CoachingLink{
+			ID:            4,
+			CoachUserID:   "coach-1",
+			AthleteUserID: pgtype.Text{String: "athlete-1", Valid: true},
+			Scopes:        []string{user.ScopeReadWorkouts},
+		}}
+
+		link, err := NewService(nil, repo).AcceptInvitation(ctx, AcceptInvitationRequest{Code: code})
+
+		require.NoError(t, err)
+		assert.Equal(t, hashInviteCode(code), repo.acceptedHash)
+		assert.Equal(t, "coach-1", link.CoachID)
+		assert.Equal(t, "athlete-1", link.AthleteID)
+	})
+
+	t.Run("unknown or expired code is not found", func(t *testing.T) {
+		_, err := NewService(nil, &stubRepository{}).AcceptInvitation(ctx, AcceptInvitationRequest{Code: code})
+
+		var errNotFound *apperrors.NotFound
+		assert.ErrorAs(t, err, &errNotFound)
+	})
+
+	t.Run("malformed code skips lookup", func(t *testing.T) {
+		repo := &stubRepository{}
+
+		_, err := NewService(nil, repo).AcceptInvitation(ctx, AcceptInvitationRequest{Code: "short"})
+
+		var errNotFound *apperrors.NotFound
+		assert.ErrorAs(t, err, &errNotFound)
+		assert.Empty(t, repo.acceptedHash)
+	})
+
+	t.Run("existing link is a validation error", func(t *testing.T) {
+		_, err := NewService(nil, &stubRepository{acceptErr: errAlreadyLinked}).AcceptInvitation(ctx, AcceptInvitationRequest{Code: code})
+
+		var errValidation *ValidationError
+		assert.ErrorAs(t, err, &errValidation)
+	})
+}
+
+func TestServiceCreateSuggestion(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "coach-1")
+	weight := 225.0
+	req := SuggestionRequest{
+		Title:       "  Lower body A ",
+		ScheduledOn: stringPtr("2026-07-06"),
+		Exercises:   []SuggestedExercise{{Name: " Back Squat ", Sets: 5, Reps: 5, Weight: &weight}},
+	}
+
+	t.Run("stores validated suggestion", func(t *testing.T) {
+		repo := &stubRepository{suggestion: &db.CoachingSuggestion{
+			ID:          9,
+			CoachUserID: "coach-1",
+			Title:       "Lower body A",
+			ScheduledOn: pgtype.Date{Time: time.Date(2026, 7, 6, 0, 0, 0, 0, time.UTC), Valid: true},
+			Exercises:   []byte(`[{"name":"Back Squat","sets":5,"reps":5,"weight":225}]`),
+		}}
+
+		suggestion, err := NewService(nil, repo).CreateSuggestion(ctx, "athlete-1", req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Lower body A", repo.suggestionInput.Title)
+		assert.Equal(t, "coach-1", repo.suggestionInput.CoachUserID)
+		assert.Equal(t, "athlete-1", repo.suggestionInput.AthleteUserID)
+		assert.JSONEq(t, `[{"name":"Back Squat","sets":5,"reps":5,"weight":225}]`, string(repo.suggestionInput.Exercises))
+		assert.Equal(t, "2026-07-06", *suggestion.ScheduledOn)
+		require.Len(t, suggestion.Exercises, 1)
+		assert.Equal(t, "Back Squat", suggestion.Exercises[0].Name)
+	})
+
+	t.Run("coach without suggest scope gets not found", func(t *testing.T) {
+		_, err := NewService(nil, &stubRepository{}).CreateSuggestion(ctx, "athlete-1", req)
+
+		var errNotFound *apperrors.NotFound
+		assert.ErrorAs(t, err, &errNotFound)
+	})
+
+	t.Run("rejects invalid exercises", func(t *testing.T) {
+		invalid := req
+		invalid.Exercises = []SuggestedExercise{{Name: "Back Squat", Sets: 0, Reps: 5}}
+
+		_, err := NewService(nil, &stubRepository{}).CreateSuggestion(ctx, "athlete-1", invalid)
+
+		var errValidation *ValidationError
+		require.ErrorAs(t, err, &errValidation)
+		assert.Equal(t, "exercises[0].sets", errValidation.Field)
+	})
+}
+
+func TestServiceResolveDelegation(t *testing.T) {
+	scopes, timezone, err := NewService(nil, &stubRepository{}).ResolveDelegation(context.Background(), "coach-1", "athlete-1")
+	require.NoError(t, err)
+	assert.Nil(t, scopes)
+	assert.Empty(t, timezone)
+
+	repo := &stubRepository{grant: &db.GetCoachingGrantRow{
+		Scopes:   []string{user.ScopeReadMetrics},
+		Timezone: pgtype.Text{String: "Europe/Berlin", Valid: true},
+	}}
+	scopes, timezone, err = NewService(nil, repo).ResolveDelegation(context.Background(), "coach-1", "athlete-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{user.ScopeReadMetrics}, scopes)
+	assert.Equal(t, "Europe/Berlin", timezone)
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
@@ -0,0 +1,239 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoachingInvitationAcceptanceRLS(t *testing.T) {
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, getTestDatabaseURL())
+	require.NoError(t, err)
+	defer pool.Close()
+	require.NoError(t, pool.Ping(ctx))
+
+	coach := "coaching-rls-test-coach"
+	athlete := "coaching-rls-test-athlete"
+	stranger := "coaching-rls-test-stranger"
+	codeHash := strings.Repeat("d", 64)
+
+	withRLSEnforced(t, pool, "coaching_link", func(ctx context.Context, tx pgx.Tx) {
+		for _, userID := range []string{coach, athlete, stranger} {
+			setRLSUser(t, ctx, tx, userID)
+			_, err := tx.Exec(ctx, "INSERT INTO users (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING", userID)
+			require.NoError(t, err)
+		}
+
+		queries := New(tx)
+		setRLSUser(t, ctx, tx, coach)
+		invite, err := queries.CreateCoachingInvitation(ctx, CreateCoachingInvitationParams{
+			CoachUserID:     coach,
+			Scopes:          []string{"read_workouts"},
+			InviteCodeHash:  codeHash,
+			InviteExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+		})
+		require.NoError(t, err)
+
+		setRLSUser(t, ctx, tx, stranger)
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM coaching_link WHERE id = $1", invite.ID), "pending invitations are hidden without the code")
+
+		setRLSUser(t, ctx, tx, athlete)
+		_, err = queries.AcceptCoachingInvitation(ctx, AcceptCoachingInvitationParams{AthleteUserID: athlete, InviteCodeHash: codeHash})
+		require.True(t, errors.Is(err, pgx.ErrNoRows), "acceptance without the code hash set must match nothing, got %v", err)
+
+		setRLSTokenHash(t, ctx, tx, codeHash)
+		link, err := queries.AcceptCoachingInvitation(ctx, AcceptCoachingInvitationParams{AthleteUserID: athlete, InviteCodeHash: codeHash})
+		require.NoError(t, err)
+		require.Equal(t, invite.ID, link.ID)
+		require.Equal(t, athlete, link.AthleteUserID.String)
+
+		_, err = queries.AcceptCoachingInvitation(ctx, AcceptCoachingInvitationParams{AthleteUserID: athlete, InviteCodeHash: codeHash})
+		require.True(t, errors.Is(err, pgx.ErrNoRows), "an accepted invitation cannot be accepted again, got %v", err)
+
+		setRLSUser(t, ctx, tx, coach)
+		require.Equal(t, 1, countRows(t, ctx, tx, "SELECT COUNT(*) FROM coaching_link WHERE id = $1 AND athlete_user_id = $2", invite.ID, athlete))
+	})
+}
+
+func TestCoachingGrantLookupRLS(t *testing.T) {
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, getTestDatabaseURL())
+	require.NoError(t, err)
+	defer pool.Close()
+	require.NoError(t, pool.Ping(ctx))
+
+	coach := "coaching-grant-test-coach"
+	athlete := "coaching-grant-test-athlete"
+	stranger := "coaching-grant-test-stranger"
+	codeHash := strings.Repeat("c", 64)
+
+	withRLSEnforced(t, pool, "users", func(ctx context.Context, tx pgx.Tx) {
+		for _, userID := range []string{coach, athlete, stranger} {
+			setRLSUser(t, ctx, tx, userID)
+			_, err := tx.Exec(ctx, "INSERT INTO users (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING", userID)
+			require.NoError(t, err)
+		}
+		setRLSUser(t, ctx, tx, athlete)
+		_, err := tx.Exec(ctx, "UPDATE users SET timezone = 'Europe/Berlin' WHERE user_id = $1", athlete)
+		require.NoError(t, err)
+
+		queries := New(tx)
+		setRLSUser(t, ctx, tx, coach)
+		_, err = queries.CreateCoachingInvitation(ctx, CreateCoachingInvitationParams{
+			CoachUserID:     coach,
+			Scopes:          []string{"read_metrics"},
+			InviteCodeHash:  codeHash,
+			InviteExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+		})
+		require.NoError(t, err)
+
+		setRLSUser(t, ctx, tx, coach)
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM users WHERE user_id = $1", athlete), "a pending invitation exposes no athlete")
+
+		setRLSUser(t, ctx, tx, athlete)
+		setRLSTokenHash(t, ctx, tx, codeHash)
+		_, err = queries.AcceptCoachingInvitation(ctx, AcceptCoachingInvitationParams{AthleteUserID: athlete, InviteCodeHash: codeHash})
+		require.NoError(t, err)
+		setRLSTokenHash(t, ctx, tx, "")
+
+		// X-Act-As is resolved while the session user is still the coach.
+		setRLSUser(t, ctx, tx, coach)
+		grant, err := queries.GetCoachingGrant(ctx, GetCoachingGrantParams{CoachUserID: coach, AthleteUserID: athlete})
+		require.NoError(t, err)
+		require.Equal(t, []string{"read_metrics"}, grant.Scopes)
+		require.Equal(t, "Europe/Berlin", grant.Timezone.String)
+
+		setRLSUser(t, ctx, tx, stranger)
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM users WHERE user_id = $1", athlete), "users without a link cannot see the athlete")
+		_, err = queries.GetCoachingGrant(ctx, GetCoachingGrantParams{CoachUserID: stranger, AthleteUserID: athlete})
+		require.True(t, errors.Is(err, pgx.ErrNoRows), "got %v", err)
+	})
+}
+
+func TestCoachReadScopePolicies(t *testing.T) {
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, getTestDatabaseURL())
+	require.NoError(t, err)
+	defer pool.Close()
+	require.NoError(t, pool.Ping(ctx))
+
+	athlete := "coaching-scope-test-athlete"
+	workoutsCoach := "coaching-scope-test-workouts-coach"
+	metricsCoach := "coaching-scope-test-metrics-coach"
+
+	withRLSEnforced(t, pool, "workout", func(ctx context.Context, tx pgx.Tx) {
+		for _, userID := range []string{athlete, workoutsCoach, metricsCoach} {
+			setRLSUser(t, ctx, tx, userID)
+			_, err := tx.Exec(ctx, "INSERT INTO users (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING", userID)
+			require.NoError(t, err)
+		}
+
+		setRLSUser(t, ctx, tx, athlete)
+		var workoutID int32
+		err := tx.QueryRow(ctx, "INSERT INTO workout (date, user_id) VALUES (NOW(), $1) RETURNING id", athlete).Scan(&workoutID)
+		require.NoError(t, err)
+		_, err = tx.Exec(ctx, "INSERT INTO body_metric_entry (user_id, measured_on, bodyweight) VALUES ($1, CURRENT_DATE, 80)", athlete)
+		require.NoError(t, err)
+
+		queries := New(tx)
+		for i, grant := range []struct {
+			coach string
+			scope string
+		}{
+			{coach: workoutsCoach, scope: "read_workouts"},
+			{coach: metricsCoach, scope: "read_metrics"},
+		} {
+			codeHash := strings.Repeat(string(rune('e'+i)), 64)
+			setRLSUser(t, ctx, tx, grant.coach)
+			_, err := queries.CreateCoachingInvitation(ctx, CreateCoachingInvitationParams{
+				CoachUserID:     grant.coach,
+				Scopes:          []string{grant.scope},
+				InviteCodeHash:  codeHash,
+				InviteExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+			})
+			require.NoError(t, err)
+
+			setRLSUser(t, ctx, tx, athlete)
+			setRLSTokenHash(t, ctx, tx, codeHash)
+			_, err = queries.AcceptCoachingInvitation(ctx, AcceptCoachingInvitationParams{AthleteUserID: athlete, InviteCodeHash: codeHash})
+			require.NoError(t, err)
+		}
+		setRLSTokenHash(t, ctx, tx, "")
+
+		setRLSUser(t, ctx, tx, workoutsCoach)
+		require.Equal(t, 1, countRows(t, ctx, tx, "SELECT COUNT(*) FROM workout WHERE id = $1", workoutID))
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM body_metric_entry WHERE user_id = $1", athlete))
+
+		setRLSUser(t, ctx, tx, metricsCoach)
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM workout WHERE id = $1", workoutID), "read_metrics must not expose raw workouts")
+		require.Equal(t, 1, countRows(t, ctx, tx, "SELECT COUNT(*) FROM body_metric_entry WHERE user_id = $1", athlete))
+	})
+}
+
+func TestCoachingLinkUpdatesRLS(t *testing.T) {
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, getTestDatabaseURL())
+	require.NoError(t, err)
+	defer pool.Close()
+	require.NoError(t, pool.Ping(ctx))
+
+	coach := "coaching-update-test-coach"
+	athlete := "coaching-update-test-athlete"
+	stranger := "coaching-update-test-stranger"
+	codeHash := strings.Repeat("b", 64)
+
+	withRLSEnforced(t, pool, "coaching_link", func(ctx context.Context, tx pgx.Tx) {
+		for _, userID := range []string{coach, athlete, stranger} {
+			setRLSUser(t, ctx, tx, userID)
+			_, err := tx.Exec(ctx, "INSERT INTO users (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING", userID)
+			require.NoError(t, err)
+		}
+
+		queries := New(tx)
+		setRLSUser(t, ctx, tx, coach)
+		invite, err := queries.CreateCoachingInvitation(ctx, CreateCoachingInvitationParams{
+			CoachUserID:     coach,
+			Scopes:          []string{"suggest_workouts"},
+			InviteCodeHash:  codeHash,
+			InviteExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+		})
+		require.NoError(t, err)
+
+		tag, err := tx.Exec(ctx, "UPDATE coaching_link SET invite_expires_at = invite_expires_at + INTERVAL '1 day' WHERE id = $1", invite.ID)
+		require.NoError(t, err)
+		require.Zero(t, tag.RowsAffected(), "coaches cannot update their pending invitations")
+
+		setRLSUser(t, ctx, tx, athlete)
+		setRLSTokenHash(t, ctx, tx, codeHash)
+		_, err = queries.AcceptCoachingInvitation(ctx, AcceptCoachingInvitationParams{AthleteUserID: athlete, InviteCodeHash: codeHash})
+		require.NoError(t, err)
+		setRLSTokenHash(t, ctx, tx, "")
+
+		setRLSUser(t, ctx, tx, coach)
+		tag, err = tx.Exec(ctx, "UPDATE coaching_link SET scopes = ARRAY['read_workouts', 'read_metrics'] WHERE id = $1", invite.ID)
+		require.NoError(t, err)
+		require.Zero(t, tag.RowsAffected(), "coaches cannot grant themselves scopes")
+		_, err = queries.UpdateCoachingLinkScopes(ctx, UpdateCoachingLinkScopesParams{Scopes: []string{"read_workouts"}, ID: invite.ID, AthleteUserID: athlete})
+		require.True(t, errors.Is(err, pgx.ErrNoRows), "got %v", err)
+
+		setRLSUser(t, ctx, tx, athlete)
+		link, err := queries.UpdateCoachingLinkScopes(ctx, UpdateCoachingLinkScopesParams{Scopes: []string{"read_metrics"}, ID: invite.ID, AthleteUserID: athlete})
+		require.NoError(t, err)
+		require.Equal(t, []string{"read_metrics"}, link.Scopes)
+
+		_, err = tx.Exec(ctx, "SAVEPOINT reassign")
+		require.NoError(t, err)
+		_, err = tx.Exec(ctx, "UPDATE coaching_link SET coach_user_id = $1 WHERE id = $2", stranger, invite.ID)
+		require.Error(t, err, "the coach on a link never changes")
+		_, err = tx.Exec(ctx, "ROLLBACK TO SAVEPOINT reassign")
+		require.NoError(t, err)
+	})
+}
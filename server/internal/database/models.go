@@ -74,6 +74,54 @@ type AiChatTrialPromptUsage struct {
 	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
 }
 
+type BodyMetricEntry struct {
+	ID             int32              `json:"id"`
+	UserID         string             `json:"user_id"`
+	MeasuredOn     pgtype.Date        `json:"measured_on"`
+	Bodyweight     pgtype.Numeric     `json:"bodyweight"`
+	BodyFatPercent pgtype.Numeric     `json:"body_fat_percent"`
+	Neck           pgtype.Numeric     `json:"neck"`
+	Chest          pgtype.Numeric     `json:"chest"`
+	Waist          pgtype.Numeric     `json:"waist"`
+	Hips           pgtype.Numeric     `json:"hips"`
+	Arm            pgtype.Numeric     `json:"arm"`
+	Thigh          pgtype.Numeric     `json:"thigh"`
+	Notes          pgtype.Text        `json:"notes"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CalendarFeed struct {
+	UserID    string             `json:"user_id"`
+	TokenHash string             `json:"token_hash"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	RotatedAt pgtype.Timestamptz `json:"rotated_at"`
+}
+
+type CoachingLink struct {
+	ID              int32              `json:"id"`
+	CoachUserID     string             `json:"coach_user_id"`
+	AthleteUserID   pgtype.Text        `json:"athlete_user_id"`
+	Scopes          []string           `json:"scopes"`
+	InviteCodeHash  pgtype.Text        `json:"invite_code_hash"`
+	InviteExpiresAt pgtype.Timestamptz `json:"invite_expires_at"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	AcceptedAt      pgtype.Timestamptz `json:"accepted_at"`
+}
+
+type CoachingSuggestion struct {
+	ID            int32              `json:"id"`
+	LinkID        int32              `json:"link_id"`
+	CoachUserID   string             `json:"coach_user_id"`
+	AthleteUserID string             `json:"athlete_user_id"`
+	Title         string             `json:"title"`
+	Notes         pgtype.Text        `json:"notes"`
+	ScheduledOn   pgtype.Date        `json:"scheduled_on"`
+	Exercises     []byte             `json:"exercises"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	DismissedAt   pgtype.Timestamptz `json:"dismissed_at"`
+}
+
 type Exercise struct {
 	ID                           int32              `json:"id"`
 	Name                         string             `json:"name"`
@@ -85,6 +133,50 @@ type Exercise struct {
 	UserID                       string             `json:"user_id"`
 }
 
+type ExerciseNote struct {
+	ID         int32              `json:"id"`
+	ExerciseID int32              `json:"exercise_id"`
+	UserID     string             `json:"user_id"`
+	Notes      pgtype.Text        `json:"notes"`
+	Settings   pgtype.Text        `json:"settings"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type Goal struct {
+	ID          int32              `json:"id"`
+	UserID      string             `json:"user_id"`
+	GoalType    string             `json:"goal_type"`
+	ExerciseID  pgtype.Int4        `json:"exercise_id"`
+	TargetValue pgtype.Numeric     `json:"target_value"`
+	StartValue  pgtype.Numeric     `json:"start_value"`
+	TargetDate  pgtype.Date        `json:"target_date"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type Notification struct {
+	ID        int32              `json:"id"`
+	UserID    string             `json:"user_id"`
+	Kind      string             `json:"kind"`
+	Title     string             `json:"title"`
+	Body      string             `json:"body"`
+	Data      []byte             `json:"data"`
+	ReadAt    pgtype.Timestamptz `json:"read_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type PersonalAccessToken struct {
+	ID          int32              `json:"id"`
+	UserID      string             `json:"user_id"`
+	Name        string             `json:"name"`
+	TokenHash   string             `json:"token_hash"`
+	TokenPrefix string             `json:"token_prefix"`
+	Scopes      []string           `json:"scopes"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+	RevokedAt   pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
 type Set struct {
 	ID            int32              `json:"id"`
 	ExerciseID    int32              `json:"exercise_id"`
@@ -97,6 +189,7 @@ type Set struct {
 	UserID        string             `json:"user_id"`
 	ExerciseOrder int32              `json:"exercise_order"`
 	SetOrder      int32              `json:"set_order"`
+	Notes         pgtype.Text        `json:"notes"`
 }
 
 type StripeCustomers struct {
@@ -129,6 +222,14 @@ type StripeWebhookEvents struct {
 	ProcessedAt   pgtype.Timestamptz `json:"processed_at"`
 }
 
+type UserAchievement struct {
+	ID             int32              `json:"id"`
+	UserID         string             `json:"user_id"`
+	AchievementKey string             `json:"achievement_key"`
+	WorkoutID      pgtype.Int4        `json:"workout_id"`
+	UnlockedAt     pgtype.Timestamptz `json:"unlocked_at"`
+}
+
 type UserFeatureAccess struct {
 	ID              int32              `json:"id"`
 	UserID          string             `json:"user_id"`
@@ -158,10 +259,59 @@ type UserTrainingProfile struct {
 	UpdatedAt                       pgtype.Timestamptz `json:"updated_at"`
 }
 
+type UserStrengthProfile struct {
+	UserID             string             `json:"user_id"`
+	Sex                pgtype.Text        `json:"sex"`
+	SquatExerciseID    pgtype.Int4        `json:"squat_exercise_id"`
+	BenchExerciseID    pgtype.Int4        `json:"bench_exercise_id"`
+	DeadliftExerciseID pgtype.Int4        `json:"deadlift_exercise_id"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+}
+
+type UserEquipment struct {
+	UserID            string             `json:"user_id"`
+	BarbellWeights    []byte             `json:"barbell_weights"`
+	Plates            []byte             `json:"plates"`
+	DumbbellIncrement pgtype.Numeric     `json:"dumbbell_increment"`
+	MachineStackStep  pgtype.Numeric     `json:"machine_stack_step"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+}
+
 type Users struct {
 	ID        int32              `json:"id"`
 	UserID    string             `json:"user_id"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	Timezone  pgtype.Text        `json:"timezone"`
+}
+
+type WebhookDelivery struct {
+	ID             int32              `json:"id"`
+	EndpointID     int32              `json:"endpoint_id"`
+	UserID         string             `json:"user_id"`
+	EventID        string             `json:"event_id"`
+	EventType      string             `json:"event_type"`
+	Payload        []byte             `json:"payload"`
+	Status         string             `json:"status"`
+	AttemptCount   int32              `json:"attempt_count"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	LastAttemptAt  pgtype.Timestamptz `json:"last_attempt_at"`
+	ResponseStatus pgtype.Int4        `json:"response_status"`
+	LastError      pgtype.Text        `json:"last_error"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type WebhookEndpoint struct {
+	ID         int32              `json:"id"`
+	UserID     string             `json:"user_id"`
+	Url        string             `json:"url"`
+	Secret     string             `json:"secret"`
+	EventTypes []string           `json:"event_types"`
+	Active     bool               `json:"active"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
 }
 
 type Workout struct {
@@ -173,3 +323,25 @@ type Workout struct {
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 	UserID       string             `json:"user_id"`
 }
+
+type WorkoutShare struct {
+	WorkoutID int32              `json:"workout_id"`
+	UserID    string             `json:"user_id"`
+	TokenHash string             `json:"token_hash"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type WorkoutTag struct {
+	ID        int32              `json:"id"`
+	UserID    string             `json:"user_id"`
+	Name      string             `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type WorkoutTagLink struct {
+	WorkoutID int32  `json:"workout_id"`
+	TagID     int32  `json:"tag_id"`
+	UserID    string `json:"user_id"`
+}
@@ -11,6 +11,43 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const acceptCoachingInvitation = `-- name: AcceptCoachingInvitation :one
+UPDATE coaching_link
+SET athlete_user_id = $1::text,
+    accepted_at = CURRENT_TIMESTAMP,
+    invite_code_hash = NULL,
+    invite_expires_at = NULL
+WHERE invite_code_hash = $2::text
+  AND athlete_user_id IS NULL
+  AND invite_expires_at > CURRENT_TIMESTAMP
+  AND coach_user_id <> $1::text
+RETURNING id, coach_user_id, athlete_user_id, scopes, invite_code_hash, invite_expires_at, created_at, accepted_at
+`
+
+type AcceptCoachingInvitationParams struct {
+	AthleteUserID  string `json:"athlete_user_id"`
+	InviteCodeHash string `json:"invite_code_hash"`
+}
+
+// Claims a pending, unexpired invitation for the athlete. Coaches cannot
+// accept their own invitations. The caller sets app.current_token_hash to
+// the code hash so the policies expose the pending row.
+func (q *Queries) AcceptCoachingInvitation(ctx context.Context, arg AcceptCoachingInvitationParams) (CoachingLink, error) {
+	row := q.db.QueryRow(ctx, acceptCoachingInvitation, arg.AthleteUserID, arg.InviteCodeHash)
+	var i CoachingLink
+	err := row.Scan(
+		&i.ID,
+		&i.CoachUserID,
+		&i.AthleteUserID,
+		&i.Scopes,
+		&i.InviteCodeHash,
+		&i.InviteExpiresAt,
+		&i.CreatedAt,
+		&i.AcceptedAt,
+	)
+	return i, err
+}
+
 const claimAIChatRunGeneration = `-- name: ClaimAIChatRunGeneration :one
 UPDATE ai_chat_run
 SET generation_status = 'generating',
@@ -101,6 +138,59 @@ func (q *Queries) ClaimAIChatRunGeneration(ctx context.Context, arg ClaimAIChatR
 	return i, err
 }
 
+const claimDueWebhookDeliveries = `-- name: ClaimDueWebhookDeliveries :many
+SELECT id, endpoint_id, user_id, event_id, event_type, payload, attempt_count, url, secret
+FROM claim_due_webhook_deliveries($1::int)
+`
+
+type ClaimDueWebhookDeliveriesRow struct {
+	ID           int32  `json:"id"`
+	EndpointID   int32  `json:"endpoint_id"`
+	UserID       string `json:"user_id"`
+	EventID      string `json:"event_id"`
+	EventType    string `json:"event_type"`
+	Payload      []byte `json:"payload"`
+	AttemptCount int32  `json:"attempt_count"`
+	Url          string `json:"url"`
+	Secret       string `json:"secret"`
+}
+
+// Claims due deliveries across all users for the dispatcher by leasing them
+// for five minutes, so a worker that dies mid-attempt has its rows retried.
+// Runs before any user context exists, so it goes through the SECURITY
+// DEFINER function from migration 00051 rather than around RLS. Deliveries
+// for inactive endpoints are skipped, so disabling an endpoint stops anything
+// it still has queued, including retries.
+func (q *Queries) ClaimDueWebhookDeliveries(ctx context.Context, batchSize int32) ([]ClaimDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimDueWebhookDeliveries, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueWebhookDeliveriesRow
+	for rows.Next() {
+		var i ClaimDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EndpointID,
+			&i.UserID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.AttemptCount,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const clearUserTrainingProfileConversationSource = `-- name: ClearUserTrainingProfileConversationSource :exec
 UPDATE user_training_profile
 SET
@@ -215,6 +305,45 @@ func (q *Queries) ConsumeAIChatTrialPromptForCurrentSubscription(ctx context.Con
 	return allowed, err
 }
 
+const countActivePersonalAccessTokens = `-- name: CountActivePersonalAccessTokens :one
+SELECT COUNT(*)
+FROM personal_access_token
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > CURRENT_TIMESTAMP
+`
+
+func (q *Queries) CountActivePersonalAccessTokens(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countActivePersonalAccessTokens, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUnreadNotifications = `-- name: CountUnreadNotifications :one
+SELECT COUNT(*)
+FROM notification
+WHERE user_id = $1 AND read_at IS NULL
+`
+
+func (q *Queries) CountUnreadNotifications(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnreadNotifications, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countWebhookEndpoints = `-- name: CountWebhookEndpoints :one
+SELECT COUNT(*)
+FROM webhook_endpoint
+WHERE user_id = $1
+`
+
+func (q *Queries) CountWebhookEndpoints(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countWebhookEndpoints, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAIChatConversation = `-- name: CreateAIChatConversation :one
 INSERT INTO ai_chat_conversation (
     user_id,
@@ -464,9 +593,317 @@ func (q *Queries) CreateAIChatStreamChunk(ctx context.Context, arg CreateAIChatS
 	return i, err
 }
 
+const createBodyMetricEntry = `-- name: CreateBodyMetricEntry :one
+INSERT INTO body_metric_entry (
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING
+    id,
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes,
+    created_at,
+    updated_at
+`
+
+type CreateBodyMetricEntryParams struct {
+	UserID         string         `json:"user_id"`
+	MeasuredOn     pgtype.Date    `json:"measured_on"`
+	Bodyweight     pgtype.Numeric `json:"bodyweight"`
+	BodyFatPercent pgtype.Numeric `json:"body_fat_percent"`
+	Neck           pgtype.Numeric `json:"neck"`
+	Chest          pgtype.Numeric `json:"chest"`
+	Waist          pgtype.Numeric `json:"waist"`
+	Hips           pgtype.Numeric `json:"hips"`
+	Arm            pgtype.Numeric `json:"arm"`
+	Thigh          pgtype.Numeric `json:"thigh"`
+	Notes          pgtype.Text    `json:"notes"`
+}
+
+func (q *Queries) CreateBodyMetricEntry(ctx context.Context, arg CreateBodyMetricEntryParams) (BodyMetricEntry, error) {
+	row := q.db.QueryRow(ctx, createBodyMetricEntry,
+		arg.UserID,
+		arg.MeasuredOn,
+		arg.Bodyweight,
+		arg.BodyFatPercent,
+		arg.Neck,
+		arg.Chest,
+		arg.Waist,
+		arg.Hips,
+		arg.Arm,
+		arg.Thigh,
+		arg.Notes,
+	)
+	var i BodyMetricEntry
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.MeasuredOn,
+		&i.Bodyweight,
+		&i.BodyFatPercent,
+		&i.Neck,
+		&i.Chest,
+		&i.Waist,
+		&i.Hips,
+		&i.Arm,
+		&i.Thigh,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createCoachingInvitation = `-- name: CreateCoachingInvitation :one
+INSERT INTO coaching_link (coach_user_id, scopes, invite_code_hash, invite_expires_at)
+VALUES ($1, $2, $3::text, $4::timestamptz)
+RETURNING id, coach_user_id, athlete_user_id, scopes, invite_code_hash, invite_expires_at, created_at, accepted_at
+`
+
+type CreateCoachingInvitationParams struct {
+	CoachUserID     string             `json:"coach_user_id"`
+	Scopes          []string           `json:"scopes"`
+	InviteCodeHash  string             `json:"invite_code_hash"`
+	InviteExpiresAt pgtype.Timestamptz `json:"invite_expires_at"`
+}
+
+func (q *Queries) CreateCoachingInvitation(ctx context.Context, arg CreateCoachingInvitationParams) (CoachingLink, error) {
+	row := q.db.QueryRow(ctx, createCoachingInvitation,
+		arg.CoachUserID,
+		arg.Scopes,
+		arg.InviteCodeHash,
+		arg.InviteExpiresAt,
+	)
+	var i CoachingLink
+	err := row.Scan(
+		&i.ID,
+		&i.CoachUserID,
+		&i.AthleteUserID,
+		&i.Scopes,
+		&i.InviteCodeHash,
+		&i.InviteExpiresAt,
+		&i.CreatedAt,
+		&i.AcceptedAt,
+	)
+	return i, err
+}
+
+const createCoachingSuggestion = `-- name: CreateCoachingSuggestion :one
+INSERT INTO coaching_suggestion (link_id, coach_user_id, athlete_user_id, title, notes, scheduled_on, exercises)
+SELECT l.id, l.coach_user_id, l.athlete_user_id, $1::text, $2::text, $3::date, $4::jsonb
+FROM coaching_link l
+WHERE l.coach_user_id = $5
+  AND l.athlete_user_id = $6::text
+  AND 'suggest_workouts' = ANY(l.scopes)
+RETURNING id, link_id, coach_user_id, athlete_user_id, title, notes, scheduled_on, exercises, created_at, dismissed_at
+`
+
+type CreateCoachingSuggestionParams struct {
+	Title         string      `json:"title"`
+	Notes         pgtype.Text `json:"notes"`
+	ScheduledOn   pgtype.Date `json:"scheduled_on"`
+	Exercises     []byte      `json:"exercises"`
+	CoachUserID   string      `json:"coach_user_id"`
+	AthleteUserID string      `json:"athlete_user_id"`
+}
+
+// Only coaches the athlete has granted suggest_workouts can add suggestions.
+func (q *Queries) CreateCoachingSuggestion(ctx context.Context, arg CreateCoachingSuggestionParams) (CoachingSuggestion, error) {
+	row := q.db.QueryRow(ctx, createCoachingSuggestion,
+		arg.Title,
+		arg.Notes,
+		arg.ScheduledOn,
+		arg.Exercises,
+		arg.CoachUserID,
+		arg.AthleteUserID,
+	)
+	var i CoachingSuggestion
+	err := row.Scan(
+		&i.ID,
+		&i.LinkID,
+		&i.CoachUserID,
+		&i.AthleteUserID,
+		&i.Title,
+		&i.Notes,
+		&i.ScheduledOn,
+		&i.Exercises,
+		&i.CreatedAt,
+		&i.DismissedAt,
+	)
+	return i, err
+}
+
+const createExerciseNote = `-- name: CreateExerciseNote :one
+INSERT INTO exercise_note (exercise_id, user_id, notes, settings)
+SELECT e.id, e.user_id, $1::text, $2::text
+FROM exercise e
+WHERE e.id = $3 AND e.user_id = $4
+RETURNING id, exercise_id, user_id, notes, settings, created_at
+`
+
+type CreateExerciseNoteParams struct {
+	Notes      pgtype.Text `json:"notes"`
+	Settings   pgtype.Text `json:"settings"`
+	ExerciseID int32       `json:"exercise_id"`
+	UserID     string      `json:"user_id"`
+}
+
+// Selecting from exercise keeps notes from being attached to another user's exercise.
+func (q *Queries) CreateExerciseNote(ctx context.Context, arg CreateExerciseNoteParams) (ExerciseNote, error) {
+	row := q.db.QueryRow(ctx, createExerciseNote,
+		arg.Notes,
+		arg.Settings,
+		arg.ExerciseID,
+		arg.UserID,
+	)
+	var i ExerciseNote
+	err := row.Scan(
+		&i.ID,
+		&i.ExerciseID,
+		&i.UserID,
+		&i.Notes,
+		&i.Settings,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createGoal = `-- name: CreateGoal :one
+INSERT INTO goal (user_id, goal_type, exercise_id, target_value, start_value, target_date)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, goal_type, exercise_id, target_value, start_value, target_date, created_at
+`
+
+type CreateGoalParams struct {
+	UserID      string         `json:"user_id"`
+	GoalType    string         `json:"goal_type"`
+	ExerciseID  pgtype.Int4    `json:"exercise_id"`
+	TargetValue pgtype.Numeric `json:"target_value"`
+	StartValue  pgtype.Numeric `json:"start_value"`
+	TargetDate  pgtype.Date    `json:"target_date"`
+}
+
+func (q *Queries) CreateGoal(ctx context.Context, arg CreateGoalParams) (Goal, error) {
+	row := q.db.QueryRow(ctx, createGoal,
+		arg.UserID,
+		arg.GoalType,
+		arg.ExerciseID,
+		arg.TargetValue,
+		arg.StartValue,
+		arg.TargetDate,
+	)
+	var i Goal
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.GoalType,
+		&i.ExerciseID,
+		&i.TargetValue,
+		&i.StartValue,
+		&i.TargetDate,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createNotification = `-- name: CreateNotification :one
+INSERT INTO notification (user_id, kind, title, body, data)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, user_id, kind, title, body, data, read_at, created_at
+`
+
+type CreateNotificationParams struct {
+	UserID string `json:"user_id"`
+	Kind   string `json:"kind"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	Data   []byte `json:"data"`
+}
+
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error) {
+	row := q.db.QueryRow(ctx, createNotification,
+		arg.UserID,
+		arg.Kind,
+		arg.Title,
+		arg.Body,
+		arg.Data,
+	)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Kind,
+		&i.Title,
+		&i.Body,
+		&i.Data,
+		&i.ReadAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPersonalAccessToken = `-- name: CreatePersonalAccessToken :one
+INSERT INTO personal_access_token (user_id, name, token_hash, token_prefix, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, name, token_hash, token_prefix, scopes, expires_at, last_used_at, revoked_at, created_at
+`
+
+type CreatePersonalAccessTokenParams struct {
+	UserID      string             `json:"user_id"`
+	Name        string             `json:"name"`
+	TokenHash   string             `json:"token_hash"`
+	TokenPrefix string             `json:"token_prefix"`
+	Scopes      []string           `json:"scopes"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreatePersonalAccessToken(ctx context.Context, arg CreatePersonalAccessTokenParams) (PersonalAccessToken, error) {
+	row := q.db.QueryRow(ctx, createPersonalAccessToken,
+		arg.UserID,
+		arg.Name,
+		arg.TokenHash,
+		arg.TokenPrefix,
+		arg.Scopes,
+		arg.ExpiresAt,
+	)
+	var i PersonalAccessToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.TokenHash,
+		&i.TokenPrefix,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const createSet = `-- name: CreateSet :one
-INSERT INTO "set" (exercise_id, workout_id, weight, reps, set_type, user_id, exercise_order, set_order)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO "set" (exercise_id, workout_id, weight, reps, set_type, user_id, exercise_order, set_order, notes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING id
 `
 
@@ -479,6 +916,7 @@ type CreateSetParams struct {
 	UserID        string         `json:"user_id"`
 	ExerciseOrder int32          `json:"exercise_order"`
 	SetOrder      int32          `json:"set_order"`
+	Notes         pgtype.Text    `json:"notes"`
 }
 
 func (q *Queries) CreateSet(ctx context.Context, arg CreateSetParams) (int32, error) {
@@ -491,6 +929,7 @@ func (q *Queries) CreateSet(ctx context.Context, arg CreateSetParams) (int32, er
 		arg.UserID,
 		arg.ExerciseOrder,
 		arg.SetOrder,
+		arg.Notes,
 	)
 	var id int32
 	err := row.Scan(&id)
@@ -510,6 +949,104 @@ func (q *Queries) CreateUser(ctx context.Context, userID string) (int32, error)
 	return id, err
 }
 
+const createUserAchievement = `-- name: CreateUserAchievement :execrows
+INSERT INTO user_achievement (user_id, achievement_key, workout_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, achievement_key) DO NOTHING
+`
+
+type CreateUserAchievementParams struct {
+	UserID         string      `json:"user_id"`
+	AchievementKey string      `json:"achievement_key"`
+	WorkoutID      pgtype.Int4 `json:"workout_id"`
+}
+
+func (q *Queries) CreateUserAchievement(ctx context.Context, arg CreateUserAchievementParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createUserAchievement, arg.UserID, arg.AchievementKey, arg.WorkoutID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_delivery (endpoint_id, user_id, event_id, event_type, payload, next_attempt_at)
+VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP + INTERVAL '5 minutes')
+RETURNING id, endpoint_id, user_id, event_id, event_type, payload, status, attempt_count, next_attempt_at, last_attempt_at, response_status, last_error, delivered_at, created_at
+`
+
+type CreateWebhookDeliveryParams struct {
+	EndpointID int32  `json:"endpoint_id"`
+	UserID     string `json:"user_id"`
+	EventID    string `json:"event_id"`
+	EventType  string `json:"event_type"`
+	Payload    []byte `json:"payload"`
+}
+
+// Queues a delivery to one endpoint that the caller attempts straight away,
+// so it starts out leased like a claimed row.
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, createWebhookDelivery,
+		arg.EndpointID,
+		arg.UserID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.UserID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.AttemptCount,
+		&i.NextAttemptAt,
+		&i.LastAttemptAt,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createWebhookEndpoint = `-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoint (user_id, url, secret, event_types)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, url, secret, event_types, active, created_at, updated_at
+`
+
+type CreateWebhookEndpointParams struct {
+	UserID     string   `json:"user_id"`
+	Url        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types"`
+}
+
+func (q *Queries) CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRow(ctx, createWebhookEndpoint,
+		arg.UserID,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+	)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const createWorkout = `-- name: CreateWorkout :one
 INSERT INTO workout (date, notes, workout_focus, user_id)
 VALUES ($1, $2, $3, $4)
@@ -536,6 +1073,47 @@ func (q *Queries) CreateWorkout(ctx context.Context, arg CreateWorkoutParams) (i
 	return id, err
 }
 
+const createWorkoutTag = `-- name: CreateWorkoutTag :one
+INSERT INTO workout_tag (user_id, name)
+VALUES ($1, $2)
+RETURNING id, user_id, name, created_at, updated_at
+`
+
+type CreateWorkoutTagParams struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+func (q *Queries) CreateWorkoutTag(ctx context.Context, arg CreateWorkoutTagParams) (WorkoutTag, error) {
+	row := q.db.QueryRow(ctx, createWorkoutTag, arg.UserID, arg.Name)
+	var i WorkoutTag
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createWorkoutTagLink = `-- name: CreateWorkoutTagLink :exec
+INSERT INTO workout_tag_link (workout_id, tag_id, user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (workout_id, tag_id) DO NOTHING
+`
+
+type CreateWorkoutTagLinkParams struct {
+	WorkoutID int32  `json:"workout_id"`
+	TagID     int32  `json:"tag_id"`
+	UserID    string `json:"user_id"`
+}
+
+func (q *Queries) CreateWorkoutTagLink(ctx context.Context, arg CreateWorkoutTagLinkParams) error {
+	_, err := q.db.Exec(ctx, createWorkoutTagLink, arg.WorkoutID, arg.TagID, arg.UserID)
+	return err
+}
+
 const deleteAIChatConversation = `-- name: DeleteAIChatConversation :execrows
 DELETE FROM ai_chat_conversation
 WHERE id = $1 AND user_id = $2
@@ -567,32 +1145,101 @@ func (q *Queries) DeleteAIChatConversationsByUser(ctx context.Context, userID st
 	return result.RowsAffected(), nil
 }
 
-const deleteExercise = `-- name: DeleteExercise :exec
-DELETE FROM exercise WHERE id = $1 AND user_id = $2
+const deleteBodyMetricEntry = `-- name: DeleteBodyMetricEntry :execrows
+DELETE FROM body_metric_entry
+WHERE id = $1 AND user_id = $2
 `
 
-type DeleteExerciseParams struct {
+type DeleteBodyMetricEntryParams struct {
 	ID     int32  `json:"id"`
 	UserID string `json:"user_id"`
 }
 
-func (q *Queries) DeleteExercise(ctx context.Context, arg DeleteExerciseParams) error {
-	_, err := q.db.Exec(ctx, deleteExercise, arg.ID, arg.UserID)
-	return err
+func (q *Queries) DeleteBodyMetricEntry(ctx context.Context, arg DeleteBodyMetricEntryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteBodyMetricEntry, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const deleteSetsByWorkout = `-- name: DeleteSetsByWorkout :exec
-DELETE FROM "set" 
-WHERE workout_id = $1 AND user_id = $2
+const deleteCalendarFeed = `-- name: DeleteCalendarFeed :execrows
+DELETE FROM calendar_feed
+WHERE user_id = $1
 `
 
-type DeleteSetsByWorkoutParams struct {
-	WorkoutID int32  `json:"workout_id"`
-	UserID    string `json:"user_id"`
+func (q *Queries) DeleteCalendarFeed(ctx context.Context, userID string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCalendarFeed, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-func (q *Queries) DeleteSetsByWorkout(ctx context.Context, arg DeleteSetsByWorkoutParams) error {
-	_, err := q.db.Exec(ctx, deleteSetsByWorkout, arg.WorkoutID, arg.UserID)
+const deleteCoachingLink = `-- name: DeleteCoachingLink :execrows
+DELETE FROM coaching_link
+WHERE id = $1
+  AND (coach_user_id = $2 OR athlete_user_id = $2)
+`
+
+type DeleteCoachingLinkParams struct {
+	ID     int32  `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+// Either party can end a link; coaches also use this to cancel invitations.
+func (q *Queries) DeleteCoachingLink(ctx context.Context, arg DeleteCoachingLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCoachingLink, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExercise = `-- name: DeleteExercise :exec
+DELETE FROM exercise WHERE id = $1 AND user_id = $2
+`
+
+type DeleteExerciseParams struct {
+	ID     int32  `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) DeleteExercise(ctx context.Context, arg DeleteExerciseParams) error {
+	_, err := q.db.Exec(ctx, deleteExercise, arg.ID, arg.UserID)
+	return err
+}
+
+const deleteGoal = `-- name: DeleteGoal :execrows
+DELETE FROM goal
+WHERE id = $1 AND user_id = $2
+`
+
+type DeleteGoalParams struct {
+	ID     int32  `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) DeleteGoal(ctx context.Context, arg DeleteGoalParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteGoal, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSetsByWorkout = `-- name: DeleteSetsByWorkout :exec
+DELETE FROM "set" 
+WHERE workout_id = $1 AND user_id = $2
+`
+
+type DeleteSetsByWorkoutParams struct {
+	WorkoutID int32  `json:"workout_id"`
+	UserID    string `json:"user_id"`
+}
+
+func (q *Queries) DeleteSetsByWorkout(ctx context.Context, arg DeleteSetsByWorkoutParams) error {
+	_, err := q.db.Exec(ctx, deleteSetsByWorkout, arg.WorkoutID, arg.UserID)
 	return err
 }
 
@@ -627,6 +1274,24 @@ func (q *Queries) DeleteUser(ctx context.Context, userID string) (int64, error)
 	return result.RowsAffected(), nil
 }
 
+const deleteWebhookEndpoint = `-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoint
+WHERE id = $1 AND user_id = $2
+`
+
+type DeleteWebhookEndpointParams struct {
+	ID     int32  `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) DeleteWebhookEndpoint(ctx context.Context, arg DeleteWebhookEndpointParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookEndpoint, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteWorkout = `-- name: DeleteWorkout :exec
 DELETE FROM workout 
 WHERE id = $1 
@@ -643,6 +1308,126 @@ func (q *Queries) DeleteWorkout(ctx context.Context, arg DeleteWorkoutParams) er
 	return err
 }
 
+const deleteWorkoutShare = `-- name: DeleteWorkoutShare :execrows
+DELETE FROM workout_share
+WHERE workout_id = $1 AND user_id = $2
+`
+
+type DeleteWorkoutShareParams struct {
+	WorkoutID int32  `json:"workout_id"`
+	UserID    string `json:"user_id"`
+}
+
+func (q *Queries) DeleteWorkoutShare(ctx context.Context, arg DeleteWorkoutShareParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWorkoutShare, arg.WorkoutID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWorkoutTag = `-- name: DeleteWorkoutTag :execrows
+DELETE FROM workout_tag
+WHERE id = $1 AND user_id = $2
+`
+
+type DeleteWorkoutTagParams struct {
+	ID     int32  `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) DeleteWorkoutTag(ctx context.Context, arg DeleteWorkoutTagParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWorkoutTag, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWorkoutTagLinks = `-- name: DeleteWorkoutTagLinks :exec
+DELETE FROM workout_tag_link
+WHERE workout_id = $1 AND user_id = $2
+`
+
+type DeleteWorkoutTagLinksParams struct {
+	WorkoutID int32  `json:"workout_id"`
+	UserID    string `json:"user_id"`
+}
+
+func (q *Queries) DeleteWorkoutTagLinks(ctx context.Context, arg DeleteWorkoutTagLinksParams) error {
+	_, err := q.db.Exec(ctx, deleteWorkoutTagLinks, arg.WorkoutID, arg.UserID)
+	return err
+}
+
+const dismissCoachingSuggestion = `-- name: DismissCoachingSuggestion :execrows
+UPDATE coaching_suggestion
+SET dismissed_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND athlete_user_id = $2 AND dismissed_at IS NULL
+`
+
+type DismissCoachingSuggestionParams struct {
+	ID            int32  `json:"id"`
+	AthleteUserID string `json:"athlete_user_id"`
+}
+
+func (q *Queries) DismissCoachingSuggestion(ctx context.Context, arg DismissCoachingSuggestionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, dismissCoachingSuggestion, arg.ID, arg.AthleteUserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueWebhookDeliveries = `-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_delivery (endpoint_id, user_id, event_id, event_type, payload)
+SELECT id, user_id, $1::varchar, $2::varchar, $3::jsonb
+FROM webhook_endpoint
+WHERE user_id = $4
+  AND active
+  AND $2::varchar = ANY(event_types)
+`
+
+type EnqueueWebhookDeliveriesParams struct {
+	EventID   string `json:"event_id"`
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+	UserID    string `json:"user_id"`
+}
+
+// Queues one delivery of the event for each active endpoint subscribed to it.
+func (q *Queries) EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueueWebhookDeliveries,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+		arg.UserID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const failPendingWebhookDeliveries = `-- name: FailPendingWebhookDeliveries :execrows
+UPDATE webhook_delivery
+SET status = 'failed', last_error = $1
+WHERE endpoint_id = $2 AND user_id = $3 AND status = 'pending'
+`
+
+type FailPendingWebhookDeliveriesParams struct {
+	LastError  pgtype.Text `json:"last_error"`
+	EndpointID int32       `json:"endpoint_id"`
+	UserID     string      `json:"user_id"`
+}
+
+func (q *Queries) FailPendingWebhookDeliveries(ctx context.Context, arg FailPendingWebhookDeliveriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, failPendingWebhookDeliveries, arg.LastError, arg.EndpointID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getAIChatConversation = `-- name: GetAIChatConversation :one
 SELECT
     id,
@@ -959,8 +1744,51 @@ func (q *Queries) GetActiveAIChatRunForConversation(ctx context.Context, arg Get
 	return i, err
 }
 
+const getActivePersonalAccessTokenByHash = `-- name: GetActivePersonalAccessTokenByHash :one
+SELECT id, user_id, scopes
+FROM personal_access_token
+WHERE token_hash = $1 AND revoked_at IS NULL AND expires_at > CURRENT_TIMESTAMP
+`
+
+type GetActivePersonalAccessTokenByHashRow struct {
+	ID     int32    `json:"id"`
+	UserID string   `json:"user_id"`
+	Scopes []string `json:"scopes"`
+}
+
+// Resolves a bearer token before any user context exists. The caller sets
+// app.current_token_hash so the select policy exposes only this row.
+func (q *Queries) GetActivePersonalAccessTokenByHash(ctx context.Context, tokenHash string) (GetActivePersonalAccessTokenByHashRow, error) {
+	row := q.db.QueryRow(ctx, getActivePersonalAccessTokenByHash, tokenHash)
+	var i GetActivePersonalAccessTokenByHashRow
+	err := row.Scan(&i.ID, &i.UserID, &i.Scopes)
+	return i, err
+}
+
+const getActiveWorkoutShareByTokenHash = `-- name: GetActiveWorkoutShareByTokenHash :one
+SELECT workout_id, user_id, token_hash, expires_at, created_at FROM workout_share
+WHERE token_hash = $1
+  AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+`
+
+// Resolves a share token before any user context exists. The caller sets
+// app.current_token_hash so the select policy exposes only this row.
+// Expired links do not resolve.
+func (q *Queries) GetActiveWorkoutShareByTokenHash(ctx context.Context, tokenHash string) (WorkoutShare, error) {
+	row := q.db.QueryRow(ctx, getActiveWorkoutShareByTokenHash, tokenHash)
+	var i WorkoutShare
+	err := row.Scan(
+		&i.WorkoutID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getBillingUserForUpdate = `-- name: GetBillingUserForUpdate :one
-SELECT id, user_id, created_at
+SELECT id, user_id, created_at, timezone
 FROM users
 WHERE user_id = $1
 FOR UPDATE
@@ -969,7 +1797,98 @@ FOR UPDATE
 func (q *Queries) GetBillingUserForUpdate(ctx context.Context, userID string) (Users, error) {
 	row := q.db.QueryRow(ctx, getBillingUserForUpdate, userID)
 	var i Users
-	err := row.Scan(&i.ID, &i.UserID, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.Timezone,
+	)
+	return i, err
+}
+
+const getBodyMetricEntry = `-- name: GetBodyMetricEntry :one
+SELECT
+    id,
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes,
+    created_at,
+    updated_at
+FROM body_metric_entry
+WHERE id = $1 AND user_id = $2
+`
+
+type GetBodyMetricEntryParams struct {
+	ID     int32  `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) GetBodyMetricEntry(ctx context.Context, arg GetBodyMetricEntryParams) (BodyMetricEntry, error) {
+	row := q.db.QueryRow(ctx, getBodyMetricEntry,
+		arg.ID,
+		arg.UserID,
+	)
+	var i BodyMetricEntry
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.MeasuredOn,
+		&i.Bodyweight,
+		&i.BodyFatPercent,
+		&i.Neck,
+		&i.Chest,
+		&i.Waist,
+		&i.Hips,
+		&i.Arm,
+		&i.Thigh,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCalendarFeed = `-- name: GetCalendarFeed :one
+SELECT user_id, token_hash, created_at, rotated_at FROM calendar_feed
+WHERE user_id = $1
+`
+
+func (q *Queries) GetCalendarFeed(ctx context.Context, userID string) (CalendarFeed, error) {
+	row := q.db.QueryRow(ctx, getCalendarFeed, userID)
+	var i CalendarFeed
+	err := row.Scan(
+		&i.UserID,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.RotatedAt,
+	)
+	return i, err
+}
+
+const getCalendarFeedByTokenHash = `-- name: GetCalendarFeedByTokenHash :one
+SELECT user_id, token_hash, created_at, rotated_at FROM calendar_feed
+WHERE token_hash = $1
+`
+
+// Resolves a feed token before any user context exists. The caller sets
+// app.current_token_hash so the select policy exposes only this row.
+func (q *Queries) GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (CalendarFeed, error) {
+	row := q.db.QueryRow(ctx, getCalendarFeedByTokenHash, tokenHash)
+	var i CalendarFeed
+	err := row.Scan(
+		&i.UserID,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.RotatedAt,
+	)
 	return i, err
 }
 
@@ -993,12 +1912,43 @@ func (q *Queries) GetChatWorkoutSnapshotStats(ctx context.Context, userID string
 	return i, err
 }
 
+const getCoachingGrant = `-- name: GetCoachingGrant :one
+SELECT l.scopes, u.timezone
+FROM coaching_link l
+JOIN users u ON u.user_id = l.athlete_user_id
+WHERE l.coach_user_id = $1
+  AND l.athlete_user_id = $2::text
+`
+
+type GetCoachingGrantParams struct {
+	CoachUserID   string `json:"coach_user_id"`
+	AthleteUserID string `json:"athlete_user_id"`
+}
+
+type GetCoachingGrantRow struct {
+	Scopes   []string    `json:"scopes"`
+	Timezone pgtype.Text `json:"timezone"`
+}
+
+// Resolves an X-Act-As request before the athlete's context exists, like the
+// Stripe customer lookup used by webhooks.
+func (q *Queries) GetCoachingGrant(ctx context.Context, arg GetCoachingGrantParams) (GetCoachingGrantRow, error) {
+	row := q.db.QueryRow(ctx, getCoachingGrant, arg.CoachUserID, arg.AthleteUserID)
+	var i GetCoachingGrantRow
+	err := row.Scan(&i.Scopes, &i.Timezone)
+	return i, err
+}
+
 const getContributionData = `-- name: GetContributionData :many
-WITH workout_totals AS (
+WITH user_tz AS (
+    SELECT COALESCE((SELECT timezone FROM users WHERE user_id = $1), 'UTC') AS tz
+),
+workout_totals AS (
     SELECT
         w.id,
         w.date,
         w.workout_focus,
+        (w.date AT TIME ZONE user_tz.tz)::DATE AS local_day,
         COUNT(s.id) FILTER (WHERE s.set_type = 'working')::INTEGER AS working_set_count,
         COALESCE(
             SUM(
@@ -1010,25 +1960,59 @@ WITH workout_totals AS (
             0
         )::FLOAT8 AS volume
     FROM workout w
+    CROSS JOIN user_tz
     LEFT JOIN "set" s ON s.workout_id = w.id
     WHERE w.user_id = $1
-      AND w.date >= CURRENT_DATE - INTERVAL '52 weeks'
-    GROUP BY w.id, w.date, w.workout_focus
+      AND w.date >= ((CURRENT_TIMESTAMP AT TIME ZONE user_tz.tz)::DATE - INTERVAL '52 weeks') AT TIME ZONE user_tz.tz
+      AND (
+          $2::TEXT[] IS NULL
+          OR (
+              SELECT COUNT(DISTINCT wt.name)
+              FROM workout_tag_link wtl
+              JOIN workout_tag wt ON wt.id = wtl.tag_id
+              WHERE wtl.workout_id = w.id
+                AND wt.name = ANY($2::TEXT[])
+          ) = CARDINALITY($2::TEXT[])
+      )
+      AND (
+          $3::TEXT[] IS NULL
+          OR NOT EXISTS (
+              SELECT 1
+              FROM workout_tag_link wtl
+              JOIN workout_tag wt ON wt.id = wtl.tag_id
+              WHERE wtl.workout_id = w.id
+                AND wt.name = ANY($3::TEXT[])
+          )
+      )
+    GROUP BY w.id, w.date, w.workout_focus, user_tz.tz
 )
 SELECT
-    DATE_TRUNC('day', wt.date)::DATE as date,
+    wt.local_day::DATE as date,
     SUM(wt.working_set_count)::INTEGER as count,
     JSON_AGG(JSONB_BUILD_OBJECT(
         'id', wt.id,
         'time', wt.date,
         'focus', wt.workout_focus,
-        'volume', wt.volume
+        'volume', wt.volume,
+        'tags', ARRAY(
+            SELECT t.name
+            FROM workout_tag_link tl
+            JOIN workout_tag t ON t.id = tl.tag_id
+            WHERE tl.workout_id = wt.id
+            ORDER BY t.name
+        )
     ) ORDER BY wt.date, wt.id) as workouts
 FROM workout_totals wt
-GROUP BY DATE_TRUNC('day', wt.date)
+GROUP BY wt.local_day
 ORDER BY date
 `
 
+type GetContributionDataParams struct {
+	UserID      string   `json:"user_id"`
+	Tags        []string `json:"tags"`
+	ExcludeTags []string `json:"exclude_tags"`
+}
+
 type GetContributionDataRow struct {
 	Date     pgtype.Date `json:"date"`
 	Count    int32       `json:"count"`
@@ -1039,8 +2023,10 @@ type GetContributionDataRow struct {
 // The WHERE clause filters by user_id (parameter $1), ensuring only the authenticated user's
 // workouts are retrieved. RLS policies on the workout table provide defense-in-depth.
 // The GROUP BY on date and JSON_AGG of workout metadata ensures no cross-user data leakage.
-func (q *Queries) GetContributionData(ctx context.Context, userID string) ([]GetContributionDataRow, error) {
-	rows, err := q.db.Query(ctx, getContributionData, userID)
+// Days are bucketed in the user's stored timezone, falling back to UTC.
+// Tags and exclude_tags filter workouts the same way as ListWorkouts.
+func (q *Queries) GetContributionData(ctx context.Context, arg GetContributionDataParams) ([]GetContributionDataRow, error) {
+	rows, err := q.db.Query(ctx, getContributionData, arg.UserID, arg.Tags, arg.ExcludeTags)
 	if err != nil {
 		return nil, err
 	}
@@ -1236,8 +2222,18 @@ SELECT
         WHERE s.exercise_id = e.id
           AND s.user_id = e.user_id
           AND s.set_type = 'working'
-    ) AS best_e1rm
+    ) AS best_e1rm,
+    note.notes AS notes,
+    note.settings AS settings,
+    note.created_at AS notes_updated_at
 FROM exercise e
+LEFT JOIN LATERAL (
+    SELECT n.notes, n.settings, n.created_at
+    FROM exercise_note n
+    WHERE n.exercise_id = e.id AND n.user_id = e.user_id
+    ORDER BY n.created_at DESC, n.id DESC
+    LIMIT 1
+) note ON true
 WHERE e.id = $1 AND e.user_id = $2
 `
 
@@ -1256,6 +2252,9 @@ type GetExerciseDetailRow struct {
 	Historical1rmUpdatedAt       pgtype.Timestamptz `json:"historical_1rm_updated_at"`
 	Historical1rmSourceWorkoutID pgtype.Int4        `json:"historical_1rm_source_workout_id"`
 	BestE1rm                     pgtype.Numeric     `json:"best_e1rm"`
+	Notes                        pgtype.Text        `json:"notes"`
+	Settings                     pgtype.Text        `json:"settings"`
+	NotesUpdatedAt               pgtype.Timestamptz `json:"notes_updated_at"`
 }
 
 func (q *Queries) GetExerciseDetail(ctx context.Context, arg GetExerciseDetailParams) (GetExerciseDetailRow, error) {
@@ -1271,39 +2270,53 @@ func (q *Queries) GetExerciseDetail(ctx context.Context, arg GetExerciseDetailPa
 		&i.Historical1rmUpdatedAt,
 		&i.Historical1rmSourceWorkoutID,
 		&i.BestE1rm,
+		&i.Notes,
+		&i.Settings,
+		&i.NotesUpdatedAt,
 	)
 	return i, err
 }
 
-const getExerciseMetricsHistoryRaw6M = `-- name: GetExerciseMetricsHistoryRaw6M :many
+const getExerciseMetricsHistoryRaw = `-- name: GetExerciseMetricsHistoryRaw :many
 WITH working_sets AS (
     SELECT
+        s.exercise_id AS exercise_id,
         w.id AS workout_id,
-        w.date::date AS workout_day,
+        (w.date AT TIME ZONE COALESCE(u.timezone, 'UTC'))::date AS workout_day,
         COALESCE(s.weight, 0)::numeric AS weight,
         s.reps AS reps,
         (COALESCE(s.weight, 0)::numeric * s.reps::numeric) AS volume,
         (COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) AS e1rm,
         e.historical_1rm AS historical_1rm,
-        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY w.id) AS session_best_e1rm
+        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY s.exercise_id, w.id) AS session_best_e1rm
     FROM "set" s
     JOIN workout w ON w.id = s.workout_id
     JOIN exercise e ON e.id = s.exercise_id
-    WHERE s.exercise_id = $1
+    JOIN users u ON u.user_id = s.user_id
+    WHERE s.exercise_id = ANY($1::int[])
       AND s.user_id = $2
       AND s.set_type = 'working'
+      AND NOT EXISTS (
+          SELECT 1
+          FROM workout_tag_link wtl
+          JOIN workout_tag wt ON wt.id = wtl.tag_id
+          WHERE wtl.workout_id = w.id AND wt.name = 'deload'
+      )
 ),
 end_day AS (
     SELECT MAX(workout_day) AS end_day
     FROM working_sets
 ),
 filtered AS (
-    SELECT workout_id, workout_day, weight, reps, volume, e1rm, historical_1rm, session_best_e1rm, end_day
+    SELECT *
     FROM working_sets, end_day
-    WHERE workout_day >= end_day - interval '6 months'
+    WHERE ($3::interval IS NULL OR workout_day >= end_day - $3::interval)
+      AND ($4::date IS NULL OR workout_day >= $4::date)
+      AND ($5::date IS NULL OR workout_day <= $5::date)
 ),
 workout_metrics AS (
     SELECT
+        exercise_id,
         workout_id,
         MIN(workout_day)::date AS workout_day,
         COALESCE(MAX(session_best_e1rm), 0)::float8 AS session_best_e1rm,
@@ -1320,21 +2333,26 @@ workout_metrics AS (
                 THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
             END
         ), 0)::float8 AS session_best_intensity,
-        COALESCE(SUM(volume), 0)::float8 AS total_volume_working
+        COALESCE(SUM(volume), 0)::float8 AS total_volume_working,
+        COUNT(*)::int AS working_set_count
     FROM filtered
-    GROUP BY workout_id
+    GROUP BY exercise_id, workout_id
 )
-SELECT workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working
+SELECT exercise_id, workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working, working_set_count
 FROM workout_metrics
-ORDER BY workout_day ASC, workout_id ASC
+ORDER BY workout_day ASC, workout_id ASC, exercise_id ASC
 `
 
-type GetExerciseMetricsHistoryRaw6MParams struct {
-	ExerciseID int32  `json:"exercise_id"`
-	UserID     string `json:"user_id"`
+type GetExerciseMetricsHistoryRawParams struct {
+	ExerciseIds []int32         `json:"exercise_ids"`
+	UserID      string          `json:"user_id"`
+	Lookback    pgtype.Interval `json:"lookback"`
+	FromDay     pgtype.Date     `json:"from_day"`
+	ToDay       pgtype.Date     `json:"to_day"`
 }
 
-type GetExerciseMetricsHistoryRaw6MRow struct {
+type GetExerciseMetricsHistoryRawRow struct {
+	ExerciseID           int32       `json:"exercise_id"`
 	WorkoutID            int32       `json:"workout_id"`
 	WorkoutDay           pgtype.Date `json:"workout_day"`
 	SessionBestE1rm      float64     `json:"session_best_e1rm"`
@@ -1342,18 +2360,30 @@ type GetExerciseMetricsHistoryRaw6MRow struct {
 	SessionAvgIntensity  float64     `json:"session_avg_intensity"`
 	SessionBestIntensity float64     `json:"session_best_intensity"`
 	TotalVolumeWorking   float64     `json:"total_volume_working"`
+	WorkingSetCount      int32       `json:"working_set_count"`
 }
 
-func (q *Queries) GetExerciseMetricsHistoryRaw6M(ctx context.Context, arg GetExerciseMetricsHistoryRaw6MParams) ([]GetExerciseMetricsHistoryRaw6MRow, error) {
-	rows, err := q.db.Query(ctx, getExerciseMetricsHistoryRaw6M, arg.ExerciseID, arg.UserID)
+// Per-workout working-set metrics for each requested exercise. lookback keeps
+// workouts within the interval before the latest workout day across all of
+// them; from_day and to_day are inclusive local days. Any bound left NULL is
+// not applied.
+func (q *Queries) GetExerciseMetricsHistoryRaw(ctx context.Context, arg GetExerciseMetricsHistoryRawParams) ([]GetExerciseMetricsHistoryRawRow, error) {
+	rows, err := q.db.Query(ctx, getExerciseMetricsHistoryRaw,
+		arg.ExerciseIds,
+		arg.UserID,
+		arg.Lookback,
+		arg.FromDay,
+		arg.ToDay,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetExerciseMetricsHistoryRaw6MRow
+	var items []GetExerciseMetricsHistoryRawRow
 	for rows.Next() {
-		var i GetExerciseMetricsHistoryRaw6MRow
+		var i GetExerciseMetricsHistoryRawRow
 		if err := rows.Scan(
+			&i.ExerciseID,
 			&i.WorkoutID,
 			&i.WorkoutDay,
 			&i.SessionBestE1rm,
@@ -1361,6 +2391,7 @@ func (q *Queries) GetExerciseMetricsHistoryRaw6M(ctx context.Context, arg GetExe
 			&i.SessionAvgIntensity,
 			&i.SessionBestIntensity,
 			&i.TotalVolumeWorking,
+			&i.WorkingSetCount,
 		); err != nil {
 			return nil, err
 		}
@@ -1372,83 +2403,75 @@ func (q *Queries) GetExerciseMetricsHistoryRaw6M(ctx context.Context, arg GetExe
 	return items, nil
 }
 
-const getExerciseMetricsHistoryRawAll = `-- name: GetExerciseMetricsHistoryRawAll :many
-WITH working_sets AS (
-    SELECT
-        w.id AS workout_id,
-        w.date::date AS workout_day,
-        COALESCE(s.weight, 0)::numeric AS weight,
-        s.reps AS reps,
-        (COALESCE(s.weight, 0)::numeric * s.reps::numeric) AS volume,
-        (COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) AS e1rm,
-        e.historical_1rm AS historical_1rm,
-        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY w.id) AS session_best_e1rm
-    FROM "set" s
-    JOIN workout w ON w.id = s.workout_id
-    JOIN exercise e ON e.id = s.exercise_id
-    WHERE s.exercise_id = $1
-      AND s.user_id = $2
-      AND s.set_type = 'working'
-),
-workout_metrics AS (
-    SELECT
-        workout_id,
-        MIN(workout_day)::date AS workout_day,
-        COALESCE(MAX(session_best_e1rm), 0)::float8 AS session_best_e1rm,
-        COALESCE(AVG(e1rm), 0)::float8 AS session_avg_e1rm,
-        COALESCE(AVG(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_avg_intensity,
-        COALESCE(MAX(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_best_intensity,
-        COALESCE(SUM(volume), 0)::float8 AS total_volume_working
-    FROM working_sets
-    GROUP BY workout_id
-)
-SELECT workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working
-FROM workout_metrics
-ORDER BY workout_day ASC, workout_id ASC
+const getExerciseWithSets = `-- name: GetExerciseWithSets :many
+SELECT 
+    s.workout_id,
+    w.date as workout_date,
+    w.notes as workout_notes,
+    w.workout_focus as workout_focus,
+    s.id as set_id,
+    s.weight,
+    s.reps,
+    s.set_type,
+    s.notes as set_notes,
+    e.id as exercise_id,
+    e.name as exercise_name,
+    s.exercise_order,
+    s.set_order,
+    (COALESCE(s.weight, 0) * s.reps)::NUMERIC(10,1) as volume
+FROM "set" s
+JOIN exercise e ON e.id = s.exercise_id
+JOIN workout w ON w.id = s.workout_id
+WHERE s.exercise_id = $1 AND s.user_id = $2
+ORDER BY w.date DESC, s.exercise_order, s.set_order, s.created_at, s.id
 `
 
-type GetExerciseMetricsHistoryRawAllParams struct {
+type GetExerciseWithSetsParams struct {
 	ExerciseID int32  `json:"exercise_id"`
 	UserID     string `json:"user_id"`
 }
 
-type GetExerciseMetricsHistoryRawAllRow struct {
-	WorkoutID            int32       `json:"workout_id"`
-	WorkoutDay           pgtype.Date `json:"workout_day"`
-	SessionBestE1rm      float64     `json:"session_best_e1rm"`
-	SessionAvgE1rm       float64     `json:"session_avg_e1rm"`
-	SessionAvgIntensity  float64     `json:"session_avg_intensity"`
-	SessionBestIntensity float64     `json:"session_best_intensity"`
-	TotalVolumeWorking   float64     `json:"total_volume_working"`
+type GetExerciseWithSetsRow struct {
+	WorkoutID     int32              `json:"workout_id"`
+	WorkoutDate   pgtype.Timestamptz `json:"workout_date"`
+	WorkoutNotes  pgtype.Text        `json:"workout_notes"`
+	WorkoutFocus  pgtype.Text        `json:"workout_focus"`
+	SetID         int32              `json:"set_id"`
+	Weight        pgtype.Numeric     `json:"weight"`
+	Reps          int32              `json:"reps"`
+	SetType       string             `json:"set_type"`
+	SetNotes      pgtype.Text        `json:"set_notes"`
+	ExerciseID    int32              `json:"exercise_id"`
+	ExerciseName  string             `json:"exercise_name"`
+	ExerciseOrder int32              `json:"exercise_order"`
+	SetOrder      int32              `json:"set_order"`
+	Volume        pgtype.Numeric     `json:"volume"`
 }
 
-func (q *Queries) GetExerciseMetricsHistoryRawAll(ctx context.Context, arg GetExerciseMetricsHistoryRawAllParams) ([]GetExerciseMetricsHistoryRawAllRow, error) {
-	rows, err := q.db.Query(ctx, getExerciseMetricsHistoryRawAll, arg.ExerciseID, arg.UserID)
+func (q *Queries) GetExerciseWithSets(ctx context.Context, arg GetExerciseWithSetsParams) ([]GetExerciseWithSetsRow, error) {
+	rows, err := q.db.Query(ctx, getExerciseWithSets, arg.ExerciseID, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetExerciseMetricsHistoryRawAllRow
+	var items []GetExerciseWithSetsRow
 	for rows.Next() {
-		var i GetExerciseMetricsHistoryRawAllRow
+		var i GetExerciseWithSetsRow
 		if err := rows.Scan(
 			&i.WorkoutID,
-			&i.WorkoutDay,
-			&i.SessionBestE1rm,
-			&i.SessionAvgE1rm,
-			&i.SessionAvgIntensity,
-			&i.SessionBestIntensity,
-			&i.TotalVolumeWorking,
+			&i.WorkoutDate,
+			&i.WorkoutNotes,
+			&i.WorkoutFocus,
+			&i.SetID,
+			&i.Weight,
+			&i.Reps,
+			&i.SetType,
+			&i.SetNotes,
+			&i.ExerciseID,
+			&i.ExerciseName,
+			&i.ExerciseOrder,
+			&i.SetOrder,
+			&i.Volume,
 		); err != nil {
 			return nil, err
 		}
@@ -1460,92 +2483,72 @@ func (q *Queries) GetExerciseMetricsHistoryRawAll(ctx context.Context, arg GetEx
 	return items, nil
 }
 
-const getExerciseMetricsHistoryRawMonth = `-- name: GetExerciseMetricsHistoryRawMonth :many
-WITH working_sets AS (
-    SELECT
-        w.id AS workout_id,
-        w.date::date AS workout_day,
-        COALESCE(s.weight, 0)::numeric AS weight,
-        s.reps AS reps,
-        (COALESCE(s.weight, 0)::numeric * s.reps::numeric) AS volume,
-        (COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) AS e1rm,
-        e.historical_1rm AS historical_1rm,
-        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY w.id) AS session_best_e1rm
+const getLastSessionSetsForExerciseChat = `-- name: GetLastSessionSetsForExerciseChat :many
+WITH latest_workout AS (
+    SELECT s.workout_id
     FROM "set" s
-    JOIN workout w ON w.id = s.workout_id
-    JOIN exercise e ON e.id = s.exercise_id
+    JOIN workout w ON w.id = s.workout_id AND w.user_id = s.user_id
     WHERE s.exercise_id = $1
       AND s.user_id = $2
       AND s.set_type = 'working'
-),
-end_day AS (
-    SELECT MAX(workout_day) AS end_day
-    FROM working_sets
-),
-filtered AS (
-    SELECT workout_id, workout_day, weight, reps, volume, e1rm, historical_1rm, session_best_e1rm, end_day
-    FROM working_sets, end_day
-    WHERE workout_day >= end_day - interval '30 days'
-),
-workout_metrics AS (
-    SELECT
-        workout_id,
-        MIN(workout_day)::date AS workout_day,
-        COALESCE(MAX(session_best_e1rm), 0)::float8 AS session_best_e1rm,
-        COALESCE(AVG(e1rm), 0)::float8 AS session_avg_e1rm,
-        COALESCE(AVG(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_avg_intensity,
-        COALESCE(MAX(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_best_intensity,
-        COALESCE(SUM(volume), 0)::float8 AS total_volume_working
-    FROM filtered
-    GROUP BY workout_id
+    ORDER BY w.date DESC, w.id DESC
+    LIMIT 1
 )
-SELECT workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working
-FROM workout_metrics
-ORDER BY workout_day ASC
+SELECT
+    s.id AS set_id,
+    w.id AS workout_id,
+    w.date AS workout_date,
+    w.workout_focus AS workout_focus,
+    s.weight,
+    s.reps,
+    s.exercise_order,
+    s.set_order,
+    s.created_at
+FROM "set" s
+JOIN latest_workout lw ON lw.workout_id = s.workout_id
+JOIN workout w ON w.id = s.workout_id AND w.user_id = s.user_id
+WHERE s.exercise_id = $1
+  AND s.user_id = $2
+  AND s.set_type = 'working'
+ORDER BY s.set_order ASC
 `
 
-type GetExerciseMetricsHistoryRawMonthParams struct {
+type GetLastSessionSetsForExerciseChatParams struct {
 	ExerciseID int32  `json:"exercise_id"`
 	UserID     string `json:"user_id"`
 }
 
-type GetExerciseMetricsHistoryRawMonthRow struct {
-	WorkoutID            int32       `json:"workout_id"`
-	WorkoutDay           pgtype.Date `json:"workout_day"`
-	SessionBestE1rm      float64     `json:"session_best_e1rm"`
-	SessionAvgE1rm       float64     `json:"session_avg_e1rm"`
-	SessionAvgIntensity  float64     `json:"session_avg_intensity"`
-	SessionBestIntensity float64     `json:"session_best_intensity"`
-	TotalVolumeWorking   float64     `json:"total_volume_working"`
+type GetLastSessionSetsForExerciseChatRow struct {
+	SetID         int32              `json:"set_id"`
+	WorkoutID     int32              `json:"workout_id"`
+	WorkoutDate   pgtype.Timestamptz `json:"workout_date"`
+	WorkoutFocus  pgtype.Text        `json:"workout_focus"`
+	Weight        pgtype.Numeric     `json:"weight"`
+	Reps          int32              `json:"reps"`
+	ExerciseOrder int32              `json:"exercise_order"`
+	SetOrder      int32              `json:"set_order"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
-func (q *Queries) GetExerciseMetricsHistoryRawMonth(ctx context.Context, arg GetExerciseMetricsHistoryRawMonthParams) ([]GetExerciseMetricsHistoryRawMonthRow, error) {
-	rows, err := q.db.Query(ctx, getExerciseMetricsHistoryRawMonth, arg.ExerciseID, arg.UserID)
+func (q *Queries) GetLastSessionSetsForExerciseChat(ctx context.Context, arg GetLastSessionSetsForExerciseChatParams) ([]GetLastSessionSetsForExerciseChatRow, error) {
+	rows, err := q.db.Query(ctx, getLastSessionSetsForExerciseChat, arg.ExerciseID, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetExerciseMetricsHistoryRawMonthRow
+	var items []GetLastSessionSetsForExerciseChatRow
 	for rows.Next() {
-		var i GetExerciseMetricsHistoryRawMonthRow
+		var i GetLastSessionSetsForExerciseChatRow
 		if err := rows.Scan(
+			&i.SetID,
 			&i.WorkoutID,
-			&i.WorkoutDay,
-			&i.SessionBestE1rm,
-			&i.SessionAvgE1rm,
-			&i.SessionAvgIntensity,
-			&i.SessionBestIntensity,
-			&i.TotalVolumeWorking,
+			&i.WorkoutDate,
+			&i.WorkoutFocus,
+			&i.Weight,
+			&i.Reps,
+			&i.ExerciseOrder,
+			&i.SetOrder,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1557,368 +2560,54 @@ func (q *Queries) GetExerciseMetricsHistoryRawMonth(ctx context.Context, arg Get
 	return items, nil
 }
 
-const getExerciseMetricsHistoryRawWeek = `-- name: GetExerciseMetricsHistoryRawWeek :many
-WITH working_sets AS (
-    SELECT
-        w.id AS workout_id,
-        w.date::date AS workout_day,
-        COALESCE(s.weight, 0)::numeric AS weight,
-        s.reps AS reps,
-        (COALESCE(s.weight, 0)::numeric * s.reps::numeric) AS volume,
-        (COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) AS e1rm,
-        e.historical_1rm AS historical_1rm,
-        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY w.id) AS session_best_e1rm
-    FROM "set" s
-    JOIN workout w ON w.id = s.workout_id
-    JOIN exercise e ON e.id = s.exercise_id
-    WHERE s.exercise_id = $1
-      AND s.user_id = $2
-      AND s.set_type = 'working'
-),
-end_day AS (
-    SELECT MAX(workout_day) AS end_day
-    FROM working_sets
-),
-filtered AS (
-    SELECT workout_id, workout_day, weight, reps, volume, e1rm, historical_1rm, session_best_e1rm, end_day
-    FROM working_sets, end_day
-    WHERE workout_day >= end_day - interval '7 days'
-),
-workout_metrics AS (
-    SELECT
-        workout_id,
-        MIN(workout_day)::date AS workout_day,
-        COALESCE(MAX(session_best_e1rm), 0)::float8 AS session_best_e1rm,
-        COALESCE(AVG(e1rm), 0)::float8 AS session_avg_e1rm,
-        COALESCE(AVG(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_avg_intensity,
-        COALESCE(MAX(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_best_intensity,
-        COALESCE(SUM(volume), 0)::float8 AS total_volume_working
-    FROM filtered
-    GROUP BY workout_id
-)
-SELECT workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working
-FROM workout_metrics
-ORDER BY workout_day ASC
+const getLatestAIChatStreamChunkSequence = `-- name: GetLatestAIChatStreamChunkSequence :one
+SELECT COALESCE(MAX(sequence), 0)::INTEGER
+FROM ai_chat_stream_chunk
+WHERE run_id = $1
+  AND user_id = $2
 `
 
-type GetExerciseMetricsHistoryRawWeekParams struct {
-	ExerciseID int32  `json:"exercise_id"`
-	UserID     string `json:"user_id"`
+type GetLatestAIChatStreamChunkSequenceParams struct {
+	RunID  int32  `json:"run_id"`
+	UserID string `json:"user_id"`
 }
 
-type GetExerciseMetricsHistoryRawWeekRow struct {
-	WorkoutID            int32       `json:"workout_id"`
-	WorkoutDay           pgtype.Date `json:"workout_day"`
-	SessionBestE1rm      float64     `json:"session_best_e1rm"`
-	SessionAvgE1rm       float64     `json:"session_avg_e1rm"`
-	SessionAvgIntensity  float64     `json:"session_avg_intensity"`
-	SessionBestIntensity float64     `json:"session_best_intensity"`
-	TotalVolumeWorking   float64     `json:"total_volume_working"`
+func (q *Queries) GetLatestAIChatStreamChunkSequence(ctx context.Context, arg GetLatestAIChatStreamChunkSequenceParams) (int32, error) {
+	row := q.db.QueryRow(ctx, getLatestAIChatStreamChunkSequence, arg.RunID, arg.UserID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
 }
 
-func (q *Queries) GetExerciseMetricsHistoryRawWeek(ctx context.Context, arg GetExerciseMetricsHistoryRawWeekParams) ([]GetExerciseMetricsHistoryRawWeekRow, error) {
-	rows, err := q.db.Query(ctx, getExerciseMetricsHistoryRawWeek, arg.ExerciseID, arg.UserID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetExerciseMetricsHistoryRawWeekRow
-	for rows.Next() {
-		var i GetExerciseMetricsHistoryRawWeekRow
-		if err := rows.Scan(
-			&i.WorkoutID,
-			&i.WorkoutDay,
-			&i.SessionBestE1rm,
-			&i.SessionAvgE1rm,
-			&i.SessionAvgIntensity,
-			&i.SessionBestIntensity,
-			&i.TotalVolumeWorking,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const getExerciseMetricsHistoryRawYear = `-- name: GetExerciseMetricsHistoryRawYear :many
-WITH working_sets AS (
-    SELECT
-        w.id AS workout_id,
-        w.date::date AS workout_day,
-        COALESCE(s.weight, 0)::numeric AS weight,
-        s.reps AS reps,
-        (COALESCE(s.weight, 0)::numeric * s.reps::numeric) AS volume,
-        (COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) AS e1rm,
-        e.historical_1rm AS historical_1rm,
-        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY w.id) AS session_best_e1rm
-    FROM "set" s
-    JOIN workout w ON w.id = s.workout_id
-    JOIN exercise e ON e.id = s.exercise_id
-    WHERE s.exercise_id = $1
-      AND s.user_id = $2
-      AND s.set_type = 'working'
-),
-end_day AS (
-    SELECT MAX(workout_day) AS end_day
-    FROM working_sets
-),
-filtered AS (
-    SELECT workout_id, workout_day, weight, reps, volume, e1rm, historical_1rm, session_best_e1rm, end_day
-    FROM working_sets, end_day
-    WHERE workout_day >= end_day - interval '1 year'
-),
-workout_metrics AS (
-    SELECT
-        workout_id,
-        MIN(workout_day)::date AS workout_day,
-        COALESCE(MAX(session_best_e1rm), 0)::float8 AS session_best_e1rm,
-        COALESCE(AVG(e1rm), 0)::float8 AS session_avg_e1rm,
-        COALESCE(AVG(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_avg_intensity,
-        COALESCE(MAX(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_best_intensity,
-        COALESCE(SUM(volume), 0)::float8 AS total_volume_working
-    FROM filtered
-    GROUP BY workout_id
-)
-SELECT workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working
-FROM workout_metrics
-ORDER BY workout_day ASC, workout_id ASC
-`
-
-type GetExerciseMetricsHistoryRawYearParams struct {
-	ExerciseID int32  `json:"exercise_id"`
-	UserID     string `json:"user_id"`
-}
-
-type GetExerciseMetricsHistoryRawYearRow struct {
-	WorkoutID            int32       `json:"workout_id"`
-	WorkoutDay           pgtype.Date `json:"workout_day"`
-	SessionBestE1rm      float64     `json:"session_best_e1rm"`
-	SessionAvgE1rm       float64     `json:"session_avg_e1rm"`
-	SessionAvgIntensity  float64     `json:"session_avg_intensity"`
-	SessionBestIntensity float64     `json:"session_best_intensity"`
-	TotalVolumeWorking   float64     `json:"total_volume_working"`
-}
-
-func (q *Queries) GetExerciseMetricsHistoryRawYear(ctx context.Context, arg GetExerciseMetricsHistoryRawYearParams) ([]GetExerciseMetricsHistoryRawYearRow, error) {
-	rows, err := q.db.Query(ctx, getExerciseMetricsHistoryRawYear, arg.ExerciseID, arg.UserID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetExerciseMetricsHistoryRawYearRow
-	for rows.Next() {
-		var i GetExerciseMetricsHistoryRawYearRow
-		if err := rows.Scan(
-			&i.WorkoutID,
-			&i.WorkoutDay,
-			&i.SessionBestE1rm,
-			&i.SessionAvgE1rm,
-			&i.SessionAvgIntensity,
-			&i.SessionBestIntensity,
-			&i.TotalVolumeWorking,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const getExerciseWithSets = `-- name: GetExerciseWithSets :many
-SELECT 
-    s.workout_id,
-    w.date as workout_date,
-    w.notes as workout_notes,
-    w.workout_focus as workout_focus,
-    s.id as set_id,
-    s.weight,
-    s.reps,
-    s.set_type,
-    e.id as exercise_id,
-    e.name as exercise_name,
-    s.exercise_order,
-    s.set_order,
-    (COALESCE(s.weight, 0) * s.reps)::NUMERIC(10,1) as volume
-FROM "set" s
-JOIN exercise e ON e.id = s.exercise_id
-JOIN workout w ON w.id = s.workout_id
-WHERE s.exercise_id = $1 AND s.user_id = $2
-ORDER BY w.date DESC, s.exercise_order, s.set_order, s.created_at, s.id
-`
-
-type GetExerciseWithSetsParams struct {
-	ExerciseID int32  `json:"exercise_id"`
-	UserID     string `json:"user_id"`
-}
-
-type GetExerciseWithSetsRow struct {
-	WorkoutID     int32              `json:"workout_id"`
-	WorkoutDate   pgtype.Timestamptz `json:"workout_date"`
-	WorkoutNotes  pgtype.Text        `json:"workout_notes"`
-	WorkoutFocus  pgtype.Text        `json:"workout_focus"`
-	SetID         int32              `json:"set_id"`
-	Weight        pgtype.Numeric     `json:"weight"`
-	Reps          int32              `json:"reps"`
-	SetType       string             `json:"set_type"`
-	ExerciseID    int32              `json:"exercise_id"`
-	ExerciseName  string             `json:"exercise_name"`
-	ExerciseOrder int32              `json:"exercise_order"`
-	SetOrder      int32              `json:"set_order"`
-	Volume        pgtype.Numeric     `json:"volume"`
-}
-
-func (q *Queries) GetExerciseWithSets(ctx context.Context, arg GetExerciseWithSetsParams) ([]GetExerciseWithSetsRow, error) {
-	rows, err := q.db.Query(ctx, getExerciseWithSets, arg.ExerciseID, arg.UserID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetExerciseWithSetsRow
-	for rows.Next() {
-		var i GetExerciseWithSetsRow
-		if err := rows.Scan(
-			&i.WorkoutID,
-			&i.WorkoutDate,
-			&i.WorkoutNotes,
-			&i.WorkoutFocus,
-			&i.SetID,
-			&i.Weight,
-			&i.Reps,
-			&i.SetType,
-			&i.ExerciseID,
-			&i.ExerciseName,
-			&i.ExerciseOrder,
-			&i.SetOrder,
-			&i.Volume,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const getLastSessionSetsForExerciseChat = `-- name: GetLastSessionSetsForExerciseChat :many
-WITH latest_workout AS (
-    SELECT s.workout_id
-    FROM "set" s
-    JOIN workout w ON w.id = s.workout_id AND w.user_id = s.user_id
-    WHERE s.exercise_id = $1
-      AND s.user_id = $2
-      AND s.set_type = 'working'
-    ORDER BY w.date DESC, w.id DESC
-    LIMIT 1
-)
-SELECT
-    s.id AS set_id,
-    w.id AS workout_id,
-    w.date AS workout_date,
-    w.workout_focus AS workout_focus,
-    s.weight,
-    s.reps,
-    s.exercise_order,
-    s.set_order,
-    s.created_at
-FROM "set" s
-JOIN latest_workout lw ON lw.workout_id = s.workout_id
-JOIN workout w ON w.id = s.workout_id AND w.user_id = s.user_id
-WHERE s.exercise_id = $1
-  AND s.user_id = $2
-  AND s.set_type = 'working'
-ORDER BY s.set_order ASC
+const getLatestBodyweight = `-- name: GetLatestBodyweight :one
+SELECT measured_on, bodyweight
+FROM body_metric_entry
+WHERE user_id = $1
+  AND bodyweight IS NOT NULL
+ORDER BY measured_on DESC, id DESC
+LIMIT 1
 `
 
-type GetLastSessionSetsForExerciseChatParams struct {
-	ExerciseID int32  `json:"exercise_id"`
-	UserID     string `json:"user_id"`
-}
-
-type GetLastSessionSetsForExerciseChatRow struct {
-	SetID         int32              `json:"set_id"`
-	WorkoutID     int32              `json:"workout_id"`
-	WorkoutDate   pgtype.Timestamptz `json:"workout_date"`
-	WorkoutFocus  pgtype.Text        `json:"workout_focus"`
-	Weight        pgtype.Numeric     `json:"weight"`
-	Reps          int32              `json:"reps"`
-	ExerciseOrder int32              `json:"exercise_order"`
-	SetOrder      int32              `json:"set_order"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+type GetLatestBodyweightRow struct {
+	MeasuredOn pgtype.Date    `json:"measured_on"`
+	Bodyweight pgtype.Numeric `json:"bodyweight"`
 }
 
-func (q *Queries) GetLastSessionSetsForExerciseChat(ctx context.Context, arg GetLastSessionSetsForExerciseChatParams) ([]GetLastSessionSetsForExerciseChatRow, error) {
-	rows, err := q.db.Query(ctx, getLastSessionSetsForExerciseChat, arg.ExerciseID, arg.UserID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetLastSessionSetsForExerciseChatRow
-	for rows.Next() {
-		var i GetLastSessionSetsForExerciseChatRow
-		if err := rows.Scan(
-			&i.SetID,
-			&i.WorkoutID,
-			&i.WorkoutDate,
-			&i.WorkoutFocus,
-			&i.Weight,
-			&i.Reps,
-			&i.ExerciseOrder,
-			&i.SetOrder,
-			&i.CreatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) GetLatestBodyweight(ctx context.Context, userID string) (GetLatestBodyweightRow, error) {
+	row := q.db.QueryRow(ctx, getLatestBodyweight, userID)
+	var i GetLatestBodyweightRow
+	err := row.Scan(&i.MeasuredOn, &i.Bodyweight)
+	return i, err
 }
 
-const getLatestAIChatStreamChunkSequence = `-- name: GetLatestAIChatStreamChunkSequence :one
-SELECT COALESCE(MAX(sequence), 0)::INTEGER
-FROM ai_chat_stream_chunk
-WHERE run_id = $1
-  AND user_id = $2
+const getLatestNotificationID = `-- name: GetLatestNotificationID :one
+SELECT COALESCE(MAX(id), 0)::int
+FROM notification
+WHERE user_id = $1
 `
 
-type GetLatestAIChatStreamChunkSequenceParams struct {
-	RunID  int32  `json:"run_id"`
-	UserID string `json:"user_id"`
-}
-
-func (q *Queries) GetLatestAIChatStreamChunkSequence(ctx context.Context, arg GetLatestAIChatStreamChunkSequenceParams) (int32, error) {
-	row := q.db.QueryRow(ctx, getLatestAIChatStreamChunkSequence, arg.RunID, arg.UserID)
+func (q *Queries) GetLatestNotificationID(ctx context.Context, userID string) (int32, error) {
+	row := q.db.QueryRow(ctx, getLatestNotificationID, userID)
 	var column_1 int32
 	err := row.Scan(&column_1)
 	return column_1, err
@@ -1976,9 +2665,18 @@ SELECT
     s.reps,
     s.exercise_order,
     s.set_order,
-    s.created_at
+    s.created_at,
+    note.notes AS exercise_notes,
+    note.settings AS exercise_settings
 FROM "set" s
 JOIN workout w ON w.id = s.workout_id
+LEFT JOIN LATERAL (
+    SELECT n.notes, n.settings
+    FROM exercise_note n
+    WHERE n.exercise_id = s.exercise_id AND n.user_id = s.user_id
+    ORDER BY n.created_at DESC, n.id DESC
+    LIMIT 1
+) note ON true
 WHERE s.exercise_id = $1 AND s.user_id = $2
 ORDER BY w.date DESC, s.set_order DESC
 LIMIT 3
@@ -1990,15 +2688,17 @@ type GetRecentSetsForExerciseParams struct {
 }
 
 type GetRecentSetsForExerciseRow struct {
-	SetID         int32              `json:"set_id"`
-	WorkoutID     int32              `json:"workout_id"`
-	WorkoutDate   pgtype.Timestamptz `json:"workout_date"`
-	WorkoutFocus  pgtype.Text        `json:"workout_focus"`
-	Weight        pgtype.Numeric     `json:"weight"`
-	Reps          int32              `json:"reps"`
-	ExerciseOrder int32              `json:"exercise_order"`
-	SetOrder      int32              `json:"set_order"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	SetID            int32              `json:"set_id"`
+	WorkoutID        int32              `json:"workout_id"`
+	WorkoutDate      pgtype.Timestamptz `json:"workout_date"`
+	WorkoutFocus     pgtype.Text        `json:"workout_focus"`
+	Weight           pgtype.Numeric     `json:"weight"`
+	Reps             int32              `json:"reps"`
+	ExerciseOrder    int32              `json:"exercise_order"`
+	SetOrder         int32              `json:"set_order"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	ExerciseNotes    pgtype.Text        `json:"exercise_notes"`
+	ExerciseSettings pgtype.Text        `json:"exercise_settings"`
 }
 
 func (q *Queries) GetRecentSetsForExercise(ctx context.Context, arg GetRecentSetsForExerciseParams) ([]GetRecentSetsForExerciseRow, error) {
@@ -2020,6 +2720,8 @@ func (q *Queries) GetRecentSetsForExercise(ctx context.Context, arg GetRecentSet
 			&i.ExerciseOrder,
 			&i.SetOrder,
 			&i.CreatedAt,
+			&i.ExerciseNotes,
+			&i.ExerciseSettings,
 		); err != nil {
 			return nil, err
 		}
@@ -2109,26 +2811,134 @@ func (q *Queries) GetStripeCustomerByUserID(ctx context.Context, userID string)
 	return i, err
 }
 
+const getStripeSubscription = `-- name: GetStripeSubscription :one
+SELECT
+    stripe_subscription_id,
+    user_id,
+    stripe_customer_id,
+    stripe_price_id,
+    stripe_event_created_at,
+    status,
+    cancel_at_period_end,
+    cancel_at,
+    current_period_start,
+    current_period_end,
+    trial_start,
+    trial_end,
+    created_at,
+    updated_at
+FROM stripe_subscriptions
+WHERE stripe_subscription_id = $1
+`
+
+func (q *Queries) GetStripeSubscription(ctx context.Context, stripeSubscriptionID string) (StripeSubscriptions, error) {
+	row := q.db.QueryRow(ctx, getStripeSubscription, stripeSubscriptionID)
+	var i StripeSubscriptions
+	err := row.Scan(
+		&i.StripeSubscriptionID,
+		&i.UserID,
+		&i.StripeCustomerID,
+		&i.StripePriceID,
+		&i.StripeEventCreatedAt,
+		&i.Status,
+		&i.CancelAtPeriodEnd,
+		&i.CancelAt,
+		&i.CurrentPeriodStart,
+		&i.CurrentPeriodEnd,
+		&i.TrialStart,
+		&i.TrialEnd,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getUser = `-- name: GetUser :one
-SELECT id, user_id, created_at FROM users WHERE id = $1
+SELECT id, user_id, created_at, timezone FROM users WHERE id = $1
 `
 
 // User queries
 func (q *Queries) GetUser(ctx context.Context, id int32) (Users, error) {
 	row := q.db.QueryRow(ctx, getUser, id)
 	var i Users
-	err := row.Scan(&i.ID, &i.UserID, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.Timezone,
+	)
 	return i, err
 }
 
 const getUserByUserID = `-- name: GetUserByUserID :one
-SELECT id, user_id, created_at FROM users WHERE user_id = $1 LIMIT 1
+SELECT id, user_id, created_at, timezone FROM users WHERE user_id = $1 LIMIT 1
 `
 
 func (q *Queries) GetUserByUserID(ctx context.Context, userID string) (Users, error) {
 	row := q.db.QueryRow(ctx, getUserByUserID, userID)
 	var i Users
-	err := row.Scan(&i.ID, &i.UserID, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.Timezone,
+	)
+	return i, err
+}
+
+const getUserEquipment = `-- name: GetUserEquipment :one
+SELECT
+    user_id,
+    barbell_weights,
+    plates,
+    dumbbell_increment,
+    machine_stack_step,
+    created_at,
+    updated_at
+FROM user_equipment
+WHERE user_id = $1
+`
+
+func (q *Queries) GetUserEquipment(ctx context.Context, userID string) (UserEquipment, error) {
+	row := q.db.QueryRow(ctx, getUserEquipment, userID)
+	var i UserEquipment
+	err := row.Scan(
+		&i.UserID,
+		&i.BarbellWeights,
+		&i.Plates,
+		&i.DumbbellIncrement,
+		&i.MachineStackStep,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getUserStrengthProfile = `-- name: GetUserStrengthProfile :one
+SELECT
+    user_id,
+    sex,
+    squat_exercise_id,
+    bench_exercise_id,
+    deadlift_exercise_id,
+    created_at,
+    updated_at
+FROM user_strength_profile
+WHERE user_id = $1
+`
+
+func (q *Queries) GetUserStrengthProfile(ctx context.Context, userID string) (UserStrengthProfile, error) {
+	row := q.db.QueryRow(ctx, getUserStrengthProfile, userID)
+	var i UserStrengthProfile
+	err := row.Scan(
+		&i.UserID,
+		&i.Sex,
+		&i.SquatExerciseID,
+		&i.BenchExerciseID,
+		&i.DeadliftExerciseID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
 	return i, err
 }
 
@@ -2170,6 +2980,33 @@ func (q *Queries) GetUserTrainingProfile(ctx context.Context, userID string) (Us
 	return i, err
 }
 
+const getWebhookEndpoint = `-- name: GetWebhookEndpoint :one
+SELECT id, user_id, url, secret, event_types, active, created_at, updated_at
+FROM webhook_endpoint
+WHERE id = $1 AND user_id = $2
+`
+
+type GetWebhookEndpointParams struct {
+	ID     int32  `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) GetWebhookEndpoint(ctx context.Context, arg GetWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRow(ctx, getWebhookEndpoint, arg.ID, arg.UserID)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getWorkout = `-- name: GetWorkout :one
 SELECT id, date, notes, workout_focus, created_at, updated_at FROM workout WHERE id = $1 AND user_id = $2
 `
@@ -2219,21 +3056,1177 @@ type GetWorkoutBestE1rmByExerciseParams struct {
 	UserID    string `json:"user_id"`
 }
 
-type GetWorkoutBestE1rmByExerciseRow struct {
-	ExerciseID int32          `json:"exercise_id"`
-	BestE1rm   pgtype.Numeric `json:"best_e1rm"`
+type GetWorkoutBestE1rmByExerciseRow struct {
+	ExerciseID int32          `json:"exercise_id"`
+	BestE1rm   pgtype.Numeric `json:"best_e1rm"`
+}
+
+func (q *Queries) GetWorkoutBestE1rmByExercise(ctx context.Context, arg GetWorkoutBestE1rmByExerciseParams) ([]GetWorkoutBestE1rmByExerciseRow, error) {
+	rows, err := q.db.Query(ctx, getWorkoutBestE1rmByExercise, arg.WorkoutID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWorkoutBestE1rmByExerciseRow
+	for rows.Next() {
+		var i GetWorkoutBestE1rmByExerciseRow
+		if err := rows.Scan(&i.ExerciseID, &i.BestE1rm); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkoutWithSets = `-- name: GetWorkoutWithSets :many
+SELECT 
+    w.id as workout_id,
+    w.date as workout_date,
+    w.notes as workout_notes,
+    w.workout_focus as workout_focus,
+    ARRAY(
+        SELECT wt.name
+        FROM workout_tag_link wtl
+        JOIN workout_tag wt ON wt.id = wtl.tag_id
+        WHERE wtl.workout_id = w.id
+        ORDER BY wt.name
+    )::TEXT[] as workout_tags,
+    s.id as set_id,
+    s.weight,
+    s.reps,
+    s.set_type,
+    s.notes as set_notes,
+    e.id as exercise_id,
+    e.name as exercise_name,
+    s.exercise_order,
+    s.set_order,
+    (COALESCE(s.weight, 0) * s.reps)::NUMERIC(10,1) as volume
+FROM workout w
+JOIN "set" s ON w.id = s.workout_id
+JOIN exercise e ON s.exercise_id = e.id
+WHERE w.id = $1 AND w.user_id = $2
+ORDER BY s.exercise_order, s.set_order, s.id
+`
+
+type GetWorkoutWithSetsParams struct {
+	ID     int32  `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+type GetWorkoutWithSetsRow struct {
+	WorkoutID     int32              `json:"workout_id"`
+	WorkoutDate   pgtype.Timestamptz `json:"workout_date"`
+	WorkoutNotes  pgtype.Text        `json:"workout_notes"`
+	WorkoutFocus  pgtype.Text        `json:"workout_focus"`
+	WorkoutTags   []string           `json:"workout_tags"`
+	SetID         int32              `json:"set_id"`
+	Weight        pgtype.Numeric     `json:"weight"`
+	Reps          int32              `json:"reps"`
+	SetType       string             `json:"set_type"`
+	SetNotes      pgtype.Text        `json:"set_notes"`
+	ExerciseID    int32              `json:"exercise_id"`
+	ExerciseName  string             `json:"exercise_name"`
+	ExerciseOrder int32              `json:"exercise_order"`
+	SetOrder      int32              `json:"set_order"`
+	Volume        pgtype.Numeric     `json:"volume"`
+}
+
+// Complex queries for joining data
+func (q *Queries) GetWorkoutWithSets(ctx context.Context, arg GetWorkoutWithSetsParams) ([]GetWorkoutWithSetsRow, error) {
+	rows, err := q.db.Query(ctx, getWorkoutWithSets, arg.ID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWorkoutWithSetsRow
+	for rows.Next() {
+		var i GetWorkoutWithSetsRow
+		if err := rows.Scan(
+			&i.WorkoutID,
+			&i.WorkoutDate,
+			&i.WorkoutNotes,
+			&i.WorkoutFocus,
+			&i.WorkoutTags,
+			&i.SetID,
+			&i.Weight,
+			&i.Reps,
+			&i.SetType,
+			&i.SetNotes,
+			&i.ExerciseID,
+			&i.ExerciseName,
+			&i.ExerciseOrder,
+			&i.SetOrder,
+			&i.Volume,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const grantStripeFeatureAccess = `-- name: GrantStripeFeatureAccess :exec
+INSERT INTO user_feature_access (
+    user_id,
+    feature_key,
+    source,
+    source_reference,
+    granted_by,
+    note,
+    starts_at,
+    expires_at
+)
+VALUES ($1, $2, 'stripe', $3, 'stripe_webhook', $4, CURRENT_TIMESTAMP, $5)
+`
+
+type GrantStripeFeatureAccessParams struct {
+	UserID          string             `json:"user_id"`
+	FeatureKey      string             `json:"feature_key"`
+	SourceReference pgtype.Text        `json:"source_reference"`
+	Note            pgtype.Text        `json:"note"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) GrantStripeFeatureAccess(ctx context.Context, arg GrantStripeFeatureAccessParams) error {
+	_, err := q.db.Exec(ctx, grantStripeFeatureAccess,
+		arg.UserID,
+		arg.FeatureKey,
+		arg.SourceReference,
+		arg.Note,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const hasActiveFeatureAccess = `-- name: HasActiveFeatureAccess :one
+SELECT EXISTS (
+    SELECT 1
+    FROM user_feature_access
+    WHERE user_id = $1
+      AND feature_key = $2
+      AND revoked_at IS NULL
+      AND starts_at <= NOW()
+      AND (expires_at IS NULL OR expires_at > NOW())
+)
+`
+
+type HasActiveFeatureAccessParams struct {
+	UserID     string `json:"user_id"`
+	FeatureKey string `json:"feature_key"`
+}
+
+func (q *Queries) HasActiveFeatureAccess(ctx context.Context, arg HasActiveFeatureAccessParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasActiveFeatureAccess, arg.UserID, arg.FeatureKey)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const hasProcessedStripeWebhookEvent = `-- name: HasProcessedStripeWebhookEvent :one
+SELECT EXISTS (
+    SELECT 1
+    FROM stripe_webhook_events
+    WHERE stripe_event_id = $1
+)
+`
+
+func (q *Queries) HasProcessedStripeWebhookEvent(ctx context.Context, stripeEventID string) (bool, error) {
+	row := q.db.QueryRow(ctx, hasProcessedStripeWebhookEvent, stripeEventID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const heartbeatAIChatRunGeneration = `-- name: HeartbeatAIChatRunGeneration :execrows
+UPDATE ai_chat_run
+SET generation_lease_expires_at = $3,
+    generation_heartbeat_at = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+  AND user_id = $2
+  AND status = 'streaming'
+  AND generation_status = 'generating'
+  AND generation_owner = $5
+`
+
+type HeartbeatAIChatRunGenerationParams struct {
+	ID                       int32              `json:"id"`
+	UserID                   string             `json:"user_id"`
+	GenerationLeaseExpiresAt pgtype.Timestamptz `json:"generation_lease_expires_at"`
+	GenerationHeartbeatAt    pgtype.Timestamptz `json:"generation_heartbeat_at"`
+	GenerationOwner          pgtype.Text        `json:"generation_owner"`
+}
+
+func (q *Queries) HeartbeatAIChatRunGeneration(ctx context.Context, arg HeartbeatAIChatRunGenerationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, heartbeatAIChatRunGeneration,
+		arg.ID,
+		arg.UserID,
+		arg.GenerationLeaseExpiresAt,
+		arg.GenerationHeartbeatAt,
+		arg.GenerationOwner,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listAIChatConversationsByUser = `-- name: ListAIChatConversationsByUser :many
+SELECT
+    id,
+    user_id,
+    title,
+    created_at,
+    updated_at,
+    last_message_at
+FROM ai_chat_conversation
+WHERE user_id = $1
+ORDER BY COALESCE(last_message_at, updated_at) DESC, updated_at DESC, id DESC
+LIMIT $2
+`
+
+type ListAIChatConversationsByUserParams struct {
+	UserID string `json:"user_id"`
+	Limit  int32  `json:"limit"`
+}
+
+type ListAIChatConversationsByUserRow struct {
+	ID            int32              `json:"id"`
+	UserID        string             `json:"user_id"`
+	Title         pgtype.Text        `json:"title"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	LastMessageAt pgtype.Timestamptz `json:"last_message_at"`
+}
+
+func (q *Queries) ListAIChatConversationsByUser(ctx context.Context, arg ListAIChatConversationsByUserParams) ([]ListAIChatConversationsByUserRow, error) {
+	rows, err := q.db.Query(ctx, listAIChatConversationsByUser, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAIChatConversationsByUserRow
+	for rows.Next() {
+		var i ListAIChatConversationsByUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LastMessageAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAIChatMessagesByConversation = `-- name: ListAIChatMessagesByConversation :many
+SELECT
+    id,
+    conversation_id,
+    user_id,
+    role,
+    content,
+    status,
+    error_message,
+    created_at,
+    updated_at,
+    completed_at
+FROM ai_chat_message
+WHERE conversation_id = $1 AND user_id = $2
+ORDER BY id ASC
+`
+
+type ListAIChatMessagesByConversationParams struct {
+	ConversationID int32  `json:"conversation_id"`
+	UserID         string `json:"user_id"`
+}
+
+func (q *Queries) ListAIChatMessagesByConversation(ctx context.Context, arg ListAIChatMessagesByConversationParams) ([]AiChatMessage, error) {
+	rows, err := q.db.Query(ctx, listAIChatMessagesByConversation, arg.ConversationID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AiChatMessage
+	for rows.Next() {
+		var i AiChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConversationID,
+			&i.UserID,
+			&i.Role,
+			&i.Content,
+			&i.Status,
+			&i.ErrorMessage,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAIChatStreamChunksAfter = `-- name: ListAIChatStreamChunksAfter :many
+SELECT
+    run_id,
+    user_id,
+    sequence,
+    delta_text,
+    created_at
+FROM ai_chat_stream_chunk
+WHERE run_id = $1
+  AND user_id = $2
+  AND sequence > $3
+ORDER BY sequence ASC
+`
+
+type ListAIChatStreamChunksAfterParams struct {
+	RunID    int32  `json:"run_id"`
+	UserID   string `json:"user_id"`
+	Sequence int32  `json:"sequence"`
+}
+
+func (q *Queries) ListAIChatStreamChunksAfter(ctx context.Context, arg ListAIChatStreamChunksAfterParams) ([]AiChatStreamChunk, error) {
+	rows, err := q.db.Query(ctx, listAIChatStreamChunksAfter, arg.RunID, arg.UserID, arg.Sequence)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AiChatStreamChunk
+	for rows.Next() {
+		var i AiChatStreamChunk
+		if err := rows.Scan(
+			&i.RunID,
+			&i.UserID,
+			&i.Sequence,
+			&i.DeltaText,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAchievementWorkoutExercises = `-- name: ListAchievementWorkoutExercises :many
+SELECT
+    w.id AS workout_id,
+    w.date,
+    s.exercise_id,
+    e.name AS exercise_name,
+    COALESCE(SUM(COALESCE(s.weight, 0) * s.reps) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS volume,
+    COALESCE(MAX(s.weight) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS top_weight,
+    COALESCE(MAX(s.weight) FILTER (WHERE s.set_type = 'working' AND s.reps >= 5), 0)::float8 AS top_five_rep_weight
+FROM workout w
+LEFT JOIN "set" s ON s.workout_id = w.id AND s.user_id = w.user_id
+LEFT JOIN exercise e ON e.id = s.exercise_id
+WHERE w.user_id = $1
+GROUP BY w.id, w.date, s.exercise_id, e.name
+ORDER BY w.date, w.id, s.exercise_id
+`
+
+type ListAchievementWorkoutExercisesRow struct {
+	WorkoutID        int32              `json:"workout_id"`
+	Date             pgtype.Timestamptz `json:"date"`
+	ExerciseID       pgtype.Int4        `json:"exercise_id"`
+	ExerciseName     pgtype.Text        `json:"exercise_name"`
+	Volume           float64            `json:"volume"`
+	TopWeight        float64            `json:"top_weight"`
+	TopFiveRepWeight float64            `json:"top_five_rep_weight"`
+}
+
+// Per-workout, per-exercise working-set totals for achievement rules. Workouts
+// without sets are returned once with a NULL exercise so they still count.
+func (q *Queries) ListAchievementWorkoutExercises(ctx context.Context, userID string) ([]ListAchievementWorkoutExercisesRow, error) {
+	rows, err := q.db.Query(ctx, listAchievementWorkoutExercises, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAchievementWorkoutExercisesRow
+	for rows.Next() {
+		var i ListAchievementWorkoutExercisesRow
+		if err := rows.Scan(
+			&i.WorkoutID,
+			&i.Date,
+			&i.ExerciseID,
+			&i.ExerciseName,
+			&i.Volume,
+			&i.TopWeight,
+			&i.TopFiveRepWeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveFeatureAccess = `-- name: ListActiveFeatureAccess :many
+SELECT
+    id,
+    user_id,
+    feature_key,
+    source,
+    source_reference,
+    granted_by,
+    note,
+    starts_at,
+    expires_at,
+    revoked_at,
+    created_at
+FROM user_feature_access
+WHERE user_id = $1
+  AND revoked_at IS NULL
+  AND starts_at <= NOW()
+  AND (expires_at IS NULL OR expires_at > NOW())
+ORDER BY feature_key, starts_at DESC, id DESC
+`
+
+// Feature access queries
+func (q *Queries) ListActiveFeatureAccess(ctx context.Context, userID string) ([]UserFeatureAccess, error) {
+	rows, err := q.db.Query(ctx, listActiveFeatureAccess, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserFeatureAccess
+	for rows.Next() {
+		var i UserFeatureAccess
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.FeatureKey,
+			&i.Source,
+			&i.SourceReference,
+			&i.GrantedBy,
+			&i.Note,
+			&i.StartsAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBodyMetricEntries = `-- name: ListBodyMetricEntries :many
+SELECT
+    id,
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes,
+    created_at,
+    updated_at
+FROM body_metric_entry
+WHERE user_id = $1
+  AND ($2::date IS NULL OR measured_on >= $2::date)
+  AND ($3::date IS NULL OR measured_on <= $3::date)
+ORDER BY measured_on DESC, id DESC
+LIMIT $4
+`
+
+type ListBodyMetricEntriesParams struct {
+	UserID    string      `json:"user_id"`
+	StartDate pgtype.Date `json:"start_date"`
+	EndDate   pgtype.Date `json:"end_date"`
+	RowLimit  int32       `json:"row_limit"`
+}
+
+func (q *Queries) ListBodyMetricEntries(ctx context.Context, arg ListBodyMetricEntriesParams) ([]BodyMetricEntry, error) {
+	rows, err := q.db.Query(ctx, listBodyMetricEntries,
+		arg.UserID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BodyMetricEntry
+	for rows.Next() {
+		var i BodyMetricEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.MeasuredOn,
+			&i.Bodyweight,
+			&i.BodyFatPercent,
+			&i.Neck,
+			&i.Chest,
+			&i.Waist,
+			&i.Hips,
+			&i.Arm,
+			&i.Thigh,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBodyMetricEntriesSince = `-- name: ListBodyMetricEntriesSince :many
+SELECT
+    id,
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes,
+    created_at,
+    updated_at
+FROM body_metric_entry
+WHERE user_id = $1
+  AND measured_on >= $2
+ORDER BY measured_on ASC, id ASC
+`
+
+type ListBodyMetricEntriesSinceParams struct {
+	UserID     string      `json:"user_id"`
+	MeasuredOn pgtype.Date `json:"measured_on"`
+}
+
+func (q *Queries) ListBodyMetricEntriesSince(ctx context.Context, arg ListBodyMetricEntriesSinceParams) ([]BodyMetricEntry, error) {
+	rows, err := q.db.Query(ctx, listBodyMetricEntriesSince,
+		arg.UserID,
+		arg.MeasuredOn,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BodyMetricEntry
+	for rows.Next() {
+		var i BodyMetricEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.MeasuredOn,
+			&i.Bodyweight,
+			&i.BodyFatPercent,
+			&i.Neck,
+			&i.Chest,
+			&i.Waist,
+			&i.Hips,
+			&i.Arm,
+			&i.Thigh,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBodyweightReadings = `-- name: ListBodyweightReadings :many
+SELECT measured_on, bodyweight
+FROM body_metric_entry
+WHERE user_id = $1
+  AND bodyweight IS NOT NULL
+ORDER BY measured_on ASC, id ASC
+`
+
+type ListBodyweightReadingsRow struct {
+	MeasuredOn pgtype.Date    `json:"measured_on"`
+	Bodyweight pgtype.Numeric `json:"bodyweight"`
+}
+
+func (q *Queries) ListBodyweightReadings(ctx context.Context, userID string) ([]ListBodyweightReadingsRow, error) {
+	rows, err := q.db.Query(ctx, listBodyweightReadings, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBodyweightReadingsRow
+	for rows.Next() {
+		var i ListBodyweightReadingsRow
+		if err := rows.Scan(&i.MeasuredOn, &i.Bodyweight); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCalendarFeedExercises = `-- name: ListCalendarFeedExercises :many
+SELECT
+    s.workout_id,
+    e.name AS exercise_name,
+    MIN(s.exercise_order)::int AS exercise_order,
+    COUNT(s.id)::int AS total_sets,
+    COUNT(s.id) FILTER (WHERE s.set_type = 'working')::int AS working_sets,
+    COALESCE(MAX(s.weight) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS top_weight
+FROM "set" s
+JOIN exercise e ON e.id = s.exercise_id AND e.user_id = s.user_id
+WHERE s.user_id = $1
+GROUP BY s.workout_id, e.id, e.name
+ORDER BY s.workout_id, exercise_order, e.name
+`
+
+type ListCalendarFeedExercisesRow struct {
+	WorkoutID     int32   `json:"workout_id"`
+	ExerciseName  string  `json:"exercise_name"`
+	ExerciseOrder int32   `json:"exercise_order"`
+	TotalSets     int32   `json:"total_sets"`
+	WorkingSets   int32   `json:"working_sets"`
+	TopWeight     float64 `json:"top_weight"`
+}
+
+// Per-workout exercise summary lines in logged order.
+func (q *Queries) ListCalendarFeedExercises(ctx context.Context, userID string) ([]ListCalendarFeedExercisesRow, error) {
+	rows, err := q.db.Query(ctx, listCalendarFeedExercises, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCalendarFeedExercisesRow
+	for rows.Next() {
+		var i ListCalendarFeedExercisesRow
+		if err := rows.Scan(
+			&i.WorkoutID,
+			&i.ExerciseName,
+			&i.ExerciseOrder,
+			&i.TotalSets,
+			&i.WorkingSets,
+			&i.TopWeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCalendarFeedWorkouts = `-- name: ListCalendarFeedWorkouts :many
+SELECT
+    w.id,
+    w.date,
+    w.workout_focus,
+    w.notes,
+    w.created_at,
+    w.updated_at,
+    COALESCE(SUM(COALESCE(s.weight, 0) * s.reps) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS tonnage,
+    COUNT(s.id) FILTER (WHERE s.set_type = 'working')::int AS working_sets
+FROM workout w
+LEFT JOIN "set" s ON s.workout_id = w.id AND s.user_id = w.user_id
+WHERE w.user_id = $1
+GROUP BY w.id
+ORDER BY w.date, w.id
+`
+
+type ListCalendarFeedWorkoutsRow struct {
+	ID           int32              `json:"id"`
+	Date         pgtype.Timestamptz `json:"date"`
+	WorkoutFocus pgtype.Text        `json:"workout_focus"`
+	Notes        pgtype.Text        `json:"notes"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	Tonnage      float64            `json:"tonnage"`
+	WorkingSets  int32              `json:"working_sets"`
+}
+
+// Every workout with its working-set volume, for publishing as calendar events.
+func (q *Queries) ListCalendarFeedWorkouts(ctx context.Context, userID string) ([]ListCalendarFeedWorkoutsRow, error) {
+	rows, err := q.db.Query(ctx, listCalendarFeedWorkouts, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCalendarFeedWorkoutsRow
+	for rows.Next() {
+		var i ListCalendarFeedWorkoutsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Date,
+			&i.WorkoutFocus,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Tonnage,
+			&i.WorkingSets,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCoachingAthletes = `-- name: ListCoachingAthletes :many
+SELECT id, coach_user_id, athlete_user_id, scopes, invite_code_hash, invite_expires_at, created_at, accepted_at FROM coaching_link
+WHERE coach_user_id = $1 AND athlete_user_id IS NOT NULL
+ORDER BY accepted_at, id
+`
+
+func (q *Queries) ListCoachingAthletes(ctx context.Context, coachUserID string) ([]CoachingLink, error) {
+	rows, err := q.db.Query(ctx, listCoachingAthletes, coachUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoachingLink
+	for rows.Next() {
+		var i CoachingLink
+		if err := rows.Scan(
+			&i.ID,
+			&i.CoachUserID,
+			&i.AthleteUserID,
+			&i.Scopes,
+			&i.InviteCodeHash,
+			&i.InviteExpiresAt,
+			&i.CreatedAt,
+			&i.AcceptedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCoachingCoaches = `-- name: ListCoachingCoaches :many
+SELECT id, coach_user_id, athlete_user_id, scopes, invite_code_hash, invite_expires_at, created_at, accepted_at FROM coaching_link
+WHERE athlete_user_id = $1::text
+ORDER BY accepted_at, id
+`
+
+func (q *Queries) ListCoachingCoaches(ctx context.Context, athleteUserID string) ([]CoachingLink, error) {
+	rows, err := q.db.Query(ctx, listCoachingCoaches, athleteUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoachingLink
+	for rows.Next() {
+		var i CoachingLink
+		if err := rows.Scan(
+			&i.ID,
+			&i.CoachUserID,
+			&i.AthleteUserID,
+			&i.Scopes,
+			&i.InviteCodeHash,
+			&i.InviteExpiresAt,
+			&i.CreatedAt,
+			&i.AcceptedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCoachingSuggestions = `-- name: ListCoachingSuggestions :many
+SELECT id, link_id, coach_user_id, athlete_user_id, title, notes, scheduled_on, exercises, created_at, dismissed_at FROM coaching_suggestion
+WHERE athlete_user_id = $1 AND dismissed_at IS NULL
+ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) ListCoachingSuggestions(ctx context.Context, athleteUserID string) ([]CoachingSuggestion, error) {
+	rows, err := q.db.Query(ctx, listCoachingSuggestions, athleteUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoachingSuggestion
+	for rows.Next() {
+		var i CoachingSuggestion
+		if err := rows.Scan(
+			&i.ID,
+			&i.LinkID,
+			&i.CoachUserID,
+			&i.AthleteUserID,
+			&i.Title,
+			&i.Notes,
+			&i.ScheduledOn,
+			&i.Exercises,
+			&i.CreatedAt,
+			&i.DismissedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCurrentExerciseNotes = `-- name: ListCurrentExerciseNotes :many
+WITH latest AS (
+    SELECT DISTINCT ON (n.exercise_id)
+        n.exercise_id,
+        n.notes,
+        n.settings,
+        n.created_at
+    FROM exercise_note n
+    WHERE n.user_id = $1
+    ORDER BY n.exercise_id, n.created_at DESC, n.id DESC
+)
+SELECT e.name AS exercise_name, latest.notes, latest.settings
+FROM latest
+JOIN exercise e ON e.id = latest.exercise_id
+WHERE latest.notes IS NOT NULL OR latest.settings IS NOT NULL
+ORDER BY latest.created_at DESC
+LIMIT $2
+`
+
+type ListCurrentExerciseNotesParams struct {
+	UserID   string `json:"user_id"`
+	RowLimit int32  `json:"row_limit"`
+}
+
+type ListCurrentExerciseNotesRow struct {
+	ExerciseName string      `json:"exercise_name"`
+	Notes        pgtype.Text `json:"notes"`
+	Settings     pgtype.Text `json:"settings"`
+}
+
+// Latest non-empty revision per exercise, most recently edited first.
+func (q *Queries) ListCurrentExerciseNotes(ctx context.Context, arg ListCurrentExerciseNotesParams) ([]ListCurrentExerciseNotesRow, error) {
+	rows, err := q.db.Query(ctx, listCurrentExerciseNotes, arg.UserID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCurrentExerciseNotesRow
+	for rows.Next() {
+		var i ListCurrentExerciseNotesRow
+		if err := rows.Scan(&i.ExerciseName, &i.Notes, &i.Settings); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExerciseNameMatches = `-- name: ListExerciseNameMatches :many
+SELECT id, name
+FROM exercise
+WHERE user_id = $1
+  AND name ILIKE '%' || $2::text || '%'
+ORDER BY name
+LIMIT 8
+`
+
+type ListExerciseNameMatchesParams struct {
+	UserID    string `json:"user_id"`
+	NameQuery string `json:"name_query"`
+}
+
+type ListExerciseNameMatchesRow struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) ListExerciseNameMatches(ctx context.Context, arg ListExerciseNameMatchesParams) ([]ListExerciseNameMatchesRow, error) {
+	rows, err := q.db.Query(ctx, listExerciseNameMatches, arg.UserID, arg.NameQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExerciseNameMatchesRow
+	for rows.Next() {
+		var i ListExerciseNameMatchesRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExerciseNoteHistory = `-- name: ListExerciseNoteHistory :many
+SELECT id, exercise_id, user_id, notes, settings, created_at
+FROM exercise_note
+WHERE exercise_id = $1 AND user_id = $2
+ORDER BY created_at DESC, id DESC
+LIMIT $3
+`
+
+type ListExerciseNoteHistoryParams struct {
+	ExerciseID int32  `json:"exercise_id"`
+	UserID     string `json:"user_id"`
+	Limit      int32  `json:"limit"`
+}
+
+func (q *Queries) ListExerciseNoteHistory(ctx context.Context, arg ListExerciseNoteHistoryParams) ([]ExerciseNote, error) {
+	rows, err := q.db.Query(ctx, listExerciseNoteHistory, arg.ExerciseID, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExerciseNote
+	for rows.Next() {
+		var i ExerciseNote
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExerciseID,
+			&i.UserID,
+			&i.Notes,
+			&i.Settings,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExerciseSessionBestsSince = `-- name: ListExerciseSessionBestsSince :many
+SELECT
+    s.exercise_id,
+    e.name AS exercise_name,
+    w.id AS workout_id,
+    (w.date AT TIME ZONE COALESCE(u.timezone, 'UTC'))::date AS workout_day,
+    MAX(COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))::float8 AS session_best_e1rm,
+    AVG(s.reps)::float8 AS avg_reps
+FROM "set" s
+JOIN workout w ON w.id = s.workout_id
+JOIN exercise e ON e.id = s.exercise_id
+JOIN users u ON u.user_id = s.user_id
+WHERE s.user_id = $1
+  AND s.set_type = 'working'
+  AND w.date >= $2
+GROUP BY s.exercise_id, e.name, w.id, workout_day
+ORDER BY s.exercise_id ASC, workout_day ASC, w.id ASC
+`
+
+type ListExerciseSessionBestsSinceParams struct {
+	UserID string             `json:"user_id"`
+	Date   pgtype.Timestamptz `json:"date"`
+}
+
+type ListExerciseSessionBestsSinceRow struct {
+	ExerciseID      int32       `json:"exercise_id"`
+	ExerciseName    string      `json:"exercise_name"`
+	WorkoutID       int32       `json:"workout_id"`
+	WorkoutDay      pgtype.Date `json:"workout_day"`
+	SessionBestE1rm float64     `json:"session_best_e1rm"`
+	AvgReps         float64     `json:"avg_reps"`
+}
+
+// Session-best Epley e1RM per workout for every exercise, using the same
+// working-set formula and local-day bucketing as GetExerciseMetricsHistoryRaw.
+func (q *Queries) ListExerciseSessionBestsSince(ctx context.Context, arg ListExerciseSessionBestsSinceParams) ([]ListExerciseSessionBestsSinceRow, error) {
+	rows, err := q.db.Query(ctx, listExerciseSessionBestsSince, arg.UserID, arg.Date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExerciseSessionBestsSinceRow
+	for rows.Next() {
+		var i ListExerciseSessionBestsSinceRow
+		if err := rows.Scan(
+			&i.ExerciseID,
+			&i.ExerciseName,
+			&i.WorkoutID,
+			&i.WorkoutDay,
+			&i.SessionBestE1rm,
+			&i.AvgReps,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExercises = `-- name: ListExercises :many
+SELECT id, name FROM exercise WHERE user_id = $1 ORDER BY name
+`
+
+type ListExercisesRow struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) ListExercises(ctx context.Context, userID string) ([]ListExercisesRow, error) {
+	rows, err := q.db.Query(ctx, listExercises, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExercisesRow
+	for rows.Next() {
+		var i ListExercisesRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExercisesWithHistorical1RMSourceWorkout = `-- name: ListExercisesWithHistorical1RMSourceWorkout :many
+SELECT id
+FROM exercise
+WHERE user_id = $1 AND historical_1rm_source_workout_id = $2
+`
+
+type ListExercisesWithHistorical1RMSourceWorkoutParams struct {
+	UserID                       string      `json:"user_id"`
+	Historical1rmSourceWorkoutID pgtype.Int4 `json:"historical_1rm_source_workout_id"`
+}
+
+func (q *Queries) ListExercisesWithHistorical1RMSourceWorkout(ctx context.Context, arg ListExercisesWithHistorical1RMSourceWorkoutParams) ([]int32, error) {
+	rows, err := q.db.Query(ctx, listExercisesWithHistorical1RMSourceWorkout, arg.UserID, arg.Historical1rmSourceWorkoutID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGoals = `-- name: ListGoals :many
+SELECT
+    g.id,
+    g.goal_type,
+    g.exercise_id,
+    e.name AS exercise_name,
+    g.target_value,
+    g.start_value,
+    g.target_date,
+    g.created_at
+FROM goal g
+LEFT JOIN exercise e ON e.id = g.exercise_id
+WHERE g.user_id = $1
+ORDER BY g.created_at, g.id
+`
+
+type ListGoalsRow struct {
+	ID           int32              `json:"id"`
+	GoalType     string             `json:"goal_type"`
+	ExerciseID   pgtype.Int4        `json:"exercise_id"`
+	ExerciseName pgtype.Text        `json:"exercise_name"`
+	TargetValue  pgtype.Numeric     `json:"target_value"`
+	StartValue   pgtype.Numeric     `json:"start_value"`
+	TargetDate   pgtype.Date        `json:"target_date"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 }
 
-func (q *Queries) GetWorkoutBestE1rmByExercise(ctx context.Context, arg GetWorkoutBestE1rmByExerciseParams) ([]GetWorkoutBestE1rmByExerciseRow, error) {
-	rows, err := q.db.Query(ctx, getWorkoutBestE1rmByExercise, arg.WorkoutID, arg.UserID)
+func (q *Queries) ListGoals(ctx context.Context, userID string) ([]ListGoalsRow, error) {
+	rows, err := q.db.Query(ctx, listGoals, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetWorkoutBestE1rmByExerciseRow
+	var items []ListGoalsRow
 	for rows.Next() {
-		var i GetWorkoutBestE1rmByExerciseRow
-		if err := rows.Scan(&i.ExerciseID, &i.BestE1rm); err != nil {
+		var i ListGoalsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GoalType,
+			&i.ExerciseID,
+			&i.ExerciseName,
+			&i.TargetValue,
+			&i.StartValue,
+			&i.TargetDate,
+			&i.CreatedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -2244,73 +4237,51 @@ func (q *Queries) GetWorkoutBestE1rmByExercise(ctx context.Context, arg GetWorko
 	return items, nil
 }
 
-const getWorkoutWithSets = `-- name: GetWorkoutWithSets :many
-SELECT 
-    w.id as workout_id,
-    w.date as workout_date,
-    w.notes as workout_notes,
-    w.workout_focus as workout_focus,
-    s.id as set_id,
-    s.weight,
-    s.reps,
-    s.set_type,
-    e.id as exercise_id,
-    e.name as exercise_name,
-    s.exercise_order,
-    s.set_order,
-    (COALESCE(s.weight, 0) * s.reps)::NUMERIC(10,1) as volume
-FROM workout w
-JOIN "set" s ON w.id = s.workout_id
-JOIN exercise e ON s.exercise_id = e.id
-WHERE w.id = $1 AND w.user_id = $2
-ORDER BY s.exercise_order, s.set_order, s.id
+const listHistorical1RMUpdatesBetween = `-- name: ListHistorical1RMUpdatesBetween :many
+SELECT
+    id,
+    name,
+    COALESCE(historical_1rm, 0)::float8 AS historical_1rm,
+    historical_1rm_updated_at,
+    historical_1rm_source_workout_id
+FROM exercise
+WHERE user_id = $1
+  AND historical_1rm IS NOT NULL
+  AND historical_1rm_updated_at >= $2
+  AND historical_1rm_updated_at < $3
+ORDER BY historical_1rm_updated_at, id
 `
 
-type GetWorkoutWithSetsParams struct {
-	ID     int32  `json:"id"`
-	UserID string `json:"user_id"`
+type ListHistorical1RMUpdatesBetweenParams struct {
+	UserID  string             `json:"user_id"`
+	StartAt pgtype.Timestamptz `json:"start_at"`
+	EndAt   pgtype.Timestamptz `json:"end_at"`
 }
 
-type GetWorkoutWithSetsRow struct {
-	WorkoutID     int32              `json:"workout_id"`
-	WorkoutDate   pgtype.Timestamptz `json:"workout_date"`
-	WorkoutNotes  pgtype.Text        `json:"workout_notes"`
-	WorkoutFocus  pgtype.Text        `json:"workout_focus"`
-	SetID         int32              `json:"set_id"`
-	Weight        pgtype.Numeric     `json:"weight"`
-	Reps          int32              `json:"reps"`
-	SetType       string             `json:"set_type"`
-	ExerciseID    int32              `json:"exercise_id"`
-	ExerciseName  string             `json:"exercise_name"`
-	ExerciseOrder int32              `json:"exercise_order"`
-	SetOrder      int32              `json:"set_order"`
-	Volume        pgtype.Numeric     `json:"volume"`
+type ListHistorical1RMUpdatesBetweenRow struct {
+	ID                           int32              `json:"id"`
+	Name                         string             `json:"name"`
+	Historical1rm                float64            `json:"historical_1rm"`
+	Historical1rmUpdatedAt       pgtype.Timestamptz `json:"historical_1rm_updated_at"`
+	Historical1rmSourceWorkoutID pgtype.Int4        `json:"historical_1rm_source_workout_id"`
 }
 
-// Complex queries for joining data
-func (q *Queries) GetWorkoutWithSets(ctx context.Context, arg GetWorkoutWithSetsParams) ([]GetWorkoutWithSetsRow, error) {
-	rows, err := q.db.Query(ctx, getWorkoutWithSets, arg.ID, arg.UserID)
+// Exercises whose stored historical 1RM was raised inside the report window.
+func (q *Queries) ListHistorical1RMUpdatesBetween(ctx context.Context, arg ListHistorical1RMUpdatesBetweenParams) ([]ListHistorical1RMUpdatesBetweenRow, error) {
+	rows, err := q.db.Query(ctx, listHistorical1RMUpdatesBetween, arg.UserID, arg.StartAt, arg.EndAt)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetWorkoutWithSetsRow
+	var items []ListHistorical1RMUpdatesBetweenRow
 	for rows.Next() {
-		var i GetWorkoutWithSetsRow
+		var i ListHistorical1RMUpdatesBetweenRow
 		if err := rows.Scan(
-			&i.WorkoutID,
-			&i.WorkoutDate,
-			&i.WorkoutNotes,
-			&i.WorkoutFocus,
-			&i.SetID,
-			&i.Weight,
-			&i.Reps,
-			&i.SetType,
-			&i.ExerciseID,
-			&i.ExerciseName,
-			&i.ExerciseOrder,
-			&i.SetOrder,
-			&i.Volume,
+			&i.ID,
+			&i.Name,
+			&i.Historical1rm,
+			&i.Historical1rmUpdatedAt,
+			&i.Historical1rmSourceWorkoutID,
 		); err != nil {
 			return nil, err
 		}
@@ -2322,156 +4293,224 @@ func (q *Queries) GetWorkoutWithSets(ctx context.Context, arg GetWorkoutWithSets
 	return items, nil
 }
 
-const grantStripeFeatureAccess = `-- name: GrantStripeFeatureAccess :exec
-INSERT INTO user_feature_access (
-    user_id,
-    feature_key,
-    source,
-    source_reference,
-    granted_by,
-    note,
-    starts_at,
-    expires_at
-)
-VALUES ($1, $2, 'stripe', $3, 'stripe_webhook', $4, CURRENT_TIMESTAMP, $5)
+const listNotifications = `-- name: ListNotifications :many
+SELECT id, user_id, kind, title, body, data, read_at, created_at
+FROM notification
+WHERE user_id = $1
+  AND (NOT $2::boolean OR read_at IS NULL)
+  AND ($3::int IS NULL OR id < $3::int)
+ORDER BY id DESC
+LIMIT $4
 `
 
-type GrantStripeFeatureAccessParams struct {
-	UserID          string             `json:"user_id"`
-	FeatureKey      string             `json:"feature_key"`
-	SourceReference pgtype.Text        `json:"source_reference"`
-	Note            pgtype.Text        `json:"note"`
-	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+type ListNotificationsParams struct {
+	UserID     string      `json:"user_id"`
+	UnreadOnly bool        `json:"unread_only"`
+	BeforeID   pgtype.Int4 `json:"before_id"`
+	RowLimit   int32       `json:"row_limit"`
 }
 
-func (q *Queries) GrantStripeFeatureAccess(ctx context.Context, arg GrantStripeFeatureAccessParams) error {
-	_, err := q.db.Exec(ctx, grantStripeFeatureAccess,
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, listNotifications,
 		arg.UserID,
-		arg.FeatureKey,
-		arg.SourceReference,
-		arg.Note,
-		arg.ExpiresAt,
+		arg.UnreadOnly,
+		arg.BeforeID,
+		arg.RowLimit,
 	)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Kind,
+			&i.Title,
+			&i.Body,
+			&i.Data,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const hasActiveFeatureAccess = `-- name: HasActiveFeatureAccess :one
-SELECT EXISTS (
-    SELECT 1
-    FROM user_feature_access
-    WHERE user_id = $1
-      AND feature_key = $2
-      AND revoked_at IS NULL
-      AND starts_at <= NOW()
-      AND (expires_at IS NULL OR expires_at > NOW())
-)
+const listNotificationsAfter = `-- name: ListNotificationsAfter :many
+SELECT id, user_id, kind, title, body, data, read_at, created_at
+FROM notification
+WHERE user_id = $1 AND id > $2
+ORDER BY id
+LIMIT 100
 `
 
-type HasActiveFeatureAccessParams struct {
-	UserID     string `json:"user_id"`
-	FeatureKey string `json:"feature_key"`
+type ListNotificationsAfterParams struct {
+	UserID  string `json:"user_id"`
+	AfterID int32  `json:"after_id"`
 }
 
-func (q *Queries) HasActiveFeatureAccess(ctx context.Context, arg HasActiveFeatureAccessParams) (bool, error) {
-	row := q.db.QueryRow(ctx, hasActiveFeatureAccess, arg.UserID, arg.FeatureKey)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+// Notifications newer than after_id, oldest first, for the live stream.
+func (q *Queries) ListNotificationsAfter(ctx context.Context, arg ListNotificationsAfterParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, listNotificationsAfter, arg.UserID, arg.AfterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Kind,
+			&i.Title,
+			&i.Body,
+			&i.Data,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const hasProcessedStripeWebhookEvent = `-- name: HasProcessedStripeWebhookEvent :one
-SELECT EXISTS (
-    SELECT 1
-    FROM stripe_webhook_events
-    WHERE stripe_event_id = $1
-)
+const listPendingCoachingInvitations = `-- name: ListPendingCoachingInvitations :many
+SELECT id, coach_user_id, athlete_user_id, scopes, invite_code_hash, invite_expires_at, created_at, accepted_at FROM coaching_link
+WHERE coach_user_id = $1
+  AND athlete_user_id IS NULL
+  AND invite_expires_at > CURRENT_TIMESTAMP
+ORDER BY created_at DESC, id DESC
 `
 
-func (q *Queries) HasProcessedStripeWebhookEvent(ctx context.Context, stripeEventID string) (bool, error) {
-	row := q.db.QueryRow(ctx, hasProcessedStripeWebhookEvent, stripeEventID)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+func (q *Queries) ListPendingCoachingInvitations(ctx context.Context, coachUserID string) ([]CoachingLink, error) {
+	rows, err := q.db.Query(ctx, listPendingCoachingInvitations, coachUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoachingLink
+	for rows.Next() {
+		var i CoachingLink
+		if err := rows.Scan(
+			&i.ID,
+			&i.CoachUserID,
+			&i.AthleteUserID,
+			&i.Scopes,
+			&i.InviteCodeHash,
+			&i.InviteExpiresAt,
+			&i.CreatedAt,
+			&i.AcceptedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const heartbeatAIChatRunGeneration = `-- name: HeartbeatAIChatRunGeneration :execrows
-UPDATE ai_chat_run
-SET generation_lease_expires_at = $3,
-    generation_heartbeat_at = $4,
-    updated_at = CURRENT_TIMESTAMP
-WHERE id = $1
-  AND user_id = $2
-  AND status = 'streaming'
-  AND generation_status = 'generating'
-  AND generation_owner = $5
+const listPersonalAccessTokens = `-- name: ListPersonalAccessTokens :many
+SELECT id, user_id, name, token_hash, token_prefix, scopes, expires_at, last_used_at, revoked_at, created_at
+FROM personal_access_token
+WHERE user_id = $1 AND revoked_at IS NULL
+ORDER BY created_at DESC, id DESC
 `
 
-type HeartbeatAIChatRunGenerationParams struct {
-	ID                       int32              `json:"id"`
-	UserID                   string             `json:"user_id"`
-	GenerationLeaseExpiresAt pgtype.Timestamptz `json:"generation_lease_expires_at"`
-	GenerationHeartbeatAt    pgtype.Timestamptz `json:"generation_heartbeat_at"`
-	GenerationOwner          pgtype.Text        `json:"generation_owner"`
-}
-
-func (q *Queries) HeartbeatAIChatRunGeneration(ctx context.Context, arg HeartbeatAIChatRunGenerationParams) (int64, error) {
-	result, err := q.db.Exec(ctx, heartbeatAIChatRunGeneration,
-		arg.ID,
-		arg.UserID,
-		arg.GenerationLeaseExpiresAt,
-		arg.GenerationHeartbeatAt,
-		arg.GenerationOwner,
-	)
+func (q *Queries) ListPersonalAccessTokens(ctx context.Context, userID string) ([]PersonalAccessToken, error) {
+	rows, err := q.db.Query(ctx, listPersonalAccessTokens, userID)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return result.RowsAffected(), nil
+	defer rows.Close()
+	var items []PersonalAccessToken
+	for rows.Next() {
+		var i PersonalAccessToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.TokenHash,
+			&i.TokenPrefix,
+			&i.Scopes,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const listAIChatConversationsByUser = `-- name: ListAIChatConversationsByUser :many
-SELECT
-    id,
-    user_id,
-    title,
-    created_at,
-    updated_at,
-    last_message_at
-FROM ai_chat_conversation
-WHERE user_id = $1
-ORDER BY COALESCE(last_message_at, updated_at) DESC, updated_at DESC, id DESC
-LIMIT $2
+const listReportExerciseProgress = `-- name: ListReportExerciseProgress :many
+SELECT
+    e.id AS exercise_id,
+    e.name AS exercise_name,
+    COUNT(s.id) FILTER (WHERE w.date >= $1)::int AS period_sets,
+    COALESCE(MAX(COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) FILTER (WHERE w.date >= $1), 0)::float8 AS period_best_e1rm,
+    COALESCE(MAX(COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) FILTER (WHERE w.date < $1), 0)::float8 AS prior_best_e1rm
+FROM "set" s
+JOIN workout w ON w.id = s.workout_id AND w.user_id = s.user_id
+JOIN exercise e ON e.id = s.exercise_id AND e.user_id = s.user_id
+WHERE s.user_id = $2
+  AND s.set_type = 'working'
+  AND w.date < $3
+GROUP BY e.id, e.name
+HAVING COUNT(s.id) FILTER (WHERE w.date >= $1) > 0
+ORDER BY e.name, e.id
 `
 
-type ListAIChatConversationsByUserParams struct {
-	UserID string `json:"user_id"`
-	Limit  int32  `json:"limit"`
+type ListReportExerciseProgressParams struct {
+	StartAt pgtype.Timestamptz `json:"start_at"`
+	UserID  string             `json:"user_id"`
+	EndAt   pgtype.Timestamptz `json:"end_at"`
 }
 
-type ListAIChatConversationsByUserRow struct {
-	ID            int32              `json:"id"`
-	UserID        string             `json:"user_id"`
-	Title         pgtype.Text        `json:"title"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
-	LastMessageAt pgtype.Timestamptz `json:"last_message_at"`
+type ListReportExerciseProgressRow struct {
+	ExerciseID     int32   `json:"exercise_id"`
+	ExerciseName   string  `json:"exercise_name"`
+	PeriodSets     int32   `json:"period_sets"`
+	PeriodBestE1rm float64 `json:"period_best_e1rm"`
+	PriorBestE1rm  float64 `json:"prior_best_e1rm"`
 }
 
-func (q *Queries) ListAIChatConversationsByUser(ctx context.Context, arg ListAIChatConversationsByUserParams) ([]ListAIChatConversationsByUserRow, error) {
-	rows, err := q.db.Query(ctx, listAIChatConversationsByUser, arg.UserID, arg.Limit)
+// Best working-set Epley e1RM per exercise trained in the report window,
+// alongside the best from any earlier session (0 when there is none).
+func (q *Queries) ListReportExerciseProgress(ctx context.Context, arg ListReportExerciseProgressParams) ([]ListReportExerciseProgressRow, error) {
+	rows, err := q.db.Query(ctx, listReportExerciseProgress, arg.StartAt, arg.UserID, arg.EndAt)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListAIChatConversationsByUserRow
+	var items []ListReportExerciseProgressRow
 	for rows.Next() {
-		var i ListAIChatConversationsByUserRow
+		var i ListReportExerciseProgressRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.Title,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.LastMessageAt,
+			&i.ExerciseID,
+			&i.ExerciseName,
+			&i.PeriodSets,
+			&i.PeriodBestE1rm,
+			&i.PriorBestE1rm,
 		); err != nil {
 			return nil, err
 		}
@@ -2483,48 +4522,52 @@ func (q *Queries) ListAIChatConversationsByUser(ctx context.Context, arg ListAIC
 	return items, nil
 }
 
-const listAIChatMessagesByConversation = `-- name: ListAIChatMessagesByConversation :many
+const listReportWorkouts = `-- name: ListReportWorkouts :many
 SELECT
-    id,
-    conversation_id,
-    user_id,
-    role,
-    content,
-    status,
-    error_message,
-    created_at,
-    updated_at,
-    completed_at
-FROM ai_chat_message
-WHERE conversation_id = $1 AND user_id = $2
-ORDER BY id ASC
+    w.id,
+    w.date,
+    w.workout_focus,
+    COALESCE(SUM(COALESCE(s.weight, 0) * s.reps) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS tonnage,
+    COUNT(s.id) FILTER (WHERE s.set_type = 'working')::int AS working_sets
+FROM workout w
+LEFT JOIN "set" s ON s.workout_id = w.id AND s.user_id = w.user_id
+WHERE w.user_id = $1
+  AND w.date >= $2
+  AND w.date < $3
+GROUP BY w.id, w.date, w.workout_focus
+ORDER BY w.date, w.id
 `
 
-type ListAIChatMessagesByConversationParams struct {
-	ConversationID int32  `json:"conversation_id"`
-	UserID         string `json:"user_id"`
+type ListReportWorkoutsParams struct {
+	UserID  string             `json:"user_id"`
+	StartAt pgtype.Timestamptz `json:"start_at"`
+	EndAt   pgtype.Timestamptz `json:"end_at"`
 }
 
-func (q *Queries) ListAIChatMessagesByConversation(ctx context.Context, arg ListAIChatMessagesByConversationParams) ([]AiChatMessage, error) {
-	rows, err := q.db.Query(ctx, listAIChatMessagesByConversation, arg.ConversationID, arg.UserID)
+type ListReportWorkoutsRow struct {
+	ID           int32              `json:"id"`
+	Date         pgtype.Timestamptz `json:"date"`
+	WorkoutFocus pgtype.Text        `json:"workout_focus"`
+	Tonnage      float64            `json:"tonnage"`
+	WorkingSets  int32              `json:"working_sets"`
+}
+
+// Per-workout working-set count and tonnage for one report window.
+func (q *Queries) ListReportWorkouts(ctx context.Context, arg ListReportWorkoutsParams) ([]ListReportWorkoutsRow, error) {
+	rows, err := q.db.Query(ctx, listReportWorkouts, arg.UserID, arg.StartAt, arg.EndAt)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AiChatMessage
+	var items []ListReportWorkoutsRow
 	for rows.Next() {
-		var i AiChatMessage
+		var i ListReportWorkoutsRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.ConversationID,
-			&i.UserID,
-			&i.Role,
-			&i.Content,
-			&i.Status,
-			&i.ErrorMessage,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.CompletedAt,
+			&i.Date,
+			&i.WorkoutFocus,
+			&i.Tonnage,
+			&i.WorkingSets,
 		); err != nil {
 			return nil, err
 		}
@@ -2536,42 +4579,44 @@ func (q *Queries) ListAIChatMessagesByConversation(ctx context.Context, arg List
 	return items, nil
 }
 
-const listAIChatStreamChunksAfter = `-- name: ListAIChatStreamChunksAfter :many
+const listSessionBestE1rmForExercises = `-- name: ListSessionBestE1rmForExercises :many
 SELECT
-    run_id,
-    user_id,
-    sequence,
-    delta_text,
-    created_at
-FROM ai_chat_stream_chunk
-WHERE run_id = $1
-  AND user_id = $2
-  AND sequence > $3
-ORDER BY sequence ASC
+    s.exercise_id,
+    (w.date AT TIME ZONE COALESCE(u.timezone, 'UTC'))::date AS workout_day,
+    MAX(COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))::float8 AS session_best_e1rm
+FROM "set" s
+JOIN workout w ON w.id = s.workout_id AND w.user_id = s.user_id
+JOIN users u ON u.user_id = s.user_id
+WHERE s.user_id = $1
+  AND s.exercise_id = ANY($2::int[])
+  AND s.set_type = 'working'
+GROUP BY s.exercise_id, workout_day
+ORDER BY workout_day ASC, s.exercise_id ASC
 `
 
-type ListAIChatStreamChunksAfterParams struct {
-	RunID    int32  `json:"run_id"`
-	UserID   string `json:"user_id"`
-	Sequence int32  `json:"sequence"`
+type ListSessionBestE1rmForExercisesParams struct {
+	UserID      string  `json:"user_id"`
+	ExerciseIds []int32 `json:"exercise_ids"`
 }
 
-func (q *Queries) ListAIChatStreamChunksAfter(ctx context.Context, arg ListAIChatStreamChunksAfterParams) ([]AiChatStreamChunk, error) {
-	rows, err := q.db.Query(ctx, listAIChatStreamChunksAfter, arg.RunID, arg.UserID, arg.Sequence)
+type ListSessionBestE1rmForExercisesRow struct {
+	ExerciseID      int32       `json:"exercise_id"`
+	WorkoutDay      pgtype.Date `json:"workout_day"`
+	SessionBestE1rm float64     `json:"session_best_e1rm"`
+}
+
+// Best Epley e1RM per local workout day for each requested exercise,
+// matching the working-set formula used by the exercise metrics queries.
+func (q *Queries) ListSessionBestE1rmForExercises(ctx context.Context, arg ListSessionBestE1rmForExercisesParams) ([]ListSessionBestE1rmForExercisesRow, error) {
+	rows, err := q.db.Query(ctx, listSessionBestE1rmForExercises, arg.UserID, arg.ExerciseIds)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AiChatStreamChunk
+	var items []ListSessionBestE1rmForExercisesRow
 	for rows.Next() {
-		var i AiChatStreamChunk
-		if err := rows.Scan(
-			&i.RunID,
-			&i.UserID,
-			&i.Sequence,
-			&i.DeltaText,
-			&i.CreatedAt,
-		); err != nil {
+		var i ListSessionBestE1rmForExercisesRow
+		if err := rows.Scan(&i.ExerciseID, &i.WorkoutDay, &i.SessionBestE1rm); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -2582,49 +4627,45 @@ func (q *Queries) ListAIChatStreamChunksAfter(ctx context.Context, arg ListAICha
 	return items, nil
 }
 
-const listActiveFeatureAccess = `-- name: ListActiveFeatureAccess :many
-SELECT
-    id,
-    user_id,
-    feature_key,
-    source,
-    source_reference,
-    granted_by,
-    note,
-    starts_at,
-    expires_at,
-    revoked_at,
-    created_at
-FROM user_feature_access
+const listSets = `-- name: ListSets :many
+SELECT id, exercise_id, workout_id, weight, reps, set_type, created_at, updated_at, exercise_order, set_order FROM "set"
 WHERE user_id = $1
-  AND revoked_at IS NULL
-  AND starts_at <= NOW()
-  AND (expires_at IS NULL OR expires_at > NOW())
-ORDER BY feature_key, starts_at DESC, id DESC
+ORDER BY exercise_order, set_order, id
 `
 
-// Feature access queries
-func (q *Queries) ListActiveFeatureAccess(ctx context.Context, userID string) ([]UserFeatureAccess, error) {
-	rows, err := q.db.Query(ctx, listActiveFeatureAccess, userID)
+type ListSetsRow struct {
+	ID            int32              `json:"id"`
+	ExerciseID    int32              `json:"exercise_id"`
+	WorkoutID     int32              `json:"workout_id"`
+	Weight        pgtype.Numeric     `json:"weight"`
+	Reps          int32              `json:"reps"`
+	SetType       string             `json:"set_type"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	ExerciseOrder int32              `json:"exercise_order"`
+	SetOrder      int32              `json:"set_order"`
+}
+
+func (q *Queries) ListSets(ctx context.Context, userID string) ([]ListSetsRow, error) {
+	rows, err := q.db.Query(ctx, listSets, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []UserFeatureAccess
+	var items []ListSetsRow
 	for rows.Next() {
-		var i UserFeatureAccess
+		var i ListSetsRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.UserID,
-			&i.FeatureKey,
-			&i.Source,
-			&i.SourceReference,
-			&i.GrantedBy,
-			&i.Note,
-			&i.StartsAt,
-			&i.ExpiresAt,
-			&i.RevokedAt,
+			&i.ExerciseID,
+			&i.WorkoutID,
+			&i.Weight,
+			&i.Reps,
+			&i.SetType,
 			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExerciseOrder,
+			&i.SetOrder,
 		); err != nil {
 			return nil, err
 		}
@@ -2636,35 +4677,35 @@ func (q *Queries) ListActiveFeatureAccess(ctx context.Context, userID string) ([
 	return items, nil
 }
 
-const listExerciseNameMatches = `-- name: ListExerciseNameMatches :many
-SELECT id, name
-FROM exercise
-WHERE user_id = $1
-  AND name ILIKE '%' || $2::text || '%'
-ORDER BY name
-LIMIT 8
+const listTopExercisesByFrequency = `-- name: ListTopExercisesByFrequency :many
+SELECT
+    e.name,
+    COUNT(DISTINCT s.workout_id)::integer AS workout_count
+FROM "set" s
+JOIN exercise e ON e.id = s.exercise_id AND e.user_id = s.user_id
+JOIN workout w ON w.id = s.workout_id AND w.user_id = s.user_id
+WHERE s.user_id = $1
+  AND w.date >= now() - interval '90 days'
+GROUP BY e.name
+ORDER BY workout_count DESC, e.name
+LIMIT 5
 `
 
-type ListExerciseNameMatchesParams struct {
-	UserID    string `json:"user_id"`
-	NameQuery string `json:"name_query"`
-}
-
-type ListExerciseNameMatchesRow struct {
-	ID   int32  `json:"id"`
-	Name string `json:"name"`
+type ListTopExercisesByFrequencyRow struct {
+	Name         string `json:"name"`
+	WorkoutCount int32  `json:"workout_count"`
 }
 
-func (q *Queries) ListExerciseNameMatches(ctx context.Context, arg ListExerciseNameMatchesParams) ([]ListExerciseNameMatchesRow, error) {
-	rows, err := q.db.Query(ctx, listExerciseNameMatches, arg.UserID, arg.NameQuery)
+func (q *Queries) ListTopExercisesByFrequency(ctx context.Context, userID string) ([]ListTopExercisesByFrequencyRow, error) {
+	rows, err := q.db.Query(ctx, listTopExercisesByFrequency, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListExerciseNameMatchesRow
+	var items []ListTopExercisesByFrequencyRow
 	for rows.Next() {
-		var i ListExerciseNameMatchesRow
-		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+		var i ListTopExercisesByFrequencyRow
+		if err := rows.Scan(&i.Name, &i.WorkoutCount); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -2675,25 +4716,33 @@ func (q *Queries) ListExerciseNameMatches(ctx context.Context, arg ListExerciseN
 	return items, nil
 }
 
-const listExercises = `-- name: ListExercises :many
-SELECT id, name FROM exercise WHERE user_id = $1 ORDER BY name
+const listUserAchievements = `-- name: ListUserAchievements :many
+SELECT achievement_key, workout_id, unlocked_at
+FROM user_achievement
+WHERE user_id = $1
+ORDER BY unlocked_at, id
 `
 
-type ListExercisesRow struct {
-	ID   int32  `json:"id"`
-	Name string `json:"name"`
+type ListUserAchievementsRow struct {
+	AchievementKey string             `json:"achievement_key"`
+	WorkoutID      pgtype.Int4        `json:"workout_id"`
+	UnlockedAt     pgtype.Timestamptz `json:"unlocked_at"`
 }
 
-func (q *Queries) ListExercises(ctx context.Context, userID string) ([]ListExercisesRow, error) {
-	rows, err := q.db.Query(ctx, listExercises, userID)
+func (q *Queries) ListUserAchievements(ctx context.Context, userID string) ([]ListUserAchievementsRow, error) {
+	rows, err := q.db.Query(ctx, listUserAchievements, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListExercisesRow
+	var items []ListUserAchievementsRow
 	for rows.Next() {
-		var i ListExercisesRow
-		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+		var i ListUserAchievementsRow
+		if err := rows.Scan(
+			&i.AchievementKey,
+			&i.WorkoutID,
+			&i.UnlockedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -2704,30 +4753,147 @@ func (q *Queries) ListExercises(ctx context.Context, userID string) ([]ListExerc
 	return items, nil
 }
 
-const listExercisesWithHistorical1RMSourceWorkout = `-- name: ListExercisesWithHistorical1RMSourceWorkout :many
-SELECT id
-FROM exercise
-WHERE user_id = $1 AND historical_1rm_source_workout_id = $2
+const listVolumeByPeriod = `-- name: ListVolumeByPeriod :many
+SELECT
+    date_trunc($1::TEXT, w.date AT TIME ZONE $2::TEXT)::DATE AS period_start,
+    s.set_type,
+    COUNT(s.id)::INTEGER AS sets,
+    COALESCE(SUM(s.reps), 0)::INTEGER AS reps,
+    COALESCE(SUM(COALESCE(s.weight, 0)::NUMERIC * s.reps::NUMERIC), 0)::FLOAT8 AS tonnage
+FROM workout w
+JOIN "set" s ON s.workout_id = w.id AND s.user_id = w.user_id
+WHERE w.user_id = $3
+  AND w.date >= $4::TIMESTAMPTZ
+  AND w.date < $5::TIMESTAMPTZ
+  AND (
+      $6::TEXT IS NULL
+      OR LOWER(BTRIM(w.workout_focus)) = LOWER(BTRIM($6::TEXT))
+  )
+  AND (
+      $7::TEXT[] IS NULL
+      OR (
+          SELECT COUNT(DISTINCT wt.name)
+          FROM workout_tag_link wtl
+          JOIN workout_tag wt ON wt.id = wtl.tag_id
+          WHERE wtl.workout_id = w.id
+            AND wt.name = ANY($7::TEXT[])
+      ) = CARDINALITY($7::TEXT[])
+  )
+  AND (
+      $8::TEXT[] IS NULL
+      OR NOT EXISTS (
+          SELECT 1
+          FROM workout_tag_link wtl
+          JOIN workout_tag wt ON wt.id = wtl.tag_id
+          WHERE wtl.workout_id = w.id
+            AND wt.name = ANY($8::TEXT[])
+      )
+  )
+GROUP BY period_start, s.set_type
+ORDER BY period_start, s.set_type
 `
 
-type ListExercisesWithHistorical1RMSourceWorkoutParams struct {
-	UserID                       string      `json:"user_id"`
-	Historical1rmSourceWorkoutID pgtype.Int4 `json:"historical_1rm_source_workout_id"`
+type ListVolumeByPeriodParams struct {
+	Period      string             `json:"period"`
+	Timezone    string             `json:"timezone"`
+	UserID      string             `json:"user_id"`
+	StartAt     pgtype.Timestamptz `json:"start_at"`
+	EndAt       pgtype.Timestamptz `json:"end_at"`
+	Focus       pgtype.Text        `json:"focus"`
+	Tags        []string           `json:"tags"`
+	ExcludeTags []string           `json:"exclude_tags"`
+}
+
+type ListVolumeByPeriodRow struct {
+	PeriodStart pgtype.Date `json:"period_start"`
+	SetType     string      `json:"set_type"`
+	Sets        int32       `json:"sets"`
+	Reps        int32       `json:"reps"`
+	Tonnage     float64     `json:"tonnage"`
+}
+
+// Tonnage, set and rep totals per local period and set type for volume
+// analytics. period is a date_trunc unit (day, week or month) applied in
+// timezone; start_at is inclusive and end_at exclusive. focus matches the
+// workout focus case-insensitively; tags and exclude_tags filter workouts the
+// same way as ListWorkouts.
+func (q *Queries) ListVolumeByPeriod(ctx context.Context, arg ListVolumeByPeriodParams) ([]ListVolumeByPeriodRow, error) {
+	rows, err := q.db.Query(ctx, listVolumeByPeriod,
+		arg.Period,
+		arg.Timezone,
+		arg.UserID,
+		arg.StartAt,
+		arg.EndAt,
+		arg.Focus,
+		arg.Tags,
+		arg.ExcludeTags,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListVolumeByPeriodRow
+	for rows.Next() {
+		var i ListVolumeByPeriodRow
+		if err := rows.Scan(
+			&i.PeriodStart,
+			&i.SetType,
+			&i.Sets,
+			&i.Reps,
+			&i.Tonnage,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, endpoint_id, user_id, event_id, event_type, payload, status, attempt_count, next_attempt_at, last_attempt_at, response_status, last_error, delivered_at, created_at
+FROM webhook_delivery
+WHERE endpoint_id = $1 AND user_id = $2
+ORDER BY id DESC
+LIMIT $3
+`
+
+type ListWebhookDeliveriesParams struct {
+	EndpointID int32  `json:"endpoint_id"`
+	UserID     string `json:"user_id"`
+	RowLimit   int32  `json:"row_limit"`
 }
 
-func (q *Queries) ListExercisesWithHistorical1RMSourceWorkout(ctx context.Context, arg ListExercisesWithHistorical1RMSourceWorkoutParams) ([]int32, error) {
-	rows, err := q.db.Query(ctx, listExercisesWithHistorical1RMSourceWorkout, arg.UserID, arg.Historical1rmSourceWorkoutID)
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries, arg.EndpointID, arg.UserID, arg.RowLimit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []int32
+	var items []WebhookDelivery
 	for rows.Next() {
-		var id int32
-		if err := rows.Scan(&id); err != nil {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.EndpointID,
+			&i.UserID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.AttemptCount,
+			&i.NextAttemptAt,
+			&i.LastAttemptAt,
+			&i.ResponseStatus,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, id)
+		items = append(items, i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -2735,45 +4901,31 @@ func (q *Queries) ListExercisesWithHistorical1RMSourceWorkout(ctx context.Contex
 	return items, nil
 }
 
-const listSets = `-- name: ListSets :many
-SELECT id, exercise_id, workout_id, weight, reps, set_type, created_at, updated_at, exercise_order, set_order FROM "set"
+const listWebhookEndpoints = `-- name: ListWebhookEndpoints :many
+SELECT id, user_id, url, secret, event_types, active, created_at, updated_at
+FROM webhook_endpoint
 WHERE user_id = $1
-ORDER BY exercise_order, set_order, id
+ORDER BY id
 `
 
-type ListSetsRow struct {
-	ID            int32              `json:"id"`
-	ExerciseID    int32              `json:"exercise_id"`
-	WorkoutID     int32              `json:"workout_id"`
-	Weight        pgtype.Numeric     `json:"weight"`
-	Reps          int32              `json:"reps"`
-	SetType       string             `json:"set_type"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
-	ExerciseOrder int32              `json:"exercise_order"`
-	SetOrder      int32              `json:"set_order"`
-}
-
-func (q *Queries) ListSets(ctx context.Context, userID string) ([]ListSetsRow, error) {
-	rows, err := q.db.Query(ctx, listSets, userID)
+func (q *Queries) ListWebhookEndpoints(ctx context.Context, userID string) ([]WebhookEndpoint, error) {
+	rows, err := q.db.Query(ctx, listWebhookEndpoints, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListSetsRow
+	var items []WebhookEndpoint
 	for rows.Next() {
-		var i ListSetsRow
+		var i WebhookEndpoint
 		if err := rows.Scan(
 			&i.ID,
-			&i.ExerciseID,
-			&i.WorkoutID,
-			&i.Weight,
-			&i.Reps,
-			&i.SetType,
+			&i.UserID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.Active,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.ExerciseOrder,
-			&i.SetOrder,
 		); err != nil {
 			return nil, err
 		}
@@ -2785,38 +4937,26 @@ func (q *Queries) ListSets(ctx context.Context, userID string) ([]ListSetsRow, e
 	return items, nil
 }
 
-const listTopExercisesByFrequency = `-- name: ListTopExercisesByFrequency :many
-SELECT
-    e.name,
-    COUNT(DISTINCT s.workout_id)::integer AS workout_count
-FROM "set" s
-JOIN exercise e ON e.id = s.exercise_id AND e.user_id = s.user_id
-JOIN workout w ON w.id = s.workout_id AND w.user_id = s.user_id
-WHERE s.user_id = $1
-  AND w.date >= now() - interval '90 days'
-GROUP BY e.name
-ORDER BY workout_count DESC, e.name
-LIMIT 5
+const listWorkoutDatesForConsistency = `-- name: ListWorkoutDatesForConsistency :many
+SELECT date
+FROM workout
+WHERE user_id = $1
+ORDER BY date, id
 `
 
-type ListTopExercisesByFrequencyRow struct {
-	Name         string `json:"name"`
-	WorkoutCount int32  `json:"workout_count"`
-}
-
-func (q *Queries) ListTopExercisesByFrequency(ctx context.Context, userID string) ([]ListTopExercisesByFrequencyRow, error) {
-	rows, err := q.db.Query(ctx, listTopExercisesByFrequency, userID)
+func (q *Queries) ListWorkoutDatesForConsistency(ctx context.Context, userID string) ([]pgtype.Timestamptz, error) {
+	rows, err := q.db.Query(ctx, listWorkoutDatesForConsistency, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListTopExercisesByFrequencyRow
+	var items []pgtype.Timestamptz
 	for rows.Next() {
-		var i ListTopExercisesByFrequencyRow
-		if err := rows.Scan(&i.Name, &i.WorkoutCount); err != nil {
+		var date pgtype.Timestamptz
+		if err := rows.Scan(&date); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, date)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -2899,10 +5039,188 @@ func (q *Queries) ListWorkoutFocusValues(ctx context.Context, userID string) ([]
 	return items, nil
 }
 
+const listWorkoutIDsForComparison = `-- name: ListWorkoutIDsForComparison :many
+SELECT w.id
+FROM workout w
+WHERE w.user_id = $1
+  AND ($2::timestamptz IS NULL OR w.date >= $2::timestamptz)
+  AND ($3::timestamptz IS NULL OR w.date <= $3::timestamptz)
+  AND (
+      NULLIF($4::text, '') IS NULL
+      OR w.workout_focus ILIKE '%' || $4::text || '%'
+  )
+ORDER BY w.date DESC, w.id DESC
+LIMIT $5
+`
+
+type ListWorkoutIDsForComparisonParams struct {
+	UserID       string             `json:"user_id"`
+	StartDate    pgtype.Timestamptz `json:"start_date"`
+	EndDate      pgtype.Timestamptz `json:"end_date"`
+	WorkoutFocus pgtype.Text        `json:"workout_focus"`
+	RowLimit     int32              `json:"row_limit"`
+}
+
+// Newest first, so the chat can pair a session with the one before it.
+func (q *Queries) ListWorkoutIDsForComparison(ctx context.Context, arg ListWorkoutIDsForComparisonParams) ([]int32, error) {
+	rows, err := q.db.Query(ctx, listWorkoutIDsForComparison,
+		arg.UserID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.WorkoutFocus,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkoutLoadsSince = `-- name: ListWorkoutLoadsSince :many
+SELECT
+    w.id,
+    w.date,
+    COALESCE(SUM(COALESCE(s.weight, 0) * s.reps) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS tonnage,
+    COUNT(s.id) FILTER (WHERE s.set_type = 'working')::int AS working_sets
+FROM workout w
+LEFT JOIN "set" s ON s.workout_id = w.id AND s.user_id = w.user_id
+WHERE w.user_id = $1
+  AND w.date >= $2
+GROUP BY w.id, w.date
+ORDER BY w.date, w.id
+`
+
+type ListWorkoutLoadsSinceParams struct {
+	UserID string             `json:"user_id"`
+	Date   pgtype.Timestamptz `json:"date"`
+}
+
+type ListWorkoutLoadsSinceRow struct {
+	ID          int32              `json:"id"`
+	Date        pgtype.Timestamptz `json:"date"`
+	Tonnage     float64            `json:"tonnage"`
+	WorkingSets int32              `json:"working_sets"`
+}
+
+// Per-session working-set tonnage and hard-set count for training load analytics.
+func (q *Queries) ListWorkoutLoadsSince(ctx context.Context, arg ListWorkoutLoadsSinceParams) ([]ListWorkoutLoadsSinceRow, error) {
+	rows, err := q.db.Query(ctx, listWorkoutLoadsSince, arg.UserID, arg.Date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWorkoutLoadsSinceRow
+	for rows.Next() {
+		var i ListWorkoutLoadsSinceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Date,
+			&i.Tonnage,
+			&i.WorkingSets,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkoutTags = `-- name: ListWorkoutTags :many
+SELECT
+    t.id,
+    t.name,
+    COUNT(l.workout_id)::INTEGER AS workout_count,
+    t.created_at,
+    t.updated_at
+FROM workout_tag t
+LEFT JOIN workout_tag_link l ON l.tag_id = t.id
+WHERE t.user_id = $1
+GROUP BY t.id
+ORDER BY t.name
+`
+
+type ListWorkoutTagsRow struct {
+	ID           int32              `json:"id"`
+	Name         string             `json:"name"`
+	WorkoutCount int32              `json:"workout_count"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+func (q *Queries) ListWorkoutTags(ctx context.Context, userID string) ([]ListWorkoutTagsRow, error) {
+	rows, err := q.db.Query(ctx, listWorkoutTags, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWorkoutTagsRow
+	for rows.Next() {
+		var i ListWorkoutTagsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.WorkoutCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkouts = `-- name: ListWorkouts :many
-SELECT id, date, notes, workout_focus, created_at, updated_at FROM workout WHERE user_id = $1 ORDER BY date DESC
+SELECT w.id, w.date, w.notes, w.workout_focus, w.created_at, w.updated_at
+FROM workout w
+WHERE w.user_id = $1
+  AND (
+      $2::TEXT[] IS NULL
+      OR (
+          SELECT COUNT(DISTINCT wt.name)
+          FROM workout_tag_link wtl
+          JOIN workout_tag wt ON wt.id = wtl.tag_id
+          WHERE wtl.workout_id = w.id
+            AND wt.name = ANY($2::TEXT[])
+      ) = CARDINALITY($2::TEXT[])
+  )
+  AND (
+      $3::TEXT[] IS NULL
+      OR NOT EXISTS (
+          SELECT 1
+          FROM workout_tag_link wtl
+          JOIN workout_tag wt ON wt.id = wtl.tag_id
+          WHERE wtl.workout_id = w.id
+            AND wt.name = ANY($3::TEXT[])
+      )
+  )
+ORDER BY w.date DESC
 `
 
+type ListWorkoutsParams struct {
+	UserID      string   `json:"user_id"`
+	Tags        []string `json:"tags"`
+	ExcludeTags []string `json:"exclude_tags"`
+}
+
 type ListWorkoutsRow struct {
 	ID           int32              `json:"id"`
 	Date         pgtype.Timestamptz `json:"date"`
@@ -2912,8 +5230,10 @@ type ListWorkoutsRow struct {
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
 
-func (q *Queries) ListWorkouts(ctx context.Context, userID string) ([]ListWorkoutsRow, error) {
-	rows, err := q.db.Query(ctx, listWorkouts, userID)
+// Tags keeps workouts carrying every listed tag; exclude_tags drops workouts
+// carrying any of them. NULL disables either filter.
+func (q *Queries) ListWorkouts(ctx context.Context, arg ListWorkoutsParams) ([]ListWorkoutsRow, error) {
+	rows, err := q.db.Query(ctx, listWorkouts, arg.UserID, arg.Tags, arg.ExcludeTags)
 	if err != nil {
 		return nil, err
 	}
@@ -2975,7 +5295,8 @@ SELECT
     s.set_order,
     s.weight,
     s.reps,
-    s.set_type
+    s.set_type,
+    s.notes AS set_notes
 FROM matching_workouts mw
 JOIN workout w ON w.id = mw.id
 LEFT JOIN "set" s ON s.workout_id = w.id
@@ -3014,6 +5335,7 @@ type ListWorkoutsWithSetsForChatRow struct {
 	Weight        pgtype.Numeric     `json:"weight"`
 	Reps          pgtype.Int4        `json:"reps"`
 	SetType       pgtype.Text        `json:"set_type"`
+	SetNotes      pgtype.Text        `json:"set_notes"`
 }
 
 func (q *Queries) ListWorkoutsWithSetsForChat(ctx context.Context, arg ListWorkoutsWithSetsForChatParams) ([]ListWorkoutsWithSetsForChatRow, error) {
@@ -3043,6 +5365,7 @@ func (q *Queries) ListWorkoutsWithSetsForChat(ctx context.Context, arg ListWorko
 			&i.Weight,
 			&i.Reps,
 			&i.SetType,
+			&i.SetNotes,
 		); err != nil {
 			return nil, err
 		}
@@ -3189,6 +5512,39 @@ func (q *Queries) MarkAIChatConversationLatestWorkoutDraftSaved(ctx context.Cont
 	return i, err
 }
 
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :execrows
+UPDATE notification
+SET read_at = CURRENT_TIMESTAMP
+WHERE user_id = $1 AND read_at IS NULL
+`
+
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, userID string) (int64, error) {
+	result, err := q.db.Exec(ctx, markAllNotificationsRead, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markNotificationRead = `-- name: MarkNotificationRead :execrows
+UPDATE notification
+SET read_at = COALESCE(read_at, CURRENT_TIMESTAMP)
+WHERE id = $1 AND user_id = $2
+`
+
+type MarkNotificationReadParams struct {
+	ID     int32  `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markNotificationRead, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const markStripeWebhookEventProcessed = `-- name: MarkStripeWebhookEventProcessed :exec
 INSERT INTO stripe_webhook_events (
     stripe_event_id,
@@ -3208,29 +5564,139 @@ func (q *Queries) MarkStripeWebhookEventProcessed(ctx context.Context, arg MarkS
 	return err
 }
 
-const ownsAIChatRunGeneration = `-- name: OwnsAIChatRunGeneration :one
-SELECT EXISTS (
-    SELECT 1
-    FROM ai_chat_run
-    WHERE id = $1
-      AND user_id = $2
-      AND status = 'streaming'
-      AND generation_status = 'generating'
-      AND generation_owner = $3
-)
+const ownsAIChatRunGeneration = `-- name: OwnsAIChatRunGeneration :one
+SELECT EXISTS (
+    SELECT 1
+    FROM ai_chat_run
+    WHERE id = $1
+      AND user_id = $2
+      AND status = 'streaming'
+      AND generation_status = 'generating'
+      AND generation_owner = $3
+)
+`
+
+type OwnsAIChatRunGenerationParams struct {
+	ID              int32       `json:"id"`
+	UserID          string      `json:"user_id"`
+	GenerationOwner pgtype.Text `json:"generation_owner"`
+}
+
+func (q *Queries) OwnsAIChatRunGeneration(ctx context.Context, arg OwnsAIChatRunGenerationParams) (bool, error) {
+	row := q.db.QueryRow(ctx, ownsAIChatRunGeneration, arg.ID, arg.UserID, arg.GenerationOwner)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const recordWebhookDeliveryAttempt = `-- name: RecordWebhookDeliveryAttempt :one
+UPDATE webhook_delivery
+SET status = $1,
+    attempt_count = attempt_count + 1,
+    last_attempt_at = CURRENT_TIMESTAMP,
+    next_attempt_at = $2,
+    response_status = $3,
+    last_error = $4,
+    delivered_at = CASE WHEN $1 = 'succeeded' THEN CURRENT_TIMESTAMP END
+WHERE id = $5 AND user_id = $6
+RETURNING id, endpoint_id, user_id, event_id, event_type, payload, status, attempt_count, next_attempt_at, last_attempt_at, response_status, last_error, delivered_at, created_at
+`
+
+type RecordWebhookDeliveryAttemptParams struct {
+	Status         string             `json:"status"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	ResponseStatus pgtype.Int4        `json:"response_status"`
+	LastError      pgtype.Text        `json:"last_error"`
+	ID             int32              `json:"id"`
+	UserID         string             `json:"user_id"`
+}
+
+func (q *Queries) RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, recordWebhookDeliveryAttempt,
+		arg.Status,
+		arg.NextAttemptAt,
+		arg.ResponseStatus,
+		arg.LastError,
+		arg.ID,
+		arg.UserID,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.UserID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.AttemptCount,
+		&i.NextAttemptAt,
+		&i.LastAttemptAt,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const renameWorkoutTag = `-- name: RenameWorkoutTag :one
+UPDATE workout_tag
+SET name = $3,
+    updated_at = NOW()
+WHERE id = $1 AND user_id = $2
+RETURNING
+    id,
+    name,
+    (SELECT COUNT(*) FROM workout_tag_link l WHERE l.tag_id = workout_tag.id)::INTEGER AS workout_count,
+    created_at,
+    updated_at
+`
+
+type RenameWorkoutTagParams struct {
+	ID     int32  `json:"id"`
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+type RenameWorkoutTagRow struct {
+	ID           int32              `json:"id"`
+	Name         string             `json:"name"`
+	WorkoutCount int32              `json:"workout_count"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+func (q *Queries) RenameWorkoutTag(ctx context.Context, arg RenameWorkoutTagParams) (RenameWorkoutTagRow, error) {
+	row := q.db.QueryRow(ctx, renameWorkoutTag, arg.ID, arg.UserID, arg.Name)
+	var i RenameWorkoutTagRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.WorkoutCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const revokePersonalAccessToken = `-- name: RevokePersonalAccessToken :execrows
+UPDATE personal_access_token
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
 `
 
-type OwnsAIChatRunGenerationParams struct {
-	ID              int32       `json:"id"`
-	UserID          string      `json:"user_id"`
-	GenerationOwner pgtype.Text `json:"generation_owner"`
+type RevokePersonalAccessTokenParams struct {
+	ID     int32  `json:"id"`
+	UserID string `json:"user_id"`
 }
 
-func (q *Queries) OwnsAIChatRunGeneration(ctx context.Context, arg OwnsAIChatRunGenerationParams) (bool, error) {
-	row := q.db.QueryRow(ctx, ownsAIChatRunGeneration, arg.ID, arg.UserID, arg.GenerationOwner)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+func (q *Queries) RevokePersonalAccessToken(ctx context.Context, arg RevokePersonalAccessTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokePersonalAccessToken, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const revokeStripeFeatureAccess = `-- name: RevokeStripeFeatureAccess :exec
@@ -3254,6 +5720,148 @@ func (q *Queries) RevokeStripeFeatureAccess(ctx context.Context, arg RevokeStrip
 	return err
 }
 
+const searchUserContent = `-- name: SearchUserContent :many
+WITH search AS (
+    SELECT
+        websearch_to_tsquery('english', $1::text) AS q,
+        'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=2, MaxWords=24, MinWords=8, FragmentDelimiter=" ... "' AS options
+),
+hits AS (
+    SELECT 'workout' AS kind,
+           w.id,
+           NULL::integer AS conversation_id,
+           w.id AS workout_id,
+           COALESCE(NULLIF(btrim(w.workout_focus), ''), 'Workout') AS title,
+           ts_headline('english', concat_ws(' ', w.workout_focus, w.notes), s.q, s.options) AS snippet,
+           ts_rank(to_tsvector('english', coalesce(w.workout_focus, '') || ' ' || coalesce(w.notes, '')), s.q) AS rank,
+           w.date AS occurred_at
+    FROM workout w, search s
+    WHERE w.user_id = $2
+      AND to_tsvector('english', coalesce(w.workout_focus, '') || ' ' || coalesce(w.notes, '')) @@ s.q
+    UNION ALL
+    SELECT 'set',
+           st.id,
+           NULL::integer,
+           st.workout_id,
+           e.name,
+           ts_headline('english', st.notes, s.q, s.options),
+           ts_rank(to_tsvector('english', coalesce(st.notes, '')), s.q),
+           w.date
+    FROM "set" st
+    JOIN workout w ON w.id = st.workout_id AND w.user_id = st.user_id
+    JOIN exercise e ON e.id = st.exercise_id AND e.user_id = st.user_id, search s
+    WHERE st.user_id = $2
+      AND to_tsvector('english', coalesce(st.notes, '')) @@ s.q
+    UNION ALL
+    SELECT 'exercise',
+           e.id,
+           NULL::integer,
+           NULL::integer,
+           e.name,
+           ts_headline('english', e.name, s.q, s.options),
+           ts_rank(to_tsvector('english', e.name), s.q),
+           e.created_at
+    FROM exercise e, search s
+    WHERE e.user_id = $2
+      AND to_tsvector('english', e.name) @@ s.q
+    UNION ALL
+    SELECT 'conversation',
+           c.id,
+           c.id,
+           NULL::integer,
+           c.title,
+           ts_headline('english', c.title, s.q, s.options),
+           ts_rank(to_tsvector('english', coalesce(c.title, '')), s.q),
+           COALESCE(c.last_message_at, c.created_at)
+    FROM ai_chat_conversation c, search s
+    WHERE c.user_id = $2
+      AND to_tsvector('english', coalesce(c.title, '')) @@ s.q
+    UNION ALL
+    SELECT 'message',
+           m.id,
+           m.conversation_id,
+           NULL::integer,
+           COALESCE(c.title, 'Conversation'),
+           ts_headline('english', m.content, s.q, s.options),
+           ts_rank(to_tsvector('english', m.content), s.q),
+           m.created_at
+    FROM ai_chat_message m
+    JOIN ai_chat_conversation c ON c.id = m.conversation_id AND c.user_id = m.user_id, search s
+    WHERE m.user_id = $2
+      AND to_tsvector('english', m.content) @@ s.q
+)
+SELECT kind::text AS kind,
+       id::integer AS id,
+       conversation_id,
+       workout_id,
+       title::text AS title,
+       snippet::text AS snippet,
+       rank::float8 AS rank,
+       occurred_at::timestamptz AS occurred_at
+FROM hits
+WHERE $3::text[] IS NULL OR kind = ANY($3::text[])
+ORDER BY rank DESC, occurred_at DESC, kind ASC, id DESC
+LIMIT $4 OFFSET $5
+`
+
+type SearchUserContentParams struct {
+	Query     string   `json:"query"`
+	UserID    string   `json:"user_id"`
+	Kinds     []string `json:"kinds"`
+	RowLimit  int32    `json:"row_limit"`
+	RowOffset int32    `json:"row_offset"`
+}
+
+type SearchUserContentRow struct {
+	Kind           string             `json:"kind"`
+	ID             int32              `json:"id"`
+	ConversationID pgtype.Int4        `json:"conversation_id"`
+	WorkoutID      pgtype.Int4        `json:"workout_id"`
+	Title          string             `json:"title"`
+	Snippet        string             `json:"snippet"`
+	Rank           float64            `json:"rank"`
+	OccurredAt     pgtype.Timestamptz `json:"occurred_at"`
+}
+
+// Each branch repeats its table's search index expression. ts_headline wraps
+// matches in chr(2)/chr(3) so the service can escape the snippet before
+// turning the markers into highlight tags. Set hits carry their workout_id so
+// clients can open the workout.
+func (q *Queries) SearchUserContent(ctx context.Context, arg SearchUserContentParams) ([]SearchUserContentRow, error) {
+	rows, err := q.db.Query(ctx, searchUserContent,
+		arg.Query,
+		arg.UserID,
+		arg.Kinds,
+		arg.RowLimit,
+		arg.RowOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchUserContentRow
+	for rows.Next() {
+		var i SearchUserContentRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.ID,
+			&i.ConversationID,
+			&i.WorkoutID,
+			&i.Title,
+			&i.Snippet,
+			&i.Rank,
+			&i.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setAIChatConversationLatestWorkoutDraft = `-- name: SetAIChatConversationLatestWorkoutDraft :exec
 UPDATE ai_chat_conversation
 SET latest_workout_draft = NULLIF($3::text, '')::jsonb,
@@ -3333,6 +5941,24 @@ func (q *Queries) SetExerciseHistorical1RM(ctx context.Context, arg SetExerciseH
 	return err
 }
 
+const setUserTimezoneIfUnset = `-- name: SetUserTimezoneIfUnset :exec
+UPDATE users
+SET timezone = $2
+WHERE user_id = $1
+  AND timezone IS NULL
+`
+
+type SetUserTimezoneIfUnsetParams struct {
+	UserID   string      `json:"user_id"`
+	Timezone pgtype.Text `json:"timezone"`
+}
+
+// Records a client-inferred timezone without overriding one the user chose.
+func (q *Queries) SetUserTimezoneIfUnset(ctx context.Context, arg SetUserTimezoneIfUnsetParams) error {
+	_, err := q.db.Exec(ctx, setUserTimezoneIfUnset, arg.UserID, arg.Timezone)
+	return err
+}
+
 const touchAIChatConversation = `-- name: TouchAIChatConversation :exec
 UPDATE ai_chat_conversation
 SET updated_at = CURRENT_TIMESTAMP,
@@ -3369,6 +5995,20 @@ func (q *Queries) TouchAIChatRun(ctx context.Context, arg TouchAIChatRunParams)
 	return err
 }
 
+const touchPersonalAccessToken = `-- name: TouchPersonalAccessToken :exec
+UPDATE personal_access_token
+SET last_used_at = CURRENT_TIMESTAMP
+WHERE id = $1
+  AND (last_used_at IS NULL OR last_used_at < CURRENT_TIMESTAMP - INTERVAL '1 minute')
+`
+
+// Records token use at most once a minute so busy clients do not write on
+// every request.
+func (q *Queries) TouchPersonalAccessToken(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, touchPersonalAccessToken, id)
+	return err
+}
+
 const updateAIChatMessageCompleted = `-- name: UpdateAIChatMessageCompleted :one
 UPDATE ai_chat_message
 SET content = $3,
@@ -3863,16 +6503,131 @@ func (q *Queries) UpdateAIChatRunStopped(ctx context.Context, arg UpdateAIChatRu
 	return i, err
 }
 
-const updateExerciseHistorical1RMFromWorkoutIfBetter = `-- name: UpdateExerciseHistorical1RMFromWorkoutIfBetter :exec
-UPDATE exercise
+const updateBodyMetricEntry = `-- name: UpdateBodyMetricEntry :one
+UPDATE body_metric_entry
+SET
+    measured_on = $3,
+    bodyweight = $4,
+    body_fat_percent = $5,
+    neck = $6,
+    chest = $7,
+    waist = $8,
+    hips = $9,
+    arm = $10,
+    thigh = $11,
+    notes = $12,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND user_id = $2
+RETURNING
+    id,
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes,
+    created_at,
+    updated_at
+`
+
+type UpdateBodyMetricEntryParams struct {
+	ID             int32          `json:"id"`
+	UserID         string         `json:"user_id"`
+	MeasuredOn     pgtype.Date    `json:"measured_on"`
+	Bodyweight     pgtype.Numeric `json:"bodyweight"`
+	BodyFatPercent pgtype.Numeric `json:"body_fat_percent"`
+	Neck           pgtype.Numeric `json:"neck"`
+	Chest          pgtype.Numeric `json:"chest"`
+	Waist          pgtype.Numeric `json:"waist"`
+	Hips           pgtype.Numeric `json:"hips"`
+	Arm            pgtype.Numeric `json:"arm"`
+	Thigh          pgtype.Numeric `json:"thigh"`
+	Notes          pgtype.Text    `json:"notes"`
+}
+
+func (q *Queries) UpdateBodyMetricEntry(ctx context.Context, arg UpdateBodyMetricEntryParams) (BodyMetricEntry, error) {
+	row := q.db.QueryRow(ctx, updateBodyMetricEntry,
+		arg.ID,
+		arg.UserID,
+		arg.MeasuredOn,
+		arg.Bodyweight,
+		arg.BodyFatPercent,
+		arg.Neck,
+		arg.Chest,
+		arg.Waist,
+		arg.Hips,
+		arg.Arm,
+		arg.Thigh,
+		arg.Notes,
+	)
+	var i BodyMetricEntry
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.MeasuredOn,
+		&i.Bodyweight,
+		&i.BodyFatPercent,
+		&i.Neck,
+		&i.Chest,
+		&i.Waist,
+		&i.Hips,
+		&i.Arm,
+		&i.Thigh,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateCoachingLinkScopes = `-- name: UpdateCoachingLinkScopes :one
+UPDATE coaching_link
+SET scopes = $1
+WHERE id = $2 AND athlete_user_id = $3::text
+RETURNING id, coach_user_id, athlete_user_id, scopes, invite_code_hash, invite_expires_at, created_at, accepted_at
+`
+
+type UpdateCoachingLinkScopesParams struct {
+	Scopes        []string `json:"scopes"`
+	ID            int32    `json:"id"`
+	AthleteUserID string   `json:"athlete_user_id"`
+}
+
+// Only the athlete can change what an accepted coach may do.
+func (q *Queries) UpdateCoachingLinkScopes(ctx context.Context, arg UpdateCoachingLinkScopesParams) (CoachingLink, error) {
+	row := q.db.QueryRow(ctx, updateCoachingLinkScopes, arg.Scopes, arg.ID, arg.AthleteUserID)
+	var i CoachingLink
+	err := row.Scan(
+		&i.ID,
+		&i.CoachUserID,
+		&i.AthleteUserID,
+		&i.Scopes,
+		&i.InviteCodeHash,
+		&i.InviteExpiresAt,
+		&i.CreatedAt,
+		&i.AcceptedAt,
+	)
+	return i, err
+}
+
+const updateExerciseHistorical1RMFromWorkoutIfBetter = `-- name: UpdateExerciseHistorical1RMFromWorkoutIfBetter :one
+UPDATE exercise e
 SET
     historical_1rm = $2,
     historical_1rm_updated_at = NOW(),
     historical_1rm_source_workout_id = $3,
     updated_at = NOW()
-WHERE id = $1
-  AND user_id = $4
-  AND (historical_1rm IS NULL OR historical_1rm < $2)
+FROM exercise previous
+WHERE e.id = $1
+  AND e.user_id = $4
+  AND previous.id = e.id
+  AND (e.historical_1rm IS NULL OR e.historical_1rm < $2)
+RETURNING e.name, previous.historical_1rm AS previous_historical_1rm
 `
 
 type UpdateExerciseHistorical1RMFromWorkoutIfBetterParams struct {
@@ -3882,14 +6637,23 @@ type UpdateExerciseHistorical1RMFromWorkoutIfBetterParams struct {
 	UserID                       string         `json:"user_id"`
 }
 
-func (q *Queries) UpdateExerciseHistorical1RMFromWorkoutIfBetter(ctx context.Context, arg UpdateExerciseHistorical1RMFromWorkoutIfBetterParams) error {
-	_, err := q.db.Exec(ctx, updateExerciseHistorical1RMFromWorkoutIfBetter,
+type UpdateExerciseHistorical1RMFromWorkoutIfBetterRow struct {
+	Name                  string         `json:"name"`
+	PreviousHistorical1rm pgtype.Numeric `json:"previous_historical_1rm"`
+}
+
+// Returns the exercise when the value was raised; previous_historical_1rm is
+// read from the pre-update row so callers can tell a first value from a PR.
+func (q *Queries) UpdateExerciseHistorical1RMFromWorkoutIfBetter(ctx context.Context, arg UpdateExerciseHistorical1RMFromWorkoutIfBetterParams) (UpdateExerciseHistorical1RMFromWorkoutIfBetterRow, error) {
+	row := q.db.QueryRow(ctx, updateExerciseHistorical1RMFromWorkoutIfBetter,
 		arg.ID,
 		arg.Historical1rm,
 		arg.Historical1rmSourceWorkoutID,
 		arg.UserID,
 	)
-	return err
+	var i UpdateExerciseHistorical1RMFromWorkoutIfBetterRow
+	err := row.Scan(&i.Name, &i.PreviousHistorical1rm)
+	return i, err
 }
 
 const updateExerciseHistorical1RMManual = `-- name: UpdateExerciseHistorical1RMManual :exec
@@ -3930,6 +6694,43 @@ func (q *Queries) UpdateExerciseName(ctx context.Context, arg UpdateExerciseName
 	return err
 }
 
+const updateGoal = `-- name: UpdateGoal :one
+UPDATE goal
+SET target_value = $1, target_date = $2
+WHERE id = $3 AND user_id = $4
+RETURNING id, user_id, goal_type, exercise_id, target_value, start_value, target_date, created_at
+`
+
+type UpdateGoalParams struct {
+	TargetValue pgtype.Numeric `json:"target_value"`
+	TargetDate  pgtype.Date    `json:"target_date"`
+	ID          int32          `json:"id"`
+	UserID      string         `json:"user_id"`
+}
+
+// Changes a goal's target. The type, exercise, start value and created_at
+// are kept so progress is still measured from when the goal was set.
+func (q *Queries) UpdateGoal(ctx context.Context, arg UpdateGoalParams) (Goal, error) {
+	row := q.db.QueryRow(ctx, updateGoal,
+		arg.TargetValue,
+		arg.TargetDate,
+		arg.ID,
+		arg.UserID,
+	)
+	var i Goal
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.GoalType,
+		&i.ExerciseID,
+		&i.TargetValue,
+		&i.StartValue,
+		&i.TargetDate,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const updateSet = `-- name: UpdateSet :one
 UPDATE "set"
 SET
@@ -3962,6 +6763,70 @@ func (q *Queries) UpdateSet(ctx context.Context, arg UpdateSetParams) (int32, er
 	return id, err
 }
 
+const updateUserTimezone = `-- name: UpdateUserTimezone :one
+UPDATE users
+SET timezone = $2
+WHERE user_id = $1
+RETURNING id, user_id, created_at, timezone
+`
+
+type UpdateUserTimezoneParams struct {
+	UserID   string      `json:"user_id"`
+	Timezone pgtype.Text `json:"timezone"`
+}
+
+func (q *Queries) UpdateUserTimezone(ctx context.Context, arg UpdateUserTimezoneParams) (Users, error) {
+	row := q.db.QueryRow(ctx, updateUserTimezone, arg.UserID, arg.Timezone)
+	var i Users
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.Timezone,
+	)
+	return i, err
+}
+
+const updateWebhookEndpoint = `-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoint
+SET url = $1,
+    event_types = $2,
+    active = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $4 AND user_id = $5
+RETURNING id, user_id, url, secret, event_types, active, created_at, updated_at
+`
+
+type UpdateWebhookEndpointParams struct {
+	Url        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	Active     bool     `json:"active"`
+	ID         int32    `json:"id"`
+	UserID     string   `json:"user_id"`
+}
+
+func (q *Queries) UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRow(ctx, updateWebhookEndpoint,
+		arg.Url,
+		arg.EventTypes,
+		arg.Active,
+		arg.ID,
+		arg.UserID,
+	)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateWorkout = `-- name: UpdateWorkout :one
 UPDATE workout
 SET
@@ -3995,6 +6860,32 @@ func (q *Queries) UpdateWorkout(ctx context.Context, arg UpdateWorkoutParams) (i
 	return id, err
 }
 
+const upsertCalendarFeed = `-- name: UpsertCalendarFeed :one
+INSERT INTO calendar_feed (user_id, token_hash)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET token_hash = EXCLUDED.token_hash,
+    rotated_at = CURRENT_TIMESTAMP
+RETURNING user_id, token_hash, created_at, rotated_at
+`
+
+type UpsertCalendarFeedParams struct {
+	UserID    string `json:"user_id"`
+	TokenHash string `json:"token_hash"`
+}
+
+func (q *Queries) UpsertCalendarFeed(ctx context.Context, arg UpsertCalendarFeedParams) (CalendarFeed, error) {
+	row := q.db.QueryRow(ctx, upsertCalendarFeed, arg.UserID, arg.TokenHash)
+	var i CalendarFeed
+	err := row.Scan(
+		&i.UserID,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.RotatedAt,
+	)
+	return i, err
+}
+
 const upsertStripeCustomer = `-- name: UpsertStripeCustomer :one
 INSERT INTO stripe_customers (
     user_id,
@@ -4141,6 +7032,116 @@ func (q *Queries) UpsertStripeSubscription(ctx context.Context, arg UpsertStripe
 	return i, err
 }
 
+const upsertUserEquipment = `-- name: UpsertUserEquipment :one
+INSERT INTO user_equipment (
+    user_id,
+    barbell_weights,
+    plates,
+    dumbbell_increment,
+    machine_stack_step
+)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    barbell_weights = EXCLUDED.barbell_weights,
+    plates = EXCLUDED.plates,
+    dumbbell_increment = EXCLUDED.dumbbell_increment,
+    machine_stack_step = EXCLUDED.machine_stack_step,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING
+    user_id,
+    barbell_weights,
+    plates,
+    dumbbell_increment,
+    machine_stack_step,
+    created_at,
+    updated_at
+`
+
+type UpsertUserEquipmentParams struct {
+	UserID            string         `json:"user_id"`
+	BarbellWeights    []byte         `json:"barbell_weights"`
+	Plates            []byte         `json:"plates"`
+	DumbbellIncrement pgtype.Numeric `json:"dumbbell_increment"`
+	MachineStackStep  pgtype.Numeric `json:"machine_stack_step"`
+}
+
+func (q *Queries) UpsertUserEquipment(ctx context.Context, arg UpsertUserEquipmentParams) (UserEquipment, error) {
+	row := q.db.QueryRow(ctx, upsertUserEquipment,
+		arg.UserID,
+		arg.BarbellWeights,
+		arg.Plates,
+		arg.DumbbellIncrement,
+		arg.MachineStackStep,
+	)
+	var i UserEquipment
+	err := row.Scan(
+		&i.UserID,
+		&i.BarbellWeights,
+		&i.Plates,
+		&i.DumbbellIncrement,
+		&i.MachineStackStep,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertUserStrengthProfile = `-- name: UpsertUserStrengthProfile :one
+INSERT INTO user_strength_profile (
+    user_id,
+    sex,
+    squat_exercise_id,
+    bench_exercise_id,
+    deadlift_exercise_id
+)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    sex = EXCLUDED.sex,
+    squat_exercise_id = EXCLUDED.squat_exercise_id,
+    bench_exercise_id = EXCLUDED.bench_exercise_id,
+    deadlift_exercise_id = EXCLUDED.deadlift_exercise_id,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING
+    user_id,
+    sex,
+    squat_exercise_id,
+    bench_exercise_id,
+    deadlift_exercise_id,
+    created_at,
+    updated_at
+`
+
+type UpsertUserStrengthProfileParams struct {
+	UserID             string      `json:"user_id"`
+	Sex                pgtype.Text `json:"sex"`
+	SquatExerciseID    pgtype.Int4 `json:"squat_exercise_id"`
+	BenchExerciseID    pgtype.Int4 `json:"bench_exercise_id"`
+	DeadliftExerciseID pgtype.Int4 `json:"deadlift_exercise_id"`
+}
+
+func (q *Queries) UpsertUserStrengthProfile(ctx context.Context, arg UpsertUserStrengthProfileParams) (UserStrengthProfile, error) {
+	row := q.db.QueryRow(ctx, upsertUserStrengthProfile,
+		arg.UserID,
+		arg.Sex,
+		arg.SquatExerciseID,
+		arg.BenchExerciseID,
+		arg.DeadliftExerciseID,
+	)
+	var i UserStrengthProfile
+	err := row.Scan(
+		&i.UserID,
+		&i.Sex,
+		&i.SquatExerciseID,
+		&i.BenchExerciseID,
+		&i.DeadliftExerciseID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const upsertUserTrainingProfileForChat = `-- name: UpsertUserTrainingProfileForChat :one
 INSERT INTO user_training_profile (
     user_id,
@@ -4345,3 +7346,62 @@ func (q *Queries) UpsertUserTrainingProfileForSettings(ctx context.Context, arg
 	)
 	return i, err
 }
+
+const upsertWorkoutShare = `-- name: UpsertWorkoutShare :one
+INSERT INTO workout_share (workout_id, user_id, token_hash, expires_at)
+SELECT w.id, w.user_id, $1::text, $2::timestamptz
+FROM workout w
+WHERE w.id = $3 AND w.user_id = $4
+ON CONFLICT (workout_id) DO UPDATE
+SET token_hash = EXCLUDED.token_hash,
+    expires_at = EXCLUDED.expires_at,
+    created_at = CURRENT_TIMESTAMP
+RETURNING workout_id, user_id, token_hash, expires_at, created_at
+`
+
+type UpsertWorkoutShareParams struct {
+	TokenHash string             `json:"token_hash"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	WorkoutID int32              `json:"workout_id"`
+	UserID    string             `json:"user_id"`
+}
+
+// Issues a share link for a workout the user owns. Any previous link for the
+// workout is replaced, so re-sharing revokes the old URL.
+func (q *Queries) UpsertWorkoutShare(ctx context.Context, arg UpsertWorkoutShareParams) (WorkoutShare, error) {
+	row := q.db.QueryRow(ctx, upsertWorkoutShare,
+		arg.TokenHash,
+		arg.ExpiresAt,
+		arg.WorkoutID,
+		arg.UserID,
+	)
+	var i WorkoutShare
+	err := row.Scan(
+		&i.WorkoutID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertWorkoutTag = `-- name: UpsertWorkoutTag :one
+INSERT INTO workout_tag (user_id, name)
+VALUES ($1, $2)
+ON CONFLICT (user_id, name) DO UPDATE SET name = EXCLUDED.name
+RETURNING id
+`
+
+type UpsertWorkoutTagParams struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+// The no-op update lets RETURNING yield the id of an existing tag.
+func (q *Queries) UpsertWorkoutTag(ctx context.Context, arg UpsertWorkoutTagParams) (int32, error) {
+	row := q.db.QueryRow(ctx, upsertWorkoutTag, arg.UserID, arg.Name)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
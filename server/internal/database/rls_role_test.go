@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+// rlsTestRole is assumed by policy tests when they connect as a superuser,
+// which would otherwise bypass RLS and hide policy bugs.
+const rlsTestRole = "fittrack_rls_test"
+
+// withRLSEnforced runs fn in a transaction that is rolled back afterwards,
+// as a role that RLS applies to. Connections that own the tables bypass RLS
+// without being superusers, so those skip the test instead.
+func withRLSEnforced(t *testing.T, pool *pgxpool.Pool, table string, fn func(ctx context.Context, tx pgx.Tx)) {
+	t.Helper()
+
+	ctx := context.Background()
+	superuser := isCurrentDatabaseUserSuperuser(t, pool)
+	if superuser {
+		_, err := pool.Exec(ctx, `
+			DO $$
+			BEGIN
+				IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = 'fittrack_rls_test') THEN
+					CREATE ROLE fittrack_rls_test NOLOGIN NOBYPASSRLS;
+				END IF;
+			END
+			$$`)
+		require.NoError(t, err)
+	} else {
+		var owner bool
+		err := pool.QueryRow(ctx, "SELECT pg_get_userbyid(relowner) = current_user FROM pg_class WHERE relname = $1", table).Scan(&owner)
+		require.NoError(t, err)
+		if owner {
+			t.Skipf("Skipping RLS assertions - connected as the owner of %s, which bypasses RLS", table)
+		}
+	}
+
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx)
+
+	if superuser {
+		_, err = tx.Exec(ctx, "SET LOCAL ROLE "+rlsTestRole)
+		require.NoError(t, err)
+	}
+	fn(ctx, tx)
+}
+
+func setRLSUser(t *testing.T, ctx context.Context, tx pgx.Tx, userID string) {
+	t.Helper()
+
+	_, err := tx.Exec(ctx, "SELECT set_config('app.current_user_id', $1, true)", userID)
+	require.NoError(t, err)
+}
+
+func setRLSTokenHash(t *testing.T, ctx context.Context, tx pgx.Tx, tokenHash string) {
+	t.Helper()
+
+	_, err := tx.Exec(ctx, "SELECT set_config('app.current_token_hash', $1, true)", tokenHash)
+	require.NoError(t, err)
+}
@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenHashLookupPolicies(t *testing.T) {
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, getTestDatabaseURL())
+	require.NoError(t, err)
+	defer pool.Close()
+	require.NoError(t, pool.Ping(ctx))
+
+	owner := "token-lookup-test-owner"
+	shareHash := strings.Repeat("a", 64)
+	feedHash := strings.Repeat("b", 64)
+	otherHash := strings.Repeat("c", 64)
+
+	withRLSEnforced(t, pool, "workout_share", func(ctx context.Context, tx pgx.Tx) {
+		setRLSUser(t, ctx, tx, owner)
+		_, err := tx.Exec(ctx, "INSERT INTO users (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING", owner)
+		require.NoError(t, err)
+		var workoutID int32
+		err = tx.QueryRow(ctx, "INSERT INTO workout (date, user_id) VALUES (NOW(), $1) RETURNING id", owner).Scan(&workoutID)
+		require.NoError(t, err)
+		_, err = tx.Exec(ctx, "INSERT INTO workout_share (workout_id, user_id, token_hash) VALUES ($1, $2, $3)", workoutID, owner, shareHash)
+		require.NoError(t, err)
+		_, err = tx.Exec(ctx, "INSERT INTO calendar_feed (user_id, token_hash) VALUES ($1, $2)", owner, feedHash)
+		require.NoError(t, err)
+
+		// Public requests have no RLS user.
+		setRLSUser(t, ctx, tx, "")
+
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM workout_share"), "no token hash set")
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM calendar_feed"), "no token hash set")
+
+		setRLSTokenHash(t, ctx, tx, shareHash)
+		require.Equal(t, 1, countRows(t, ctx, tx, "SELECT COUNT(*) FROM workout_share WHERE token_hash = $1", shareHash))
+		require.Equal(t, 1, countRows(t, ctx, tx, "SELECT COUNT(*) FROM workout_share"), "only the matching share is visible")
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM calendar_feed"))
+
+		setRLSTokenHash(t, ctx, tx, feedHash)
+		require.Equal(t, 1, countRows(t, ctx, tx, "SELECT COUNT(*) FROM calendar_feed WHERE token_hash = $1", feedHash))
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM workout_share"))
+
+		setRLSTokenHash(t, ctx, tx, otherHash)
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM workout_share"))
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM calendar_feed"))
+	})
+}
+
+func TestAccessTokenHashLookupPolicy(t *testing.T) {
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, getTestDatabaseURL())
+	require.NoError(t, err)
+	defer pool.Close()
+	require.NoError(t, pool.Ping(ctx))
+
+	owner := "token-lookup-test-owner"
+	tokenHash := strings.Repeat("d", 64)
+	otherHash := strings.Repeat("e", 64)
+
+	withRLSEnforced(t, pool, "personal_access_token", func(ctx context.Context, tx pgx.Tx) {
+		setRLSUser(t, ctx, tx, owner)
+		_, err := tx.Exec(ctx, "INSERT INTO users (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING", owner)
+		require.NoError(t, err)
+		_, err = tx.Exec(ctx, `
+			INSERT INTO personal_access_token (user_id, name, token_hash, token_prefix, scopes, expires_at)
+			VALUES ($1, 'Sync', $2, 'ftpat_abc', ARRAY['workouts:read'], NOW() + INTERVAL '1 day')
+		`, owner, tokenHash)
+		require.NoError(t, err)
+
+		// Bearer tokens are resolved before there is an RLS user.
+		setRLSUser(t, ctx, tx, "")
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM personal_access_token"), "no token hash set")
+
+		setRLSTokenHash(t, ctx, tx, tokenHash)
+		queries := New(tx)
+		token, err := queries.GetActivePersonalAccessTokenByHash(ctx, tokenHash)
+		require.NoError(t, err)
+		require.Equal(t, owner, token.UserID)
+		require.Equal(t, 1, countRows(t, ctx, tx, "SELECT COUNT(*) FROM personal_access_token"), "only the matching token is visible")
+
+		setRLSTokenHash(t, ctx, tx, otherHash)
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM personal_access_token"))
+	})
+}
+
+func countRows(t *testing.T, ctx context.Context, tx pgx.Tx, query string, args ...any) int {
+	t.Helper()
+
+	var count int
+	require.NoError(t, tx.QueryRow(ctx, query, args...).Scan(&count))
+	return count
+}
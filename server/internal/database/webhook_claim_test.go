@@ -0,0 +1,83 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimDueWebhookDeliveriesRLS(t *testing.T) {
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, getTestDatabaseURL())
+	require.NoError(t, err)
+	defer pool.Close()
+	require.NoError(t, pool.Ping(ctx))
+
+	owner := "webhook-claim-test-owner"
+
+	withRLSEnforced(t, pool, "webhook_delivery", func(ctx context.Context, tx pgx.Tx) {
+		setRLSUser(t, ctx, tx, owner)
+		_, err := tx.Exec(ctx, "INSERT INTO users (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING", owner)
+		require.NoError(t, err)
+		var endpointID int32
+		err = tx.QueryRow(ctx, `
+			INSERT INTO webhook_endpoint (user_id, url, secret, event_types)
+			VALUES ($1, 'https://example.com/hooks', 'whsec_test', ARRAY['workout.created'])
+			RETURNING id
+		`, owner).Scan(&endpointID)
+		require.NoError(t, err)
+		_, err = tx.Exec(ctx, `
+			INSERT INTO webhook_delivery (endpoint_id, user_id, event_id, event_type, payload, attempt_count)
+			VALUES ($1, $2, 'evt_claim_first', 'workout.created', '{}'::jsonb, 0),
+			       ($1, $2, 'evt_claim_retry', 'workout.created', '{}'::jsonb, 2)
+		`, endpointID, owner)
+		require.NoError(t, err)
+
+		// Disable the endpoint without failing its queue, as happens when a
+		// delivery is enqueued concurrently with the update.
+		_, err = tx.Exec(ctx, "UPDATE webhook_endpoint SET active = FALSE WHERE id = $1", endpointID)
+		require.NoError(t, err)
+
+		// The dispatcher claims with no RLS user, so the deliveries are only
+		// reachable through the claim function.
+		setRLSUser(t, ctx, tx, "")
+		require.Equal(t, 0, countRows(t, ctx, tx, "SELECT COUNT(*) FROM webhook_delivery WHERE endpoint_id = $1", endpointID))
+
+		queries := New(tx)
+		claimed, err := queries.ClaimDueWebhookDeliveries(ctx, 100)
+		require.NoError(t, err)
+		for _, row := range claimed {
+			require.NotEqual(t, endpointID, row.EndpointID, "claimed a delivery for an inactive endpoint")
+		}
+
+		setRLSUser(t, ctx, tx, owner)
+		require.Equal(t, 2, countRows(t, ctx, tx, `
+			SELECT COUNT(*) FROM webhook_delivery
+			WHERE endpoint_id = $1 AND status = 'pending' AND next_attempt_at <= CURRENT_TIMESTAMP
+		`, endpointID), "inactive deliveries must not be leased")
+		_, err = tx.Exec(ctx, "UPDATE webhook_endpoint SET active = TRUE WHERE id = $1", endpointID)
+		require.NoError(t, err)
+
+		setRLSUser(t, ctx, tx, "")
+		claimed, err = queries.ClaimDueWebhookDeliveries(ctx, 100)
+		require.NoError(t, err)
+		reclaimed := 0
+		for _, row := range claimed {
+			if row.EndpointID == endpointID {
+				require.Equal(t, owner, row.UserID)
+				require.Equal(t, "whsec_test", row.Secret)
+				reclaimed++
+			}
+		}
+		require.Equal(t, 2, reclaimed)
+
+		setRLSUser(t, ctx, tx, owner)
+		require.Equal(t, 0, countRows(t, ctx, tx, `
+			SELECT COUNT(*) FROM webhook_delivery
+			WHERE endpoint_id = $1 AND next_attempt_at <= CURRENT_TIMESTAMP
+		`, endpointID), "claimed deliveries are leased")
+	})
+}
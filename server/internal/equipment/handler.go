@@ -0,0 +1,134 @@
+package equipment
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type equipmentService interface {
+	GetInventory(ctx context.Context) (*InventoryResponse, error)
+	UpdateInventory(ctx context.Context, req UpdateInventoryRequest) (*InventoryResponse, error)
+	GetPlateBreakdown(ctx context.Context, weight float64, bar *float64) (*PlateBreakdown, error)
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service equipmentService
+}
+
+func NewHandler(logger *slog.Logger, service equipmentService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// GetInventory godoc
+// @Summary Get equipment inventory
+// @Description Returns the bars, plate pairs, dumbbell increment and machine stack step used to round suggested weights. Users who have not saved equipment get a default commercial-gym inventory with is_default set.
+// @Tags equipment
+// @Produce json
+// @Security StackAuth
+// @Success 200 {object} equipment.InventoryResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /equipment [get]
+func (h *Handler) GetInventory(w http.ResponseWriter, r *http.Request) {
+	inv, err := h.service.GetInventory(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get equipment")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, inv); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// UpdateInventory godoc
+// @Summary Update equipment inventory
+// @Description Replaces the equipment inventory. Weights must be positive multiples of 0.25; the first bar is the primary bar.
+// @Tags equipment
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param request body equipment.UpdateInventoryRequest true "Equipment inventory"
+// @Success 200 {object} equipment.InventoryResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /equipment [put]
+func (h *Handler) UpdateInventory(w http.ResponseWriter, r *http.Request) {
+	var req UpdateInventoryRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	inv, err := h.service.UpdateInventory(r.Context(), req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to update equipment")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, inv); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// GetPlateBreakdown godoc
+// @Summary Get plate breakdown
+// @Description Returns the plates to load on each side of the bar for the closest weight to the target that the user's equipment allows.
+// @Tags tools
+// @Produce json
+// @Security StackAuth
+// @Param weight query number true "Target total weight including the bar"
+// @Param bar query number false "Bar weight; defaults to the primary bar and must be one of the user's bars"
+// @Success 200 {object} equipment.PlateBreakdown
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /tools/plates [get]
+func (h *Handler) GetPlateBreakdown(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	weight, err := floatQueryParam(query.Get("weight"))
+	if err != nil || weight == nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "weight must be a number", err)
+		return
+	}
+	bar, err := floatQueryParam(query.Get("bar"))
+	if err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "bar must be a number", err)
+		return
+	}
+
+	breakdown, err := h.service.GetPlateBreakdown(r.Context(), *weight, bar)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get plate breakdown")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, breakdown); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
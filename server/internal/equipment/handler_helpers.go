@@ -0,0 +1,32 @@
+package equipment
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/request"
+)
+
+const maxEquipmentJSONBodyBytes = 4 << 10
+
+func decodeStrictJSON(w http.ResponseWriter, r *http.Request, dst any) error {
+	return request.DecodeStrictJSON(w, r, dst, maxEquipmentJSONBodyBytes)
+}
+
+// floatQueryParam parses an optional finite number; a blank value returns nil.
+func floatQueryParam(raw string) (*float64, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, err
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, strconv.ErrRange
+	}
+	return &value, nil
+}
@@ -0,0 +1,136 @@
+package equipment
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRepository struct {
+	inv   *InventoryResponse
+	saved Inventory
+}
+
+func (r *stubRepository) GetInventory(context.Context, string) (*InventoryResponse, error) {
+	if r.inv == nil {
+		return &InventoryResponse{Inventory: DefaultInventory(), IsDefault: true}, nil
+	}
+	return r.inv, nil
+}
+
+func (r *stubRepository) UpsertInventory(_ context.Context, _ string, inv Inventory) (*InventoryResponse, error) {
+	r.saved = inv
+	return &InventoryResponse{Inventory: inv}, nil
+}
+
+func newTestHandler(repo Repository) *Handler {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewHandler(logger, NewService(logger, repo))
+}
+
+func TestHandlerGetPlateBreakdown(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	t.Run("loads the primary bar", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		newTestHandler(&stubRepository{}).GetPlateBreakdown(rr, httptest.NewRequest(http.MethodGet, "/api/tools/plates?weight=227", nil).WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"requested_weight":227,"weight":225,"bar_weight":45,"per_side":[{"weight":45,"count":2}],"exact":false}`, rr.Body.String())
+	})
+
+	t.Run("uses a requested bar", func(t *testing.T) {
+		repo := &stubRepository{inv: &InventoryResponse{Inventory: Inventory{
+			BarbellWeights: []float64{45, 35},
+			Plates:         []Plate{{Weight: 25, Pairs: 1}},
+		}}}
+		rr := httptest.NewRecorder()
+
+		newTestHandler(repo).GetPlateBreakdown(rr, httptest.NewRequest(http.MethodGet, "/api/tools/plates?weight=85&bar=35", nil).WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"exact":true`)
+	})
+
+	t.Run("rejects a bar the user does not own", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		newTestHandler(&stubRepository{}).GetPlateBreakdown(rr, httptest.NewRequest(http.MethodGet, "/api/tools/plates?weight=135&bar=33", nil).WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "barbell weights")
+	})
+
+	for _, query := range []string{"", "weight=heavy", "weight=NaN", "weight=0", "weight=2001"} {
+		t.Run("rejects "+query, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+
+			newTestHandler(&stubRepository{}).GetPlateBreakdown(rr, httptest.NewRequest(http.MethodGet, "/api/tools/plates?"+query, nil).WithContext(ctx))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestHandlerUpdateInventory(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	t.Run("saves plates heaviest first", func(t *testing.T) {
+		repo := &stubRepository{}
+		rr := httptest.NewRecorder()
+		body := `{"barbell_weights":[45],"plates":[{"weight":10,"pairs":2},{"weight":45,"pairs":3}],"dumbbell_increment":2.5,"machine_stack_step":7.5}`
+
+		newTestHandler(repo).UpdateInventory(rr, httptest.NewRequest(http.MethodPut, "/api/equipment", strings.NewReader(body)).WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, []Plate{{Weight: 45, Pairs: 3}, {Weight: 10, Pairs: 2}}, repo.saved.Plates)
+		assert.Equal(t, 7.5, repo.saved.MachineStackStep)
+	})
+
+	tests := map[string]string{
+		"missing bars":     `{"barbell_weights":[],"plates":[{"weight":45,"pairs":1}],"dumbbell_increment":5,"machine_stack_step":10}`,
+		"fractional plate": `{"barbell_weights":[45],"plates":[{"weight":2.3,"pairs":1}],"dumbbell_increment":5,"machine_stack_step":10}`,
+		"duplicate plate":  `{"barbell_weights":[45],"plates":[{"weight":45,"pairs":1},{"weight":45,"pairs":2}],"dumbbell_increment":5,"machine_stack_step":10}`,
+		"too many pairs":   `{"barbell_weights":[45],"plates":[{"weight":45,"pairs":21}],"dumbbell_increment":5,"machine_stack_step":10}`,
+		"missing step":     `{"barbell_weights":[45],"plates":[{"weight":45,"pairs":1}],"dumbbell_increment":5}`,
+		"unknown field":    `{"barbell_weights":[45],"plates":[{"weight":45,"pairs":1}],"dumbbell_increment":5,"machine_stack_step":10,"kettlebells":[16]}`,
+	}
+	for name, body := range tests {
+		t.Run("rejects "+name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+
+			newTestHandler(&stubRepository{}).UpdateInventory(rr, httptest.NewRequest(http.MethodPut, "/api/equipment", strings.NewReader(body)).WithContext(ctx))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+
+	t.Run("delegated access is read only", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		delegated := user.WithDelegation(ctx, user.Delegation{ActorID: "coach-1", Scopes: []string{user.ScopeReadWorkouts}})
+		body := `{"barbell_weights":[45],"plates":[{"weight":45,"pairs":1}],"dumbbell_increment":5,"machine_stack_step":10}`
+
+		newTestHandler(&stubRepository{}).UpdateInventory(rr, httptest.NewRequest(http.MethodPut, "/api/equipment", strings.NewReader(body)).WithContext(delegated))
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestHandlerGetInventoryDefaults(t *testing.T) {
+	rr := httptest.NewRecorder()
+
+	newTestHandler(&stubRepository{}).GetInventory(rr, httptest.NewRequest(http.MethodGet, "/api/equipment", nil).WithContext(user.WithContext(context.Background(), "user-1")))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"is_default":true`)
+	assert.Contains(t, rr.Body.String(), `"barbell_weights":[45]`)
+}
@@ -0,0 +1,228 @@
+package equipment
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// LoadKind is how an exercise is loaded, which decides how a weight is
+// rounded.
+type LoadKind string
+
+const (
+	LoadBarbell  LoadKind = "barbell"
+	LoadDumbbell LoadKind = "dumbbell"
+	LoadMachine  LoadKind = "machine"
+)
+
+// unitsPerWeight is the loading precision. Weights are handled as whole
+// quarter units so plate sums are exact.
+const unitsPerWeight = 4
+
+var (
+	plateLoadedKeywords = []string{"smith", "plate loaded", "plate-loaded", "leg press", "hack squat"}
+	dumbbellKeywords    = []string{"dumbbell", "kettlebell"}
+	machineKeywords     = []string{"machine", "cable", "pulldown", "pushdown", "pec deck", "leg extension", "leg curl", "selectorized"}
+)
+
+// KindForExercise guesses how an exercise is loaded from its name. Anything
+// that does not look like a dumbbell or stack exercise is treated as a
+// plate-loaded bar.
+func KindForExercise(name string) LoadKind {
+	lower := strings.ToLower(strings.TrimSpace(name))
+	for _, keyword := range plateLoadedKeywords {
+		if strings.Contains(lower, keyword) {
+			return LoadBarbell
+		}
+	}
+	if strings.HasPrefix(lower, "db ") {
+		return LoadDumbbell
+	}
+	for _, keyword := range dumbbellKeywords {
+		if strings.Contains(lower, keyword) {
+			return LoadDumbbell
+		}
+	}
+	for _, keyword := range machineKeywords {
+		if strings.Contains(lower, keyword) {
+			return LoadMachine
+		}
+	}
+	return LoadBarbell
+}
+
+// RoundForExercise rounds weight to the closest load the user can set up for
+// the named exercise.
+func (inv Inventory) RoundForExercise(name string, weight float64) float64 {
+	return inv.Round(weight, KindForExercise(name))
+}
+
+// Round returns the loadable weight closest to weight. Dumbbells and stacks
+// round to their step. Bars use the primary bar, or the heaviest lighter bar
+// when weight is below it; a weight lighter than every bar rounds to the
+// dumbbell increment. Non-positive weights are returned unchanged.
+func (inv Inventory) Round(weight float64, kind LoadKind) float64 {
+	if weight <= 0 {
+		return weight
+	}
+
+	switch kind {
+	case LoadDumbbell:
+		return roundToStep(weight, inv.DumbbellIncrement)
+	case LoadMachine:
+		return roundToStep(weight, inv.MachineStackStep)
+	}
+
+	bar, ok := inv.barFor(weight)
+	if !ok {
+		return roundToStep(weight, inv.DumbbellIncrement)
+	}
+	return inv.Breakdown(weight, bar).Weight
+}
+
+// Breakdown finds the plates to put on each side of bar to get as close to
+// weight as the inventory allows. Ties go to the lighter load.
+func (inv Inventory) Breakdown(weight float64, bar float64) PlateBreakdown {
+	breakdown := PlateBreakdown{
+		RequestedWeight: weight,
+		Weight:          bar,
+		BarWeight:       bar,
+		PerSide:         []PlateCount{},
+	}
+
+	// Plates go on in pairs, so work with the per-side target in half units.
+	targetHalfUnits := toUnits(weight) - toUnits(bar)
+	if targetHalfUnits > 0 {
+		sideUnits := platesPerSide(inv.Plates, targetHalfUnits)
+		sideTotal := 0
+		for _, units := range sideUnits {
+			sideTotal += units
+		}
+		breakdown.Weight = fromUnits(toUnits(bar) + 2*sideTotal)
+		breakdown.PerSide = countPlates(sideUnits)
+	}
+
+	breakdown.Exact = toUnits(breakdown.Weight) == toUnits(weight)
+	return breakdown
+}
+
+// barFor picks the primary bar when weight can be loaded on it, otherwise
+// the heaviest bar that is not heavier than weight.
+func (inv Inventory) barFor(weight float64) (float64, bool) {
+	if len(inv.BarbellWeights) == 0 {
+		return 0, false
+	}
+	if primary := inv.BarbellWeights[0]; weight >= primary {
+		return primary, true
+	}
+
+	best, found := 0.0, false
+	for _, bar := range inv.BarbellWeights[1:] {
+		if bar <= weight && bar > best {
+			best, found = bar, true
+		}
+	}
+	return best, found
+}
+
+// platesPerSide solves a bounded subset sum over the individual plates for
+// one side and returns the chosen plates in units, heaviest first.
+// targetHalfUnits is twice the per-side target, so an odd remainder is still
+// compared exactly.
+func platesPerSide(plates []Plate, targetHalfUnits int) []int {
+	var items []int
+	maxItem := 0
+	for _, plate := range plates {
+		units := toUnits(plate.Weight)
+		if units <= 0 {
+			continue
+		}
+		for i := 0; i < plate.Pairs; i++ {
+			items = append(items, units)
+		}
+		maxItem = max(maxItem, units)
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(items)))
+
+	total := 0
+	for _, units := range items {
+		total += units
+	}
+	// Sums past the target plus one plate can never be closest.
+	limit := min(total, targetHalfUnits/2+maxItem)
+
+	// from[s] is the index of the last plate used to first reach sum s, so
+	// walking back through from never reuses a plate.
+	from := make([]int, limit+1)
+	for i := range from {
+		from[i] = -1
+	}
+	reached := make([]bool, limit+1)
+	reached[0] = true
+	for i, units := range items {
+		for sum := limit; sum >= units; sum-- {
+			if !reached[sum] && reached[sum-units] {
+				reached[sum] = true
+				from[sum] = i
+			}
+		}
+	}
+
+	best := 0
+	for sum := 1; sum <= limit; sum++ {
+		if !reached[sum] {
+			continue
+		}
+		if abs(2*sum-targetHalfUnits) < abs(2*best-targetHalfUnits) {
+			best = sum
+		}
+	}
+
+	chosen := make([]int, 0)
+	for sum := best; sum > 0; sum -= items[from[sum]] {
+		chosen = append(chosen, items[from[sum]])
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(chosen)))
+	return chosen
+}
+
+func countPlates(sideUnits []int) []PlateCount {
+	counts := make([]PlateCount, 0)
+	for _, units := range sideUnits {
+		weight := fromUnits(units)
+		if n := len(counts); n > 0 && counts[n-1].Weight == weight {
+			counts[n-1].Count++
+			continue
+		}
+		counts = append(counts, PlateCount{Weight: weight, Count: 1})
+	}
+	return counts
+}
+
+func roundToStep(weight float64, step float64) float64 {
+	stepUnits := toUnits(step)
+	if stepUnits <= 0 {
+		return weight
+	}
+	steps := int(math.Round(weight * unitsPerWeight / float64(stepUnits)))
+	return fromUnits(max(steps, 1) * stepUnits)
+}
+
+func toUnits(weight float64) int {
+	return int(math.Round(weight * unitsPerWeight))
+}
+
+func fromUnits(units int) float64 {
+	return float64(units) / unitsPerWeight
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
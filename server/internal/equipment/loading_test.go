@@ -0,0 +1,89 @@
+package equipment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInventoryBreakdown(t *testing.T) {
+	inv := DefaultInventory()
+
+	t.Run("exact load", func(t *testing.T) {
+		got := inv.Breakdown(225, 45)
+
+		assert.True(t, got.Exact)
+		assert.Equal(t, 225.0, got.Weight)
+		assert.Equal(t, []PlateCount{{Weight: 45, Count: 2}}, got.PerSide)
+	})
+
+	t.Run("rounds to the closest loadable weight", func(t *testing.T) {
+		got := inv.Breakdown(83.7, 45)
+
+		assert.False(t, got.Exact)
+		assert.Equal(t, 85.0, got.Weight)
+		assert.Equal(t, []PlateCount{{Weight: 10, Count: 2}}, got.PerSide)
+	})
+
+	t.Run("ties go to the lighter load", func(t *testing.T) {
+		got := inv.Breakdown(137.5, 45)
+
+		assert.Equal(t, 135.0, got.Weight)
+	})
+
+	t.Run("respects plate counts", func(t *testing.T) {
+		got := inv.Breakdown(1000, 45)
+
+		// Every plate in the default inventory: 297.5 per side.
+		assert.Equal(t, 640.0, got.Weight)
+		assert.Equal(t, PlateCount{Weight: 45, Count: 4}, got.PerSide[0])
+	})
+
+	t.Run("uses a mix when one size runs out", func(t *testing.T) {
+		limited := Inventory{BarbellWeights: []float64{45}, Plates: []Plate{{Weight: 45, Pairs: 1}, {Weight: 25, Pairs: 2}}}
+
+		got := limited.Breakdown(235, 45)
+
+		assert.True(t, got.Exact)
+		assert.Equal(t, []PlateCount{{Weight: 45, Count: 1}, {Weight: 25, Count: 2}}, got.PerSide)
+	})
+
+	t.Run("below the bar", func(t *testing.T) {
+		got := inv.Breakdown(30, 45)
+
+		assert.Equal(t, 45.0, got.Weight)
+		assert.Empty(t, got.PerSide)
+		assert.False(t, got.Exact)
+	})
+}
+
+func TestInventoryRound(t *testing.T) {
+	inv := DefaultInventory()
+	inv.BarbellWeights = []float64{45, 15}
+
+	assert.Equal(t, 85.0, inv.RoundForExercise("Back Squat", 83.7))
+	assert.Equal(t, 35.0, inv.RoundForExercise("Incline Dumbbell Press", 37.4))
+	assert.Equal(t, 110.0, inv.RoundForExercise("Lat Pulldown", 106))
+	assert.Equal(t, 25.0, inv.RoundForExercise("EZ Bar Curl", 27), "lighter bar below the primary bar")
+	assert.Equal(t, 10.0, inv.RoundForExercise("Barbell Wrist Curl", 12), "lighter than every bar")
+	assert.Equal(t, 5.0, inv.Round(1, LoadDumbbell), "never rounds down to zero")
+	assert.Equal(t, 0.0, inv.Round(0, LoadBarbell))
+}
+
+func TestKindForExercise(t *testing.T) {
+	tests := map[string]LoadKind{
+		"Bench Press":           LoadBarbell,
+		"DB Row":                LoadDumbbell,
+		"Kettlebell Swing":      LoadDumbbell,
+		"Cable Fly":             LoadMachine,
+		"Seated Leg Curl":       LoadMachine,
+		"Smith Machine Squat":   LoadBarbell,
+		"Leg Press":             LoadBarbell,
+		"Romanian Deadlift":     LoadBarbell,
+		"Single-Arm Dumbbell":   LoadDumbbell,
+		"Triceps Rope Pushdown": LoadMachine,
+	}
+	for name, want := range tests {
+		assert.Equal(t, want, KindForExercise(name), name)
+	}
+}
@@ -0,0 +1,97 @@
+package equipment
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	maxBarbells       = 5
+	maxPlateSizes     = 12
+	maxPlatePairs     = 20
+	maxEquipmentLoad  = 100
+	maxStepSize       = 50
+	maxBreakdownLoad  = 2000
+	defaultBarWeight  = 45
+	defaultDumbbell   = 5
+	defaultStackStep  = 10
+	equipmentResource = "equipment"
+)
+
+// Plate is one plate size and how many pairs of it the user owns.
+type Plate struct {
+	Weight float64 `json:"weight" example:"45"`
+	Pairs  int     `json:"pairs" example:"4"`
+}
+
+// Inventory is the equipment a user loads weights with, in the units they
+// log lifts in. BarbellWeights lists the primary bar first. Weights are
+// multiples of 0.25 so every combination can be loaded exactly.
+type Inventory struct {
+	BarbellWeights    []float64 `json:"barbell_weights" example:"45,35"`
+	Plates            []Plate   `json:"plates"`
+	DumbbellIncrement float64   `json:"dumbbell_increment" example:"5"`
+	MachineStackStep  float64   `json:"machine_stack_step" example:"10"`
+}
+
+// InventoryResponse is the stored inventory, or the default commercial-gym
+// inventory when IsDefault is true.
+type InventoryResponse struct {
+	Inventory
+	IsDefault bool       `json:"is_default"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// UpdateInventoryRequest replaces the user's equipment inventory.
+type UpdateInventoryRequest struct {
+	BarbellWeights    []float64 `json:"barbell_weights" example:"45,35"`
+	Plates            []Plate   `json:"plates"`
+	DumbbellIncrement float64   `json:"dumbbell_increment" example:"5"`
+	MachineStackStep  float64   `json:"machine_stack_step" example:"10"`
+}
+
+// PlateCount is how many plates of one size go on each side of the bar.
+type PlateCount struct {
+	Weight float64 `json:"weight" example:"45"`
+	Count  int     `json:"count" example:"2"`
+}
+
+// PlateBreakdown shows how to load the closest achievable weight to
+// RequestedWeight. PerSide lists the plates for one side, heaviest first.
+type PlateBreakdown struct {
+	RequestedWeight float64      `json:"requested_weight" example:"227"`
+	Weight          float64      `json:"weight" example:"225"`
+	BarWeight       float64      `json:"bar_weight" example:"45"`
+	PerSide         []PlateCount `json:"per_side"`
+	Exact           bool         `json:"exact"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// DefaultInventory is a typical commercial gym in pounds, used until the user
+// saves their own equipment.
+func DefaultInventory() Inventory {
+	return Inventory{
+		BarbellWeights: []float64{defaultBarWeight},
+		Plates: []Plate{
+			{Weight: 45, Pairs: 4},
+			{Weight: 35, Pairs: 1},
+			{Weight: 25, Pairs: 2},
+			{Weight: 10, Pairs: 2},
+			{Weight: 5, Pairs: 2},
+			{Weight: 2.5, Pairs: 1},
+		},
+		DumbbellIncrement: defaultDumbbell,
+		MachineStackStep:  defaultStackStep,
+	}
+}
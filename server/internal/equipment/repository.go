@@ -0,0 +1,141 @@
+package equipment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	GetInventory(ctx context.Context, userID string) (*InventoryResponse, error)
+	UpsertInventory(ctx context.Context, userID string, inv Inventory) (*InventoryResponse, error)
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	conn    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		conn:    conn,
+	}
+}
+
+// GetInventory returns the stored inventory, or the default inventory when
+// the user has not saved one.
+func (r *repository) GetInventory(ctx context.Context, userID string) (*InventoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	return LoadInventory(ctx, r.queries, userID)
+}
+
+func (r *repository) UpsertInventory(ctx context.Context, userID string, inv Inventory) (*InventoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	barbellWeights, err := json.Marshal(inv.BarbellWeights)
+	if err != nil {
+		return nil, fmt.Errorf("encode barbell weights: %w", err)
+	}
+	plates, err := json.Marshal(inv.Plates)
+	if err != nil {
+		return nil, fmt.Errorf("encode plates: %w", err)
+	}
+	dumbbellIncrement, err := numericFromFloat(inv.DumbbellIncrement)
+	if err != nil {
+		return nil, err
+	}
+	machineStackStep, err := numericFromFloat(inv.MachineStackStep)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.queries.UpsertUserEquipment(ctx, db.UpsertUserEquipmentParams{
+		UserID:            userID,
+		BarbellWeights:    barbellWeights,
+		Plates:            plates,
+		DumbbellIncrement: dumbbellIncrement,
+		MachineStackStep:  machineStackStep,
+	})
+	if err != nil {
+		r.logger.Error("database error updating equipment", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("upsert equipment: %w", err)
+	}
+	return inventoryFromRow(row)
+}
+
+// LoadInventory reads a user's inventory with the given queries, falling back
+// to DefaultInventory. Other packages use it to round the weights they
+// suggest.
+func LoadInventory(ctx context.Context, queries *db.Queries, userID string) (*InventoryResponse, error) {
+	row, err := queries.GetUserEquipment(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &InventoryResponse{Inventory: DefaultInventory(), IsDefault: true}, nil
+		}
+		return nil, fmt.Errorf("get equipment: %w", err)
+	}
+	return inventoryFromRow(row)
+}
+
+func inventoryFromRow(row db.UserEquipment) (*InventoryResponse, error) {
+	resp := &InventoryResponse{
+		Inventory: Inventory{
+			BarbellWeights: []float64{},
+			Plates:         []Plate{},
+		},
+	}
+	if err := json.Unmarshal(row.BarbellWeights, &resp.BarbellWeights); err != nil {
+		return nil, fmt.Errorf("decode barbell weights: %w", err)
+	}
+	if err := json.Unmarshal(row.Plates, &resp.Plates); err != nil {
+		return nil, fmt.Errorf("decode plates: %w", err)
+	}
+
+	var err error
+	if resp.DumbbellIncrement, err = floatFromNumeric(row.DumbbellIncrement); err != nil {
+		return nil, err
+	}
+	if resp.MachineStackStep, err = floatFromNumeric(row.MachineStackStep); err != nil {
+		return nil, err
+	}
+	if row.UpdatedAt.Valid {
+		updatedAt := row.UpdatedAt.Time
+		resp.UpdatedAt = &updatedAt
+	}
+	return resp, nil
+}
+
+func numericFromFloat(val float64) (pgtype.Numeric, error) {
+	var n pgtype.Numeric
+	if err := n.Scan(fmt.Sprintf("%.2f", val)); err != nil {
+		return pgtype.Numeric{}, fmt.Errorf("failed to convert float to numeric: %w", err)
+	}
+	return n, nil
+}
+
+func floatFromNumeric(n pgtype.Numeric) (float64, error) {
+	if !n.Valid {
+		return 0, nil
+	}
+	f64, err := n.Float64Value()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert numeric to float64: %w", err)
+	}
+	return f64.Float64, nil
+}
+
+var _ Repository = (*repository)(nil)
@@ -0,0 +1,142 @@
+package equipment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"sort"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger *slog.Logger
+	repo   Repository
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+func (s *Service) GetInventory(ctx context.Context) (*InventoryResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadWorkouts) {
+		return nil, &apperrors.Unauthorized{Resource: equipmentResource, UserID: ""}
+	}
+
+	inv, err := s.repo.GetInventory(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get equipment: %w", err)
+	}
+	return inv, nil
+}
+
+// UpdateInventory replaces the inventory. Plates are stored heaviest first.
+func (s *Service) UpdateInventory(ctx context.Context, req UpdateInventoryRequest) (*InventoryResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return nil, &apperrors.Unauthorized{Resource: equipmentResource, UserID: ""}
+	}
+
+	inv, err := validateInventory(req)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.UpsertInventory(ctx, userID, inv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update equipment: %w", err)
+	}
+	return updated, nil
+}
+
+// GetPlateBreakdown loads weight onto bar, or onto the primary bar when bar
+// is nil, using the user's plates.
+func (s *Service) GetPlateBreakdown(ctx context.Context, weight float64, bar *float64) (*PlateBreakdown, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadWorkouts) {
+		return nil, &apperrors.Unauthorized{Resource: equipmentResource, UserID: ""}
+	}
+
+	if weight <= 0 || weight > maxBreakdownLoad {
+		return nil, &ValidationError{Field: "weight", Message: fmt.Sprintf("must be greater than 0 and at most %d", maxBreakdownLoad)}
+	}
+
+	inv, err := s.repo.GetInventory(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plate breakdown: %w", err)
+	}
+
+	barWeight := inv.BarbellWeights[0]
+	if bar != nil {
+		if !slices.Contains(inv.BarbellWeights, *bar) {
+			return nil, &ValidationError{Field: "bar", Message: "must be one of your barbell weights"}
+		}
+		barWeight = *bar
+	}
+
+	breakdown := inv.Breakdown(weight, barWeight)
+	return &breakdown, nil
+}
+
+func validateInventory(req UpdateInventoryRequest) (Inventory, error) {
+	if len(req.BarbellWeights) == 0 || len(req.BarbellWeights) > maxBarbells {
+		return Inventory{}, &ValidationError{Field: "barbell_weights", Message: fmt.Sprintf("must list between 1 and %d bars", maxBarbells)}
+	}
+	for _, bar := range req.BarbellWeights {
+		if !validLoad(bar, maxEquipmentLoad) {
+			return Inventory{}, &ValidationError{Field: "barbell_weights", Message: loadMessage(maxEquipmentLoad)}
+		}
+	}
+
+	if len(req.Plates) == 0 || len(req.Plates) > maxPlateSizes {
+		return Inventory{}, &ValidationError{Field: "plates", Message: fmt.Sprintf("must list between 1 and %d plate sizes", maxPlateSizes)}
+	}
+	plates := slices.Clone(req.Plates)
+	seen := make(map[float64]bool, len(plates))
+	for _, plate := range plates {
+		if !validLoad(plate.Weight, maxEquipmentLoad) {
+			return Inventory{}, &ValidationError{Field: "plates", Message: loadMessage(maxEquipmentLoad)}
+		}
+		if plate.Pairs < 1 || plate.Pairs > maxPlatePairs {
+			return Inventory{}, &ValidationError{Field: "plates", Message: fmt.Sprintf("pairs must be between 1 and %d", maxPlatePairs)}
+		}
+		if seen[plate.Weight] {
+			return Inventory{}, &ValidationError{Field: "plates", Message: "each plate size may only be listed once"}
+		}
+		seen[plate.Weight] = true
+	}
+	sort.Slice(plates, func(i, j int) bool { return plates[i].Weight > plates[j].Weight })
+
+	if !validLoad(req.DumbbellIncrement, maxStepSize) {
+		return Inventory{}, &ValidationError{Field: "dumbbell_increment", Message: loadMessage(maxStepSize)}
+	}
+	if !validLoad(req.MachineStackStep, maxStepSize) {
+		return Inventory{}, &ValidationError{Field: "machine_stack_step", Message: loadMessage(maxStepSize)}
+	}
+
+	return Inventory{
+		BarbellWeights:    slices.Clone(req.BarbellWeights),
+		Plates:            plates,
+		DumbbellIncrement: req.DumbbellIncrement,
+		MachineStackStep:  req.MachineStackStep,
+	}, nil
+}
+
+// validLoad accepts positive multiples of 0.25 up to limit.
+func validLoad(weight float64, limit float64) bool {
+	if weight <= 0 || weight > limit {
+		return false
+	}
+	return math.Abs(weight*unitsPerWeight-math.Round(weight*unitsPerWeight)) < 1e-9
+}
+
+func loadMessage(limit int) string {
+	return fmt.Sprintf("must be a multiple of 0.25 greater than 0 and at most %d", limit)
+}
@@ -0,0 +1,138 @@
+package exercise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// exerciseNoteHistoryLimit caps how many past revisions are returned.
+const exerciseNoteHistoryLimit = 20
+
+func textPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	s := t.String
+	return &s
+}
+
+// trimmedOrNil treats blank input as clearing the field.
+func trimmedOrNil(val *string) *string {
+	if val == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*val)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+func sameText(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// MARK: GetExerciseNotes
+func (es *ExerciseService) GetExerciseNotes(ctx context.Context, id int32) (*ExerciseNotesResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
+		return nil, &apperrors.Unauthorized{Resource: "exercise", UserID: ""}
+	}
+
+	if err := es.lookupExercise(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	return es.exerciseNotes(ctx, id, userID)
+}
+
+// MARK: UpdateExerciseNotes
+// UpdateExerciseNotes saves a new revision of the exercise's notes and settings.
+// Saving the same values as the current revision does not add history.
+func (es *ExerciseService) UpdateExerciseNotes(ctx context.Context, id int32, req UpdateExerciseNotesRequest) (*ExerciseNotesResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || user.IsDelegated(ctx) {
+		return nil, &apperrors.Unauthorized{Resource: "exercise", UserID: ""}
+	}
+
+	if err := es.lookupExercise(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	notes := trimmedOrNil(req.Notes)
+	settings := trimmedOrNil(req.Settings)
+
+	latest, err := es.repo.ListExerciseNoteHistory(ctx, id, 1, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up current exercise notes: %w", err)
+	}
+
+	var currentNotes, currentSettings *string
+	if len(latest) > 0 {
+		currentNotes = textPtr(latest[0].Notes)
+		currentSettings = textPtr(latest[0].Settings)
+	}
+
+	if !sameText(notes, currentNotes) || !sameText(settings, currentSettings) {
+		_, err := es.repo.CreateExerciseNote(ctx, id, notes, settings, userID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &apperrors.NotFound{Resource: "exercise", ID: fmt.Sprintf("%d", id)}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to save exercise notes: %w", err)
+		}
+	}
+
+	return es.exerciseNotes(ctx, id, userID)
+}
+
+func (es *ExerciseService) lookupExercise(ctx context.Context, id int32, userID string) error {
+	_, err := es.repo.GetExercise(ctx, id, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &apperrors.NotFound{Resource: "exercise", ID: fmt.Sprintf("%d", id)}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to look up exercise: %w", err)
+	}
+	return nil
+}
+
+func (es *ExerciseService) exerciseNotes(ctx context.Context, id int32, userID string) (*ExerciseNotesResponse, error) {
+	rows, err := es.repo.ListExerciseNoteHistory(ctx, id, exerciseNoteHistoryLimit, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exercise note history: %w", err)
+	}
+
+	resp := &ExerciseNotesResponse{History: make([]ExerciseNoteResponse, 0, len(rows))}
+	for _, row := range rows {
+		resp.History = append(resp.History, exerciseNoteResponse(row))
+	}
+	if len(resp.History) > 0 {
+		latest := resp.History[0]
+		if latest.Notes != nil || latest.Settings != nil {
+			resp.Current = &latest
+		}
+	}
+
+	return resp, nil
+}
+
+func exerciseNoteResponse(row db.ExerciseNote) ExerciseNoteResponse {
+	return ExerciseNoteResponse{
+		ID:        row.ID,
+		Notes:     textPtr(row.Notes),
+		Settings:  textPtr(row.Settings),
+		CreatedAt: row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,184 @@
+package exercise
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func exerciseNoteRow(id int32, notes, settings string) db.ExerciseNote {
+	row := db.ExerciseNote{
+		ID:        id,
+		CreatedAt: pgtype.Timestamptz{Time: time.Date(2025, 3, int(id), 12, 0, 0, 0, time.UTC), Valid: true},
+	}
+	if notes != "" {
+		row.Notes = pgtype.Text{String: notes, Valid: true}
+	}
+	if settings != "" {
+		row.Settings = pgtype.Text{String: settings, Valid: true}
+	}
+	return row
+}
+
+func newExerciseNotesRequest(t *testing.T, method string, body string, userID string) *http.Request {
+	t.Helper()
+	ctx := context.WithValue(context.Background(), user.UserIDKey, userID)
+	req := httptest.NewRequest(method, "/api/exercises/7/notes", bytes.NewBufferString(body)).WithContext(ctx)
+	req.SetPathValue("id", "7")
+	return req
+}
+
+func TestExerciseHandler_GetExerciseNotes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	userID := "user-123"
+	exerciseID := int32(7)
+
+	t.Run("returns current and history", func(t *testing.T) {
+		repo := new(MockExerciseRepository)
+		repo.On("GetExercise", mock.Anything, exerciseID, userID).Return(db.Exercise{ID: exerciseID}, nil)
+		repo.On("ListExerciseNoteHistory", mock.Anything, exerciseID, int32(exerciseNoteHistoryLimit), userID).
+			Return([]db.ExerciseNote{exerciseNoteRow(2, "Grip just outside rings", "Seat 4"), exerciseNoteRow(1, "Grip on rings", "")}, nil)
+		handler := NewHandler(logger, validator.New(), NewService(logger, repo))
+		w := httptest.NewRecorder()
+
+		handler.GetExerciseNotes(w, newExerciseNotesRequest(t, http.MethodGet, "", userID))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var resp ExerciseNotesResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.NotNil(t, resp.Current)
+		assert.Equal(t, "Seat 4", *resp.Current.Settings)
+		assert.Len(t, resp.History, 2)
+		assert.Nil(t, resp.History[1].Settings)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("cleared notes have no current revision", func(t *testing.T) {
+		repo := new(MockExerciseRepository)
+		repo.On("GetExercise", mock.Anything, exerciseID, userID).Return(db.Exercise{ID: exerciseID}, nil)
+		repo.On("ListExerciseNoteHistory", mock.Anything, exerciseID, int32(exerciseNoteHistoryLimit), userID).
+			Return([]db.ExerciseNote{exerciseNoteRow(2, "", ""), exerciseNoteRow(1, "Grip on rings", "")}, nil)
+		handler := NewHandler(logger, validator.New(), NewService(logger, repo))
+		w := httptest.NewRecorder()
+
+		handler.GetExerciseNotes(w, newExerciseNotesRequest(t, http.MethodGet, "", userID))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), `"current"`)
+	})
+
+	t.Run("missing exercise", func(t *testing.T) {
+		repo := new(MockExerciseRepository)
+		repo.On("GetExercise", mock.Anything, exerciseID, userID).Return(db.Exercise{}, pgx.ErrNoRows)
+		handler := NewHandler(logger, validator.New(), NewService(logger, repo))
+		w := httptest.NewRecorder()
+
+		handler.GetExerciseNotes(w, newExerciseNotesRequest(t, http.MethodGet, "", userID))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestExerciseHandler_UpdateExerciseNotes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	userID := "user-123"
+	exerciseID := int32(7)
+
+	t.Run("saves a trimmed revision", func(t *testing.T) {
+		repo := new(MockExerciseRepository)
+		repo.On("GetExercise", mock.Anything, exerciseID, userID).Return(db.Exercise{ID: exerciseID}, nil)
+		repo.On("ListExerciseNoteHistory", mock.Anything, exerciseID, int32(1), userID).
+			Return([]db.ExerciseNote{exerciseNoteRow(1, "Grip on rings", "")}, nil).Once()
+		repo.On("CreateExerciseNote", mock.Anything, exerciseID, mock.AnythingOfType("*string"), (*string)(nil), userID).
+			Return(exerciseNoteRow(2, "Grip just outside rings", ""), nil).
+			Run(func(args mock.Arguments) {
+				assert.Equal(t, "Grip just outside rings", *args.Get(2).(*string))
+			})
+		repo.On("ListExerciseNoteHistory", mock.Anything, exerciseID, int32(exerciseNoteHistoryLimit), userID).
+			Return([]db.ExerciseNote{exerciseNoteRow(2, "Grip just outside rings", ""), exerciseNoteRow(1, "Grip on rings", "")}, nil)
+		handler := NewHandler(logger, validator.New(), NewService(logger, repo))
+		w := httptest.NewRecorder()
+
+		handler.UpdateExerciseNotes(w, newExerciseNotesRequest(t, http.MethodPut, `{"notes":"  Grip just outside rings ","settings":"  "}`, userID))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"notes":"Grip just outside rings"`)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("unchanged notes do not add history", func(t *testing.T) {
+		repo := new(MockExerciseRepository)
+		repo.On("GetExercise", mock.Anything, exerciseID, userID).Return(db.Exercise{ID: exerciseID}, nil)
+		repo.On("ListExerciseNoteHistory", mock.Anything, exerciseID, mock.AnythingOfType("int32"), userID).
+			Return([]db.ExerciseNote{exerciseNoteRow(1, "Grip on rings", "Seat 4")}, nil)
+		handler := NewHandler(logger, validator.New(), NewService(logger, repo))
+		w := httptest.NewRecorder()
+
+		handler.UpdateExerciseNotes(w, newExerciseNotesRequest(t, http.MethodPut, `{"notes":"Grip on rings","settings":"Seat 4"}`, userID))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		repo.AssertNotCalled(t, "CreateExerciseNote", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects notes over the limit", func(t *testing.T) {
+		handler := NewHandler(logger, validator.New(), NewService(logger, new(MockExerciseRepository)))
+		w := httptest.NewRecorder()
+		notes := strings.Repeat("a", 2001)
+		body, _ := json.Marshal(UpdateExerciseNotesRequest{Notes: &notes})
+
+		handler.UpdateExerciseNotes(w, newExerciseNotesRequest(t, http.MethodPut, string(body), userID))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("delegated access is read only", func(t *testing.T) {
+		handler := NewHandler(logger, validator.New(), NewService(logger, new(MockExerciseRepository)))
+		w := httptest.NewRecorder()
+		req := newExerciseNotesRequest(t, http.MethodPut, `{"notes":"Grip on rings"}`, userID)
+		req = req.WithContext(user.WithDelegation(req.Context(), user.Delegation{ActorID: "coach-1", Scopes: []string{user.ScopeReadWorkouts}}))
+
+		handler.UpdateExerciseNotes(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestExerciseService_GetExerciseWithSets_IncludesNotes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	userID := "user-123"
+	exerciseID := int32(7)
+	updatedAt := time.Date(2025, 3, 2, 12, 0, 0, 0, time.UTC)
+
+	repo := new(MockExerciseRepository)
+	repo.On("GetExerciseDetail", mock.Anything, exerciseID, userID).Return(db.GetExerciseDetailRow{
+		ID:             exerciseID,
+		Notes:          pgtype.Text{String: "Grip just outside rings", Valid: true},
+		Settings:       pgtype.Text{String: "Seat 4", Valid: true},
+		NotesUpdatedAt: pgtype.Timestamptz{Time: updatedAt, Valid: true},
+	}, nil)
+	repo.On("GetExerciseWithSets", mock.Anything, exerciseID, userID).Return([]db.GetExerciseWithSetsRow{}, nil)
+
+	detail, err := NewService(logger, repo).GetExerciseWithSets(user.WithContext(context.Background(), userID), exerciseID)
+
+	require.NoError(t, err)
+	require.NotNil(t, detail.Exercise.Notes)
+	assert.Equal(t, "Grip just outside rings", *detail.Exercise.Notes)
+	assert.Equal(t, "Seat 4", *detail.Exercise.Settings)
+	assert.Equal(t, updatedAt, *detail.Exercise.NotesUpdatedAt)
+}
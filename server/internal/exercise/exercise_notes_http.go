@@ -0,0 +1,101 @@
+package exercise
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+// MARK: GetExerciseNotes
+// GetExerciseNotes godoc
+// @Summary Get exercise notes
+// @Description Get the current notes, cues and equipment settings for an exercise plus recent revisions, newest first.
+// @Tags exercises
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param id path int true "Exercise ID"
+// @Success 200 {object} exercise.ExerciseNotesResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request - Invalid exercise ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found - Exercise not found or doesn't belong to user"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /exercises/{id}/notes [get]
+func (h *ExerciseHandler) GetExerciseNotes(w http.ResponseWriter, r *http.Request) {
+	exerciseID, ok := h.decodeExerciseID(w, r)
+	if !ok {
+		return
+	}
+
+	notes, err := h.exerciseService.GetExerciseNotes(r.Context(), exerciseID)
+	if err != nil {
+		h.writeExerciseNotesError(w, r, err, "Failed to get exercise notes")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, notes); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "Failed to write response", err)
+		return
+	}
+}
+
+// MARK: UpdateExerciseNotes
+// UpdateExerciseNotes godoc
+// @Summary Update exercise notes
+// @Description Replace the notes, cues and equipment settings for an exercise. Each change is kept as a revision; blank or omitted fields are cleared.
+// @Tags exercises
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param id path int true "Exercise ID"
+// @Param body body UpdateExerciseNotesRequest true "Exercise notes"
+// @Success 200 {object} exercise.ExerciseNotesResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request - Invalid exercise ID or validation error"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found - Exercise not found or doesn't belong to user"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /exercises/{id}/notes [put]
+func (h *ExerciseHandler) UpdateExerciseNotes(w http.ResponseWriter, r *http.Request) {
+	exerciseID, ok := h.decodeExerciseID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateExerciseNotesRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Failed to decode request body", err)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Validation failed", err)
+		return
+	}
+
+	notes, err := h.exerciseService.UpdateExerciseNotes(r.Context(), exerciseID, req)
+	if err != nil {
+		h.writeExerciseNotesError(w, r, err, "Failed to update exercise notes")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, notes); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "Failed to write response", err)
+		return
+	}
+}
+
+func (h *ExerciseHandler) writeExerciseNotesError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errNotFound *apperrors.NotFound
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errNotFound):
+		response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, message, err)
+	}
+}
@@ -200,13 +200,16 @@ func (h *ExerciseHandler) GetRecentSetsForExercise(w http.ResponseWriter, r *htt
 // MARK: GetExerciseMetricsHistory
 // GetExerciseMetricsHistory godoc
 // @Summary Get exercise metrics history
-// @Description Get time-series session metrics for an exercise. All ranges return per-workout session points filtered to the selected window.
+// @Description Get time-series session metrics for an exercise. Use a range code for a window ending at the latest session, or from/to local dates for a custom window. bucket=week or month folds sessions together: bests take the max, volume is summed and averages are weighted by working sets.
 // @Tags exercises
 // @Accept json
 // @Produce json
 // @Security StackAuth
 // @Param id path int true "Exercise ID"
-// @Param range query string false "Range selector" Enums(W,M,6M,Y) default(M)
+// @Param range query string false "Range selector; cannot be combined with from/to" Enums(W,M,6M,Y) default(M)
+// @Param from query string false "First local day to include (YYYY-MM-DD)"
+// @Param to query string false "Last local day to include (YYYY-MM-DD)"
+// @Param bucket query string false "Point grouping" Enums(workout,week,month) default(workout)
 // @Success 200 {object} exercise.ExerciseMetricsHistoryResponse
 // @Failure 400 {object} response.ErrorResponse "Bad Request"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
@@ -219,14 +222,18 @@ func (h *ExerciseHandler) GetExerciseMetricsHistory(w http.ResponseWriter, r *ht
 		return
 	}
 
-	rng := r.URL.Query().Get("range")
-	if rng == "" {
-		rng = "M"
+	window, err := readMetricsHistoryWindow(r.URL.Query())
+	if err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, err.Error(), nil)
+		return
 	}
 
 	req := GetExerciseMetricsHistoryRequest{
 		ExerciseID: exerciseID,
-		Range:      rng,
+		Range:      window.Range,
+		From:       window.From,
+		To:         window.To,
+		Bucket:     window.Bucket,
 	}
 
 	if err := h.validator.Struct(req); err != nil {
@@ -234,7 +241,7 @@ func (h *ExerciseHandler) GetExerciseMetricsHistory(w http.ResponseWriter, r *ht
 		return
 	}
 
-	history, err := h.exerciseService.GetExerciseMetricsHistory(r.Context(), req.ExerciseID, req.Range)
+	history, err := h.exerciseService.GetExerciseMetricsHistory(r.Context(), req)
 	if err != nil {
 		var errUnauthorized *apperrors.Unauthorized
 		var errNotFound *apperrors.NotFound
@@ -256,6 +263,74 @@ func (h *ExerciseHandler) GetExerciseMetricsHistory(w http.ResponseWriter, r *ht
 	}
 }
 
+// MARK: GetExercisesMetricsHistory
+// GetExercisesMetricsHistory godoc
+// @Summary Compare metrics history across exercises
+// @Description Get metrics for up to 8 exercises on one shared time axis. Each series has a point per axis entry (null where the exercise has no session) with percent_of_1rm relative to the exercise's historical 1RM, or its best e1RM in the window when none is set. Range, from/to and bucket behave as on the single-exercise endpoint; range windows end at the latest session across the exercises.
+// @Tags exercises
+// @Produce json
+// @Security StackAuth
+// @Param ids query string true "Comma-separated exercise IDs"
+// @Param range query string false "Range selector; cannot be combined with from/to" Enums(W,M,6M,Y) default(M)
+// @Param from query string false "First local day to include (YYYY-MM-DD)"
+// @Param to query string false "Last local day to include (YYYY-MM-DD)"
+// @Param bucket query string false "Point grouping" Enums(workout,week,month) default(workout)
+// @Success 200 {object} exercise.ExercisesMetricsHistoryResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found - Exercise not found or doesn't belong to user"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /exercises/metrics-history [get]
+func (h *ExerciseHandler) GetExercisesMetricsHistory(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	ids, err := parseExerciseIDs(query.Get("ids"))
+	if err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Invalid exercise IDs", err)
+		return
+	}
+
+	window, err := readMetricsHistoryWindow(query)
+	if err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	req := GetExercisesMetricsHistoryRequest{
+		ExerciseIDs: ids,
+		Range:       window.Range,
+		From:        window.From,
+		To:          window.To,
+		Bucket:      window.Bucket,
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Invalid request", err)
+		return
+	}
+
+	history, err := h.exerciseService.GetExercisesMetricsHistory(r.Context(), req)
+	if err != nil {
+		var errUnauthorized *apperrors.Unauthorized
+		var errNotFound *apperrors.NotFound
+
+		switch {
+		case errors.As(err, &errUnauthorized):
+			response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+		case errors.As(err, &errNotFound):
+			response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+		default:
+			response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "Failed to get exercises metrics history", err)
+		}
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, history); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "Failed to write response", err)
+		return
+	}
+}
+
 // MARK: UpdateExerciseName
 // UpdateExerciseName godoc
 // @Summary Update an exercise name
@@ -1,7 +1,10 @@
 package exercise
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -30,3 +33,54 @@ func (h *ExerciseHandler) decodeExerciseID(w http.ResponseWriter, r *http.Reques
 func decodeStrictJSON(w http.ResponseWriter, r *http.Request, dst any) error {
 	return request.DecodeStrictJSON(w, r, dst, maxExerciseJSONBodyBytes)
 }
+
+// metricsHistoryWindow is the range, from, to and bucket options shared by
+// the metrics history endpoints.
+type metricsHistoryWindow struct {
+	Range  string
+	From   string
+	To     string
+	Bucket MetricsHistoryBucket
+}
+
+// readMetricsHistoryWindow reads the window options from the query string.
+// Without from/to the range defaults to M; a range cannot be combined with
+// dates. Field formats are left to the validator.
+func readMetricsHistoryWindow(query url.Values) (metricsHistoryWindow, error) {
+	window := metricsHistoryWindow{
+		Range:  query.Get("range"),
+		From:   query.Get("from"),
+		To:     query.Get("to"),
+		Bucket: MetricsHistoryBucket(query.Get("bucket")),
+	}
+
+	custom := window.From != "" || window.To != ""
+	if custom && window.Range != "" {
+		return window, errors.New("range cannot be combined with from or to")
+	}
+	if !custom && window.Range == "" {
+		window.Range = "M"
+	}
+	// Dates are zero-padded, so string order is date order.
+	if window.From != "" && window.To != "" && window.To < window.From {
+		return window, errors.New("to must not be before from")
+	}
+	return window, nil
+}
+
+// parseExerciseIDs parses a comma-separated list of exercise IDs.
+func parseExerciseIDs(raw string) ([]int32, error) {
+	ids := make([]int32, 0)
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(part, 10, 32)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("invalid exercise ID %q", part)
+		}
+		ids = append(ids, int32(parsed))
+	}
+	return ids, nil
+}
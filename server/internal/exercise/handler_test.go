@@ -82,6 +82,11 @@ func (m *MockExerciseRepository) GetExerciseMetricsHistory(ctx context.Context,
 	return args.Get(0).([]ExerciseMetricsHistoryPoint), args.Get(1).(MetricsHistoryBucket), args.Error(2)
 }
 
+func (m *MockExerciseRepository) GetExercisesMetricsHistory(ctx context.Context, req GetExercisesMetricsHistoryRequest, userID string) (map[int32][]ExerciseMetricsHistoryPoint, MetricsHistoryBucket, error) {
+	args := m.Called(ctx, req, userID)
+	return args.Get(0).(map[int32][]ExerciseMetricsHistoryPoint), args.Get(1).(MetricsHistoryBucket), args.Error(2)
+}
+
 func (m *MockExerciseRepository) DeleteExercise(ctx context.Context, id int32, userID string) error {
 	args := m.Called(ctx, id, userID)
 	return args.Error(0)
@@ -107,6 +112,16 @@ func (m *MockExerciseRepository) SetExerciseHistorical1RM(ctx context.Context, i
 	return args.Error(0)
 }
 
+func (m *MockExerciseRepository) CreateExerciseNote(ctx context.Context, id int32, notes, settings *string, userID string) (db.ExerciseNote, error) {
+	args := m.Called(ctx, id, notes, settings, userID)
+	return args.Get(0).(db.ExerciseNote), args.Error(1)
+}
+
+func (m *MockExerciseRepository) ListExerciseNoteHistory(ctx context.Context, id int32, limit int32, userID string) ([]db.ExerciseNote, error) {
+	args := m.Called(ctx, id, limit, userID)
+	return args.Get(0).([]db.ExerciseNote), args.Error(1)
+}
+
 type errorResponse struct {
 	Message string `json:"message"`
 }
@@ -24,7 +24,7 @@ func floatPtrFromNumeric(n pgtype.Numeric) (*float64, error) {
 // MARK: UpdateExerciseHistorical1RM
 func (es *ExerciseService) UpdateExerciseHistorical1RM(ctx context.Context, id int32, req UpdateExerciseHistorical1RMRequest) error {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || user.IsDelegated(ctx) {
 		return &apperrors.Unauthorized{Resource: "exercise", UserID: ""}
 	}
 
@@ -3,6 +3,7 @@ package exercise
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
@@ -13,6 +14,13 @@ type MetricsHistoryBucket string
 
 const (
 	MetricsHistoryBucketWorkout MetricsHistoryBucket = "workout"
+	MetricsHistoryBucketWeek    MetricsHistoryBucket = "week"
+	MetricsHistoryBucketMonth   MetricsHistoryBucket = "month"
+)
+
+const (
+	metricsHistoryDateLayout  = "2006-01-02"
+	metricsHistoryCustomRange = "custom"
 )
 
 type ExerciseMetricsHistoryPoint struct {
@@ -28,28 +36,63 @@ type ExerciseMetricsHistoryPoint struct {
 
 type ExerciseMetricsHistoryResponse struct {
 	Range  string                        `json:"range"`
+	From   string                        `json:"from,omitempty"`
+	To     string                        `json:"to,omitempty"`
 	Bucket MetricsHistoryBucket          `json:"bucket"`
 	Points []ExerciseMetricsHistoryPoint `json:"points"`
 }
 
-func (es *ExerciseService) GetExerciseMetricsHistory(ctx context.Context, exerciseID int32, r string) (*ExerciseMetricsHistoryResponse, error) {
+// ExerciseMetricsComparisonPoint is a session point with its best e1RM as a
+// percentage of the exercise's reference 1RM.
+type ExerciseMetricsComparisonPoint struct {
+	ExerciseMetricsHistoryPoint
+	PercentOf1RM float64 `json:"percent_of_1rm"`
+}
+
+// ExerciseMetricsSeries holds one exercise's points aligned to the shared
+// axis; a point is null where the exercise has no session.
+type ExerciseMetricsSeries struct {
+	ExerciseID   int32                             `json:"exercise_id"`
+	Name         string                            `json:"name"`
+	Reference1RM float64                           `json:"reference_1rm"`
+	Points       []*ExerciseMetricsComparisonPoint `json:"points"`
+}
+
+type MetricsHistoryAxisPoint struct {
+	X         string    `json:"x"`
+	Date      time.Time `json:"date"`
+	WorkoutID *int32    `json:"workout_id,omitempty"`
+}
+
+type ExercisesMetricsHistoryResponse struct {
+	Range  string                    `json:"range"`
+	From   string                    `json:"from,omitempty"`
+	To     string                    `json:"to,omitempty"`
+	Bucket MetricsHistoryBucket      `json:"bucket"`
+	Axis   []MetricsHistoryAxisPoint `json:"axis"`
+	Series []ExerciseMetricsSeries   `json:"series"`
+}
+
+// metricsHistorySession is one workout's point plus the working-set count
+// used to weight averages when sessions are bucketed together.
+type metricsHistorySession struct {
+	point       ExerciseMetricsHistoryPoint
+	workingSets int32
+}
+
+func (es *ExerciseService) GetExerciseMetricsHistory(ctx context.Context, req GetExerciseMetricsHistoryRequest) (*ExerciseMetricsHistoryResponse, error) {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
 		return nil, &apperrors.Unauthorized{Resource: "exercise", UserID: ""}
 	}
 
-	_, err := es.repo.GetExercise(ctx, exerciseID, userID)
+	_, err := es.repo.GetExercise(ctx, req.ExerciseID, userID)
 	if err != nil {
-		return nil, &apperrors.NotFound{Resource: "exercise", ID: fmt.Sprintf("%d", exerciseID)}
-	}
-
-	req := GetExerciseMetricsHistoryRequest{
-		ExerciseID: exerciseID,
-		Range:      r,
+		return nil, &apperrors.NotFound{Resource: "exercise", ID: fmt.Sprintf("%d", req.ExerciseID)}
 	}
 
 	// Handler validates, but keep this safe for internal callers.
-	if req.Range == "" {
+	if req.Range == "" && req.From == "" && req.To == "" {
 		req.Range = "M"
 	}
 
@@ -58,9 +101,181 @@ func (es *ExerciseService) GetExerciseMetricsHistory(ctx context.Context, exerci
 		return nil, fmt.Errorf("failed to get exercise metrics history: %w", err)
 	}
 
+	rng := req.Range
+	if rng == "" {
+		rng = metricsHistoryCustomRange
+	}
+
 	return &ExerciseMetricsHistoryResponse{
-		Range:  req.Range,
+		Range:  rng,
+		From:   req.From,
+		To:     req.To,
 		Bucket: bucket,
 		Points: points,
 	}, nil
 }
+
+// GetExercisesMetricsHistory returns metrics for several exercises on one
+// time axis, normalized to each exercise's historical 1RM.
+func (es *ExerciseService) GetExercisesMetricsHistory(ctx context.Context, req GetExercisesMetricsHistoryRequest) (*ExercisesMetricsHistoryResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
+		return nil, &apperrors.Unauthorized{Resource: "exercise", UserID: ""}
+	}
+
+	series := make([]ExerciseMetricsSeries, 0, len(req.ExerciseIDs))
+	historical := make([]*float64, 0, len(req.ExerciseIDs))
+	for _, exerciseID := range req.ExerciseIDs {
+		ex, err := es.repo.GetExercise(ctx, exerciseID, userID)
+		if err != nil {
+			return nil, &apperrors.NotFound{Resource: "exercise", ID: fmt.Sprintf("%d", exerciseID)}
+		}
+		oneRM, err := floatPtrFromNumeric(ex.Historical1rm)
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, ExerciseMetricsSeries{ExerciseID: ex.ID, Name: ex.Name})
+		historical = append(historical, oneRM)
+	}
+
+	// Handler validates, but keep this safe for internal callers.
+	if req.Range == "" && req.From == "" && req.To == "" {
+		req.Range = "M"
+	}
+
+	pointsByExercise, bucket, err := es.repo.GetExercisesMetricsHistory(ctx, req, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exercises metrics history: %w", err)
+	}
+
+	for i := range series {
+		points := pointsByExercise[series[i].ExerciseID]
+		series[i].Reference1RM = referenceOneRM(historical[i], points)
+	}
+	axis := alignMetricsSeries(series, pointsByExercise)
+
+	rng := req.Range
+	if rng == "" {
+		rng = metricsHistoryCustomRange
+	}
+
+	return &ExercisesMetricsHistoryResponse{
+		Range:  rng,
+		From:   req.From,
+		To:     req.To,
+		Bucket: bucket,
+		Axis:   axis,
+		Series: series,
+	}, nil
+}
+
+// referenceOneRM is the historical 1RM when one is set, otherwise the best
+// e1RM in the returned points.
+func referenceOneRM(historical *float64, points []ExerciseMetricsHistoryPoint) float64 {
+	if historical != nil && *historical > 0 {
+		return *historical
+	}
+	best := 0.0
+	for _, point := range points {
+		best = max(best, point.SessionBestE1RM)
+	}
+	return best
+}
+
+// alignMetricsSeries builds the shared axis from every series' points and
+// fills each series' Points to match it. Series must have Reference1RM set.
+func alignMetricsSeries(series []ExerciseMetricsSeries, pointsByExercise map[int32][]ExerciseMetricsHistoryPoint) []MetricsHistoryAxisPoint {
+	axis := make([]MetricsHistoryAxisPoint, 0)
+	seen := make(map[string]bool)
+	for _, s := range series {
+		for _, point := range pointsByExercise[s.ExerciseID] {
+			if seen[point.X] {
+				continue
+			}
+			seen[point.X] = true
+			axis = append(axis, MetricsHistoryAxisPoint{X: point.X, Date: point.Date, WorkoutID: point.WorkoutID})
+		}
+	}
+	sort.SliceStable(axis, func(i, j int) bool {
+		if !axis[i].Date.Equal(axis[j].Date) {
+			return axis[i].Date.Before(axis[j].Date)
+		}
+		if axis[i].WorkoutID != nil && axis[j].WorkoutID != nil {
+			return *axis[i].WorkoutID < *axis[j].WorkoutID
+		}
+		return false
+	})
+
+	index := make(map[string]int, len(axis))
+	for i, point := range axis {
+		index[point.X] = i
+	}
+	for i := range series {
+		series[i].Points = make([]*ExerciseMetricsComparisonPoint, len(axis))
+		for _, point := range pointsByExercise[series[i].ExerciseID] {
+			aligned := &ExerciseMetricsComparisonPoint{ExerciseMetricsHistoryPoint: point}
+			if series[i].Reference1RM > 0 {
+				aligned.PercentOf1RM = point.SessionBestE1RM / series[i].Reference1RM * 100
+			}
+			series[i].Points[index[point.X]] = aligned
+		}
+	}
+	return axis
+}
+
+func metricsHistoryBucketOrDefault(bucket MetricsHistoryBucket) MetricsHistoryBucket {
+	if bucket == "" {
+		return MetricsHistoryBucketWorkout
+	}
+	return bucket
+}
+
+// bucketMetricsHistory folds per-workout sessions into week (Monday start) or
+// month points. Bests take the max, volume is summed and averages are
+// weighted by working sets. Sessions must be in date order.
+func bucketMetricsHistory(sessions []metricsHistorySession, bucket MetricsHistoryBucket) []ExerciseMetricsHistoryPoint {
+	points := make([]ExerciseMetricsHistoryPoint, 0, len(sessions))
+	if bucket != MetricsHistoryBucketWeek && bucket != MetricsHistoryBucketMonth {
+		for _, session := range sessions {
+			points = append(points, session.point)
+		}
+		return points
+	}
+
+	var sets []int32
+	for _, session := range sessions {
+		start := metricsHistoryBucketStart(session.point.Date, bucket)
+		n := len(points)
+		if n == 0 || !points[n-1].Date.Equal(start) {
+			points = append(points, ExerciseMetricsHistoryPoint{
+				X:    start.Format(metricsHistoryDateLayout),
+				Date: start,
+			})
+			sets = append(sets, 0)
+			n++
+		}
+
+		p := &points[n-1]
+		p.SessionBestE1RM = max(p.SessionBestE1RM, session.point.SessionBestE1RM)
+		p.SessionBestIntensity = max(p.SessionBestIntensity, session.point.SessionBestIntensity)
+		p.TotalVolumeWorking += session.point.TotalVolumeWorking
+
+		total := sets[n-1] + session.workingSets
+		if total > 0 {
+			prev, added := float64(sets[n-1]), float64(session.workingSets)
+			p.SessionAvgE1RM = (p.SessionAvgE1RM*prev + session.point.SessionAvgE1RM*added) / float64(total)
+			p.SessionAvgIntensity = (p.SessionAvgIntensity*prev + session.point.SessionAvgIntensity*added) / float64(total)
+		}
+		sets[n-1] = total
+	}
+	return points
+}
+
+func metricsHistoryBucketStart(day time.Time, bucket MetricsHistoryBucket) time.Time {
+	year, month, date := day.Date()
+	if bucket == MetricsHistoryBucketMonth {
+		return time.Date(year, month, 1, 0, 0, 0, 0, day.Location())
+	}
+	offset := (int(day.Weekday()) + 6) % 7
+	return time.Date(year, month, date-offset, 0, 0, 0, 0, day.Location())
+}
@@ -2,6 +2,7 @@ package exercise
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log/slog"
 	"net/http"
@@ -14,6 +15,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExerciseHandler_GetExerciseMetricsHistory(t *testing.T) {
@@ -73,6 +75,56 @@ func TestExerciseHandler_GetExerciseMetricsHistory(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("200 custom dates by week", func(t *testing.T) {
+		mockRepo := new(MockExerciseRepository)
+		service := NewService(logger, mockRepo)
+		handler := NewHandler(logger, validate, service)
+
+		exerciseID := int32(1)
+		mockRepo.On("GetExercise", mock.Anything, exerciseID, userID).Return(db.Exercise{ID: exerciseID, Name: "Bench"}, nil)
+
+		wantReq := GetExerciseMetricsHistoryRequest{
+			ExerciseID: exerciseID,
+			From:       "2026-01-01",
+			To:         "2026-03-31",
+			Bucket:     MetricsHistoryBucketWeek,
+		}
+		mockRepo.
+			On("GetExerciseMetricsHistory", mock.Anything, wantReq, userID).
+			Return([]ExerciseMetricsHistoryPoint{}, MetricsHistoryBucketWeek, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/exercises/1/metrics-history?from=2026-01-01&to=2026-03-31&bucket=week", nil).WithContext(ctx)
+		req.SetPathValue("id", "1")
+
+		w := httptest.NewRecorder()
+		handler.GetExerciseMetricsHistory(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"range":"custom","from":"2026-01-01","to":"2026-03-31","bucket":"week","points":[]}`, w.Body.String())
+		mockRepo.AssertExpectations(t)
+	})
+
+	for name, query := range map[string]string{
+		"range with dates": "range=M&from=2026-01-01",
+		"bad date":         "from=01/02/2026",
+		"reversed dates":   "from=2026-03-01&to=2026-02-01",
+		"unknown bucket":   "bucket=day",
+	} {
+		t.Run("400 "+name, func(t *testing.T) {
+			mockRepo := new(MockExerciseRepository)
+			handler := NewHandler(logger, validate, NewService(logger, mockRepo))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/exercises/1/metrics-history?"+query, nil).WithContext(ctx)
+			req.SetPathValue("id", "1")
+
+			w := httptest.NewRecorder()
+			handler.GetExerciseMetricsHistory(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockRepo.AssertNotCalled(t, "GetExerciseMetricsHistory")
+		})
+	}
+
 	t.Run("404 when exercise missing", func(t *testing.T) {
 		mockRepo := new(MockExerciseRepository)
 		service := NewService(logger, mockRepo)
@@ -96,3 +148,95 @@ type ioDiscard struct{}
 func (ioDiscard) Write(p []byte) (int, error) { return len(p), nil }
 
 func ptrInt32(v int32) *int32 { return &v }
+
+func TestExerciseHandler_GetExercisesMetricsHistory(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(ioDiscard{}, nil))
+	validate := validator.New()
+
+	userID := "user-123"
+	ctx := context.WithValue(context.Background(), user.UserIDKey, userID)
+
+	t.Run("200 aligns series and normalizes to 1RM", func(t *testing.T) {
+		mockRepo := new(MockExerciseRepository)
+		handler := NewHandler(logger, validate, NewService(logger, mockRepo))
+
+		mockRepo.On("GetExercise", mock.Anything, int32(1), userID).
+			Return(db.Exercise{ID: 1, Name: "Back Squat", Historical1rm: mustNumeric(t, "400")}, nil)
+		mockRepo.On("GetExercise", mock.Anything, int32(2), userID).
+			Return(db.Exercise{ID: 2, Name: "Front Squat"}, nil)
+
+		wantReq := GetExercisesMetricsHistoryRequest{ExerciseIDs: []int32{1, 2}, Range: "6M"}
+		mockRepo.
+			On("GetExercisesMetricsHistory", mock.Anything, wantReq, userID).
+			Return(map[int32][]ExerciseMetricsHistoryPoint{
+				1: {
+					{X: "10", Date: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), WorkoutID: ptrInt32(10), SessionBestE1RM: 300},
+					{X: "12", Date: time.Date(2026, 2, 8, 0, 0, 0, 0, time.UTC), WorkoutID: ptrInt32(12), SessionBestE1RM: 320},
+				},
+				2: {
+					{X: "11", Date: time.Date(2026, 2, 4, 0, 0, 0, 0, time.UTC), WorkoutID: ptrInt32(11), SessionBestE1RM: 200},
+					{X: "12", Date: time.Date(2026, 2, 8, 0, 0, 0, 0, time.UTC), WorkoutID: ptrInt32(12), SessionBestE1RM: 250},
+				},
+			}, MetricsHistoryBucketWorkout, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/exercises/metrics-history?ids=1,2&range=6M", nil).WithContext(ctx)
+		w := httptest.NewRecorder()
+		handler.GetExercisesMetricsHistory(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got ExercisesMetricsHistoryResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, []string{"10", "11", "12"}, []string{got.Axis[0].X, got.Axis[1].X, got.Axis[2].X})
+		require.Len(t, got.Series, 2)
+
+		squat := got.Series[0]
+		assert.Equal(t, 400.0, squat.Reference1RM)
+		require.Len(t, squat.Points, 3)
+		assert.Equal(t, 75.0, squat.Points[0].PercentOf1RM)
+		assert.Nil(t, squat.Points[1])
+		assert.Equal(t, 80.0, squat.Points[2].PercentOf1RM)
+
+		front := got.Series[1]
+		assert.Equal(t, "Front Squat", front.Name)
+		assert.Equal(t, 250.0, front.Reference1RM, "falls back to the best e1RM without a historical 1RM")
+		assert.Nil(t, front.Points[0])
+		assert.Equal(t, 80.0, front.Points[1].PercentOf1RM)
+		assert.Equal(t, 100.0, front.Points[2].PercentOf1RM)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("404 when an exercise is missing", func(t *testing.T) {
+		mockRepo := new(MockExerciseRepository)
+		handler := NewHandler(logger, validate, NewService(logger, mockRepo))
+
+		mockRepo.On("GetExercise", mock.Anything, int32(1), userID).Return(db.Exercise{ID: 1, Name: "Bench"}, nil)
+		mockRepo.On("GetExercise", mock.Anything, int32(99), userID).Return(db.Exercise{}, errors.New("missing"))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/exercises/metrics-history?ids=1,99", nil).WithContext(ctx)
+		w := httptest.NewRecorder()
+		handler.GetExercisesMetricsHistory(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockRepo.AssertNotCalled(t, "GetExercisesMetricsHistory")
+	})
+
+	for name, query := range map[string]string{
+		"missing ids":    "",
+		"bad id":         "ids=1,abc",
+		"duplicate ids":  "ids=1,1",
+		"too many ids":   "ids=1,2,3,4,5,6,7,8,9",
+		"unknown bucket": "ids=1,2&bucket=year",
+	} {
+		t.Run("400 "+name, func(t *testing.T) {
+			mockRepo := new(MockExerciseRepository)
+			handler := NewHandler(logger, validate, NewService(logger, mockRepo))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/exercises/metrics-history?"+query, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+			handler.GetExercisesMetricsHistory(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockRepo.AssertNotCalled(t, "GetExercise")
+		})
+	}
+}
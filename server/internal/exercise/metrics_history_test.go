@@ -0,0 +1,80 @@
+package exercise
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketMetricsHistory(t *testing.T) {
+	day := func(month time.Month, d int) time.Time { return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC) }
+	sessions := []metricsHistorySession{
+		// Thursday and Saturday of the same week, then the next Monday.
+		{point: ExerciseMetricsHistoryPoint{X: "1", Date: day(time.January, 29), SessionBestE1RM: 200, SessionAvgE1RM: 180, SessionAvgIntensity: 80, SessionBestIntensity: 90, TotalVolumeWorking: 3000}, workingSets: 3},
+		{point: ExerciseMetricsHistoryPoint{X: "2", Date: day(time.January, 31), SessionBestE1RM: 210, SessionAvgE1RM: 200, SessionAvgIntensity: 90, SessionBestIntensity: 95, TotalVolumeWorking: 1000}, workingSets: 1},
+		{point: ExerciseMetricsHistoryPoint{X: "3", Date: day(time.February, 2), SessionBestE1RM: 190, SessionAvgE1RM: 170, SessionAvgIntensity: 75, SessionBestIntensity: 85, TotalVolumeWorking: 2000}, workingSets: 2},
+	}
+
+	t.Run("workout keeps sessions", func(t *testing.T) {
+		points := bucketMetricsHistory(sessions, MetricsHistoryBucketWorkout)
+
+		require.Len(t, points, 3)
+		assert.Equal(t, "2", points[1].X)
+	})
+
+	t.Run("week starts on monday", func(t *testing.T) {
+		points := bucketMetricsHistory(sessions, MetricsHistoryBucketWeek)
+
+		require.Len(t, points, 2)
+		assert.Equal(t, ExerciseMetricsHistoryPoint{
+			X:                    "2026-01-26",
+			Date:                 day(time.January, 26),
+			SessionBestE1RM:      210,
+			SessionAvgE1RM:       185,
+			SessionAvgIntensity:  82.5,
+			SessionBestIntensity: 95,
+			TotalVolumeWorking:   4000,
+		}, points[0])
+		assert.Equal(t, "2026-02-02", points[1].X)
+		assert.Nil(t, points[1].WorkoutID)
+	})
+
+	t.Run("month", func(t *testing.T) {
+		points := bucketMetricsHistory(sessions, MetricsHistoryBucketMonth)
+
+		require.Len(t, points, 2)
+		assert.Equal(t, "2026-01-01", points[0].X)
+		assert.Equal(t, 4000.0, points[0].TotalVolumeWorking)
+		assert.Equal(t, "2026-02-01", points[1].X)
+		assert.Equal(t, 190.0, points[1].SessionBestE1RM)
+	})
+}
+
+func TestMetricsHistoryParams(t *testing.T) {
+	t.Run("range code sets a lookback", func(t *testing.T) {
+		params, err := metricsHistoryParams([]int32{1}, "user-1", "6M", "", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, pgtype.Interval{Months: 6, Valid: true}, params.Lookback)
+		assert.False(t, params.FromDay.Valid)
+		assert.False(t, params.ToDay.Valid)
+	})
+
+	t.Run("custom dates", func(t *testing.T) {
+		params, err := metricsHistoryParams([]int32{1}, "user-1", "", "2026-01-01", "")
+
+		require.NoError(t, err)
+		assert.False(t, params.Lookback.Valid)
+		assert.Equal(t, pgtype.Date{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}, params.FromDay)
+		assert.False(t, params.ToDay.Valid)
+	})
+
+	t.Run("unknown range", func(t *testing.T) {
+		_, err := metricsHistoryParams([]int32{1}, "user-1", "Q", "", "")
+
+		assert.Error(t, err)
+	})
+}
@@ -284,129 +284,115 @@ func (er *exerciseRepository) GetRecentSetsForExercise(ctx context.Context, id i
 }
 
 func (er *exerciseRepository) GetExerciseMetricsHistory(ctx context.Context, req GetExerciseMetricsHistoryRequest, userID string) ([]ExerciseMetricsHistoryPoint, MetricsHistoryBucket, error) {
+	params, err := metricsHistoryParams([]int32{req.ExerciseID}, userID, req.Range, req.From, req.To)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sessions, err := er.metricsHistorySessions(ctx, params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	bucket := metricsHistoryBucketOrDefault(req.Bucket)
+	return bucketMetricsHistory(sessions[req.ExerciseID], bucket), bucket, nil
+}
+
+func (er *exerciseRepository) GetExercisesMetricsHistory(ctx context.Context, req GetExercisesMetricsHistoryRequest, userID string) (map[int32][]ExerciseMetricsHistoryPoint, MetricsHistoryBucket, error) {
+	params, err := metricsHistoryParams(req.ExerciseIDs, userID, req.Range, req.From, req.To)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sessions, err := er.metricsHistorySessions(ctx, params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	bucket := metricsHistoryBucketOrDefault(req.Bucket)
+	points := make(map[int32][]ExerciseMetricsHistoryPoint, len(req.ExerciseIDs))
+	for _, exerciseID := range req.ExerciseIDs {
+		points[exerciseID] = bucketMetricsHistory(sessions[exerciseID], bucket)
+	}
+	return points, bucket, nil
+}
+
+// metricsHistorySessions runs the metrics history query and groups the
+// per-workout sessions by exercise, keeping date order.
+func (er *exerciseRepository) metricsHistorySessions(ctx context.Context, params db.GetExerciseMetricsHistoryRawParams) (map[int32][]metricsHistorySession, error) {
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	switch req.Range {
-	case "W":
-		{
-			rows, err := er.queries.GetExerciseMetricsHistoryRawWeek(ctx, db.GetExerciseMetricsHistoryRawWeekParams{
-				ExerciseID: req.ExerciseID,
-				UserID:     userID,
-			})
-			if err != nil {
-				return nil, "", fmt.Errorf("get exercise metrics history raw week query failed: %w", err)
-			}
-
-			points := make([]ExerciseMetricsHistoryPoint, 0, len(rows))
-			for _, row := range rows {
-				workoutID := row.WorkoutID
-				if !row.WorkoutDay.Valid {
-					continue
-				}
-				points = append(points, ExerciseMetricsHistoryPoint{
-					X:                    fmt.Sprintf("%d", workoutID),
-					Date:                 row.WorkoutDay.Time,
-					WorkoutID:            &workoutID,
-					SessionBestE1RM:      row.SessionBestE1rm,
-					SessionAvgE1RM:       row.SessionAvgE1rm,
-					SessionAvgIntensity:  row.SessionAvgIntensity,
-					SessionBestIntensity: row.SessionBestIntensity,
-					TotalVolumeWorking:   row.TotalVolumeWorking,
-				})
-			}
-			return points, MetricsHistoryBucketWorkout, nil
+	rows, err := er.queries.GetExerciseMetricsHistoryRaw(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("get exercise metrics history raw query failed: %w", err)
+	}
+
+	sessions := make(map[int32][]metricsHistorySession, len(params.ExerciseIds))
+	for _, row := range rows {
+		workoutID := row.WorkoutID
+		if !row.WorkoutDay.Valid {
+			continue
 		}
+		sessions[row.ExerciseID] = append(sessions[row.ExerciseID], metricsHistorySession{
+			point: ExerciseMetricsHistoryPoint{
+				X:                    fmt.Sprintf("%d", workoutID),
+				Date:                 row.WorkoutDay.Time,
+				WorkoutID:            &workoutID,
+				SessionBestE1RM:      row.SessionBestE1rm,
+				SessionAvgE1RM:       row.SessionAvgE1rm,
+				SessionAvgIntensity:  row.SessionAvgIntensity,
+				SessionBestIntensity: row.SessionBestIntensity,
+				TotalVolumeWorking:   row.TotalVolumeWorking,
+			},
+			workingSets: row.WorkingSetCount,
+		})
+	}
+	return sessions, nil
+}
+
+// metricsHistoryParams turns a range code and optional from/to days into
+// query bounds. Range windows end at the latest session, not today.
+func metricsHistoryParams(exerciseIDs []int32, userID, rng, from, to string) (db.GetExerciseMetricsHistoryRawParams, error) {
+	params := db.GetExerciseMetricsHistoryRawParams{
+		ExerciseIds: exerciseIDs,
+		UserID:      userID,
+	}
+
+	switch rng {
+	case "":
+		// Custom window: only from/to apply.
+	case "W":
+		params.Lookback = pgtype.Interval{Days: 7, Valid: true}
 	case "M":
-		{
-			rows, err := er.queries.GetExerciseMetricsHistoryRawMonth(ctx, db.GetExerciseMetricsHistoryRawMonthParams{
-				ExerciseID: req.ExerciseID,
-				UserID:     userID,
-			})
-			if err != nil {
-				return nil, "", fmt.Errorf("get exercise metrics history raw month query failed: %w", err)
-			}
-
-			points := make([]ExerciseMetricsHistoryPoint, 0, len(rows))
-			for _, row := range rows {
-				workoutID := row.WorkoutID
-				if !row.WorkoutDay.Valid {
-					continue
-				}
-				points = append(points, ExerciseMetricsHistoryPoint{
-					X:                    fmt.Sprintf("%d", workoutID),
-					Date:                 row.WorkoutDay.Time,
-					WorkoutID:            &workoutID,
-					SessionBestE1RM:      row.SessionBestE1rm,
-					SessionAvgE1RM:       row.SessionAvgE1rm,
-					SessionAvgIntensity:  row.SessionAvgIntensity,
-					SessionBestIntensity: row.SessionBestIntensity,
-					TotalVolumeWorking:   row.TotalVolumeWorking,
-				})
-			}
-			return points, MetricsHistoryBucketWorkout, nil
-		}
+		params.Lookback = pgtype.Interval{Days: 30, Valid: true}
 	case "6M":
-		{
-			rows, err := er.queries.GetExerciseMetricsHistoryRaw6M(ctx, db.GetExerciseMetricsHistoryRaw6MParams{
-				ExerciseID: req.ExerciseID,
-				UserID:     userID,
-			})
-			if err != nil {
-				return nil, "", fmt.Errorf("get exercise metrics history raw 6M query failed: %w", err)
-			}
-
-			points := make([]ExerciseMetricsHistoryPoint, 0, len(rows))
-			for _, row := range rows {
-				workoutID := row.WorkoutID
-				if !row.WorkoutDay.Valid {
-					continue
-				}
-				points = append(points, ExerciseMetricsHistoryPoint{
-					X:                    fmt.Sprintf("%d", workoutID),
-					Date:                 row.WorkoutDay.Time,
-					WorkoutID:            &workoutID,
-					SessionBestE1RM:      row.SessionBestE1rm,
-					SessionAvgE1RM:       row.SessionAvgE1rm,
-					SessionAvgIntensity:  row.SessionAvgIntensity,
-					SessionBestIntensity: row.SessionBestIntensity,
-					TotalVolumeWorking:   row.TotalVolumeWorking,
-				})
-			}
-			return points, MetricsHistoryBucketWorkout, nil
-		}
+		params.Lookback = pgtype.Interval{Months: 6, Valid: true}
 	case "Y":
-		{
-			rows, err := er.queries.GetExerciseMetricsHistoryRawYear(ctx, db.GetExerciseMetricsHistoryRawYearParams{
-				ExerciseID: req.ExerciseID,
-				UserID:     userID,
-			})
-			if err != nil {
-				return nil, "", fmt.Errorf("get exercise metrics history raw year query failed: %w", err)
-			}
-
-			points := make([]ExerciseMetricsHistoryPoint, 0, len(rows))
-			for _, row := range rows {
-				workoutID := row.WorkoutID
-				if !row.WorkoutDay.Valid {
-					continue
-				}
-				points = append(points, ExerciseMetricsHistoryPoint{
-					X:                    fmt.Sprintf("%d", workoutID),
-					Date:                 row.WorkoutDay.Time,
-					WorkoutID:            &workoutID,
-					SessionBestE1RM:      row.SessionBestE1rm,
-					SessionAvgE1RM:       row.SessionAvgE1rm,
-					SessionAvgIntensity:  row.SessionAvgIntensity,
-					SessionBestIntensity: row.SessionBestIntensity,
-					TotalVolumeWorking:   row.TotalVolumeWorking,
-				})
-			}
-			return points, MetricsHistoryBucketWorkout, nil
-		}
+		params.Lookback = pgtype.Interval{Months: 12, Valid: true}
 	default:
-		return nil, "", fmt.Errorf("invalid range: %q", req.Range)
+		return params, fmt.Errorf("invalid range: %q", rng)
 	}
+
+	var err error
+	if params.FromDay, err = metricsHistoryDay(from); err != nil {
+		return params, fmt.Errorf("invalid from date: %w", err)
+	}
+	if params.ToDay, err = metricsHistoryDay(to); err != nil {
+		return params, fmt.Errorf("invalid to date: %w", err)
+	}
+	return params, nil
+}
+
+func metricsHistoryDay(value string) (pgtype.Date, error) {
+	if value == "" {
+		return pgtype.Date{}, nil
+	}
+	day, err := time.Parse(metricsHistoryDateLayout, value)
+	if err != nil {
+		return pgtype.Date{}, err
+	}
+	return pgtype.Date{Time: day, Valid: true}, nil
 }
 
 func (er *exerciseRepository) UpdateExerciseName(ctx context.Context, id int32, name, userID string) error {
@@ -552,4 +538,64 @@ func (er *exerciseRepository) DeleteExercise(ctx context.Context, id int32, user
 	return nil
 }
 
+func (er *exerciseRepository) CreateExerciseNote(ctx context.Context, id int32, notes, settings *string, userID string) (db.ExerciseNote, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	note, err := er.queries.CreateExerciseNote(ctx, db.CreateExerciseNoteParams{
+		Notes:      textFromPtr(notes),
+		Settings:   textFromPtr(settings),
+		ExerciseID: id,
+		UserID:     userID,
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			er.logger.Error("create exercise note failed - RLS policy violation",
+				"error", err,
+				"exercise_id", id,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		} else {
+			er.logger.Error("create exercise note failed",
+				"exercise_id", id,
+				"user_id", userID,
+				"error", err)
+		}
+		return db.ExerciseNote{}, fmt.Errorf("failed to create exercise note (id: %d): %w", id, err)
+	}
+
+	return note, nil
+}
+
+func (er *exerciseRepository) ListExerciseNoteHistory(ctx context.Context, id int32, limit int32, userID string) ([]db.ExerciseNote, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	notes, err := er.queries.ListExerciseNoteHistory(ctx, db.ListExerciseNoteHistoryParams{
+		ExerciseID: id,
+		UserID:     userID,
+		Limit:      limit,
+	})
+	if err != nil {
+		er.logger.Error("list exercise note history failed",
+			"exercise_id", id,
+			"user_id", userID,
+			"error", err)
+		return nil, fmt.Errorf("failed to list exercise note history (id: %d): %w", id, err)
+	}
+
+	if notes == nil {
+		notes = []db.ExerciseNote{}
+	}
+
+	return notes, nil
+}
+
+func textFromPtr(val *string) pgtype.Text {
+	if val == nil {
+		return pgtype.Text{Valid: false}
+	}
+	return pgtype.Text{String: *val, Valid: true}
+}
+
 var _ ExerciseRepository = (*exerciseRepository)(nil)
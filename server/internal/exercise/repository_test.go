@@ -49,6 +49,11 @@ func (m *MockExerciseRepositoryForTest) GetExerciseMetricsHistory(ctx context.Co
 	return args.Get(0).([]ExerciseMetricsHistoryPoint), args.Get(1).(MetricsHistoryBucket), args.Error(2)
 }
 
+func (m *MockExerciseRepositoryForTest) GetExercisesMetricsHistory(ctx context.Context, req GetExercisesMetricsHistoryRequest, userID string) (map[int32][]ExerciseMetricsHistoryPoint, MetricsHistoryBucket, error) {
+	args := m.Called(ctx, req, userID)
+	return args.Get(0).(map[int32][]ExerciseMetricsHistoryPoint), args.Get(1).(MetricsHistoryBucket), args.Error(2)
+}
+
 func TestExerciseRepository_GetRecentSetsForExercise(t *testing.T) {
 	mockRepo := &MockExerciseRepositoryForTest{}
 
@@ -9,6 +9,7 @@ import (
 
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/strength"
 	"github.com/Andrewy-gh/fittrack/server/internal/user"
 	"github.com/jackc/pgx/v5"
 )
@@ -22,16 +23,26 @@ type ExerciseRepository interface {
 	GetExerciseWithSets(ctx context.Context, id int32, userID string) ([]db.GetExerciseWithSetsRow, error)
 	GetRecentSetsForExercise(ctx context.Context, id int32, userID string) ([]db.GetRecentSetsForExerciseRow, error)
 	GetExerciseMetricsHistory(ctx context.Context, req GetExerciseMetricsHistoryRequest, userID string) ([]ExerciseMetricsHistoryPoint, MetricsHistoryBucket, error)
+	GetExercisesMetricsHistory(ctx context.Context, req GetExercisesMetricsHistoryRequest, userID string) (map[int32][]ExerciseMetricsHistoryPoint, MetricsHistoryBucket, error)
 	UpdateExerciseName(ctx context.Context, id int32, name, userID string) error
 	GetExerciseBestE1rmWithWorkout(ctx context.Context, exerciseID int32, userID string) (db.GetExerciseBestE1rmWithWorkoutRow, error)
 	UpdateExerciseHistorical1RMManual(ctx context.Context, id int32, historical1rm *float64, userID string) error
 	SetExerciseHistorical1RM(ctx context.Context, id int32, historical1rm *float64, sourceWorkoutID *int32, userID string) error
 	DeleteExercise(ctx context.Context, id int32, userID string) error
+	CreateExerciseNote(ctx context.Context, id int32, notes, settings *string, userID string) (db.ExerciseNote, error)
+	ListExerciseNoteHistory(ctx context.Context, id int32, limit int32, userID string) ([]db.ExerciseNote, error)
+}
+
+// strengthClassifier classifies a designated lift's best e1RM against the
+// strength standards. It returns nil when the exercise is not designated.
+type strengthClassifier interface {
+	ClassifyExercise(ctx context.Context, exerciseID int32, bestE1RM float64) (*strength.LiftClassification, error)
 }
 
 type ExerciseService struct {
-	logger *slog.Logger
-	repo   ExerciseRepository
+	logger     *slog.Logger
+	repo       ExerciseRepository
+	classifier strengthClassifier
 }
 
 func NewService(logger *slog.Logger, repo ExerciseRepository) *ExerciseService {
@@ -41,9 +52,13 @@ func NewService(logger *slog.Logger, repo ExerciseRepository) *ExerciseService {
 	}
 }
 
+func (es *ExerciseService) SetStrengthClassifier(classifier strengthClassifier) {
+	es.classifier = classifier
+}
+
 func (es *ExerciseService) ListExercises(ctx context.Context) ([]db.Exercise, error) {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
 		return nil, &apperrors.Unauthorized{Resource: "exercise", UserID: ""}
 	}
 
@@ -56,7 +71,7 @@ func (es *ExerciseService) ListExercises(ctx context.Context) ([]db.Exercise, er
 
 func (es *ExerciseService) GetExerciseWithSets(ctx context.Context, id int32) (*ExerciseDetailResponse, error) {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
 		return nil, &apperrors.Unauthorized{Resource: "exercise", UserID: ""}
 	}
 
@@ -100,6 +115,21 @@ func (es *ExerciseService) GetExerciseWithSets(ctx context.Context, id int32) (*
 		historical1rmSourceWorkoutID = &id
 	}
 
+	var notesUpdatedAt *time.Time
+	if exercise.NotesUpdatedAt.Valid {
+		t := exercise.NotesUpdatedAt.Time
+		notesUpdatedAt = &t
+	}
+
+	var classification *strength.LiftClassification
+	if es.classifier != nil && bestE1RM != nil {
+		classification, err = es.classifier.ClassifyExercise(ctx, id, *bestE1RM)
+		if err != nil {
+			es.logger.Warn("failed to classify exercise strength", "error", err, "exercise_id", id)
+			classification = nil
+		}
+	}
+
 	return &ExerciseDetailResponse{
 		Exercise: ExerciseDetailExerciseResponse{
 			ID:                           exercise.ID,
@@ -111,6 +141,10 @@ func (es *ExerciseService) GetExerciseWithSets(ctx context.Context, id int32) (*
 			Historical1RMUpdatedAt:       historical1rmUpdatedAt,
 			Historical1RMSourceWorkoutID: historical1rmSourceWorkoutID,
 			BestE1RM:                     bestE1RM,
+			StrengthClassification:       classification,
+			Notes:                        textPtr(exercise.Notes),
+			Settings:                     textPtr(exercise.Settings),
+			NotesUpdatedAt:               notesUpdatedAt,
 		},
 		Sets: setResponses,
 	}, nil
@@ -118,7 +152,7 @@ func (es *ExerciseService) GetExerciseWithSets(ctx context.Context, id int32) (*
 
 func (es *ExerciseService) GetOrCreateExercise(ctx context.Context, name string) (*db.Exercise, error) {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || user.IsDelegated(ctx) {
 		return nil, &apperrors.Unauthorized{Resource: "exercise", UserID: ""}
 	}
 
@@ -131,7 +165,7 @@ func (es *ExerciseService) GetOrCreateExercise(ctx context.Context, name string)
 
 func (es *ExerciseService) GetRecentSetsForExercise(ctx context.Context, id int32) ([]db.GetRecentSetsForExerciseRow, error) {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
 		return nil, &apperrors.Unauthorized{Resource: "exercise", UserID: ""}
 	}
 
@@ -145,7 +179,7 @@ func (es *ExerciseService) GetRecentSetsForExercise(ctx context.Context, id int3
 // MARK: UpdateExerciseName
 func (es *ExerciseService) UpdateExerciseName(ctx context.Context, id int32, name string) error {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || user.IsDelegated(ctx) {
 		return &apperrors.Unauthorized{Resource: "exercise", UserID: ""}
 	}
 
@@ -167,7 +201,7 @@ func (es *ExerciseService) UpdateExerciseName(ctx context.Context, id int32, nam
 // MARK: DeleteExercise
 func (es *ExerciseService) DeleteExercise(ctx context.Context, id int32) error {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || user.IsDelegated(ctx) {
 		return &apperrors.Unauthorized{Resource: "exercise", UserID: ""}
 	}
 
@@ -228,6 +262,12 @@ func (es *ExerciseService) convertExerciseWithSetsRows(rows []db.GetExerciseWith
 			workoutNotes = &row.WorkoutNotes.String
 		}
 
+		// Convert set notes from pgtype.Text to *string
+		var setNotes *string
+		if row.SetNotes.Valid {
+			setNotes = &row.SetNotes.String
+		}
+
 		response[i] = ExerciseWithSetsResponse{
 			WorkoutID:     row.WorkoutID,
 			WorkoutDate:   row.WorkoutDate.Time,
@@ -236,6 +276,7 @@ func (es *ExerciseService) convertExerciseWithSetsRows(rows []db.GetExerciseWith
 			Weight:        weight,
 			Reps:          row.Reps,
 			SetType:       row.SetType,
+			SetNotes:      setNotes,
 			ExerciseID:    row.ExerciseID,
 			ExerciseName:  row.ExerciseName,
 			ExerciseOrder: exerciseOrder,
@@ -9,8 +9,10 @@ import (
 
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/strength"
 	"github.com/Andrewy-gh/fittrack/server/internal/user"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -97,6 +99,68 @@ func TestExerciseService_GetExerciseWithSets_LookupErrorClassification(t *testin
 	}
 }
 
+type stubStrengthClassifier struct {
+	classification *strength.LiftClassification
+	err            error
+	bestE1RM       float64
+}
+
+func (s *stubStrengthClassifier) ClassifyExercise(_ context.Context, _ int32, bestE1RM float64) (*strength.LiftClassification, error) {
+	s.bestE1RM = bestE1RM
+	return s.classification, s.err
+}
+
+func TestExerciseService_GetExerciseWithSets_StrengthClassification(t *testing.T) {
+	userID := "user-123"
+	exerciseID := int32(1)
+
+	var bestE1RM pgtype.Numeric
+	if err := bestE1RM.Scan("300.00"); err != nil {
+		t.Fatalf("scan numeric: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		classifier *stubStrengthClassifier
+		wantLevel  string
+	}{
+		{
+			name:       "attaches classification",
+			classifier: &stubStrengthClassifier{classification: &strength.LiftClassification{Lift: strength.LiftSquat, Level: strength.LevelIntermediate}},
+			wantLevel:  strength.LevelIntermediate,
+		},
+		{
+			name:       "classifier errors are not fatal",
+			classifier: &stubStrengthClassifier{err: assert.AnError},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockExerciseRepository)
+			mockRepo.On("GetExerciseDetail", mock.Anything, exerciseID, userID).Return(db.GetExerciseDetailRow{ID: exerciseID, BestE1rm: bestE1RM}, nil)
+			mockRepo.On("GetExerciseWithSets", mock.Anything, exerciseID, userID).Return([]db.GetExerciseWithSetsRow{}, nil)
+
+			service := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepo)
+			service.SetStrengthClassifier(tt.classifier)
+			ctx := context.WithValue(context.Background(), user.UserIDKey, userID)
+
+			detail, err := service.GetExerciseWithSets(ctx, exerciseID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assert.Equal(t, 300.0, tt.classifier.bestE1RM)
+			if tt.wantLevel == "" {
+				assert.Nil(t, detail.Exercise.StrengthClassification)
+			} else if assert.NotNil(t, detail.Exercise.StrengthClassification) {
+				assert.Equal(t, tt.wantLevel, detail.Exercise.StrengthClassification.Level)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestExerciseService_DeleteExercise(t *testing.T) {
 	tests := []struct {
 		name        string
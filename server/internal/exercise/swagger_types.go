@@ -1,6 +1,10 @@
 package exercise
 
-import "time"
+import (
+	"time"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/strength"
+)
 
 // ExerciseResponse represents an exercise response for swagger documentation
 type ExerciseResponse struct {
@@ -20,6 +24,7 @@ type ExerciseWithSetsResponse struct {
 	Weight        *float64  `json:"weight,omitempty" example:"225.5"`
 	Reps          int32     `json:"reps" validate:"required" example:"10"`
 	SetType       string    `json:"set_type" validate:"required" example:"working"`
+	SetNotes      *string   `json:"set_notes,omitempty" example:"Belt on, felt fast"`
 	ExerciseID    int32     `json:"exercise_id" validate:"required" example:"1"`
 	ExerciseName  string    `json:"exercise_name" validate:"required" example:"Bench Press"`
 	ExerciseOrder *int32    `json:"exercise_order,omitempty" example:"0"`
@@ -39,6 +44,15 @@ type ExerciseDetailExerciseResponse struct {
 	Historical1RMUpdatedAt       *time.Time `json:"historical_1rm_updated_at,omitempty" example:"2023-01-01T15:04:05Z"`
 	Historical1RMSourceWorkoutID *int32     `json:"historical_1rm_source_workout_id,omitempty" example:"42"`
 	BestE1RM                     *float64   `json:"best_e1rm,omitempty" example:"305.0"`
+
+	// StrengthClassification is set when the exercise is the user's
+	// designated squat, bench or deadlift and a sex and bodyweight are known.
+	StrengthClassification *strength.LiftClassification `json:"strength_classification,omitempty"`
+
+	// Notes and Settings come from the latest saved exercise note revision.
+	Notes          *string    `json:"notes,omitempty" example:"Grip just outside the rings"`
+	Settings       *string    `json:"settings,omitempty" example:"Seat height 4"`
+	NotesUpdatedAt *time.Time `json:"notes_updated_at,omitempty" example:"2023-01-01T15:04:05Z"`
 }
 
 // ExerciseDetailResponse is the response for GET /exercises/{id}.
@@ -66,4 +80,22 @@ type RecentSetsResponse struct {
 	ExerciseOrder *int32    `json:"exercise_order,omitempty" example:"0"`
 	SetOrder      *int32    `json:"set_order,omitempty" example:"2"`
 	CreatedAt     time.Time `json:"created_at" validate:"required" example:"2023-01-01T15:04:05Z"`
+
+	ExerciseNotes    *string `json:"exercise_notes,omitempty" example:"Grip just outside the rings"`
+	ExerciseSettings *string `json:"exercise_settings,omitempty" example:"Seat height 4"`
+}
+
+// ExerciseNoteResponse is one saved revision of an exercise's notes and settings.
+type ExerciseNoteResponse struct {
+	ID        int32     `json:"id" validate:"required" example:"1"`
+	Notes     *string   `json:"notes,omitempty" example:"Grip just outside the rings"`
+	Settings  *string   `json:"settings,omitempty" example:"Seat height 4"`
+	CreatedAt time.Time `json:"created_at" validate:"required" example:"2023-01-01T15:04:05Z"`
+}
+
+// ExerciseNotesResponse is the response for GET and PUT /exercises/{id}/notes.
+// Current is omitted when nothing is saved or the latest revision cleared both fields.
+type ExerciseNotesResponse struct {
+	Current *ExerciseNoteResponse  `json:"current,omitempty"`
+	History []ExerciseNoteResponse `json:"history" validate:"required"`
 }
@@ -13,8 +13,19 @@ type GetRecentSetsRequest struct {
 }
 
 type GetExerciseMetricsHistoryRequest struct {
-	ExerciseID int32  `json:"exercise_id" validate:"required,min=1"`
-	Range      string `json:"range" validate:"required,oneof=W M 6M Y"`
+	ExerciseID int32                `json:"exercise_id" validate:"required,min=1"`
+	Range      string               `json:"range" validate:"omitempty,oneof=W M 6M Y"`
+	From       string               `json:"from" validate:"omitempty,datetime=2006-01-02"`
+	To         string               `json:"to" validate:"omitempty,datetime=2006-01-02"`
+	Bucket     MetricsHistoryBucket `json:"bucket" validate:"omitempty,oneof=workout week month"`
+}
+
+type GetExercisesMetricsHistoryRequest struct {
+	ExerciseIDs []int32              `json:"exercise_ids" validate:"required,min=1,max=8,unique,dive,min=1"`
+	Range       string               `json:"range" validate:"omitempty,oneof=W M 6M Y"`
+	From        string               `json:"from" validate:"omitempty,datetime=2006-01-02"`
+	To          string               `json:"to" validate:"omitempty,datetime=2006-01-02"`
+	Bucket      MetricsHistoryBucket `json:"bucket" validate:"omitempty,oneof=workout week month"`
 }
 
 type UpdateExerciseNameRequest struct {
@@ -25,3 +36,8 @@ type UpdateExerciseHistorical1RMRequest struct {
 	Mode          string   `json:"mode" validate:"omitempty,oneof=manual recompute"`
 	Historical1RM *float64 `json:"historical_1rm" validate:"omitempty,gte=0,lte=999999.99"`
 }
+
+type UpdateExerciseNotesRequest struct {
+	Notes    *string `json:"notes" validate:"omitempty,max=2000"`
+	Settings *string `json:"settings" validate:"omitempty,max=500"`
+}
@@ -0,0 +1,164 @@
+package goals
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type goalsService interface {
+	List(ctx context.Context) ([]GoalResponse, error)
+	Create(ctx context.Context, req CreateGoalRequest) (*GoalResponse, error)
+	Update(ctx context.Context, id int32, req UpdateGoalRequest) (*GoalResponse, error)
+	Delete(ctx context.Context, id int32) error
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service goalsService
+}
+
+func NewHandler(logger *slog.Logger, service goalsService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// ListGoals godoc
+// @Summary List goals
+// @Description Returns the authenticated user's goals with current progress, a projected attainment date fitted from recent data, and a status of achieved, on_track, behind or not_enough_data.
+// @Tags goals
+// @Produce json
+// @Security StackAuth
+// @Success 200 {array} goals.GoalResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /goals [get]
+func (h *Handler) ListGoals(w http.ResponseWriter, r *http.Request) {
+	goals, err := h.service.List(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list goals")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, goals); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// CreateGoal godoc
+// @Summary Create goal
+// @Description Sets an e1rm, frequency (workouts per week) or bodyweight goal with an optional target date. Progress is measured from the value at creation.
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param request body goals.CreateGoalRequest true "Goal"
+// @Success 201 {object} goals.GoalResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /goals [post]
+func (h *Handler) CreateGoal(w http.ResponseWriter, r *http.Request) {
+	var req CreateGoalRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	goal, err := h.service.Create(r.Context(), req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to create goal")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusCreated, goal); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// UpdateGoal godoc
+// @Summary Update goal
+// @Description Changes a goal's target value or target date. Omitted fields are kept and an empty target_date removes the date. The type, exercise, starting value and creation date cannot change.
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param id path int true "Goal ID"
+// @Param request body goals.UpdateGoalRequest true "Goal changes"
+// @Success 200 {object} goals.GoalResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /goals/{id} [patch]
+func (h *Handler) UpdateGoal(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeGoalID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateGoalRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	goal, err := h.service.Update(r.Context(), id, req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to update goal")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, goal); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// DeleteGoal godoc
+// @Summary Delete goal
+// @Description Deletes one goal owned by the authenticated user.
+// @Tags goals
+// @Security StackAuth
+// @Param id path int true "Goal ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /goals/{id} [delete]
+func (h *Handler) DeleteGoal(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeGoalID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		h.writeServiceError(w, r, err, "failed to delete goal")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errNotFound *apperrors.NotFound
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errNotFound):
+		response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
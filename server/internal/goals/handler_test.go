@@ -0,0 +1,192 @@
+package goals
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubGoalsService struct {
+	goals   []GoalResponse
+	goal    *GoalResponse
+	err     error
+	request CreateGoalRequest
+	update  UpdateGoalRequest
+	id      int32
+}
+
+func (s *stubGoalsService) List(_ context.Context) ([]GoalResponse, error) {
+	return s.goals, s.err
+}
+
+func (s *stubGoalsService) Create(_ context.Context, req CreateGoalRequest) (*GoalResponse, error) {
+	s.request = req
+	return s.goal, s.err
+}
+
+func (s *stubGoalsService) Update(_ context.Context, id int32, req UpdateGoalRequest) (*GoalResponse, error) {
+	s.id = id
+	s.update = req
+	return s.goal, s.err
+}
+
+func (s *stubGoalsService) Delete(_ context.Context, id int32) error {
+	s.id = id
+	return s.err
+}
+
+func newGoalsRequest(method string, target string, body string, id string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if id != "" {
+		req.SetPathValue("id", id)
+	}
+	return req
+}
+
+func TestHandlerListGoals(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("lists goals", func(t *testing.T) {
+		handler := NewHandler(logger, &stubGoalsService{goals: []GoalResponse{{ID: 1, Type: TypeFrequency, TargetValue: 4, Status: StatusNotEnoughData}}})
+		rr := httptest.NewRecorder()
+
+		handler.ListGoals(rr, newGoalsRequest(http.MethodGet, "/api/goals", "", ""))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"status":"not_enough_data"`)
+	})
+
+	t.Run("maps unauthorized", func(t *testing.T) {
+		handler := NewHandler(logger, &stubGoalsService{err: &apperrors.Unauthorized{Resource: goalResource}})
+		rr := httptest.NewRecorder()
+
+		handler.ListGoals(rr, newGoalsRequest(http.MethodGet, "/api/goals", "", ""))
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestHandlerCreateGoal(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("creates goal", func(t *testing.T) {
+		service := &stubGoalsService{goal: &GoalResponse{ID: 3, Type: TypeE1RM, TargetValue: 180, Status: StatusOnTrack}}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.CreateGoal(rr, newGoalsRequest(http.MethodPost, "/api/goals", `{"type":"e1rm","exercise_id":12,"target_value":180,"target_date":"2027-03-01"}`, ""))
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		require.NotNil(t, service.request.ExerciseID)
+		assert.Equal(t, int32(12), *service.request.ExerciseID)
+		assert.Equal(t, "2027-03-01", *service.request.TargetDate)
+		assert.Contains(t, rr.Body.String(), `"status":"on_track"`)
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		handler := NewHandler(logger, &stubGoalsService{})
+		rr := httptest.NewRecorder()
+
+		handler.CreateGoal(rr, newGoalsRequest(http.MethodPost, "/api/goals", `{"type":"frequency","target":4}`, ""))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps validation errors to bad request", func(t *testing.T) {
+		handler := NewHandler(logger, &stubGoalsService{err: &ValidationError{Field: "exercise_id", Message: "is required for e1rm goals"}})
+		rr := httptest.NewRecorder()
+
+		handler.CreateGoal(rr, newGoalsRequest(http.MethodPost, "/api/goals", `{"type":"e1rm","target_value":180}`, ""))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "is required for e1rm goals")
+	})
+}
+
+func TestHandlerUpdateGoal(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("updates goal", func(t *testing.T) {
+		service := &stubGoalsService{goal: &GoalResponse{ID: 3, Type: TypeE1RM, TargetValue: 185, Status: StatusBehind}}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.UpdateGoal(rr, newGoalsRequest(http.MethodPatch, "/api/goals/3", `{"target_value":185,"target_date":""}`, "3"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, int32(3), service.id)
+		require.NotNil(t, service.update.TargetValue)
+		assert.Equal(t, 185.0, *service.update.TargetValue)
+		require.NotNil(t, service.update.TargetDate)
+		assert.Empty(t, *service.update.TargetDate)
+		assert.Contains(t, rr.Body.String(), `"target_value":185`)
+	})
+
+	t.Run("rejects fields that cannot change", func(t *testing.T) {
+		handler := NewHandler(logger, &stubGoalsService{})
+		rr := httptest.NewRecorder()
+
+		handler.UpdateGoal(rr, newGoalsRequest(http.MethodPatch, "/api/goals/3", `{"type":"frequency"}`, "3"))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("rejects invalid id", func(t *testing.T) {
+		handler := NewHandler(logger, &stubGoalsService{})
+		rr := httptest.NewRecorder()
+
+		handler.UpdateGoal(rr, newGoalsRequest(http.MethodPatch, "/api/goals/abc", `{"target_value":185}`, "abc"))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps not found", func(t *testing.T) {
+		handler := NewHandler(logger, &stubGoalsService{err: &apperrors.NotFound{Resource: goalResource, ID: "9"}})
+		rr := httptest.NewRecorder()
+
+		handler.UpdateGoal(rr, newGoalsRequest(http.MethodPatch, "/api/goals/9", `{"target_value":185}`, "9"))
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestHandlerDeleteGoal(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("rejects invalid id", func(t *testing.T) {
+		handler := NewHandler(logger, &stubGoalsService{})
+		rr := httptest.NewRecorder()
+
+		handler.DeleteGoal(rr, newGoalsRequest(http.MethodDelete, "/api/goals/abc", "", "abc"))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("deletes goal", func(t *testing.T) {
+		service := &stubGoalsService{}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.DeleteGoal(rr, newGoalsRequest(http.MethodDelete, "/api/goals/4", "", "4"))
+
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, int32(4), service.id)
+	})
+
+	t.Run("maps not found", func(t *testing.T) {
+		handler := NewHandler(logger, &stubGoalsService{err: &apperrors.NotFound{Resource: goalResource, ID: "9"}})
+		rr := httptest.NewRecorder()
+
+		handler.DeleteGoal(rr, newGoalsRequest(http.MethodDelete, "/api/goals/9", "", "9"))
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
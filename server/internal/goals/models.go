@@ -0,0 +1,128 @@
+package goals
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	goalsDateLayout    = "2006-01-02"
+	goalResource       = "goal"
+	maxGoals           = 20
+	maxTargetLoad      = 2000
+	maxFrequencyTarget = 14
+	maxTargetYears     = 5
+
+	// e1rmFitDays and bodyweightFitDays are how far back projections look;
+	// older data says little about the current rate of change.
+	e1rmFitDays       = 90
+	bodyweightFitDays = 60
+	frequencyFitWeeks = 8
+	// frequencyCurrentWeeks is the trailing window averaged for the current
+	// workouts-per-week value.
+	frequencyCurrentWeeks = 4
+	minProjectionPoints   = 3
+	// maxProjectionDays drops projections so far out that the trend is
+	// effectively flat.
+	maxProjectionDays = 5 * 365
+)
+
+const (
+	TypeE1RM       = "e1rm"
+	TypeFrequency  = "frequency"
+	TypeBodyweight = "bodyweight"
+)
+
+const (
+	StatusAchieved      = "achieved"
+	StatusOnTrack       = "on_track"
+	StatusBehind        = "behind"
+	StatusNotEnoughData = "not_enough_data"
+)
+
+// CreateGoalRequest sets a goal. e1rm goals target an estimated 1RM for
+// ExerciseID, frequency goals a number of workouts per week, and bodyweight
+// goals a bodyweight in the units the user logs lifts in. TargetDate is
+// optional.
+type CreateGoalRequest struct {
+	Type        string  `json:"type" example:"e1rm"`
+	ExerciseID  *int32  `json:"exercise_id" example:"12"`
+	TargetValue float64 `json:"target_value" example:"180"`
+	TargetDate  *string `json:"target_date" example:"2027-03-01"`
+}
+
+// UpdateGoalRequest changes a goal's target. Omitted fields are kept and an
+// empty TargetDate removes the date. The type and exercise cannot change,
+// and progress is still measured from the value when the goal was set.
+type UpdateGoalRequest struct {
+	TargetValue *float64 `json:"target_value,omitempty" example:"185"`
+	TargetDate  *string  `json:"target_date,omitempty" example:"2027-06-01"`
+}
+
+// GoalResponse is a goal with its progress. CurrentValue is the best e1RM
+// over the last 90 days, the latest bodyweight, or the average workouts per
+// week over the last 4 weeks. RatePerWeek and ProjectedDate come from a
+// least-squares fit of the recent data; ProjectedDate is omitted when the
+// trend does not head toward the target.
+type GoalResponse struct {
+	ID              int32     `json:"id"`
+	Type            string    `json:"type" example:"e1rm"`
+	ExerciseID      *int32    `json:"exercise_id,omitempty" example:"12"`
+	ExerciseName    *string   `json:"exercise_name,omitempty" example:"Back Squat"`
+	TargetValue     float64   `json:"target_value" example:"180"`
+	TargetDate      *string   `json:"target_date" example:"2027-03-01"`
+	StartValue      *float64  `json:"start_value" example:"160"`
+	CurrentValue    *float64  `json:"current_value" example:"168.5"`
+	ProgressPercent *float64  `json:"progress_percent" example:"42.5"`
+	RatePerWeek     *float64  `json:"rate_per_week" example:"1.2"`
+	ProjectedDate   *string   `json:"projected_date" example:"2027-01-18"`
+	Status          string    `json:"status" example:"on_track"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// goal is a stored goal.
+type goal struct {
+	ID           int32
+	Type         string
+	ExerciseID   *int32
+	ExerciseName *string
+	TargetValue  float64
+	StartValue   *float64
+	TargetDate   *time.Time
+	CreatedAt    time.Time
+}
+
+// goalValues is a validated CreateGoalRequest ready to be written.
+type goalValues struct {
+	Type        string
+	ExerciseID  *int32
+	TargetValue float64
+	StartValue  *float64
+	TargetDate  *time.Time
+}
+
+// point is one dated value of a tracked metric.
+type point struct {
+	Day   time.Time
+	Value float64
+}
+
+// progressData is the logged history goals are measured against. Days are
+// civil dates at UTC midnight in the user's timezone.
+type progressData struct {
+	SessionBests map[int32][]point
+	Bodyweights  []point
+	WorkoutDays  []time.Time
+}
@@ -0,0 +1,196 @@
+package goals
+
+import (
+	"math"
+	"time"
+)
+
+func evaluateGoals(goals []goal, data progressData, today time.Time) []GoalResponse {
+	responses := make([]GoalResponse, 0, len(goals))
+	for _, g := range goals {
+		responses = append(responses, evaluateGoal(g, data, today))
+	}
+	return responses
+}
+
+// evaluateGoal measures g against data as of today. A goal is achieved once
+// the current value reaches the target in the direction goalIncreasing gives,
+// and progress is only reported while the start is short of the target. Other
+// goals need minProjectionPoints recent values before a trend line is fitted;
+// they are on track when the line reaches the target by the target date, or
+// at all when there is no target date.
+func evaluateGoal(g goal, data progressData, today time.Time) GoalResponse {
+	resp := GoalResponse{
+		ID:           g.ID,
+		Type:         g.Type,
+		ExerciseID:   g.ExerciseID,
+		ExerciseName: g.ExerciseName,
+		TargetValue:  g.TargetValue,
+		StartValue:   g.StartValue,
+		Status:       StatusNotEnoughData,
+		CreatedAt:    g.CreatedAt,
+	}
+	if g.TargetDate != nil {
+		targetDate := g.TargetDate.Format(goalsDateLayout)
+		resp.TargetDate = &targetDate
+	}
+
+	current, series := measure(g, data, today)
+	increasing := goalIncreasing(g)
+	if current != nil {
+		value := roundTo(*current, 2)
+		resp.CurrentValue = &value
+		if (increasing && *current >= g.TargetValue) || (!increasing && *current <= g.TargetValue) {
+			progress := 100.0
+			resp.ProgressPercent = &progress
+			resp.Status = StatusAchieved
+			return resp
+		}
+		if g.StartValue != nil && (increasing && g.TargetValue > *g.StartValue || !increasing && g.TargetValue < *g.StartValue) {
+			progress := roundTo(math.Max(0, math.Min(100, (*current-*g.StartValue)/(g.TargetValue-*g.StartValue)*100)), 1)
+			resp.ProgressPercent = &progress
+		}
+	}
+
+	if len(series) < minProjectionPoints {
+		return resp
+	}
+	resp.Status = StatusBehind
+	slope, intercept, ok := fitLine(series, today)
+	if !ok {
+		return resp
+	}
+	rate := roundTo(slope*7, 2)
+	resp.RatePerWeek = &rate
+	if (increasing && slope <= 0) || (!increasing && slope >= 0) {
+		return resp
+	}
+
+	// The fitted line can already be past the target while the measured
+	// value is not, so the earliest projection is today.
+	days := math.Max(0, math.Ceil((g.TargetValue-intercept)/slope))
+	if days > maxProjectionDays {
+		return resp
+	}
+	projected := today.AddDate(0, 0, int(days))
+	projectedDate := projected.Format(goalsDateLayout)
+	resp.ProjectedDate = &projectedDate
+	if g.TargetDate == nil || !projected.After(*g.TargetDate) {
+		resp.Status = StatusOnTrack
+	}
+	return resp
+}
+
+// goalIncreasing reports whether g is met by reaching at least its target.
+// E1RM and frequency goals always are, even when set below the current
+// value, so training less never reads as progress. Only bodyweight goals
+// take their direction from where they started.
+func goalIncreasing(g goal) bool {
+	if g.Type != TypeBodyweight || g.StartValue == nil {
+		return true
+	}
+	return g.TargetValue >= *g.StartValue
+}
+
+// measure returns the current value of the metric g tracks and the recent
+// values to fit a trend to.
+func measure(g goal, data progressData, today time.Time) (*float64, []point) {
+	switch g.Type {
+	case TypeE1RM:
+		if g.ExerciseID == nil {
+			return nil, nil
+		}
+		series := pointsSince(data.SessionBests[*g.ExerciseID], today.AddDate(0, 0, -(e1rmFitDays-1)))
+		if len(series) == 0 {
+			return nil, nil
+		}
+		best := series[0].Value
+		for _, p := range series[1:] {
+			best = math.Max(best, p.Value)
+		}
+		return &best, series
+	case TypeBodyweight:
+		series := pointsSince(data.Bodyweights, today.AddDate(0, 0, -(bodyweightFitDays-1)))
+		if len(series) == 0 {
+			return nil, nil
+		}
+		latest := series[len(series)-1].Value
+		return &latest, series
+	case TypeFrequency:
+		return weeklyFrequency(data.WorkoutDays, today)
+	default:
+		return nil, nil
+	}
+}
+
+// weeklyFrequency counts workouts in trailing 7-day weeks ending today. The
+// current value averages the last frequencyCurrentWeeks weeks; the series
+// covers up to frequencyFitWeeks weeks, skipping weeks that ended before the
+// first logged workout. days must be sorted ascending.
+func weeklyFrequency(days []time.Time, today time.Time) (*float64, []point) {
+	counts := make([]int, frequencyFitWeeks)
+	for _, day := range days {
+		if day.After(today) {
+			continue
+		}
+		week := int(today.Sub(day).Hours()/24) / 7
+		if week < frequencyFitWeeks {
+			counts[week]++
+		}
+	}
+
+	total := 0
+	for _, count := range counts[:frequencyCurrentWeeks] {
+		total += count
+	}
+	current := float64(total) / frequencyCurrentWeeks
+
+	series := make([]point, 0, frequencyFitWeeks)
+	for week := frequencyFitWeeks - 1; week >= 0; week-- {
+		end := today.AddDate(0, 0, -7*week)
+		if len(days) == 0 || end.Before(days[0]) {
+			continue
+		}
+		series = append(series, point{Day: end, Value: float64(counts[week])})
+	}
+	return &current, series
+}
+
+// fitLine fits value = slope*x + intercept by least squares, with x in days
+// relative to today, so intercept is the trend value today.
+func fitLine(points []point, today time.Time) (float64, float64, bool) {
+	if len(points) < 2 {
+		return 0, 0, false
+	}
+
+	n := float64(len(points))
+	var sumX, sumY, sumXX, sumXY float64
+	for _, p := range points {
+		x := p.Day.Sub(today).Hours() / 24
+		sumX += x
+		sumY += p.Value
+		sumXX += x * x
+		sumXY += x * p.Value
+	}
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0, 0, false
+	}
+	slope := (n*sumXY - sumX*sumY) / denominator
+	intercept := (sumY - slope*sumX) / n
+	return slope, intercept, true
+}
+
+func pointsSince(points []point, start time.Time) []point {
+	for i, p := range points {
+		if !p.Day.Before(start) {
+			return points[i:]
+		}
+	}
+	return nil
+}
+
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
@@ -0,0 +1,183 @@
+package goals
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func floatPtr(value float64) *float64 {
+	return &value
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+func int32Ptr(value int32) *int32 {
+	return &value
+}
+
+func daysAgo(today time.Time, days int) time.Time {
+	return today.AddDate(0, 0, -days)
+}
+
+func TestEvaluateGoal(t *testing.T) {
+	today := time.Date(2026, 7, 6, 0, 0, 0, 0, time.UTC)
+	squatID := int32(12)
+	squatGoal := func(targetDate *time.Time) goal {
+		return goal{ID: 1, Type: TypeE1RM, ExerciseID: int32Ptr(squatID), TargetValue: 180, StartValue: floatPtr(140), TargetDate: targetDate}
+	}
+	climbing := progressData{SessionBests: map[int32][]point{squatID: {
+		{Day: daysAgo(today, 120), Value: 200},
+		{Day: daysAgo(today, 60), Value: 130},
+		{Day: daysAgo(today, 30), Value: 145},
+		{Day: today, Value: 160},
+	}}}
+	lateDate := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	earlyDate := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("on track when the trend reaches the target by the date", func(t *testing.T) {
+		resp := evaluateGoal(squatGoal(&lateDate), climbing, today)
+
+		assert.Equal(t, StatusOnTrack, resp.Status)
+		assert.Equal(t, 160.0, *resp.CurrentValue, "sessions older than the fit window are ignored")
+		assert.Equal(t, 50.0, *resp.ProgressPercent)
+		assert.Equal(t, 3.5, *resp.RatePerWeek)
+		assert.Equal(t, "2026-08-15", *resp.ProjectedDate)
+		assert.Equal(t, "2026-12-31", *resp.TargetDate)
+	})
+
+	t.Run("behind when the projection misses the date", func(t *testing.T) {
+		resp := evaluateGoal(squatGoal(&earlyDate), climbing, today)
+
+		assert.Equal(t, StatusBehind, resp.Status)
+		assert.Equal(t, "2026-08-15", *resp.ProjectedDate)
+	})
+
+	t.Run("achieved once the target is reached", func(t *testing.T) {
+		data := progressData{SessionBests: map[int32][]point{squatID: {{Day: daysAgo(today, 5), Value: 182.5}}}}
+
+		resp := evaluateGoal(squatGoal(nil), data, today)
+
+		assert.Equal(t, StatusAchieved, resp.Status)
+		assert.Equal(t, 100.0, *resp.ProgressPercent)
+		assert.Nil(t, resp.ProjectedDate)
+	})
+
+	t.Run("not enough data for a projection", func(t *testing.T) {
+		data := progressData{SessionBests: map[int32][]point{squatID: {
+			{Day: daysAgo(today, 7), Value: 150},
+			{Day: today, Value: 155},
+		}}}
+
+		resp := evaluateGoal(squatGoal(&lateDate), data, today)
+
+		assert.Equal(t, StatusNotEnoughData, resp.Status)
+		assert.Equal(t, 155.0, *resp.CurrentValue)
+		assert.Nil(t, resp.RatePerWeek)
+	})
+
+	t.Run("bodyweight loss projects a falling trend", func(t *testing.T) {
+		g := goal{Type: TypeBodyweight, TargetValue: 80, StartValue: floatPtr(90)}
+		data := progressData{Bodyweights: []point{
+			{Day: daysAgo(today, 4), Value: 88},
+			{Day: daysAgo(today, 2), Value: 87},
+			{Day: today, Value: 86},
+		}}
+
+		resp := evaluateGoal(g, data, today)
+
+		assert.Equal(t, StatusOnTrack, resp.Status)
+		assert.Equal(t, 40.0, *resp.ProgressPercent)
+		assert.Equal(t, -3.5, *resp.RatePerWeek)
+		assert.Equal(t, "2026-07-18", *resp.ProjectedDate)
+	})
+
+	t.Run("bodyweight moving away from the target is behind", func(t *testing.T) {
+		g := goal{Type: TypeBodyweight, TargetValue: 80, StartValue: floatPtr(90)}
+		data := progressData{Bodyweights: []point{
+			{Day: daysAgo(today, 4), Value: 89},
+			{Day: daysAgo(today, 2), Value: 90},
+			{Day: today, Value: 91},
+		}}
+
+		resp := evaluateGoal(g, data, today)
+
+		assert.Equal(t, StatusBehind, resp.Status)
+		assert.Equal(t, 0.0, *resp.ProgressPercent)
+		assert.Nil(t, resp.ProjectedDate)
+	})
+
+	t.Run("a target below the start value is still a minimum", func(t *testing.T) {
+		var days []time.Time
+		for week := 7; week >= 0; week-- {
+			for _, offset := range []int{6, 5, 3, 1, 0} {
+				if week > 3 || offset%2 == 1 {
+					days = append(days, daysAgo(today, week*7+offset))
+				}
+			}
+		}
+		frequency := goal{Type: TypeFrequency, TargetValue: 4, StartValue: floatPtr(5)}
+
+		resp := evaluateGoal(frequency, progressData{WorkoutDays: days}, today)
+
+		assert.Equal(t, StatusBehind, resp.Status, "training less than the target is not achieved")
+		assert.Equal(t, 3.0, *resp.CurrentValue)
+		assert.Nil(t, resp.ProgressPercent)
+		assert.Nil(t, resp.ProjectedDate)
+
+		e1rm := squatGoal(nil)
+		e1rm.StartValue = floatPtr(190)
+		resp = evaluateGoal(e1rm, climbing, today)
+		assert.Equal(t, StatusOnTrack, resp.Status, "a rising e1RM heads towards the target")
+		assert.Nil(t, resp.ProgressPercent)
+
+		resp = evaluateGoal(e1rm, progressData{SessionBests: map[int32][]point{squatID: {{Day: today, Value: 185}}}}, today)
+		assert.Equal(t, StatusAchieved, resp.Status)
+	})
+
+	t.Run("flat frequency below target is behind", func(t *testing.T) {
+		var days []time.Time
+		for week := 7; week >= 0; week-- {
+			for _, offset := range []int{5, 3, 1} {
+				days = append(days, daysAgo(today, week*7+offset))
+			}
+		}
+
+		resp := evaluateGoal(goal{Type: TypeFrequency, TargetValue: 4, StartValue: floatPtr(3)}, progressData{WorkoutDays: days}, today)
+
+		assert.Equal(t, StatusBehind, resp.Status)
+		assert.Equal(t, 3.0, *resp.CurrentValue)
+		assert.Equal(t, 0.0, *resp.RatePerWeek)
+	})
+}
+
+func TestWeeklyFrequency(t *testing.T) {
+	today := time.Date(2026, 7, 6, 0, 0, 0, 0, time.UTC)
+	days := []time.Time{daysAgo(today, 8), daysAgo(today, 1), today}
+
+	current, series := weeklyFrequency(days, today)
+
+	require.NotNil(t, current)
+	assert.Equal(t, 0.75, *current)
+	assert.Equal(t, []point{
+		{Day: daysAgo(today, 7), Value: 1},
+		{Day: today, Value: 2},
+	}, series)
+}
+
+func TestDailyMeans(t *testing.T) {
+	day := time.Date(2026, 7, 6, 0, 0, 0, 0, time.UTC)
+
+	means := dailyMeans([]point{
+		{Day: day, Value: 80},
+		{Day: day, Value: 81},
+		{Day: day, Value: 82},
+		{Day: day.AddDate(0, 0, 1), Value: 79},
+	})
+
+	assert.Equal(t, []point{{Day: day, Value: 81}, {Day: day.AddDate(0, 0, 1), Value: 79}}, means)
+}
@@ -0,0 +1,339 @@
+package goals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	List(ctx context.Context, userID string) ([]goal, error)
+	ProgressData(ctx context.Context, userID string, goals []goal) (*progressData, error)
+	ExerciseName(ctx context.Context, userID string, exerciseID int32) (string, error)
+	Create(ctx context.Context, userID string, values goalValues) (*goal, error)
+	Update(ctx context.Context, userID string, id int32, values goalValues) (*goal, error)
+	Delete(ctx context.Context, userID string, id int32) error
+}
+
+var (
+	ErrGoalNotFound     = errors.New("goal not found")
+	ErrExerciseNotFound = errors.New("exercise not found")
+)
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	conn    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		conn:    conn,
+	}
+}
+
+func (r *repository) List(ctx context.Context, userID string) ([]goal, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	goals, err := listGoals(ctx, r.queries, userID)
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list goals failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, err
+	}
+	return goals, nil
+}
+
+func (r *repository) ProgressData(ctx context.Context, userID string, goals []goal) (*progressData, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	return loadProgressData(ctx, r.queries, userID, goals)
+}
+
+func (r *repository) ExerciseName(ctx context.Context, userID string, exerciseID int32) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	row, err := r.queries.GetExercise(ctx, db.GetExerciseParams{ID: exerciseID, UserID: userID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrExerciseNotFound
+		}
+		return "", fmt.Errorf("get exercise %d: %w", exerciseID, err)
+	}
+	return row.Name, nil
+}
+
+func (r *repository) Create(ctx context.Context, userID string, values goalValues) (*goal, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	target, err := numericFromFloat(&values.TargetValue)
+	if err != nil {
+		return nil, err
+	}
+	start, err := numericFromFloat(values.StartValue)
+	if err != nil {
+		return nil, err
+	}
+	params := db.CreateGoalParams{
+		UserID:      userID,
+		GoalType:    values.Type,
+		TargetValue: target,
+		StartValue:  start,
+	}
+	if values.ExerciseID != nil {
+		params.ExerciseID = pgtype.Int4{Int32: *values.ExerciseID, Valid: true}
+	}
+	if values.TargetDate != nil {
+		params.TargetDate = pgtype.Date{Time: *values.TargetDate, Valid: true}
+	}
+
+	row, err := r.queries.CreateGoal(ctx, params)
+	if err != nil {
+		r.logger.Error("database error creating goal", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("create goal: %w", err)
+	}
+	return goalFromColumns(row.ID, row.GoalType, row.ExerciseID, pgtype.Text{}, row.TargetValue, row.StartValue, row.TargetDate, row.CreatedAt)
+}
+
+// Update writes a goal's target value and date. The other columns are left
+// as they were.
+func (r *repository) Update(ctx context.Context, userID string, id int32, values goalValues) (*goal, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	target, err := numericFromFloat(&values.TargetValue)
+	if err != nil {
+		return nil, err
+	}
+	params := db.UpdateGoalParams{
+		TargetValue: target,
+		ID:          id,
+		UserID:      userID,
+	}
+	if values.TargetDate != nil {
+		params.TargetDate = pgtype.Date{Time: *values.TargetDate, Valid: true}
+	}
+
+	row, err := r.queries.UpdateGoal(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrGoalNotFound
+		}
+		r.logger.Error("database error updating goal", "error", err, "user_id", userID, "goal_id", id)
+		return nil, fmt.Errorf("update goal: %w", err)
+	}
+	return goalFromColumns(row.ID, row.GoalType, row.ExerciseID, pgtype.Text{}, row.TargetValue, row.StartValue, row.TargetDate, row.CreatedAt)
+}
+
+func (r *repository) Delete(ctx context.Context, userID string, id int32) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rowsAffected, err := r.queries.DeleteGoal(ctx, db.DeleteGoalParams{ID: id, UserID: userID})
+	if err != nil {
+		r.logger.Error("database error deleting goal", "error", err, "user_id", userID, "goal_id", id)
+		return fmt.Errorf("delete goal: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrGoalNotFound
+	}
+	return nil
+}
+
+// LoadGoals reads and evaluates a user's goals with the given queries as of
+// today, a civil date at UTC midnight. Other packages use it to show goal
+// progress without a Service.
+func LoadGoals(ctx context.Context, queries *db.Queries, userID string, today time.Time) ([]GoalResponse, error) {
+	goals, err := listGoals(ctx, queries, userID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := loadProgressData(ctx, queries, userID, goals)
+	if err != nil {
+		return nil, err
+	}
+	return evaluateGoals(goals, *data, today), nil
+}
+
+func listGoals(ctx context.Context, queries *db.Queries, userID string) ([]goal, error) {
+	rows, err := queries.ListGoals(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list goals: %w", err)
+	}
+
+	goals := make([]goal, 0, len(rows))
+	for _, row := range rows {
+		g, err := goalFromColumns(row.ID, row.GoalType, row.ExerciseID, row.ExerciseName, row.TargetValue, row.StartValue, row.TargetDate, row.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		goals = append(goals, *g)
+	}
+	return goals, nil
+}
+
+// loadProgressData reads only the history the given goals track. Workout
+// days are bucketed in the request timezone.
+func loadProgressData(ctx context.Context, queries *db.Queries, userID string, goals []goal) (*progressData, error) {
+	data := &progressData{SessionBests: map[int32][]point{}}
+
+	var exerciseIDs []int32
+	needBodyweight, needWorkouts := false, false
+	for _, g := range goals {
+		switch g.Type {
+		case TypeE1RM:
+			if g.ExerciseID != nil {
+				exerciseIDs = append(exerciseIDs, *g.ExerciseID)
+			}
+		case TypeBodyweight:
+			needBodyweight = true
+		case TypeFrequency:
+			needWorkouts = true
+		}
+	}
+
+	if len(exerciseIDs) > 0 {
+		rows, err := queries.ListSessionBestE1rmForExercises(ctx, db.ListSessionBestE1rmForExercisesParams{
+			UserID:      userID,
+			ExerciseIds: exerciseIDs,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list session best e1rm: %w", err)
+		}
+		for _, row := range rows {
+			if !row.WorkoutDay.Valid {
+				continue
+			}
+			data.SessionBests[row.ExerciseID] = append(data.SessionBests[row.ExerciseID], point{Day: row.WorkoutDay.Time, Value: row.SessionBestE1rm})
+		}
+	}
+
+	if needBodyweight {
+		rows, err := queries.ListBodyweightReadings(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("list bodyweight readings: %w", err)
+		}
+		readings := make([]point, 0, len(rows))
+		for _, row := range rows {
+			if !row.MeasuredOn.Valid || !row.Bodyweight.Valid {
+				continue
+			}
+			value, err := row.Bodyweight.Float64Value()
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert numeric to float64: %w", err)
+			}
+			readings = append(readings, point{Day: row.MeasuredOn.Time, Value: value.Float64})
+		}
+		data.Bodyweights = dailyMeans(readings)
+	}
+
+	if needWorkouts {
+		rows, err := queries.ListWorkoutDatesForConsistency(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("list workout dates: %w", err)
+		}
+		loc := user.Location(ctx)
+		for _, row := range rows {
+			if !row.Valid {
+				continue
+			}
+			local := row.Time.In(loc)
+			data.WorkoutDays = append(data.WorkoutDays, time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC))
+		}
+	}
+
+	return data, nil
+}
+
+// dailyMeans averages readings that share a day. Readings must be sorted by
+// day.
+func dailyMeans(readings []point) []point {
+	means := make([]point, 0, len(readings))
+	count := 0
+	for _, reading := range readings {
+		if len(means) > 0 && means[len(means)-1].Day.Equal(reading.Day) {
+			last := &means[len(means)-1]
+			count++
+			last.Value += (reading.Value - last.Value) / float64(count)
+			continue
+		}
+		means = append(means, reading)
+		count = 1
+	}
+	return means
+}
+
+func goalFromColumns(id int32, goalType string, exerciseID pgtype.Int4, exerciseName pgtype.Text, target pgtype.Numeric, start pgtype.Numeric, targetDate pgtype.Date, createdAt pgtype.Timestamptz) (*goal, error) {
+	g := &goal{
+		ID:        id,
+		Type:      goalType,
+		CreatedAt: createdAt.Time,
+	}
+	if exerciseID.Valid {
+		id := exerciseID.Int32
+		g.ExerciseID = &id
+	}
+	if exerciseName.Valid {
+		name := exerciseName.String
+		g.ExerciseName = &name
+	}
+	if targetDate.Valid {
+		date := targetDate.Time
+		g.TargetDate = &date
+	}
+
+	targetValue, err := floatPtrFromNumeric(target)
+	if err != nil {
+		return nil, err
+	}
+	if targetValue != nil {
+		g.TargetValue = *targetValue
+	}
+	if g.StartValue, err = floatPtrFromNumeric(start); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func numericFromFloat(val *float64) (pgtype.Numeric, error) {
+	if val == nil {
+		return pgtype.Numeric{Valid: false}, nil
+	}
+
+	var n pgtype.Numeric
+	if err := n.Scan(fmt.Sprintf("%.2f", *val)); err != nil {
+		return pgtype.Numeric{}, fmt.Errorf("failed to convert float to numeric: %w", err)
+	}
+	return n, nil
+}
+
+func floatPtrFromNumeric(n pgtype.Numeric) (*float64, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	f64, err := n.Float64Value()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert numeric to float64: %w", err)
+	}
+	return &f64.Float64, nil
+}
+
+var _ Repository = (*repository)(nil)
@@ -0,0 +1,155 @@
+package goals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger *slog.Logger
+	repo   Repository
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// List returns every goal with its progress as of today in the user's
+// timezone, oldest first.
+func (s *Service) List(ctx context.Context) ([]GoalResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: goalResource, UserID: ""}
+	}
+
+	goals, err := s.repo.List(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list goals: %w", err)
+	}
+	data, err := s.repo.ProgressData(ctx, userID, goals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load goal progress: %w", err)
+	}
+	return evaluateGoals(goals, *data, user.Today(ctx)), nil
+}
+
+// Create sets a goal. The current value of the tracked metric is stored as
+// the starting point; bodyweight goals need a logged bodyweight to know
+// whether the target is a gain or a loss.
+func (s *Service) Create(ctx context.Context, req CreateGoalRequest) (*GoalResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return nil, &apperrors.Unauthorized{Resource: goalResource, UserID: ""}
+	}
+
+	today := user.Today(ctx)
+	values, err := validateCreateRequest(req, today)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.List(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list goals: %w", err)
+	}
+	if len(existing) >= maxGoals {
+		return nil, &ValidationError{Message: fmt.Sprintf("at most %d goals can be set", maxGoals)}
+	}
+
+	draft := goal{Type: values.Type, ExerciseID: values.ExerciseID, TargetValue: values.TargetValue}
+	if values.ExerciseID != nil {
+		name, err := s.repo.ExerciseName(ctx, userID, *values.ExerciseID)
+		if err != nil {
+			if errors.Is(err, ErrExerciseNotFound) {
+				return nil, &ValidationError{Field: "exercise_id", Message: "must be one of your exercises"}
+			}
+			return nil, fmt.Errorf("failed to create goal: %w", err)
+		}
+		draft.ExerciseName = &name
+	}
+
+	data, err := s.repo.ProgressData(ctx, userID, []goal{draft})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load goal progress: %w", err)
+	}
+	if start, _ := measure(draft, *data, today); start != nil {
+		value := roundTo(*start, 2)
+		values.StartValue = &value
+	} else if values.Type == TypeBodyweight {
+		return nil, &ValidationError{Field: "type", Message: "log a bodyweight before setting a bodyweight goal"}
+	}
+
+	created, err := s.repo.Create(ctx, userID, *values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create goal: %w", err)
+	}
+	created.ExerciseName = draft.ExerciseName
+
+	resp := evaluateGoal(*created, *data, today)
+	return &resp, nil
+}
+
+// Update changes a goal's target value or date and returns it with fresh
+// progress. The starting value and creation date are kept.
+func (s *Service) Update(ctx context.Context, id int32, req UpdateGoalRequest) (*GoalResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return nil, &apperrors.Unauthorized{Resource: goalResource, UserID: ""}
+	}
+
+	goals, err := s.repo.List(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list goals: %w", err)
+	}
+	idx := slices.IndexFunc(goals, func(g goal) bool { return g.ID == id })
+	if idx < 0 {
+		return nil, &apperrors.NotFound{Resource: goalResource, ID: fmt.Sprintf("%d", id)}
+	}
+	existing := goals[idx]
+
+	today := user.Today(ctx)
+	values, err := validateUpdateRequest(req, existing, today)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(ctx, userID, id, *values)
+	if err != nil {
+		if errors.Is(err, ErrGoalNotFound) {
+			return nil, &apperrors.NotFound{Resource: goalResource, ID: fmt.Sprintf("%d", id)}
+		}
+		return nil, fmt.Errorf("failed to update goal: %w", err)
+	}
+	updated.ExerciseName = existing.ExerciseName
+
+	data, err := s.repo.ProgressData(ctx, userID, []goal{*updated})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load goal progress: %w", err)
+	}
+	resp := evaluateGoal(*updated, *data, today)
+	return &resp, nil
+}
+
+func (s *Service) Delete(ctx context.Context, id int32) error {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return &apperrors.Unauthorized{Resource: goalResource, UserID: ""}
+	}
+
+	if err := s.repo.Delete(ctx, userID, id); err != nil {
+		if errors.Is(err, ErrGoalNotFound) {
+			return &apperrors.NotFound{Resource: goalResource, ID: fmt.Sprintf("%d", id)}
+		}
+		return fmt.Errorf("failed to delete goal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package goals
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// validateCreateRequest normalizes a goal and requires target dates after
+// today in the user's timezone.
+func validateCreateRequest(req CreateGoalRequest, today time.Time) (*goalValues, error) {
+	values := &goalValues{
+		Type:        strings.ToLower(strings.TrimSpace(req.Type)),
+		ExerciseID:  req.ExerciseID,
+		TargetValue: req.TargetValue,
+	}
+
+	maxTarget := float64(maxTargetLoad)
+	switch values.Type {
+	case TypeE1RM:
+		if values.ExerciseID == nil || *values.ExerciseID <= 0 {
+			return nil, &ValidationError{Field: "exercise_id", Message: "is required for e1rm goals"}
+		}
+	case TypeFrequency, TypeBodyweight:
+		if values.ExerciseID != nil {
+			return nil, &ValidationError{Field: "exercise_id", Message: "is only allowed for e1rm goals"}
+		}
+		if values.Type == TypeFrequency {
+			maxTarget = maxFrequencyTarget
+		}
+	default:
+		return nil, &ValidationError{Field: "type", Message: "must be one of e1rm, frequency, bodyweight"}
+	}
+
+	if err := validateTargetValue(values.TargetValue, maxTarget); err != nil {
+		return nil, err
+	}
+
+	if req.TargetDate != nil {
+		targetDate, err := parseTargetDate(*req.TargetDate, today)
+		if err != nil {
+			return nil, err
+		}
+		values.TargetDate = targetDate
+	}
+
+	return values, nil
+}
+
+// validateUpdateRequest applies an update to an existing goal. Only fields
+// present in the request are validated, so an existing target date that has
+// since passed can be kept.
+func validateUpdateRequest(req UpdateGoalRequest, existing goal, today time.Time) (*goalValues, error) {
+	values := &goalValues{
+		Type:        existing.Type,
+		ExerciseID:  existing.ExerciseID,
+		TargetValue: existing.TargetValue,
+		StartValue:  existing.StartValue,
+		TargetDate:  existing.TargetDate,
+	}
+
+	if req.TargetValue != nil {
+		maxTarget := float64(maxTargetLoad)
+		if values.Type == TypeFrequency {
+			maxTarget = maxFrequencyTarget
+		}
+		if err := validateTargetValue(*req.TargetValue, maxTarget); err != nil {
+			return nil, err
+		}
+		values.TargetValue = *req.TargetValue
+	}
+
+	if req.TargetDate != nil {
+		targetDate, err := parseTargetDate(*req.TargetDate, today)
+		if err != nil {
+			return nil, err
+		}
+		values.TargetDate = targetDate
+	}
+
+	return values, nil
+}
+
+func validateTargetValue(value float64, maxTarget float64) error {
+	if math.IsNaN(value) || value <= 0 || value > maxTarget {
+		return &ValidationError{Field: "target_value", Message: fmt.Sprintf("must be greater than 0 and at most %g", maxTarget)}
+	}
+	return nil
+}
+
+// parseTargetDate returns nil for a blank date and otherwise requires a date
+// after today and within maxTargetYears.
+func parseTargetDate(raw string, today time.Time) (*time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	targetDate, err := time.Parse(goalsDateLayout, raw)
+	if err != nil {
+		return nil, &ValidationError{Field: "target_date", Message: "must be a date formatted as YYYY-MM-DD"}
+	}
+	if !targetDate.After(today) {
+		return nil, &ValidationError{Field: "target_date", Message: "must be after today"}
+	}
+	if targetDate.After(today.AddDate(maxTargetYears, 0, 0)) {
+		return nil, &ValidationError{Field: "target_date", Message: fmt.Sprintf("must be within %d years", maxTargetYears)}
+	}
+	return &targetDate, nil
+}
@@ -0,0 +1,115 @@
+package goals
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCreateRequest(t *testing.T) {
+	today := time.Date(2026, 7, 6, 0, 0, 0, 0, time.UTC)
+
+	t.Run("normalizes an e1rm goal", func(t *testing.T) {
+		values, err := validateCreateRequest(CreateGoalRequest{
+			Type:        " E1RM ",
+			ExerciseID:  int32Ptr(12),
+			TargetValue: 180,
+			TargetDate:  stringPtr("2027-03-01"),
+		}, today)
+
+		require.NoError(t, err)
+		assert.Equal(t, TypeE1RM, values.Type)
+		assert.Equal(t, int32(12), *values.ExerciseID)
+		assert.Equal(t, time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC), *values.TargetDate)
+	})
+
+	t.Run("target date is optional", func(t *testing.T) {
+		values, err := validateCreateRequest(CreateGoalRequest{Type: TypeFrequency, TargetValue: 4}, today)
+
+		require.NoError(t, err)
+		assert.Nil(t, values.TargetDate)
+	})
+
+	tests := []struct {
+		name  string
+		req   CreateGoalRequest
+		field string
+	}{
+		{name: "unknown type", req: CreateGoalRequest{Type: "volume", TargetValue: 10}, field: "type"},
+		{name: "e1rm without exercise", req: CreateGoalRequest{Type: TypeE1RM, TargetValue: 180}, field: "exercise_id"},
+		{name: "exercise on a bodyweight goal", req: CreateGoalRequest{Type: TypeBodyweight, ExerciseID: int32Ptr(12), TargetValue: 80}, field: "exercise_id"},
+		{name: "non-positive target", req: CreateGoalRequest{Type: TypeBodyweight, TargetValue: 0}, field: "target_value"},
+		{name: "frequency above a daily-doubles week", req: CreateGoalRequest{Type: TypeFrequency, TargetValue: 15}, field: "target_value"},
+		{name: "malformed date", req: CreateGoalRequest{Type: TypeFrequency, TargetValue: 4, TargetDate: stringPtr("03/01/2027")}, field: "target_date"},
+		{name: "date not in the future", req: CreateGoalRequest{Type: TypeFrequency, TargetValue: 4, TargetDate: stringPtr("2026-07-06")}, field: "target_date"},
+		{name: "date too far out", req: CreateGoalRequest{Type: TypeFrequency, TargetValue: 4, TargetDate: stringPtr("2031-07-07")}, field: "target_date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validateCreateRequest(tt.req, today)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestValidateUpdateRequest(t *testing.T) {
+	today := time.Date(2026, 7, 6, 0, 0, 0, 0, time.UTC)
+	pastDate := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	existing := goal{
+		ID:          3,
+		Type:        TypeE1RM,
+		ExerciseID:  int32Ptr(12),
+		TargetValue: 180,
+		StartValue:  floatPtr(160),
+		TargetDate:  &pastDate,
+	}
+
+	t.Run("keeps omitted fields and the start value", func(t *testing.T) {
+		values, err := validateUpdateRequest(UpdateGoalRequest{TargetValue: floatPtr(185)}, existing, today)
+
+		require.NoError(t, err)
+		assert.Equal(t, TypeE1RM, values.Type)
+		assert.Equal(t, int32(12), *values.ExerciseID)
+		assert.Equal(t, 185.0, values.TargetValue)
+		assert.Equal(t, 160.0, *values.StartValue)
+		assert.Equal(t, pastDate, *values.TargetDate, "a passed date is kept when not changed")
+	})
+
+	t.Run("sets and clears the target date", func(t *testing.T) {
+		values, err := validateUpdateRequest(UpdateGoalRequest{TargetDate: stringPtr("2027-06-01")}, existing, today)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC), *values.TargetDate)
+
+		values, err = validateUpdateRequest(UpdateGoalRequest{TargetDate: stringPtr("")}, existing, today)
+		require.NoError(t, err)
+		assert.Nil(t, values.TargetDate)
+	})
+
+	frequency := goal{ID: 4, Type: TypeFrequency, TargetValue: 3}
+	tests := []struct {
+		name     string
+		req      UpdateGoalRequest
+		existing goal
+		field    string
+	}{
+		{name: "non-positive target", req: UpdateGoalRequest{TargetValue: floatPtr(0)}, existing: existing, field: "target_value"},
+		{name: "target above the load limit", req: UpdateGoalRequest{TargetValue: floatPtr(2001)}, existing: existing, field: "target_value"},
+		{name: "frequency above a daily-doubles week", req: UpdateGoalRequest{TargetValue: floatPtr(15)}, existing: frequency, field: "target_value"},
+		{name: "malformed date", req: UpdateGoalRequest{TargetDate: stringPtr("06/01/2027")}, existing: existing, field: "target_date"},
+		{name: "date not in the future", req: UpdateGoalRequest{TargetDate: stringPtr("2026-07-06")}, existing: existing, field: "target_date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validateUpdateRequest(tt.req, tt.existing, today)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
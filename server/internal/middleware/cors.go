@@ -28,7 +28,7 @@ func CORS(allowedOrigins []string, logger *slog.Logger) func(http.Handler) http.
 			if allowed {
 				w.Header().Set("Access-Control-Allow-Origin", origin)
 				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-stack-access-token")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-stack-access-token, X-Timezone")
 				w.Header().Set("Access-Control-Allow-Credentials", "true")
 			}
 
@@ -0,0 +1,184 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type notificationsService interface {
+	List(ctx context.Context, opts ListOptions) (*NotificationsResponse, error)
+	UnreadCount(ctx context.Context) (*UnreadCountResponse, error)
+	MarkRead(ctx context.Context, id int32) error
+	MarkAllRead(ctx context.Context) (*MarkAllReadResponse, error)
+	Stream(ctx context.Context, emit func(event string, payload any) error) error
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service notificationsService
+}
+
+func NewHandler(logger *slog.Logger, service notificationsService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// ListNotifications godoc
+// @Summary List notifications
+// @Description Returns the authenticated user's notifications, newest first. Use next_before_id as before_id to page back.
+// @Tags notifications
+// @Produce json
+// @Security StackAuth
+// @Param unread query bool false "Only unread notifications"
+// @Param before_id query int false "Only notifications older than this ID"
+// @Param limit query int false "Maximum notifications to return" default(50) minimum(1) maximum(200)
+// @Success 200 {object} notifications.NotificationsResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /notifications [get]
+func (h *Handler) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	opts, ok := h.decodeListOptions(w, r)
+	if !ok {
+		return
+	}
+
+	notifications, err := h.service.List(r.Context(), opts)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list notifications")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, notifications); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// GetUnreadCount godoc
+// @Summary Get unread notification count
+// @Description Returns how many of the authenticated user's notifications are unread.
+// @Tags notifications
+// @Produce json
+// @Security StackAuth
+// @Success 200 {object} notifications.UnreadCountResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /notifications/unread-count [get]
+func (h *Handler) GetUnreadCount(w http.ResponseWriter, r *http.Request) {
+	count, err := h.service.UnreadCount(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to count unread notifications")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, count); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark notification read
+// @Description Marks one notification read. Marking an already read notification keeps its original read time.
+// @Tags notifications
+// @Security StackAuth
+// @Param id path int true "Notification ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /notifications/{id}/read [post]
+func (h *Handler) MarkNotificationRead(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeNotificationID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.MarkRead(r.Context(), id); err != nil {
+		h.writeServiceError(w, r, err, "failed to mark notification read")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary Mark all notifications read
+// @Description Marks every unread notification read and returns how many were updated.
+// @Tags notifications
+// @Produce json
+// @Security StackAuth
+// @Success 200 {object} notifications.MarkAllReadResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /notifications/read-all [post]
+func (h *Handler) MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	result, err := h.service.MarkAllRead(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to mark notifications read")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, result); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// StreamNotifications godoc
+// @Summary Stream notifications
+// @Description Opens a Server-Sent Events stream. A ready event carries the unread count, then a notification event is sent for each new notification, and ping events keep idle connections open. Reconnect and re-list to catch up after a disconnect.
+// @Tags notifications
+// @Produce text/event-stream
+// @Security StackAuth
+// @Success 200 {object} notifications.StreamEvent
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /notifications/stream [get]
+func (h *Handler) StreamNotifications(w http.ResponseWriter, r *http.Request) {
+	// The stream starts on the first event, so failures before it (such as a
+	// missing user) still get a JSON error response.
+	var sse *response.SSEWriter
+	emit := func(event string, payload any) error {
+		if sse == nil {
+			started, err := response.StartSSE(w)
+			if err != nil {
+				return err
+			}
+			sse = started
+		}
+		return sse.Write(event, payload)
+	}
+
+	err := h.service.Stream(r.Context(), emit)
+	switch {
+	case err == nil:
+	case sse == nil:
+		h.writeServiceError(w, r, err, "failed to stream notifications")
+	default:
+		h.logger.Warn("notification stream ended with error", "error", err, "path", r.URL.Path)
+	}
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errNotFound *apperrors.NotFound
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errNotFound):
+		response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
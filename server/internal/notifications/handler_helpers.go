@@ -0,0 +1,60 @@
+package notifications
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+func (h *Handler) decodeNotificationID(w http.ResponseWriter, r *http.Request) (int32, bool) {
+	raw := strings.TrimSpace(r.PathValue("id"))
+	if raw == "" {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Missing notification ID", nil)
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || parsed <= 0 {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Invalid notification ID", err)
+		return 0, false
+	}
+
+	return int32(parsed), true
+}
+
+func (h *Handler) decodeListOptions(w http.ResponseWriter, r *http.Request) (ListOptions, bool) {
+	query := r.URL.Query()
+	var opts ListOptions
+
+	if raw := strings.TrimSpace(query.Get("unread")); raw != "" {
+		unread, err := strconv.ParseBool(raw)
+		if err != nil {
+			response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "unread must be a boolean", err)
+			return ListOptions{}, false
+		}
+		opts.UnreadOnly = unread
+	}
+
+	if raw := strings.TrimSpace(query.Get("before_id")); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "before_id must be an integer", err)
+			return ListOptions{}, false
+		}
+		beforeID := int32(parsed)
+		opts.BeforeID = &beforeID
+	}
+
+	if raw := strings.TrimSpace(query.Get("limit")); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil {
+			response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "limit must be an integer", err)
+			return ListOptions{}, false
+		}
+		opts.Limit = limit
+	}
+
+	return opts, true
+}
@@ -0,0 +1,168 @@
+package notifications
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubNotificationsService struct {
+	list   *NotificationsResponse
+	opts   ListOptions
+	id     int32
+	err    error
+	events []string
+}
+
+func (s *stubNotificationsService) List(_ context.Context, opts ListOptions) (*NotificationsResponse, error) {
+	s.opts = opts
+	return s.list, s.err
+}
+
+func (s *stubNotificationsService) UnreadCount(context.Context) (*UnreadCountResponse, error) {
+	return &UnreadCountResponse{Count: 3}, s.err
+}
+
+func (s *stubNotificationsService) MarkRead(_ context.Context, id int32) error {
+	s.id = id
+	return s.err
+}
+
+func (s *stubNotificationsService) MarkAllRead(context.Context) (*MarkAllReadResponse, error) {
+	return &MarkAllReadResponse{Updated: 3}, s.err
+}
+
+func (s *stubNotificationsService) Stream(_ context.Context, emit func(event string, payload any) error) error {
+	if s.err != nil {
+		return s.err
+	}
+	for _, event := range s.events {
+		if err := emit(event, UnreadCountResponse{Count: 1}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// streamRecorder supports the write deadline SSE streams clear.
+type streamRecorder struct {
+	*httptest.ResponseRecorder
+}
+
+func (r *streamRecorder) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+func TestHandlerListNotifications(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("passes query options", func(t *testing.T) {
+		service := &stubNotificationsService{list: &NotificationsResponse{Notifications: []NotificationResponse{{ID: 4, Kind: KindAIRunRecovered}}}}
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).ListNotifications(rr, httptest.NewRequest(http.MethodGet, "/api/notifications?unread=true&before_id=9&limit=10", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"kind":"ai_run_recovered"`)
+		assert.True(t, service.opts.UnreadOnly)
+		require.NotNil(t, service.opts.BeforeID)
+		assert.Equal(t, int32(9), *service.opts.BeforeID)
+		assert.Equal(t, 10, service.opts.Limit)
+	})
+
+	t.Run("rejects a malformed unread filter", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubNotificationsService{}).ListNotifications(rr, httptest.NewRequest(http.MethodGet, "/api/notifications?unread=maybe", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps validation errors", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		service := &stubNotificationsService{err: &ValidationError{Field: "limit", Message: "must be between 1 and 200"}}
+
+		NewHandler(logger, service).ListNotifications(rr, httptest.NewRequest(http.MethodGet, "/api/notifications?limit=500", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandlerMarkNotificationRead(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("marks the notification", func(t *testing.T) {
+		service := &stubNotificationsService{}
+		req := httptest.NewRequest(http.MethodPost, "/api/notifications/12/read", nil)
+		req.SetPathValue("id", "12")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).MarkNotificationRead(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, int32(12), service.id)
+	})
+
+	t.Run("rejects an invalid id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/notifications/abc/read", nil)
+		req.SetPathValue("id", "abc")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubNotificationsService{}).MarkNotificationRead(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps not found", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/notifications/12/read", nil)
+		req.SetPathValue("id", "12")
+		rr := httptest.NewRecorder()
+		service := &stubNotificationsService{err: &apperrors.NotFound{Resource: notificationResource, ID: "12"}}
+
+		NewHandler(logger, service).MarkNotificationRead(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestHandlerMarkAllNotificationsRead(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	rr := httptest.NewRecorder()
+
+	NewHandler(logger, &stubNotificationsService{}).MarkAllNotificationsRead(rr, httptest.NewRequest(http.MethodPost, "/api/notifications/read-all", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"updated":3}`, rr.Body.String())
+}
+
+func TestHandlerStreamNotifications(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("writes events as server-sent events", func(t *testing.T) {
+		rr := &streamRecorder{ResponseRecorder: httptest.NewRecorder()}
+
+		NewHandler(logger, &stubNotificationsService{events: []string{"ready"}}).StreamNotifications(rr, httptest.NewRequest(http.MethodGet, "/api/notifications/stream", nil))
+
+		assert.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Body.String(), "event: ready\n")
+		assert.Contains(t, rr.Body.String(), `data: {"count":1}`)
+	})
+
+	t.Run("returns JSON errors before the stream starts", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		service := &stubNotificationsService{err: &apperrors.Unauthorized{Resource: notificationResource}}
+
+		NewHandler(logger, service).StreamNotifications(rr, httptest.NewRequest(http.MethodGet, "/api/notifications/stream", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Contains(t, rr.Header().Get("Content-Type"), "application/json")
+	})
+}
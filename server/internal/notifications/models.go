@@ -0,0 +1,96 @@
+package notifications
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	notificationResource = "notification"
+	defaultListLimit     = 50
+	maxListLimit         = 200
+	maxTitleLength       = 256
+	maxKindLength        = 64
+
+	// streamPollInterval is how often an open stream checks for new rows.
+	// Producers write inside their own transactions, so polling is what
+	// guarantees a notification is only pushed once it has committed.
+	streamPollInterval = 2 * time.Second
+	// streamHeartbeatInterval keeps idle streams from being closed by
+	// proxies.
+	streamHeartbeatInterval = 25 * time.Second
+	// streamSettleWindow is how long a stream keeps re-reading below an id it
+	// has sent. It is longer than the 30 second timeout writes run under, so
+	// any lower id still in flight has committed or rolled back by then.
+	streamSettleWindow = time.Minute
+)
+
+// Kinds written by the producers in this codebase. Clients should treat
+// unknown kinds as generic notifications.
+const (
+	KindSubscriptionChanged = "subscription_changed"
+	KindAIRunRecovered      = "ai_run_recovered"
+	KindHistorical1RM       = "historical_1rm_increased"
+)
+
+// Notification is what a producer writes. Data is stored as a JSON object and
+// returned to the client unchanged, typically ids to deep-link to.
+type Notification struct {
+	UserID string
+	Kind   string
+	Title  string
+	Body   string
+	Data   map[string]any
+}
+
+type NotificationResponse struct {
+	ID        int32          `json:"id" example:"42"`
+	Kind      string         `json:"kind" example:"historical_1rm_increased"`
+	Title     string         `json:"title" example:"New Back Squat 1RM"`
+	Body      string         `json:"body" example:"Estimated 1RM is now 152.5, up from 147.5."`
+	Data      map[string]any `json:"data"`
+	ReadAt    *time.Time     `json:"read_at,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// NotificationsResponse is one page, newest first. Pass NextBeforeID as
+// before_id to fetch the next page; it is omitted on the last page.
+type NotificationsResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	NextBeforeID  *int32                 `json:"next_before_id,omitempty" example:"17"`
+}
+
+type UnreadCountResponse struct {
+	Count int64 `json:"count" example:"3"`
+}
+
+type MarkAllReadResponse struct {
+	Updated int64 `json:"updated" example:"3"`
+}
+
+// StreamEvent is the payload of a "notification" event on the live stream.
+// UnreadCount is the unread count when the event was sent.
+type StreamEvent struct {
+	Notification NotificationResponse `json:"notification"`
+	UnreadCount  int64                `json:"unread_count"`
+}
+
+// ListOptions filters the inbox. Limit defaults to 50; BeforeID pages back
+// from an earlier response.
+type ListOptions struct {
+	UnreadOnly bool
+	BeforeID   *int32
+	Limit      int
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
@@ -0,0 +1,83 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+)
+
+// Producer writes notifications for other packages. Notify runs on the
+// caller's queries, so a producer inside a transaction passes its
+// transaction-bound queries and the notification commits or rolls back with
+// the change that caused it. Open streams pick it up after commit.
+type Producer interface {
+	Notify(ctx context.Context, q *db.Queries, n Notification) error
+}
+
+type producer struct {
+	logger *slog.Logger
+}
+
+func NewProducer(logger *slog.Logger) Producer {
+	return &producer{logger: logger}
+}
+
+func (p *producer) Notify(ctx context.Context, q *db.Queries, n Notification) error {
+	params, err := createParams(n)
+	if err != nil {
+		return err
+	}
+
+	row, err := q.CreateNotification(ctx, params)
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			p.logger.Error("create notification failed - RLS policy violation",
+				"error", err,
+				"user_id", n.UserID,
+				"kind", n.Kind,
+				"error_type", "rls_violation")
+		}
+		return fmt.Errorf("create notification: %w", err)
+	}
+
+	p.logger.Debug("notification created", "notification_id", row.ID, "user_id", n.UserID, "kind", n.Kind)
+	return nil
+}
+
+func createParams(n Notification) (db.CreateNotificationParams, error) {
+	userID := strings.TrimSpace(n.UserID)
+	kind := strings.TrimSpace(n.Kind)
+	title := strings.TrimSpace(n.Title)
+	switch {
+	case userID == "":
+		return db.CreateNotificationParams{}, &ValidationError{Field: "user_id", Message: "is required"}
+	case kind == "" || len(kind) > maxKindLength:
+		return db.CreateNotificationParams{}, &ValidationError{Field: "kind", Message: fmt.Sprintf("must be 1 to %d characters", maxKindLength)}
+	case title == "":
+		return db.CreateNotificationParams{}, &ValidationError{Field: "title", Message: "is required"}
+	}
+	if len([]rune(title)) > maxTitleLength {
+		title = string([]rune(title)[:maxTitleLength])
+	}
+
+	data := n.Data
+	if data == nil {
+		data = map[string]any{}
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return db.CreateNotificationParams{}, fmt.Errorf("encode notification data: %w", err)
+	}
+
+	return db.CreateNotificationParams{
+		UserID: userID,
+		Kind:   kind,
+		Title:  title,
+		Body:   strings.TrimSpace(n.Body),
+		Data:   encoded,
+	}, nil
+}
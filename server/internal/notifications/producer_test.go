@@ -0,0 +1,53 @@
+package notifications
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateParams(t *testing.T) {
+	t.Run("encodes data and trims text", func(t *testing.T) {
+		params, err := createParams(Notification{
+			UserID: "user-1",
+			Kind:   KindHistorical1RM,
+			Title:  " New Back Squat 1RM ",
+			Body:   " Estimated 1RM is now 150. ",
+			Data:   map[string]any{"exercise_id": 3},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "New Back Squat 1RM", params.Title)
+		assert.Equal(t, "Estimated 1RM is now 150.", params.Body)
+		assert.JSONEq(t, `{"exercise_id":3}`, string(params.Data))
+	})
+
+	t.Run("stores an empty object without data", func(t *testing.T) {
+		params, err := createParams(Notification{UserID: "user-1", Kind: KindAIRunRecovered, Title: "Ready"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "{}", string(params.Data))
+	})
+
+	t.Run("truncates long titles", func(t *testing.T) {
+		params, err := createParams(Notification{UserID: "user-1", Kind: KindAIRunRecovered, Title: strings.Repeat("a", maxTitleLength+10)})
+
+		require.NoError(t, err)
+		assert.Len(t, params.Title, maxTitleLength)
+	})
+
+	t.Run("requires a user, kind and title", func(t *testing.T) {
+		for _, n := range []Notification{
+			{Kind: KindAIRunRecovered, Title: "Ready"},
+			{UserID: "user-1", Title: "Ready"},
+			{UserID: "user-1", Kind: KindAIRunRecovered},
+		} {
+			_, err := createParams(n)
+
+			var validation *ValidationError
+			require.ErrorAs(t, err, &validation)
+		}
+	})
+}
@@ -0,0 +1,157 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	List(ctx context.Context, userID string, params listParams) ([]NotificationResponse, error)
+	// ListAfter returns notifications with an id above afterID, oldest first.
+	ListAfter(ctx context.Context, userID string, afterID int32) ([]NotificationResponse, error)
+	LatestID(ctx context.Context, userID string) (int32, error)
+	UnreadCount(ctx context.Context, userID string) (int64, error)
+	// MarkRead reports whether the notification exists; marking an already
+	// read notification keeps its original read time.
+	MarkRead(ctx context.Context, userID string, id int32) (bool, error)
+	MarkAllRead(ctx context.Context, userID string) (int64, error)
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	conn    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		conn:    conn,
+	}
+}
+
+func (r *repository) List(ctx context.Context, userID string, params listParams) ([]NotificationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var beforeID pgtype.Int4
+	if params.BeforeID != nil {
+		beforeID = pgtype.Int4{Int32: *params.BeforeID, Valid: true}
+	}
+	rows, err := r.queries.ListNotifications(ctx, db.ListNotificationsParams{
+		UserID:     userID,
+		UnreadOnly: params.UnreadOnly,
+		BeforeID:   beforeID,
+		RowLimit:   params.Limit,
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list notifications failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list notifications: %w", err)
+	}
+	return mapNotifications(rows)
+}
+
+func (r *repository) ListAfter(ctx context.Context, userID string, afterID int32) ([]NotificationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListNotificationsAfter(ctx, db.ListNotificationsAfterParams{
+		UserID:  userID,
+		AfterID: afterID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list new notifications: %w", err)
+	}
+	return mapNotifications(rows)
+}
+
+func (r *repository) LatestID(ctx context.Context, userID string) (int32, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	id, err := r.queries.GetLatestNotificationID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("get latest notification id: %w", err)
+	}
+	return id, nil
+}
+
+func (r *repository) UnreadCount(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	count, err := r.queries.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+func (r *repository) MarkRead(ctx context.Context, userID string, id int32) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	affected, err := r.queries.MarkNotificationRead(ctx, db.MarkNotificationReadParams{ID: id, UserID: userID})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("mark notification read failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"notification_id", id,
+				"error_type", "rls_violation")
+		}
+		return false, fmt.Errorf("mark notification read: %w", err)
+	}
+	return affected > 0, nil
+}
+
+func (r *repository) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	affected, err := r.queries.MarkAllNotificationsRead(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("mark all notifications read: %w", err)
+	}
+	return affected, nil
+}
+
+func mapNotifications(rows []db.Notification) ([]NotificationResponse, error) {
+	notifications := make([]NotificationResponse, 0, len(rows))
+	for _, row := range rows {
+		n := NotificationResponse{
+			ID:        row.ID,
+			Kind:      row.Kind,
+			Title:     row.Title,
+			Body:      row.Body,
+			Data:      map[string]any{},
+			CreatedAt: row.CreatedAt.Time,
+		}
+		if len(row.Data) > 0 {
+			if err := json.Unmarshal(row.Data, &n.Data); err != nil {
+				return nil, fmt.Errorf("decode notification %d data: %w", row.ID, err)
+			}
+		}
+		if row.ReadAt.Valid {
+			readAt := row.ReadAt.Time
+			n.ReadAt = &readAt
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
+}
+
+var _ Repository = (*repository)(nil)
@@ -0,0 +1,250 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+const (
+	streamEventReady        = "ready"
+	streamEventNotification = "notification"
+	streamEventPing         = "ping"
+)
+
+type Service struct {
+	logger            *slog.Logger
+	repo              Repository
+	pollInterval      time.Duration
+	heartbeatInterval time.Duration
+	settleWindow      time.Duration
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger:            logger,
+		repo:              repo,
+		pollInterval:      streamPollInterval,
+		heartbeatInterval: streamHeartbeatInterval,
+		settleWindow:      streamSettleWindow,
+	}
+}
+
+type listParams struct {
+	UnreadOnly bool
+	BeforeID   *int32
+	Limit      int32
+}
+
+// List returns one page of the inbox, newest first. Notifications are
+// personal, so delegated coach requests are refused.
+func (s *Service) List(ctx context.Context, opts ListOptions) (*NotificationsResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := validateListOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// One extra row tells us whether there is another page.
+	pageSize := params.Limit
+	params.Limit++
+	notifications, err := s.repo.List(ctx, userID, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	resp := &NotificationsResponse{Notifications: notifications}
+	if len(notifications) > int(pageSize) {
+		resp.Notifications = notifications[:pageSize]
+		next := resp.Notifications[pageSize-1].ID
+		resp.NextBeforeID = &next
+	}
+	return resp, nil
+}
+
+func (s *Service) UnreadCount(ctx context.Context) (*UnreadCountResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.UnreadCount(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return &UnreadCountResponse{Count: count}, nil
+}
+
+// MarkRead marks one notification read. Marking it again is a no-op.
+func (s *Service) MarkRead(ctx context.Context, id int32) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	found, err := s.repo.MarkRead(ctx, userID, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark notification read: %w", err)
+	}
+	if !found {
+		return &apperrors.NotFound{Resource: notificationResource, ID: strconv.Itoa(int(id))}
+	}
+	return nil
+}
+
+func (s *Service) MarkAllRead(ctx context.Context) (*MarkAllReadResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.MarkAllRead(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark all notifications read: %w", err)
+	}
+	return &MarkAllReadResponse{Updated: updated}, nil
+}
+
+// Stream pushes notifications created after it starts until ctx is done.
+// emit receives a "ready" event with the unread count, then a "notification"
+// event per new row and a "ping" event when idle. An error from emit, such as
+// a closed connection, ends the stream.
+func (s *Service) Stream(ctx context.Context, emit func(event string, payload any) error) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	latestID, err := s.repo.LatestID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to start notification stream: %w", err)
+	}
+	unread, err := s.repo.UnreadCount(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	if err := emit(streamEventReady, UnreadCountResponse{Count: unread}); err != nil {
+		return err
+	}
+
+	cursor := newStreamCursor(latestID, s.settleWindow)
+	poll := time.NewTicker(s.pollInterval)
+	defer poll.Stop()
+	heartbeat := time.NewTicker(s.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if err := emit(streamEventPing, struct{}{}); err != nil {
+				return err
+			}
+		case <-poll.C:
+			rows, err := s.repo.ListAfter(ctx, userID, cursor.floor)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return fmt.Errorf("failed to poll notifications: %w", err)
+			}
+			fresh := cursor.unsent(rows)
+			cursor.settle(time.Now())
+			if len(fresh) == 0 {
+				continue
+			}
+			unread, err := s.repo.UnreadCount(ctx, userID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return fmt.Errorf("failed to count unread notifications: %w", err)
+			}
+			for _, n := range fresh {
+				if err := emit(streamEventNotification, StreamEvent{Notification: n, UnreadCount: unread}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// streamCursor tracks what an open stream has sent. Notification ids come
+// from a sequence, so a row can commit after one with a higher id has
+// already been sent. Each poll therefore re-reads from floor, the highest id
+// below which nothing more can appear, and skips ids it has already sent. An
+// id settles once it was sent longer ago than window: anything allocated
+// before it has committed or rolled back by then, so floor moves up to it.
+type streamCursor struct {
+	floor  int32
+	sent   map[int32]time.Time
+	window time.Duration
+}
+
+func newStreamCursor(floor int32, window time.Duration) *streamCursor {
+	return &streamCursor{floor: floor, sent: make(map[int32]time.Time), window: window}
+}
+
+// unsent returns the rows not sent yet, oldest first, and records them as
+// sent.
+func (c *streamCursor) unsent(rows []NotificationResponse) []NotificationResponse {
+	now := time.Now()
+	fresh := make([]NotificationResponse, 0, len(rows))
+	for _, n := range rows {
+		if _, ok := c.sent[n.ID]; ok || n.ID <= c.floor {
+			continue
+		}
+		c.sent[n.ID] = now
+		fresh = append(fresh, n)
+	}
+	return fresh
+}
+
+// settle advances floor through the lowest sent ids that have been sent for
+// longer than the window.
+func (c *streamCursor) settle(now time.Time) {
+	for len(c.sent) > 0 {
+		lowest := int32(0)
+		for id := range c.sent {
+			if lowest == 0 || id < lowest {
+				lowest = id
+			}
+		}
+		if now.Sub(c.sent[lowest]) < c.window {
+			return
+		}
+		c.floor = lowest
+		delete(c.sent, lowest)
+	}
+}
+
+func currentUserID(ctx context.Context) (string, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return "", &apperrors.Unauthorized{Resource: notificationResource, UserID: ""}
+	}
+	return userID, nil
+}
+
+func validateListOptions(opts ListOptions) (listParams, error) {
+	limit := opts.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if limit < 1 || limit > maxListLimit {
+		return listParams{}, &ValidationError{Field: "limit", Message: fmt.Sprintf("must be between 1 and %d", maxListLimit)}
+	}
+	if opts.BeforeID != nil && *opts.BeforeID <= 0 {
+		return listParams{}, &ValidationError{Field: "before_id", Message: "must be a positive integer"}
+	}
+	return listParams{UnreadOnly: opts.UnreadOnly, BeforeID: opts.BeforeID, Limit: int32(limit)}, nil
+}
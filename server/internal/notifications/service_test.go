@@ -0,0 +1,218 @@
+package notifications
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRepository struct {
+	listed   []NotificationResponse
+	params   listParams
+	fresh    [][]NotificationResponse
+	afterIDs []int32
+	latestID int32
+	unread   int64
+	found    bool
+	markedID int32
+}
+
+func (r *stubRepository) List(_ context.Context, _ string, params listParams) ([]NotificationResponse, error) {
+	r.params = params
+	return r.listed, nil
+}
+
+func (r *stubRepository) ListAfter(_ context.Context, _ string, afterID int32) ([]NotificationResponse, error) {
+	r.afterIDs = append(r.afterIDs, afterID)
+	if len(r.fresh) == 0 {
+		return nil, nil
+	}
+	next := r.fresh[0]
+	r.fresh = r.fresh[1:]
+	return next, nil
+}
+
+func (r *stubRepository) LatestID(context.Context, string) (int32, error) {
+	return r.latestID, nil
+}
+
+func (r *stubRepository) UnreadCount(context.Context, string) (int64, error) {
+	return r.unread, nil
+}
+
+func (r *stubRepository) MarkRead(_ context.Context, _ string, id int32) (bool, error) {
+	r.markedID = id
+	return r.found, nil
+}
+
+func (r *stubRepository) MarkAllRead(context.Context, string) (int64, error) {
+	return r.unread, nil
+}
+
+func TestServiceList(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	t.Run("returns a cursor when there is another page", func(t *testing.T) {
+		repo := &stubRepository{listed: []NotificationResponse{{ID: 9}, {ID: 8}, {ID: 7}}}
+
+		resp, err := NewService(logger, repo).List(ctx, ListOptions{Limit: 2, UnreadOnly: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), repo.params.Limit)
+		assert.True(t, repo.params.UnreadOnly)
+		require.Len(t, resp.Notifications, 2)
+		require.NotNil(t, resp.NextBeforeID)
+		assert.Equal(t, int32(8), *resp.NextBeforeID)
+	})
+
+	t.Run("omits the cursor on the last page", func(t *testing.T) {
+		repo := &stubRepository{listed: []NotificationResponse{{ID: 2}}}
+
+		resp, err := NewService(logger, repo).List(ctx, ListOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(defaultListLimit+1), repo.params.Limit)
+		assert.Len(t, resp.Notifications, 1)
+		assert.Nil(t, resp.NextBeforeID)
+	})
+
+	t.Run("rejects out of range limits", func(t *testing.T) {
+		_, err := NewService(logger, &stubRepository{}).List(ctx, ListOptions{Limit: maxListLimit + 1})
+
+		var validation *ValidationError
+		require.ErrorAs(t, err, &validation)
+		assert.Equal(t, "limit", validation.Field)
+	})
+
+	t.Run("refuses delegated requests", func(t *testing.T) {
+		delegated := user.WithDelegation(ctx, user.Delegation{ActorID: "coach-1", Scopes: []string{user.ScopeReadWorkouts}})
+
+		_, err := NewService(logger, &stubRepository{}).List(delegated, ListOptions{})
+
+		var unauthorized *apperrors.Unauthorized
+		require.ErrorAs(t, err, &unauthorized)
+	})
+}
+
+func TestServiceMarkRead(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	t.Run("marks an owned notification", func(t *testing.T) {
+		repo := &stubRepository{found: true}
+
+		err := NewService(logger, repo).MarkRead(ctx, 4)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), repo.markedID)
+	})
+
+	t.Run("maps a missing notification to not found", func(t *testing.T) {
+		err := NewService(logger, &stubRepository{}).MarkRead(ctx, 4)
+
+		var notFound *apperrors.NotFound
+		require.ErrorAs(t, err, &notFound)
+	})
+}
+
+func TestStreamCursor(t *testing.T) {
+	start := time.Now()
+	cursor := newStreamCursor(5, time.Minute)
+
+	assert.Equal(t, []NotificationResponse{{ID: 6}, {ID: 8}}, cursor.unsent([]NotificationResponse{{ID: 5}, {ID: 6}, {ID: 8}}))
+	cursor.settle(start)
+	assert.Equal(t, int32(5), cursor.floor, "recently sent ids keep the window open")
+
+	assert.Equal(t, []NotificationResponse{{ID: 7}}, cursor.unsent([]NotificationResponse{{ID: 6}, {ID: 7}, {ID: 8}}))
+	cursor.settle(start.Add(2 * time.Minute))
+	assert.Equal(t, int32(8), cursor.floor)
+	assert.Empty(t, cursor.sent)
+}
+
+func TestServiceStream(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("sends ready then notifications newer than the start", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(user.WithContext(context.Background(), "user-1"))
+		defer cancel()
+		repo := &stubRepository{
+			latestID: 5,
+			unread:   2,
+			fresh:    [][]NotificationResponse{nil, {{ID: 6}, {ID: 7}}},
+		}
+		service := NewService(logger, repo)
+		service.pollInterval = time.Millisecond
+		service.heartbeatInterval = time.Hour
+
+		var events []string
+		var payloads []any
+		err := service.Stream(ctx, func(event string, payload any) error {
+			events = append(events, event)
+			payloads = append(payloads, payload)
+			if len(events) == 3 {
+				cancel()
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ready", "notification", "notification"}, events)
+		assert.Equal(t, UnreadCountResponse{Count: 2}, payloads[0])
+		assert.Equal(t, int32(7), payloads[2].(StreamEvent).Notification.ID)
+		assert.Equal(t, []int32{5, 5}, repo.afterIDs[:2])
+	})
+
+	t.Run("sends a late commit below an id already sent", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(user.WithContext(context.Background(), "user-1"))
+		defer cancel()
+		repo := &stubRepository{
+			latestID: 5,
+			fresh:    [][]NotificationResponse{{{ID: 6}, {ID: 8}}, {{ID: 6}, {ID: 7}, {ID: 8}}, {{ID: 6}, {ID: 7}, {ID: 8}, {ID: 9}}},
+		}
+		service := NewService(logger, repo)
+		service.pollInterval = time.Millisecond
+		service.heartbeatInterval = time.Hour
+
+		var sent []int32
+		err := service.Stream(ctx, func(event string, payload any) error {
+			if event == streamEventNotification {
+				sent = append(sent, payload.(StreamEvent).Notification.ID)
+			}
+			if len(sent) == 4 {
+				cancel()
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int32{6, 8, 7, 9}, sent)
+		assert.Equal(t, []int32{5, 5, 5}, repo.afterIDs[:3], "the window is re-read until it settles")
+	})
+
+	t.Run("stops when emit fails", func(t *testing.T) {
+		ctx := user.WithContext(context.Background(), "user-1")
+
+		err := NewService(logger, &stubRepository{}).Stream(ctx, func(string, any) error {
+			return context.Canceled
+		})
+
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("refuses requests without a user", func(t *testing.T) {
+		err := NewService(logger, &stubRepository{}).Stream(context.Background(), func(string, any) error {
+			return nil
+		})
+
+		var unauthorized *apperrors.Unauthorized
+		require.ErrorAs(t, err, &unauthorized)
+	})
+}
@@ -0,0 +1,117 @@
+package report
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// buildReport summarizes the workouts in current and compares them with
+// previous. data.workouts may span both windows; progress and historical 1RM
+// rows are expected to cover current only.
+func buildReport(current window, previous window, loc *time.Location, data reportData) *Report {
+	report := &Report{
+		Period:                    current.toPeriod(loc),
+		FocusBreakdown:            []FocusBreakdown{},
+		Historical1RMImprovements: []Historical1RMImprovement{},
+		MostImproved:              []ExerciseImprovement{},
+	}
+	if data.historical1RM != nil {
+		report.Historical1RMImprovements = data.historical1RM
+	}
+
+	var previousTotals Totals
+	focusIndex := make(map[string]int)
+	for _, workout := range data.workouts {
+		switch {
+		case current.contains(workout.date):
+			addWorkout(&report.Totals, workout)
+
+			focus := strings.TrimSpace(workout.focus)
+			if focus == "" {
+				focus = unspecifiedFocus
+			}
+			idx, ok := focusIndex[focus]
+			if !ok {
+				idx = len(report.FocusBreakdown)
+				focusIndex[focus] = idx
+				report.FocusBreakdown = append(report.FocusBreakdown, FocusBreakdown{Focus: focus})
+			}
+			breakdown := &report.FocusBreakdown[idx]
+			breakdown.Sessions++
+			breakdown.WorkingSets += workout.workingSets
+			breakdown.Tonnage += workout.tonnage
+		case previous.contains(workout.date):
+			addWorkout(&previousTotals, workout)
+		}
+	}
+
+	report.Totals.Tonnage = roundTo(report.Totals.Tonnage, 1)
+	previousTotals.Tonnage = roundTo(previousTotals.Tonnage, 1)
+	for i := range report.FocusBreakdown {
+		report.FocusBreakdown[i].Tonnage = roundTo(report.FocusBreakdown[i].Tonnage, 1)
+	}
+	sort.SliceStable(report.FocusBreakdown, func(i, j int) bool {
+		if report.FocusBreakdown[i].Sessions != report.FocusBreakdown[j].Sessions {
+			return report.FocusBreakdown[i].Sessions > report.FocusBreakdown[j].Sessions
+		}
+		return report.FocusBreakdown[i].Focus < report.FocusBreakdown[j].Focus
+	})
+
+	report.MostImproved = mostImproved(data.progress)
+	report.Comparison = PeriodComparison{
+		Previous:          previous.toPeriod(loc),
+		PreviousTotals:    previousTotals,
+		SessionsChange:    report.Totals.Sessions - previousTotals.Sessions,
+		WorkingSetsChange: report.Totals.WorkingSets - previousTotals.WorkingSets,
+		TonnageChange:     roundTo(report.Totals.Tonnage-previousTotals.Tonnage, 1),
+	}
+	if previousTotals.Tonnage > 0 {
+		percent := roundTo((report.Totals.Tonnage-previousTotals.Tonnage)/previousTotals.Tonnage*100, 1)
+		report.Comparison.TonnageChangePercent = &percent
+	}
+	return report
+}
+
+func addWorkout(totals *Totals, workout workoutLoad) {
+	totals.Sessions++
+	totals.WorkingSets += workout.workingSets
+	totals.Tonnage += workout.tonnage
+}
+
+// mostImproved ranks exercises that beat their previous best e1RM by percent
+// gain. Exercises first trained in the period have no baseline and are skipped.
+func mostImproved(progress []exerciseProgress) []ExerciseImprovement {
+	improvements := []ExerciseImprovement{}
+	for _, exercise := range progress {
+		if exercise.priorBestE1RM <= 0 || exercise.periodBestE1RM <= exercise.priorBestE1RM {
+			continue
+		}
+		change := exercise.periodBestE1RM - exercise.priorBestE1RM
+		improvements = append(improvements, ExerciseImprovement{
+			ExerciseID:     exercise.exerciseID,
+			ExerciseName:   exercise.exerciseName,
+			PeriodBestE1RM: roundTo(exercise.periodBestE1RM, 1),
+			PriorBestE1RM:  roundTo(exercise.priorBestE1RM, 1),
+			Change:         roundTo(change, 1),
+			ChangePercent:  roundTo(change/exercise.priorBestE1RM*100, 1),
+		})
+	}
+
+	sort.SliceStable(improvements, func(i, j int) bool {
+		if improvements[i].ChangePercent != improvements[j].ChangePercent {
+			return improvements[i].ChangePercent > improvements[j].ChangePercent
+		}
+		return improvements[i].ExerciseName < improvements[j].ExerciseName
+	})
+	if len(improvements) > mostImprovedLimit {
+		improvements = improvements[:mostImprovedLimit]
+	}
+	return improvements
+}
+
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
@@ -0,0 +1,160 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func reportTestDay(t *testing.T, value string) time.Time {
+	t.Helper()
+	day, err := time.Parse(reportDateLayout, value)
+	require.NoError(t, err)
+	return day
+}
+
+func reportTestTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	instant, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	return instant
+}
+
+func TestResolveWindow(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	day := reportTestDay(t, "2026-03-11")
+
+	tests := []struct {
+		period        string
+		wantStart     string
+		wantEnd       string
+		wantPrevStart string
+		wantPrevEnd   string
+	}{
+		{period: PeriodWeek, wantStart: "2026-03-09", wantEnd: "2026-03-15", wantPrevStart: "2026-03-02", wantPrevEnd: "2026-03-08"},
+		{period: PeriodMonth, wantStart: "2026-03-01", wantEnd: "2026-03-31", wantPrevStart: "2026-02-01", wantPrevEnd: "2026-02-28"},
+		{period: PeriodYear, wantStart: "2026-01-01", wantEnd: "2026-12-31", wantPrevStart: "2025-01-01", wantPrevEnd: "2025-12-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.period, func(t *testing.T) {
+			current := resolveWindow(tt.period, day, loc)
+			previous := current.previous(loc)
+
+			assert.Equal(t, Period{Type: tt.period, Start: tt.wantStart, End: tt.wantEnd, Timezone: "America/New_York"}, current.toPeriod(loc))
+			assert.Equal(t, tt.wantPrevStart, previous.toPeriod(loc).Start)
+			assert.Equal(t, tt.wantPrevEnd, previous.toPeriod(loc).End)
+			assert.Equal(t, current.startAt, previous.endAt)
+		})
+	}
+
+	t.Run("bounds are local midnights across DST", func(t *testing.T) {
+		current := resolveWindow(PeriodWeek, day, loc)
+
+		// 2026-03-08 is the US spring-forward Sunday, so the previous week
+		// spans 167 hours.
+		assert.Equal(t, reportTestTime(t, "2026-03-09T04:00:00Z"), current.startAt.UTC())
+		assert.Equal(t, 167*time.Hour, current.startAt.Sub(current.previous(loc).startAt))
+	})
+}
+
+func TestBuildReportAggregatesAndCompares(t *testing.T) {
+	current := resolveWindow(PeriodWeek, reportTestDay(t, "2026-07-01"), time.UTC)
+	previous := current.previous(time.UTC)
+	sourceWorkout := int32(41)
+	data := reportData{
+		workouts: []workoutLoad{
+			{date: reportTestTime(t, "2026-06-23T10:00:00Z"), focus: "Upper", workingSets: 10, tonnage: 8000},
+			{date: reportTestTime(t, "2026-06-29T10:00:00Z"), focus: "Upper", workingSets: 12, tonnage: 9000},
+			{date: reportTestTime(t, "2026-07-01T10:00:00Z"), focus: "Lower", workingSets: 8, tonnage: 12000},
+			{date: reportTestTime(t, "2026-07-03T10:00:00Z"), focus: " ", workingSets: 4, tonnage: 1000.04},
+			{date: reportTestTime(t, "2026-07-04T10:00:00Z"), focus: "Upper", workingSets: 10, tonnage: 7000},
+		},
+		progress: []exerciseProgress{
+			{exerciseID: 1, exerciseName: "Bench Press", periodBestE1RM: 231, priorBestE1RM: 220},
+			{exerciseID: 2, exerciseName: "Squat", periodBestE1RM: 330, priorBestE1RM: 300},
+			{exerciseID: 3, exerciseName: "Row", periodBestE1RM: 190, priorBestE1RM: 200},
+			{exerciseID: 4, exerciseName: "Lunge", periodBestE1RM: 150},
+		},
+		historical1RM: []Historical1RMImprovement{
+			{ExerciseID: 2, ExerciseName: "Squat", Historical1RM: 325, UpdatedOn: "2026-07-01", SourceWorkoutID: &sourceWorkout},
+		},
+	}
+
+	report := buildReport(current, previous, time.UTC, data)
+
+	assert.Equal(t, Period{Type: PeriodWeek, Start: "2026-06-29", End: "2026-07-05", Timezone: "UTC"}, report.Period)
+	assert.Equal(t, Totals{Sessions: 4, WorkingSets: 34, Tonnage: 29000}, report.Totals)
+	assert.Equal(t, []FocusBreakdown{
+		{Focus: "Upper", Sessions: 2, WorkingSets: 22, Tonnage: 16000},
+		{Focus: "Lower", Sessions: 1, WorkingSets: 8, Tonnage: 12000},
+		{Focus: unspecifiedFocus, Sessions: 1, WorkingSets: 4, Tonnage: 1000},
+	}, report.FocusBreakdown)
+
+	require.Len(t, report.MostImproved, 2)
+	assert.Equal(t, ExerciseImprovement{ExerciseID: 2, ExerciseName: "Squat", PeriodBestE1RM: 330, PriorBestE1RM: 300, Change: 30, ChangePercent: 10}, report.MostImproved[0])
+	assert.Equal(t, "Bench Press", report.MostImproved[1].ExerciseName)
+	assert.Equal(t, 5.0, report.MostImproved[1].ChangePercent)
+	assert.Equal(t, data.historical1RM, report.Historical1RMImprovements)
+
+	assert.Equal(t, "2026-06-22", report.Comparison.Previous.Start)
+	assert.Equal(t, Totals{Sessions: 1, WorkingSets: 10, Tonnage: 8000}, report.Comparison.PreviousTotals)
+	assert.Equal(t, 3, report.Comparison.SessionsChange)
+	assert.Equal(t, 24, report.Comparison.WorkingSetsChange)
+	assert.Equal(t, 21000.0, report.Comparison.TonnageChange)
+	require.NotNil(t, report.Comparison.TonnageChangePercent)
+	assert.Equal(t, 262.5, *report.Comparison.TonnageChangePercent)
+}
+
+func TestBuildReportWithoutWorkouts(t *testing.T) {
+	current := resolveWindow(PeriodMonth, reportTestDay(t, "2026-07-15"), time.UTC)
+
+	report := buildReport(current, current.previous(time.UTC), time.UTC, reportData{})
+
+	assert.Zero(t, report.Totals)
+	assert.NotNil(t, report.FocusBreakdown)
+	assert.NotNil(t, report.MostImproved)
+	assert.NotNil(t, report.Historical1RMImprovements)
+	assert.Nil(t, report.Comparison.TonnageChangePercent)
+}
+
+func TestMostImprovedLimitsResults(t *testing.T) {
+	var progress []exerciseProgress
+	for i := int32(1); i <= mostImprovedLimit+2; i++ {
+		progress = append(progress, exerciseProgress{exerciseID: i, exerciseName: "Lift", periodBestE1RM: 100 + float64(i), priorBestE1RM: 100})
+	}
+
+	improvements := mostImproved(progress)
+
+	require.Len(t, improvements, mostImprovedLimit)
+	assert.Equal(t, int32(mostImprovedLimit+2), improvements[0].ExerciseID)
+}
+
+func TestValidateReportOptions(t *testing.T) {
+	today := reportTestDay(t, "2026-07-10")
+
+	period, day, err := validateReportOptions(ReportOptions{Period: " Month "}, today)
+	require.NoError(t, err)
+	assert.Equal(t, PeriodMonth, period)
+	assert.Equal(t, today, day)
+
+	_, day, err = validateReportOptions(ReportOptions{Period: PeriodYear, Date: "2025-02-14"}, today)
+	require.NoError(t, err)
+	assert.Equal(t, reportTestDay(t, "2025-02-14"), day)
+
+	var validationErr *ValidationError
+	_, _, err = validateReportOptions(ReportOptions{Period: "quarter"}, today)
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "period", validationErr.Field)
+
+	_, _, err = validateReportOptions(ReportOptions{Period: PeriodWeek, Date: "07/01/2026"}, today)
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "date", validationErr.Field)
+
+	_, _, err = validateReportOptions(ReportOptions{Period: PeriodWeek, Date: "2026-07-11"}, today)
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "date", validationErr.Field)
+}
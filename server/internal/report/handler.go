@@ -0,0 +1,80 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type reportService interface {
+	GetReport(ctx context.Context, opts ReportOptions) (*Report, error)
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service reportService
+}
+
+func NewHandler(logger *slog.Logger, service reportService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// GetReport godoc
+// @Summary Get training summary report
+// @Description Summarizes the week, month or year containing the given date in the user's timezone: sessions, working sets, tonnage, per-focus breakdown, new historical 1RMs, most improved exercises and the change from the previous period. Send `Accept: text/markdown` for a Markdown recap instead of JSON.
+// @Tags reports
+// @Produce json
+// @Produce text/markdown
+// @Security StackAuth
+// @Param period path string true "Report period" Enums(week, month, year)
+// @Param date query string false "Any day inside the period (YYYY-MM-DD); defaults to today"
+// @Success 200 {object} report.Report
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /reports/{period} [get]
+func (h *Handler) GetReport(w http.ResponseWriter, r *http.Request) {
+	opts := ReportOptions{
+		Period: r.PathValue("period"),
+		Date:   strings.TrimSpace(r.URL.Query().Get("date")),
+	}
+
+	report, err := h.service.GetReport(r.Context(), opts)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get training report")
+		return
+	}
+
+	w.Header().Add("Vary", "Accept")
+	if response.PrefersMarkdown(r.Header.Get("Accept")) {
+		if err := response.Markdown(w, http.StatusOK, RenderMarkdown(report)); err != nil {
+			h.logger.Error("failed to write markdown report", "error", err)
+		}
+		return
+	}
+	if err := response.JSON(w, http.StatusOK, report); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
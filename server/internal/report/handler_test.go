@@ -0,0 +1,101 @@
+package report
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubReportService struct {
+	report *Report
+	err    error
+	opts   ReportOptions
+}
+
+func (s *stubReportService) GetReport(_ context.Context, opts ReportOptions) (*Report, error) {
+	s.opts = opts
+	return s.report, s.err
+}
+
+func newReportRequest(target string, period string, accept string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	req.SetPathValue("period", period)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	return req
+}
+
+func testReport() *Report {
+	return &Report{
+		Period:                    Period{Type: PeriodWeek, Start: "2026-06-29", End: "2026-07-05", Timezone: "UTC"},
+		FocusBreakdown:            []FocusBreakdown{},
+		Historical1RMImprovements: []Historical1RMImprovement{},
+		MostImproved:              []ExerciseImprovement{},
+	}
+}
+
+func TestHandlerGetReport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("writes JSON by default", func(t *testing.T) {
+		service := &stubReportService{report: testReport()}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.GetReport(rr, newReportRequest("/api/reports/week?date=2026-07-01", "week", "application/json"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, ReportOptions{Period: "week", Date: "2026-07-01"}, service.opts)
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "Accept", rr.Header().Get("Vary"))
+		assert.Contains(t, rr.Body.String(), `"start":"2026-06-29"`)
+	})
+
+	t.Run("renders markdown when negotiated", func(t *testing.T) {
+		handler := NewHandler(logger, &stubReportService{report: testReport()})
+		rr := httptest.NewRecorder()
+
+		handler.GetReport(rr, newReportRequest("/api/reports/week", "week", "text/markdown, application/json;q=0.5"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/markdown; charset=utf-8", rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Body.String(), "# Week of June 29, 2026")
+	})
+
+	t.Run("wildcard accept keeps JSON", func(t *testing.T) {
+		handler := NewHandler(logger, &stubReportService{report: testReport()})
+		rr := httptest.NewRecorder()
+
+		handler.GetReport(rr, newReportRequest("/api/reports/week", "week", "*/*"))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	})
+
+	t.Run("maps validation errors to bad request", func(t *testing.T) {
+		handler := NewHandler(logger, &stubReportService{err: &ValidationError{Field: "period", Message: "must be week, month or year"}})
+		rr := httptest.NewRecorder()
+
+		handler.GetReport(rr, newReportRequest("/api/reports/decade", "decade", ""))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "period")
+	})
+
+	t.Run("maps unauthorized", func(t *testing.T) {
+		handler := NewHandler(logger, &stubReportService{err: &apperrors.Unauthorized{Resource: "training report"}})
+		rr := httptest.NewRecorder()
+
+		handler.GetReport(rr, newReportRequest("/api/reports/month", "month", ""))
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
@@ -0,0 +1,98 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RenderMarkdown formats a report as a Markdown recap for clients that
+// negotiate text/markdown.
+func RenderMarkdown(report *Report) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", markdownTitle(report.Period))
+	fmt.Fprintf(&b, "%s to %s (%s)\n\n", report.Period.Start, report.Period.End, report.Period.Timezone)
+
+	b.WriteString("## Summary\n\n")
+	fmt.Fprintf(&b, "- Sessions: %d%s\n", report.Totals.Sessions, signedInt(report.Comparison.SessionsChange))
+	fmt.Fprintf(&b, "- Working sets: %d%s\n", report.Totals.WorkingSets, signedInt(report.Comparison.WorkingSetsChange))
+	fmt.Fprintf(&b, "- Tonnage: %s lb%s\n", formatNumber(report.Totals.Tonnage), tonnageChange(report.Comparison))
+	fmt.Fprintf(&b, "\nChanges are against the previous %s (%s to %s).\n", report.Period.Type, report.Comparison.Previous.Start, report.Comparison.Previous.End)
+
+	if len(report.FocusBreakdown) > 0 {
+		b.WriteString("\n## By focus\n\n")
+		b.WriteString("| Focus | Sessions | Working sets | Tonnage (lb) |\n")
+		b.WriteString("| --- | ---: | ---: | ---: |\n")
+		for _, focus := range report.FocusBreakdown {
+			fmt.Fprintf(&b, "| %s | %d | %d | %s |\n", escapeTableCell(focus.Focus), focus.Sessions, focus.WorkingSets, formatNumber(focus.Tonnage))
+		}
+	}
+
+	if len(report.MostImproved) > 0 {
+		b.WriteString("\n## Most improved\n\n")
+		for _, exercise := range report.MostImproved {
+			fmt.Fprintf(&b, "- %s: %s → %s lb e1RM (+%s%%)\n", exercise.ExerciseName, formatNumber(exercise.PriorBestE1RM), formatNumber(exercise.PeriodBestE1RM), formatNumber(exercise.ChangePercent))
+		}
+	}
+
+	if len(report.Historical1RMImprovements) > 0 {
+		b.WriteString("\n## New historical 1RMs\n\n")
+		for _, improvement := range report.Historical1RMImprovements {
+			fmt.Fprintf(&b, "- %s: %s lb on %s\n", improvement.ExerciseName, formatNumber(improvement.Historical1RM), improvement.UpdatedOn)
+		}
+	}
+
+	if report.Totals.Sessions == 0 {
+		fmt.Fprintf(&b, "\nNo workouts were logged this %s.\n", report.Period.Type)
+	}
+	return b.String()
+}
+
+func markdownTitle(period Period) string {
+	start, err := time.Parse(reportDateLayout, period.Start)
+	if err != nil {
+		return "Training report"
+	}
+	switch period.Type {
+	case PeriodYear:
+		return fmt.Sprintf("%d year in review", start.Year())
+	case PeriodMonth:
+		return start.Format("January 2006") + " training report"
+	default:
+		return "Week of " + start.Format("January 2, 2006")
+	}
+}
+
+func signedInt(change int) string {
+	if change == 0 {
+		return " (no change)"
+	}
+	return fmt.Sprintf(" (%+d)", change)
+}
+
+func tonnageChange(comparison PeriodComparison) string {
+	if comparison.TonnageChange == 0 {
+		return " (no change)"
+	}
+	sign := "+"
+	if comparison.TonnageChange < 0 {
+		sign = "-"
+	}
+	change := sign + formatNumber(math.Abs(comparison.TonnageChange))
+	if comparison.TonnageChangePercent == nil {
+		return " (" + change + ")"
+	}
+	return fmt.Sprintf(" (%s, %+.1f%%)", change, *comparison.TonnageChangePercent)
+}
+
+// formatNumber drops a trailing ".0" so whole numbers read naturally.
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func escapeTableCell(value string) string {
+	return strings.ReplaceAll(value, "|", `\|`)
+}
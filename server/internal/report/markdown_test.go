@@ -0,0 +1,56 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	percent := -12.5
+	report := &Report{
+		Period: Period{Type: PeriodMonth, Start: "2026-07-01", End: "2026-07-31", Timezone: "UTC"},
+		Totals: Totals{Sessions: 12, WorkingSets: 180, Tonnage: 70000},
+		FocusBreakdown: []FocusBreakdown{
+			{Focus: "Push|Pull", Sessions: 12, WorkingSets: 180, Tonnage: 70000},
+		},
+		MostImproved: []ExerciseImprovement{
+			{ExerciseName: "Squat", PeriodBestE1RM: 330, PriorBestE1RM: 300, Change: 30, ChangePercent: 10},
+		},
+		Historical1RMImprovements: []Historical1RMImprovement{
+			{ExerciseName: "Squat", Historical1RM: 325.5, UpdatedOn: "2026-07-14"},
+		},
+		Comparison: PeriodComparison{
+			Previous:             Period{Type: PeriodMonth, Start: "2026-06-01", End: "2026-06-30"},
+			SessionsChange:       2,
+			TonnageChange:        -10000,
+			TonnageChangePercent: &percent,
+		},
+	}
+
+	markdown := RenderMarkdown(report)
+
+	assert.Contains(t, markdown, "# July 2026 training report\n")
+	assert.Contains(t, markdown, "- Sessions: 12 (+2)\n")
+	assert.Contains(t, markdown, "- Working sets: 180 (no change)\n")
+	assert.Contains(t, markdown, "- Tonnage: 70000 lb (-10000, -12.5%)\n")
+	assert.Contains(t, markdown, "previous month (2026-06-01 to 2026-06-30)")
+	assert.Contains(t, markdown, `| Push\|Pull | 12 | 180 | 70000 |`)
+	assert.Contains(t, markdown, "- Squat: 300 → 330 lb e1RM (+10%)\n")
+	assert.Contains(t, markdown, "- Squat: 325.5 lb on 2026-07-14\n")
+	assert.NotContains(t, markdown, "No workouts were logged")
+}
+
+func TestRenderMarkdownEmptyYear(t *testing.T) {
+	report := &Report{
+		Period:     Period{Type: PeriodYear, Start: "2025-01-01", End: "2025-12-31", Timezone: "UTC"},
+		Comparison: PeriodComparison{Previous: Period{Type: PeriodYear, Start: "2024-01-01", End: "2024-12-31"}},
+	}
+
+	markdown := RenderMarkdown(report)
+
+	assert.Contains(t, markdown, "# 2025 year in review\n")
+	assert.Contains(t, markdown, "No workouts were logged this year.")
+	assert.NotContains(t, markdown, "## By focus")
+	assert.NotContains(t, markdown, "## Most improved")
+}
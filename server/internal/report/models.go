@@ -0,0 +1,133 @@
+package report
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+	PeriodYear  = "year"
+)
+
+const (
+	reportDateLayout  = "2006-01-02"
+	mostImprovedLimit = 5
+	// unspecifiedFocus groups workouts logged without a focus in the breakdown.
+	unspecifiedFocus = "Unspecified"
+)
+
+// ReportOptions selects the calendar period to summarize. Date may be any day
+// inside the period (YYYY-MM-DD) and defaults to today in the user's timezone.
+type ReportOptions struct {
+	Period string
+	Date   string
+}
+
+// Period is an inclusive range of local calendar days.
+type Period struct {
+	Type     string `json:"type" example:"week"`
+	Start    string `json:"start" example:"2026-06-29"`
+	End      string `json:"end" example:"2026-07-05"`
+	Timezone string `json:"timezone" example:"America/New_York"`
+}
+
+// Totals aggregate working sets across every workout in a period. Tonnage is
+// weight × reps in the units the user logs lifts in.
+type Totals struct {
+	Sessions    int     `json:"sessions"`
+	WorkingSets int     `json:"working_sets"`
+	Tonnage     float64 `json:"tonnage"`
+}
+
+type FocusBreakdown struct {
+	Focus       string  `json:"focus" example:"Upper Body"`
+	Sessions    int     `json:"sessions"`
+	WorkingSets int     `json:"working_sets"`
+	Tonnage     float64 `json:"tonnage"`
+}
+
+// Historical1RMImprovement is an exercise whose stored historical 1RM was
+// raised during the period.
+type Historical1RMImprovement struct {
+	ExerciseID      int32   `json:"exercise_id"`
+	ExerciseName    string  `json:"exercise_name"`
+	Historical1RM   float64 `json:"historical_1rm"`
+	UpdatedOn       string  `json:"updated_on" example:"2026-07-02"`
+	SourceWorkoutID *int32  `json:"source_workout_id,omitempty"`
+}
+
+// ExerciseImprovement compares an exercise's best e1RM in the period with its
+// best from all earlier sessions.
+type ExerciseImprovement struct {
+	ExerciseID     int32   `json:"exercise_id"`
+	ExerciseName   string  `json:"exercise_name"`
+	PeriodBestE1RM float64 `json:"period_best_e1rm"`
+	PriorBestE1RM  float64 `json:"prior_best_e1rm"`
+	Change         float64 `json:"change"`
+	ChangePercent  float64 `json:"change_percent"`
+}
+
+// PeriodComparison reports the previous period of the same length and the
+// change from it. TonnageChangePercent is nil when the previous period had
+// no tonnage.
+type PeriodComparison struct {
+	Previous             Period   `json:"previous"`
+	PreviousTotals       Totals   `json:"previous_totals"`
+	SessionsChange       int      `json:"sessions_change"`
+	WorkingSetsChange    int      `json:"working_sets_change"`
+	TonnageChange        float64  `json:"tonnage_change"`
+	TonnageChangePercent *float64 `json:"tonnage_change_percent"`
+}
+
+type Report struct {
+	Period                    Period                     `json:"period"`
+	Totals                    Totals                     `json:"totals"`
+	FocusBreakdown            []FocusBreakdown           `json:"focus_breakdown"`
+	Historical1RMImprovements []Historical1RMImprovement `json:"historical_1rm_improvements"`
+	MostImproved              []ExerciseImprovement      `json:"most_improved"`
+	Comparison                PeriodComparison           `json:"comparison"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// window is a period's local calendar days plus the instants bounding it.
+// StartAt is inclusive and EndAt exclusive.
+type window struct {
+	period   string
+	firstDay time.Time
+	lastDay  time.Time
+	startAt  time.Time
+	endAt    time.Time
+}
+
+type workoutLoad struct {
+	date        time.Time
+	focus       string
+	workingSets int
+	tonnage     float64
+}
+
+type exerciseProgress struct {
+	exerciseID     int32
+	exerciseName   string
+	periodBestE1RM float64
+	priorBestE1RM  float64
+}
+
+type reportData struct {
+	workouts      []workoutLoad
+	progress      []exerciseProgress
+	historical1RM []Historical1RMImprovement
+}
@@ -0,0 +1,49 @@
+package report
+
+import "time"
+
+// resolveWindow returns the calendar period of the given type containing day.
+// day is a local civil date expressed as a UTC midnight; the returned instants
+// are local midnights in loc, so DST transitions stay on day boundaries.
+func resolveWindow(period string, day time.Time, loc *time.Location) window {
+	var firstDay, lastDay time.Time
+	switch period {
+	case PeriodYear:
+		firstDay = time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		lastDay = firstDay.AddDate(1, 0, -1)
+	case PeriodMonth:
+		firstDay = time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+		lastDay = firstDay.AddDate(0, 1, -1)
+	default:
+		// Weeks run Monday through Sunday, matching the consistency analytics.
+		firstDay = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		lastDay = firstDay.AddDate(0, 0, 6)
+	}
+
+	endDay := lastDay.AddDate(0, 0, 1)
+	return window{
+		period:   period,
+		firstDay: firstDay,
+		lastDay:  lastDay,
+		startAt:  time.Date(firstDay.Year(), firstDay.Month(), firstDay.Day(), 0, 0, 0, 0, loc),
+		endAt:    time.Date(endDay.Year(), endDay.Month(), endDay.Day(), 0, 0, 0, 0, loc),
+	}
+}
+
+// previous returns the period immediately before w.
+func (w window) previous(loc *time.Location) window {
+	return resolveWindow(w.period, w.firstDay.AddDate(0, 0, -1), loc)
+}
+
+func (w window) contains(instant time.Time) bool {
+	return !instant.Before(w.startAt) && instant.Before(w.endAt)
+}
+
+func (w window) toPeriod(loc *time.Location) Period {
+	return Period{
+		Type:     w.period,
+		Start:    w.firstDay.Format(reportDateLayout),
+		End:      w.lastDay.Format(reportDateLayout),
+		Timezone: loc.String(),
+	}
+}
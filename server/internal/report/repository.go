@@ -0,0 +1,136 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	ListWorkouts(ctx context.Context, userID string, startAt time.Time, endAt time.Time) ([]workoutLoad, error)
+	ListExerciseProgress(ctx context.Context, userID string, startAt time.Time, endAt time.Time) ([]exerciseProgress, error)
+	ListHistorical1RMUpdates(ctx context.Context, userID string, startAt time.Time, endAt time.Time, loc *time.Location) ([]Historical1RMImprovement, error)
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	conn    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		conn:    conn,
+	}
+}
+
+func (r *repository) ListWorkouts(ctx context.Context, userID string, startAt time.Time, endAt time.Time) ([]workoutLoad, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListReportWorkouts(ctx, db.ListReportWorkoutsParams{
+		UserID:  userID,
+		StartAt: pgtype.Timestamptz{Time: startAt, Valid: true},
+		EndAt:   pgtype.Timestamptz{Time: endAt, Valid: true},
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list report workouts failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list report workouts: %w", err)
+	}
+
+	workouts := make([]workoutLoad, 0, len(rows))
+	for _, row := range rows {
+		if !row.Date.Valid {
+			continue
+		}
+		workouts = append(workouts, workoutLoad{
+			date:        row.Date.Time,
+			focus:       row.WorkoutFocus.String,
+			workingSets: int(row.WorkingSets),
+			tonnage:     row.Tonnage,
+		})
+	}
+	return workouts, nil
+}
+
+func (r *repository) ListExerciseProgress(ctx context.Context, userID string, startAt time.Time, endAt time.Time) ([]exerciseProgress, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListReportExerciseProgress(ctx, db.ListReportExerciseProgressParams{
+		StartAt: pgtype.Timestamptz{Time: startAt, Valid: true},
+		UserID:  userID,
+		EndAt:   pgtype.Timestamptz{Time: endAt, Valid: true},
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list report exercise progress failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list report exercise progress: %w", err)
+	}
+
+	progress := make([]exerciseProgress, 0, len(rows))
+	for _, row := range rows {
+		progress = append(progress, exerciseProgress{
+			exerciseID:     row.ExerciseID,
+			exerciseName:   row.ExerciseName,
+			periodBestE1RM: row.PeriodBestE1rm,
+			priorBestE1RM:  row.PriorBestE1rm,
+		})
+	}
+	return progress, nil
+}
+
+func (r *repository) ListHistorical1RMUpdates(ctx context.Context, userID string, startAt time.Time, endAt time.Time, loc *time.Location) ([]Historical1RMImprovement, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListHistorical1RMUpdatesBetween(ctx, db.ListHistorical1RMUpdatesBetweenParams{
+		UserID:  userID,
+		StartAt: pgtype.Timestamptz{Time: startAt, Valid: true},
+		EndAt:   pgtype.Timestamptz{Time: endAt, Valid: true},
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list historical 1RM updates failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list historical 1RM updates: %w", err)
+	}
+
+	improvements := make([]Historical1RMImprovement, 0, len(rows))
+	for _, row := range rows {
+		improvement := Historical1RMImprovement{
+			ExerciseID:    row.ID,
+			ExerciseName:  row.Name,
+			Historical1RM: row.Historical1rm,
+			UpdatedOn:     user.CivilDay(row.Historical1rmUpdatedAt.Time, loc).Format(reportDateLayout),
+		}
+		if row.Historical1rmSourceWorkoutID.Valid {
+			workoutID := row.Historical1rmSourceWorkoutID.Int32
+			improvement.SourceWorkoutID = &workoutID
+		}
+		improvements = append(improvements, improvement)
+	}
+	return improvements, nil
+}
+
+var _ Repository = (*repository)(nil)
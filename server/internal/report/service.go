@@ -0,0 +1,76 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger *slog.Logger
+	repo   Repository
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// GetReport summarizes the week, month or year containing opts.Date in the
+// user's timezone and compares it with the period before.
+func (s *Service) GetReport(ctx context.Context, opts ReportOptions) (*Report, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: "training report", UserID: ""}
+	}
+
+	loc := user.Location(ctx)
+	period, day, err := validateReportOptions(opts, user.CivilDay(time.Now(), loc))
+	if err != nil {
+		return nil, err
+	}
+
+	current := resolveWindow(period, day, loc)
+	previous := current.previous(loc)
+
+	var data reportData
+	if data.workouts, err = s.repo.ListWorkouts(ctx, userID, previous.startAt, current.endAt); err != nil {
+		return nil, fmt.Errorf("failed to get training report: %w", err)
+	}
+	if data.progress, err = s.repo.ListExerciseProgress(ctx, userID, current.startAt, current.endAt); err != nil {
+		return nil, fmt.Errorf("failed to get training report: %w", err)
+	}
+	if data.historical1RM, err = s.repo.ListHistorical1RMUpdates(ctx, userID, current.startAt, current.endAt, loc); err != nil {
+		return nil, fmt.Errorf("failed to get training report: %w", err)
+	}
+	return buildReport(current, previous, loc, data), nil
+}
+
+func validateReportOptions(opts ReportOptions, today time.Time) (string, time.Time, error) {
+	period := strings.ToLower(strings.TrimSpace(opts.Period))
+	switch period {
+	case PeriodWeek, PeriodMonth, PeriodYear:
+	default:
+		return "", time.Time{}, &ValidationError{Field: "period", Message: fmt.Sprintf("must be %s, %s or %s", PeriodWeek, PeriodMonth, PeriodYear)}
+	}
+
+	rawDate := strings.TrimSpace(opts.Date)
+	if rawDate == "" {
+		return period, today, nil
+	}
+	day, err := time.Parse(reportDateLayout, rawDate)
+	if err != nil {
+		return "", time.Time{}, &ValidationError{Field: "date", Message: "must use YYYY-MM-DD"}
+	}
+	if day.After(today) {
+		return "", time.Time{}, &ValidationError{Field: "date", Message: "must not be in the future"}
+	}
+	return period, day, nil
+}
@@ -0,0 +1,87 @@
+package response
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// PrefersMarkdown reports whether an Accept header explicitly asks for
+// text/markdown at a quality no lower than text/html. Wildcards alone never
+// select Markdown, so browsers and JSON clients keep their default.
+func PrefersMarkdown(accept string) bool {
+	markdownQuality, markdownExplicit := representationQuality(accept, "text/markdown")
+	if !markdownExplicit || markdownQuality <= 0 {
+		return false
+	}
+
+	htmlQuality, _ := representationQuality(accept, "text/html")
+	return markdownQuality >= htmlQuality
+}
+
+// PrefersHTML reports whether an Accept header explicitly asks for text/html
+// at a quality no lower than application/json, as browsers navigating to a
+// page do.
+func PrefersHTML(accept string) bool {
+	htmlQuality, htmlExplicit := representationQuality(accept, "text/html")
+	if !htmlExplicit || htmlQuality <= 0 {
+		return false
+	}
+
+	jsonQuality, _ := representationQuality(accept, "application/json")
+	return htmlQuality >= jsonQuality
+}
+
+func representationQuality(accept, representation string) (float64, bool) {
+	quality := 0.0
+	bestSpecificity := -1
+	explicit := false
+	representationParts := strings.SplitN(representation, "/", 2)
+
+	for _, item := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+		mediaParts := strings.SplitN(mediaType, "/", 2)
+		if len(mediaParts) != 2 || (mediaParts[0] != "*" && mediaParts[0] != representationParts[0]) || (mediaParts[1] != "*" && mediaParts[1] != representationParts[1]) {
+			continue
+		}
+
+		specificity := 0
+		if mediaParts[0] != "*" {
+			specificity++
+		}
+		if mediaParts[1] != "*" {
+			specificity++
+		}
+		if mediaType == representation {
+			explicit = true
+		}
+		if specificity < bestSpecificity {
+			continue
+		}
+
+		itemQuality := 1.0
+		if rawQuality, ok := params["q"]; ok {
+			parsedQuality, err := strconv.ParseFloat(rawQuality, 64)
+			if err != nil || parsedQuality < 0 || parsedQuality > 1 {
+				continue
+			}
+			itemQuality = parsedQuality
+		}
+		bestSpecificity = specificity
+		quality = itemQuality
+	}
+
+	return quality, explicit
+}
+
+// Markdown writes a Markdown document to the HTTP response writer.
+func Markdown(w http.ResponseWriter, status int, body string) error {
+	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+	w.WriteHeader(status)
+	_, err := w.Write([]byte(body))
+	return err
+}
@@ -1,4 +1,4 @@
-package aichat
+package response
 
 import (
 	"encoding/json"
@@ -7,20 +7,33 @@ import (
 	"time"
 )
 
-type sseWriter struct {
+// SSEWriter writes Server-Sent Events, numbering events from 1 and flushing
+// after each one so clients see them immediately.
+type SSEWriter struct {
 	w           http.ResponseWriter
 	controller  *http.ResponseController
 	nextEventID int
 }
 
-func newSSEWriter(w http.ResponseWriter) *sseWriter {
-	return &sseWriter{
+func NewSSEWriter(w http.ResponseWriter) *SSEWriter {
+	return &SSEWriter{
 		w:          w,
 		controller: http.NewResponseController(w),
 	}
 }
 
-func (s *sseWriter) prepareHeaders() {
+// StartSSE sets the event-stream headers and lifts the server write timeout
+// for a long-lived stream.
+func StartSSE(w http.ResponseWriter) (*SSEWriter, error) {
+	sse := NewSSEWriter(w)
+	sse.PrepareHeaders()
+	if err := sse.DisableWriteTimeout(); err != nil {
+		return nil, err
+	}
+	return sse, nil
+}
+
+func (s *SSEWriter) PrepareHeaders() {
 	headers := s.w.Header()
 	headers.Set("Content-Type", "text/event-stream")
 	headers.Set("Cache-Control", "no-cache")
@@ -28,11 +41,13 @@ func (s *sseWriter) prepareHeaders() {
 	headers.Set("X-Accel-Buffering", "no")
 }
 
-func (s *sseWriter) disableWriteTimeout() error {
+func (s *SSEWriter) DisableWriteTimeout() error {
 	return s.controller.SetWriteDeadline(time.Time{})
 }
 
-func (s *sseWriter) write(event string, payload any) error {
+// Write sends payload as JSON under the given event name; an empty event
+// uses the default "message" event.
+func (s *SSEWriter) Write(event string, payload any) error {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return err
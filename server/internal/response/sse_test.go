@@ -1,4 +1,4 @@
-package aichat
+package response
 
 import (
 	"encoding/json"
@@ -19,12 +19,16 @@ func (d *deadlineRecorder) SetWriteDeadline(deadline time.Time) error {
 	return nil
 }
 
+type testEvent struct {
+	Type string `json:"type"`
+}
+
 func TestSSEWriterDisableWriteTimeout(t *testing.T) {
 	recorder := &deadlineRecorder{ResponseRecorder: httptest.NewRecorder()}
-	writer := newSSEWriter(recorder)
+	writer := NewSSEWriter(recorder)
 
-	if err := writer.disableWriteTimeout(); err != nil {
-		t.Fatalf("disableWriteTimeout() error = %v", err)
+	if err := writer.DisableWriteTimeout(); err != nil {
+		t.Fatalf("DisableWriteTimeout() error = %v", err)
 	}
 
 	if !recorder.writeDeadline.IsZero() {
@@ -34,13 +38,13 @@ func TestSSEWriterDisableWriteTimeout(t *testing.T) {
 
 func TestSSEWriterWrite_EmitsMonotonicEventIDs(t *testing.T) {
 	recorder := &deadlineRecorder{ResponseRecorder: httptest.NewRecorder()}
-	writer := newSSEWriter(recorder)
+	writer := NewSSEWriter(recorder)
 
-	if err := writer.write("start", StreamEvent{Type: "start"}); err != nil {
-		t.Fatalf("first write() error = %v", err)
+	if err := writer.Write("start", testEvent{Type: "start"}); err != nil {
+		t.Fatalf("first Write() error = %v", err)
 	}
-	if err := writer.write("done", StreamEvent{Type: "done"}); err != nil {
-		t.Fatalf("second write() error = %v", err)
+	if err := writer.Write("done", testEvent{Type: "done"}); err != nil {
+		t.Fatalf("second Write() error = %v", err)
 	}
 
 	body := recorder.Body.String()
@@ -51,7 +55,7 @@ func TestSSEWriterWrite_EmitsMonotonicEventIDs(t *testing.T) {
 		t.Fatalf("expected second event id in body, got %q", body)
 	}
 
-	var event StreamEvent
+	var event testEvent
 	payload := strings.Split(strings.Split(body, "data: ")[1], "\n\n")[0]
 	if err := json.Unmarshal([]byte(payload), &event); err != nil {
 		t.Fatalf("json.Unmarshal() error = %v", err)
@@ -61,4 +65,19 @@ func TestSSEWriterWrite_EmitsMonotonicEventIDs(t *testing.T) {
 	}
 }
 
+func TestStartSSE_SetsEventStreamHeaders(t *testing.T) {
+	recorder := &deadlineRecorder{ResponseRecorder: httptest.NewRecorder()}
+
+	if _, err := StartSSE(recorder); err != nil {
+		t.Fatalf("StartSSE() error = %v", err)
+	}
+
+	if got := recorder.Header().Get("Content-Type"); got != "text/event-stream" {
+		t.Fatalf("Content-Type = %q, want text/event-stream", got)
+	}
+	if got := recorder.Header().Get("X-Accel-Buffering"); got != "no" {
+		t.Fatalf("X-Accel-Buffering = %q, want no", got)
+	}
+}
+
 var _ http.ResponseWriter = (*deadlineRecorder)(nil)
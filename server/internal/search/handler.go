@@ -0,0 +1,94 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type searchService interface {
+	Search(ctx context.Context, opts Options) (*SearchResponse, error)
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service searchService
+}
+
+func NewHandler(logger *slog.Logger, service searchService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// Search godoc
+// @Summary Search workouts, exercises and AI chats
+// @Description Full-text search over the authenticated user's workout notes and focus, set notes, exercise names, and AI chat conversation titles and messages. Supports quoted phrases, OR, and -excluded terms. Snippets are HTML-escaped with matches wrapped in <mark> tags.
+// @Tags search
+// @Produce json
+// @Security StackAuth
+// @Param q query string true "Search terms"
+// @Param type query string false "Comma-separated hit types to include: workout, set, exercise, conversation, message"
+// @Param limit query int false "Maximum hits to return" default(20) minimum(1) maximum(50)
+// @Param offset query int false "Hits to skip, from a previous next_offset" default(0) minimum(0) maximum(1000)
+// @Success 200 {object} search.SearchResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /search [get]
+func (h *Handler) Search(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	opts := Options{Query: query.Get("q")}
+	if raw := strings.TrimSpace(query.Get("type")); raw != "" {
+		opts.Types = strings.Split(raw, ",")
+	}
+
+	var err error
+	if opts.Limit, err = intQueryParam(query.Get("limit")); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "limit must be an integer", err)
+		return
+	}
+	if opts.Offset, err = intQueryParam(query.Get("offset")); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "offset must be an integer", err)
+		return
+	}
+
+	results, err := h.service.Search(r.Context(), opts)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to search")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, results); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), nil)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
+
+func intQueryParam(raw string) (int, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(raw)
+}
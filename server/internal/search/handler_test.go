@@ -0,0 +1,65 @@
+package search
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSearchService struct {
+	resp *SearchResponse
+	err  error
+	opts Options
+}
+
+func (s *stubSearchService) Search(_ context.Context, opts Options) (*SearchResponse, error) {
+	s.opts = opts
+	return s.resp, s.err
+}
+
+func TestHandlerSearch(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("passes query options", func(t *testing.T) {
+		service := &stubSearchService{resp: &SearchResponse{Query: "bench", Hits: []Hit{{Type: HitTypeWorkout, ID: 1, Snippet: "<mark>bench</mark>"}}, Limit: 10}}
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).Search(rr, httptest.NewRequest(http.MethodGet, "/api/search?q=bench&type=workout,message&limit=10&offset=20", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, Options{Query: "bench", Types: []string{"workout", "message"}, Limit: 10, Offset: 20}, service.opts)
+		assert.Contains(t, rr.Body.String(), `"type":"workout"`)
+	})
+
+	t.Run("rejects non-integer paging", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubSearchService{}).Search(rr, httptest.NewRequest(http.MethodGet, "/api/search?q=bench&offset=next", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps service errors", func(t *testing.T) {
+		for _, tt := range []struct {
+			err  error
+			code int
+		}{
+			{err: &ValidationError{Field: "q", Message: "is required"}, code: http.StatusBadRequest},
+			{err: &apperrors.Unauthorized{Resource: "search"}, code: http.StatusUnauthorized},
+			{err: io.ErrUnexpectedEOF, code: http.StatusInternalServerError},
+		} {
+			rr := httptest.NewRecorder()
+
+			NewHandler(logger, &stubSearchService{err: tt.err}).Search(rr, httptest.NewRequest(http.MethodGet, "/api/search", nil))
+
+			assert.Equal(t, tt.code, rr.Code)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package search
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	HitTypeWorkout      = "workout"
+	HitTypeSet          = "set"
+	HitTypeExercise     = "exercise"
+	HitTypeConversation = "conversation"
+	HitTypeMessage      = "message"
+
+	defaultLimit   = 20
+	maxLimit       = 50
+	maxOffset      = 1000
+	maxQueryLength = 200
+
+	// The search query wraps matched terms in these control characters, which
+	// cannot appear in escaped output, so snippets can be escaped safely
+	// before highlight tags are added.
+	snippetMatchStart = "\x02"
+	snippetMatchStop  = "\x03"
+	highlightStart    = "<mark>"
+	highlightStop     = "</mark>"
+)
+
+// HitTypes lists the searchable content types in the order they are
+// documented.
+var HitTypes = []string{HitTypeWorkout, HitTypeSet, HitTypeExercise, HitTypeConversation, HitTypeMessage}
+
+type Options struct {
+	Query  string
+	Types  []string
+	Limit  int
+	Offset int
+}
+
+// Hit is one search match. Snippet is HTML-escaped text with matched terms
+// wrapped in <mark> tags. WorkoutID is set for workout and set hits, and
+// ConversationID for conversation and message hits, so clients can open the
+// parent workout or conversation.
+type Hit struct {
+	Type           string    `json:"type" example:"workout"`
+	ID             int32     `json:"id"`
+	WorkoutID      *int32    `json:"workout_id,omitempty"`
+	ConversationID *int32    `json:"conversation_id,omitempty"`
+	Title          string    `json:"title"`
+	Snippet        string    `json:"snippet" example:"Felt strong on <mark>bench</mark> today"`
+	Rank           float64   `json:"rank"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
+
+// SearchResponse is one page of hits, best matches first. NextOffset is set
+// when more hits are available.
+type SearchResponse struct {
+	Query      string `json:"query"`
+	Hits       []Hit  `json:"hits"`
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	NextOffset *int   `json:"next_offset,omitempty"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+type searchParams struct {
+	Query  string
+	Types  []string
+	Limit  int
+	Offset int
+}
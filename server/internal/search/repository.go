@@ -0,0 +1,48 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	Search(ctx context.Context, userID string, params searchParams) ([]db.SearchUserContentRow, error)
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, pool *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		pool:    pool,
+	}
+}
+
+func (r *repository) Search(ctx context.Context, userID string, params searchParams) ([]db.SearchUserContentRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.SearchUserContent(ctx, db.SearchUserContentParams{
+		Query:     params.Query,
+		UserID:    userID,
+		Kinds:     params.Types,
+		RowLimit:  int32(params.Limit),
+		RowOffset: int32(params.Offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search user content: %w", err)
+	}
+	return rows, nil
+}
+
+var _ Repository = (*repository)(nil)
@@ -0,0 +1,131 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log/slog"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger *slog.Logger
+	repo   Repository
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// Search runs a full-text search over the user's workout notes and focus,
+// exercise names, and AI chat conversations. The query accepts web search
+// syntax: quoted phrases, OR, and -excluded terms.
+func (s *Service) Search(ctx context.Context, opts Options) (*SearchResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" {
+		return nil, &apperrors.Unauthorized{Resource: "search", UserID: ""}
+	}
+
+	params, err := validateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to learn whether another page exists.
+	rows, err := s.repo.Search(ctx, userID, searchParams{
+		Query:  params.Query,
+		Types:  params.Types,
+		Limit:  params.Limit + 1,
+		Offset: params.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+
+	resp := &SearchResponse{
+		Query:  params.Query,
+		Hits:   make([]Hit, 0, min(len(rows), params.Limit)),
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	}
+	if len(rows) > params.Limit {
+		rows = rows[:params.Limit]
+		next := params.Offset + params.Limit
+		resp.NextOffset = &next
+	}
+	for _, row := range rows {
+		resp.Hits = append(resp.Hits, hitFromRow(row))
+	}
+	return resp, nil
+}
+
+func validateOptions(opts Options) (*searchParams, error) {
+	query := strings.TrimSpace(opts.Query)
+	if query == "" {
+		return nil, &ValidationError{Field: "q", Message: "is required"}
+	}
+	if utf8.RuneCountInString(query) > maxQueryLength {
+		return nil, &ValidationError{Field: "q", Message: fmt.Sprintf("must be at most %d characters", maxQueryLength)}
+	}
+
+	var types []string
+	for _, value := range opts.Types {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" || slices.Contains(types, value) {
+			continue
+		}
+		if !slices.Contains(HitTypes, value) {
+			return nil, &ValidationError{Field: "type", Message: "must be one of " + strings.Join(HitTypes, ", ")}
+		}
+		types = append(types, value)
+	}
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit < 1 || limit > maxLimit {
+		return nil, &ValidationError{Field: "limit", Message: fmt.Sprintf("must be between 1 and %d", maxLimit)}
+	}
+	if opts.Offset < 0 || opts.Offset > maxOffset {
+		return nil, &ValidationError{Field: "offset", Message: fmt.Sprintf("must be between 0 and %d", maxOffset)}
+	}
+
+	return &searchParams{Query: query, Types: types, Limit: limit, Offset: opts.Offset}, nil
+}
+
+func hitFromRow(row db.SearchUserContentRow) Hit {
+	hit := Hit{
+		Type:       row.Kind,
+		ID:         row.ID,
+		Title:      row.Title,
+		Snippet:    highlightSnippet(row.Snippet),
+		Rank:       row.Rank,
+		OccurredAt: row.OccurredAt.Time,
+	}
+	if row.WorkoutID.Valid {
+		workoutID := row.WorkoutID.Int32
+		hit.WorkoutID = &workoutID
+	}
+	if row.ConversationID.Valid {
+		conversationID := row.ConversationID.Int32
+		hit.ConversationID = &conversationID
+	}
+	return hit
+}
+
+// highlightSnippet escapes a ts_headline snippet and replaces its match
+// markers with <mark> tags, so stored text can never inject markup.
+func highlightSnippet(raw string) string {
+	escaped := html.EscapeString(strings.TrimSpace(raw))
+	return strings.NewReplacer(snippetMatchStart, highlightStart, snippetMatchStop, highlightStop).Replace(escaped)
+}
@@ -0,0 +1,126 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRepository struct {
+	rows   []db.SearchUserContentRow
+	err    error
+	userID string
+	params searchParams
+}
+
+func (r *stubRepository) Search(_ context.Context, userID string, params searchParams) ([]db.SearchUserContentRow, error) {
+	r.userID = userID
+	r.params = params
+	return r.rows, r.err
+}
+
+func searchRow(kind string, id int32) db.SearchUserContentRow {
+	return db.SearchUserContentRow{
+		Kind:       kind,
+		ID:         id,
+		Title:      "Push day",
+		Snippet:    "\x02Bench\x03 felt <fast>",
+		Rank:       0.5,
+		OccurredAt: pgtype.Timestamptz{Time: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+	}
+}
+
+func TestServiceSearch(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	t.Run("maps hits and reports the next page", func(t *testing.T) {
+		message := searchRow(HitTypeMessage, 9)
+		message.ConversationID = pgtype.Int4{Int32: 4, Valid: true}
+		set := searchRow(HitTypeSet, 12)
+		set.WorkoutID = pgtype.Int4{Int32: 3, Valid: true}
+		repo := &stubRepository{rows: []db.SearchUserContentRow{set, message, searchRow(HitTypeExercise, 2)}}
+
+		resp, err := NewService(nil, repo).Search(ctx, Options{Query: " bench ", Types: []string{"Message", "workout", "message"}, Limit: 2, Offset: 4})
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", repo.userID)
+		assert.Equal(t, searchParams{Query: "bench", Types: []string{"message", "workout"}, Limit: 3, Offset: 4}, repo.params)
+		require.Len(t, resp.Hits, 2)
+		assert.Equal(t, "<mark>Bench</mark> felt &lt;fast&gt;", resp.Hits[0].Snippet)
+		assert.Nil(t, resp.Hits[0].ConversationID)
+		require.NotNil(t, resp.Hits[0].WorkoutID)
+		assert.Equal(t, int32(3), *resp.Hits[0].WorkoutID)
+		assert.Nil(t, resp.Hits[1].WorkoutID)
+		require.NotNil(t, resp.Hits[1].ConversationID)
+		assert.Equal(t, int32(4), *resp.Hits[1].ConversationID)
+		require.NotNil(t, resp.NextOffset)
+		assert.Equal(t, 6, *resp.NextOffset)
+	})
+
+	t.Run("last page has no next offset", func(t *testing.T) {
+		repo := &stubRepository{rows: []db.SearchUserContentRow{searchRow(HitTypeWorkout, 1)}}
+
+		resp, err := NewService(nil, repo).Search(ctx, Options{Query: "bench"})
+
+		require.NoError(t, err)
+		assert.Equal(t, defaultLimit+1, repo.params.Limit)
+		assert.Len(t, resp.Hits, 1)
+		assert.Nil(t, resp.NextOffset)
+	})
+
+	t.Run("returns an empty list without hits", func(t *testing.T) {
+		resp, err := NewService(nil, &stubRepository{}).Search(ctx, Options{Query: "bench"})
+
+		require.NoError(t, err)
+		assert.NotNil(t, resp.Hits)
+		assert.Empty(t, resp.Hits)
+	})
+
+	t.Run("wraps repository errors", func(t *testing.T) {
+		_, err := NewService(nil, &stubRepository{err: errors.New("boom")}).Search(ctx, Options{Query: "bench"})
+
+		require.Error(t, err)
+		var validationErr *ValidationError
+		assert.False(t, errors.As(err, &validationErr))
+	})
+
+	t.Run("requires a user", func(t *testing.T) {
+		_, err := NewService(nil, &stubRepository{}).Search(context.Background(), Options{Query: "bench"})
+
+		var unauthorized *apperrors.Unauthorized
+		require.ErrorAs(t, err, &unauthorized)
+	})
+}
+
+func TestValidateOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  Options
+		field string
+	}{
+		{name: "blank query", opts: Options{Query: "  "}, field: "q"},
+		{name: "long query", opts: Options{Query: string(make([]rune, maxQueryLength+1))}, field: "q"},
+		{name: "unknown type", opts: Options{Query: "bench", Types: []string{"template"}}, field: "type"},
+		{name: "limit too large", opts: Options{Query: "bench", Limit: maxLimit + 1}, field: "limit"},
+		{name: "negative limit", opts: Options{Query: "bench", Limit: -1}, field: "limit"},
+		{name: "negative offset", opts: Options{Query: "bench", Offset: -1}, field: "offset"},
+		{name: "offset too large", opts: Options{Query: "bench", Offset: maxOffset + 1}, field: "offset"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validateOptions(tt.opts)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
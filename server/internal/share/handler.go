@@ -0,0 +1,173 @@
+package share
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/request"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+const maxShareJSONBodyBytes = 1 << 10
+
+type shareService interface {
+	CreateShare(ctx context.Context, workoutID int32, req CreateShareRequest) (*ShareLinkResponse, error)
+	RevokeShare(ctx context.Context, workoutID int32) error
+	GetSharedWorkout(ctx context.Context, token string) (*SharedWorkoutResponse, error)
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service shareService
+}
+
+func NewHandler(logger *slog.Logger, service shareService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// CreateShare godoc
+// @Summary Create workout share link
+// @Description Issues an unguessable public link to a read-only view of one of the authenticated user's workouts. Sharing again replaces the previous link. The request body is optional.
+// @Tags workouts
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param id path int true "Workout ID"
+// @Param request body share.CreateShareRequest false "Share options"
+// @Success 201 {object} share.ShareLinkResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /workouts/{id}/share [post]
+func (h *Handler) CreateShare(w http.ResponseWriter, r *http.Request) {
+	workoutID, ok := h.decodeWorkoutID(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateShareRequest
+	if err := request.DecodeStrictJSON(w, r, &req, maxShareJSONBodyBytes); err != nil && !errors.Is(err, io.EOF) {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	link, err := h.service.CreateShare(r.Context(), workoutID, req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to create workout share")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	if err := response.JSON(w, http.StatusCreated, link); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// RevokeShare godoc
+// @Summary Revoke workout share link
+// @Description Disables the public link to one of the authenticated user's workouts.
+// @Tags workouts
+// @Security StackAuth
+// @Param id path int true "Workout ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /workouts/{id}/share [delete]
+func (h *Handler) RevokeShare(w http.ResponseWriter, r *http.Request) {
+	workoutID, ok := h.decodeWorkoutID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RevokeShare(r.Context(), workoutID); err != nil {
+		h.writeServiceError(w, r, err, "failed to revoke workout share")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ServeShare renders the shared workout for the token in the path as JSON,
+// Markdown or an HTML page, depending on the Accept header. It is mounted
+// outside /api so it needs no credentials; the token itself grants access.
+func (h *Handler) ServeShare(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Accept")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.Header().Set("X-Robots-Tag", "noindex, nofollow")
+
+	workout, err := h.service.GetSharedWorkout(r.Context(), r.PathValue("token"))
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to load shared workout")
+		return
+	}
+
+	accept := r.Header.Get("Accept")
+	switch {
+	case response.PrefersMarkdown(accept):
+		if err := response.Markdown(w, http.StatusOK, RenderMarkdown(workout)); err != nil {
+			h.logger.Error("failed to write shared workout", "error", err)
+		}
+	case response.PrefersHTML(accept):
+		page, err := RenderHTML(workout)
+		if err != nil {
+			response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to render shared workout", err)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(page)); err != nil {
+			h.logger.Error("failed to write shared workout", "error", err)
+		}
+	default:
+		if err := response.JSON(w, http.StatusOK, workout); err != nil {
+			response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+		}
+	}
+}
+
+func (h *Handler) decodeWorkoutID(w http.ResponseWriter, r *http.Request) (int32, bool) {
+	raw := strings.TrimSpace(r.PathValue("id"))
+	if raw == "" {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Missing workout ID", nil)
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || parsed <= 0 {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Invalid workout ID", err)
+		return 0, false
+	}
+
+	return int32(parsed), true
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errNotFound *apperrors.NotFound
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errNotFound):
+		response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
@@ -0,0 +1,196 @@
+package share
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubShareService struct {
+	link      *ShareLinkResponse
+	workout   *SharedWorkoutResponse
+	err       error
+	workoutID int32
+	req       CreateShareRequest
+	token     string
+}
+
+func (s *stubShareService) CreateShare(_ context.Context, workoutID int32, req CreateShareRequest) (*ShareLinkResponse, error) {
+	s.workoutID = workoutID
+	s.req = req
+	return s.link, s.err
+}
+
+func (s *stubShareService) RevokeShare(_ context.Context, workoutID int32) error {
+	s.workoutID = workoutID
+	return s.err
+}
+
+func (s *stubShareService) GetSharedWorkout(_ context.Context, token string) (*SharedWorkoutResponse, error) {
+	s.token = token
+	return s.workout, s.err
+}
+
+func TestHandlerCreateShare(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("accepts an empty body", func(t *testing.T) {
+		service := &stubShareService{link: &ShareLinkResponse{Token: "abc", URL: "https://fittrack.example/s/abc"}}
+		handler := NewHandler(logger, service)
+		req := httptest.NewRequest(http.MethodPost, "/api/workouts/7/share", nil)
+		req.SetPathValue("id", "7")
+		rr := httptest.NewRecorder()
+
+		handler.CreateShare(rr, req)
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, int32(7), service.workoutID)
+		assert.Nil(t, service.req.ExpiresInDays)
+		assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+		assert.Contains(t, rr.Body.String(), `"url":"https://fittrack.example/s/abc"`)
+	})
+
+	t.Run("decodes expiry", func(t *testing.T) {
+		service := &stubShareService{link: &ShareLinkResponse{Token: "abc"}}
+		handler := NewHandler(logger, service)
+		req := httptest.NewRequest(http.MethodPost, "/api/workouts/7/share", strings.NewReader(`{"expires_in_days":3}`))
+		req.SetPathValue("id", "7")
+		rr := httptest.NewRecorder()
+
+		handler.CreateShare(rr, req)
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		require.NotNil(t, service.req.ExpiresInDays)
+		assert.Equal(t, 3, *service.req.ExpiresInDays)
+	})
+
+	t.Run("rejects unknown fields and invalid ids", func(t *testing.T) {
+		handler := NewHandler(logger, &stubShareService{})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/workouts/7/share", strings.NewReader(`{"expires_at":"tomorrow"}`))
+		req.SetPathValue("id", "7")
+		rr := httptest.NewRecorder()
+		handler.CreateShare(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		req = httptest.NewRequest(http.MethodPost, "/api/workouts/abc/share", nil)
+		req.SetPathValue("id", "abc")
+		rr = httptest.NewRecorder()
+		handler.CreateShare(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps service errors", func(t *testing.T) {
+		tests := []struct {
+			err  error
+			want int
+		}{
+			{err: &ValidationError{Field: "expires_in_days", Message: "must be between 1 and 365"}, want: http.StatusBadRequest},
+			{err: &apperrors.Unauthorized{Resource: "workout share"}, want: http.StatusUnauthorized},
+			{err: &apperrors.NotFound{Resource: "workout", ID: "7"}, want: http.StatusNotFound},
+		}
+		for _, tt := range tests {
+			handler := NewHandler(logger, &stubShareService{err: tt.err})
+			req := httptest.NewRequest(http.MethodPost, "/api/workouts/7/share", nil)
+			req.SetPathValue("id", "7")
+			rr := httptest.NewRecorder()
+
+			handler.CreateShare(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+		}
+	})
+}
+
+func TestHandlerRevokeShare(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	service := &stubShareService{}
+	handler := NewHandler(logger, service)
+	req := httptest.NewRequest(http.MethodDelete, "/api/workouts/7/share", nil)
+	req.SetPathValue("id", "7")
+	rr := httptest.NewRecorder()
+
+	handler.RevokeShare(rr, req)
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+	assert.Equal(t, int32(7), service.workoutID)
+}
+
+func TestHandlerServeShare(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	focus := "Push"
+	weight := 185.0
+	workout := &SharedWorkoutResponse{
+		Date:  "2026-07-01",
+		Focus: &focus,
+		Exercises: []SharedExercise{{
+			Name: `<script>alert("x")</script> Press`,
+			Sets: []SharedSet{{Weight: &weight, Reps: 5, SetType: "working"}},
+		}},
+		Volume: 925,
+	}
+
+	serve := func(accept string) *httptest.ResponseRecorder {
+		handler := NewHandler(logger, &stubShareService{workout: workout})
+		req := httptest.NewRequest(http.MethodGet, "/s/token", nil)
+		req.SetPathValue("token", "token")
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeShare(rr, req)
+		return rr
+	}
+
+	t.Run("defaults to JSON", func(t *testing.T) {
+		rr := serve("")
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Header().Get("Content-Type"), "application/json")
+		assert.Contains(t, rr.Body.String(), `"date":"2026-07-01"`)
+		assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+		assert.Equal(t, "no-referrer", rr.Header().Get("Referrer-Policy"))
+		assert.Equal(t, "noindex, nofollow", rr.Header().Get("X-Robots-Tag"))
+		assert.Equal(t, "Accept", rr.Header().Get("Vary"))
+	})
+
+	t.Run("renders Markdown when requested", func(t *testing.T) {
+		rr := serve("text/markdown")
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Header().Get("Content-Type"), "text/markdown")
+		assert.Contains(t, rr.Body.String(), "# Push workout, 2026-07-01")
+		assert.Contains(t, rr.Body.String(), "| 1 | working | 185 | 5 |")
+		assert.Contains(t, rr.Body.String(), "Working-set volume: 925 lb")
+	})
+
+	t.Run("renders an escaped HTML page for browsers", func(t *testing.T) {
+		rr := serve("text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Header().Get("Content-Type"), "text/html")
+		assert.Contains(t, rr.Header().Get("Content-Security-Policy"), "default-src 'none'")
+		assert.Contains(t, rr.Body.String(), "<h1>Push workout, 2026-07-01</h1>")
+		assert.Contains(t, rr.Body.String(), "&lt;script&gt;")
+		assert.NotContains(t, rr.Body.String(), "<script>")
+	})
+
+	t.Run("reports unknown tokens as not found", func(t *testing.T) {
+		handler := NewHandler(logger, &stubShareService{err: &apperrors.NotFound{Resource: "shared workout"}})
+		req := httptest.NewRequest(http.MethodGet, "/s/token", nil)
+		req.SetPathValue("token", "token")
+		rr := httptest.NewRecorder()
+
+		handler.ServeShare(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
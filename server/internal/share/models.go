@@ -0,0 +1,81 @@
+package share
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// shareTokenBytes of randomness encode to a 43-character URL-safe token.
+	shareTokenBytes       = 32
+	shareTokenLength      = 43
+	sharePathPrefix       = "/s/"
+	maxShareExpiresInDays = 365
+	sharedDateLayout      = "2006-01-02"
+)
+
+// CreateShareRequest optionally limits how long a share link stays valid.
+// Links without an expiry work until they are revoked or replaced.
+type CreateShareRequest struct {
+	ExpiresInDays *int `json:"expires_in_days,omitempty" example:"7"`
+}
+
+// ShareLinkResponse carries a newly issued share link. The token is only
+// returned here, because only a hash of it is stored.
+type ShareLinkResponse struct {
+	Token     string     `json:"token"`
+	URL       string     `json:"url" example:"https://fittrack.example/s/abc123"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// SharedWorkoutResponse is the read-only public view of a shared workout. It
+// deliberately carries no user, workout, exercise or set identifiers.
+type SharedWorkoutResponse struct {
+	Date      string           `json:"date" example:"2026-07-01"`
+	Focus     *string          `json:"workout_focus,omitempty"`
+	Notes     *string          `json:"notes,omitempty"`
+	Exercises []SharedExercise `json:"exercises"`
+	Volume    float64          `json:"volume"`
+}
+
+type SharedExercise struct {
+	Name string      `json:"name"`
+	Sets []SharedSet `json:"sets"`
+}
+
+type SharedSet struct {
+	Weight  *float64 `json:"weight,omitempty"`
+	Reps    int      `json:"reps"`
+	SetType string   `json:"set_type"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// sharedWorkout is a shared workout as loaded for the public view. The
+// owner's timezone is only used to print the workout's calendar date.
+type sharedWorkout struct {
+	date     time.Time
+	focus    string
+	notes    string
+	timezone string
+	sets     []sharedSet
+}
+
+// sharedSet is one logged set, in display order.
+type sharedSet struct {
+	exerciseName string
+	weight       *float64
+	reps         int
+	setType      string
+}
@@ -0,0 +1,112 @@
+package share
+
+import (
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+)
+
+// RenderMarkdown formats a shared workout for clients that negotiate
+// text/markdown.
+func RenderMarkdown(workout *SharedWorkoutResponse) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", singleLine(sharedTitle(workout)))
+	if workout.Notes != nil {
+		for _, line := range strings.Split(*workout.Notes, "\n") {
+			fmt.Fprintf(&b, "> %s\n", strings.TrimRight(line, "\r"))
+		}
+		b.WriteString("\n")
+	}
+
+	for _, exercise := range workout.Exercises {
+		fmt.Fprintf(&b, "## %s\n\n", singleLine(exercise.Name))
+		b.WriteString("| Set | Type | Weight (lb) | Reps |\n")
+		b.WriteString("| ---: | --- | ---: | ---: |\n")
+		for i, set := range exercise.Sets {
+			fmt.Fprintf(&b, "| %d | %s | %s | %d |\n", i+1, strings.ReplaceAll(set.SetType, "|", `\|`), formatWeight(set.Weight), set.Reps)
+		}
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(&b, "Working-set volume: %s lb\n", formatNumber(workout.Volume))
+	return b.String()
+}
+
+var sharedWorkoutPage = template.Must(template.New("shared-workout").Funcs(template.FuncMap{
+	"weight": formatWeight,
+	"number": formatNumber,
+	"inc":    func(i int) int { return i + 1 },
+}).Parse(`<!doctype html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex, nofollow">
+<title>{{.Title}} · FitTrack</title>
+<style>
+body { font-family: system-ui, sans-serif; max-width: 40rem; margin: 2rem auto; padding: 0 1rem; color: #1f2933; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 1.5rem; }
+th, td { padding: 0.25rem 0.5rem; border-bottom: 1px solid #e4e7eb; text-align: left; }
+td.num, th.num { text-align: right; }
+blockquote { margin: 0 0 1.5rem; padding-left: 1rem; border-left: 3px solid #cbd2d9; white-space: pre-line; }
+footer { color: #7b8794; font-size: 0.875rem; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{with .Workout.Notes}}<blockquote>{{.}}</blockquote>
+{{end}}{{range .Workout.Exercises}}<h2>{{.Name}}</h2>
+<table>
+<thead><tr><th class="num">Set</th><th>Type</th><th class="num">Weight (lb)</th><th class="num">Reps</th></tr></thead>
+<tbody>
+{{range $i, $set := .Sets}}<tr><td class="num">{{inc $i}}</td><td>{{$set.SetType}}</td><td class="num">{{weight $set.Weight}}</td><td class="num">{{$set.Reps}}</td></tr>
+{{end}}</tbody>
+</table>
+{{end}}<p>Working-set volume: {{number .Workout.Volume}} lb</p>
+<footer>Shared from FitTrack</footer>
+</body>
+</html>
+`))
+
+// RenderHTML formats a shared workout as a standalone page for browsers.
+// All workout text is escaped by html/template.
+func RenderHTML(workout *SharedWorkoutResponse) (string, error) {
+	var b strings.Builder
+	err := sharedWorkoutPage.Execute(&b, struct {
+		Title   string
+		Workout *SharedWorkoutResponse
+	}{
+		Title:   sharedTitle(workout),
+		Workout: workout,
+	})
+	if err != nil {
+		return "", fmt.Errorf("render shared workout page: %w", err)
+	}
+	return b.String(), nil
+}
+
+func sharedTitle(workout *SharedWorkoutResponse) string {
+	if workout.Focus != nil {
+		return fmt.Sprintf("%s workout, %s", *workout.Focus, workout.Date)
+	}
+	return "Workout, " + workout.Date
+}
+
+func formatWeight(weight *float64) string {
+	if weight == nil {
+		return "-"
+	}
+	return formatNumber(*weight)
+}
+
+// formatNumber drops a trailing ".0" so whole numbers read naturally.
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// singleLine keeps user-entered names from breaking out of a Markdown heading.
+func singleLine(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
@@ -0,0 +1,181 @@
+package share
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	UpsertShare(ctx context.Context, userID string, workoutID int32, tokenHash string, expiresAt *time.Time) (*db.WorkoutShare, error)
+	DeleteShare(ctx context.Context, userID string, workoutID int32) (bool, error)
+	GetActiveShareByTokenHash(ctx context.Context, tokenHash string) (*db.WorkoutShare, error)
+	GetSharedWorkout(ctx context.Context, userID string, workoutID int32) (*sharedWorkout, error)
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, pool *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		pool:    pool,
+	}
+}
+
+// UpsertShare issues a share link for one of the user's workouts, replacing
+// any existing link. It returns nil when the workout does not exist or
+// belongs to someone else.
+func (r *repository) UpsertShare(ctx context.Context, userID string, workoutID int32, tokenHash string, expiresAt *time.Time) (*db.WorkoutShare, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	params := db.UpsertWorkoutShareParams{
+		TokenHash: tokenHash,
+		WorkoutID: workoutID,
+		UserID:    userID,
+	}
+	if expiresAt != nil {
+		params.ExpiresAt = pgtype.Timestamptz{Time: *expiresAt, Valid: true}
+	}
+
+	share, err := r.queries.UpsertWorkoutShare(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("upsert workout share failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"workout_id", workoutID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("upsert workout share: %w", err)
+	}
+	return &share, nil
+}
+
+func (r *repository) DeleteShare(ctx context.Context, userID string, workoutID int32) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.DeleteWorkoutShare(ctx, db.DeleteWorkoutShareParams{
+		WorkoutID: workoutID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("delete workout share: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// GetActiveShareByTokenHash resolves an unauthenticated share request to its
+// workout, or nil when the token is unknown, revoked or expired. There is no
+// RLS user yet, so the token hash is set for a read-only transaction and the
+// select policy exposes only the matching share.
+func (r *repository) GetActiveShareByTokenHash(ctx context.Context, tokenHash string) (*db.WorkoutShare, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("begin workout share lookup transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT set_config('app.current_token_hash', $1, true)", tokenHash); err != nil {
+		return nil, fmt.Errorf("set workout share token hash: %w", err)
+	}
+
+	share, err := r.queries.WithTx(tx).GetActiveWorkoutShareByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get workout share by token: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit workout share lookup transaction: %w", err)
+	}
+	return &share, nil
+}
+
+// GetSharedWorkout loads a shared workout with its sets, or nil when it has
+// none. Share requests bypass the auth middleware, so the RLS user is set for
+// the duration of a read-only transaction.
+func (r *repository) GetSharedWorkout(ctx context.Context, userID string, workoutID int32) (*sharedWorkout, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("begin workout share transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT set_config('app.current_user_id', $1, true)", userID); err != nil {
+		return nil, fmt.Errorf("set workout share rls user: %w", err)
+	}
+
+	qtx := r.queries.WithTx(tx)
+	rows, err := qtx.GetWorkoutWithSets(ctx, db.GetWorkoutWithSetsParams{
+		ID:     workoutID,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get shared workout sets: %w", err)
+	}
+	owner, err := qtx.GetUserByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get shared workout owner: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit workout share transaction: %w", err)
+	}
+
+	return sharedWorkoutFromRows(rows, owner.Timezone.String)
+}
+
+func sharedWorkoutFromRows(rows []db.GetWorkoutWithSetsRow, timezone string) (*sharedWorkout, error) {
+	if len(rows) == 0 || !rows[0].WorkoutDate.Valid {
+		return nil, nil
+	}
+
+	workout := &sharedWorkout{
+		date:     rows[0].WorkoutDate.Time,
+		focus:    rows[0].WorkoutFocus.String,
+		notes:    rows[0].WorkoutNotes.String,
+		timezone: timezone,
+		sets:     make([]sharedSet, 0, len(rows)),
+	}
+	for _, row := range rows {
+		set := sharedSet{
+			exerciseName: row.ExerciseName,
+			reps:         int(row.Reps),
+			setType:      row.SetType,
+		}
+		if row.Weight.Valid {
+			weight, err := row.Weight.Float64Value()
+			if err != nil {
+				return nil, fmt.Errorf("convert shared set weight: %w", err)
+			}
+			set.weight = &weight.Float64
+		}
+		workout.sets = append(workout.sets, set)
+	}
+	return workout, nil
+}
+
+var _ Repository = (*repository)(nil)
@@ -0,0 +1,155 @@
+package share
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger  *slog.Logger
+	repo    Repository
+	baseURL string
+}
+
+// NewService builds share URLs from baseURL, the public origin the app is
+// served from.
+func NewService(logger *slog.Logger, repo Repository, baseURL string) *Service {
+	return &Service{
+		logger:  logger,
+		repo:    repo,
+		baseURL: strings.TrimRight(strings.TrimSpace(baseURL), "/"),
+	}
+}
+
+// CreateShare issues a share link for one of the user's workouts. Any link
+// previously issued for the workout stops working.
+func (s *Service) CreateShare(ctx context.Context, workoutID int32, req CreateShareRequest) (*ShareLinkResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" {
+		return nil, &apperrors.Unauthorized{Resource: "workout share", UserID: ""}
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresInDays != nil {
+		days := *req.ExpiresInDays
+		if days < 1 || days > maxShareExpiresInDays {
+			return nil, &ValidationError{Field: "expires_in_days", Message: fmt.Sprintf("must be between 1 and %d", maxShareExpiresInDays)}
+		}
+		expiry := time.Now().UTC().AddDate(0, 0, days)
+		expiresAt = &expiry
+	}
+
+	token, tokenHash, err := newShareToken()
+	if err != nil {
+		return nil, err
+	}
+	share, err := s.repo.UpsertShare(ctx, userID, workoutID, tokenHash, expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workout share: %w", err)
+	}
+	if share == nil {
+		return nil, &apperrors.NotFound{Resource: "workout", ID: fmt.Sprintf("%d", workoutID)}
+	}
+
+	resp := &ShareLinkResponse{
+		Token:     token,
+		URL:       s.baseURL + sharePathPrefix + token,
+		CreatedAt: share.CreatedAt.Time,
+	}
+	if share.ExpiresAt.Valid {
+		expiry := share.ExpiresAt.Time
+		resp.ExpiresAt = &expiry
+	}
+	return resp, nil
+}
+
+// RevokeShare disables the workout's share link.
+func (s *Service) RevokeShare(ctx context.Context, workoutID int32) error {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" {
+		return &apperrors.Unauthorized{Resource: "workout share", UserID: ""}
+	}
+
+	deleted, err := s.repo.DeleteShare(ctx, userID, workoutID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke workout share: %w", err)
+	}
+	if !deleted {
+		return &apperrors.NotFound{Resource: "workout share", ID: fmt.Sprintf("%d", workoutID)}
+	}
+	return nil
+}
+
+// GetSharedWorkout authenticates a public request by its share token.
+// Unknown, malformed, revoked and expired tokens are all reported as not
+// found.
+func (s *Service) GetSharedWorkout(ctx context.Context, token string) (*SharedWorkoutResponse, error) {
+	token = strings.TrimSpace(token)
+	if !validShareToken(token) {
+		return nil, &apperrors.NotFound{Resource: "shared workout", ID: ""}
+	}
+
+	share, err := s.repo.GetActiveShareByTokenHash(ctx, hashShareToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve workout share: %w", err)
+	}
+	if share == nil {
+		return nil, &apperrors.NotFound{Resource: "shared workout", ID: ""}
+	}
+
+	workout, err := s.repo.GetSharedWorkout(ctx, share.UserID, share.WorkoutID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load shared workout: %w", err)
+	}
+	if workout == nil {
+		return nil, &apperrors.NotFound{Resource: "shared workout", ID: ""}
+	}
+	return buildSharedWorkout(workout), nil
+}
+
+// buildSharedWorkout groups consecutive sets by exercise and totals the
+// working-set volume.
+func buildSharedWorkout(workout *sharedWorkout) *SharedWorkoutResponse {
+	loc := time.UTC
+	if workout.timezone != "" {
+		if loaded, err := time.LoadLocation(workout.timezone); err == nil {
+			loc = loaded
+		}
+	}
+
+	resp := &SharedWorkoutResponse{
+		Date:      workout.date.In(loc).Format(sharedDateLayout),
+		Exercises: []SharedExercise{},
+	}
+	if focus := strings.TrimSpace(workout.focus); focus != "" {
+		resp.Focus = &focus
+	}
+	if notes := strings.TrimSpace(workout.notes); notes != "" {
+		resp.Notes = &notes
+	}
+
+	volume := 0.0
+	for _, set := range workout.sets {
+		if len(resp.Exercises) == 0 || resp.Exercises[len(resp.Exercises)-1].Name != set.exerciseName {
+			resp.Exercises = append(resp.Exercises, SharedExercise{Name: set.exerciseName})
+		}
+		current := &resp.Exercises[len(resp.Exercises)-1]
+		current.Sets = append(current.Sets, SharedSet{
+			Weight:  set.weight,
+			Reps:    set.reps,
+			SetType: set.setType,
+		})
+		if set.setType == "working" && set.weight != nil {
+			volume += *set.weight * float64(set.reps)
+		}
+	}
+	resp.Volume = math.Round(volume*10) / 10
+	return resp
+}
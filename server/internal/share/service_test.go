@@ -0,0 +1,194 @@
+package share
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRepository struct {
+	share         *db.WorkoutShare
+	missing       bool
+	upsertedHash  string
+	upsertedID    int32
+	expiresAt     *time.Time
+	lookedUpHash  string
+	loadedUserID  string
+	loadedWorkout int32
+	workout       *sharedWorkout
+	deleted       bool
+}
+
+func (r *stubRepository) UpsertShare(_ context.Context, userID string, workoutID int32, tokenHash string, expiresAt *time.Time) (*db.WorkoutShare, error) {
+	if r.missing {
+		return nil, nil
+	}
+	r.upsertedHash = tokenHash
+	r.upsertedID = workoutID
+	r.expiresAt = expiresAt
+	share := &db.WorkoutShare{
+		WorkoutID: workoutID,
+		UserID:    userID,
+		TokenHash: tokenHash,
+		CreatedAt: pgtype.Timestamptz{Time: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+	}
+	if expiresAt != nil {
+		share.ExpiresAt = pgtype.Timestamptz{Time: *expiresAt, Valid: true}
+	}
+	return share, nil
+}
+
+func (r *stubRepository) DeleteShare(context.Context, string, int32) (bool, error) {
+	return r.deleted, nil
+}
+
+func (r *stubRepository) GetActiveShareByTokenHash(_ context.Context, tokenHash string) (*db.WorkoutShare, error) {
+	r.lookedUpHash = tokenHash
+	if r.share == nil || r.share.TokenHash != tokenHash {
+		return nil, nil
+	}
+	return r.share, nil
+}
+
+func (r *stubRepository) GetSharedWorkout(_ context.Context, userID string, workoutID int32) (*sharedWorkout, error) {
+	r.loadedUserID = userID
+	r.loadedWorkout = workoutID
+	return r.workout, nil
+}
+
+func TestServiceCreateShare(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	t.Run("issues a hashed token without expiry", func(t *testing.T) {
+		repo := &stubRepository{}
+		service := NewService(nil, repo, "https://fittrack.example/")
+
+		link, err := service.CreateShare(ctx, 12, CreateShareRequest{})
+
+		require.NoError(t, err)
+		assert.True(t, validShareToken(link.Token))
+		assert.Equal(t, hashShareToken(link.Token), repo.upsertedHash)
+		assert.Equal(t, int32(12), repo.upsertedID)
+		assert.Equal(t, "https://fittrack.example/s/"+link.Token, link.URL)
+		assert.Nil(t, link.ExpiresAt)
+	})
+
+	t.Run("sets expiry from days", func(t *testing.T) {
+		repo := &stubRepository{}
+		service := NewService(nil, repo, "")
+		days := 7
+
+		link, err := service.CreateShare(ctx, 12, CreateShareRequest{ExpiresInDays: &days})
+
+		require.NoError(t, err)
+		require.NotNil(t, link.ExpiresAt)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, 7), *link.ExpiresAt, time.Minute)
+	})
+
+	t.Run("rejects out of range expiry", func(t *testing.T) {
+		service := NewService(nil, &stubRepository{}, "")
+		days := maxShareExpiresInDays + 1
+
+		_, err := service.CreateShare(ctx, 12, CreateShareRequest{ExpiresInDays: &days})
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "expires_in_days", validationErr.Field)
+	})
+
+	t.Run("reports workouts the user does not own as not found", func(t *testing.T) {
+		service := NewService(nil, &stubRepository{missing: true}, "")
+
+		_, err := service.CreateShare(ctx, 12, CreateShareRequest{})
+
+		var notFound *apperrors.NotFound
+		require.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("requires a user", func(t *testing.T) {
+		service := NewService(nil, &stubRepository{}, "")
+
+		_, err := service.CreateShare(context.Background(), 12, CreateShareRequest{})
+
+		var unauthorized *apperrors.Unauthorized
+		require.ErrorAs(t, err, &unauthorized)
+	})
+}
+
+func TestServiceRevokeShare(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	require.NoError(t, NewService(nil, &stubRepository{deleted: true}, "").RevokeShare(ctx, 3))
+
+	err := NewService(nil, &stubRepository{}, "").RevokeShare(ctx, 3)
+	var notFound *apperrors.NotFound
+	require.ErrorAs(t, err, &notFound)
+}
+
+func TestServiceGetSharedWorkout(t *testing.T) {
+	token, tokenHash, err := newShareToken()
+	require.NoError(t, err)
+	bench := 185.0
+	repo := &stubRepository{
+		share: &db.WorkoutShare{WorkoutID: 42, UserID: "owner-secret", TokenHash: tokenHash},
+		workout: &sharedWorkout{
+			date:     time.Date(2026, 7, 2, 2, 0, 0, 0, time.UTC),
+			focus:    "Push",
+			notes:    "  Felt strong  ",
+			timezone: "America/New_York",
+			sets: []sharedSet{
+				{exerciseName: "Bench Press", weight: &bench, reps: 5, setType: "warmup"},
+				{exerciseName: "Bench Press", weight: &bench, reps: 5, setType: "working"},
+				{exerciseName: "Bench Press", weight: &bench, reps: 4, setType: "working"},
+				{exerciseName: "Push-up", reps: 20, setType: "working"},
+			},
+		},
+	}
+	service := NewService(nil, repo, "")
+
+	workout, err := service.GetSharedWorkout(context.Background(), token)
+
+	require.NoError(t, err)
+	assert.Equal(t, "owner-secret", repo.loadedUserID)
+	assert.Equal(t, int32(42), repo.loadedWorkout)
+	assert.Equal(t, "2026-07-01", workout.Date)
+	require.NotNil(t, workout.Focus)
+	assert.Equal(t, "Push", *workout.Focus)
+	require.NotNil(t, workout.Notes)
+	assert.Equal(t, "Felt strong", *workout.Notes)
+	require.Len(t, workout.Exercises, 2)
+	assert.Equal(t, "Bench Press", workout.Exercises[0].Name)
+	assert.Len(t, workout.Exercises[0].Sets, 3)
+	assert.Nil(t, workout.Exercises[1].Sets[0].Weight)
+	assert.Equal(t, 1665.0, workout.Volume)
+
+	body, err := json.Marshal(workout)
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "owner-secret")
+	assert.NotContains(t, string(body), "42")
+	assert.NotContains(t, string(body), "user_id")
+}
+
+func TestServiceGetSharedWorkoutRejectsUnknownTokens(t *testing.T) {
+	token, _, err := newShareToken()
+	require.NoError(t, err)
+	repo := &stubRepository{}
+	service := NewService(nil, repo, "")
+	var notFound *apperrors.NotFound
+
+	_, err = service.GetSharedWorkout(context.Background(), "short")
+	require.ErrorAs(t, err, &notFound)
+	assert.Empty(t, repo.lookedUpHash, "malformed tokens should not reach the database")
+
+	_, err = service.GetSharedWorkout(context.Background(), token)
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, hashShareToken(token), repo.lookedUpHash)
+}
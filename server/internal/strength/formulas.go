@@ -0,0 +1,89 @@
+package strength
+
+import "math"
+
+// Coefficients for the DOTS, original Wilks and IPF GL (classic powerlifting)
+// formulas. All take bodyweight and total in kilograms.
+var (
+	dotsMale   = [5]float64{-0.000001093, 0.0007391293, -0.1918759221, 24.0900756, -307.75076}
+	dotsFemale = [5]float64{-0.0000010706, 0.0005158568, -0.1126655495, 13.6175032, -57.96288}
+
+	wilksMale   = [6]float64{-216.0475144, 16.2606339, -0.002388645, -0.00113732, 7.01863e-06, -1.291e-08}
+	wilksFemale = [6]float64{594.31747775582, -27.23842536447, 0.82112226871, -0.00930733913, 4.731582e-05, -9.054e-08}
+
+	ipfGLMale   = [3]float64{1199.72839, 1025.18162, 0.00921}
+	ipfGLFemale = [3]float64{610.32796, 1045.59282, 0.03048}
+)
+
+const minIPFGLBodyweightKg = 35
+
+// dotsScore returns the DOTS score for a total and bodyweight in kg.
+func dotsScore(sex string, totalKg float64, bodyweightKg float64) (float64, bool) {
+	coefficients, minBW, maxBW := dotsMale, 40.0, 210.0
+	if sex == SexFemale {
+		coefficients, maxBW = dotsFemale, 150.0
+	}
+	if totalKg <= 0 || bodyweightKg <= 0 {
+		return 0, false
+	}
+
+	bw := clamp(bodyweightKg, minBW, maxBW)
+	denominator := coefficients[0]*math.Pow(bw, 4) +
+		coefficients[1]*math.Pow(bw, 3) +
+		coefficients[2]*math.Pow(bw, 2) +
+		coefficients[3]*bw +
+		coefficients[4]
+	if denominator <= 0 {
+		return 0, false
+	}
+	return totalKg * 500 / denominator, true
+}
+
+// wilksScore returns the original (pre-2020) Wilks score for a total and
+// bodyweight in kg.
+func wilksScore(sex string, totalKg float64, bodyweightKg float64) (float64, bool) {
+	coefficients, minBW, maxBW := wilksMale, 40.0, 201.9
+	if sex == SexFemale {
+		coefficients, minBW, maxBW = wilksFemale, 26.51, 154.53
+	}
+	if totalKg <= 0 || bodyweightKg <= 0 {
+		return 0, false
+	}
+
+	bw := clamp(bodyweightKg, minBW, maxBW)
+	denominator := 0.0
+	for power, coefficient := range coefficients {
+		denominator += coefficient * math.Pow(bw, float64(power))
+	}
+	if denominator <= 0 {
+		return 0, false
+	}
+	return totalKg * 500 / denominator, true
+}
+
+// ipfGLScore returns the IPF GL points for a classic (raw) powerlifting total
+// and bodyweight in kg. The formula is undefined below 35 kg bodyweight.
+func ipfGLScore(sex string, totalKg float64, bodyweightKg float64) (float64, bool) {
+	coefficients := ipfGLMale
+	if sex == SexFemale {
+		coefficients = ipfGLFemale
+	}
+	if totalKg <= 0 || bodyweightKg < minIPFGLBodyweightKg {
+		return 0, false
+	}
+
+	denominator := coefficients[0] - coefficients[1]*math.Exp(-coefficients[2]*bodyweightKg)
+	if denominator <= 0 {
+		return 0, false
+	}
+	return totalKg * 100 / denominator, true
+}
+
+func clamp(value float64, low float64, high float64) float64 {
+	return math.Min(math.Max(value, low), high)
+}
+
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
@@ -0,0 +1,91 @@
+package strength
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScoreFormulasMatchReferenceValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		formula func(string, float64, float64) (float64, bool)
+		sex     string
+		total   float64
+		bw      float64
+		want    float64
+	}{
+		{name: "dots male", formula: dotsScore, sex: SexMale, total: 700, bw: 100, want: 430.86},
+		{name: "dots female", formula: dotsScore, sex: SexFemale, total: 400, bw: 60, want: 443.42},
+		{name: "wilks male", formula: wilksScore, sex: SexMale, total: 700, bw: 100, want: 426.01},
+		{name: "wilks female", formula: wilksScore, sex: SexFemale, total: 400, bw: 60, want: 445.95},
+		{name: "ipf gl male", formula: ipfGLScore, sex: SexMale, total: 700, bw: 100, want: 88.43},
+		{name: "ipf gl female", formula: ipfGLScore, sex: SexFemale, total: 400, bw: 60, want: 90.42},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.formula(tt.sex, tt.total, tt.bw)
+			require.True(t, ok)
+			assert.InDelta(t, tt.want, got, 0.01)
+		})
+	}
+}
+
+func TestScoreFormulasRejectUnusableInputs(t *testing.T) {
+	_, ok := dotsScore(SexMale, 0, 100)
+	assert.False(t, ok)
+	_, ok = wilksScore(SexFemale, 300, 0)
+	assert.False(t, ok)
+	_, ok = ipfGLScore(SexMale, 300, 30)
+	assert.False(t, ok)
+}
+
+func TestDotsClampsBodyweight(t *testing.T) {
+	atCap, ok := dotsScore(SexMale, 900, 210)
+	require.True(t, ok)
+	aboveCap, ok := dotsScore(SexMale, 900, 240)
+	require.True(t, ok)
+	assert.Equal(t, atCap, aboveCap)
+}
+
+func TestClassifyLift(t *testing.T) {
+	t.Run("places ratio between thresholds", func(t *testing.T) {
+		// 200 lbs is about 90.7 kg, the 90-110 kg male bracket.
+		got, ok := classifyLift(SexMale, LiftSquat, 300, 200)
+
+		require.True(t, ok)
+		assert.Equal(t, LevelIntermediate, got.Level)
+		assert.Equal(t, 1.5, got.Ratio)
+		require.NotNil(t, got.NextLevel)
+		assert.Equal(t, LevelAdvanced, *got.NextLevel)
+		require.NotNil(t, got.NextLevelE1RM)
+		assert.Equal(t, 360.0, *got.NextLevelE1RM)
+	})
+
+	t.Run("below novice is beginner", func(t *testing.T) {
+		got, ok := classifyLift(SexFemale, LiftBench, 50, 130)
+
+		require.True(t, ok)
+		assert.Equal(t, LevelBeginner, got.Level)
+		require.NotNil(t, got.NextLevel)
+		assert.Equal(t, LevelNovice, *got.NextLevel)
+	})
+
+	t.Run("elite has no next level", func(t *testing.T) {
+		got, ok := classifyLift(SexMale, LiftDeadlift, 700, 200)
+
+		require.True(t, ok)
+		assert.Equal(t, LevelElite, got.Level)
+		assert.Nil(t, got.NextLevel)
+		assert.Nil(t, got.NextLevelE1RM)
+	})
+
+	t.Run("requires sex and bodyweight", func(t *testing.T) {
+		_, ok := classifyLift("", LiftSquat, 300, 200)
+		assert.False(t, ok)
+		_, ok = classifyLift(SexMale, LiftSquat, 300, 0)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,141 @@
+package strength
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type strengthService interface {
+	GetProfile(ctx context.Context) (*ProfileResponse, error)
+	UpdateProfile(ctx context.Context, req UpdateProfileRequest) (*ProfileResponse, error)
+	GetScores(ctx context.Context) (*ScoresResponse, error)
+	GetScoreHistory(ctx context.Context) (*ScoreHistoryResponse, error)
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service strengthService
+}
+
+func NewHandler(logger *slog.Logger, service strengthService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// GetProfile godoc
+// @Summary Get strength profile
+// @Description Returns the sex and designated squat, bench and deadlift exercises used for relative-strength scoring.
+// @Tags strength
+// @Produce json
+// @Security StackAuth
+// @Success 200 {object} strength.ProfileResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /strength/profile [get]
+func (h *Handler) GetProfile(w http.ResponseWriter, r *http.Request) {
+	profile, err := h.service.GetProfile(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get strength profile")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, profile); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// UpdateProfile godoc
+// @Summary Update strength profile
+// @Description Replaces the strength profile. Designated exercises must belong to the authenticated user; null fields are cleared.
+// @Tags strength
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param request body strength.UpdateProfileRequest true "Strength profile"
+// @Success 200 {object} strength.ProfileResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /strength/profile [put]
+func (h *Handler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	var req UpdateProfileRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	profile, err := h.service.UpdateProfile(r.Context(), req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to update strength profile")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, profile); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// GetScores godoc
+// @Summary Get relative strength scores
+// @Description Returns the current powerlifting total with DOTS, Wilks and IPF GL scores from the best squat, bench and deadlift e1RMs and the latest bodyweight, plus per-lift strength-standard classifications.
+// @Tags strength
+// @Produce json
+// @Security StackAuth
+// @Success 200 {object} strength.ScoresResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /strength/scores [get]
+func (h *Handler) GetScores(w http.ResponseWriter, r *http.Request) {
+	scores, err := h.service.GetScores(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get strength scores")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, scores); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// GetScoreHistory godoc
+// @Summary Get relative strength score history
+// @Description Returns DOTS, Wilks and IPF GL over time, one point per workout day once all three lifts have been logged, using the bodyweight reading nearest each day.
+// @Tags strength
+// @Produce json
+// @Security StackAuth
+// @Success 200 {object} strength.ScoreHistoryResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /strength/scores/history [get]
+func (h *Handler) GetScoreHistory(w http.ResponseWriter, r *http.Request) {
+	history, err := h.service.GetScoreHistory(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to get strength score history")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, history); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
@@ -0,0 +1,13 @@
+package strength
+
+import (
+	"net/http"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/request"
+)
+
+const maxStrengthJSONBodyBytes = 4 << 10
+
+func decodeStrictJSON(w http.ResponseWriter, r *http.Request, dst any) error {
+	return request.DecodeStrictJSON(w, r, dst, maxStrengthJSONBodyBytes)
+}
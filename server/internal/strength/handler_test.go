@@ -0,0 +1,103 @@
+package strength
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubStrengthService struct {
+	profile *ProfileResponse
+	scores  *ScoresResponse
+	history *ScoreHistoryResponse
+	err     error
+	request UpdateProfileRequest
+}
+
+func (s *stubStrengthService) GetProfile(context.Context) (*ProfileResponse, error) {
+	return s.profile, s.err
+}
+
+func (s *stubStrengthService) UpdateProfile(_ context.Context, req UpdateProfileRequest) (*ProfileResponse, error) {
+	s.request = req
+	return s.profile, s.err
+}
+
+func (s *stubStrengthService) GetScores(context.Context) (*ScoresResponse, error) {
+	return s.scores, s.err
+}
+
+func (s *stubStrengthService) GetScoreHistory(context.Context) (*ScoreHistoryResponse, error) {
+	return s.history, s.err
+}
+
+func TestHandlerUpdateProfile(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("updates profile", func(t *testing.T) {
+		sex := SexFemale
+		service := &stubStrengthService{profile: &ProfileResponse{Sex: &sex, SquatExerciseID: int32Ptr(4)}}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.UpdateProfile(rr, httptest.NewRequest(http.MethodPut, "/api/strength/profile", strings.NewReader(`{"sex":"female","squat_exercise_id":4}`)))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NotNil(t, service.request.SquatExerciseID)
+		assert.Equal(t, int32(4), *service.request.SquatExerciseID)
+		assert.Nil(t, service.request.BenchExerciseID)
+		assert.Contains(t, rr.Body.String(), `"sex":"female"`)
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		handler := NewHandler(logger, &stubStrengthService{})
+		rr := httptest.NewRecorder()
+
+		handler.UpdateProfile(rr, httptest.NewRequest(http.MethodPut, "/api/strength/profile", strings.NewReader(`{"gender":"female"}`)))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps validation errors to bad request", func(t *testing.T) {
+		handler := NewHandler(logger, &stubStrengthService{err: &ValidationError{Field: "bench_exercise_id", Message: "exercise not found"}})
+		rr := httptest.NewRecorder()
+
+		handler.UpdateProfile(rr, httptest.NewRequest(http.MethodPut, "/api/strength/profile", strings.NewReader(`{"bench_exercise_id":99}`)))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "exercise not found")
+	})
+}
+
+func TestHandlerGetScores(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("returns scores", func(t *testing.T) {
+		dots := 430.86
+		service := &stubStrengthService{scores: &ScoresResponse{DOTS: &dots, Lifts: []LiftScore{}, MissingInputs: []string{}}}
+		handler := NewHandler(logger, service)
+		rr := httptest.NewRecorder()
+
+		handler.GetScores(rr, httptest.NewRequest(http.MethodGet, "/api/strength/scores", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"dots":430.86`)
+	})
+
+	t.Run("maps unauthorized", func(t *testing.T) {
+		handler := NewHandler(logger, &stubStrengthService{err: &apperrors.Unauthorized{Resource: "strength scores"}})
+		rr := httptest.NewRecorder()
+
+		handler.GetScoreHistory(rr, httptest.NewRequest(http.MethodGet, "/api/strength/scores/history", nil))
+
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
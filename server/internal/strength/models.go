@@ -0,0 +1,165 @@
+package strength
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	strengthDateLayout = "2006-01-02"
+	// lbsToKg converts logged weights, which the app records in pounds, to the
+	// kilogram inputs the scoring formulas and standards tables expect.
+	lbsToKg = 0.45359237
+)
+
+const (
+	SexMale   = "male"
+	SexFemale = "female"
+)
+
+const (
+	LiftSquat    = "squat"
+	LiftBench    = "bench"
+	LiftDeadlift = "deadlift"
+)
+
+const (
+	LevelBeginner     = "beginner"
+	LevelNovice       = "novice"
+	LevelIntermediate = "intermediate"
+	LevelAdvanced     = "advanced"
+	LevelElite        = "elite"
+)
+
+const (
+	MissingSex        = "sex"
+	MissingBodyweight = "bodyweight"
+	MissingSquat      = "squat"
+	MissingBench      = "bench"
+	MissingDeadlift   = "deadlift"
+)
+
+// ProfileResponse holds the inputs used for relative-strength scoring: the
+// user's sex and which of their exercises count as squat, bench and deadlift.
+type ProfileResponse struct {
+	Sex                *string `json:"sex" example:"male"`
+	SquatExerciseID    *int32  `json:"squat_exercise_id"`
+	BenchExerciseID    *int32  `json:"bench_exercise_id"`
+	DeadliftExerciseID *int32  `json:"deadlift_exercise_id"`
+}
+
+// UpdateProfileRequest replaces the strength profile. Omitted or null fields
+// are cleared.
+type UpdateProfileRequest struct {
+	Sex                *string `json:"sex" example:"male"`
+	SquatExerciseID    *int32  `json:"squat_exercise_id"`
+	BenchExerciseID    *int32  `json:"bench_exercise_id"`
+	DeadliftExerciseID *int32  `json:"deadlift_exercise_id"`
+}
+
+// LiftClassification places a lift's best e1RM against the built-in strength
+// standards for the user's sex and bodyweight. Weights are in lbs.
+type LiftClassification struct {
+	Lift          string   `json:"lift" example:"squat"`
+	E1RM          float64  `json:"e1rm"`
+	Bodyweight    float64  `json:"bodyweight"`
+	Ratio         float64  `json:"ratio"`
+	Level         string   `json:"level" example:"intermediate"`
+	NextLevel     *string  `json:"next_level" example:"advanced"`
+	NextLevelE1RM *float64 `json:"next_level_e1rm"`
+}
+
+type LiftScore struct {
+	Lift           string              `json:"lift" example:"squat"`
+	ExerciseID     int32               `json:"exercise_id"`
+	E1RM           float64             `json:"e1rm"`
+	Classification *LiftClassification `json:"classification,omitempty"`
+}
+
+// ScoresResponse reports the current powerlifting total and DOTS, Wilks and
+// IPF GL scores. Scores are omitted while MissingInputs is non-empty.
+type ScoresResponse struct {
+	Sex            *string     `json:"sex"`
+	Bodyweight     *float64    `json:"bodyweight"`
+	BodyweightDate *string     `json:"bodyweight_date" example:"2026-07-01"`
+	Total          *float64    `json:"total"`
+	DOTS           *float64    `json:"dots"`
+	Wilks          *float64    `json:"wilks"`
+	IPFGL          *float64    `json:"ipf_gl"`
+	Lifts          []LiftScore `json:"lifts"`
+	MissingInputs  []string    `json:"missing_inputs"`
+}
+
+// ScoreHistoryPoint is the score as of one workout day, using the running best
+// e1RM for each lift and the bodyweight reading nearest that day.
+type ScoreHistoryPoint struct {
+	Date       string  `json:"date" example:"2026-07-01"`
+	Squat      float64 `json:"squat"`
+	Bench      float64 `json:"bench"`
+	Deadlift   float64 `json:"deadlift"`
+	Total      float64 `json:"total"`
+	Bodyweight float64 `json:"bodyweight"`
+	DOTS       float64 `json:"dots"`
+	Wilks      float64 `json:"wilks"`
+	IPFGL      float64 `json:"ipf_gl"`
+}
+
+type ScoreHistoryResponse struct {
+	Sex           *string             `json:"sex"`
+	Points        []ScoreHistoryPoint `json:"points"`
+	MissingInputs []string            `json:"missing_inputs"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// profile is the stored strength profile in service form.
+type profile struct {
+	Sex                string
+	SquatExerciseID    *int32
+	BenchExerciseID    *int32
+	DeadliftExerciseID *int32
+}
+
+// bodyweightReading is one dated bodyweight from the body metrics log.
+type bodyweightReading struct {
+	Date       time.Time
+	Bodyweight float64
+}
+
+// sessionBest is the best e1RM for one exercise on one local workout day.
+type sessionBest struct {
+	ExerciseID int32
+	Date       time.Time
+	E1RM       float64
+}
+
+// liftExerciseIDs returns the designated exercise per lift, in squat, bench,
+// deadlift order, skipping lifts without a designation.
+func (p profile) liftExerciseIDs() []liftExercise {
+	var lifts []liftExercise
+	for _, candidate := range []liftExercise{
+		{Lift: LiftSquat, ExerciseID: p.SquatExerciseID},
+		{Lift: LiftBench, ExerciseID: p.BenchExerciseID},
+		{Lift: LiftDeadlift, ExerciseID: p.DeadliftExerciseID},
+	} {
+		if candidate.ExerciseID != nil {
+			lifts = append(lifts, candidate)
+		}
+	}
+	return lifts
+}
+
+type liftExercise struct {
+	Lift       string
+	ExerciseID *int32
+}
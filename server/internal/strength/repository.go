@@ -0,0 +1,165 @@
+package strength
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	GetProfile(ctx context.Context, userID string) (*profile, error)
+	UpsertProfile(ctx context.Context, userID string, p profile) (*profile, error)
+	ExerciseExists(ctx context.Context, userID string, exerciseID int32) (bool, error)
+	ListBodyweightReadings(ctx context.Context, userID string) ([]bodyweightReading, error)
+	ListSessionBests(ctx context.Context, userID string, exerciseIDs []int32) ([]sessionBest, error)
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	conn    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		conn:    conn,
+	}
+}
+
+// GetProfile returns the stored strength profile, or an empty profile when
+// the user has not set one.
+func (r *repository) GetProfile(ctx context.Context, userID string) (*profile, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	row, err := r.queries.GetUserStrengthProfile(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &profile{}, nil
+		}
+		return nil, fmt.Errorf("get strength profile: %w", err)
+	}
+	return profileFromRow(row), nil
+}
+
+func (r *repository) UpsertProfile(ctx context.Context, userID string, p profile) (*profile, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	row, err := r.queries.UpsertUserStrengthProfile(ctx, db.UpsertUserStrengthProfileParams{
+		UserID:             userID,
+		Sex:                pgtype.Text{String: p.Sex, Valid: p.Sex != ""},
+		SquatExerciseID:    optionalInt4(p.SquatExerciseID),
+		BenchExerciseID:    optionalInt4(p.BenchExerciseID),
+		DeadliftExerciseID: optionalInt4(p.DeadliftExerciseID),
+	})
+	if err != nil {
+		r.logger.Error("database error updating strength profile", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("upsert strength profile: %w", err)
+	}
+	return profileFromRow(row), nil
+}
+
+func (r *repository) ExerciseExists(ctx context.Context, userID string, exerciseID int32) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := r.queries.GetExercise(ctx, db.GetExerciseParams{ID: exerciseID, UserID: userID}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get exercise %d: %w", exerciseID, err)
+	}
+	return true, nil
+}
+
+func (r *repository) ListBodyweightReadings(ctx context.Context, userID string) ([]bodyweightReading, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListBodyweightReadings(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list bodyweight readings: %w", err)
+	}
+
+	readings := make([]bodyweightReading, 0, len(rows))
+	for _, row := range rows {
+		if !row.MeasuredOn.Valid || !row.Bodyweight.Valid {
+			continue
+		}
+		value, err := row.Bodyweight.Float64Value()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert numeric to float64: %w", err)
+		}
+		readings = append(readings, bodyweightReading{Date: row.MeasuredOn.Time, Bodyweight: value.Float64})
+	}
+	return readings, nil
+}
+
+func (r *repository) ListSessionBests(ctx context.Context, userID string, exerciseIDs []int32) ([]sessionBest, error) {
+	if len(exerciseIDs) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListSessionBestE1rmForExercises(ctx, db.ListSessionBestE1rmForExercisesParams{
+		UserID:      userID,
+		ExerciseIds: exerciseIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list session best e1rm: %w", err)
+	}
+
+	bests := make([]sessionBest, 0, len(rows))
+	for _, row := range rows {
+		if !row.WorkoutDay.Valid {
+			continue
+		}
+		bests = append(bests, sessionBest{
+			ExerciseID: row.ExerciseID,
+			Date:       row.WorkoutDay.Time,
+			E1RM:       row.SessionBestE1rm,
+		})
+	}
+	return bests, nil
+}
+
+func profileFromRow(row db.UserStrengthProfile) *profile {
+	p := &profile{
+		SquatExerciseID:    int32PtrFromInt4(row.SquatExerciseID),
+		BenchExerciseID:    int32PtrFromInt4(row.BenchExerciseID),
+		DeadliftExerciseID: int32PtrFromInt4(row.DeadliftExerciseID),
+	}
+	if row.Sex.Valid {
+		p.Sex = row.Sex.String
+	}
+	return p
+}
+
+func optionalInt4(value *int32) pgtype.Int4 {
+	if value == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *value, Valid: true}
+}
+
+func int32PtrFromInt4(value pgtype.Int4) *int32 {
+	if !value.Valid {
+		return nil
+	}
+	v := value.Int32
+	return &v
+}
+
+var _ Repository = (*repository)(nil)
@@ -0,0 +1,178 @@
+package strength
+
+import (
+	"sort"
+	"time"
+)
+
+// buildScores computes the current total and scores from each designated
+// lift's all-time best session e1RM and the most recent bodyweight reading.
+func buildScores(p profile, bests []sessionBest, readings []bodyweightReading) *ScoresResponse {
+	resp := &ScoresResponse{
+		Lifts:         []LiftScore{},
+		MissingInputs: []string{},
+	}
+	if p.Sex != "" {
+		sex := p.Sex
+		resp.Sex = &sex
+	}
+
+	var bodyweight float64
+	if len(readings) > 0 {
+		latest := readings[len(readings)-1]
+		bodyweight = latest.Bodyweight
+		date := latest.Date.Format(strengthDateLayout)
+		resp.Bodyweight = &bodyweight
+		resp.BodyweightDate = &date
+	}
+
+	bestByExercise := make(map[int32]float64, len(bests))
+	for _, best := range bests {
+		if best.E1RM > bestByExercise[best.ExerciseID] {
+			bestByExercise[best.ExerciseID] = best.E1RM
+		}
+	}
+
+	liftBests := make(map[string]float64, 3)
+	for _, lift := range p.liftExerciseIDs() {
+		e1rm, ok := bestByExercise[*lift.ExerciseID]
+		if !ok || e1rm <= 0 {
+			continue
+		}
+		liftBests[lift.Lift] = e1rm
+
+		score := LiftScore{
+			Lift:       lift.Lift,
+			ExerciseID: *lift.ExerciseID,
+			E1RM:       roundTo(e1rm, 1),
+		}
+		if classification, ok := classifyLift(p.Sex, lift.Lift, e1rm, bodyweight); ok {
+			score.Classification = classification
+		}
+		resp.Lifts = append(resp.Lifts, score)
+	}
+
+	resp.MissingInputs = missingInputs(p, len(readings) > 0, liftBests)
+	if len(resp.MissingInputs) > 0 {
+		return resp
+	}
+
+	total := liftBests[LiftSquat] + liftBests[LiftBench] + liftBests[LiftDeadlift]
+	roundedTotal := roundTo(total, 1)
+	resp.Total = &roundedTotal
+	resp.DOTS, resp.Wilks, resp.IPFGL = scorePointers(p.Sex, total, bodyweight)
+	return resp
+}
+
+// buildScoreHistory walks workout days in order, tracking the running best
+// e1RM per lift, and emits a point for every day once all three lifts have a
+// value. Each point uses the bodyweight reading nearest that day.
+func buildScoreHistory(p profile, bests []sessionBest, readings []bodyweightReading) *ScoreHistoryResponse {
+	resp := &ScoreHistoryResponse{
+		Points:        []ScoreHistoryPoint{},
+		MissingInputs: []string{},
+	}
+	if p.Sex != "" {
+		sex := p.Sex
+		resp.Sex = &sex
+	}
+
+	liftsByExercise := make(map[int32][]string, 3)
+	for _, lift := range p.liftExerciseIDs() {
+		liftsByExercise[*lift.ExerciseID] = append(liftsByExercise[*lift.ExerciseID], lift.Lift)
+	}
+
+	sorted := append([]sessionBest(nil), bests...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+
+	running := make(map[string]float64, 3)
+	for i := 0; i < len(sorted); {
+		day := sorted[i].Date
+		for ; i < len(sorted) && sorted[i].Date.Equal(day); i++ {
+			for _, lift := range liftsByExercise[sorted[i].ExerciseID] {
+				if sorted[i].E1RM > running[lift] {
+					running[lift] = sorted[i].E1RM
+				}
+			}
+		}
+
+		if p.Sex == "" || len(readings) == 0 || len(running) < 3 {
+			continue
+		}
+		bodyweight := nearestBodyweight(readings, day)
+		total := running[LiftSquat] + running[LiftBench] + running[LiftDeadlift]
+		dots, wilks, ipfGL := scorePointers(p.Sex, total, bodyweight)
+		if dots == nil || wilks == nil || ipfGL == nil {
+			continue
+		}
+		resp.Points = append(resp.Points, ScoreHistoryPoint{
+			Date:       day.Format(strengthDateLayout),
+			Squat:      roundTo(running[LiftSquat], 1),
+			Bench:      roundTo(running[LiftBench], 1),
+			Deadlift:   roundTo(running[LiftDeadlift], 1),
+			Total:      roundTo(total, 1),
+			Bodyweight: roundTo(bodyweight, 1),
+			DOTS:       *dots,
+			Wilks:      *wilks,
+			IPFGL:      *ipfGL,
+		})
+	}
+
+	resp.MissingInputs = missingInputs(p, len(readings) > 0, running)
+	return resp
+}
+
+func missingInputs(p profile, hasBodyweight bool, liftBests map[string]float64) []string {
+	missing := []string{}
+	if p.Sex == "" {
+		missing = append(missing, MissingSex)
+	}
+	if !hasBodyweight {
+		missing = append(missing, MissingBodyweight)
+	}
+	for _, lift := range []string{LiftSquat, LiftBench, LiftDeadlift} {
+		if liftBests[lift] <= 0 {
+			missing = append(missing, lift)
+		}
+	}
+	return missing
+}
+
+// scorePointers converts a total and bodyweight in lbs to kg and returns the
+// rounded DOTS, Wilks and IPF GL scores, nil where a formula is undefined.
+func scorePointers(sex string, total float64, bodyweight float64) (*float64, *float64, *float64) {
+	totalKg := total * lbsToKg
+	bodyweightKg := bodyweight * lbsToKg
+
+	score := func(formula func(string, float64, float64) (float64, bool)) *float64 {
+		value, ok := formula(sex, totalKg, bodyweightKg)
+		if !ok {
+			return nil
+		}
+		rounded := roundTo(value, 2)
+		return &rounded
+	}
+	return score(dotsScore), score(wilksScore), score(ipfGLScore)
+}
+
+// nearestBodyweight returns the reading closest to day; ties go to the
+// earlier reading. readings must be sorted by date and non-empty.
+func nearestBodyweight(readings []bodyweightReading, day time.Time) float64 {
+	idx := sort.Search(len(readings), func(i int) bool {
+		return !readings[i].Date.Before(day)
+	})
+	switch {
+	case idx == 0:
+		return readings[0].Bodyweight
+	case idx == len(readings):
+		return readings[len(readings)-1].Bodyweight
+	}
+
+	before, after := readings[idx-1], readings[idx]
+	if after.Date.Sub(day) < day.Sub(before.Date) {
+		return after.Bodyweight
+	}
+	return before.Bodyweight
+}
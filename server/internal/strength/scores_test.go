@@ -0,0 +1,114 @@
+package strength
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strengthDay(value string) time.Time {
+	day, err := time.Parse(strengthDateLayout, value)
+	if err != nil {
+		panic(err)
+	}
+	return day
+}
+
+func int32Ptr(value int32) *int32 {
+	return &value
+}
+
+func fullProfile() profile {
+	return profile{
+		Sex:                SexMale,
+		SquatExerciseID:    int32Ptr(1),
+		BenchExerciseID:    int32Ptr(2),
+		DeadliftExerciseID: int32Ptr(3),
+	}
+}
+
+func TestBuildScores(t *testing.T) {
+	t.Run("uses best e1rm per lift and latest bodyweight", func(t *testing.T) {
+		bests := []sessionBest{
+			{ExerciseID: 1, Date: strengthDay("2026-06-01"), E1RM: 300},
+			{ExerciseID: 1, Date: strengthDay("2026-06-08"), E1RM: 290},
+			{ExerciseID: 2, Date: strengthDay("2026-06-02"), E1RM: 220},
+			{ExerciseID: 3, Date: strengthDay("2026-06-03"), E1RM: 400},
+		}
+		readings := []bodyweightReading{
+			{Date: strengthDay("2026-05-01"), Bodyweight: 210},
+			{Date: strengthDay("2026-06-05"), Bodyweight: 200},
+		}
+
+		scores := buildScores(fullProfile(), bests, readings)
+
+		assert.Empty(t, scores.MissingInputs)
+		require.NotNil(t, scores.Total)
+		assert.Equal(t, 920.0, *scores.Total)
+		require.NotNil(t, scores.BodyweightDate)
+		assert.Equal(t, "2026-06-05", *scores.BodyweightDate)
+		require.NotNil(t, scores.DOTS)
+		require.NotNil(t, scores.Wilks)
+		require.NotNil(t, scores.IPFGL)
+		require.Len(t, scores.Lifts, 3)
+		assert.Equal(t, 300.0, scores.Lifts[0].E1RM)
+		require.NotNil(t, scores.Lifts[0].Classification)
+		assert.Equal(t, LevelIntermediate, scores.Lifts[0].Classification.Level)
+	})
+
+	t.Run("reports missing inputs", func(t *testing.T) {
+		p := profile{SquatExerciseID: int32Ptr(1)}
+		bests := []sessionBest{{ExerciseID: 1, Date: strengthDay("2026-06-01"), E1RM: 300}}
+
+		scores := buildScores(p, bests, nil)
+
+		assert.Equal(t, []string{MissingSex, MissingBodyweight, MissingBench, MissingDeadlift}, scores.MissingInputs)
+		assert.Nil(t, scores.Total)
+		assert.Nil(t, scores.DOTS)
+		require.Len(t, scores.Lifts, 1)
+		assert.Nil(t, scores.Lifts[0].Classification)
+	})
+}
+
+func TestBuildScoreHistory(t *testing.T) {
+	bests := []sessionBest{
+		{ExerciseID: 1, Date: strengthDay("2026-06-01"), E1RM: 300},
+		{ExerciseID: 2, Date: strengthDay("2026-06-02"), E1RM: 220},
+		{ExerciseID: 3, Date: strengthDay("2026-06-03"), E1RM: 400},
+		{ExerciseID: 1, Date: strengthDay("2026-06-10"), E1RM: 280},
+		{ExerciseID: 2, Date: strengthDay("2026-06-20"), E1RM: 230},
+	}
+	readings := []bodyweightReading{
+		{Date: strengthDay("2026-05-30"), Bodyweight: 205},
+		{Date: strengthDay("2026-06-15"), Bodyweight: 200},
+	}
+
+	history := buildScoreHistory(fullProfile(), bests, readings)
+
+	assert.Empty(t, history.MissingInputs)
+	require.Len(t, history.Points, 3)
+	assert.Equal(t, "2026-06-03", history.Points[0].Date)
+	assert.Equal(t, 920.0, history.Points[0].Total)
+	assert.Equal(t, 205.0, history.Points[0].Bodyweight)
+	// A lighter session does not lower the running best.
+	assert.Equal(t, "2026-06-10", history.Points[1].Date)
+	assert.Equal(t, 300.0, history.Points[1].Squat)
+	assert.Equal(t, 200.0, history.Points[1].Bodyweight)
+	assert.Equal(t, "2026-06-20", history.Points[2].Date)
+	assert.Equal(t, 930.0, history.Points[2].Total)
+	assert.Greater(t, history.Points[2].DOTS, history.Points[0].DOTS)
+}
+
+func TestNearestBodyweightPrefersEarlierReadingOnTie(t *testing.T) {
+	readings := []bodyweightReading{
+		{Date: strengthDay("2026-06-01"), Bodyweight: 200},
+		{Date: strengthDay("2026-06-05"), Bodyweight: 204},
+	}
+
+	assert.Equal(t, 200.0, nearestBodyweight(readings, strengthDay("2026-06-03")))
+	assert.Equal(t, 204.0, nearestBodyweight(readings, strengthDay("2026-06-04")))
+	assert.Equal(t, 200.0, nearestBodyweight(readings, strengthDay("2026-05-01")))
+	assert.Equal(t, 204.0, nearestBodyweight(readings, strengthDay("2026-07-01")))
+}
@@ -0,0 +1,193 @@
+package strength
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger *slog.Logger
+	repo   Repository
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+func (s *Service) GetProfile(ctx context.Context) (*ProfileResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: "strength profile", UserID: ""}
+	}
+
+	p, err := s.repo.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get strength profile: %w", err)
+	}
+	return profileResponse(*p), nil
+}
+
+// UpdateProfile replaces the strength profile after checking that every
+// designated exercise belongs to the user.
+func (s *Service) UpdateProfile(ctx context.Context, req UpdateProfileRequest) (*ProfileResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return nil, &apperrors.Unauthorized{Resource: "strength profile", UserID: ""}
+	}
+
+	p := profile{
+		SquatExerciseID:    req.SquatExerciseID,
+		BenchExerciseID:    req.BenchExerciseID,
+		DeadliftExerciseID: req.DeadliftExerciseID,
+	}
+	if req.Sex != nil {
+		p.Sex = strings.ToLower(strings.TrimSpace(*req.Sex))
+		if p.Sex != SexMale && p.Sex != SexFemale {
+			return nil, &ValidationError{Field: "sex", Message: "must be male or female"}
+		}
+	}
+
+	for _, field := range []struct {
+		name string
+		id   *int32
+	}{
+		{"squat_exercise_id", p.SquatExerciseID},
+		{"bench_exercise_id", p.BenchExerciseID},
+		{"deadlift_exercise_id", p.DeadliftExerciseID},
+	} {
+		if field.id == nil {
+			continue
+		}
+		if *field.id <= 0 {
+			return nil, &ValidationError{Field: field.name, Message: "must be a positive exercise ID"}
+		}
+		exists, err := s.repo.ExerciseExists(ctx, userID, *field.id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update strength profile: %w", err)
+		}
+		if !exists {
+			return nil, &ValidationError{Field: field.name, Message: "exercise not found"}
+		}
+	}
+
+	updated, err := s.repo.UpsertProfile(ctx, userID, p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update strength profile: %w", err)
+	}
+	return profileResponse(*updated), nil
+}
+
+func (s *Service) GetScores(ctx context.Context) (*ScoresResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: "strength scores", UserID: ""}
+	}
+
+	p, bests, readings, err := s.loadInputs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get strength scores: %w", err)
+	}
+	return buildScores(*p, bests, readings), nil
+}
+
+func (s *Service) GetScoreHistory(ctx context.Context) (*ScoreHistoryResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, &apperrors.Unauthorized{Resource: "strength scores", UserID: ""}
+	}
+
+	p, bests, readings, err := s.loadInputs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get strength score history: %w", err)
+	}
+	return buildScoreHistory(*p, bests, readings), nil
+}
+
+// ClassifyExercise classifies bestE1RM against the strength standards when
+// exerciseID is one of the user's designated squat, bench or deadlift
+// exercises. It returns nil when the exercise is not designated or the
+// profile lacks a sex or bodyweight.
+func (s *Service) ClassifyExercise(ctx context.Context, exerciseID int32, bestE1RM float64) (*LiftClassification, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" {
+		return nil, &apperrors.Unauthorized{Resource: "strength classification", UserID: ""}
+	}
+	// A coach without access to the athlete's metrics sees no classification,
+	// since it is derived from bodyweight.
+	if !user.Permits(ctx, user.ScopeReadMetrics) {
+		return nil, nil
+	}
+
+	p, err := s.repo.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to classify exercise: %w", err)
+	}
+	if p.Sex == "" {
+		return nil, nil
+	}
+
+	lift := ""
+	for _, candidate := range p.liftExerciseIDs() {
+		if *candidate.ExerciseID == exerciseID {
+			lift = candidate.Lift
+			break
+		}
+	}
+	if lift == "" {
+		return nil, nil
+	}
+
+	readings, err := s.repo.ListBodyweightReadings(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to classify exercise: %w", err)
+	}
+	if len(readings) == 0 {
+		return nil, nil
+	}
+
+	classification, _ := classifyLift(p.Sex, lift, bestE1RM, readings[len(readings)-1].Bodyweight)
+	return classification, nil
+}
+
+func (s *Service) loadInputs(ctx context.Context, userID string) (*profile, []sessionBest, []bodyweightReading, error) {
+	p, err := s.repo.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	exerciseIDs := make([]int32, 0, 3)
+	for _, lift := range p.liftExerciseIDs() {
+		exerciseIDs = append(exerciseIDs, *lift.ExerciseID)
+	}
+	bests, err := s.repo.ListSessionBests(ctx, userID, exerciseIDs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	readings, err := s.repo.ListBodyweightReadings(ctx, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return p, bests, readings, nil
+}
+
+func profileResponse(p profile) *ProfileResponse {
+	resp := &ProfileResponse{
+		SquatExerciseID:    p.SquatExerciseID,
+		BenchExerciseID:    p.BenchExerciseID,
+		DeadliftExerciseID: p.DeadliftExerciseID,
+	}
+	if p.Sex != "" {
+		sex := p.Sex
+		resp.Sex = &sex
+	}
+	return resp
+}
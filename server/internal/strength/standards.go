@@ -0,0 +1,112 @@
+package strength
+
+// standardsBracket holds bodyweight-to-e1RM ratio thresholds for reaching
+// novice, intermediate, advanced and elite; anything below novice is beginner.
+type standardsBracket struct {
+	maxBodyweightKg float64
+	thresholds      map[string][4]float64
+}
+
+var thresholdLevels = [4]string{LevelNovice, LevelIntermediate, LevelAdvanced, LevelElite}
+
+// Built-in strength standards as e1RM / bodyweight ratios. The last bracket
+// per sex has no upper bound.
+var strengthStandards = map[string][]standardsBracket{
+	SexMale: {
+		{60, map[string][4]float64{
+			LiftSquat:    {1.25, 1.65, 2.15, 2.75},
+			LiftBench:    {0.95, 1.3, 1.65, 2.05},
+			LiftDeadlift: {1.5, 1.95, 2.5, 3.1},
+		}},
+		{75, map[string][4]float64{
+			LiftSquat:    {1.2, 1.55, 2.05, 2.6},
+			LiftBench:    {0.9, 1.2, 1.55, 1.95},
+			LiftDeadlift: {1.45, 1.85, 2.4, 2.95},
+		}},
+		{90, map[string][4]float64{
+			LiftSquat:    {1.1, 1.5, 1.95, 2.45},
+			LiftBench:    {0.85, 1.15, 1.45, 1.85},
+			LiftDeadlift: {1.35, 1.8, 2.25, 2.8},
+		}},
+		{110, map[string][4]float64{
+			LiftSquat:    {1.05, 1.4, 1.8, 2.3},
+			LiftBench:    {0.8, 1.05, 1.35, 1.7},
+			LiftDeadlift: {1.25, 1.65, 2.1, 2.6},
+		}},
+		{0, map[string][4]float64{
+			LiftSquat:    {0.95, 1.25, 1.65, 2.1},
+			LiftBench:    {0.7, 0.95, 1.25, 1.55},
+			LiftDeadlift: {1.1, 1.45, 1.9, 2.35},
+		}},
+	},
+	SexFemale: {
+		{50, map[string][4]float64{
+			LiftSquat:    {0.95, 1.3, 1.7, 2.15},
+			LiftBench:    {0.6, 0.85, 1.1, 1.4},
+			LiftDeadlift: {1.2, 1.6, 2.05, 2.6},
+		}},
+		{60, map[string][4]float64{
+			LiftSquat:    {0.9, 1.25, 1.6, 2.05},
+			LiftBench:    {0.57, 0.8, 1.05, 1.3},
+			LiftDeadlift: {1.15, 1.5, 1.95, 2.45},
+		}},
+		{70, map[string][4]float64{
+			LiftSquat:    {0.85, 1.15, 1.5, 1.9},
+			LiftBench:    {0.53, 0.75, 0.97, 1.2},
+			LiftDeadlift: {1.05, 1.4, 1.8, 2.3},
+		}},
+		{85, map[string][4]float64{
+			LiftSquat:    {0.8, 1.05, 1.4, 1.75},
+			LiftBench:    {0.5, 0.7, 0.9, 1.12},
+			LiftDeadlift: {1.0, 1.3, 1.7, 2.1},
+		}},
+		{0, map[string][4]float64{
+			LiftSquat:    {0.7, 0.95, 1.25, 1.55},
+			LiftBench:    {0.45, 0.62, 0.8, 1.0},
+			LiftDeadlift: {0.9, 1.15, 1.5, 1.9},
+		}},
+	},
+}
+
+// classifyLift places an e1RM against the standards for the given sex and
+// bodyweight. e1RM and bodyweight are in lbs; the ratio is unit-free but the
+// bracket is chosen by bodyweight in kg.
+func classifyLift(sex string, lift string, e1rm float64, bodyweight float64) (*LiftClassification, bool) {
+	brackets, ok := strengthStandards[sex]
+	if !ok || e1rm <= 0 || bodyweight <= 0 {
+		return nil, false
+	}
+
+	bodyweightKg := bodyweight * lbsToKg
+	bracket := brackets[len(brackets)-1]
+	for _, candidate := range brackets[:len(brackets)-1] {
+		if bodyweightKg <= candidate.maxBodyweightKg {
+			bracket = candidate
+			break
+		}
+	}
+	thresholds, ok := bracket.thresholds[lift]
+	if !ok {
+		return nil, false
+	}
+
+	ratio := e1rm / bodyweight
+	classification := &LiftClassification{
+		Lift:       lift,
+		E1RM:       roundTo(e1rm, 1),
+		Bodyweight: roundTo(bodyweight, 1),
+		Ratio:      roundTo(ratio, 2),
+		Level:      LevelBeginner,
+	}
+	for i, threshold := range thresholds {
+		if ratio < threshold {
+			next := thresholdLevels[i]
+			target := roundTo(threshold*bodyweight, 1)
+			classification.NextLevel = &next
+			classification.NextLevelE1RM = &target
+			break
+		}
+		classification.Level = thresholdLevels[i]
+	}
+	return classification, true
+}
@@ -0,0 +1,164 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type tagService interface {
+	List(ctx context.Context) ([]TagResponse, error)
+	Create(ctx context.Context, req TagRequest) (*TagResponse, error)
+	Rename(ctx context.Context, id int32, req TagRequest) (*TagResponse, error)
+	Delete(ctx context.Context, id int32) error
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service tagService
+}
+
+func NewHandler(logger *slog.Logger, service tagService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// ListTags godoc
+// @Summary List workout tags
+// @Description Returns the authenticated user's workout tags, ordered by name, with the number of workouts carrying each.
+// @Tags tags
+// @Produce json
+// @Security StackAuth
+// @Success 200 {array} tag.TagResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /tags [get]
+func (h *Handler) ListTags(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.service.List(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list tags")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, tags); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// CreateTag godoc
+// @Summary Create workout tag
+// @Description Adds a tag to the user's vocabulary. Names are lowercased and whitespace-collapsed before saving. Tags sent with a workout are created automatically, so this is only needed to add one ahead of time.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param request body tag.TagRequest true "Tag"
+// @Success 201 {object} tag.TagResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /tags [post]
+func (h *Handler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	var req TagRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	tag, err := h.service.Create(r.Context(), req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to create tag")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusCreated, tag); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// RenameTag godoc
+// @Summary Rename workout tag
+// @Description Renames a tag on every workout that carries it. Renaming onto another existing tag is rejected.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param id path int true "Tag ID"
+// @Param request body tag.TagRequest true "Tag"
+// @Success 200 {object} tag.TagResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /tags/{id} [patch]
+func (h *Handler) RenameTag(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeTagID(w, r)
+	if !ok {
+		return
+	}
+
+	var req TagRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	tag, err := h.service.Rename(r.Context(), id, req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to rename tag")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, tag); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// DeleteTag godoc
+// @Summary Delete workout tag
+// @Description Deletes a tag and removes it from every workout. The workouts themselves are kept.
+// @Tags tags
+// @Security StackAuth
+// @Param id path int true "Tag ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /tags/{id} [delete]
+func (h *Handler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeTagID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		h.writeServiceError(w, r, err, "failed to delete tag")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errNotFound *apperrors.NotFound
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errNotFound):
+		response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
@@ -0,0 +1,120 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubTagService struct {
+	tags    []TagResponse
+	tag     *TagResponse
+	err     error
+	request TagRequest
+	id      int32
+}
+
+func (s *stubTagService) List(context.Context) ([]TagResponse, error) {
+	return s.tags, s.err
+}
+
+func (s *stubTagService) Create(_ context.Context, req TagRequest) (*TagResponse, error) {
+	s.request = req
+	return s.tag, s.err
+}
+
+func (s *stubTagService) Rename(_ context.Context, id int32, req TagRequest) (*TagResponse, error) {
+	s.id = id
+	s.request = req
+	return s.tag, s.err
+}
+
+func (s *stubTagService) Delete(_ context.Context, id int32) error {
+	s.id = id
+	return s.err
+}
+
+func newTagRequest(method string, body string, id string) *http.Request {
+	req := httptest.NewRequest(method, "/api/tags", strings.NewReader(body))
+	if id != "" {
+		req.SetPathValue("id", id)
+	}
+	return req
+}
+
+func TestHandlerListTags(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	service := &stubTagService{tags: []TagResponse{{ID: 1, Name: "deload", WorkoutCount: 2}}}
+	rr := httptest.NewRecorder()
+
+	NewHandler(logger, service).ListTags(rr, newTagRequest(http.MethodGet, "", ""))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"workout_count":2`)
+}
+
+func TestHandlerCreateTag(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("creates a tag", func(t *testing.T) {
+		service := &stubTagService{tag: &TagResponse{ID: 4, Name: "travel"}}
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).CreateTag(rr, newTagRequest(http.MethodPost, `{"name":"Travel"}`, ""))
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, "Travel", service.request.Name)
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubTagService{}).CreateTag(rr, newTagRequest(http.MethodPost, `{"name":"travel","color":"red"}`, ""))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps validation errors", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		service := &stubTagService{err: &ValidationError{Field: "name", Message: `tag "travel" already exists`}}
+
+		NewHandler(logger, service).CreateTag(rr, newTagRequest(http.MethodPost, `{"name":"travel"}`, ""))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandlerRenameAndDeleteTag(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	service := &stubTagService{tag: &TagResponse{ID: 7, Name: "sick"}}
+	rr := httptest.NewRecorder()
+	NewHandler(logger, service).RenameTag(rr, newTagRequest(http.MethodPatch, `{"name":"sick"}`, "7"))
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, int32(7), service.id)
+
+	rr = httptest.NewRecorder()
+	NewHandler(logger, &stubTagService{}).RenameTag(rr, newTagRequest(http.MethodPatch, `{"name":"sick"}`, "abc"))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = httptest.NewRecorder()
+	NewHandler(logger, &stubTagService{}).DeleteTag(rr, newTagRequest(http.MethodDelete, "", "7"))
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = httptest.NewRecorder()
+	NewHandler(logger, &stubTagService{err: &apperrors.NotFound{Resource: "tag", ID: "7"}}).DeleteTag(rr, newTagRequest(http.MethodDelete, "", "7"))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	rr = httptest.NewRecorder()
+	NewHandler(logger, &stubTagService{err: errors.New("database unavailable")}).DeleteTag(rr, newTagRequest(http.MethodDelete, "", "7"))
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
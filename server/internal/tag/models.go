@@ -0,0 +1,75 @@
+package tag
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// MaxNameLength matches workout_tag.name.
+	MaxNameLength = 64
+	// MaxPerWorkout caps how many tags one workout can carry.
+	MaxPerWorkout = 10
+	// Deload marks lighter recovery sessions; metrics trends skip workouts
+	// carrying it.
+	Deload = "deload"
+)
+
+type TagRequest struct {
+	Name string `json:"name" example:"deload"`
+}
+
+type TagResponse struct {
+	ID           int32      `json:"id"`
+	Name         string     `json:"name" example:"deload"`
+	WorkoutCount int32      `json:"workout_count"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// Normalize lowercases a tag name, trims it and collapses inner whitespace,
+// so "Comp  Prep " and "comp prep" name the same tag.
+func Normalize(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// NormalizeList normalizes each name, dropping blanks and duplicates while
+// keeping first-seen order. It returns nil when nothing is left.
+func NormalizeList(names []string) []string {
+	var normalized []string
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		name = Normalize(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		normalized = append(normalized, name)
+	}
+	return normalized
+}
+
+// ParseQuery reads a tag filter from repeated and/or comma-separated query
+// values, e.g. ?tag=deload&tag=travel or ?tag=deload,travel.
+func ParseQuery(values []string) []string {
+	var names []string
+	for _, value := range values {
+		names = append(names, strings.Split(value, ",")...)
+	}
+	return NormalizeList(names)
+}
@@ -0,0 +1,126 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// errTagExists is returned when a create or rename collides with another of
+// the user's tags.
+var errTagExists = errors.New("tag already exists")
+
+// errTagNotFound is returned when the tag does not exist or belongs to
+// another user.
+var errTagNotFound = errors.New("tag not found")
+
+type Repository interface {
+	List(ctx context.Context, userID string) ([]TagResponse, error)
+	Create(ctx context.Context, userID string, name string) (*TagResponse, error)
+	Rename(ctx context.Context, userID string, id int32, name string) (*TagResponse, error)
+	Delete(ctx context.Context, userID string, id int32) error
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, pool *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		pool:    pool,
+	}
+}
+
+func (r *repository) List(ctx context.Context, userID string) ([]TagResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ListWorkoutTags(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list workout tags: %w", err)
+	}
+
+	tags := make([]TagResponse, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, *tagResponse(row.ID, row.Name, row.WorkoutCount, row.CreatedAt, row.UpdatedAt))
+	}
+	return tags, nil
+}
+
+func (r *repository) Create(ctx context.Context, userID string, name string) (*TagResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	tag, err := r.queries.CreateWorkoutTag(ctx, db.CreateWorkoutTagParams{UserID: userID, Name: name})
+	if err != nil {
+		if db.IsUniqueConstraintError(err) {
+			return nil, errTagExists
+		}
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("create workout tag failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("create workout tag: %w", err)
+	}
+	return tagResponse(tag.ID, tag.Name, 0, tag.CreatedAt, tag.UpdatedAt), nil
+}
+
+func (r *repository) Rename(ctx context.Context, userID string, id int32, name string) (*TagResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	tag, err := r.queries.RenameWorkoutTag(ctx, db.RenameWorkoutTagParams{ID: id, UserID: userID, Name: name})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errTagNotFound
+		}
+		if db.IsUniqueConstraintError(err) {
+			return nil, errTagExists
+		}
+		return nil, fmt.Errorf("rename workout tag: %w", err)
+	}
+	return tagResponse(tag.ID, tag.Name, tag.WorkoutCount, tag.CreatedAt, tag.UpdatedAt), nil
+}
+
+func (r *repository) Delete(ctx context.Context, userID string, id int32) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	rowsAffected, err := r.queries.DeleteWorkoutTag(ctx, db.DeleteWorkoutTagParams{ID: id, UserID: userID})
+	if err != nil {
+		return fmt.Errorf("delete workout tag: %w", err)
+	}
+	if rowsAffected == 0 {
+		return errTagNotFound
+	}
+	return nil
+}
+
+func tagResponse(id int32, name string, workoutCount int32, createdAt, updatedAt pgtype.Timestamptz) *TagResponse {
+	tag := &TagResponse{
+		ID:           id,
+		Name:         name,
+		WorkoutCount: workoutCount,
+		CreatedAt:    createdAt.Time,
+	}
+	if updatedAt.Valid {
+		updated := updatedAt.Time
+		tag.UpdatedAt = &updated
+	}
+	return tag
+}
+
+var _ Repository = (*repository)(nil)
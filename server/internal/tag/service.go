@@ -0,0 +1,112 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"unicode/utf8"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+type Service struct {
+	logger *slog.Logger
+	repo   Repository
+}
+
+func NewService(logger *slog.Logger, repo Repository) *Service {
+	return &Service{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// List returns the user's tag vocabulary with how many workouts carry each
+// tag, ordered by name.
+func (s *Service) List(ctx context.Context) ([]TagResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || !user.Permits(ctx, user.ScopeReadWorkouts) {
+		return nil, &apperrors.Unauthorized{Resource: "tag", UserID: ""}
+	}
+
+	tags, err := s.repo.List(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return tags, nil
+}
+
+func (s *Service) Create(ctx context.Context, req TagRequest) (*TagResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return nil, &apperrors.Unauthorized{Resource: "tag", UserID: ""}
+	}
+
+	name, err := validateName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, userID, name)
+	if err != nil {
+		return nil, tagError(err, 0, name, "failed to create tag")
+	}
+	return created, nil
+}
+
+// Rename changes a tag's name everywhere it is used. Renaming onto another
+// existing tag is rejected rather than merged.
+func (s *Service) Rename(ctx context.Context, id int32, req TagRequest) (*TagResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return nil, &apperrors.Unauthorized{Resource: "tag", UserID: ""}
+	}
+
+	name, err := validateName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	renamed, err := s.repo.Rename(ctx, userID, id, name)
+	if err != nil {
+		return nil, tagError(err, id, name, "failed to rename tag")
+	}
+	return renamed, nil
+}
+
+// Delete removes a tag and detaches it from every workout.
+func (s *Service) Delete(ctx context.Context, id int32) error {
+	userID, ok := user.Current(ctx)
+	if !ok || userID == "" || user.IsDelegated(ctx) {
+		return &apperrors.Unauthorized{Resource: "tag", UserID: ""}
+	}
+
+	if err := s.repo.Delete(ctx, userID, id); err != nil {
+		return tagError(err, id, "", "failed to delete tag")
+	}
+	return nil
+}
+
+func validateName(raw string) (string, error) {
+	name := Normalize(raw)
+	if name == "" {
+		return "", &ValidationError{Field: "name", Message: "is required"}
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return "", &ValidationError{Field: "name", Message: fmt.Sprintf("must be at most %d characters", MaxNameLength)}
+	}
+	return name, nil
+}
+
+func tagError(err error, id int32, name string, message string) error {
+	switch {
+	case errors.Is(err, errTagNotFound):
+		return &apperrors.NotFound{Resource: "tag", ID: fmt.Sprintf("%d", id)}
+	case errors.Is(err, errTagExists):
+		return &ValidationError{Field: "name", Message: fmt.Sprintf("tag %q already exists", name)}
+	default:
+		return fmt.Errorf("%s: %w", message, err)
+	}
+}
@@ -0,0 +1,141 @@
+package tag
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRepository struct {
+	tags      []TagResponse
+	tag       *TagResponse
+	err       error
+	userID    string
+	id        int32
+	name      string
+	deletedID int32
+}
+
+func (r *stubRepository) List(_ context.Context, userID string) ([]TagResponse, error) {
+	r.userID = userID
+	return r.tags, r.err
+}
+
+func (r *stubRepository) Create(_ context.Context, userID string, name string) (*TagResponse, error) {
+	r.userID = userID
+	r.name = name
+	return r.tag, r.err
+}
+
+func (r *stubRepository) Rename(_ context.Context, userID string, id int32, name string) (*TagResponse, error) {
+	r.userID = userID
+	r.id = id
+	r.name = name
+	return r.tag, r.err
+}
+
+func (r *stubRepository) Delete(_ context.Context, userID string, id int32) error {
+	r.userID = userID
+	r.deletedID = id
+	return r.err
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "comp prep", Normalize("  Comp \t PREP "))
+	assert.Equal(t, "", Normalize("   "))
+	assert.Equal(t, []string{"deload", "travel"}, NormalizeList([]string{"Deload", " ", "travel", "DELOAD"}))
+	assert.Nil(t, NormalizeList([]string{"", " "}))
+	assert.Equal(t, []string{"deload", "sick", "travel"}, ParseQuery([]string{"deload, Sick", "travel,,deload"}))
+}
+
+func TestServiceCreate(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	t.Run("normalizes the name", func(t *testing.T) {
+		repo := &stubRepository{tag: &TagResponse{ID: 1, Name: "comp prep"}}
+
+		tag, err := NewService(nil, repo).Create(ctx, TagRequest{Name: " Comp  Prep "})
+
+		require.NoError(t, err)
+		assert.Equal(t, "comp prep", repo.name)
+		assert.Equal(t, "user-1", repo.userID)
+		assert.Equal(t, int32(1), tag.ID)
+	})
+
+	tests := []struct {
+		name string
+		req  TagRequest
+	}{
+		{name: "blank name", req: TagRequest{Name: "   "}},
+		{name: "name too long", req: TagRequest{Name: strings.Repeat("a", MaxNameLength+1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewService(nil, &stubRepository{}).Create(ctx, tt.req)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, "name", validationErr.Field)
+		})
+	}
+
+	t.Run("maps duplicates to a validation error", func(t *testing.T) {
+		_, err := NewService(nil, &stubRepository{err: errTagExists}).Create(ctx, TagRequest{Name: "deload"})
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Message, "already exists")
+	})
+}
+
+func TestServiceRenameAndDelete(t *testing.T) {
+	ctx := user.WithContext(context.Background(), "user-1")
+
+	repo := &stubRepository{tag: &TagResponse{ID: 3, Name: "travel", WorkoutCount: 4}}
+	tag, err := NewService(nil, repo).Rename(ctx, 3, TagRequest{Name: "Travel"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), repo.id)
+	assert.Equal(t, "travel", repo.name)
+	assert.Equal(t, int32(4), tag.WorkoutCount)
+
+	var errNotFound *apperrors.NotFound
+	_, err = NewService(nil, &stubRepository{err: errTagNotFound}).Rename(ctx, 9, TagRequest{Name: "sick"})
+	assert.ErrorAs(t, err, &errNotFound)
+	assert.ErrorAs(t, NewService(nil, &stubRepository{err: errTagNotFound}).Delete(ctx, 9), &errNotFound)
+
+	repo = &stubRepository{}
+	require.NoError(t, NewService(nil, repo).Delete(ctx, 5))
+	assert.Equal(t, int32(5), repo.deletedID)
+}
+
+func TestServiceDelegatedAccess(t *testing.T) {
+	ctx := user.WithDelegation(user.WithContext(context.Background(), "athlete-1"), user.Delegation{
+		ActorID: "coach-1",
+		Scopes:  []string{user.ScopeReadWorkouts},
+	})
+	var errUnauthorized *apperrors.Unauthorized
+
+	repo := &stubRepository{tags: []TagResponse{{ID: 1, Name: "deload"}}}
+	tags, err := NewService(nil, repo).List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, tags, 1)
+	assert.Equal(t, "athlete-1", repo.userID)
+
+	_, err = NewService(nil, &stubRepository{}).Create(ctx, TagRequest{Name: "deload"})
+	assert.ErrorAs(t, err, &errUnauthorized)
+	_, err = NewService(nil, &stubRepository{}).Rename(ctx, 1, TagRequest{Name: "deload"})
+	assert.ErrorAs(t, err, &errUnauthorized)
+	assert.ErrorAs(t, NewService(nil, &stubRepository{}).Delete(ctx, 1), &errUnauthorized)
+
+	metricsOnly := user.WithDelegation(user.WithContext(context.Background(), "athlete-1"), user.Delegation{
+		ActorID: "coach-1",
+		Scopes:  []string{user.ScopeReadMetrics},
+	})
+	_, err = NewService(nil, &stubRepository{}).List(metricsOnly)
+	assert.ErrorAs(t, err, &errUnauthorized)
+}
@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"slices"
+)
+
+const (
+	DelegationKey contextKey = "delegation"
+
+	// ActAsHeader names the athlete a coach is acting for. Delegated requests
+	// are read-only and limited to the scopes the athlete granted.
+	ActAsHeader = "X-Act-As"
+
+	// ScopeReadWorkouts covers the workout log itself and ScopeReadMetrics
+	// covers body metrics, the strength profile and goals; metrics derived
+	// from logged sets need both. ScopeSuggestWorkouts is never honoured
+	// through ActAsHeader, since delegated requests are read-only; it only
+	// lets the coach post to the coaching suggestions endpoint.
+	ScopeReadWorkouts    = "read_workouts"
+	ScopeReadMetrics     = "read_metrics"
+	ScopeSuggestWorkouts = "suggest_workouts"
+)
+
+// Scopes lists every scope a coach can be granted, in display order.
+var Scopes = []string{ScopeReadWorkouts, ScopeReadMetrics, ScopeSuggestWorkouts}
+
+// Delegation records that a request is made by a coach on an athlete's
+// behalf. The athlete is the current user; ActorID is the coach.
+type Delegation struct {
+	ActorID string
+	Scopes  []string
+}
+
+func WithDelegation(ctx context.Context, delegation Delegation) context.Context {
+	return context.WithValue(ctx, DelegationKey, delegation)
+}
+
+// CurrentDelegation returns the delegation attached to the request, if the
+// current user is being acted for by a coach.
+func CurrentDelegation(ctx context.Context) (Delegation, bool) {
+	delegation, ok := ctx.Value(DelegationKey).(Delegation)
+	return delegation, ok
+}
+
+// IsDelegated reports whether a coach is acting for the current user. Services
+// refuse writes on delegated requests.
+func IsDelegated(ctx context.Context) bool {
+	_, ok := CurrentDelegation(ctx)
+	return ok
+}
+
+// Permits reports whether the request may read the current user's data
+// covered by scope. Users can always read their own data; a coach acting for
+// them needs the scope to have been granted.
+func Permits(ctx context.Context, scope string) bool {
+	delegation, ok := CurrentDelegation(ctx)
+	if !ok {
+		return true
+	}
+	return slices.Contains(delegation.Scopes, scope)
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermits(t *testing.T) {
+	ctx := WithContext(context.Background(), "athlete-1")
+	assert.False(t, IsDelegated(ctx))
+	assert.True(t, Permits(ctx, ScopeReadMetrics))
+
+	delegated := WithDelegation(ctx, Delegation{ActorID: "coach-1", Scopes: []string{ScopeReadWorkouts}})
+	assert.True(t, IsDelegated(delegated))
+	assert.True(t, Permits(delegated, ScopeReadWorkouts))
+	assert.False(t, Permits(delegated, ScopeReadMetrics))
+
+	delegation, ok := CurrentDelegation(delegated)
+	assert.True(t, ok)
+	assert.Equal(t, "coach-1", delegation.ActorID)
+}
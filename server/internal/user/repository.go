@@ -10,6 +10,7 @@ import (
 
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -63,4 +64,20 @@ func (r *userRepository) CreateUser(ctx context.Context, userID string) (db.User
 	return r.queries.GetUser(ctx, id)
 }
 
+// SetTimezoneIfUnset stores a timezone only when the user has not chosen one yet
+func (r *userRepository) SetTimezoneIfUnset(ctx context.Context, userID string, timezone string) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	err := r.queries.SetUserTimezoneIfUnset(ctx, db.SetUserTimezoneIfUnsetParams{
+		UserID:   userID,
+		Timezone: pgtype.Text{String: timezone, Valid: true},
+	})
+	if err != nil {
+		r.logger.Error("database error setting inferred timezone", "error", err, "user_id", userID)
+		return fmt.Errorf("failed to set timezone for user %s: %w", userID, err)
+	}
+	return nil
+}
+
 var _ UserRepository = (*userRepository)(nil)
@@ -14,6 +14,7 @@ import (
 type UserRepository interface {
 	GetUser(ctx context.Context, id string) (db.Users, error)
 	CreateUser(ctx context.Context, userID string) (db.Users, error)
+	SetTimezoneIfUnset(ctx context.Context, userID string, timezone string) error
 }
 
 type Service struct {
@@ -56,3 +57,17 @@ func (s *Service) EnsureUser(ctx context.Context, userID string) (db.Users, erro
 	s.logger.Info("created new user", "user_id", userID)
 	return user, nil
 }
+
+// InferTimezone records the client's timezone for users who have not picked one.
+// Invalid names are ignored so a bad header never blocks a request.
+func (s *Service) InferTimezone(ctx context.Context, userID string, timezone string) (string, bool) {
+	normalized, err := NormalizeTimezone(timezone)
+	if err != nil {
+		s.logger.Debug("ignoring invalid client timezone", "user_id", userID, "timezone", timezone)
+		return "", false
+	}
+	if err := s.repo.SetTimezoneIfUnset(ctx, userID, normalized); err != nil {
+		s.logger.Warn("failed to store inferred timezone", "error", err, "user_id", userID)
+	}
+	return normalized, true
+}
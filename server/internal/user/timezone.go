@@ -0,0 +1,77 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	// Embed the IANA database so timezone lookups do not depend on the host image.
+	_ "time/tzdata"
+)
+
+const (
+	TimezoneKey contextKey = "timezone"
+
+	// TimezoneHeader carries the client's IANA timezone, for example the value
+	// of Intl.DateTimeFormat().resolvedOptions().timeZone in the browser.
+	TimezoneHeader  = "X-Timezone"
+	DefaultTimezone = "UTC"
+	maxTimezoneLen  = 64
+)
+
+func WithTimezone(ctx context.Context, timezone string) context.Context {
+	return context.WithValue(ctx, TimezoneKey, timezone)
+}
+
+// Timezone returns the timezone attached to the request, if any.
+func Timezone(ctx context.Context) (string, bool) {
+	timezone, ok := ctx.Value(TimezoneKey).(string)
+	if !ok || timezone == "" {
+		return "", false
+	}
+	return timezone, true
+}
+
+// Location resolves the request timezone, falling back to UTC when none is
+// attached or the stored name can no longer be loaded.
+func Location(ctx context.Context) *time.Location {
+	timezone, ok := Timezone(ctx)
+	if !ok {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// CivilDay returns value's calendar date in loc as a UTC midnight, so days
+// compare, subtract and format without zone offsets getting in the way.
+func CivilDay(value time.Time, loc *time.Location) time.Time {
+	local := value.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Today returns today's civil date in the request timezone as a UTC midnight.
+func Today(ctx context.Context) time.Time {
+	return CivilDay(time.Now(), Location(ctx))
+}
+
+// NormalizeTimezone trims and validates an IANA timezone name. "Local" is
+// rejected because it resolves to the server's zone, not the user's.
+func NormalizeTimezone(timezone string) (string, error) {
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		return "", fmt.Errorf("timezone is required")
+	}
+	if len(timezone) > maxTimezoneLen || strings.EqualFold(timezone, "Local") {
+		return "", fmt.Errorf("invalid timezone %q", timezone)
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return "", fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+	return loc.String(), nil
+}
@@ -0,0 +1,66 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTimezone(t *testing.T) {
+	t.Run("accepts IANA names", func(t *testing.T) {
+		timezone, err := NormalizeTimezone("  America/New_York ")
+
+		require.NoError(t, err)
+		assert.Equal(t, "America/New_York", timezone)
+	})
+
+	for _, value := range []string{"", "   ", "Local", "local", "Mars/Olympus_Mons"} {
+		t.Run("rejects "+value, func(t *testing.T) {
+			_, err := NormalizeTimezone(value)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLocation(t *testing.T) {
+	t.Run("defaults to UTC", func(t *testing.T) {
+		assert.Equal(t, time.UTC, Location(context.Background()))
+	})
+
+	t.Run("resolves attached timezone", func(t *testing.T) {
+		ctx := WithTimezone(context.Background(), "Asia/Tokyo")
+
+		assert.Equal(t, "Asia/Tokyo", Location(ctx).String())
+	})
+
+	t.Run("falls back to UTC for unloadable names", func(t *testing.T) {
+		ctx := WithTimezone(context.Background(), "Nowhere/Special")
+
+		assert.Equal(t, time.UTC, Location(ctx))
+	})
+}
+
+func TestCivilDay(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	instant := time.Date(2026, 3, 31, 20, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), CivilDay(instant, time.UTC))
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), CivilDay(instant, tokyo))
+}
+
+func TestToday(t *testing.T) {
+	ctx := WithTimezone(context.Background(), "Pacific/Kiritimati")
+	loc := Location(ctx)
+
+	before := CivilDay(time.Now(), loc)
+	today := Today(ctx)
+	after := CivilDay(time.Now(), loc)
+
+	assert.Equal(t, time.UTC, today.Location())
+	assert.Contains(t, []time.Time{before, after}, today)
+}
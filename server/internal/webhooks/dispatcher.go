@@ -0,0 +1,167 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+)
+
+// deliveryRecorder is the part of the repository the dispatcher needs.
+type deliveryRecorder interface {
+	ClaimDue(ctx context.Context, batchSize int32) ([]delivery, error)
+	RecordAttempt(ctx context.Context, d delivery, result attemptResult) (db.WebhookDelivery, error)
+}
+
+// Dispatcher sends queued deliveries. Run polls for due deliveries until its
+// context is cancelled; several instances may run at once because claims
+// skip rows another instance has locked.
+type Dispatcher struct {
+	logger   *slog.Logger
+	repo     deliveryRecorder
+	client   *http.Client
+	now      func() time.Time
+	interval time.Duration
+	// allowAddress decides which resolved addresses deliveries may connect
+	// to. Tests replace it to reach httptest servers on loopback.
+	allowAddress func(netip.Addr) bool
+}
+
+func NewDispatcher(logger *slog.Logger, repo Repository) *Dispatcher {
+	d := &Dispatcher{
+		logger:       logger,
+		repo:         repo,
+		now:          time.Now,
+		interval:     dispatchInterval,
+		allowAddress: publicAddress,
+	}
+	d.client = newDeliveryClient(func(addr netip.Addr) bool {
+		return d.allowAddress(addr)
+	})
+	return d
+}
+
+// Run delivers due deliveries every few seconds until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	d.logger.Info("starting webhook dispatcher", "interval", d.interval.String())
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("webhook dispatcher stopped")
+			return
+		case <-ticker.C:
+			d.dispatchDue(ctx)
+		}
+	}
+}
+
+// dispatchDue delivers claimed batches until the queue has nothing due.
+func (d *Dispatcher) dispatchDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		claimed, err := d.repo.ClaimDue(ctx, dispatchBatchSize)
+		if err != nil {
+			d.logger.Error("failed to claim webhook deliveries", "error", err)
+			return
+		}
+		for _, item := range claimed {
+			if _, err := d.deliver(ctx, item); err != nil {
+				d.logger.Error("failed to record webhook delivery attempt", "error", err, "delivery_id", item.ID)
+			}
+		}
+		if len(claimed) < dispatchBatchSize {
+			return
+		}
+	}
+}
+
+// deliver makes one attempt and records the outcome. If recording fails the
+// lease expires and the delivery is attempted again.
+func (d *Dispatcher) deliver(ctx context.Context, item delivery) (db.WebhookDelivery, error) {
+	result := d.attempt(ctx, item)
+	switch result.Status {
+	case StatusSucceeded:
+		d.logger.Debug("webhook delivered", "delivery_id", item.ID, "user_id", item.UserID, "event_type", item.EventType)
+	case StatusFailed:
+		d.logger.Warn("webhook delivery failed permanently", "delivery_id", item.ID, "user_id", item.UserID, "event_type", item.EventType, "error", result.Error)
+	}
+	return d.repo.RecordAttempt(ctx, item, result)
+}
+
+// attempt POSTs the signed payload. Any 2xx response is a success; anything
+// else is retried with backoff until maxDeliveryAttempts have been made.
+func (d *Dispatcher) attempt(ctx context.Context, item delivery) attemptResult {
+	now := d.now()
+	statusCode, err := d.post(ctx, item, now)
+	if err == nil {
+		return attemptResult{Status: StatusSucceeded, NextAttemptAt: now, ResponseStatus: statusCode}
+	}
+
+	result := attemptResult{ResponseStatus: statusCode, Error: truncateError(err.Error())}
+	attempts := item.AttemptCount + 1
+	if attempts >= maxDeliveryAttempts {
+		result.Status = StatusFailed
+		result.NextAttemptAt = now
+		return result
+	}
+	result.Status = StatusPending
+	result.NextAttemptAt = now.Add(retryDelay(attempts))
+	return result
+}
+
+func (d *Dispatcher) post(ctx context.Context, item delivery, now time.Time) (*int32, error) {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, item.URL, bytes.NewReader(item.Payload))
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(EventHeader, item.EventType)
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(int64(item.ID), 10))
+	req.Header.Set(SignatureHeader, signatureHeader(item.Secret, now, item.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+	// Reading a little of the body lets the connection be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodyRead))
+
+	statusCode := int32(resp.StatusCode)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &statusCode, fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+	return &statusCode, nil
+}
+
+// retryDelay is how long to wait after the given number of failed attempts.
+func retryDelay(attempts int32) time.Duration {
+	delay := retryBaseDelay
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return delay
+}
+
+func truncateError(message string) string {
+	if len([]rune(message)) <= maxLastErrorLength {
+		return message
+	}
+	return string([]rune(message)[:maxLastErrorLength])
+}
@@ -0,0 +1,236 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRecorder struct {
+	claims   [][]delivery
+	claimed  int
+	recorded []attemptResult
+}
+
+func (r *stubRecorder) ClaimDue(context.Context, int32) ([]delivery, error) {
+	r.claimed++
+	if len(r.claims) == 0 {
+		return nil, nil
+	}
+	next := r.claims[0]
+	r.claims = r.claims[1:]
+	return next, nil
+}
+
+func (r *stubRecorder) RecordAttempt(_ context.Context, d delivery, result attemptResult) (db.WebhookDelivery, error) {
+	r.recorded = append(r.recorded, result)
+	return db.WebhookDelivery{ID: d.ID, Status: result.Status, AttemptCount: d.AttemptCount + 1}, nil
+}
+
+func newTestDispatcher(repo deliveryRecorder, now time.Time) *Dispatcher {
+	d := NewDispatcher(slog.New(slog.NewTextHandler(io.Discard, nil)), nil)
+	d.repo = repo
+	d.now = func() time.Time { return now }
+	d.allowAddress = allowAnyAddress
+	return d
+}
+
+// allowAnyAddress lets tests deliver to httptest servers on loopback.
+func allowAnyAddress(netip.Addr) bool {
+	return true
+}
+
+// verifySignature checks a SignatureHeader value the way a receiver would.
+func verifySignature(t *testing.T, secret string, header string, body []byte) {
+	t.Helper()
+	parts := strings.Split(header, ",")
+	require.Len(t, parts, 2)
+	timestamp := strings.TrimPrefix(parts[0], "t=")
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + string(body)))
+	assert.Equal(t, "v1="+hex.EncodeToString(mac.Sum(nil)), parts[1])
+}
+
+func TestDispatcherAttempt(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	payload := []byte(`{"id":"evt_1","type":"workout.created","data":{"workout_id":7}}`)
+
+	t.Run("posts a signed payload", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+		recorder := &stubRecorder{}
+
+		row, err := newTestDispatcher(recorder, now).deliver(context.Background(), delivery{
+			ID: 31, UserID: "user-1", EventType: EventWorkoutCreated, Payload: payload, URL: server.URL, Secret: "whsec_test",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, StatusSucceeded, row.Status)
+		require.NotNil(t, received)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, EventWorkoutCreated, received.Header.Get(EventHeader))
+		assert.Equal(t, "31", received.Header.Get(DeliveryHeader))
+		assert.Equal(t, payload, body)
+		assert.True(t, strings.HasPrefix(received.Header.Get(SignatureHeader), "t=1792411200,"))
+		verifySignature(t, "whsec_test", received.Header.Get(SignatureHeader), body)
+		require.Len(t, recorder.recorded, 1)
+		require.NotNil(t, recorder.recorded[0].ResponseStatus)
+		assert.Equal(t, int32(http.StatusNoContent), *recorder.recorded[0].ResponseStatus)
+	})
+
+	t.Run("retries error responses with backoff", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		result := newTestDispatcher(&stubRecorder{}, now).attempt(context.Background(), delivery{
+			ID: 31, AttemptCount: 2, Payload: payload, URL: server.URL, Secret: "whsec_test",
+		})
+
+		assert.Equal(t, StatusPending, result.Status)
+		assert.Equal(t, now.Add(2*time.Minute), result.NextAttemptAt)
+		require.NotNil(t, result.ResponseStatus)
+		assert.Equal(t, int32(http.StatusInternalServerError), *result.ResponseStatus)
+		assert.Equal(t, "endpoint responded with status 500", result.Error)
+	})
+
+	t.Run("gives up after the last attempt", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		result := newTestDispatcher(&stubRecorder{}, now).attempt(context.Background(), delivery{
+			ID: 31, AttemptCount: maxDeliveryAttempts - 1, Payload: payload, URL: server.URL, Secret: "whsec_test",
+		})
+
+		assert.Equal(t, StatusFailed, result.Status)
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		followed := false
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			followed = true
+		}))
+		defer target.Close()
+		server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+		defer server.Close()
+
+		result := newTestDispatcher(&stubRecorder{}, now).attempt(context.Background(), delivery{
+			ID: 31, Payload: payload, URL: server.URL, Secret: "whsec_test",
+		})
+
+		assert.Equal(t, StatusPending, result.Status)
+		assert.False(t, followed)
+	})
+
+	t.Run("retries unreachable endpoints", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		result := newTestDispatcher(&stubRecorder{}, now).attempt(context.Background(), delivery{
+			ID: 31, Payload: payload, URL: server.URL, Secret: "whsec_test",
+		})
+
+		assert.Equal(t, StatusPending, result.Status)
+		assert.Nil(t, result.ResponseStatus)
+		assert.Equal(t, now.Add(retryBaseDelay), result.NextAttemptAt)
+		assert.Contains(t, result.Error, "send request")
+	})
+}
+
+func TestDispatcherDispatchDue(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	full := make([]delivery, dispatchBatchSize)
+	for i := range full {
+		full[i] = delivery{ID: int32(i + 1), Payload: []byte(`{}`), URL: server.URL, Secret: "whsec_test"}
+	}
+	recorder := &stubRecorder{claims: [][]delivery{full, {{ID: 99, Payload: []byte(`{}`), URL: server.URL, Secret: "whsec_test"}}}}
+
+	newTestDispatcher(recorder, time.Now()).dispatchDue(context.Background())
+
+	assert.Equal(t, 2, recorder.claimed, "a short batch means nothing else is due")
+	assert.Equal(t, dispatchBatchSize+1, calls)
+	assert.Len(t, recorder.recorded, dispatchBatchSize+1)
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, retryDelay(1))
+	assert.Equal(t, time.Minute, retryDelay(2))
+	assert.Equal(t, 32*time.Minute, retryDelay(7))
+	assert.Equal(t, time.Hour, retryDelay(8))
+	assert.Equal(t, time.Hour, retryDelay(maxDeliveryAttempts))
+}
+
+func TestDispatcherRefusesInternalAddresses(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+	recorder := &stubRecorder{}
+	d := newTestDispatcher(recorder, time.Now())
+	d.allowAddress = publicAddress
+
+	row, err := d.deliver(context.Background(), delivery{
+		ID: 31, EventType: EventWorkoutCreated, Payload: []byte(`{}`), URL: server.URL, Secret: "whsec_test",
+	})
+
+	require.NoError(t, err)
+	assert.False(t, called, "the loopback server must not be reached")
+	assert.Equal(t, StatusPending, row.Status)
+	require.Len(t, recorder.recorded, 1)
+	assert.Contains(t, recorder.recorded[0].Error, errDisallowedAddress.Error())
+	assert.Nil(t, recorder.recorded[0].ResponseStatus)
+}
+
+func TestPublicAddress(t *testing.T) {
+	for _, tt := range []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"100.64.0.1", false},
+		{"224.0.0.1", false},
+		{"::ffff:127.0.0.1", false},
+	} {
+		assert.Equal(t, tt.want, publicAddress(netip.MustParseAddr(tt.addr)), tt.addr)
+	}
+}
@@ -0,0 +1,53 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+)
+
+func newEvent(eventType string, data any, now time.Time) (Event, []byte, error) {
+	id, err := newEventID()
+	if err != nil {
+		return Event{}, nil, err
+	}
+	if data == nil {
+		data = map[string]any{}
+	}
+	event := Event{
+		ID:        id,
+		Type:      eventType,
+		CreatedAt: now.UTC(),
+		Data:      data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return Event{}, nil, fmt.Errorf("encode webhook event: %w", err)
+	}
+	return event, payload, nil
+}
+
+// enqueue queues eventType for each of the user's active endpoints subscribed
+// to it and reports how many deliveries were queued. It runs on the caller's
+// queries, so inside a transaction the deliveries commit with the change that
+// caused them and are never sent for a rolled back write.
+func enqueue(ctx context.Context, q *db.Queries, userID string, eventType string, data any) (int64, error) {
+	event, payload, err := newEvent(eventType, data, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	queued, err := q.EnqueueWebhookDeliveries(ctx, db.EnqueueWebhookDeliveriesParams{
+		EventID:   event.ID,
+		EventType: event.Type,
+		Payload:   payload,
+		UserID:    userID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("enqueue %s webhook deliveries: %w", eventType, err)
+	}
+	return queued, nil
+}
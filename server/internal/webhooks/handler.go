@@ -0,0 +1,230 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+type webhooksService interface {
+	ListEndpoints(ctx context.Context) ([]EndpointResponse, error)
+	CreateEndpoint(ctx context.Context, req CreateEndpointRequest) (*CreatedEndpointResponse, error)
+	UpdateEndpoint(ctx context.Context, id int32, req UpdateEndpointRequest) (*EndpointResponse, error)
+	DeleteEndpoint(ctx context.Context, id int32) error
+	ListDeliveries(ctx context.Context, id int32, limit int) ([]DeliveryResponse, error)
+	SendTestEvent(ctx context.Context, id int32) (*DeliveryResponse, error)
+}
+
+type Handler struct {
+	logger  *slog.Logger
+	service webhooksService
+}
+
+func NewHandler(logger *slog.Logger, service webhooksService) *Handler {
+	return &Handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// ListEndpoints godoc
+// @Summary List webhook endpoints
+// @Description Returns the authenticated user's webhook endpoints. Secrets are only returned when an endpoint is created.
+// @Tags webhooks
+// @Produce json
+// @Security StackAuth
+// @Success 200 {array} webhooks.EndpointResponse
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /webhooks [get]
+func (h *Handler) ListEndpoints(w http.ResponseWriter, r *http.Request) {
+	endpoints, err := h.service.ListEndpoints(r.Context())
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list webhook endpoints")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, endpoints); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// CreateEndpoint godoc
+// @Summary Create webhook endpoint
+// @Description Subscribes a URL to workout.created, workout.updated, workout.deleted, record.achieved or subscription.changed events. Each delivery is a JSON POST signed in the X-FitTrack-Signature header as t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed with the secret>. The secret is only returned here.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param request body webhooks.CreateEndpointRequest true "Webhook endpoint"
+// @Success 201 {object} webhooks.CreatedEndpointResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /webhooks [post]
+func (h *Handler) CreateEndpoint(w http.ResponseWriter, r *http.Request) {
+	var req CreateEndpointRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	endpoint, err := h.service.CreateEndpoint(r.Context(), req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to create webhook endpoint")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusCreated, endpoint); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// UpdateEndpoint godoc
+// @Summary Update webhook endpoint
+// @Description Changes the URL, event types or active flag; omitted fields are kept. Deactivating an endpoint fails its pending deliveries.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param id path int true "Webhook ID"
+// @Param request body webhooks.UpdateEndpointRequest true "Fields to change"
+// @Success 200 {object} webhooks.EndpointResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /webhooks/{id} [patch]
+func (h *Handler) UpdateEndpoint(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeEndpointID(w, r)
+	if !ok {
+		return
+	}
+	var req UpdateEndpointRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	endpoint, err := h.service.UpdateEndpoint(r.Context(), id, req)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to update webhook endpoint")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, endpoint); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// DeleteEndpoint godoc
+// @Summary Delete webhook endpoint
+// @Description Deletes an endpoint along with its delivery log and any pending deliveries.
+// @Tags webhooks
+// @Security StackAuth
+// @Param id path int true "Webhook ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /webhooks/{id} [delete]
+func (h *Handler) DeleteEndpoint(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeEndpointID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteEndpoint(r.Context(), id); err != nil {
+		h.writeServiceError(w, r, err, "failed to delete webhook endpoint")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListDeliveries godoc
+// @Summary List webhook deliveries
+// @Description Returns an endpoint's delivery log, newest first. Failed attempts are retried with exponential backoff for about three hours before a delivery is marked failed.
+// @Tags webhooks
+// @Produce json
+// @Security StackAuth
+// @Param id path int true "Webhook ID"
+// @Param limit query int false "Maximum deliveries to return" default(50) minimum(1) maximum(200)
+// @Success 200 {array} webhooks.DeliveryResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /webhooks/{id}/deliveries [get]
+func (h *Handler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeEndpointID(w, r)
+	if !ok {
+		return
+	}
+	limit, ok := h.decodeLimit(w, r)
+	if !ok {
+		return
+	}
+
+	deliveries, err := h.service.ListDeliveries(r.Context(), id, limit)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to list webhook deliveries")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, deliveries); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+// SendTestEvent godoc
+// @Summary Send test webhook
+// @Description Sends a signed webhook.test event to the endpoint immediately and returns the logged delivery. A failed test is retried like any other delivery.
+// @Tags webhooks
+// @Produce json
+// @Security StackAuth
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} webhooks.DeliveryResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /webhooks/{id}/test [post]
+func (h *Handler) SendTestEvent(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.decodeEndpointID(w, r)
+	if !ok {
+		return
+	}
+
+	delivery, err := h.service.SendTestEvent(r.Context(), id)
+	if err != nil {
+		h.writeServiceError(w, r, err, "failed to send test webhook")
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, delivery); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+	}
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var errUnauthorized *apperrors.Unauthorized
+	var errNotFound *apperrors.NotFound
+	var errValidation *ValidationError
+
+	switch {
+	case errors.As(err, &errUnauthorized):
+		response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+	case errors.As(err, &errNotFound):
+		response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+	case errors.As(err, &errValidation):
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, errValidation.Error(), errValidation)
+	default:
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, fallback, err)
+	}
+}
@@ -0,0 +1,46 @@
+package webhooks
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/request"
+	"github.com/Andrewy-gh/fittrack/server/internal/response"
+)
+
+const maxWebhooksJSONBodyBytes = 8 << 10
+
+func (h *Handler) decodeEndpointID(w http.ResponseWriter, r *http.Request) (int32, bool) {
+	raw := strings.TrimSpace(r.PathValue("id"))
+	if raw == "" {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Missing webhook ID", nil)
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || parsed <= 0 {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Invalid webhook ID", err)
+		return 0, false
+	}
+
+	return int32(parsed), true
+}
+
+func (h *Handler) decodeLimit(w http.ResponseWriter, r *http.Request) (int, bool) {
+	raw := strings.TrimSpace(r.URL.Query().Get("limit"))
+	if raw == "" {
+		return 0, true
+	}
+
+	limit, err := strconv.Atoi(raw)
+	if err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "limit must be an integer", err)
+		return 0, false
+	}
+	return limit, true
+}
+
+func decodeStrictJSON(w http.ResponseWriter, r *http.Request, dst any) error {
+	return request.DecodeStrictJSON(w, r, dst, maxWebhooksJSONBodyBytes)
+}
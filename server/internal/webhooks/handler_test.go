@@ -0,0 +1,184 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubWebhooksService struct {
+	created CreateEndpointRequest
+	updated UpdateEndpointRequest
+	id      int32
+	limit   int
+	err     error
+}
+
+func (s *stubWebhooksService) ListEndpoints(context.Context) ([]EndpointResponse, error) {
+	return []EndpointResponse{{ID: 4, URL: "https://example.com/hooks"}}, s.err
+}
+
+func (s *stubWebhooksService) CreateEndpoint(_ context.Context, req CreateEndpointRequest) (*CreatedEndpointResponse, error) {
+	s.created = req
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &CreatedEndpointResponse{EndpointResponse: EndpointResponse{ID: 4, URL: req.URL}, Secret: "whsec_test"}, nil
+}
+
+func (s *stubWebhooksService) UpdateEndpoint(_ context.Context, id int32, req UpdateEndpointRequest) (*EndpointResponse, error) {
+	s.id = id
+	s.updated = req
+	return &EndpointResponse{ID: id}, s.err
+}
+
+func (s *stubWebhooksService) DeleteEndpoint(_ context.Context, id int32) error {
+	s.id = id
+	return s.err
+}
+
+func (s *stubWebhooksService) ListDeliveries(_ context.Context, id int32, limit int) ([]DeliveryResponse, error) {
+	s.id = id
+	s.limit = limit
+	return []DeliveryResponse{}, s.err
+}
+
+func (s *stubWebhooksService) SendTestEvent(_ context.Context, id int32) (*DeliveryResponse, error) {
+	s.id = id
+	return &DeliveryResponse{ID: 5, EventType: EventTest, Status: StatusSucceeded}, s.err
+}
+
+func TestHandlerCreateEndpoint(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("returns the secret", func(t *testing.T) {
+		service := &stubWebhooksService{}
+		body := `{"url":"https://example.com/hooks","event_types":["workout.created"]}`
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).CreateEndpoint(rr, httptest.NewRequest(http.MethodPost, "/api/webhooks", strings.NewReader(body)))
+
+		require.Equal(t, http.StatusCreated, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"secret":"whsec_test"`)
+		assert.Equal(t, []string{EventWorkoutCreated}, service.created.EventTypes)
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubWebhooksService{}).CreateEndpoint(rr, httptest.NewRequest(http.MethodPost, "/api/webhooks", strings.NewReader(`{"url":"https://example.com","secret":"mine"}`)))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maps validation errors", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		service := &stubWebhooksService{err: &ValidationError{Field: "url", Message: "is required"}}
+
+		NewHandler(logger, service).CreateEndpoint(rr, httptest.NewRequest(http.MethodPost, "/api/webhooks", strings.NewReader(`{}`)))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandlerUpdateEndpoint(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("passes set fields", func(t *testing.T) {
+		service := &stubWebhooksService{}
+		req := httptest.NewRequest(http.MethodPatch, "/api/webhooks/4", strings.NewReader(`{"active":false}`))
+		req.SetPathValue("id", "4")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).UpdateEndpoint(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, int32(4), service.id)
+		require.NotNil(t, service.updated.Active)
+		assert.False(t, *service.updated.Active)
+		assert.Nil(t, service.updated.URL)
+	})
+
+	t.Run("maps not found", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPatch, "/api/webhooks/4", strings.NewReader(`{}`))
+		req.SetPathValue("id", "4")
+		rr := httptest.NewRecorder()
+		service := &stubWebhooksService{err: &apperrors.NotFound{Resource: endpointResource, ID: "4"}}
+
+		NewHandler(logger, service).UpdateEndpoint(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestHandlerDeleteEndpoint(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("deletes the endpoint", func(t *testing.T) {
+		service := &stubWebhooksService{}
+		req := httptest.NewRequest(http.MethodDelete, "/api/webhooks/4", nil)
+		req.SetPathValue("id", "4")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).DeleteEndpoint(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, int32(4), service.id)
+	})
+
+	t.Run("rejects an invalid id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/api/webhooks/abc", nil)
+		req.SetPathValue("id", "abc")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubWebhooksService{}).DeleteEndpoint(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandlerListDeliveries(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("passes the limit", func(t *testing.T) {
+		service := &stubWebhooksService{}
+		req := httptest.NewRequest(http.MethodGet, "/api/webhooks/4/deliveries?limit=20", nil)
+		req.SetPathValue("id", "4")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, service).ListDeliveries(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, 20, service.limit)
+	})
+
+	t.Run("rejects a malformed limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/webhooks/4/deliveries?limit=many", nil)
+		req.SetPathValue("id", "4")
+		rr := httptest.NewRecorder()
+
+		NewHandler(logger, &stubWebhooksService{}).ListDeliveries(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandlerSendTestEvent(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	req := httptest.NewRequest(http.MethodPost, "/api/webhooks/4/test", nil)
+	req.SetPathValue("id", "4")
+	rr := httptest.NewRecorder()
+
+	NewHandler(logger, &stubWebhooksService{}).SendTestEvent(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"event_type":"webhook.test"`)
+}
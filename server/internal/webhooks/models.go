@@ -0,0 +1,137 @@
+package webhooks
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	endpointResource    = "webhook"
+	maxEndpoints        = 10
+	maxURLLength        = 2048
+	defaultListLimit    = 50
+	maxListLimit        = 200
+	maxLastErrorLength  = 512
+	maxResponseBodyRead = 4 << 10
+
+	// deliveryTimeout bounds one attempt. The dispatcher delivers a claimed
+	// batch one at a time, so dispatchBatchSize times this must stay under
+	// the five minute lease in ClaimDueWebhookDeliveries.
+	deliveryTimeout   = 10 * time.Second
+	dispatchBatchSize = 10
+	dispatchInterval  = 5 * time.Second
+
+	// Failed attempts are retried after retryBaseDelay, doubling each time
+	// up to retryMaxDelay, until maxDeliveryAttempts have been made. That
+	// gives an endpoint roughly three hours to recover.
+	retryBaseDelay      = 30 * time.Second
+	retryMaxDelay       = time.Hour
+	maxDeliveryAttempts = 10
+)
+
+// Event types. EventTest is only sent by the test endpoint and cannot be
+// subscribed to.
+const (
+	EventWorkoutCreated      = "workout.created"
+	EventWorkoutUpdated      = "workout.updated"
+	EventWorkoutDeleted      = "workout.deleted"
+	EventRecordAchieved      = "record.achieved"
+	EventSubscriptionChanged = "subscription.changed"
+	EventTest                = "webhook.test"
+)
+
+// subscribableEvents lists the event types an endpoint may subscribe to, in
+// the order they are documented.
+var subscribableEvents = []string{
+	EventWorkoutCreated,
+	EventWorkoutUpdated,
+	EventWorkoutDeleted,
+	EventRecordAchieved,
+	EventSubscriptionChanged,
+}
+
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// Request headers sent with every delivery. SignatureHeader carries
+// "t=<unix seconds>,v1=<hex HMAC-SHA256>" where the HMAC is keyed with the
+// endpoint secret over "<t>.<raw body>". Receivers should recompute it and
+// reject stale timestamps.
+const (
+	SignatureHeader = "X-FitTrack-Signature"
+	EventHeader     = "X-FitTrack-Event"
+	DeliveryHeader  = "X-FitTrack-Delivery"
+	userAgent       = "FitTrack-Webhooks/1.0"
+)
+
+// Event is the JSON body of every delivery. ID is shared by all deliveries of
+// the same event, so receivers can use it to ignore retries they have
+// already processed.
+type Event struct {
+	ID        string    `json:"id" example:"evt_3f9a1c0b7d2e4f6a8b9c0d1e2f3a4b5c"`
+	Type      string    `json:"type" example:"workout.created"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// CreateEndpointRequest subscribes a URL to one or more event types.
+type CreateEndpointRequest struct {
+	URL        string   `json:"url" example:"https://example.com/hooks/fittrack"`
+	EventTypes []string `json:"event_types" example:"workout.created,record.achieved"`
+}
+
+// UpdateEndpointRequest changes only the fields that are set. Deactivating an
+// endpoint fails its pending deliveries instead of sending them later.
+type UpdateEndpointRequest struct {
+	URL        *string  `json:"url,omitempty" example:"https://example.com/hooks/fittrack"`
+	EventTypes []string `json:"event_types,omitempty" example:"workout.created"`
+	Active     *bool    `json:"active,omitempty" example:"false"`
+}
+
+type EndpointResponse struct {
+	ID         int32     `json:"id" example:"7"`
+	URL        string    `json:"url" example:"https://example.com/hooks/fittrack"`
+	EventTypes []string  `json:"event_types" example:"workout.created,record.achieved"`
+	Active     bool      `json:"active" example:"true"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// CreatedEndpointResponse is only returned on creation; the secret is not
+// shown again.
+type CreatedEndpointResponse struct {
+	EndpointResponse
+	Secret string `json:"secret" example:"whsec_2b7e151628aed2a6abf7158809cf4f3c762e7160f38b4da56a784d9045190cfe"`
+}
+
+// DeliveryResponse is one entry in an endpoint's delivery log. NextAttemptAt
+// is only set while the delivery is pending.
+type DeliveryResponse struct {
+	ID             int32          `json:"id" example:"31"`
+	EventID        string         `json:"event_id" example:"evt_3f9a1c0b7d2e4f6a8b9c0d1e2f3a4b5c"`
+	EventType      string         `json:"event_type" example:"workout.created"`
+	Status         string         `json:"status" example:"succeeded"`
+	AttemptCount   int32          `json:"attempt_count" example:"1"`
+	ResponseStatus *int32         `json:"response_status,omitempty" example:"200"`
+	LastError      string         `json:"last_error,omitempty"`
+	Payload        map[string]any `json:"payload"`
+	NextAttemptAt  *time.Time     `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  *time.Time     `json:"last_attempt_at,omitempty"`
+	DeliveredAt    *time.Time     `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Field) == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
@@ -0,0 +1,36 @@
+package webhooks
+
+import (
+	"context"
+	"log/slog"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+)
+
+// Publisher queues webhook events for other packages. Like
+// notifications.Producer, Publish runs on the caller's queries: a change
+// made in a transaction passes its transaction-bound queries, so the
+// deliveries commit or roll back with it and are never lost between the
+// write and the enqueue.
+type Publisher interface {
+	Publish(ctx context.Context, q *db.Queries, userID string, eventType string, data any) error
+}
+
+type publisher struct {
+	logger *slog.Logger
+}
+
+func NewPublisher(logger *slog.Logger) Publisher {
+	return &publisher{logger: logger}
+}
+
+func (p *publisher) Publish(ctx context.Context, q *db.Queries, userID string, eventType string, data any) error {
+	queued, err := enqueue(ctx, q, userID, eventType, data)
+	if err != nil {
+		return err
+	}
+	if queued > 0 {
+		p.logger.Debug("webhook event queued", "user_id", userID, "event_type", eventType, "deliveries", queued)
+	}
+	return nil
+}
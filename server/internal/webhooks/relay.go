@@ -0,0 +1,60 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
+)
+
+// notificationEvents maps the notification kinds that double as webhook
+// events to their event type.
+var notificationEvents = map[string]string{
+	notifications.KindHistorical1RM:       EventRecordAchieved,
+	notifications.KindSubscriptionChanged: EventSubscriptionChanged,
+}
+
+type notificationRelay struct {
+	logger  *slog.Logger
+	next    notifications.Producer
+	enqueue func(ctx context.Context, q *db.Queries, userID string, eventType string, data any) (int64, error)
+}
+
+// NewNotificationRelay wraps a notifications producer so that 1RM increases
+// and subscription changes are also queued as webhook events. The event is
+// queued on the same queries as the notification, so both commit or roll
+// back with the change that caused them.
+func NewNotificationRelay(logger *slog.Logger, next notifications.Producer) notifications.Producer {
+	return &notificationRelay{
+		logger:  logger,
+		next:    next,
+		enqueue: enqueue,
+	}
+}
+
+func (r *notificationRelay) Notify(ctx context.Context, q *db.Queries, n notifications.Notification) error {
+	if err := r.next.Notify(ctx, q, n); err != nil {
+		return err
+	}
+	eventType, ok := notificationEvents[n.Kind]
+	if !ok {
+		return nil
+	}
+
+	data := make(map[string]any, len(n.Data)+1)
+	for key, value := range n.Data {
+		data[key] = value
+	}
+	data["title"] = n.Title
+
+	queued, err := r.enqueue(ctx, q, n.UserID, eventType, data)
+	if err != nil {
+		return fmt.Errorf("relay notification to webhooks: %w", err)
+	}
+	if queued > 0 {
+		r.logger.Debug("webhook event queued", "user_id", n.UserID, "event_type", eventType, "deliveries", queued)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubProducer struct {
+	notified []notifications.Notification
+	err      error
+}
+
+func (p *stubProducer) Notify(_ context.Context, _ *db.Queries, n notifications.Notification) error {
+	p.notified = append(p.notified, n)
+	return p.err
+}
+
+type enqueuedEvent struct {
+	userID    string
+	eventType string
+	data      map[string]any
+}
+
+func newTestRelay(next notifications.Producer, enqueued *[]enqueuedEvent) *notificationRelay {
+	return &notificationRelay{
+		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+		next:   next,
+		enqueue: func(_ context.Context, _ *db.Queries, userID string, eventType string, data any) (int64, error) {
+			*enqueued = append(*enqueued, enqueuedEvent{userID: userID, eventType: eventType, data: data.(map[string]any)})
+			return 1, nil
+		},
+	}
+}
+
+func TestNotificationRelay(t *testing.T) {
+	t.Run("queues record events", func(t *testing.T) {
+		var enqueued []enqueuedEvent
+		next := &stubProducer{}
+
+		err := newTestRelay(next, &enqueued).Notify(context.Background(), nil, notifications.Notification{
+			UserID: "user-1",
+			Kind:   notifications.KindHistorical1RM,
+			Title:  "New Back Squat 1RM",
+			Data:   map[string]any{"exercise_id": int32(3)},
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, next.notified, 1)
+		require.Len(t, enqueued, 1)
+		assert.Equal(t, enqueuedEvent{
+			userID:    "user-1",
+			eventType: EventRecordAchieved,
+			data:      map[string]any{"exercise_id": int32(3), "title": "New Back Squat 1RM"},
+		}, enqueued[0])
+	})
+
+	t.Run("queues subscription events", func(t *testing.T) {
+		var enqueued []enqueuedEvent
+
+		err := newTestRelay(&stubProducer{}, &enqueued).Notify(context.Background(), nil, notifications.Notification{
+			UserID: "user-1",
+			Kind:   notifications.KindSubscriptionChanged,
+			Title:  "Your subscription is active",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, enqueued, 1)
+		assert.Equal(t, EventSubscriptionChanged, enqueued[0].eventType)
+	})
+
+	t.Run("ignores other kinds", func(t *testing.T) {
+		var enqueued []enqueuedEvent
+
+		err := newTestRelay(&stubProducer{}, &enqueued).Notify(context.Background(), nil, notifications.Notification{
+			UserID: "user-1",
+			Kind:   notifications.KindAIRunRecovered,
+			Title:  "Your AI coach reply is ready",
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, enqueued)
+	})
+
+	t.Run("does not queue when the notification fails", func(t *testing.T) {
+		var enqueued []enqueuedEvent
+
+		err := newTestRelay(&stubProducer{err: assert.AnError}, &enqueued).Notify(context.Background(), nil, notifications.Notification{
+			UserID: "user-1",
+			Kind:   notifications.KindHistorical1RM,
+			Title:  "New Back Squat 1RM",
+		})
+
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Empty(t, enqueued)
+	})
+}
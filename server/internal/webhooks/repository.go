@@ -0,0 +1,312 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// delivery is a claimed delivery with the endpoint it goes to.
+type delivery struct {
+	ID           int32
+	EndpointID   int32
+	UserID       string
+	EventType    string
+	Payload      []byte
+	AttemptCount int32
+	URL          string
+	Secret       string
+}
+
+// attemptResult is the outcome of one delivery attempt. NextAttemptAt only
+// matters while Status is pending.
+type attemptResult struct {
+	Status         string
+	NextAttemptAt  time.Time
+	ResponseStatus *int32
+	Error          string
+}
+
+type endpointValues struct {
+	URL        string
+	EventTypes []string
+	Active     bool
+}
+
+type Repository interface {
+	ListEndpoints(ctx context.Context, userID string) ([]db.WebhookEndpoint, error)
+	// GetEndpoint returns nil when the endpoint does not exist.
+	GetEndpoint(ctx context.Context, userID string, id int32) (*db.WebhookEndpoint, error)
+	CountEndpoints(ctx context.Context, userID string) (int64, error)
+	CreateEndpoint(ctx context.Context, userID string, secret string, values endpointValues) (db.WebhookEndpoint, error)
+	// UpdateEndpoint returns nil when the endpoint does not exist. Deactivating
+	// an endpoint fails its pending deliveries in the same transaction.
+	UpdateEndpoint(ctx context.Context, userID string, id int32, values endpointValues) (*db.WebhookEndpoint, error)
+	DeleteEndpoint(ctx context.Context, userID string, id int32) (bool, error)
+	ListDeliveries(ctx context.Context, userID string, endpointID int32, limit int32) ([]db.WebhookDelivery, error)
+	// CreateDelivery queues one event for a single endpoint, leased so the
+	// dispatcher leaves it to the caller's immediate attempt.
+	CreateDelivery(ctx context.Context, endpoint db.WebhookEndpoint, eventType string, data any) (delivery, error)
+	ClaimDue(ctx context.Context, batchSize int32) ([]delivery, error)
+	RecordAttempt(ctx context.Context, d delivery, result attemptResult) (db.WebhookDelivery, error)
+}
+
+type repository struct {
+	logger  *slog.Logger
+	queries *db.Queries
+	conn    *pgxpool.Pool
+}
+
+func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool) Repository {
+	return &repository{
+		logger:  logger,
+		queries: queries,
+		conn:    conn,
+	}
+}
+
+func (r *repository) ListEndpoints(ctx context.Context, userID string) ([]db.WebhookEndpoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	endpoints, err := r.queries.ListWebhookEndpoints(ctx, userID)
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("list webhook endpoints failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("list webhook endpoints: %w", err)
+	}
+	return endpoints, nil
+}
+
+func (r *repository) GetEndpoint(ctx context.Context, userID string, id int32) (*db.WebhookEndpoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	endpoint, err := r.queries.GetWebhookEndpoint(ctx, db.GetWebhookEndpointParams{ID: id, UserID: userID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get webhook endpoint: %w", err)
+	}
+	return &endpoint, nil
+}
+
+func (r *repository) CountEndpoints(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	count, err := r.queries.CountWebhookEndpoints(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("count webhook endpoints: %w", err)
+	}
+	return count, nil
+}
+
+func (r *repository) CreateEndpoint(ctx context.Context, userID string, secret string, values endpointValues) (db.WebhookEndpoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	endpoint, err := r.queries.CreateWebhookEndpoint(ctx, db.CreateWebhookEndpointParams{
+		UserID:     userID,
+		Url:        values.URL,
+		Secret:     secret,
+		EventTypes: values.EventTypes,
+	})
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("create webhook endpoint failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"error_type", "rls_violation")
+		}
+		return db.WebhookEndpoint{}, fmt.Errorf("create webhook endpoint: %w", err)
+	}
+	return endpoint, nil
+}
+
+func (r *repository) UpdateEndpoint(ctx context.Context, userID string, id int32, values endpointValues) (*db.WebhookEndpoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin webhook endpoint update: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	endpoint, err := qtx.UpdateWebhookEndpoint(ctx, db.UpdateWebhookEndpointParams{
+		Url:        values.URL,
+		EventTypes: values.EventTypes,
+		Active:     values.Active,
+		ID:         id,
+		UserID:     userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		if db.IsRowLevelSecurityError(err) {
+			r.logger.Error("update webhook endpoint failed - RLS policy violation",
+				"error", err,
+				"user_id", userID,
+				"endpoint_id", id,
+				"error_type", "rls_violation")
+		}
+		return nil, fmt.Errorf("update webhook endpoint: %w", err)
+	}
+
+	if !endpoint.Active {
+		if _, err := qtx.FailPendingWebhookDeliveries(ctx, db.FailPendingWebhookDeliveriesParams{
+			LastError:  pgtype.Text{String: "endpoint deactivated", Valid: true},
+			EndpointID: id,
+			UserID:     userID,
+		}); err != nil {
+			return nil, fmt.Errorf("fail pending webhook deliveries: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit webhook endpoint update: %w", err)
+	}
+	return &endpoint, nil
+}
+
+func (r *repository) DeleteEndpoint(ctx context.Context, userID string, id int32) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	affected, err := r.queries.DeleteWebhookEndpoint(ctx, db.DeleteWebhookEndpointParams{ID: id, UserID: userID})
+	if err != nil {
+		return false, fmt.Errorf("delete webhook endpoint: %w", err)
+	}
+	return affected > 0, nil
+}
+
+func (r *repository) ListDeliveries(ctx context.Context, userID string, endpointID int32, limit int32) ([]db.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	deliveries, err := r.queries.ListWebhookDeliveries(ctx, db.ListWebhookDeliveriesParams{
+		EndpointID: endpointID,
+		UserID:     userID,
+		RowLimit:   limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func (r *repository) CreateDelivery(ctx context.Context, endpoint db.WebhookEndpoint, eventType string, data any) (delivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	event, payload, err := newEvent(eventType, data, time.Now())
+	if err != nil {
+		return delivery{}, err
+	}
+	row, err := r.queries.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+		EndpointID: endpoint.ID,
+		UserID:     endpoint.UserID,
+		EventID:    event.ID,
+		EventType:  event.Type,
+		Payload:    payload,
+	})
+	if err != nil {
+		return delivery{}, fmt.Errorf("create webhook delivery: %w", err)
+	}
+
+	return delivery{
+		ID:           row.ID,
+		EndpointID:   row.EndpointID,
+		UserID:       row.UserID,
+		EventType:    row.EventType,
+		Payload:      row.Payload,
+		AttemptCount: row.AttemptCount,
+		URL:          endpoint.Url,
+		Secret:       endpoint.Secret,
+	}, nil
+}
+
+// ClaimDue leases due deliveries across all users. It runs outside any
+// request, so there is no RLS user; the claim goes through a SECURITY
+// DEFINER function that only leases due deliveries.
+func (r *repository) ClaimDue(ctx context.Context, batchSize int32) ([]delivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rows, err := r.queries.ClaimDueWebhookDeliveries(ctx, batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("claim due webhook deliveries: %w", err)
+	}
+
+	deliveries := make([]delivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, delivery{
+			ID:           row.ID,
+			EndpointID:   row.EndpointID,
+			UserID:       row.UserID,
+			EventType:    row.EventType,
+			Payload:      row.Payload,
+			AttemptCount: row.AttemptCount,
+			URL:          row.Url,
+			Secret:       row.Secret,
+		})
+	}
+	return deliveries, nil
+}
+
+// RecordAttempt stores an attempt's outcome. The dispatcher has no request
+// context, so the RLS user is set to the delivery's owner for the
+// transaction.
+func (r *repository) RecordAttempt(ctx context.Context, d delivery, result attemptResult) (db.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return db.WebhookDelivery{}, fmt.Errorf("begin webhook delivery update: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT set_config('app.current_user_id', $1, true)", d.UserID); err != nil {
+		return db.WebhookDelivery{}, fmt.Errorf("set webhook delivery rls user: %w", err)
+	}
+
+	params := db.RecordWebhookDeliveryAttemptParams{
+		Status:        result.Status,
+		NextAttemptAt: pgtype.Timestamptz{Time: result.NextAttemptAt.UTC(), Valid: true},
+		ID:            d.ID,
+		UserID:        d.UserID,
+	}
+	if result.ResponseStatus != nil {
+		params.ResponseStatus = pgtype.Int4{Int32: *result.ResponseStatus, Valid: true}
+	}
+	if result.Error != "" {
+		params.LastError = pgtype.Text{String: result.Error, Valid: true}
+	}
+
+	row, err := r.queries.WithTx(tx).RecordWebhookDeliveryAttempt(ctx, params)
+	if err != nil {
+		return db.WebhookDelivery{}, fmt.Errorf("record webhook delivery attempt: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return db.WebhookDelivery{}, fmt.Errorf("commit webhook delivery update: %w", err)
+	}
+	return row, nil
+}
+
+var _ Repository = (*repository)(nil)
@@ -0,0 +1,326 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+)
+
+// deliverer makes one delivery attempt and records it.
+type deliverer interface {
+	deliver(ctx context.Context, item delivery) (db.WebhookDelivery, error)
+}
+
+type Service struct {
+	logger     *slog.Logger
+	repo       Repository
+	dispatcher deliverer
+}
+
+func NewService(logger *slog.Logger, repo Repository, dispatcher *Dispatcher) *Service {
+	return &Service{
+		logger:     logger,
+		repo:       repo,
+		dispatcher: dispatcher,
+	}
+}
+
+// ListEndpoints returns the user's endpoints without their secrets. Webhooks
+// send the user's data elsewhere, so delegated coach requests are refused.
+func (s *Service) ListEndpoints(ctx context.Context) ([]EndpointResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := s.repo.ListEndpoints(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+
+	resp := make([]EndpointResponse, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		resp = append(resp, endpointResponse(endpoint))
+	}
+	return resp, nil
+}
+
+// CreateEndpoint subscribes a URL and returns it with its signing secret,
+// which is only shown here.
+func (s *Service) CreateEndpoint(ctx context.Context, req CreateEndpointRequest) (*CreatedEndpointResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endpointURL, err := validateURL(req.URL)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes, err := validateEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountEndpoints(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count webhook endpoints: %w", err)
+	}
+	if count >= maxEndpoints {
+		return nil, &ValidationError{Message: fmt.Sprintf("you can have at most %d webhook endpoints", maxEndpoints)}
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := s.repo.CreateEndpoint(ctx, userID, secret, endpointValues{URL: endpointURL, EventTypes: eventTypes, Active: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+
+	return &CreatedEndpointResponse{
+		EndpointResponse: endpointResponse(endpoint),
+		Secret:           endpoint.Secret,
+	}, nil
+}
+
+func (s *Service) UpdateEndpoint(ctx context.Context, id int32, req UpdateEndpointRequest) (*EndpointResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.endpoint(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	values := endpointValues{URL: existing.Url, EventTypes: existing.EventTypes, Active: existing.Active}
+	if req.URL != nil {
+		if values.URL, err = validateURL(*req.URL); err != nil {
+			return nil, err
+		}
+	}
+	if req.EventTypes != nil {
+		if values.EventTypes, err = validateEventTypes(req.EventTypes); err != nil {
+			return nil, err
+		}
+	}
+	if req.Active != nil {
+		values.Active = *req.Active
+	}
+
+	updated, err := s.repo.UpdateEndpoint(ctx, userID, id, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+	if updated == nil {
+		return nil, &apperrors.NotFound{Resource: endpointResource, ID: strconv.FormatInt(int64(id), 10)}
+	}
+
+	resp := endpointResponse(*updated)
+	return &resp, nil
+}
+
+func (s *Service) DeleteEndpoint(ctx context.Context, id int32) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.repo.DeleteEndpoint(ctx, userID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+	if !deleted {
+		return &apperrors.NotFound{Resource: endpointResource, ID: strconv.FormatInt(int64(id), 10)}
+	}
+	return nil
+}
+
+// ListDeliveries returns an endpoint's delivery log, newest first. limit
+// defaults to 50.
+func (s *Service) ListDeliveries(ctx context.Context, id int32, limit int) ([]DeliveryResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if limit < 1 || limit > maxListLimit {
+		return nil, &ValidationError{Field: "limit", Message: fmt.Sprintf("must be between 1 and %d", maxListLimit)}
+	}
+	if _, err := s.endpoint(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.repo.ListDeliveries(ctx, userID, id, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	resp := make([]DeliveryResponse, 0, len(deliveries))
+	for _, row := range deliveries {
+		delivery, err := deliveryResponse(row)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, delivery)
+	}
+	return resp, nil
+}
+
+// SendTestEvent sends a webhook.test event to the endpoint straight away,
+// whether or not it is active, and returns the logged delivery. A failed
+// test is retried like any other delivery.
+func (s *Service) SendTestEvent(ctx context.Context, id int32) (*DeliveryResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := s.endpoint(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	queued, err := s.repo.CreateDelivery(ctx, *endpoint, EventTest, map[string]any{
+		"endpoint_id": endpoint.ID,
+		"message":     "This is a test event from FitTrack.",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue test webhook: %w", err)
+	}
+	row, err := s.dispatcher.deliver(ctx, queued)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send test webhook: %w", err)
+	}
+
+	resp, err := deliveryResponse(row)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *Service) endpoint(ctx context.Context, userID string, id int32) (*db.WebhookEndpoint, error) {
+	endpoint, err := s.repo.GetEndpoint(ctx, userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook endpoint: %w", err)
+	}
+	if endpoint == nil {
+		return nil, &apperrors.NotFound{Resource: endpointResource, ID: strconv.FormatInt(int64(id), 10)}
+	}
+	return endpoint, nil
+}
+
+func currentUserID(ctx context.Context) (string, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || user.IsDelegated(ctx) {
+		return "", &apperrors.Unauthorized{Resource: endpointResource, UserID: ""}
+	}
+	return userID, nil
+}
+
+func validateURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", &ValidationError{Field: "url", Message: "is required"}
+	}
+	if len(raw) > maxURLLength {
+		return "", &ValidationError{Field: "url", Message: fmt.Sprintf("must be at most %d characters", maxURLLength)}
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", &ValidationError{Field: "url", Message: "must be an absolute http or https URL"}
+	}
+	if parsed.User != nil {
+		return "", &ValidationError{Field: "url", Message: "must not contain credentials"}
+	}
+	// Hostnames are checked again when each delivery connects; this only
+	// gives early feedback for URLs that can never be delivered to.
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if addr, err := netip.ParseAddr(host); (err == nil && !publicAddress(addr)) || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return "", &ValidationError{Field: "url", Message: "must not point to a local or private address"}
+	}
+	return raw, nil
+}
+
+// validateEventTypes rejects unknown types and returns the rest deduplicated
+// in their documented order.
+func validateEventTypes(eventTypes []string) ([]string, error) {
+	if len(eventTypes) == 0 {
+		return nil, &ValidationError{Field: "event_types", Message: "must include at least one event type"}
+	}
+	requested := make(map[string]bool, len(eventTypes))
+	for _, eventType := range eventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if !slices.Contains(subscribableEvents, eventType) {
+			return nil, &ValidationError{Field: "event_types", Message: fmt.Sprintf("unknown event type %q; must be one of %s", eventType, strings.Join(subscribableEvents, ", "))}
+		}
+		requested[eventType] = true
+	}
+
+	validated := make([]string, 0, len(requested))
+	for _, eventType := range subscribableEvents {
+		if requested[eventType] {
+			validated = append(validated, eventType)
+		}
+	}
+	return validated, nil
+}
+
+func endpointResponse(endpoint db.WebhookEndpoint) EndpointResponse {
+	return EndpointResponse{
+		ID:         endpoint.ID,
+		URL:        endpoint.Url,
+		EventTypes: endpoint.EventTypes,
+		Active:     endpoint.Active,
+		CreatedAt:  endpoint.CreatedAt.Time,
+		UpdatedAt:  endpoint.UpdatedAt.Time,
+	}
+}
+
+func deliveryResponse(row db.WebhookDelivery) (DeliveryResponse, error) {
+	resp := DeliveryResponse{
+		ID:           row.ID,
+		EventID:      row.EventID,
+		EventType:    row.EventType,
+		Status:       row.Status,
+		AttemptCount: row.AttemptCount,
+		LastError:    row.LastError.String,
+		Payload:      map[string]any{},
+		CreatedAt:    row.CreatedAt.Time,
+	}
+	if len(row.Payload) > 0 {
+		if err := json.Unmarshal(row.Payload, &resp.Payload); err != nil {
+			return DeliveryResponse{}, fmt.Errorf("decode webhook delivery %d payload: %w", row.ID, err)
+		}
+	}
+	if row.ResponseStatus.Valid {
+		status := row.ResponseStatus.Int32
+		resp.ResponseStatus = &status
+	}
+	if row.Status == StatusPending && row.NextAttemptAt.Valid {
+		next := row.NextAttemptAt.Time
+		resp.NextAttemptAt = &next
+	}
+	if row.LastAttemptAt.Valid {
+		last := row.LastAttemptAt.Time
+		resp.LastAttemptAt = &last
+	}
+	if row.DeliveredAt.Valid {
+		delivered := row.DeliveredAt.Time
+		resp.DeliveredAt = &delivered
+	}
+	return resp, nil
+}
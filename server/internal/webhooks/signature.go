@@ -0,0 +1,46 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	secretPrefix  = "whsec_"
+	secretBytes   = 32
+	eventIDPrefix = "evt_"
+	eventIDBytes  = 16
+)
+
+// newSecret returns a random signing secret for a new endpoint.
+func newSecret() (string, error) {
+	raw := make([]byte, secretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(raw), nil
+}
+
+func newEventID() (string, error) {
+	raw := make([]byte, eventIDBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generate webhook event id: %w", err)
+	}
+	return eventIDPrefix + hex.EncodeToString(raw), nil
+}
+
+// signatureHeader returns the SignatureHeader value for body sent at
+// timestamp.
+func signatureHeader(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
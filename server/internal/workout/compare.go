@@ -0,0 +1,254 @@
+package workout
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+)
+
+const (
+	matchedByExerciseID = "exercise_id"
+	matchedByName       = "name"
+
+	// minNameSimilarity is how alike two normalized exercise names must be,
+	// as 1 minus their edit distance over the longer length, to be paired.
+	minNameSimilarity = 0.8
+)
+
+// ComparisonWorkoutFromRows converts GetWorkoutWithSets rows for use with
+// CompareWorkouts. It returns nil when there are no rows.
+func ComparisonWorkoutFromRows(rows []db.GetWorkoutWithSetsRow) (*ComparisonWorkout, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	workout := &ComparisonWorkout{
+		ID:   rows[0].WorkoutID,
+		Date: rows[0].WorkoutDate.Time,
+		Sets: make([]ComparisonSet, 0, len(rows)),
+	}
+	if rows[0].WorkoutFocus.Valid {
+		focus := rows[0].WorkoutFocus.String
+		workout.Focus = &focus
+	}
+	for _, row := range rows {
+		set := ComparisonSet{
+			ExerciseID:   row.ExerciseID,
+			ExerciseName: row.ExerciseName,
+			Reps:         row.Reps,
+			SetType:      row.SetType,
+		}
+		if row.Weight.Valid {
+			weight, err := row.Weight.Float64Value()
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert weight: %w", err)
+			}
+			set.Weight = &weight.Float64
+		}
+		workout.Sets = append(workout.Sets, set)
+	}
+	return workout, nil
+}
+
+// CompareWorkouts aligns the exercises of two workouts, first by exercise ID
+// and then by similar names, and reports the change from a to b for each
+// pair along with the exercises only one of them contains.
+func CompareWorkouts(a ComparisonWorkout, b ComparisonWorkout) *WorkoutComparisonResponse {
+	exercisesA := summarizeExercises(a.Sets)
+	exercisesB := summarizeExercises(b.Sets)
+
+	pairs := make(map[int]int, len(exercisesA))
+	matchedBy := make(map[int]string, len(exercisesA))
+	pairedB := make(map[int]bool, len(exercisesB))
+
+	indexB := make(map[int32]int, len(exercisesB))
+	for j, exercise := range exercisesB {
+		indexB[exercise.ExerciseID] = j
+	}
+	for i, exercise := range exercisesA {
+		if j, ok := indexB[exercise.ExerciseID]; ok {
+			pairs[i] = j
+			matchedBy[i] = matchedByExerciseID
+			pairedB[j] = true
+		}
+	}
+
+	// Pair the remaining exercises greedily, most similar names first.
+	type candidate struct {
+		a, b       int
+		similarity float64
+	}
+	var candidates []candidate
+	for i, exerciseA := range exercisesA {
+		if _, ok := pairs[i]; ok {
+			continue
+		}
+		for j, exerciseB := range exercisesB {
+			if pairedB[j] {
+				continue
+			}
+			if similarity := nameSimilarity(exerciseA.ExerciseName, exerciseB.ExerciseName); similarity >= minNameSimilarity {
+				candidates = append(candidates, candidate{a: i, b: j, similarity: similarity})
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].similarity > candidates[j].similarity
+	})
+	for _, c := range candidates {
+		if _, ok := pairs[c.a]; ok || pairedB[c.b] {
+			continue
+		}
+		pairs[c.a] = c.b
+		matchedBy[c.a] = matchedByName
+		pairedB[c.b] = true
+	}
+
+	comparison := &WorkoutComparisonResponse{
+		A:         comparedWorkout(a),
+		B:         comparedWorkout(b),
+		Exercises: []ExerciseComparisonResponse{},
+		OnlyInA:   []ExerciseSessionResponse{},
+		OnlyInB:   []ExerciseSessionResponse{},
+	}
+	for i, exerciseA := range exercisesA {
+		j, ok := pairs[i]
+		if !ok {
+			comparison.OnlyInA = append(comparison.OnlyInA, exerciseA)
+			continue
+		}
+		exerciseB := exercisesB[j]
+		comparison.Exercises = append(comparison.Exercises, ExerciseComparisonResponse{
+			ExerciseName: exerciseA.ExerciseName,
+			MatchedBy:    matchedBy[i],
+			A:            exerciseA,
+			B:            exerciseB,
+			Delta:        exerciseDelta(exerciseA, exerciseB),
+		})
+	}
+	for j, exerciseB := range exercisesB {
+		if !pairedB[j] {
+			comparison.OnlyInB = append(comparison.OnlyInB, exerciseB)
+		}
+	}
+	return comparison
+}
+
+func comparedWorkout(workout ComparisonWorkout) ComparedWorkoutResponse {
+	return ComparedWorkoutResponse{
+		WorkoutID: workout.ID,
+		Date:      workout.Date,
+		Focus:     workout.Focus,
+	}
+}
+
+// summarizeExercises totals the working sets of each exercise, in the order
+// the exercises first appear.
+func summarizeExercises(sets []ComparisonSet) []ExerciseSessionResponse {
+	var exercises []ExerciseSessionResponse
+	index := make(map[int32]int)
+	for _, set := range sets {
+		i, ok := index[set.ExerciseID]
+		if !ok {
+			i = len(exercises)
+			index[set.ExerciseID] = i
+			exercises = append(exercises, ExerciseSessionResponse{
+				ExerciseID:   set.ExerciseID,
+				ExerciseName: set.ExerciseName,
+			})
+		}
+		if set.SetType != "working" {
+			continue
+		}
+
+		exercise := &exercises[i]
+		exercise.Sets++
+		exercise.Reps += int(set.Reps)
+		if set.Weight == nil {
+			continue
+		}
+		weight := *set.Weight
+		exercise.Volume = roundComparison(exercise.Volume + weight*float64(set.Reps))
+		if e1rm := roundComparison(weight * (1 + float64(set.Reps)/30)); e1rm > exercise.E1RM {
+			exercise.E1RM = e1rm
+		}
+		if exercise.TopSet == nil || weight > exercise.TopSet.Weight || (weight == exercise.TopSet.Weight && set.Reps > exercise.TopSet.Reps) {
+			exercise.TopSet = &TopSetResponse{Weight: weight, Reps: set.Reps}
+		}
+	}
+	return exercises
+}
+
+func exerciseDelta(a ExerciseSessionResponse, b ExerciseSessionResponse) ExerciseDeltaResponse {
+	delta := ExerciseDeltaResponse{
+		Sets:   b.Sets - a.Sets,
+		Reps:   b.Reps - a.Reps,
+		Volume: roundComparison(b.Volume - a.Volume),
+		E1RM:   roundComparison(b.E1RM - a.E1RM),
+	}
+	var topA, topB TopSetResponse
+	if a.TopSet != nil {
+		topA = *a.TopSet
+	}
+	if b.TopSet != nil {
+		topB = *b.TopSet
+	}
+	delta.TopSetWeight = roundComparison(topB.Weight - topA.Weight)
+	delta.TopSetReps = topB.Reps - topA.Reps
+	return delta
+}
+
+// nameSimilarity scores two exercise names from 0 to 1, ignoring case,
+// punctuation and word order, so "Bench Press (Barbell)" and "barbell bench
+// press" score 1.
+func nameSimilarity(a string, b string) float64 {
+	keyA := exerciseNameKey(a)
+	keyB := exerciseNameKey(b)
+	if keyA == "" || keyB == "" {
+		return 0
+	}
+	if keyA == keyB {
+		return 1
+	}
+
+	runesA := []rune(keyA)
+	runesB := []rune(keyB)
+	longest := max(len(runesA), len(runesB))
+	return 1 - float64(levenshtein(runesA, runesB))/float64(longest)
+}
+
+func exerciseNameKey(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
+
+func levenshtein(a []rune, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func roundComparison(value float64) float64 {
+	return math.Round(value*100) / 100
+}
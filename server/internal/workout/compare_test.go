@@ -0,0 +1,169 @@
+package workout
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func comparisonSet(exerciseID int32, name string, weight float64, reps int32, setType string) ComparisonSet {
+	return ComparisonSet{ExerciseID: exerciseID, ExerciseName: name, Weight: &weight, Reps: reps, SetType: setType}
+}
+
+func TestCompareWorkouts(t *testing.T) {
+	a := ComparisonWorkout{
+		ID:   1,
+		Date: time.Date(2026, 6, 1, 10, 0, 0, 0, time.UTC),
+		Sets: []ComparisonSet{
+			comparisonSet(10, "Bench Press", 95, 10, "warmup"),
+			comparisonSet(10, "Bench Press", 185, 5, "working"),
+			comparisonSet(10, "Bench Press", 185, 5, "working"),
+			comparisonSet(11, "Barbell Row", 135, 8, "working"),
+			comparisonSet(12, "Bench Press (Incline)", 135, 8, "working"),
+			comparisonSet(13, "Face Pull", 40, 15, "working"),
+		},
+	}
+	b := ComparisonWorkout{
+		ID:   2,
+		Date: time.Date(2026, 6, 8, 10, 0, 0, 0, time.UTC),
+		Sets: []ComparisonSet{
+			comparisonSet(10, "Bench Press", 195, 5, "working"),
+			comparisonSet(10, "Bench Press", 185, 6, "working"),
+			comparisonSet(10, "Bench Press", 185, 5, "working"),
+			comparisonSet(21, "incline bench press", 145, 8, "working"),
+			comparisonSet(11, "Barbell Row", 135, 8, "working"),
+			comparisonSet(22, "Lateral Raise", 20, 12, "working"),
+		},
+	}
+
+	comparison := CompareWorkouts(a, b)
+
+	assert.Equal(t, int32(1), comparison.A.WorkoutID)
+	assert.Equal(t, int32(2), comparison.B.WorkoutID)
+	require.Len(t, comparison.Exercises, 3)
+
+	bench := comparison.Exercises[0]
+	assert.Equal(t, "Bench Press", bench.ExerciseName)
+	assert.Equal(t, matchedByExerciseID, bench.MatchedBy)
+	assert.Equal(t, 2, bench.A.Sets, "warm-up sets are excluded")
+	assert.Equal(t, 10, bench.A.Reps)
+	assert.Equal(t, &TopSetResponse{Weight: 185, Reps: 5}, bench.A.TopSet)
+	assert.Equal(t, &TopSetResponse{Weight: 195, Reps: 5}, bench.B.TopSet)
+	assert.Equal(t, ExerciseDeltaResponse{
+		Sets:         1,
+		Reps:         6,
+		TopSetWeight: 10,
+		TopSetReps:   0,
+		Volume:       3010 - 1850,
+		E1RM:         227.5 - 215.83,
+	}, bench.Delta)
+
+	assert.Equal(t, "Barbell Row", comparison.Exercises[1].ExerciseName)
+	assert.Equal(t, ExerciseDeltaResponse{}, comparison.Exercises[1].Delta)
+
+	incline := comparison.Exercises[2]
+	assert.Equal(t, matchedByName, incline.MatchedBy)
+	assert.Equal(t, int32(12), incline.A.ExerciseID)
+	assert.Equal(t, int32(21), incline.B.ExerciseID)
+	assert.Equal(t, 10.0, incline.Delta.TopSetWeight)
+
+	require.Len(t, comparison.OnlyInA, 1)
+	assert.Equal(t, "Face Pull", comparison.OnlyInA[0].ExerciseName)
+	require.Len(t, comparison.OnlyInB, 1)
+	assert.Equal(t, "Lateral Raise", comparison.OnlyInB[0].ExerciseName)
+}
+
+func TestNameSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, nameSimilarity("Bench Press (Barbell)", "barbell bench press"))
+	assert.GreaterOrEqual(t, nameSimilarity("Romanian Deadlift", "Romanian Deadlifts"), minNameSimilarity)
+	assert.Less(t, nameSimilarity("Back Squat", "Front Squat"), minNameSimilarity)
+	assert.Less(t, nameSimilarity("Deadlift", "Bench Press"), minNameSimilarity)
+}
+
+func TestWorkoutHandler_CompareWorkouts(t *testing.T) {
+	userID := "test-user-id"
+	ctx := context.WithValue(context.Background(), user.UserIDKey, userID)
+	rows := func(id int32, exerciseName string) []db.GetWorkoutWithSetsRow {
+		return []db.GetWorkoutWithSetsRow{{
+			WorkoutID:    id,
+			WorkoutDate:  pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			ExerciseID:   5,
+			ExerciseName: exerciseName,
+			Reps:         5,
+			SetType:      "working",
+		}}
+	}
+
+	tests := []struct {
+		name         string
+		query        string
+		setupMock    func(*MockWorkoutRepository)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:  "compares both workouts",
+			query: "a=1&b=2",
+			setupMock: func(m *MockWorkoutRepository) {
+				m.On("GetWorkoutWithSets", mock.Anything, int32(1), userID).Return(rows(1, "Squat"), nil)
+				m.On("GetWorkoutWithSets", mock.Anything, int32(2), userID).Return(rows(2, "Squat"), nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `"matchedBy":"exercise_id"`,
+		},
+		{
+			name:         "missing b",
+			query:        "a=1",
+			setupMock:    func(m *MockWorkoutRepository) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "Missing workout ID in b",
+		},
+		{
+			name:         "same workout twice",
+			query:        "a=3&b=3",
+			setupMock:    func(m *MockWorkoutRepository) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:  "unknown workout",
+			query: "a=1&b=999",
+			setupMock: func(m *MockWorkoutRepository) {
+				m.On("GetWorkoutWithSets", mock.Anything, int32(1), userID).Return(rows(1, "Squat"), nil)
+				m.On("GetWorkoutWithSets", mock.Anything, int32(999), userID).Return([]db.GetWorkoutWithSetsRow{}, nil)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockWorkoutRepository)
+			tt.setupMock(mockRepo)
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			handler := NewHandler(logger, validator.New(), &WorkoutService{repo: mockRepo, logger: logger})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/workouts/compare?"+tt.query, nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.CompareWorkouts(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
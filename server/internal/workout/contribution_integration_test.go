@@ -35,7 +35,7 @@ func TestContributionData_Integration_RLS(t *testing.T) {
 
 	// Initialize repositories
 	exerciseRepo := exercise.NewRepository(logger, queries, pool)
-	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo)
+	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo, nil, nil)
 	workoutService := NewService(logger, workoutRepo)
 	handler := NewHandler(logger, validator, workoutService)
 
@@ -146,7 +146,7 @@ func TestContributionData_Integration(t *testing.T) {
 
 	// Initialize repositories
 	exerciseRepo := exercise.NewRepository(logger, queries, pool)
-	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo)
+	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo, nil, nil)
 	workoutService := NewService(logger, workoutRepo)
 	handler := NewHandler(logger, validator, workoutService)
 
@@ -36,7 +36,7 @@ func TestWorkoutHandler_GetContributionData(t *testing.T) {
 				yesterday := now.AddDate(0, 0, -1)
 				focusValue := "Strength"
 
-				m.On("GetContributionData", mock.Anything, userID).Return([]db.GetContributionDataRow{
+				m.On("GetContributionData", mock.Anything, userID, TagFilter{}).Return([]db.GetContributionDataRow{
 					{
 						Date:     pgtype.Date{Time: yesterday, Valid: true},
 						Count:    5,
@@ -57,7 +57,7 @@ func TestWorkoutHandler_GetContributionData(t *testing.T) {
 		{
 			name: "successful fetch with empty result",
 			setupMock: func(m *MockWorkoutRepository) {
-				m.On("GetContributionData", mock.Anything, userID).Return([]db.GetContributionDataRow{}, nil)
+				m.On("GetContributionData", mock.Anything, userID, TagFilter{}).Return([]db.GetContributionDataRow{}, nil)
 			},
 			ctx:          context.WithValue(context.Background(), user.UserIDKey, userID),
 			expectedCode: http.StatusOK,
@@ -67,7 +67,7 @@ func TestWorkoutHandler_GetContributionData(t *testing.T) {
 		{
 			name: "service error",
 			setupMock: func(m *MockWorkoutRepository) {
-				m.On("GetContributionData", mock.Anything, userID).Return([]db.GetContributionDataRow{}, assert.AnError)
+				m.On("GetContributionData", mock.Anything, userID, TagFilter{}).Return([]db.GetContributionDataRow{}, assert.AnError)
 			},
 			ctx:           context.WithValue(context.Background(), user.UserIDKey, userID),
 			expectedCode:  http.StatusInternalServerError,
@@ -40,7 +40,7 @@ func TestWorkoutDeletionIntegration(t *testing.T) {
 
 	// Initialize repositories
 	exerciseRepo := exercise.NewRepository(logger, queries, pool)
-	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo)
+	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo, nil, nil)
 	workoutService := NewService(logger, workoutRepo)
 	handler := NewHandler(logger, validator, workoutService)
 
@@ -77,7 +77,7 @@ func TestWorkoutDeletionIntegration(t *testing.T) {
 		assert.Equal(t, 0, setCount, "All sets should be deleted after workout deletion")
 
 		// Verify user's workout list is empty
-		workouts, err := workoutRepo.ListWorkouts(ctx, userID)
+		workouts, err := workoutRepo.ListWorkouts(ctx, userID, TagFilter{})
 		require.NoError(t, err)
 		assert.Empty(t, workouts, "User should have no workouts after deletion")
 	})
@@ -91,7 +91,7 @@ func TestWorkoutDeletionIntegration(t *testing.T) {
 		ctx = user.WithContext(ctx, userID)
 
 		// Verify workout exists before deletion
-		workouts, err := workoutService.ListWorkouts(ctx)
+		workouts, err := workoutService.ListWorkouts(ctx, TagFilter{})
 		require.NoError(t, err)
 		assert.Len(t, workouts, 1, "User should have one workout before deletion")
 		assert.Equal(t, workoutID, workouts[0].ID)
@@ -101,7 +101,7 @@ func TestWorkoutDeletionIntegration(t *testing.T) {
 		require.NoError(t, err, "Service deletion should succeed")
 
 		// Verify workout is deleted
-		workouts, err = workoutService.ListWorkouts(ctx)
+		workouts, err = workoutService.ListWorkouts(ctx, TagFilter{})
 		require.NoError(t, err)
 		assert.Empty(t, workouts, "User should have no workouts after deletion")
 	})
@@ -177,7 +177,7 @@ func TestWorkoutDeletionIntegration(t *testing.T) {
 		ctxA := testutils.SetTestUserContext(context.Background(), t, pool, userA)
 		ctxA = user.WithContext(ctxA, userA)
 
-		workoutsA, err := workoutService.ListWorkouts(ctxA)
+		workoutsA, err := workoutService.ListWorkouts(ctxA, TagFilter{})
 		require.NoError(t, err)
 		assert.Len(t, workoutsA, 1, "User A's workout should still exist")
 		assert.Equal(t, workoutAID, workoutsA[0].ID)
@@ -186,7 +186,7 @@ func TestWorkoutDeletionIntegration(t *testing.T) {
 		err = workoutService.DeleteWorkout(ctxB, workoutBID)
 		require.NoError(t, err, "User B should be able to delete their own workout")
 
-		workoutsB, err := workoutService.ListWorkouts(ctxB)
+		workoutsB, err := workoutService.ListWorkouts(ctxB, TagFilter{})
 		require.NoError(t, err)
 		assert.Empty(t, workoutsB, "User B should have no workouts after deletion")
 	})
@@ -263,7 +263,7 @@ func TestWorkoutDeletionRLSSecurity(t *testing.T) {
 		validator := validator.New()
 		queries := db.New(pool)
 		exerciseRepo := exercise.NewRepository(logger, queries, pool)
-		workoutRepo := NewRepository(logger, queries, pool, exerciseRepo)
+		workoutRepo := NewRepository(logger, queries, pool, exerciseRepo, nil, nil)
 		workoutService := NewService(logger, workoutRepo)
 		handler := NewHandler(logger, validator, workoutService)
 
@@ -28,17 +28,19 @@ func NewHandler(logger *slog.Logger, validator *validator.Validate, workoutServi
 // MARK: ListWorkouts
 // ListWorkouts godoc
 // @Summary List workouts
-// @Description Get all workouts for the authenticated user
+// @Description Get all workouts for the authenticated user, optionally filtered by tag
 // @Tags workouts
 // @Accept json
 // @Produce json
 // @Security StackAuth
+// @Param tag query []string false "Only workouts carrying every listed tag (repeatable or comma-separated)" collectionFormat(multi)
+// @Param excludeTag query []string false "Skip workouts carrying any listed tag (repeatable or comma-separated)" collectionFormat(multi)
 // @Success 200 {array} workout.WorkoutResponse
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
 // @Failure 500 {object} response.ErrorResponse "Internal Server Error"
 // @Router /workouts [get]
 func (h *WorkoutHandler) ListWorkouts(w http.ResponseWriter, r *http.Request) {
-	workouts, err := h.workoutService.ListWorkouts(r.Context())
+	workouts, err := h.workoutService.ListWorkouts(r.Context(), decodeTagFilter(r))
 	if err != nil {
 		var errUnauthorized *apperrors.Unauthorized
 		if errors.As(err, &errUnauthorized) {
@@ -128,12 +130,14 @@ func (h *WorkoutHandler) GetNewWorkoutContext(w http.ResponseWriter, r *http.Req
 // @Accept json
 // @Produce json
 // @Security StackAuth
+// @Param tag query []string false "Only workouts carrying every listed tag (repeatable or comma-separated)" collectionFormat(multi)
+// @Param excludeTag query []string false "Skip workouts carrying any listed tag (repeatable or comma-separated)" collectionFormat(multi)
 // @Success 200 {object} workout.ContributionDataResponse
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
 // @Failure 500 {object} response.ErrorResponse "Internal Server Error"
 // @Router /workouts/contribution-data [get]
 func (h *WorkoutHandler) GetContributionData(w http.ResponseWriter, r *http.Request) {
-	contributionData, err := h.workoutService.GetContributionData(r.Context())
+	contributionData, err := h.workoutService.GetContributionData(r.Context(), decodeTagFilter(r))
 	if err != nil {
 		var errUnauthorized *apperrors.Unauthorized
 		if errors.As(err, &errUnauthorized) {
@@ -187,6 +191,57 @@ func (h *WorkoutHandler) GetWorkoutWithSets(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+// MARK: CompareWorkouts
+// CompareWorkouts godoc
+// @Summary Compare two workouts
+// @Description Lines up the exercises of two workouts, matching by exercise and then by similar name, and returns the change in working sets, reps, top set, volume and estimated 1RM from workout a to workout b, plus the exercises only one of them contains.
+// @Tags workouts
+// @Produce json
+// @Security StackAuth
+// @Param a query int true "Baseline workout ID"
+// @Param b query int true "Workout ID to compare against the baseline"
+// @Success 200 {object} workout.WorkoutComparisonResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /workouts/compare [get]
+func (h *WorkoutHandler) CompareWorkouts(w http.ResponseWriter, r *http.Request) {
+	a, ok := h.decodeWorkoutIDQuery(w, r, "a")
+	if !ok {
+		return
+	}
+	b, ok := h.decodeWorkoutIDQuery(w, r, "b")
+	if !ok {
+		return
+	}
+	if a == b {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "a and b must be different workouts", nil)
+		return
+	}
+
+	comparison, err := h.workoutService.CompareWorkouts(r.Context(), a, b)
+	if err != nil {
+		var errUnauthorized *apperrors.Unauthorized
+		var errNotFound *apperrors.NotFound
+
+		switch {
+		case errors.As(err, &errUnauthorized):
+			response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+		case errors.As(err, &errNotFound):
+			response.ErrorJSON(w, r, h.logger, http.StatusNotFound, errNotFound.Error(), nil)
+		default:
+			response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to compare workouts", err)
+		}
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, comparison); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+		return
+	}
+}
+
 // MARK: CreateWorkout
 // CreateWorkout godoc
 // @Summary Create a new workout
@@ -229,6 +284,49 @@ func (h *WorkoutHandler) CreateWorkout(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// MARK: GenerateWarmup
+// GenerateWarmup godoc
+// @Summary Generate warm-up sets
+// @Description Build warm-up sets leading to a working weight. Loads are rounded to the user's equipment, using the given bar or the primary bar for barbell lifts. Steps override the named scheme; the standard scheme is used when neither is given.
+// @Tags tools
+// @Accept json
+// @Produce json
+// @Security StackAuth
+// @Param request body workout.WarmupRequest true "Working set and warm-up scheme"
+// @Success 200 {object} workout.WarmupResponse
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /tools/warmup [post]
+func (h *WorkoutHandler) GenerateWarmup(w http.ResponseWriter, r *http.Request) {
+	var req WarmupRequest
+	if err := decodeStrictJSON(w, r, &req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "failed to decode request body", err)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "validation error occurred", err)
+		return
+	}
+
+	warmup, err := h.workoutService.GenerateWarmup(r.Context(), req)
+	if err != nil {
+		var errUnauthorized *apperrors.Unauthorized
+		if errors.As(err, &errUnauthorized) {
+			response.ErrorJSON(w, r, h.logger, http.StatusUnauthorized, errUnauthorized.Error(), nil)
+		} else {
+			response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to generate warm-up sets", err)
+		}
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, warmup); err != nil {
+		response.ErrorJSON(w, r, h.logger, http.StatusInternalServerError, "failed to write response", err)
+		return
+	}
+}
+
 // MARK: UpdateWorkout
 // UpdateWorkout godoc
 // @Summary Update an existing workout (full replacement)
@@ -18,7 +18,7 @@ import (
 func BenchmarkWorkoutHandler_ListWorkouts(b *testing.B) {
 	userID := "test-user-id"
 	mockRepo := &MockWorkoutRepository{}
-	mockRepo.On("ListWorkouts", mock.Anything, userID).Return([]db.Workout{
+	mockRepo.On("ListWorkouts", mock.Anything, userID, TagFilter{}).Return([]db.Workout{
 		{ID: 1, Date: pgtype.Timestamptz{Time: time.Now(), Valid: true}},
 	}, nil)
 
@@ -10,9 +10,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/analytics"
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	"github.com/Andrewy-gh/fittrack/server/internal/user"
 	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -113,6 +115,15 @@ func TestWorkoutHandler_ListWorkoutFocusValues(t *testing.T) {
 	}
 }
 
+type stubPlateauDetector struct {
+	plateaus []analytics.ExercisePlateau
+	err      error
+}
+
+func (s stubPlateauDetector) FlaggedPlateaus(context.Context) ([]analytics.ExercisePlateau, error) {
+	return s.plateaus, s.err
+}
+
 func TestWorkoutHandler_GetNewWorkoutContext(t *testing.T) {
 	userID := "test-user-id"
 	templateDate := time.Date(2026, 5, 1, 14, 0, 0, 0, time.UTC)
@@ -121,6 +132,7 @@ func TestWorkoutHandler_GetNewWorkoutContext(t *testing.T) {
 	tests := []struct {
 		name          string
 		setupMock     func(*MockWorkoutRepository)
+		detector      plateauDetector
 		ctx           context.Context
 		expectedCode  int
 		expectedError string
@@ -156,6 +168,46 @@ func TestWorkoutHandler_GetNewWorkoutContext(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "includes plateaued exercises",
+			setupMock: func(m *MockWorkoutRepository) {
+				m.On("ListWorkoutFocusTemplates", mock.Anything, userID).Return([]db.ListWorkoutFocusTemplatesRow{}, nil)
+				m.On("GetLatestWorkoutNote", mock.Anything, userID).Return(db.GetLatestWorkoutNoteRow{}, pgx.ErrNoRows)
+			},
+			detector: stubPlateauDetector{plateaus: []analytics.ExercisePlateau{{
+				ExerciseID:   3,
+				ExerciseName: "Bench Press",
+				Status:       analytics.PlateauStatusStalled,
+				Interventions: []analytics.PlateauIntervention{
+					{Type: analytics.InterventionRepRangeChange, Message: "Run a block of 6-8 reps."},
+					{Type: analytics.InterventionVariationSwap, Message: "Swap in a paused bench."},
+				},
+			}}},
+			ctx:          context.WithValue(context.Background(), user.UserIDKey, userID),
+			expectedCode: http.StatusOK,
+			assertBody: func(t *testing.T, result NewWorkoutContextResponse) {
+				assert.Equal(t, []PlateauedExerciseResponse{{
+					ExerciseID:   3,
+					ExerciseName: "Bench Press",
+					Status:       analytics.PlateauStatusStalled,
+					Suggestion:   "Run a block of 6-8 reps.",
+				}}, result.PlateauedExercises)
+			},
+		},
+		{
+			name: "omits plateaus when detection fails",
+			setupMock: func(m *MockWorkoutRepository) {
+				m.On("ListWorkoutFocusTemplates", mock.Anything, userID).Return([]db.ListWorkoutFocusTemplatesRow{}, nil)
+				m.On("GetLatestWorkoutNote", mock.Anything, userID).Return(db.GetLatestWorkoutNoteRow{}, pgx.ErrNoRows)
+			},
+			detector:     stubPlateauDetector{err: assert.AnError},
+			ctx:          context.WithValue(context.Background(), user.UserIDKey, userID),
+			expectedCode: http.StatusOK,
+			assertBody: func(t *testing.T, result NewWorkoutContextResponse) {
+				assert.Empty(t, result.FocusTemplates)
+				assert.Nil(t, result.PlateauedExercises)
+			},
+		},
 		{
 			name:          "unauthenticated user",
 			setupMock:     func(m *MockWorkoutRepository) {},
@@ -172,8 +224,9 @@ func TestWorkoutHandler_GetNewWorkoutContext(t *testing.T) {
 
 			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 			service := &WorkoutService{
-				repo:   mockRepo,
-				logger: logger,
+				repo:     mockRepo,
+				logger:   logger,
+				plateaus: tt.detector,
 			}
 			handler := NewHandler(logger, validator.New(), service)
 
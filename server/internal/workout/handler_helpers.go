@@ -7,6 +7,7 @@ import (
 
 	"github.com/Andrewy-gh/fittrack/server/internal/request"
 	"github.com/Andrewy-gh/fittrack/server/internal/response"
+	"github.com/Andrewy-gh/fittrack/server/internal/tag"
 )
 
 const maxWorkoutJSONBodyBytes = 256 << 10
@@ -27,6 +28,32 @@ func (h *WorkoutHandler) decodeWorkoutID(w http.ResponseWriter, r *http.Request)
 	return int32(parsed), true
 }
 
+func (h *WorkoutHandler) decodeWorkoutIDQuery(w http.ResponseWriter, r *http.Request, name string) (int32, bool) {
+	raw := strings.TrimSpace(r.URL.Query().Get(name))
+	if raw == "" {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Missing workout ID in "+name, nil)
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || parsed <= 0 {
+		response.ErrorJSON(w, r, h.logger, http.StatusBadRequest, "Invalid workout ID in "+name, err)
+		return 0, false
+	}
+
+	return int32(parsed), true
+}
+
+// decodeTagFilter reads the tag and excludeTag query parameters. Values may
+// be repeated or comma-separated and are normalized like stored tags.
+func decodeTagFilter(r *http.Request) TagFilter {
+	query := r.URL.Query()
+	return TagFilter{
+		Tags:        tag.ParseQuery(query["tag"]),
+		ExcludeTags: tag.ParseQuery(query["excludeTag"]),
+	}
+}
+
 func decodeStrictJSON(w http.ResponseWriter, r *http.Request, dst any) error {
 	return request.DecodeStrictJSON(w, r, dst, maxWorkoutJSONBodyBytes)
 }
@@ -33,7 +33,7 @@ func TestWorkoutHandler_Integration_ListWorkouts_RLS(t *testing.T) {
 
 	// Initialize repositories
 	exerciseRepo := exercise.NewRepository(logger, queries, pool)
-	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo)
+	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo, nil, nil)
 	workoutService := NewService(logger, workoutRepo)
 	handler := NewHandler(logger, validator, workoutService)
 
@@ -34,7 +34,7 @@ func TestWorkoutHandler_ListWorkouts(t *testing.T) {
 		{
 			name: "successful fetch",
 			setupMock: func(m *MockWorkoutRepository) {
-				m.On("ListWorkouts", mock.Anything, userID).Return([]db.Workout{
+				m.On("ListWorkouts", mock.Anything, userID, TagFilter{}).Return([]db.Workout{
 					{
 						ID: 1,
 						Date: pgtype.Timestamptz{
@@ -52,7 +52,7 @@ func TestWorkoutHandler_ListWorkouts(t *testing.T) {
 		{
 			name: "internal server error",
 			setupMock: func(m *MockWorkoutRepository) {
-				m.On("ListWorkouts", mock.Anything, userID).Return([]db.Workout{}, assert.AnError)
+				m.On("ListWorkouts", mock.Anything, userID, TagFilter{}).Return([]db.Workout{}, assert.AnError)
 			},
 			ctx:           context.WithValue(context.Background(), user.UserIDKey, userID),
 			expectedCode:  http.StatusInternalServerError,
@@ -110,6 +110,81 @@ func TestWorkoutHandler_ListWorkouts(t *testing.T) {
 	}
 }
 
+func TestWorkoutHandler_ListWorkouts_TagFilter(t *testing.T) {
+	userID := "test-user-id"
+	mockRepo := &MockWorkoutRepository{}
+	mockRepo.On("ListWorkouts", mock.Anything, userID, TagFilter{
+		Tags:        []string{"comp prep", "travel"},
+		ExcludeTags: []string{"deload"},
+	}).Return([]db.Workout{}, nil)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handler := NewHandler(logger, validator.New(), &WorkoutService{repo: mockRepo, logger: logger})
+
+	ctx := context.WithValue(context.Background(), user.UserIDKey, userID)
+	req := httptest.NewRequest("GET", "/api/workouts?tag=Comp+Prep,travel&tag=TRAVEL&excludeTag=deload", nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	handler.ListWorkouts(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransformWorkoutRequest_Tags(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	draft := workoutRequestDraft{Date: "2026-01-02T15:04:05Z"}
+
+	reformatted, err := transformWorkoutRequest(logger, draft)
+	assert.NoError(t, err)
+	assert.Nil(t, reformatted.Workout.Tags, "omitted tags leave existing tags unchanged")
+
+	draft.Tags = []string{" "}
+	reformatted, err = transformWorkoutRequest(logger, draft)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, reformatted.Workout.Tags, "an empty list clears tags")
+
+	draft.Tags = []string{"Deload", "deload ", "Sick"}
+	reformatted, err = transformWorkoutRequest(logger, draft)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"deload", "sick"}, reformatted.Workout.Tags)
+
+	request, err := toCreateWorkoutRequest(reformatted)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"deload", "sick"}, request.Tags)
+}
+
+func TestTransformWorkoutRequest_SetNotes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	beltOn, blank := " belt on ", "  "
+	draft := newUpdateWorkoutDraft(UpdateWorkoutRequest{
+		Date: "2026-01-02T15:04:05Z",
+		Exercises: []UpdateExercise{{
+			Name: "Bench Press",
+			Sets: []UpdateSet{
+				{Reps: 5, SetType: "working", Notes: &beltOn},
+				{Reps: 5, SetType: "working", Notes: &blank},
+				{Reps: 5, SetType: "working"},
+			},
+		}},
+	})
+
+	reformatted, err := transformWorkoutRequest(logger, draft)
+	assert.NoError(t, err)
+
+	pgData, err := convertToPGTypes(reformatted)
+	assert.NoError(t, err)
+	assert.Equal(t, pgtype.Text{String: "belt on", Valid: true}, pgData.Sets[0].Notes)
+	assert.False(t, pgData.Sets[1].Notes.Valid, "blank notes are stored as NULL")
+	assert.False(t, pgData.Sets[2].Notes.Valid)
+
+	request, err := toCreateWorkoutRequest(reformatted)
+	assert.NoError(t, err)
+	sets := request.Exercises[0].Sets
+	assert.Equal(t, " belt on ", *sets[0].Notes)
+	assert.Nil(t, sets[2].Notes)
+}
+
 func TestWorkoutHandler_GetWorkoutWithSets(t *testing.T) {
 	userID := "test-user-id"
 
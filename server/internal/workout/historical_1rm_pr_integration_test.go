@@ -26,7 +26,7 @@ func TestWorkout_Historical1RM_PR_Lifecycle_Integration(t *testing.T) {
 	queries := db.New(pool)
 
 	exerciseRepo := exercise.NewRepository(logger, queries, pool)
-	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo)
+	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo, nil, nil)
 	workoutService := NewService(logger, workoutRepo)
 
 	userID := "test-user-a"
@@ -9,9 +9,12 @@ import (
 // Request/Response types
 type CreateWorkoutRequest struct {
 	Date         string          `json:"date" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
-	Notes        *string         `json:"notes,omitempty" validate:"omitempty,max=256"`
+	Notes        *string         `json:"notes,omitempty" validate:"omitempty,max=10000"`
 	WorkoutFocus *string         `json:"workoutFocus,omitempty" validate:"omitempty,max=256"`
+	Tags         []string        `json:"tags,omitempty" validate:"omitempty,max=10,dive,max=64"`
 	Exercises    []ExerciseInput `json:"exercises" validate:"required,min=1,dive"`
+	// WarmupScheme adds a warm-up ramp to each exercise that has none.
+	WarmupScheme string `json:"warmupScheme,omitempty" validate:"omitempty,oneof=standard quick powerlifting"`
 }
 
 type ExerciseInput struct {
@@ -23,6 +26,7 @@ type SetInput struct {
 	Weight  *float64 `json:"weight,omitempty" validate:"omitempty,gte=0,lte=999999999.9"`
 	Reps    int      `json:"reps" validate:"required,gte=1"`
 	SetType string   `json:"setType" validate:"required,oneof=warmup working"`
+	Notes   *string  `json:"notes,omitempty" validate:"omitempty,max=500"`
 }
 
 type UpdateExercise struct {
@@ -34,6 +38,7 @@ type UpdateSet struct {
 	Weight  *float64 `json:"weight,omitempty" validate:"omitempty,gte=0,lte=999999999.9"`
 	Reps    int      `json:"reps" validate:"required,gte=1"`
 	SetType string   `json:"setType" validate:"required,oneof=warmup working"`
+	Notes   *string  `json:"notes,omitempty" validate:"omitempty,max=500"`
 }
 
 type exerciseRequestDraft struct {
@@ -45,12 +50,14 @@ type setRequestDraft struct {
 	Weight  *float64
 	Reps    int
 	SetType string
+	Notes   *string
 }
 
 type workoutRequestDraft struct {
 	Date         string
 	Notes        *string
 	WorkoutFocus *string
+	Tags         []string
 	Exercises    []exerciseRequestDraft
 }
 
@@ -73,6 +80,7 @@ type PGSetData struct {
 	SetType       string
 	ExerciseOrder int32
 	SetOrder      int32
+	Notes         pgtype.Text
 }
 
 type PGReformattedRequest struct {
@@ -87,6 +95,9 @@ type WorkoutData struct {
 	Date         time.Time
 	Notes        *string
 	WorkoutFocus *string
+	// Tags are normalized tag names. Nil leaves an existing workout's tags
+	// unchanged; an empty slice clears them.
+	Tags []string
 }
 
 type ExerciseData struct {
@@ -98,6 +109,7 @@ type SetData struct {
 	Weight       *float64
 	Reps         int
 	SetType      string
+	Notes        *string
 }
 type ReformattedRequest struct {
 	Workout   WorkoutData
@@ -107,19 +119,29 @@ type ReformattedRequest struct {
 
 // UPDATE endpoint types for PUT /api/workouts/{id}
 // Returns 204 No Content on success
+// Tags replaces the workout's tags when present; omit it to keep them.
 type UpdateWorkoutRequest struct {
 	Date         string           `json:"date" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
-	Notes        *string          `json:"notes,omitempty" validate:"omitempty,max=256"`
+	Notes        *string          `json:"notes,omitempty" validate:"omitempty,max=10000"`
 	WorkoutFocus *string          `json:"workoutFocus,omitempty" validate:"omitempty,max=256"`
+	Tags         []string         `json:"tags,omitempty" validate:"omitempty,max=10,dive,max=64"`
 	Exercises    []UpdateExercise `json:"exercises" validate:"required,min=1,dive"`
 }
 
+// TagFilter narrows workout lists by tag. A workout must carry every tag in
+// Tags and none in ExcludeTags; empty fields do not filter.
+type TagFilter struct {
+	Tags        []string
+	ExcludeTags []string
+}
+
 // Contribution Graph types for GET /api/workouts/contribution-data
 type WorkoutSummary struct {
-	ID     int32   `json:"id"`
-	Time   string  `json:"time"`
-	Focus  *string `json:"focus"`
-	Volume float64 `json:"volume"`
+	ID     int32    `json:"id"`
+	Time   string   `json:"time"`
+	Focus  *string  `json:"focus"`
+	Volume float64  `json:"volume"`
+	Tags   []string `json:"tags,omitempty"`
 }
 
 type ContributionDay struct {
@@ -146,7 +168,109 @@ type LatestWorkoutNoteResponse struct {
 	Note      string    `json:"note" validate:"required" example:"Great workout today"`
 }
 
+// PlateauedExerciseResponse flags a lift that has stalled or regressed, with
+// the top suggested intervention.
+type PlateauedExerciseResponse struct {
+	ExerciseID   int32  `json:"exerciseId" validate:"required" example:"1"`
+	ExerciseName string `json:"exerciseName" validate:"required" example:"Bench Press"`
+	Status       string `json:"status" validate:"required" example:"stalled"`
+	Suggestion   string `json:"suggestion,omitempty" example:"Change the rep range for a 3-4 week block."`
+}
+
 type NewWorkoutContextResponse struct {
-	FocusTemplates    []FocusTemplateResponse    `json:"focusTemplates"`
-	LatestWorkoutNote *LatestWorkoutNoteResponse `json:"latestWorkoutNote,omitempty"`
+	FocusTemplates     []FocusTemplateResponse     `json:"focusTemplates"`
+	LatestWorkoutNote  *LatestWorkoutNoteResponse  `json:"latestWorkoutNote,omitempty"`
+	PlateauedExercises []PlateauedExerciseResponse `json:"plateauedExercises,omitempty"`
+}
+
+// WorkoutComparisonResponse lines up the exercises of two workouts for
+// GET /api/workouts/compare. Deltas are B minus A.
+type WorkoutComparisonResponse struct {
+	A         ComparedWorkoutResponse      `json:"a" validate:"required"`
+	B         ComparedWorkoutResponse      `json:"b" validate:"required"`
+	Exercises []ExerciseComparisonResponse `json:"exercises" validate:"required"`
+	OnlyInA   []ExerciseSessionResponse    `json:"onlyInA" validate:"required"`
+	OnlyInB   []ExerciseSessionResponse    `json:"onlyInB" validate:"required"`
+}
+
+type ComparedWorkoutResponse struct {
+	WorkoutID int32     `json:"workoutId" validate:"required" example:"1"`
+	Date      time.Time `json:"date" validate:"required" example:"2023-01-01T15:04:05Z"`
+	Focus     *string   `json:"focus,omitempty" example:"Upper Body"`
+}
+
+// ExerciseComparisonResponse pairs an exercise present in both workouts.
+// MatchedBy is exercise_id when both sessions logged the same exercise, or
+// name when differently named exercises were paired by similarity.
+type ExerciseComparisonResponse struct {
+	ExerciseName string                  `json:"exerciseName" validate:"required" example:"Bench Press"`
+	MatchedBy    string                  `json:"matchedBy" validate:"required" example:"exercise_id"`
+	A            ExerciseSessionResponse `json:"a" validate:"required"`
+	B            ExerciseSessionResponse `json:"b" validate:"required"`
+	Delta        ExerciseDeltaResponse   `json:"delta" validate:"required"`
+}
+
+// ExerciseSessionResponse summarizes the working sets of one exercise in one
+// workout. Warm-up sets are left out.
+type ExerciseSessionResponse struct {
+	ExerciseID   int32           `json:"exerciseId" validate:"required" example:"1"`
+	ExerciseName string          `json:"exerciseName" validate:"required" example:"Bench Press"`
+	Sets         int             `json:"sets" validate:"required" example:"3"`
+	Reps         int             `json:"reps" validate:"required" example:"24"`
+	TopSet       *TopSetResponse `json:"topSet,omitempty"`
+	Volume       float64         `json:"volume" validate:"required" example:"4800"`
+	E1RM         float64         `json:"e1rm" validate:"required" example:"246.67"`
+}
+
+// TopSetResponse is the heaviest working set, with more reps breaking ties.
+type TopSetResponse struct {
+	Weight float64 `json:"weight" validate:"required" example:"225"`
+	Reps   int32   `json:"reps" validate:"required" example:"5"`
+}
+
+type ExerciseDeltaResponse struct {
+	Sets         int     `json:"sets" example:"1"`
+	Reps         int     `json:"reps" example:"5"`
+	TopSetWeight float64 `json:"topSetWeight" example:"10"`
+	TopSetReps   int32   `json:"topSetReps" example:"0"`
+	Volume       float64 `json:"volume" example:"650"`
+	E1RM         float64 `json:"e1rm" example:"11.67"`
+}
+
+// ComparisonWorkout is the input to CompareWorkouts: one workout and its
+// logged sets in exercise order.
+type ComparisonWorkout struct {
+	ID    int32
+	Date  time.Time
+	Focus *string
+	Sets  []ComparisonSet
+}
+
+type ComparisonSet struct {
+	ExerciseID   int32
+	ExerciseName string
+	Weight       *float64
+	Reps         int32
+	SetType      string
+}
+
+// WarmupRequest asks POST /api/tools/warmup for a ramp up to a working set.
+// Steps replace the named Scheme when present. BarWeight defaults to the
+// user's primary bar.
+type WarmupRequest struct {
+	Exercise      string       `json:"exercise" validate:"required,min=1,max=256" example:"Back Squat"`
+	WorkingWeight float64      `json:"workingWeight" validate:"gt=0,lte=2000" example:"225"`
+	WorkingReps   int          `json:"workingReps" validate:"required,gte=1,lte=100" example:"5"`
+	BarWeight     *float64     `json:"barWeight,omitempty" validate:"omitempty,gt=0,lte=100" example:"45"`
+	Scheme        string       `json:"scheme,omitempty" validate:"omitempty,oneof=standard quick powerlifting" example:"standard"`
+	Steps         []WarmupStep `json:"steps,omitempty" validate:"omitempty,max=10,dive"`
+}
+
+// WarmupResponse lists the warm-up sets to do before the working set. Scheme
+// is custom when the request supplied its own steps.
+type WarmupResponse struct {
+	Exercise string     `json:"exercise" example:"Back Squat"`
+	Scheme   string     `json:"scheme" example:"standard"`
+	LoadKind string     `json:"loadKind" example:"barbell"`
+	Sets     []SetInput `json:"sets"`
 }
@@ -8,6 +8,8 @@ import (
 
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	"github.com/Andrewy-gh/fittrack/server/internal/exercise"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
+	"github.com/Andrewy-gh/fittrack/server/internal/webhooks"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -18,24 +20,34 @@ type workoutRepository struct {
 	conn         *pgxpool.Pool
 	exerciseRepo exercise.ExerciseRepository
 	txSaver      TxSaver
+	notifier     notifications.Producer
+	events       webhooks.Publisher
 }
 
-func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool, exerciseRepo exercise.ExerciseRepository) WorkoutRepository {
+// NewRepository returns a WorkoutRepository. notifier and events may be nil,
+// in which case writes queue no notifications or webhook events.
+func NewRepository(logger *slog.Logger, queries *db.Queries, conn *pgxpool.Pool, exerciseRepo exercise.ExerciseRepository, notifier notifications.Producer, events webhooks.Publisher) WorkoutRepository {
 	return &workoutRepository{
 		logger:       logger,
 		queries:      queries,
 		conn:         conn,
 		exerciseRepo: exerciseRepo,
-		txSaver:      NewTxSaver(logger, exerciseRepo),
+		txSaver:      NewTxSaver(logger, exerciseRepo, notifier, events),
+		notifier:     notifier,
+		events:       events,
 	}
 }
 
 // MARK: ListWorkouts
-func (wr *workoutRepository) ListWorkouts(ctx context.Context, userId string) ([]db.Workout, error) {
+func (wr *workoutRepository) ListWorkouts(ctx context.Context, userId string, filter TagFilter) ([]db.Workout, error) {
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	workoutRows, err := wr.queries.ListWorkouts(ctx, userId)
+	workoutRows, err := wr.queries.ListWorkouts(ctx, db.ListWorkoutsParams{
+		UserID:      userId,
+		Tags:        filter.Tags,
+		ExcludeTags: filter.ExcludeTags,
+	})
 	if err != nil {
 		// Check if this might be an RLS-related error
 		if db.IsRowLevelSecurityError(err) {
@@ -295,6 +307,13 @@ func (wr *workoutRepository) UpdateWorkout(ctx context.Context, id int32, reform
 		"workout_id", id,
 		"user_id", userID)
 
+	if reformatted.Workout.Tags != nil {
+		if err := setWorkoutTags(ctx, qtx, id, reformatted.Workout.Tags, userID); err != nil {
+			wr.logger.Error("failed to update workout tags", "error", err, "workout_id", id)
+			return fmt.Errorf("failed to update workout tags: %w", err)
+		}
+	}
+
 	// Step 2: Handle exercise/set updates (replace strategy - delete all and recreate)
 	if len(reformatted.Exercises) > 0 {
 		wr.logger.Info("processing exercise/set updates",
@@ -342,6 +361,11 @@ func (wr *workoutRepository) UpdateWorkout(ctx context.Context, id int32, reform
 			"sets_created", len(pgData.Sets))
 	}
 
+	if err := publishWorkoutEvent(ctx, qtx, wr.events, webhooks.EventWorkoutUpdated, id, userID); err != nil {
+		wr.logger.Error("failed to queue workout updated webhook", "error", err, "workout_id", id)
+		return fmt.Errorf("failed to queue workout updated webhook: %w", err)
+	}
+
 	// Commit the transaction
 	if err := tx.Commit(ctx); err != nil {
 		wr.logger.Error("failed to commit update transaction", "error", err)
@@ -410,6 +434,11 @@ func (wr *workoutRepository) DeleteWorkout(ctx context.Context, id int32, userID
 		return fmt.Errorf("failed to delete workout (id: %d): %w", id, err)
 	}
 
+	if err := publishWorkoutEvent(ctx, qtx, wr.events, webhooks.EventWorkoutDeleted, id, userID); err != nil {
+		wr.logger.Error("failed to queue workout deleted webhook", "error", err, "workout_id", id)
+		return fmt.Errorf("failed to queue workout deleted webhook: %w", err)
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		wr.logger.Error("failed to commit delete transaction", "error", err)
 		return fmt.Errorf("failed to commit delete transaction: %w", err)
@@ -52,11 +52,15 @@ func (wr *workoutRepository) ListWorkoutFocusValues(ctx context.Context, userID
 }
 
 // MARK: GetContributionData
-func (wr *workoutRepository) GetContributionData(ctx context.Context, userID string) ([]db.GetContributionDataRow, error) {
+func (wr *workoutRepository) GetContributionData(ctx context.Context, userID string, filter TagFilter) ([]db.GetContributionDataRow, error) {
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	rows, err := wr.queries.GetContributionData(ctx, userID)
+	rows, err := wr.queries.GetContributionData(ctx, db.GetContributionDataParams{
+		UserID:      userID,
+		Tags:        filter.Tags,
+		ExcludeTags: filter.ExcludeTags,
+	})
 	if err != nil {
 		// Check if this might be an RLS-related error
 		if db.IsRowLevelSecurityError(err) {
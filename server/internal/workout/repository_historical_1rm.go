@@ -2,14 +2,21 @@ package workout
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-func updateHistorical1rmFromWorkout(ctx context.Context, qtx *db.Queries, workoutID int32, userID string) error {
+// updateHistorical1rmFromWorkout raises each exercise's historical 1RM to the
+// workout's best e1RM where that is higher. When notifier is set, raising an
+// existing value (not setting the first one) notifies the user in the same
+// transaction.
+func updateHistorical1rmFromWorkout(ctx context.Context, qtx *db.Queries, workoutID int32, userID string, notifier notifications.Producer) error {
 	rows, err := qtx.GetWorkoutBestE1rmByExercise(ctx, db.GetWorkoutBestE1rmByExerciseParams{
 		WorkoutID: workoutID,
 		UserID:    userID,
@@ -19,7 +26,7 @@ func updateHistorical1rmFromWorkout(ctx context.Context, qtx *db.Queries, workou
 	}
 
 	for _, row := range rows {
-		if err := qtx.UpdateExerciseHistorical1RMFromWorkoutIfBetter(ctx, db.UpdateExerciseHistorical1RMFromWorkoutIfBetterParams{
+		updated, err := qtx.UpdateExerciseHistorical1RMFromWorkoutIfBetter(ctx, db.UpdateExerciseHistorical1RMFromWorkoutIfBetterParams{
 			ID:            row.ExerciseID,
 			Historical1rm: row.BestE1rm,
 			Historical1rmSourceWorkoutID: pgtype.Int4{
@@ -27,16 +34,55 @@ func updateHistorical1rmFromWorkout(ctx context.Context, qtx *db.Queries, workou
 				Valid: true,
 			},
 			UserID: userID,
-		}); err != nil {
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
 			return fmt.Errorf("update exercise historical 1rm from workout if better failed (exercise_id: %d): %w", row.ExerciseID, err)
 		}
+		if notifier == nil || !updated.PreviousHistorical1rm.Valid {
+			continue
+		}
+		if err := notifyHistorical1rmIncrease(ctx, qtx, notifier, userID, workoutID, row, updated); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func notifyHistorical1rmIncrease(ctx context.Context, qtx *db.Queries, notifier notifications.Producer, userID string, workoutID int32, best db.GetWorkoutBestE1rmByExerciseRow, updated db.UpdateExerciseHistorical1RMFromWorkoutIfBetterRow) error {
+	current, err := best.BestE1rm.Float64Value()
+	if err != nil {
+		return fmt.Errorf("failed to convert numeric to float64: %w", err)
+	}
+	previous, err := updated.PreviousHistorical1rm.Float64Value()
+	if err != nil {
+		return fmt.Errorf("failed to convert numeric to float64: %w", err)
 	}
 
+	currentText := strconv.FormatFloat(current.Float64, 'f', -1, 64)
+	previousText := strconv.FormatFloat(previous.Float64, 'f', -1, 64)
+	if err := notifier.Notify(ctx, qtx, notifications.Notification{
+		UserID: userID,
+		Kind:   notifications.KindHistorical1RM,
+		Title:  fmt.Sprintf("New %s 1RM", updated.Name),
+		Body:   fmt.Sprintf("Estimated 1RM is now %s, up from %s.", currentText, previousText),
+		Data: map[string]any{
+			"exercise_id":             best.ExerciseID,
+			"workout_id":              workoutID,
+			"historical_1rm":          current.Float64,
+			"previous_historical_1rm": previous.Float64,
+		},
+	}); err != nil {
+		return fmt.Errorf("notify historical 1rm increase failed (exercise_id: %d): %w", best.ExerciseID, err)
+	}
 	return nil
 }
 
 func (wr *workoutRepository) updateHistorical1rmFromWorkout(ctx context.Context, qtx *db.Queries, workoutID int32, userID string) error {
-	return updateHistorical1rmFromWorkout(ctx, qtx, workoutID, userID)
+	return updateHistorical1rmFromWorkout(ctx, qtx, workoutID, userID, wr.notifier)
 }
 
 func (wr *workoutRepository) recomputeHistorical1rmForExercisesSourcedFromWorkout(ctx context.Context, qtx *db.Queries, workoutID int32, userID string) error {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	"github.com/Andrewy-gh/fittrack/server/internal/exercise"
@@ -80,6 +81,7 @@ func insertSets(ctx context.Context, logger *slog.Logger, qtx *db.Queries, sets
 			UserID:        userID,
 			ExerciseOrder: set.ExerciseOrder,
 			SetOrder:      set.SetOrder,
+			Notes:         set.Notes,
 		})
 		if err != nil {
 			errMsg := fmt.Sprintf("failed to create set for exercise %s (ID: %d)", set.ExerciseName, exerciseID)
@@ -91,6 +93,34 @@ func insertSets(ctx context.Context, logger *slog.Logger, qtx *db.Queries, sets
 	return nil
 }
 
+// MARK: setWorkoutTags
+// setWorkoutTags replaces a workout's tags, creating any tag names the user
+// has not used before.
+func setWorkoutTags(ctx context.Context, qtx *db.Queries, workoutID int32, tags []string, userID string) error {
+	if err := qtx.DeleteWorkoutTagLinks(ctx, db.DeleteWorkoutTagLinksParams{
+		WorkoutID: workoutID,
+		UserID:    userID,
+	}); err != nil {
+		return fmt.Errorf("failed to clear workout tags: %w", err)
+	}
+
+	for _, name := range tags {
+		tagID, err := qtx.UpsertWorkoutTag(ctx, db.UpsertWorkoutTagParams{UserID: userID, Name: name})
+		if err != nil {
+			return fmt.Errorf("failed to save tag %q: %w", name, err)
+		}
+		if err := qtx.CreateWorkoutTagLink(ctx, db.CreateWorkoutTagLinkParams{
+			WorkoutID: workoutID,
+			TagID:     tagID,
+			UserID:    userID,
+		}); err != nil {
+			return fmt.Errorf("failed to link tag %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 // MARK: convertToPGTypes
 func convertToPGTypes(reformatted *ReformattedRequest) (*PGReformattedRequest, error) {
 	// Convert workout
@@ -156,6 +186,13 @@ func convertToPGTypes(reformatted *ReformattedRequest) (*PGReformattedRequest, e
 			SetOrder:      setOrderCounters[set.ExerciseName],
 		}
 
+		// Blank set notes are stored as NULL
+		if set.Notes != nil {
+			if notes := strings.TrimSpace(*set.Notes); notes != "" {
+				pgSet.Notes = pgtype.Text{String: notes, Valid: true}
+			}
+		}
+
 		if set.Weight != nil {
 			// Convert float64 to pgtype.Numeric with proper precision
 			if err := pgSet.Weight.Scan(fmt.Sprintf("%.1f", *set.Weight)); err != nil {
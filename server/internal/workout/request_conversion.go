@@ -16,6 +16,10 @@ func toCreateWorkoutRequest(reformatted *ReformattedRequest) (CreateWorkoutReque
 		request.WorkoutFocus = &workoutFocus
 	}
 
+	if reformatted.Workout.Tags != nil {
+		request.Tags = append([]string{}, reformatted.Workout.Tags...)
+	}
+
 	setsByExercise := make(map[string][]SetInput, len(reformatted.Exercises))
 	for _, set := range reformatted.Sets {
 		var weight *float64
@@ -23,10 +27,16 @@ func toCreateWorkoutRequest(reformatted *ReformattedRequest) (CreateWorkoutReque
 			value := *set.Weight
 			weight = &value
 		}
+		var notes *string
+		if set.Notes != nil {
+			value := *set.Notes
+			notes = &value
+		}
 		setsByExercise[set.ExerciseName] = append(setsByExercise[set.ExerciseName], SetInput{
 			Weight:  weight,
 			Reps:    set.Reps,
 			SetType: set.SetType,
+			Notes:   notes,
 		})
 	}
 
@@ -9,29 +9,53 @@ import (
 	"sort"
 	"time"
 
+	"github.com/Andrewy-gh/fittrack/server/internal/achievements"
+	"github.com/Andrewy-gh/fittrack/server/internal/analytics"
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
 	apperrors "github.com/Andrewy-gh/fittrack/server/internal/errors"
+	"github.com/Andrewy-gh/fittrack/server/internal/tag"
 	"github.com/Andrewy-gh/fittrack/server/internal/user"
 	"github.com/jackc/pgx/v5"
 )
 
 type WorkoutRepository interface {
-	ListWorkouts(ctx context.Context, userID string) ([]db.Workout, error)
+	ListWorkouts(ctx context.Context, userID string, filter TagFilter) ([]db.Workout, error)
 	ListWorkoutFocusTemplates(ctx context.Context, userID string) ([]db.ListWorkoutFocusTemplatesRow, error)
 	GetLatestWorkoutNote(ctx context.Context, userID string) (db.GetLatestWorkoutNoteRow, error)
 	GetWorkout(ctx context.Context, id int32, userID string) (db.Workout, error)
 	GetWorkoutWithSets(ctx context.Context, id int32, userID string) ([]db.GetWorkoutWithSetsRow, error)
 	ListWorkoutFocusValues(ctx context.Context, userID string) ([]string, error)
-	GetContributionData(ctx context.Context, userID string) ([]db.GetContributionDataRow, error)
+	GetContributionData(ctx context.Context, userID string, filter TagFilter) ([]db.GetContributionDataRow, error)
 	SaveWorkout(ctx context.Context, reformatted *ReformattedRequest, userID string) error
 	SaveWorkoutWithID(ctx context.Context, reformatted *ReformattedRequest, userID string) (int32, error)
 	UpdateWorkout(ctx context.Context, id int32, reformatted *ReformattedRequest, userID string) error
 	DeleteWorkout(ctx context.Context, id int32, userID string) error
 }
 
+// plateauDetector reports exercises whose e1RM trend has stalled or
+// regressed over the default analysis window.
+type plateauDetector interface {
+	FlaggedPlateaus(ctx context.Context) ([]analytics.ExercisePlateau, error)
+}
+
+// inventoryLoader reads the equipment warm-up weights are rounded to.
+type inventoryLoader interface {
+	GetInventory(ctx context.Context) (*equipment.InventoryResponse, error)
+}
+
+// achievementEvaluator unlocks achievements newly earned by the user's
+// logged workouts.
+type achievementEvaluator interface {
+	Evaluate(ctx context.Context) ([]achievements.AchievementResponse, error)
+}
+
 type WorkoutService struct {
-	logger *slog.Logger
-	repo   WorkoutRepository
+	logger       *slog.Logger
+	repo         WorkoutRepository
+	plateaus     plateauDetector
+	equipment    inventoryLoader
+	achievements achievementEvaluator
 }
 
 func NewService(logger *slog.Logger, repo WorkoutRepository) *WorkoutService {
@@ -41,12 +65,24 @@ func NewService(logger *slog.Logger, repo WorkoutRepository) *WorkoutService {
 	}
 }
 
-func (ws *WorkoutService) ListWorkouts(ctx context.Context) ([]db.Workout, error) {
+func (ws *WorkoutService) SetPlateauDetector(detector plateauDetector) {
+	ws.plateaus = detector
+}
+
+func (ws *WorkoutService) SetInventoryLoader(loader inventoryLoader) {
+	ws.equipment = loader
+}
+
+func (ws *WorkoutService) SetAchievementEvaluator(evaluator achievementEvaluator) {
+	ws.achievements = evaluator
+}
+
+func (ws *WorkoutService) ListWorkouts(ctx context.Context, filter TagFilter) ([]db.Workout, error) {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
 		return nil, &apperrors.Unauthorized{Resource: "workout", UserID: ""}
 	}
-	workouts, err := ws.repo.ListWorkouts(ctx, userID)
+	workouts, err := ws.repo.ListWorkouts(ctx, userID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list workouts: %w", err)
 	}
@@ -55,7 +91,7 @@ func (ws *WorkoutService) ListWorkouts(ctx context.Context) ([]db.Workout, error
 
 func (ws *WorkoutService) GetNewWorkoutContext(ctx context.Context) (*NewWorkoutContextResponse, error) {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
 		return nil, &apperrors.Unauthorized{Resource: "workout", UserID: ""}
 	}
 
@@ -93,12 +129,31 @@ func (ws *WorkoutService) GetNewWorkoutContext(ctx context.Context) (*NewWorkout
 		}
 	}
 
+	if ws.plateaus != nil {
+		flagged, err := ws.plateaus.FlaggedPlateaus(ctx)
+		if err != nil {
+			ws.logger.Warn("failed to detect plateaued exercises", "error", err)
+		} else {
+			for _, plateau := range flagged {
+				plateaued := PlateauedExerciseResponse{
+					ExerciseID:   plateau.ExerciseID,
+					ExerciseName: plateau.ExerciseName,
+					Status:       plateau.Status,
+				}
+				if len(plateau.Interventions) > 0 {
+					plateaued.Suggestion = plateau.Interventions[0].Message
+				}
+				response.PlateauedExercises = append(response.PlateauedExercises, plateaued)
+			}
+		}
+	}
+
 	return response, nil
 }
 
 func (ws *WorkoutService) GetWorkoutWithSets(ctx context.Context, id int32) ([]WorkoutWithSetsResponse, error) {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
 		return nil, &apperrors.Unauthorized{Resource: "workout", UserID: ""}
 	}
 	workoutWithSets, err := ws.repo.GetWorkoutWithSets(ctx, id, userID)
@@ -107,7 +162,7 @@ func (ws *WorkoutService) GetWorkoutWithSets(ctx context.Context, id int32) ([]W
 	}
 
 	// Convert database rows to response type
-	response, err := ws.convertWorkoutWithSetsRows(workoutWithSets)
+	response, err := convertWorkoutWithSetsRows(workoutWithSets)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert workout with sets rows: %w", err)
 	}
@@ -115,6 +170,40 @@ func (ws *WorkoutService) GetWorkoutWithSets(ctx context.Context, id int32) ([]W
 	return response, nil
 }
 
+// CompareWorkouts lines up two of the user's workouts exercise by exercise.
+// Deltas are b minus a.
+func (ws *WorkoutService) CompareWorkouts(ctx context.Context, a int32, b int32) (*WorkoutComparisonResponse, error) {
+	userID, ok := user.Current(ctx)
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
+		return nil, &apperrors.Unauthorized{Resource: "workout", UserID: ""}
+	}
+
+	workoutA, err := ws.comparisonWorkout(ctx, a, userID)
+	if err != nil {
+		return nil, err
+	}
+	workoutB, err := ws.comparisonWorkout(ctx, b, userID)
+	if err != nil {
+		return nil, err
+	}
+	return CompareWorkouts(*workoutA, *workoutB), nil
+}
+
+func (ws *WorkoutService) comparisonWorkout(ctx context.Context, id int32, userID string) (*ComparisonWorkout, error) {
+	rows, err := ws.repo.GetWorkoutWithSets(ctx, id, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workout with sets: %w", err)
+	}
+	workout, err := ComparisonWorkoutFromRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert workout with sets rows: %w", err)
+	}
+	if workout == nil {
+		return nil, &apperrors.NotFound{Resource: "workout", ID: fmt.Sprintf("%d", id)}
+	}
+	return workout, nil
+}
+
 func (ws *WorkoutService) CreateWorkout(ctx context.Context, requestBody CreateWorkoutRequest) error {
 	_, err := ws.CreateWorkoutWithID(ctx, requestBody)
 	return err
@@ -122,9 +211,12 @@ func (ws *WorkoutService) CreateWorkout(ctx context.Context, requestBody CreateW
 
 func (ws *WorkoutService) CreateWorkoutWithID(ctx context.Context, requestBody CreateWorkoutRequest) (int32, error) {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || user.IsDelegated(ctx) {
 		return 0, &apperrors.Unauthorized{Resource: "workout", UserID: ""}
 	}
+	if steps, ok := WarmupSchemeSteps(requestBody.WarmupScheme); ok {
+		requestBody.Exercises = WithWarmupSets(requestBody.Exercises, steps, ws.inventory(ctx))
+	}
 	// Transform the request to our internal format
 	reformatted, err := ws.transformRequest(requestBody)
 	if err != nil {
@@ -136,15 +228,74 @@ func (ws *WorkoutService) CreateWorkoutWithID(ctx context.Context, requestBody C
 	if err != nil {
 		return 0, fmt.Errorf("failed to save workout: %w", err)
 	}
+	ws.evaluateAchievements(ctx)
 
 	return workoutID, nil
 }
 
+// GenerateWarmup builds a warm-up ramp for one working set using the named
+// scheme, or the standard scheme when neither a scheme nor steps are given.
+func (ws *WorkoutService) GenerateWarmup(ctx context.Context, req WarmupRequest) (*WarmupResponse, error) {
+	if _, ok := user.Current(ctx); !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
+		return nil, &apperrors.Unauthorized{Resource: "workout", UserID: ""}
+	}
+
+	scheme, steps := warmupSchemeCustom, req.Steps
+	if len(steps) == 0 {
+		scheme = req.Scheme
+		if scheme == "" {
+			scheme = WarmupSchemeStandard
+		}
+		steps, _ = WarmupSchemeSteps(scheme)
+	}
+
+	bar := 0.0
+	if req.BarWeight != nil {
+		bar = *req.BarWeight
+	}
+
+	return &WarmupResponse{
+		Exercise: req.Exercise,
+		Scheme:   scheme,
+		LoadKind: string(equipment.KindForExercise(req.Exercise)),
+		Sets:     GenerateWarmupSets(req.Exercise, req.WorkingWeight, req.WorkingReps, steps, ws.inventory(ctx), bar),
+	}, nil
+}
+
+// inventory returns the user's equipment, falling back to the default gym
+// when none is wired up or it cannot be read.
+func (ws *WorkoutService) inventory(ctx context.Context) equipment.Inventory {
+	if ws.equipment == nil {
+		return equipment.DefaultInventory()
+	}
+	inv, err := ws.equipment.GetInventory(ctx)
+	if err != nil {
+		ws.logger.Warn("failed to load equipment for warm-up sets, using defaults", "error", err)
+		return equipment.DefaultInventory()
+	}
+	return inv.Inventory
+}
+
+// evaluateAchievements unlocks achievements after a workout write. The write
+// has already committed, so failures are logged rather than returned.
+func (ws *WorkoutService) evaluateAchievements(ctx context.Context) {
+	if ws.achievements == nil {
+		return
+	}
+	unlocked, err := ws.achievements.Evaluate(ctx)
+	if err != nil {
+		ws.logger.Warn("failed to evaluate achievements", "error", err)
+	}
+	for _, achievement := range unlocked {
+		ws.logger.Info("achievement unlocked", "achievement", achievement.Key)
+	}
+}
+
 // UpdateWorkout updates an existing workout (PUT endpoint)
 // Returns 204 No Content on success
 func (ws *WorkoutService) UpdateWorkout(ctx context.Context, id int32, req UpdateWorkoutRequest) error {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || user.IsDelegated(ctx) {
 		return &apperrors.Unauthorized{Resource: "workout", UserID: ""}
 	}
 
@@ -168,6 +319,7 @@ func (ws *WorkoutService) UpdateWorkout(ctx context.Context, id int32, req Updat
 	if err := ws.repo.UpdateWorkout(ctx, id, reformatted, userID); err != nil {
 		return fmt.Errorf("failed to update workout: %w", err)
 	}
+	ws.evaluateAchievements(ctx)
 
 	return nil
 }
@@ -176,7 +328,7 @@ func (ws *WorkoutService) UpdateWorkout(ctx context.Context, id int32, req Updat
 // Returns 204 No Content on success
 func (ws *WorkoutService) DeleteWorkout(ctx context.Context, id int32) error {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || user.IsDelegated(ctx) {
 		return &apperrors.Unauthorized{Resource: "workout", UserID: ""}
 	}
 
@@ -201,7 +353,7 @@ func (ws *WorkoutService) DeleteWorkout(ctx context.Context, id int32) error {
 // ListWorkoutFocusValues retrieves all distinct workout focus values for the authenticated user
 func (ws *WorkoutService) ListWorkoutFocusValues(ctx context.Context) ([]string, error) {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
 		return nil, &apperrors.Unauthorized{Resource: "workout", UserID: ""}
 	}
 	focusValues, err := ws.repo.ListWorkoutFocusValues(ctx, userID)
@@ -218,13 +370,13 @@ func (ws *WorkoutService) ListWorkoutFocusValues(ctx context.Context) ([]string,
 }
 
 // GetContributionData retrieves contribution graph data for the past 52 weeks
-func (ws *WorkoutService) GetContributionData(ctx context.Context) (*ContributionDataResponse, error) {
+func (ws *WorkoutService) GetContributionData(ctx context.Context, filter TagFilter) (*ContributionDataResponse, error) {
 	userID, ok := user.Current(ctx)
-	if !ok {
+	if !ok || !user.Permits(ctx, user.ScopeReadWorkouts) {
 		return nil, &apperrors.Unauthorized{Resource: "workout", UserID: ""}
 	}
 
-	rows, err := ws.repo.GetContributionData(ctx, userID)
+	rows, err := ws.repo.GetContributionData(ctx, userID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get contribution data: %w", err)
 	}
@@ -344,6 +496,7 @@ func newCreateWorkoutDraft(request CreateWorkoutRequest) workoutRequestDraft {
 		Date:         request.Date,
 		Notes:        request.Notes,
 		WorkoutFocus: request.WorkoutFocus,
+		Tags:         request.Tags,
 		Exercises:    exerciseInputsToDraft(request.Exercises),
 	}
 }
@@ -353,6 +506,7 @@ func newUpdateWorkoutDraft(request UpdateWorkoutRequest) workoutRequestDraft {
 		Date:         request.Date,
 		Notes:        request.Notes,
 		WorkoutFocus: request.WorkoutFocus,
+		Tags:         request.Tags,
 		Exercises:    updateExercisesToDraft(request.Exercises),
 	}
 }
@@ -366,6 +520,7 @@ func exerciseInputsToDraft(exercises []ExerciseInput) []exerciseRequestDraft {
 				Weight:  set.Weight,
 				Reps:    set.Reps,
 				SetType: set.SetType,
+				Notes:   set.Notes,
 			})
 		}
 		draftExercises = append(draftExercises, exerciseRequestDraft{
@@ -385,6 +540,7 @@ func updateExercisesToDraft(exercises []UpdateExercise) []exerciseRequestDraft {
 				Weight:  set.Weight,
 				Reps:    set.Reps,
 				SetType: set.SetType,
+				Notes:   set.Notes,
 			})
 		}
 		draftExercises = append(draftExercises, exerciseRequestDraft{
@@ -409,6 +565,12 @@ func transformWorkoutRequest(logger *slog.Logger, request workoutRequestDraft) (
 		Notes:        request.Notes,
 		WorkoutFocus: request.WorkoutFocus,
 	}
+	if request.Tags != nil {
+		workout.Tags = tag.NormalizeList(request.Tags)
+		if workout.Tags == nil {
+			workout.Tags = []string{}
+		}
+	}
 
 	// Process exercises and sets
 	exerciseMap := make(map[string]bool)
@@ -429,6 +591,7 @@ func transformWorkoutRequest(logger *slog.Logger, request workoutRequestDraft) (
 				Weight:       set.Weight,
 				Reps:         set.Reps,
 				SetType:      set.SetType,
+				Notes:        set.Notes,
 			})
 		}
 	}
@@ -449,7 +612,7 @@ func (ws *WorkoutService) transformUpdateRequest(request UpdateWorkoutRequest) (
 }
 
 // convertWorkoutWithSetsRows converts database rows to response type, handling pgtype.Numeric to float64 conversion
-func (ws *WorkoutService) convertWorkoutWithSetsRows(rows []db.GetWorkoutWithSetsRow) ([]WorkoutWithSetsResponse, error) {
+func convertWorkoutWithSetsRows(rows []db.GetWorkoutWithSetsRow) ([]WorkoutWithSetsResponse, error) {
 	response := make([]WorkoutWithSetsResponse, len(rows))
 
 	for i, row := range rows {
@@ -491,15 +654,22 @@ func (ws *WorkoutService) convertWorkoutWithSetsRows(rows []db.GetWorkoutWithSet
 			workoutFocus = &row.WorkoutFocus.String
 		}
 
+		var setNotes *string
+		if row.SetNotes.Valid {
+			setNotes = &row.SetNotes.String
+		}
+
 		response[i] = WorkoutWithSetsResponse{
 			WorkoutID:     row.WorkoutID,
 			WorkoutDate:   row.WorkoutDate.Time,
 			WorkoutNotes:  workoutNotes,
 			WorkoutFocus:  workoutFocus,
+			WorkoutTags:   row.WorkoutTags,
 			SetID:         row.SetID,
 			Weight:        weight,
 			Reps:          row.Reps,
 			SetType:       row.SetType,
+			SetNotes:      setNotes,
 			ExerciseID:    row.ExerciseID,
 			ExerciseName:  row.ExerciseName,
 			ExerciseOrder: exerciseOrder,
@@ -0,0 +1,82 @@
+package workout
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/achievements"
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type stubAchievementEvaluator struct {
+	calls int
+	err   error
+}
+
+func (s *stubAchievementEvaluator) Evaluate(ctx context.Context) ([]achievements.AchievementResponse, error) {
+	s.calls++
+	return nil, s.err
+}
+
+func TestWorkoutService_EvaluatesAchievementsAfterWrites(t *testing.T) {
+	userID := "test-user-id"
+	ctx := context.WithValue(context.Background(), user.UserIDKey, userID)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("create", func(t *testing.T) {
+		mockRepo := new(MockWorkoutRepository)
+		mockRepo.On("SaveWorkoutWithID", mock.Anything, mock.Anything, userID).Return(int32(7), nil)
+		evaluator := &stubAchievementEvaluator{err: assert.AnError}
+		service := NewService(logger, mockRepo)
+		service.SetAchievementEvaluator(evaluator)
+
+		id, err := service.CreateWorkoutWithID(ctx, CreateWorkoutRequest{
+			Date:      time.Now().Format(time.RFC3339),
+			Exercises: []ExerciseInput{{Name: "Bench Press", Sets: []SetInput{{Weight: float64Ptr(135), Reps: 5, SetType: "working"}}}},
+		})
+
+		require.NoError(t, err, "achievement failures do not fail the write")
+		assert.Equal(t, int32(7), id)
+		assert.Equal(t, 1, evaluator.calls)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		mockRepo := new(MockWorkoutRepository)
+		mockRepo.On("GetWorkout", mock.Anything, int32(7), userID).Return(db.Workout{ID: 7}, nil)
+		mockRepo.On("UpdateWorkout", mock.Anything, int32(7), mock.Anything, userID).Return(nil)
+		evaluator := &stubAchievementEvaluator{}
+		service := NewService(logger, mockRepo)
+		service.SetAchievementEvaluator(evaluator)
+
+		err := service.UpdateWorkout(ctx, 7, UpdateWorkoutRequest{
+			Date:      time.Now().UTC().Format(time.RFC3339),
+			Exercises: []UpdateExercise{{Name: "Bench Press", Sets: []UpdateSet{{Reps: 5, SetType: "working"}}}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, evaluator.calls)
+	})
+
+	t.Run("failed writes are not evaluated", func(t *testing.T) {
+		mockRepo := new(MockWorkoutRepository)
+		mockRepo.On("SaveWorkoutWithID", mock.Anything, mock.Anything, userID).Return(int32(0), assert.AnError)
+		evaluator := &stubAchievementEvaluator{}
+		service := NewService(logger, mockRepo)
+		service.SetAchievementEvaluator(evaluator)
+
+		_, err := service.CreateWorkoutWithID(ctx, CreateWorkoutRequest{
+			Date:      time.Now().Format(time.RFC3339),
+			Exercises: []ExerciseInput{{Name: "Bench Press", Sets: []SetInput{{Weight: float64Ptr(135), Reps: 5, SetType: "working"}}}},
+		})
+
+		require.Error(t, err)
+		assert.Zero(t, evaluator.calls)
+	})
+}
@@ -0,0 +1,119 @@
+package workout
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/exercise"
+	"github.com/Andrewy-gh/fittrack/server/internal/testutils"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/Andrewy-gh/fittrack/server/internal/webhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type publishedEvent struct {
+	userID    string
+	eventType string
+	data      map[string]any
+	q         *db.Queries
+}
+
+type stubWebhookPublisher struct {
+	events []publishedEvent
+	err    error
+}
+
+func (s *stubWebhookPublisher) Publish(_ context.Context, q *db.Queries, userID string, eventType string, data any) error {
+	s.events = append(s.events, publishedEvent{userID: userID, eventType: eventType, data: data.(map[string]any), q: q})
+	return s.err
+}
+
+func TestPublishWorkoutEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("nothing is queued without a publisher", func(t *testing.T) {
+		require.NoError(t, publishWorkoutEvent(ctx, nil, nil, webhooks.EventWorkoutCreated, 7, "user-1"))
+	})
+
+	t.Run("delete only carries the id", func(t *testing.T) {
+		publisher := &stubWebhookPublisher{}
+		qtx := db.New(nil)
+
+		require.NoError(t, publishWorkoutEvent(ctx, qtx, publisher, webhooks.EventWorkoutDeleted, 7, "user-1"))
+		require.Len(t, publisher.events, 1)
+		assert.Equal(t, publishedEvent{userID: "user-1", eventType: webhooks.EventWorkoutDeleted, data: map[string]any{"workout_id": int32(7)}, q: qtx}, publisher.events[0])
+	})
+
+	t.Run("queue failures are returned so the write rolls back", func(t *testing.T) {
+		publisher := &stubWebhookPublisher{err: assert.AnError}
+
+		err := publishWorkoutEvent(ctx, db.New(nil), publisher, webhooks.EventWorkoutDeleted, 7, "user-1")
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestWorkoutWebhookEvents_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool, cleanup := setupTestDatabase(t)
+	defer cleanup()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	queries := db.New(pool)
+	exerciseRepo := exercise.NewRepository(logger, queries, pool)
+
+	userID := "test-user-a"
+	ctx := testutils.SetTestUserContext(context.Background(), t, pool, userID)
+	ctx = user.WithContext(ctx, userID)
+
+	createReq := CreateWorkoutRequest{
+		Date:      "2026-02-10T10:00:00Z",
+		Exercises: []ExerciseInput{{Name: "Bench Press", Sets: []SetInput{{Weight: float64Ptr(135), Reps: 5, SetType: "working"}}}},
+	}
+
+	t.Run("writes queue their events inside the transaction", func(t *testing.T) {
+		publisher := &stubWebhookPublisher{}
+		service := NewService(logger, NewRepository(logger, queries, pool, exerciseRepo, nil, publisher))
+
+		id, err := service.CreateWorkoutWithID(ctx, createReq)
+		require.NoError(t, err)
+		require.NoError(t, service.UpdateWorkout(ctx, id, UpdateWorkoutRequest{
+			Date:      "2026-02-10T10:00:00Z",
+			Exercises: []UpdateExercise{{Name: "Bench Press", Sets: []UpdateSet{{Weight: float64Ptr(140), Reps: 5, SetType: "working"}}}},
+		}))
+		require.NoError(t, service.DeleteWorkout(ctx, id))
+
+		require.Len(t, publisher.events, 3)
+		for i, eventType := range []string{webhooks.EventWorkoutCreated, webhooks.EventWorkoutUpdated, webhooks.EventWorkoutDeleted} {
+			event := publisher.events[i]
+			assert.Equal(t, eventType, event.eventType)
+			assert.Equal(t, userID, event.userID)
+			assert.Equal(t, id, event.data["workout_id"])
+			assert.NotSame(t, queries, event.q, "events are queued on the transaction, not the pool")
+		}
+		assert.Len(t, publisher.events[0].data["sets"], 1)
+		assert.Len(t, publisher.events[1].data["sets"], 1)
+		assert.NotContains(t, publisher.events[2].data, "sets")
+	})
+
+	t.Run("a failed enqueue rolls back the write", func(t *testing.T) {
+		publisher := &stubWebhookPublisher{err: assert.AnError}
+		service := NewService(logger, NewRepository(logger, queries, pool, exerciseRepo, nil, publisher))
+
+		var before int
+		require.NoError(t, pool.QueryRow(ctx, "SELECT COUNT(*) FROM workout WHERE user_id = $1", userID).Scan(&before))
+
+		_, err := service.CreateWorkoutWithID(ctx, createReq)
+		require.ErrorIs(t, err, assert.AnError)
+
+		var after int
+		require.NoError(t, pool.QueryRow(ctx, "SELECT COUNT(*) FROM workout WHERE user_id = $1", userID).Scan(&after))
+		assert.Equal(t, before, after)
+	})
+}
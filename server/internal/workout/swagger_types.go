@@ -43,10 +43,12 @@ type WorkoutWithSetsResponse struct {
 	WorkoutDate   time.Time  `json:"workout_date" validate:"required" example:"2023-01-01T15:04:05Z"`
 	WorkoutNotes  *string    `json:"workout_notes,omitempty" example:"Great workout today"`
 	WorkoutFocus  *string    `json:"workout_focus,omitempty" example:"Upper Body"`
+	WorkoutTags   []string   `json:"workout_tags,omitempty" example:"deload"`
 	SetID         int32      `json:"set_id" validate:"required" example:"1"`
 	Weight        *float64   `json:"weight,omitempty" example:"225.5"`
 	Reps          int32      `json:"reps" validate:"required" example:"10"`
 	SetType       string     `json:"set_type" validate:"required" example:"working"`
+	SetNotes      *string    `json:"set_notes,omitempty" example:"Belt on, felt fast"`
 	ExerciseID    int32      `json:"exercise_id" validate:"required" example:"1"`
 	ExerciseName  string     `json:"exercise_name" validate:"required" example:"Bench Press"`
 	ExerciseOrder *int32     `json:"exercise_order,omitempty" example:"0"`
@@ -58,7 +60,8 @@ type WorkoutWithSetsResponse struct {
 // @Description Request model for updating existing workout metadata
 type UpdateWorkoutRequestSwagger struct {
 	Date         *string         `json:"date,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2023-01-15T10:00:00Z"`
-	Notes        *string         `json:"notes,omitempty" validate:"omitempty,max=256" example:"Updated workout notes"`
+	Notes        *string         `json:"notes,omitempty" validate:"omitempty,max=10000" example:"Updated workout notes"`
 	WorkoutFocus *string         `json:"workout_focus,omitempty" validate:"omitempty,max=256" example:"Upper Body"`
+	Tags         []string        `json:"tags,omitempty" validate:"omitempty,max=10,dive,max=64" example:"deload"`
 	Exercises    []ExerciseInput `json:"exercises,omitempty" validate:"omitempty,dive" example:"[]"`
 }
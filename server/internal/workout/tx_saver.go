@@ -7,6 +7,8 @@ import (
 
 	db "github.com/Andrewy-gh/fittrack/server/internal/database"
 	"github.com/Andrewy-gh/fittrack/server/internal/exercise"
+	"github.com/Andrewy-gh/fittrack/server/internal/notifications"
+	"github.com/Andrewy-gh/fittrack/server/internal/webhooks"
 )
 
 type TxSaver interface {
@@ -16,12 +18,20 @@ type TxSaver interface {
 type txSaver struct {
 	logger       *slog.Logger
 	exerciseRepo exercise.ExerciseRepository
+	notifier     notifications.Producer
+	events       webhooks.Publisher
 }
 
-func NewTxSaver(logger *slog.Logger, exerciseRepo exercise.ExerciseRepository) TxSaver {
+// NewTxSaver returns a TxSaver. notifier may be nil, in which case 1RM
+// increases are recorded without a notification, and events may be nil, in
+// which case no workout.created webhook is queued. Both are written on the
+// caller's transaction, so they commit only with the saved workout.
+func NewTxSaver(logger *slog.Logger, exerciseRepo exercise.ExerciseRepository, notifier notifications.Producer, events webhooks.Publisher) TxSaver {
 	return &txSaver{
 		logger:       logger,
 		exerciseRepo: exerciseRepo,
+		notifier:     notifier,
+		events:       events,
 	}
 }
 
@@ -54,11 +64,23 @@ func (s *txSaver) SaveWorkoutTx(ctx context.Context, qtx *db.Queries, requestBod
 		return 0, fmt.Errorf("failed to insert sets: %w", err)
 	}
 
-	if err := updateHistorical1rmFromWorkout(ctx, qtx, workoutRow.ID, userID); err != nil {
+	if len(reformatted.Workout.Tags) > 0 {
+		if err := setWorkoutTags(ctx, qtx, workoutRow.ID, reformatted.Workout.Tags, userID); err != nil {
+			s.logger.Error("failed to tag workout", "error", err, "workout_id", workoutRow.ID)
+			return 0, fmt.Errorf("failed to tag workout: %w", err)
+		}
+	}
+
+	if err := updateHistorical1rmFromWorkout(ctx, qtx, workoutRow.ID, userID, s.notifier); err != nil {
 		s.logger.Error("failed to update historical 1RM from workout", "error", err, "workout_id", workoutRow.ID)
 		return 0, fmt.Errorf("failed to update historical 1RM from workout: %w", err)
 	}
 
+	if err := publishWorkoutEvent(ctx, qtx, s.events, webhooks.EventWorkoutCreated, workoutRow.ID, userID); err != nil {
+		s.logger.Error("failed to queue workout created webhook", "error", err, "workout_id", workoutRow.ID)
+		return 0, fmt.Errorf("failed to queue workout created webhook: %w", err)
+	}
+
 	return workoutRow.ID, nil
 }
 
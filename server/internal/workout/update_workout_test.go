@@ -189,7 +189,7 @@ func TestWorkoutHandler_UpdateWorkout(t *testing.T) {
 			workoutID: "1",
 			requestBody: UpdateWorkoutRequest{
 				Date:  "2023-01-15T10:00:00Z",
-				Notes: stringPtr(string(make([]byte, 500))), // Exceeds 256 char limit
+				Notes: stringPtr(string(make([]byte, 10001))), // Exceeds 10000 char limit
 				Exercises: []UpdateExercise{
 					{
 						Name: "placeholder",
@@ -383,7 +383,7 @@ func TestWorkoutHandler_UpdateWorkout_Integration(t *testing.T) {
 
 	// Initialize repositories
 	exerciseRepo := exercise.NewRepository(logger, queries, pool)
-	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo)
+	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo, nil, nil)
 	workoutService := NewService(logger, workoutRepo)
 	handler := NewHandler(logger, validator, workoutService)
 
@@ -0,0 +1,136 @@
+package workout
+
+import (
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
+)
+
+const (
+	WarmupSchemeStandard     = "standard"
+	WarmupSchemeQuick        = "quick"
+	WarmupSchemePowerlifting = "powerlifting"
+
+	// warmupSchemeCustom is reported when the request supplies its own steps.
+	warmupSchemeCustom = "custom"
+
+	// highRepWorkingReps is where the working set is light enough that
+	// warm-ups above highRepMaxWarmupPercent only add fatigue.
+	highRepWorkingReps      = 10
+	highRepMaxWarmupPercent = 60
+)
+
+// WarmupStep is one warm-up set as a percentage of the working weight. A
+// Percent of 0 is the empty bar and is skipped for dumbbell and machine work.
+type WarmupStep struct {
+	Percent float64 `json:"percent" validate:"gte=0,lt=100" example:"60"`
+	Reps    int     `json:"reps" validate:"gte=1,lte=20" example:"3"`
+}
+
+var warmupSchemes = map[string][]WarmupStep{
+	WarmupSchemeStandard: {
+		{Percent: 0, Reps: 10},
+		{Percent: 40, Reps: 5},
+		{Percent: 60, Reps: 3},
+		{Percent: 80, Reps: 2},
+	},
+	WarmupSchemeQuick: {
+		{Percent: 50, Reps: 5},
+		{Percent: 75, Reps: 3},
+	},
+	WarmupSchemePowerlifting: {
+		{Percent: 0, Reps: 10},
+		{Percent: 0, Reps: 5},
+		{Percent: 40, Reps: 5},
+		{Percent: 55, Reps: 3},
+		{Percent: 70, Reps: 2},
+		{Percent: 80, Reps: 1},
+		{Percent: 90, Reps: 1},
+	},
+}
+
+// WarmupSchemeSteps returns the steps of a named scheme.
+func WarmupSchemeSteps(scheme string) ([]WarmupStep, bool) {
+	steps, ok := warmupSchemes[scheme]
+	return steps, ok
+}
+
+// GenerateWarmupSets ramps up to workingWeight for the named exercise,
+// rounding each step to a load the inventory can make. Barbell steps are
+// loaded on bar, or on the primary bar when bar is 0. Steps that round to
+// the working weight or do not add weight over the previous warm-up are
+// dropped, as are heavy steps before high-rep working sets.
+func GenerateWarmupSets(exercise string, workingWeight float64, workingReps int, steps []WarmupStep, inv equipment.Inventory, bar float64) []SetInput {
+	sets := []SetInput{}
+	if workingWeight <= 0 {
+		return sets
+	}
+
+	kind := equipment.KindForExercise(exercise)
+	if kind == equipment.LoadBarbell && bar <= 0 && len(inv.BarbellWeights) > 0 {
+		bar = inv.BarbellWeights[0]
+	}
+
+	last := 0.0
+	for _, step := range steps {
+		if workingReps >= highRepWorkingReps && step.Percent > highRepMaxWarmupPercent {
+			continue
+		}
+
+		var weight float64
+		switch {
+		case step.Percent == 0 && (kind != equipment.LoadBarbell || bar <= 0):
+			continue
+		case step.Percent == 0:
+			weight = bar
+		case kind == equipment.LoadBarbell && bar > 0:
+			weight = inv.Breakdown(workingWeight*step.Percent/100, bar).Weight
+		default:
+			weight = inv.Round(workingWeight*step.Percent/100, kind)
+		}
+
+		if weight <= 0 || weight >= workingWeight {
+			continue
+		}
+		// Repeated empty-bar sets are intentional; other steps must climb.
+		if step.Percent > 0 && weight <= last {
+			continue
+		}
+
+		setWeight := weight
+		sets = append(sets, SetInput{Weight: &setWeight, Reps: step.Reps, SetType: "warmup"})
+		last = weight
+	}
+	return sets
+}
+
+// WithWarmupSets returns a copy of exercises where each exercise without
+// warm-up sets is led by a ramp to its heaviest weighted working set.
+func WithWarmupSets(exercises []ExerciseInput, steps []WarmupStep, inv equipment.Inventory) []ExerciseInput {
+	result := make([]ExerciseInput, len(exercises))
+	for i, exercise := range exercises {
+		result[i] = exercise
+
+		var top *SetInput
+		hasWarmup := false
+		for j := range exercise.Sets {
+			set := &exercise.Sets[j]
+			if set.SetType == "warmup" {
+				hasWarmup = true
+				break
+			}
+			if set.SetType == "working" && set.Weight != nil && *set.Weight > 0 &&
+				(top == nil || *set.Weight > *top.Weight) {
+				top = set
+			}
+		}
+		if hasWarmup || top == nil {
+			continue
+		}
+
+		warmups := GenerateWarmupSets(exercise.Name, *top.Weight, top.Reps, steps, inv, 0)
+		if len(warmups) == 0 {
+			continue
+		}
+		result[i].Sets = append(warmups, exercise.Sets...)
+	}
+	return result
+}
@@ -0,0 +1,240 @@
+package workout
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Andrewy-gh/fittrack/server/internal/equipment"
+	"github.com/Andrewy-gh/fittrack/server/internal/user"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type stubInventoryLoader struct {
+	inv equipment.Inventory
+}
+
+func (s stubInventoryLoader) GetInventory(ctx context.Context) (*equipment.InventoryResponse, error) {
+	return &equipment.InventoryResponse{Inventory: s.inv}, nil
+}
+
+func warmupLoads(sets []SetInput) [][2]float64 {
+	loads := make([][2]float64, 0, len(sets))
+	for _, set := range sets {
+		loads = append(loads, [2]float64{*set.Weight, float64(set.Reps)})
+	}
+	return loads
+}
+
+func TestGenerateWarmupSets(t *testing.T) {
+	inv := equipment.DefaultInventory()
+	standard, _ := WarmupSchemeSteps(WarmupSchemeStandard)
+
+	tests := []struct {
+		name     string
+		exercise string
+		weight   float64
+		reps     int
+		bar      float64
+		expected [][2]float64
+	}{
+		{
+			name:     "barbell ramp starts with the empty bar",
+			exercise: "Back Squat",
+			weight:   225,
+			reps:     5,
+			expected: [][2]float64{{45, 10}, {90, 5}, {135, 3}, {180, 2}},
+		},
+		{
+			name:     "steps that do not climb are dropped",
+			exercise: "Back Squat",
+			weight:   95,
+			reps:     5,
+			expected: [][2]float64{{45, 10}, {55, 3}, {75, 2}},
+		},
+		{
+			name:     "high-rep working sets skip heavy steps",
+			exercise: "Back Squat",
+			weight:   135,
+			reps:     12,
+			expected: [][2]float64{{45, 10}, {55, 5}, {80, 3}},
+		},
+		{
+			name:     "given bar is used",
+			exercise: "Bench Press",
+			weight:   135,
+			reps:     5,
+			bar:      35,
+			expected: [][2]float64{{35, 10}, {55, 5}, {80, 3}, {110, 2}},
+		},
+		{
+			name:     "dumbbells skip the bar and round to the increment",
+			exercise: "Dumbbell Bench Press",
+			weight:   80,
+			reps:     8,
+			expected: [][2]float64{{30, 5}, {50, 3}, {65, 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sets := GenerateWarmupSets(tt.exercise, tt.weight, tt.reps, standard, inv, tt.bar)
+
+			assert.Equal(t, tt.expected, warmupLoads(sets))
+			for _, set := range sets {
+				assert.Equal(t, "warmup", set.SetType)
+			}
+		})
+	}
+}
+
+func TestWithWarmupSets(t *testing.T) {
+	exercises := []ExerciseInput{
+		{Name: "Back Squat", Sets: []SetInput{
+			{Weight: float64Ptr(205), Reps: 5, SetType: "working"},
+			{Weight: float64Ptr(225), Reps: 3, SetType: "working"},
+		}},
+		{Name: "Deadlift", Sets: []SetInput{
+			{Weight: float64Ptr(135), Reps: 5, SetType: "warmup"},
+			{Weight: float64Ptr(315), Reps: 5, SetType: "working"},
+		}},
+		{Name: "Pull Up", Sets: []SetInput{{Reps: 8, SetType: "working"}}},
+	}
+	quick, _ := WarmupSchemeSteps(WarmupSchemeQuick)
+
+	result := WithWarmupSets(exercises, quick, equipment.DefaultInventory())
+
+	require.Len(t, result, 3)
+	assert.Equal(t, [][2]float64{{110, 5}, {170, 3}, {205, 5}, {225, 3}}, warmupLoads(result[0].Sets))
+	assert.Equal(t, exercises[1].Sets, result[1].Sets)
+	assert.Equal(t, exercises[2].Sets, result[2].Sets)
+	assert.Len(t, exercises[0].Sets, 2, "input exercises are not modified")
+}
+
+func TestWorkoutHandler_GenerateWarmup(t *testing.T) {
+	ctx := context.WithValue(context.Background(), user.UserIDKey, "test-user-id")
+
+	tests := []struct {
+		name         string
+		body         string
+		loader       inventoryLoader
+		ctx          context.Context
+		expectedCode int
+		expected     *WarmupResponse
+	}{
+		{
+			name:         "defaults to the standard scheme",
+			body:         `{"exercise":"Back Squat","workingWeight":225,"workingReps":5}`,
+			ctx:          ctx,
+			expectedCode: http.StatusOK,
+			expected: &WarmupResponse{
+				Exercise: "Back Squat",
+				Scheme:   WarmupSchemeStandard,
+				LoadKind: "barbell",
+				Sets: []SetInput{
+					{Weight: float64Ptr(45), Reps: 10, SetType: "warmup"},
+					{Weight: float64Ptr(90), Reps: 5, SetType: "warmup"},
+					{Weight: float64Ptr(135), Reps: 3, SetType: "warmup"},
+					{Weight: float64Ptr(180), Reps: 2, SetType: "warmup"},
+				},
+			},
+		},
+		{
+			name:         "custom steps use the user's equipment",
+			body:         `{"exercise":"Dumbbell Curl","workingWeight":30,"workingReps":8,"steps":[{"percent":50,"reps":8},{"percent":75,"reps":4}]}`,
+			loader:       stubInventoryLoader{inv: equipment.Inventory{BarbellWeights: []float64{20}, DumbbellIncrement: 2.5, MachineStackStep: 5}},
+			ctx:          ctx,
+			expectedCode: http.StatusOK,
+			expected: &WarmupResponse{
+				Exercise: "Dumbbell Curl",
+				Scheme:   "custom",
+				LoadKind: "dumbbell",
+				Sets: []SetInput{
+					{Weight: float64Ptr(15), Reps: 8, SetType: "warmup"},
+					{Weight: float64Ptr(22.5), Reps: 4, SetType: "warmup"},
+				},
+			},
+		},
+		{
+			name:         "unknown scheme",
+			body:         `{"exercise":"Back Squat","workingWeight":225,"workingReps":5,"scheme":"pyramid"}`,
+			ctx:          ctx,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "missing working weight",
+			body:         `{"exercise":"Back Squat","workingReps":5}`,
+			ctx:          ctx,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "unauthenticated",
+			body:         `{"exercise":"Back Squat","workingWeight":225,"workingReps":5}`,
+			ctx:          context.Background(),
+			expectedCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			service := &WorkoutService{repo: new(MockWorkoutRepository), logger: logger}
+			if tt.loader != nil {
+				service.SetInventoryLoader(tt.loader)
+			}
+			handler := NewHandler(logger, validator.New(), service)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/tools/warmup", bytes.NewBufferString(tt.body)).WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.GenerateWarmup(w, req)
+
+			require.Equal(t, tt.expectedCode, w.Code, w.Body.String())
+			if tt.expected != nil {
+				var got WarmupResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+				assert.Equal(t, *tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWorkoutService_CreateWorkoutWithID_WarmupScheme(t *testing.T) {
+	userID := "test-user-id"
+	ctx := context.WithValue(context.Background(), user.UserIDKey, userID)
+	mockRepo := new(MockWorkoutRepository)
+	mockRepo.On("SaveWorkoutWithID", mock.Anything, mock.MatchedBy(func(reformatted *ReformattedRequest) bool {
+		if len(reformatted.Sets) != 5 {
+			return false
+		}
+		for _, set := range reformatted.Sets[:4] {
+			if set.SetType != "warmup" {
+				return false
+			}
+		}
+		return reformatted.Sets[4].SetType == "working"
+	}), userID).Return(int32(7), nil)
+
+	service := &WorkoutService{repo: mockRepo, logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	id, err := service.CreateWorkoutWithID(ctx, CreateWorkoutRequest{
+		Date:         time.Now().Format(time.RFC3339),
+		WarmupScheme: WarmupSchemeStandard,
+		Exercises: []ExerciseInput{{
+			Name: "Bench Press",
+			Sets: []SetInput{{Weight: float64Ptr(135), Reps: 5, SetType: "working"}},
+		}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), id)
+	mockRepo.AssertExpectations(t)
+}
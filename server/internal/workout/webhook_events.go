@@ -0,0 +1,33 @@
+package workout
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Andrewy-gh/fittrack/server/internal/database"
+	"github.com/Andrewy-gh/fittrack/server/internal/webhooks"
+)
+
+// publishWorkoutEvent queues a workout webhook on qtx, before the
+// transaction that made the change commits, so the event is delivered
+// exactly when the write sticks. Created and updated events carry the
+// workout's sets. events may be nil, in which case nothing is queued.
+func publishWorkoutEvent(ctx context.Context, qtx *db.Queries, events webhooks.Publisher, eventType string, id int32, userID string) error {
+	if events == nil {
+		return nil
+	}
+
+	data := map[string]any{"workout_id": id}
+	if eventType != webhooks.EventWorkoutDeleted {
+		rows, err := qtx.GetWorkoutWithSets(ctx, db.GetWorkoutWithSetsParams{ID: id, UserID: userID})
+		if err != nil {
+			return fmt.Errorf("load workout for %s webhook: %w", eventType, err)
+		}
+		sets, err := convertWorkoutWithSetsRows(rows)
+		if err != nil {
+			return fmt.Errorf("convert workout for %s webhook: %w", eventType, err)
+		}
+		data["sets"] = sets
+	}
+	return events.Publish(ctx, qtx, userID, eventType, data)
+}
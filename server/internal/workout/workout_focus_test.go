@@ -352,7 +352,7 @@ func TestWorkoutFocus_Integration(t *testing.T) {
 
 	// Initialize repositories
 	exerciseRepo := exercise.NewRepository(logger, queries, pool)
-	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo)
+	workoutRepo := NewRepository(logger, queries, pool, exerciseRepo, nil, nil)
 	workoutService := NewService(logger, workoutRepo)
 	handler := NewHandler(logger, validator, workoutService)
 
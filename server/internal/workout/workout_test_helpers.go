@@ -68,8 +68,8 @@ func (m *MockWorkoutRepository) GetWorkout(ctx context.Context, id int32, userID
 	return args.Get(0).(db.Workout), args.Error(1)
 }
 
-func (m *MockWorkoutRepository) ListWorkouts(ctx context.Context, userID string) ([]db.Workout, error) {
-	args := m.Called(ctx, userID)
+func (m *MockWorkoutRepository) ListWorkouts(ctx context.Context, userID string, filter TagFilter) ([]db.Workout, error) {
+	args := m.Called(ctx, userID, filter)
 	return args.Get(0).([]db.Workout), args.Error(1)
 }
 
@@ -103,8 +103,8 @@ func (m *MockWorkoutRepository) ListWorkoutFocusValues(ctx context.Context, user
 	return args.Get(0).([]string), args.Error(1)
 }
 
-func (m *MockWorkoutRepository) GetContributionData(ctx context.Context, userID string) ([]db.GetContributionDataRow, error) {
-	args := m.Called(ctx, userID)
+func (m *MockWorkoutRepository) GetContributionData(ctx context.Context, userID string, filter TagFilter) ([]db.GetContributionDataRow, error) {
+	args := m.Called(ctx, userID, filter)
 	return args.Get(0).([]db.GetContributionDataRow), args.Error(1)
 }
 
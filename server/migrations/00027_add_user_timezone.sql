@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN timezone VARCHAR(64);
+ALTER TABLE users ADD CONSTRAINT users_timezone_not_empty
+    CHECK (timezone IS NULL OR btrim(timezone) <> '');
+
+-- +goose Down
+ALTER TABLE users DROP CONSTRAINT users_timezone_not_empty;
+ALTER TABLE users DROP COLUMN timezone;
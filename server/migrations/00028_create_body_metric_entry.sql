@@ -0,0 +1,73 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE body_metric_entry (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    measured_on DATE NOT NULL,
+    bodyweight NUMERIC(6,2),
+    body_fat_percent NUMERIC(4,1),
+    neck NUMERIC(5,1),
+    chest NUMERIC(5,1),
+    waist NUMERIC(5,1),
+    hips NUMERIC(5,1),
+    arm NUMERIC(5,1),
+    thigh NUMERIC(5,1),
+    notes VARCHAR(256),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT body_metric_entry_has_measurement CHECK (
+        num_nonnulls(bodyweight, body_fat_percent, neck, chest, waist, hips, arm, thigh) > 0
+    ),
+    CONSTRAINT body_metric_entry_bodyweight_positive CHECK (bodyweight IS NULL OR bodyweight > 0),
+    CONSTRAINT body_metric_entry_body_fat_percent_bounds CHECK (
+        body_fat_percent IS NULL OR body_fat_percent BETWEEN 1 AND 75
+    ),
+    CONSTRAINT body_metric_entry_girths_positive CHECK (
+        (neck IS NULL OR neck > 0)
+        AND (chest IS NULL OR chest > 0)
+        AND (waist IS NULL OR waist > 0)
+        AND (hips IS NULL OR hips > 0)
+        AND (arm IS NULL OR arm > 0)
+        AND (thigh IS NULL OR thigh > 0)
+    )
+);
+
+CREATE INDEX idx_body_metric_entry_user_measured_on ON body_metric_entry(user_id, measured_on DESC, id DESC);
+
+ALTER TABLE body_metric_entry ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY body_metric_entry_select_policy ON body_metric_entry
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+CREATE POLICY body_metric_entry_insert_policy ON body_metric_entry
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY body_metric_entry_update_policy ON body_metric_entry
+    FOR UPDATE TO PUBLIC
+    USING (user_id = current_user_id())
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY body_metric_entry_delete_policy ON body_metric_entry
+    FOR DELETE TO PUBLIC
+    USING (user_id = current_user_id());
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON body_metric_entry TO PUBLIC;
+GRANT USAGE ON SEQUENCE body_metric_entry_id_seq TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS body_metric_entry_delete_policy ON body_metric_entry;
+DROP POLICY IF EXISTS body_metric_entry_update_policy ON body_metric_entry;
+DROP POLICY IF EXISTS body_metric_entry_insert_policy ON body_metric_entry;
+DROP POLICY IF EXISTS body_metric_entry_select_policy ON body_metric_entry;
+
+ALTER TABLE body_metric_entry DISABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON SEQUENCE body_metric_entry_id_seq FROM PUBLIC;
+REVOKE ALL ON body_metric_entry FROM PUBLIC;
+
+DROP TABLE IF EXISTS body_metric_entry;
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_strength_profile (
+    user_id VARCHAR(256) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    sex VARCHAR(8),
+    squat_exercise_id INTEGER REFERENCES exercise(id) ON DELETE SET NULL,
+    bench_exercise_id INTEGER REFERENCES exercise(id) ON DELETE SET NULL,
+    deadlift_exercise_id INTEGER REFERENCES exercise(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT user_strength_profile_sex_valid CHECK (
+        sex IS NULL OR sex IN ('male', 'female')
+    )
+);
+
+ALTER TABLE user_strength_profile ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY user_strength_profile_select_policy ON user_strength_profile
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+CREATE POLICY user_strength_profile_insert_policy ON user_strength_profile
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY user_strength_profile_update_policy ON user_strength_profile
+    FOR UPDATE TO PUBLIC
+    USING (user_id = current_user_id())
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY user_strength_profile_delete_policy ON user_strength_profile
+    FOR DELETE TO PUBLIC
+    USING (user_id = current_user_id());
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON user_strength_profile TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS user_strength_profile_delete_policy ON user_strength_profile;
+DROP POLICY IF EXISTS user_strength_profile_update_policy ON user_strength_profile;
+DROP POLICY IF EXISTS user_strength_profile_insert_policy ON user_strength_profile;
+DROP POLICY IF EXISTS user_strength_profile_select_policy ON user_strength_profile;
+
+ALTER TABLE user_strength_profile DISABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON user_strength_profile FROM PUBLIC;
+
+DROP TABLE IF EXISTS user_strength_profile;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE calendar_feed (
+    user_id VARCHAR(256) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    rotated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE calendar_feed ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY calendar_feed_select_policy ON calendar_feed
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+CREATE POLICY calendar_feed_insert_policy ON calendar_feed
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY calendar_feed_update_policy ON calendar_feed
+    FOR UPDATE TO PUBLIC
+    USING (user_id = current_user_id())
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY calendar_feed_delete_policy ON calendar_feed
+    FOR DELETE TO PUBLIC
+    USING (user_id = current_user_id());
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON calendar_feed TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS calendar_feed_delete_policy ON calendar_feed;
+DROP POLICY IF EXISTS calendar_feed_update_policy ON calendar_feed;
+DROP POLICY IF EXISTS calendar_feed_insert_policy ON calendar_feed;
+DROP POLICY IF EXISTS calendar_feed_select_policy ON calendar_feed;
+
+ALTER TABLE calendar_feed DISABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON calendar_feed FROM PUBLIC;
+
+DROP TABLE IF EXISTS calendar_feed;
+-- +goose StatementEnd
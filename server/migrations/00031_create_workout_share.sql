@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE workout_share (
+    workout_id INTEGER PRIMARY KEY REFERENCES workout(id) ON DELETE CASCADE,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_workout_share_user_id ON workout_share(user_id);
+
+ALTER TABLE workout_share ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY workout_share_select_policy ON workout_share
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+CREATE POLICY workout_share_insert_policy ON workout_share
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY workout_share_update_policy ON workout_share
+    FOR UPDATE TO PUBLIC
+    USING (user_id = current_user_id())
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY workout_share_delete_policy ON workout_share
+    FOR DELETE TO PUBLIC
+    USING (user_id = current_user_id());
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON workout_share TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS workout_share_delete_policy ON workout_share;
+DROP POLICY IF EXISTS workout_share_update_policy ON workout_share;
+DROP POLICY IF EXISTS workout_share_insert_policy ON workout_share;
+DROP POLICY IF EXISTS workout_share_select_policy ON workout_share;
+
+ALTER TABLE workout_share DISABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON workout_share FROM PUBLIC;
+
+DROP INDEX IF EXISTS idx_workout_share_user_id;
+DROP TABLE IF EXISTS workout_share;
+-- +goose StatementEnd
@@ -0,0 +1,191 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A coaching link starts as a coach's pending invitation and becomes active
+-- once an athlete accepts it, at which point athlete_user_id is set and the
+-- invite code is cleared.
+CREATE TABLE coaching_link (
+    id SERIAL PRIMARY KEY,
+    coach_user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    athlete_user_id VARCHAR(256) REFERENCES users(user_id) ON DELETE CASCADE,
+    scopes TEXT[] NOT NULL,
+    invite_code_hash CHAR(64) UNIQUE,
+    invite_expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    accepted_at TIMESTAMPTZ,
+    CONSTRAINT coaching_link_scopes_valid CHECK (
+        cardinality(scopes) > 0
+        AND scopes <@ ARRAY['read_workouts', 'read_metrics', 'suggest_workouts']::TEXT[]
+    ),
+    CONSTRAINT coaching_link_not_self CHECK (athlete_user_id IS NULL OR athlete_user_id <> coach_user_id),
+    CONSTRAINT coaching_link_state CHECK (
+        (athlete_user_id IS NULL AND accepted_at IS NULL AND invite_code_hash IS NOT NULL AND invite_expires_at IS NOT NULL)
+        OR (athlete_user_id IS NOT NULL AND accepted_at IS NOT NULL AND invite_code_hash IS NULL)
+    )
+);
+
+CREATE UNIQUE INDEX idx_coaching_link_coach_athlete ON coaching_link(coach_user_id, athlete_user_id) WHERE athlete_user_id IS NOT NULL;
+CREATE INDEX idx_coaching_link_athlete ON coaching_link(athlete_user_id) WHERE athlete_user_id IS NOT NULL;
+
+CREATE TABLE coaching_suggestion (
+    id SERIAL PRIMARY KEY,
+    link_id INTEGER NOT NULL REFERENCES coaching_link(id) ON DELETE CASCADE,
+    coach_user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    athlete_user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    title VARCHAR(256) NOT NULL,
+    notes TEXT,
+    scheduled_on DATE,
+    exercises JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    dismissed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_coaching_suggestion_athlete_created ON coaching_suggestion(athlete_user_id, created_at DESC, id DESC);
+
+-- coach_has_scope extends the current_user_id() approach from migration
+-- 00006: the session user may read an athlete's rows when an accepted
+-- coaching link grants the scope.
+CREATE OR REPLACE FUNCTION coach_has_scope(athlete TEXT, required_scope TEXT)
+RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM coaching_link
+        WHERE coach_user_id = current_user_id()
+          AND athlete_user_id = athlete
+          AND required_scope = ANY(scopes)
+    );
+$$ LANGUAGE SQL STABLE;
+
+ALTER TABLE coaching_link ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY coaching_link_select_policy ON coaching_link
+    FOR SELECT TO PUBLIC
+    USING (coach_user_id = current_user_id() OR athlete_user_id = current_user_id());
+
+CREATE POLICY coaching_link_insert_policy ON coaching_link
+    FOR INSERT TO PUBLIC
+    WITH CHECK (coach_user_id = current_user_id() AND athlete_user_id IS NULL);
+
+-- Athletes accept an invitation by claiming a pending, unexpired row.
+CREATE POLICY coaching_link_update_policy ON coaching_link
+    FOR UPDATE TO PUBLIC
+    USING (
+        coach_user_id = current_user_id()
+        OR athlete_user_id = current_user_id()
+        OR (athlete_user_id IS NULL AND invite_expires_at > CURRENT_TIMESTAMP)
+    )
+    WITH CHECK (coach_user_id = current_user_id() OR athlete_user_id = current_user_id());
+
+CREATE POLICY coaching_link_delete_policy ON coaching_link
+    FOR DELETE TO PUBLIC
+    USING (coach_user_id = current_user_id() OR athlete_user_id = current_user_id());
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON coaching_link TO PUBLIC;
+GRANT USAGE ON SEQUENCE coaching_link_id_seq TO PUBLIC;
+
+ALTER TABLE coaching_suggestion ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY coaching_suggestion_select_policy ON coaching_suggestion
+    FOR SELECT TO PUBLIC
+    USING (coach_user_id = current_user_id() OR athlete_user_id = current_user_id());
+
+CREATE POLICY coaching_suggestion_insert_policy ON coaching_suggestion
+    FOR INSERT TO PUBLIC
+    WITH CHECK (coach_user_id = current_user_id() AND coach_has_scope(athlete_user_id, 'suggest_workouts'));
+
+CREATE POLICY coaching_suggestion_update_policy ON coaching_suggestion
+    FOR UPDATE TO PUBLIC
+    USING (athlete_user_id = current_user_id())
+    WITH CHECK (athlete_user_id = current_user_id());
+
+CREATE POLICY coaching_suggestion_delete_policy ON coaching_suggestion
+    FOR DELETE TO PUBLIC
+    USING (coach_user_id = current_user_id() OR athlete_user_id = current_user_id());
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON coaching_suggestion TO PUBLIC;
+GRANT USAGE ON SEQUENCE coaching_suggestion_id_seq TO PUBLIC;
+
+-- Coaches read workout data with either scope, since metrics are derived
+-- from logged sets. Write policies are unchanged, so delegated access stays
+-- read-only in the database too.
+DROP POLICY IF EXISTS workout_select_policy ON workout;
+CREATE POLICY workout_select_policy ON workout
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+        OR coach_has_scope(user_id, 'read_metrics')
+    );
+
+DROP POLICY IF EXISTS exercise_select_policy ON exercise;
+CREATE POLICY exercise_select_policy ON exercise
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+        OR coach_has_scope(user_id, 'read_metrics')
+    );
+
+DROP POLICY IF EXISTS set_select_policy ON "set";
+CREATE POLICY set_select_policy ON "set"
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+        OR coach_has_scope(user_id, 'read_metrics')
+    );
+
+DROP POLICY IF EXISTS body_metric_entry_select_policy ON body_metric_entry;
+CREATE POLICY body_metric_entry_select_policy ON body_metric_entry
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id() OR coach_has_scope(user_id, 'read_metrics'));
+
+DROP POLICY IF EXISTS user_strength_profile_select_policy ON user_strength_profile;
+CREATE POLICY user_strength_profile_select_policy ON user_strength_profile
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id() OR coach_has_scope(user_id, 'read_metrics'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS user_strength_profile_select_policy ON user_strength_profile;
+CREATE POLICY user_strength_profile_select_policy ON user_strength_profile
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+DROP POLICY IF EXISTS body_metric_entry_select_policy ON body_metric_entry;
+CREATE POLICY body_metric_entry_select_policy ON body_metric_entry
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+DROP POLICY IF EXISTS set_select_policy ON "set";
+CREATE POLICY set_select_policy ON "set"
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+DROP POLICY IF EXISTS exercise_select_policy ON exercise;
+CREATE POLICY exercise_select_policy ON exercise
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+DROP POLICY IF EXISTS workout_select_policy ON workout;
+CREATE POLICY workout_select_policy ON workout
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+DROP POLICY IF EXISTS coaching_suggestion_delete_policy ON coaching_suggestion;
+DROP POLICY IF EXISTS coaching_suggestion_update_policy ON coaching_suggestion;
+DROP POLICY IF EXISTS coaching_suggestion_insert_policy ON coaching_suggestion;
+DROP POLICY IF EXISTS coaching_suggestion_select_policy ON coaching_suggestion;
+DROP POLICY IF EXISTS coaching_link_delete_policy ON coaching_link;
+DROP POLICY IF EXISTS coaching_link_update_policy ON coaching_link;
+DROP POLICY IF EXISTS coaching_link_insert_policy ON coaching_link;
+DROP POLICY IF EXISTS coaching_link_select_policy ON coaching_link;
+
+REVOKE ALL ON SEQUENCE coaching_suggestion_id_seq FROM PUBLIC;
+REVOKE ALL ON coaching_suggestion FROM PUBLIC;
+REVOKE ALL ON SEQUENCE coaching_link_id_seq FROM PUBLIC;
+REVOKE ALL ON coaching_link FROM PUBLIC;
+
+DROP FUNCTION IF EXISTS coach_has_scope(TEXT, TEXT);
+DROP TABLE IF EXISTS coaching_suggestion;
+DROP TABLE IF EXISTS coaching_link;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Expression indexes rather than stored tsvector columns, so the searchable
+-- text stays defined in one place per table. Search queries must repeat these
+-- expressions exactly for the planner to use the indexes.
+CREATE INDEX idx_workout_search ON workout
+    USING GIN (to_tsvector('english', coalesce(workout_focus, '') || ' ' || coalesce(notes, '')));
+
+CREATE INDEX idx_exercise_search ON exercise
+    USING GIN (to_tsvector('english', name));
+
+CREATE INDEX idx_ai_chat_conversation_search ON ai_chat_conversation
+    USING GIN (to_tsvector('english', coalesce(title, '')));
+
+CREATE INDEX idx_ai_chat_message_search ON ai_chat_message
+    USING GIN (to_tsvector('english', content));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_ai_chat_message_search;
+DROP INDEX IF EXISTS idx_ai_chat_conversation_search;
+DROP INDEX IF EXISTS idx_exercise_search;
+DROP INDEX IF EXISTS idx_workout_search;
+-- +goose StatementEnd
@@ -0,0 +1,88 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Tags are stored lowercased, so the unique constraint is case-insensitive.
+CREATE TABLE workout_tag (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ,
+    CONSTRAINT workout_tag_user_id_name_key UNIQUE (user_id, name),
+    CONSTRAINT workout_tag_name_normalized CHECK (name <> '' AND name = lower(btrim(name)))
+);
+
+CREATE TABLE workout_tag_link (
+    workout_id INTEGER NOT NULL REFERENCES workout(id) ON DELETE CASCADE,
+    tag_id INTEGER NOT NULL REFERENCES workout_tag(id) ON DELETE CASCADE,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (workout_id, tag_id)
+);
+
+CREATE INDEX idx_workout_tag_link_tag_id ON workout_tag_link(tag_id);
+
+ALTER TABLE workout_tag ENABLE ROW LEVEL SECURITY;
+
+-- Coaches who can read an athlete's workouts can also see how they are tagged.
+CREATE POLICY workout_tag_select_policy ON workout_tag
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+        OR coach_has_scope(user_id, 'read_metrics')
+    );
+
+CREATE POLICY workout_tag_insert_policy ON workout_tag
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY workout_tag_update_policy ON workout_tag
+    FOR UPDATE TO PUBLIC
+    USING (user_id = current_user_id())
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY workout_tag_delete_policy ON workout_tag
+    FOR DELETE TO PUBLIC
+    USING (user_id = current_user_id());
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON workout_tag TO PUBLIC;
+GRANT USAGE ON SEQUENCE workout_tag_id_seq TO PUBLIC;
+
+ALTER TABLE workout_tag_link ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY workout_tag_link_select_policy ON workout_tag_link
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+        OR coach_has_scope(user_id, 'read_metrics')
+    );
+
+CREATE POLICY workout_tag_link_insert_policy ON workout_tag_link
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY workout_tag_link_delete_policy ON workout_tag_link
+    FOR DELETE TO PUBLIC
+    USING (user_id = current_user_id());
+
+GRANT SELECT, INSERT, DELETE ON workout_tag_link TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS workout_tag_link_delete_policy ON workout_tag_link;
+DROP POLICY IF EXISTS workout_tag_link_insert_policy ON workout_tag_link;
+DROP POLICY IF EXISTS workout_tag_link_select_policy ON workout_tag_link;
+DROP POLICY IF EXISTS workout_tag_delete_policy ON workout_tag;
+DROP POLICY IF EXISTS workout_tag_update_policy ON workout_tag;
+DROP POLICY IF EXISTS workout_tag_insert_policy ON workout_tag;
+DROP POLICY IF EXISTS workout_tag_select_policy ON workout_tag;
+
+REVOKE ALL ON workout_tag_link FROM PUBLIC;
+REVOKE ALL ON SEQUENCE workout_tag_id_seq FROM PUBLIC;
+REVOKE ALL ON workout_tag FROM PUBLIC;
+
+DROP INDEX IF EXISTS idx_workout_tag_link_tag_id;
+DROP TABLE IF EXISTS workout_tag_link;
+DROP TABLE IF EXISTS workout_tag;
+-- +goose StatementEnd
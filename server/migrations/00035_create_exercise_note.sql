@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Each save appends a revision; the newest row per exercise is the current note.
+CREATE TABLE exercise_note (
+    id SERIAL PRIMARY KEY,
+    exercise_id INTEGER NOT NULL REFERENCES exercise(id) ON DELETE CASCADE,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    notes TEXT,
+    settings TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT exercise_note_notes_length CHECK (notes IS NULL OR char_length(notes) <= 2000),
+    CONSTRAINT exercise_note_settings_length CHECK (settings IS NULL OR char_length(settings) <= 500)
+);
+
+CREATE INDEX idx_exercise_note_exercise_created ON exercise_note(exercise_id, created_at DESC, id DESC);
+
+ALTER TABLE exercise_note ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY exercise_note_select_policy ON exercise_note
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+    );
+
+CREATE POLICY exercise_note_insert_policy ON exercise_note
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY exercise_note_delete_policy ON exercise_note
+    FOR DELETE TO PUBLIC
+    USING (user_id = current_user_id());
+
+GRANT SELECT, INSERT, DELETE ON exercise_note TO PUBLIC;
+GRANT USAGE ON SEQUENCE exercise_note_id_seq TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS exercise_note_delete_policy ON exercise_note;
+DROP POLICY IF EXISTS exercise_note_insert_policy ON exercise_note;
+DROP POLICY IF EXISTS exercise_note_select_policy ON exercise_note;
+
+REVOKE ALL ON SEQUENCE exercise_note_id_seq FROM PUBLIC;
+REVOKE ALL ON exercise_note FROM PUBLIC;
+
+DROP INDEX IF EXISTS idx_exercise_note_exercise_created;
+DROP TABLE IF EXISTS exercise_note;
+-- +goose StatementEnd
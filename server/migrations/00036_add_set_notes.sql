@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Workout notes hold Markdown, so they outgrow the old 256 character column.
+ALTER TABLE workout ALTER COLUMN notes TYPE TEXT;
+ALTER TABLE workout ADD CONSTRAINT workout_notes_length CHECK (notes IS NULL OR char_length(notes) <= 10000);
+
+ALTER TABLE "set" ADD COLUMN notes TEXT;
+ALTER TABLE "set" ADD CONSTRAINT set_notes_length CHECK (notes IS NULL OR char_length(notes) <= 500);
+
+-- Search queries repeat this expression exactly.
+CREATE INDEX idx_set_notes_search ON "set" USING GIN (to_tsvector('english', coalesce(notes, '')));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_set_notes_search;
+
+ALTER TABLE "set" DROP CONSTRAINT IF EXISTS set_notes_length;
+ALTER TABLE "set" DROP COLUMN IF EXISTS notes;
+
+ALTER TABLE workout DROP CONSTRAINT IF EXISTS workout_notes_length;
+ALTER TABLE workout ALTER COLUMN notes TYPE VARCHAR(256) USING left(notes, 256);
+-- +goose StatementEnd
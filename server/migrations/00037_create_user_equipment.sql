@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Loading equipment used to round suggested weights. barbell_weights is a JSON
+-- array of bar weights with the primary bar first; plates is a JSON array of
+-- {"weight": n, "pairs": n} objects.
+CREATE TABLE user_equipment (
+    user_id VARCHAR(256) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    barbell_weights JSONB NOT NULL,
+    plates JSONB NOT NULL,
+    dumbbell_increment NUMERIC(6,2) NOT NULL,
+    machine_stack_step NUMERIC(6,2) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT user_equipment_barbell_weights_array CHECK (jsonb_typeof(barbell_weights) = 'array'),
+    CONSTRAINT user_equipment_plates_array CHECK (jsonb_typeof(plates) = 'array'),
+    CONSTRAINT user_equipment_dumbbell_increment_positive CHECK (dumbbell_increment > 0),
+    CONSTRAINT user_equipment_machine_stack_step_positive CHECK (machine_stack_step > 0)
+);
+
+ALTER TABLE user_equipment ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY user_equipment_select_policy ON user_equipment
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+    );
+
+CREATE POLICY user_equipment_insert_policy ON user_equipment
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY user_equipment_update_policy ON user_equipment
+    FOR UPDATE TO PUBLIC
+    USING (user_id = current_user_id())
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY user_equipment_delete_policy ON user_equipment
+    FOR DELETE TO PUBLIC
+    USING (user_id = current_user_id());
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON user_equipment TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS user_equipment_delete_policy ON user_equipment;
+DROP POLICY IF EXISTS user_equipment_update_policy ON user_equipment;
+DROP POLICY IF EXISTS user_equipment_insert_policy ON user_equipment;
+DROP POLICY IF EXISTS user_equipment_select_policy ON user_equipment;
+
+ALTER TABLE user_equipment DISABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON user_equipment FROM PUBLIC;
+
+DROP TABLE IF EXISTS user_equipment;
+-- +goose StatementEnd
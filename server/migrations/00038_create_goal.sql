@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Training goals. exercise_id is set only for e1rm goals. start_value is the
+-- tracked metric when the goal was set and decides whether the target is
+-- reached by going above or below it.
+CREATE TABLE goal (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    goal_type VARCHAR(16) NOT NULL,
+    exercise_id INTEGER REFERENCES exercise(id) ON DELETE CASCADE,
+    target_value NUMERIC(8,2) NOT NULL,
+    start_value NUMERIC(8,2),
+    target_date DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT goal_type_valid CHECK (goal_type IN ('e1rm', 'frequency', 'bodyweight')),
+    CONSTRAINT goal_exercise_matches_type CHECK ((goal_type = 'e1rm') = (exercise_id IS NOT NULL)),
+    CONSTRAINT goal_target_value_positive CHECK (target_value > 0)
+);
+
+CREATE INDEX idx_goal_user_created ON goal(user_id, created_at, id);
+
+ALTER TABLE goal ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY goal_select_policy ON goal
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_metrics')
+    );
+
+CREATE POLICY goal_insert_policy ON goal
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY goal_delete_policy ON goal
+    FOR DELETE TO PUBLIC
+    USING (user_id = current_user_id());
+
+GRANT SELECT, INSERT, DELETE ON goal TO PUBLIC;
+GRANT USAGE ON SEQUENCE goal_id_seq TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS goal_delete_policy ON goal;
+DROP POLICY IF EXISTS goal_insert_policy ON goal;
+DROP POLICY IF EXISTS goal_select_policy ON goal;
+
+REVOKE ALL ON SEQUENCE goal_id_seq FROM PUBLIC;
+REVOKE ALL ON goal FROM PUBLIC;
+
+DROP INDEX IF EXISTS idx_goal_user_created;
+DROP TABLE IF EXISTS goal;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Unlocked achievements. achievement_key names a rule defined in code, so
+-- new rules need no schema change. workout_id is the workout that first met
+-- the rule and is cleared if that workout is deleted; the achievement stays.
+CREATE TABLE user_achievement (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    achievement_key VARCHAR(64) NOT NULL,
+    workout_id INTEGER REFERENCES workout(id) ON DELETE SET NULL,
+    unlocked_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT user_achievement_user_key UNIQUE (user_id, achievement_key),
+    CONSTRAINT user_achievement_key_not_empty CHECK (btrim(achievement_key) <> '')
+);
+
+ALTER TABLE user_achievement ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY user_achievement_select_policy ON user_achievement
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+    );
+
+CREATE POLICY user_achievement_insert_policy ON user_achievement
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+GRANT SELECT, INSERT ON user_achievement TO PUBLIC;
+GRANT USAGE ON SEQUENCE user_achievement_id_seq TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS user_achievement_insert_policy ON user_achievement;
+DROP POLICY IF EXISTS user_achievement_select_policy ON user_achievement;
+
+REVOKE ALL ON SEQUENCE user_achievement_id_seq FROM PUBLIC;
+REVOKE ALL ON user_achievement FROM PUBLIC;
+
+DROP TABLE IF EXISTS user_achievement;
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+-- In-app notifications. Rows are written by other features inside their own
+-- transactions (billing webhooks, AI run recovery, 1RM increases), so kind is
+-- free-form and data carries whatever the client needs to deep-link.
+CREATE TABLE notification (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    kind VARCHAR(64) NOT NULL,
+    title VARCHAR(256) NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    data JSONB NOT NULL DEFAULT '{}'::jsonb,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT notification_kind_not_empty CHECK (btrim(kind) <> ''),
+    CONSTRAINT notification_title_not_empty CHECK (btrim(title) <> ''),
+    CONSTRAINT notification_data_object CHECK (jsonb_typeof(data) = 'object')
+);
+
+CREATE INDEX idx_notification_user_id ON notification(user_id, id DESC);
+CREATE INDEX idx_notification_user_unread ON notification(user_id) WHERE read_at IS NULL;
+
+ALTER TABLE notification ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY notification_select_policy ON notification
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+CREATE POLICY notification_insert_policy ON notification
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY notification_update_policy ON notification
+    FOR UPDATE TO PUBLIC
+    USING (user_id = current_user_id())
+    WITH CHECK (user_id = current_user_id());
+
+GRANT SELECT, INSERT, UPDATE ON notification TO PUBLIC;
+GRANT USAGE ON SEQUENCE notification_id_seq TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS notification_update_policy ON notification;
+DROP POLICY IF EXISTS notification_insert_policy ON notification;
+DROP POLICY IF EXISTS notification_select_policy ON notification;
+
+REVOKE ALL ON SEQUENCE notification_id_seq FROM PUBLIC;
+REVOKE ALL ON notification FROM PUBLIC;
+
+DROP TABLE IF EXISTS notification;
+-- +goose StatementEnd
@@ -0,0 +1,100 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Outbound webhooks. The secret signs payloads, so unlike calendar and share
+-- tokens it is stored as-is rather than hashed.
+CREATE TABLE webhook_endpoint (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(128) NOT NULL,
+    event_types TEXT[] NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT webhook_endpoint_url_http CHECK (url ~ '^https?://'),
+    CONSTRAINT webhook_endpoint_event_types_not_empty CHECK (cardinality(event_types) > 0)
+);
+
+-- One row per event per endpoint. The dispatcher claims pending rows whose
+-- next_attempt_at has passed and pushes it back with exponential backoff on
+-- failure, so this table is both the queue and the delivery log.
+CREATE TABLE webhook_delivery (
+    id SERIAL PRIMARY KEY,
+    endpoint_id INTEGER NOT NULL REFERENCES webhook_endpoint(id) ON DELETE CASCADE,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    event_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_attempt_at TIMESTAMPTZ,
+    response_status INTEGER,
+    last_error TEXT,
+    delivered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT webhook_delivery_status_valid CHECK (status IN ('pending', 'succeeded', 'failed')),
+    CONSTRAINT webhook_delivery_payload_object CHECK (jsonb_typeof(payload) = 'object')
+);
+
+CREATE INDEX idx_webhook_endpoint_user_id ON webhook_endpoint(user_id);
+CREATE INDEX idx_webhook_delivery_endpoint_id ON webhook_delivery(endpoint_id, id DESC);
+CREATE INDEX idx_webhook_delivery_due ON webhook_delivery(next_attempt_at) WHERE status = 'pending';
+
+ALTER TABLE webhook_endpoint ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_delivery ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY webhook_endpoint_select_policy ON webhook_endpoint
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+CREATE POLICY webhook_endpoint_insert_policy ON webhook_endpoint
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY webhook_endpoint_update_policy ON webhook_endpoint
+    FOR UPDATE TO PUBLIC
+    USING (user_id = current_user_id())
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY webhook_endpoint_delete_policy ON webhook_endpoint
+    FOR DELETE TO PUBLIC
+    USING (user_id = current_user_id());
+
+CREATE POLICY webhook_delivery_select_policy ON webhook_delivery
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+CREATE POLICY webhook_delivery_insert_policy ON webhook_delivery
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY webhook_delivery_update_policy ON webhook_delivery
+    FOR UPDATE TO PUBLIC
+    USING (user_id = current_user_id())
+    WITH CHECK (user_id = current_user_id());
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON webhook_endpoint TO PUBLIC;
+GRANT SELECT, INSERT, UPDATE ON webhook_delivery TO PUBLIC;
+GRANT USAGE ON SEQUENCE webhook_endpoint_id_seq TO PUBLIC;
+GRANT USAGE ON SEQUENCE webhook_delivery_id_seq TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS webhook_delivery_update_policy ON webhook_delivery;
+DROP POLICY IF EXISTS webhook_delivery_insert_policy ON webhook_delivery;
+DROP POLICY IF EXISTS webhook_delivery_select_policy ON webhook_delivery;
+DROP POLICY IF EXISTS webhook_endpoint_delete_policy ON webhook_endpoint;
+DROP POLICY IF EXISTS webhook_endpoint_update_policy ON webhook_endpoint;
+DROP POLICY IF EXISTS webhook_endpoint_insert_policy ON webhook_endpoint;
+DROP POLICY IF EXISTS webhook_endpoint_select_policy ON webhook_endpoint;
+
+REVOKE ALL ON SEQUENCE webhook_delivery_id_seq FROM PUBLIC;
+REVOKE ALL ON SEQUENCE webhook_endpoint_id_seq FROM PUBLIC;
+REVOKE ALL ON webhook_delivery FROM PUBLIC;
+REVOKE ALL ON webhook_endpoint FROM PUBLIC;
+
+DROP TABLE IF EXISTS webhook_delivery;
+DROP TABLE IF EXISTS webhook_endpoint;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Personal access tokens for the public API. Only the SHA-256 of the token is
+-- stored; the token itself is shown once when it is created.
+CREATE TABLE personal_access_token (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    token_prefix VARCHAR(16) NOT NULL,
+    scopes TEXT[] NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT personal_access_token_name_not_empty CHECK (btrim(name) <> ''),
+    CONSTRAINT personal_access_token_scopes_valid CHECK (
+        cardinality(scopes) > 0
+        AND scopes <@ ARRAY['workouts:read', 'workouts:write', 'chat:use']::TEXT[]
+    ),
+    CONSTRAINT personal_access_token_expires_after_created CHECK (expires_at > created_at)
+);
+
+CREATE INDEX idx_personal_access_token_user_id ON personal_access_token(user_id, created_at DESC, id DESC);
+
+ALTER TABLE personal_access_token ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY personal_access_token_select_policy ON personal_access_token
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+CREATE POLICY personal_access_token_insert_policy ON personal_access_token
+    FOR INSERT TO PUBLIC
+    WITH CHECK (user_id = current_user_id());
+
+CREATE POLICY personal_access_token_update_policy ON personal_access_token
+    FOR UPDATE TO PUBLIC
+    USING (user_id = current_user_id())
+    WITH CHECK (user_id = current_user_id());
+
+GRANT SELECT, INSERT, UPDATE ON personal_access_token TO PUBLIC;
+GRANT USAGE ON SEQUENCE personal_access_token_id_seq TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS personal_access_token_update_policy ON personal_access_token;
+DROP POLICY IF EXISTS personal_access_token_insert_policy ON personal_access_token;
+DROP POLICY IF EXISTS personal_access_token_select_policy ON personal_access_token;
+
+REVOKE ALL ON SEQUENCE personal_access_token_id_seq FROM PUBLIC;
+REVOKE ALL ON personal_access_token FROM PUBLIC;
+
+DROP TABLE IF EXISTS personal_access_token;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Public share links and calendar feeds are resolved before any user context
+-- exists. Instead of relying on a role that bypasses RLS, the lookup sets
+-- app.current_token_hash for its transaction and these policies expose only
+-- the row with that hash.
+CREATE OR REPLACE FUNCTION current_token_hash()
+RETURNS TEXT AS $$
+    SELECT NULLIF(current_setting('app.current_token_hash', true), '');
+$$ LANGUAGE SQL STABLE;
+
+DROP POLICY IF EXISTS workout_share_select_policy ON workout_share;
+CREATE POLICY workout_share_select_policy ON workout_share
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id() OR token_hash = current_token_hash());
+
+DROP POLICY IF EXISTS calendar_feed_select_policy ON calendar_feed;
+CREATE POLICY calendar_feed_select_policy ON calendar_feed
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id() OR token_hash = current_token_hash());
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS calendar_feed_select_policy ON calendar_feed;
+CREATE POLICY calendar_feed_select_policy ON calendar_feed
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+DROP POLICY IF EXISTS workout_share_select_policy ON workout_share;
+CREATE POLICY workout_share_select_policy ON workout_share
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+
+DROP FUNCTION IF EXISTS current_token_hash();
+-- +goose StatementEnd
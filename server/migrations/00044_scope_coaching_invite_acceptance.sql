@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A pending invitation has no athlete yet, so the athlete accepting it could
+-- not see the row and the accepting UPDATE matched nothing. Acceptance now
+-- sets app.current_token_hash to the invite code hash, and only that pending,
+-- unexpired invitation is exposed. This also replaces the update branch that
+-- let any user update any pending invitation.
+DROP POLICY IF EXISTS coaching_link_select_policy ON coaching_link;
+CREATE POLICY coaching_link_select_policy ON coaching_link
+    FOR SELECT TO PUBLIC
+    USING (
+        coach_user_id = current_user_id()
+        OR athlete_user_id = current_user_id()
+        OR (
+            athlete_user_id IS NULL
+            AND invite_expires_at > CURRENT_TIMESTAMP
+            AND invite_code_hash = current_token_hash()
+        )
+    );
+
+DROP POLICY IF EXISTS coaching_link_update_policy ON coaching_link;
+CREATE POLICY coaching_link_update_policy ON coaching_link
+    FOR UPDATE TO PUBLIC
+    USING (
+        coach_user_id = current_user_id()
+        OR athlete_user_id = current_user_id()
+        OR (
+            athlete_user_id IS NULL
+            AND invite_expires_at > CURRENT_TIMESTAMP
+            AND invite_code_hash = current_token_hash()
+        )
+    )
+    WITH CHECK (coach_user_id = current_user_id() OR athlete_user_id = current_user_id());
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS coaching_link_update_policy ON coaching_link;
+CREATE POLICY coaching_link_update_policy ON coaching_link
+    FOR UPDATE TO PUBLIC
+    USING (
+        coach_user_id = current_user_id()
+        OR athlete_user_id = current_user_id()
+        OR (athlete_user_id IS NULL AND invite_expires_at > CURRENT_TIMESTAMP)
+    )
+    WITH CHECK (coach_user_id = current_user_id() OR athlete_user_id = current_user_id());
+
+DROP POLICY IF EXISTS coaching_link_select_policy ON coaching_link;
+CREATE POLICY coaching_link_select_policy ON coaching_link
+    FOR SELECT TO PUBLIC
+    USING (coach_user_id = current_user_id() OR athlete_user_id = current_user_id());
+-- +goose StatementEnd
@@ -0,0 +1,80 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Raw workout, exercise, set and tag rows now need read_workouts. Previously
+-- read_metrics exposed them too, because metrics are derived from logged
+-- sets, which left the service checks as the only thing keeping a
+-- metrics-only coach out of the workout log. API routes that derive metrics
+-- from sets now need both scopes.
+DROP POLICY IF EXISTS workout_select_policy ON workout;
+CREATE POLICY workout_select_policy ON workout
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id() OR coach_has_scope(user_id, 'read_workouts'));
+
+DROP POLICY IF EXISTS exercise_select_policy ON exercise;
+CREATE POLICY exercise_select_policy ON exercise
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id() OR coach_has_scope(user_id, 'read_workouts'));
+
+DROP POLICY IF EXISTS set_select_policy ON "set";
+CREATE POLICY set_select_policy ON "set"
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id() OR coach_has_scope(user_id, 'read_workouts'));
+
+DROP POLICY IF EXISTS workout_tag_select_policy ON workout_tag;
+CREATE POLICY workout_tag_select_policy ON workout_tag
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id() OR coach_has_scope(user_id, 'read_workouts'));
+
+DROP POLICY IF EXISTS workout_tag_link_select_policy ON workout_tag_link;
+CREATE POLICY workout_tag_link_select_policy ON workout_tag_link
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id() OR coach_has_scope(user_id, 'read_workouts'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS workout_tag_link_select_policy ON workout_tag_link;
+CREATE POLICY workout_tag_link_select_policy ON workout_tag_link
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+        OR coach_has_scope(user_id, 'read_metrics')
+    );
+
+DROP POLICY IF EXISTS workout_tag_select_policy ON workout_tag;
+CREATE POLICY workout_tag_select_policy ON workout_tag
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+        OR coach_has_scope(user_id, 'read_metrics')
+    );
+
+DROP POLICY IF EXISTS set_select_policy ON "set";
+CREATE POLICY set_select_policy ON "set"
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+        OR coach_has_scope(user_id, 'read_metrics')
+    );
+
+DROP POLICY IF EXISTS exercise_select_policy ON exercise;
+CREATE POLICY exercise_select_policy ON exercise
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+        OR coach_has_scope(user_id, 'read_metrics')
+    );
+
+DROP POLICY IF EXISTS workout_select_policy ON workout;
+CREATE POLICY workout_select_policy ON workout
+    FOR SELECT TO PUBLIC
+    USING (
+        user_id = current_user_id()
+        OR coach_has_scope(user_id, 'read_workouts')
+        OR coach_has_scope(user_id, 'read_metrics')
+    );
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Body metrics, goals and the training profile describe the user rather than
+-- their workout log, so tokens reach them through their own profile scopes
+-- instead of workouts:read and workouts:write.
+ALTER TABLE personal_access_token DROP CONSTRAINT personal_access_token_scopes_valid;
+ALTER TABLE personal_access_token ADD CONSTRAINT personal_access_token_scopes_valid CHECK (
+    cardinality(scopes) > 0
+    AND scopes <@ ARRAY['workouts:read', 'workouts:write', 'profile:read', 'profile:write', 'chat:use']::TEXT[]
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM personal_access_token
+WHERE scopes <@ ARRAY['profile:read', 'profile:write']::TEXT[];
+
+UPDATE personal_access_token
+SET scopes = array_remove(array_remove(scopes, 'profile:read'), 'profile:write')
+WHERE scopes && ARRAY['profile:read', 'profile:write']::TEXT[];
+
+ALTER TABLE personal_access_token DROP CONSTRAINT personal_access_token_scopes_valid;
+ALTER TABLE personal_access_token ADD CONSTRAINT personal_access_token_scopes_valid CHECK (
+    cardinality(scopes) > 0
+    AND scopes <@ ARRAY['workouts:read', 'workouts:write', 'chat:use']::TEXT[]
+);
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Bearer tokens are resolved before any user context exists. Like share and
+-- calendar tokens, the lookup sets app.current_token_hash for its
+-- transaction and this policy exposes only the token with that hash.
+DROP POLICY IF EXISTS personal_access_token_select_policy ON personal_access_token;
+CREATE POLICY personal_access_token_select_policy ON personal_access_token
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id() OR token_hash = current_token_hash());
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS personal_access_token_select_policy ON personal_access_token;
+CREATE POLICY personal_access_token_select_policy ON personal_access_token
+    FOR SELECT TO PUBLIC
+    USING (user_id = current_user_id());
+-- +goose StatementEnd
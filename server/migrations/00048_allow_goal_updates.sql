@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Goals can be edited. Only the target value and date change; the type,
+-- exercise and starting value stay as they were when the goal was set.
+CREATE POLICY goal_update_policy ON goal
+    FOR UPDATE TO PUBLIC
+    USING (user_id = current_user_id())
+    WITH CHECK (user_id = current_user_id());
+
+GRANT UPDATE (target_value, target_date) ON goal TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+REVOKE UPDATE (target_value, target_date) ON goal FROM PUBLIC;
+
+DROP POLICY IF EXISTS goal_update_policy ON goal;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Delegated reads join the athlete's users row for their timezone while the
+-- session user is still the coach, so users_policy alone hid it and every
+-- X-Act-As grant lookup came back empty. An accepted coaching link now
+-- exposes the athlete's row to that coach; pending invitations have no
+-- athlete and expose nothing.
+CREATE POLICY users_coach_select_policy ON users
+    FOR SELECT TO PUBLIC
+    USING (
+        EXISTS (
+            SELECT 1 FROM coaching_link
+            WHERE coach_user_id = current_user_id()
+              AND athlete_user_id = users.user_id
+        )
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP POLICY IF EXISTS users_coach_select_policy ON users;
+-- +goose StatementEnd
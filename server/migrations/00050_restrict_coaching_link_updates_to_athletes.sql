@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Coaches could update their own links, so at the database level a coach
+-- could rewrite scopes and grant themselves read_workouts or read_metrics.
+-- Only the athlete changes an accepted link now, and accepting a pending
+-- invitation is the only other update. The coach on a link never changes.
+DROP POLICY IF EXISTS coaching_link_update_policy ON coaching_link;
+CREATE POLICY coaching_link_update_policy ON coaching_link
+    FOR UPDATE TO PUBLIC
+    USING (
+        athlete_user_id = current_user_id()
+        OR (
+            athlete_user_id IS NULL
+            AND invite_expires_at > CURRENT_TIMESTAMP
+            AND invite_code_hash = current_token_hash()
+        )
+    )
+    WITH CHECK (athlete_user_id = current_user_id());
+
+REVOKE UPDATE ON coaching_link FROM PUBLIC;
+GRANT UPDATE (athlete_user_id, scopes, invite_code_hash, invite_expires_at, accepted_at) ON coaching_link TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+REVOKE UPDATE (athlete_user_id, scopes, invite_code_hash, invite_expires_at, accepted_at) ON coaching_link FROM PUBLIC;
+GRANT UPDATE ON coaching_link TO PUBLIC;
+
+DROP POLICY IF EXISTS coaching_link_update_policy ON coaching_link;
+CREATE POLICY coaching_link_update_policy ON coaching_link
+    FOR UPDATE TO PUBLIC
+    USING (
+        coach_user_id = current_user_id()
+        OR athlete_user_id = current_user_id()
+        OR (
+            athlete_user_id IS NULL
+            AND invite_expires_at > CURRENT_TIMESTAMP
+            AND invite_code_hash = current_token_hash()
+        )
+    )
+    WITH CHECK (coach_user_id = current_user_id() OR athlete_user_id = current_user_id());
+-- +goose StatementEnd
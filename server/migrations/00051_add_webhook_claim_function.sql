@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The dispatcher claims deliveries across all users before any user context
+-- exists, so under RLS it only worked while the application role owned the
+-- tables. Claiming now goes through this SECURITY DEFINER function, which
+-- runs as its owner and does nothing but lease due deliveries, instead of a
+-- policy that would expose every user's queue and endpoint secrets.
+CREATE OR REPLACE FUNCTION claim_due_webhook_deliveries(batch_size INTEGER)
+RETURNS TABLE (
+    id INTEGER,
+    endpoint_id INTEGER,
+    user_id VARCHAR,
+    event_id VARCHAR,
+    event_type VARCHAR,
+    payload JSONB,
+    attempt_count INTEGER,
+    url VARCHAR,
+    secret VARCHAR
+) AS $$
+    UPDATE webhook_delivery d
+    SET next_attempt_at = CURRENT_TIMESTAMP + INTERVAL '5 minutes'
+    FROM webhook_endpoint e
+    WHERE e.id = d.endpoint_id
+      AND e.active
+      AND d.id IN (
+        SELECT pending.id
+        FROM webhook_delivery pending
+        JOIN webhook_endpoint pe ON pe.id = pending.endpoint_id
+        WHERE pending.status = 'pending'
+          AND pending.next_attempt_at <= CURRENT_TIMESTAMP
+          AND pe.active
+        ORDER BY pending.next_attempt_at, pending.id
+        LIMIT batch_size
+        FOR UPDATE OF pending SKIP LOCKED
+      )
+    RETURNING d.id, d.endpoint_id, d.user_id, d.event_id, d.event_type, d.payload, d.attempt_count, e.url, e.secret;
+$$ LANGUAGE SQL VOLATILE SECURITY DEFINER SET search_path = public, pg_temp;
+
+GRANT EXECUTE ON FUNCTION claim_due_webhook_deliveries(INTEGER) TO PUBLIC;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS claim_due_webhook_deliveries(INTEGER);
+-- +goose StatementEnd
@@ -3,7 +3,32 @@
 SELECT id, date, notes, workout_focus, created_at, updated_at FROM workout WHERE id = $1 AND user_id = $2;
 
 -- name: ListWorkouts :many
-SELECT id, date, notes, workout_focus, created_at, updated_at FROM workout WHERE user_id = $1 ORDER BY date DESC;
+-- Tags keeps workouts carrying every listed tag; exclude_tags drops workouts
+-- carrying any of them. NULL disables either filter.
+SELECT w.id, w.date, w.notes, w.workout_focus, w.created_at, w.updated_at
+FROM workout w
+WHERE w.user_id = sqlc.arg('user_id')
+  AND (
+      sqlc.narg('tags')::TEXT[] IS NULL
+      OR (
+          SELECT COUNT(DISTINCT wt.name)
+          FROM workout_tag_link wtl
+          JOIN workout_tag wt ON wt.id = wtl.tag_id
+          WHERE wtl.workout_id = w.id
+            AND wt.name = ANY(sqlc.narg('tags')::TEXT[])
+      ) = CARDINALITY(sqlc.narg('tags')::TEXT[])
+  )
+  AND (
+      sqlc.narg('exclude_tags')::TEXT[] IS NULL
+      OR NOT EXISTS (
+          SELECT 1
+          FROM workout_tag_link wtl
+          JOIN workout_tag wt ON wt.id = wtl.tag_id
+          WHERE wtl.workout_id = w.id
+            AND wt.name = ANY(sqlc.narg('exclude_tags')::TEXT[])
+      )
+  )
+ORDER BY w.date DESC;
 
 -- name: ListWorkoutFocusTemplates :many
 WITH ranked_focus_workouts AS (
@@ -53,8 +78,18 @@ SELECT
         WHERE s.exercise_id = e.id
           AND s.user_id = e.user_id
           AND s.set_type = 'working'
-    ) AS best_e1rm
+    ) AS best_e1rm,
+    note.notes AS notes,
+    note.settings AS settings,
+    note.created_at AS notes_updated_at
 FROM exercise e
+LEFT JOIN LATERAL (
+    SELECT n.notes, n.settings, n.created_at
+    FROM exercise_note n
+    WHERE n.exercise_id = e.id AND n.user_id = e.user_id
+    ORDER BY n.created_at DESC, n.id DESC
+    LIMIT 1
+) note ON true
 WHERE e.id = $1 AND e.user_id = $2;
 
 -- name: ListExercises :many
@@ -79,6 +114,7 @@ SELECT
     s.weight,
     s.reps,
     s.set_type,
+    s.notes as set_notes,
     e.id as exercise_id,
     e.name as exercise_name,
     s.exercise_order,
@@ -90,129 +126,35 @@ JOIN workout w ON w.id = s.workout_id
 WHERE s.exercise_id = $1 AND s.user_id = $2
 ORDER BY w.date DESC, s.exercise_order, s.set_order, s.created_at, s.id;
 
--- name: GetExerciseMetricsHistoryRawWeek :many
-WITH working_sets AS (
-    SELECT
-        w.id AS workout_id,
-        w.date::date AS workout_day,
-        COALESCE(s.weight, 0)::numeric AS weight,
-        s.reps AS reps,
-        (COALESCE(s.weight, 0)::numeric * s.reps::numeric) AS volume,
-        (COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) AS e1rm,
-        e.historical_1rm AS historical_1rm,
-        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY w.id) AS session_best_e1rm
-    FROM "set" s
-    JOIN workout w ON w.id = s.workout_id
-    JOIN exercise e ON e.id = s.exercise_id
-    WHERE s.exercise_id = $1
-      AND s.user_id = $2
-      AND s.set_type = 'working'
-),
-end_day AS (
-    SELECT MAX(workout_day) AS end_day
-    FROM working_sets
-),
-filtered AS (
-    SELECT *
-    FROM working_sets, end_day
-    WHERE workout_day >= end_day - interval '7 days'
-),
-workout_metrics AS (
-    SELECT
-        workout_id,
-        MIN(workout_day)::date AS workout_day,
-        COALESCE(MAX(session_best_e1rm), 0)::float8 AS session_best_e1rm,
-        COALESCE(AVG(e1rm), 0)::float8 AS session_avg_e1rm,
-        COALESCE(AVG(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_avg_intensity,
-        COALESCE(MAX(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_best_intensity,
-        COALESCE(SUM(volume), 0)::float8 AS total_volume_working
-    FROM filtered
-    GROUP BY workout_id
-)
-SELECT workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working
-FROM workout_metrics
-ORDER BY workout_day ASC;
-
--- name: GetExerciseMetricsHistoryRawMonth :many
-WITH working_sets AS (
-    SELECT
-        w.id AS workout_id,
-        w.date::date AS workout_day,
-        COALESCE(s.weight, 0)::numeric AS weight,
-        s.reps AS reps,
-        (COALESCE(s.weight, 0)::numeric * s.reps::numeric) AS volume,
-        (COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) AS e1rm,
-        e.historical_1rm AS historical_1rm,
-        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY w.id) AS session_best_e1rm
-    FROM "set" s
-    JOIN workout w ON w.id = s.workout_id
-    JOIN exercise e ON e.id = s.exercise_id
-    WHERE s.exercise_id = $1
-      AND s.user_id = $2
-      AND s.set_type = 'working'
-),
-end_day AS (
-    SELECT MAX(workout_day) AS end_day
-    FROM working_sets
-),
-filtered AS (
-    SELECT *
-    FROM working_sets, end_day
-    WHERE workout_day >= end_day - interval '30 days'
-),
-workout_metrics AS (
-    SELECT
-        workout_id,
-        MIN(workout_day)::date AS workout_day,
-        COALESCE(MAX(session_best_e1rm), 0)::float8 AS session_best_e1rm,
-        COALESCE(AVG(e1rm), 0)::float8 AS session_avg_e1rm,
-        COALESCE(AVG(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_avg_intensity,
-        COALESCE(MAX(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_best_intensity,
-        COALESCE(SUM(volume), 0)::float8 AS total_volume_working
-    FROM filtered
-    GROUP BY workout_id
-)
-SELECT workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working
-FROM workout_metrics
-ORDER BY workout_day ASC;
-
--- name: GetExerciseMetricsHistoryRaw6M :many
+-- name: GetExerciseMetricsHistoryRaw :many
+-- Per-workout working-set metrics for each requested exercise. lookback keeps
+-- workouts within the interval before the latest workout day across all of
+-- them; from_day and to_day are inclusive local days. Any bound left NULL is
+-- not applied.
 WITH working_sets AS (
     SELECT
+        s.exercise_id AS exercise_id,
         w.id AS workout_id,
-        w.date::date AS workout_day,
+        (w.date AT TIME ZONE COALESCE(u.timezone, 'UTC'))::date AS workout_day,
         COALESCE(s.weight, 0)::numeric AS weight,
         s.reps AS reps,
         (COALESCE(s.weight, 0)::numeric * s.reps::numeric) AS volume,
         (COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) AS e1rm,
         e.historical_1rm AS historical_1rm,
-        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY w.id) AS session_best_e1rm
+        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY s.exercise_id, w.id) AS session_best_e1rm
     FROM "set" s
     JOIN workout w ON w.id = s.workout_id
     JOIN exercise e ON e.id = s.exercise_id
-    WHERE s.exercise_id = $1
-      AND s.user_id = $2
+    JOIN users u ON u.user_id = s.user_id
+    WHERE s.exercise_id = ANY(sqlc.arg(exercise_ids)::int[])
+      AND s.user_id = sqlc.arg(user_id)
       AND s.set_type = 'working'
+      AND NOT EXISTS (
+          SELECT 1
+          FROM workout_tag_link wtl
+          JOIN workout_tag wt ON wt.id = wtl.tag_id
+          WHERE wtl.workout_id = w.id AND wt.name = 'deload'
+      )
 ),
 end_day AS (
     SELECT MAX(workout_day) AS end_day
@@ -221,10 +163,13 @@ end_day AS (
 filtered AS (
     SELECT *
     FROM working_sets, end_day
-    WHERE workout_day >= end_day - interval '6 months'
+    WHERE (sqlc.narg('lookback')::interval IS NULL OR workout_day >= end_day - sqlc.narg('lookback')::interval)
+      AND (sqlc.narg('from_day')::date IS NULL OR workout_day >= sqlc.narg('from_day')::date)
+      AND (sqlc.narg('to_day')::date IS NULL OR workout_day <= sqlc.narg('to_day')::date)
 ),
 workout_metrics AS (
     SELECT
+        exercise_id,
         workout_id,
         MIN(workout_day)::date AS workout_day,
         COALESCE(MAX(session_best_e1rm), 0)::float8 AS session_best_e1rm,
@@ -241,110 +186,48 @@ workout_metrics AS (
                 THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
             END
         ), 0)::float8 AS session_best_intensity,
-        COALESCE(SUM(volume), 0)::float8 AS total_volume_working
+        COALESCE(SUM(volume), 0)::float8 AS total_volume_working,
+        COUNT(*)::int AS working_set_count
     FROM filtered
-    GROUP BY workout_id
+    GROUP BY exercise_id, workout_id
 )
-SELECT workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working
+SELECT exercise_id, workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working, working_set_count
 FROM workout_metrics
-ORDER BY workout_day ASC, workout_id ASC;
+ORDER BY workout_day ASC, workout_id ASC, exercise_id ASC;
 
--- name: GetExerciseMetricsHistoryRawYear :many
-WITH working_sets AS (
-    SELECT
-        w.id AS workout_id,
-        w.date::date AS workout_day,
-        COALESCE(s.weight, 0)::numeric AS weight,
-        s.reps AS reps,
-        (COALESCE(s.weight, 0)::numeric * s.reps::numeric) AS volume,
-        (COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) AS e1rm,
-        e.historical_1rm AS historical_1rm,
-        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY w.id) AS session_best_e1rm
-    FROM "set" s
-    JOIN workout w ON w.id = s.workout_id
-    JOIN exercise e ON e.id = s.exercise_id
-    WHERE s.exercise_id = $1
-      AND s.user_id = $2
-      AND s.set_type = 'working'
-),
-end_day AS (
-    SELECT MAX(workout_day) AS end_day
-    FROM working_sets
-),
-filtered AS (
-    SELECT *
-    FROM working_sets, end_day
-    WHERE workout_day >= end_day - interval '1 year'
-),
-workout_metrics AS (
-    SELECT
-        workout_id,
-        MIN(workout_day)::date AS workout_day,
-        COALESCE(MAX(session_best_e1rm), 0)::float8 AS session_best_e1rm,
-        COALESCE(AVG(e1rm), 0)::float8 AS session_avg_e1rm,
-        COALESCE(AVG(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_avg_intensity,
-        COALESCE(MAX(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_best_intensity,
-        COALESCE(SUM(volume), 0)::float8 AS total_volume_working
-    FROM filtered
-    GROUP BY workout_id
-)
-SELECT workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working
-FROM workout_metrics
-ORDER BY workout_day ASC, workout_id ASC;
+-- name: ListExerciseSessionBestsSince :many
+-- Session-best Epley e1RM per workout for every exercise, using the same
+-- working-set formula and local-day bucketing as GetExerciseMetricsHistoryRaw.
+SELECT
+    s.exercise_id,
+    e.name AS exercise_name,
+    w.id AS workout_id,
+    (w.date AT TIME ZONE COALESCE(u.timezone, 'UTC'))::date AS workout_day,
+    MAX(COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))::float8 AS session_best_e1rm,
+    AVG(s.reps)::float8 AS avg_reps
+FROM "set" s
+JOIN workout w ON w.id = s.workout_id
+JOIN exercise e ON e.id = s.exercise_id
+JOIN users u ON u.user_id = s.user_id
+WHERE s.user_id = $1
+  AND s.set_type = 'working'
+  AND w.date >= $2
+GROUP BY s.exercise_id, e.name, w.id, workout_day
+ORDER BY s.exercise_id ASC, workout_day ASC, w.id ASC;
 
--- name: GetExerciseMetricsHistoryRawAll :many
-WITH working_sets AS (
-    SELECT
-        w.id AS workout_id,
-        w.date::date AS workout_day,
-        COALESCE(s.weight, 0)::numeric AS weight,
-        s.reps AS reps,
-        (COALESCE(s.weight, 0)::numeric * s.reps::numeric) AS volume,
-        (COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) AS e1rm,
-        e.historical_1rm AS historical_1rm,
-        MAX((COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))) OVER (PARTITION BY w.id) AS session_best_e1rm
-    FROM "set" s
-    JOIN workout w ON w.id = s.workout_id
-    JOIN exercise e ON e.id = s.exercise_id
-    WHERE s.exercise_id = $1
-      AND s.user_id = $2
-      AND s.set_type = 'working'
-),
-workout_metrics AS (
-    SELECT
-        workout_id,
-        MIN(workout_day)::date AS workout_day,
-        COALESCE(MAX(session_best_e1rm), 0)::float8 AS session_best_e1rm,
-        COALESCE(AVG(e1rm), 0)::float8 AS session_avg_e1rm,
-        COALESCE(AVG(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_avg_intensity,
-        COALESCE(MAX(
-            CASE
-                WHEN (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) > 0
-                THEN (weight / (CASE WHEN historical_1rm > 0 THEN historical_1rm ELSE session_best_e1rm END) * 100)
-            END
-        ), 0)::float8 AS session_best_intensity,
-        COALESCE(SUM(volume), 0)::float8 AS total_volume_working
-    FROM working_sets
-    GROUP BY workout_id
-)
-SELECT workout_id, workout_day, session_best_e1rm, session_avg_e1rm, session_avg_intensity, session_best_intensity, total_volume_working
-FROM workout_metrics
-ORDER BY workout_day ASC, workout_id ASC;
+-- name: ListWorkoutIDsForComparison :many
+-- Newest first, so the chat can pair a session with the one before it.
+SELECT w.id
+FROM workout w
+WHERE w.user_id = sqlc.arg(user_id)
+  AND (sqlc.narg(start_date)::timestamptz IS NULL OR w.date >= sqlc.narg(start_date)::timestamptz)
+  AND (sqlc.narg(end_date)::timestamptz IS NULL OR w.date <= sqlc.narg(end_date)::timestamptz)
+  AND (
+      NULLIF(sqlc.narg(workout_focus)::text, '') IS NULL
+      OR w.workout_focus ILIKE '%' || sqlc.narg(workout_focus)::text || '%'
+  )
+ORDER BY w.date DESC, w.id DESC
+LIMIT sqlc.arg(row_limit);
 
 -- name: ListWorkoutsWithSetsForChat :many
 WITH matching_workouts AS (
@@ -382,7 +265,8 @@ SELECT
     s.set_order,
     s.weight,
     s.reps,
-    s.set_type
+    s.set_type,
+    s.notes AS set_notes
 FROM matching_workouts mw
 JOIN workout w ON w.id = mw.id
 LEFT JOIN "set" s ON s.workout_id = w.id
@@ -602,16 +486,21 @@ SET
     updated_at = NOW()
 WHERE id = $1 AND user_id = $4;
 
--- name: UpdateExerciseHistorical1RMFromWorkoutIfBetter :exec
-UPDATE exercise
+-- name: UpdateExerciseHistorical1RMFromWorkoutIfBetter :one
+-- Returns the exercise when the value was raised; previous_historical_1rm is
+-- read from the pre-update row so callers can tell a first value from a PR.
+UPDATE exercise e
 SET
     historical_1rm = $2,
     historical_1rm_updated_at = NOW(),
     historical_1rm_source_workout_id = $3,
     updated_at = NOW()
-WHERE id = $1
-  AND user_id = $4
-  AND (historical_1rm IS NULL OR historical_1rm < $2);
+FROM exercise previous
+WHERE e.id = $1
+  AND e.user_id = $4
+  AND previous.id = e.id
+  AND (e.historical_1rm IS NULL OR e.historical_1rm < $2)
+RETURNING e.name, previous.historical_1rm AS previous_historical_1rm;
 
 -- name: ListExercisesWithHistorical1RMSourceWorkout :many
 SELECT id
@@ -664,8 +553,8 @@ ORDER BY e1rm DESC, workout_date DESC, workout_id DESC
 LIMIT 1;
 
 -- name: CreateSet :one
-INSERT INTO "set" (exercise_id, workout_id, weight, reps, set_type, user_id, exercise_order, set_order)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO "set" (exercise_id, workout_id, weight, reps, set_type, user_id, exercise_order, set_order, notes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING id;
 
 -- Complex queries for joining data
@@ -675,10 +564,18 @@ SELECT
     w.date as workout_date,
     w.notes as workout_notes,
     w.workout_focus as workout_focus,
+    ARRAY(
+        SELECT wt.name
+        FROM workout_tag_link wtl
+        JOIN workout_tag wt ON wt.id = wtl.tag_id
+        WHERE wtl.workout_id = w.id
+        ORDER BY wt.name
+    )::TEXT[] as workout_tags,
     s.id as set_id,
     s.weight,
     s.reps,
     s.set_type,
+    s.notes as set_notes,
     e.id as exercise_id,
     e.name as exercise_name,
     s.exercise_order,
@@ -695,10 +592,23 @@ SELECT id, name FROM exercise WHERE name = $1 AND user_id = $2;
 
 -- User queries
 -- name: GetUser :one
-SELECT id, user_id, created_at FROM users WHERE id = $1;
+SELECT id, user_id, created_at, timezone FROM users WHERE id = $1;
 
 -- name: GetUserByUserID :one
-SELECT id, user_id, created_at FROM users WHERE user_id = $1 LIMIT 1;
+SELECT id, user_id, created_at, timezone FROM users WHERE user_id = $1 LIMIT 1;
+
+-- name: UpdateUserTimezone :one
+UPDATE users
+SET timezone = $2
+WHERE user_id = $1
+RETURNING id, user_id, created_at, timezone;
+
+-- name: SetUserTimezoneIfUnset :exec
+-- Records a client-inferred timezone without overriding one the user chose.
+UPDATE users
+SET timezone = $2
+WHERE user_id = $1
+  AND timezone IS NULL;
 
 -- name: CreateUser :one
 INSERT INTO users (user_id)
@@ -753,7 +663,7 @@ FROM stripe_customers
 WHERE stripe_customer_id = $1;
 
 -- name: GetBillingUserForUpdate :one
-SELECT id, user_id, created_at
+SELECT id, user_id, created_at, timezone
 FROM users
 WHERE user_id = $1
 FOR UPDATE;
@@ -859,6 +769,25 @@ ORDER BY
     created_at DESC
 LIMIT 1;
 
+-- name: GetStripeSubscription :one
+SELECT
+    stripe_subscription_id,
+    user_id,
+    stripe_customer_id,
+    stripe_price_id,
+    stripe_event_created_at,
+    status,
+    cancel_at_period_end,
+    cancel_at,
+    current_period_start,
+    current_period_end,
+    trial_start,
+    trial_end,
+    created_at,
+    updated_at
+FROM stripe_subscriptions
+WHERE stripe_subscription_id = $1;
+
 -- name: HasProcessedStripeWebhookEvent :one
 SELECT EXISTS (
     SELECT 1
@@ -996,9 +925,18 @@ SELECT
     s.reps,
     s.exercise_order,
     s.set_order,
-    s.created_at
+    s.created_at,
+    note.notes AS exercise_notes,
+    note.settings AS exercise_settings
 FROM "set" s
 JOIN workout w ON w.id = s.workout_id
+LEFT JOIN LATERAL (
+    SELECT n.notes, n.settings
+    FROM exercise_note n
+    WHERE n.exercise_id = s.exercise_id AND n.user_id = s.user_id
+    ORDER BY n.created_at DESC, n.id DESC
+    LIMIT 1
+) note ON true
 WHERE s.exercise_id = $1 AND s.user_id = $2
 ORDER BY w.date DESC, s.set_order DESC
 LIMIT 3;
@@ -1044,11 +982,17 @@ ORDER BY workout_focus;
 -- The WHERE clause filters by user_id (parameter $1), ensuring only the authenticated user's
 -- workouts are retrieved. RLS policies on the workout table provide defense-in-depth.
 -- The GROUP BY on date and JSON_AGG of workout metadata ensures no cross-user data leakage.
-WITH workout_totals AS (
+-- Days are bucketed in the user's stored timezone, falling back to UTC.
+-- Tags and exclude_tags filter workouts the same way as ListWorkouts.
+WITH user_tz AS (
+    SELECT COALESCE((SELECT timezone FROM users WHERE user_id = sqlc.arg('user_id')), 'UTC') AS tz
+),
+workout_totals AS (
     SELECT
         w.id,
         w.date,
         w.workout_focus,
+        (w.date AT TIME ZONE user_tz.tz)::DATE AS local_day,
         COUNT(s.id) FILTER (WHERE s.set_type = 'working')::INTEGER AS working_set_count,
         COALESCE(
             SUM(
@@ -1060,24 +1004,116 @@ WITH workout_totals AS (
             0
         )::FLOAT8 AS volume
     FROM workout w
+    CROSS JOIN user_tz
     LEFT JOIN "set" s ON s.workout_id = w.id
-    WHERE w.user_id = $1
-      AND w.date >= CURRENT_DATE - INTERVAL '52 weeks'
-    GROUP BY w.id, w.date, w.workout_focus
+    WHERE w.user_id = sqlc.arg('user_id')
+      AND w.date >= ((CURRENT_TIMESTAMP AT TIME ZONE user_tz.tz)::DATE - INTERVAL '52 weeks') AT TIME ZONE user_tz.tz
+      AND (
+          sqlc.narg('tags')::TEXT[] IS NULL
+          OR (
+              SELECT COUNT(DISTINCT wt.name)
+              FROM workout_tag_link wtl
+              JOIN workout_tag wt ON wt.id = wtl.tag_id
+              WHERE wtl.workout_id = w.id
+                AND wt.name = ANY(sqlc.narg('tags')::TEXT[])
+          ) = CARDINALITY(sqlc.narg('tags')::TEXT[])
+      )
+      AND (
+          sqlc.narg('exclude_tags')::TEXT[] IS NULL
+          OR NOT EXISTS (
+              SELECT 1
+              FROM workout_tag_link wtl
+              JOIN workout_tag wt ON wt.id = wtl.tag_id
+              WHERE wtl.workout_id = w.id
+                AND wt.name = ANY(sqlc.narg('exclude_tags')::TEXT[])
+          )
+      )
+    GROUP BY w.id, w.date, w.workout_focus, user_tz.tz
 )
 SELECT
-    DATE_TRUNC('day', wt.date)::DATE as date,
+    wt.local_day::DATE as date,
     SUM(wt.working_set_count)::INTEGER as count,
     JSON_AGG(JSONB_BUILD_OBJECT(
         'id', wt.id,
         'time', wt.date,
         'focus', wt.workout_focus,
-        'volume', wt.volume
+        'volume', wt.volume,
+        'tags', ARRAY(
+            SELECT t.name
+            FROM workout_tag_link tl
+            JOIN workout_tag t ON t.id = tl.tag_id
+            WHERE tl.workout_id = wt.id
+            ORDER BY t.name
+        )
     ) ORDER BY wt.date, wt.id) as workouts
 FROM workout_totals wt
-GROUP BY DATE_TRUNC('day', wt.date)
+GROUP BY wt.local_day
 ORDER BY date;
 
+-- name: ListWorkoutDatesForConsistency :many
+SELECT date
+FROM workout
+WHERE user_id = $1
+ORDER BY date, id;
+
+-- name: ListWorkoutLoadsSince :many
+-- Per-session working-set tonnage and hard-set count for training load analytics.
+SELECT
+    w.id,
+    w.date,
+    COALESCE(SUM(COALESCE(s.weight, 0) * s.reps) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS tonnage,
+    COUNT(s.id) FILTER (WHERE s.set_type = 'working')::int AS working_sets
+FROM workout w
+LEFT JOIN "set" s ON s.workout_id = w.id AND s.user_id = w.user_id
+WHERE w.user_id = $1
+  AND w.date >= $2
+GROUP BY w.id, w.date
+ORDER BY w.date, w.id;
+
+-- name: ListVolumeByPeriod :many
+-- Tonnage, set and rep totals per local period and set type for volume
+-- analytics. period is a date_trunc unit (day, week or month) applied in
+-- timezone; start_at is inclusive and end_at exclusive. focus matches the
+-- workout focus case-insensitively; tags and exclude_tags filter workouts the
+-- same way as ListWorkouts.
+SELECT
+    date_trunc(sqlc.arg('period')::TEXT, w.date AT TIME ZONE sqlc.arg('timezone')::TEXT)::DATE AS period_start,
+    s.set_type,
+    COUNT(s.id)::INTEGER AS sets,
+    COALESCE(SUM(s.reps), 0)::INTEGER AS reps,
+    COALESCE(SUM(COALESCE(s.weight, 0)::NUMERIC * s.reps::NUMERIC), 0)::FLOAT8 AS tonnage
+FROM workout w
+JOIN "set" s ON s.workout_id = w.id AND s.user_id = w.user_id
+WHERE w.user_id = sqlc.arg('user_id')
+  AND w.date >= sqlc.arg('start_at')::TIMESTAMPTZ
+  AND w.date < sqlc.arg('end_at')::TIMESTAMPTZ
+  AND (
+      sqlc.narg('focus')::TEXT IS NULL
+      OR LOWER(BTRIM(w.workout_focus)) = LOWER(BTRIM(sqlc.narg('focus')::TEXT))
+  )
+  AND (
+      sqlc.narg('tags')::TEXT[] IS NULL
+      OR (
+          SELECT COUNT(DISTINCT wt.name)
+          FROM workout_tag_link wtl
+          JOIN workout_tag wt ON wt.id = wtl.tag_id
+          WHERE wtl.workout_id = w.id
+            AND wt.name = ANY(sqlc.narg('tags')::TEXT[])
+      ) = CARDINALITY(sqlc.narg('tags')::TEXT[])
+  )
+  AND (
+      sqlc.narg('exclude_tags')::TEXT[] IS NULL
+      OR NOT EXISTS (
+          SELECT 1
+          FROM workout_tag_link wtl
+          JOIN workout_tag wt ON wt.id = wtl.tag_id
+          WHERE wtl.workout_id = w.id
+            AND wt.name = ANY(sqlc.narg('exclude_tags')::TEXT[])
+      )
+  )
+GROUP BY period_start, s.set_type
+ORDER BY period_start, s.set_type;
+
 -- name: LockAIChatUserMutation :exec
 -- Serializes conversation creation, stream start, and deletion for one owner.
 SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg(user_id)::text, 250));
@@ -1714,3 +1750,851 @@ RETURNING
     updated_at,
     started_at,
     completed_at;
+
+-- Body metric queries
+
+-- name: CreateBodyMetricEntry :one
+INSERT INTO body_metric_entry (
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING
+    id,
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes,
+    created_at,
+    updated_at;
+
+-- name: GetBodyMetricEntry :one
+SELECT
+    id,
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes,
+    created_at,
+    updated_at
+FROM body_metric_entry
+WHERE id = $1 AND user_id = $2;
+
+-- name: ListBodyMetricEntries :many
+SELECT
+    id,
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes,
+    created_at,
+    updated_at
+FROM body_metric_entry
+WHERE user_id = sqlc.arg(user_id)
+  AND (sqlc.narg(start_date)::date IS NULL OR measured_on >= sqlc.narg(start_date)::date)
+  AND (sqlc.narg(end_date)::date IS NULL OR measured_on <= sqlc.narg(end_date)::date)
+ORDER BY measured_on DESC, id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListBodyMetricEntriesSince :many
+SELECT
+    id,
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes,
+    created_at,
+    updated_at
+FROM body_metric_entry
+WHERE user_id = $1
+  AND measured_on >= $2
+ORDER BY measured_on ASC, id ASC;
+
+-- name: UpdateBodyMetricEntry :one
+UPDATE body_metric_entry
+SET
+    measured_on = $3,
+    bodyweight = $4,
+    body_fat_percent = $5,
+    neck = $6,
+    chest = $7,
+    waist = $8,
+    hips = $9,
+    arm = $10,
+    thigh = $11,
+    notes = $12,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND user_id = $2
+RETURNING
+    id,
+    user_id,
+    measured_on,
+    bodyweight,
+    body_fat_percent,
+    neck,
+    chest,
+    waist,
+    hips,
+    arm,
+    thigh,
+    notes,
+    created_at,
+    updated_at;
+
+-- name: DeleteBodyMetricEntry :execrows
+DELETE FROM body_metric_entry
+WHERE id = $1 AND user_id = $2;
+
+-- name: GetLatestBodyweight :one
+SELECT measured_on, bodyweight
+FROM body_metric_entry
+WHERE user_id = $1
+  AND bodyweight IS NOT NULL
+ORDER BY measured_on DESC, id DESC
+LIMIT 1;
+
+-- Strength profile queries
+
+-- name: GetUserStrengthProfile :one
+SELECT
+    user_id,
+    sex,
+    squat_exercise_id,
+    bench_exercise_id,
+    deadlift_exercise_id,
+    created_at,
+    updated_at
+FROM user_strength_profile
+WHERE user_id = $1;
+
+-- name: UpsertUserStrengthProfile :one
+INSERT INTO user_strength_profile (
+    user_id,
+    sex,
+    squat_exercise_id,
+    bench_exercise_id,
+    deadlift_exercise_id
+)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    sex = EXCLUDED.sex,
+    squat_exercise_id = EXCLUDED.squat_exercise_id,
+    bench_exercise_id = EXCLUDED.bench_exercise_id,
+    deadlift_exercise_id = EXCLUDED.deadlift_exercise_id,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING
+    user_id,
+    sex,
+    squat_exercise_id,
+    bench_exercise_id,
+    deadlift_exercise_id,
+    created_at,
+    updated_at;
+
+-- name: ListBodyweightReadings :many
+SELECT measured_on, bodyweight
+FROM body_metric_entry
+WHERE user_id = $1
+  AND bodyweight IS NOT NULL
+ORDER BY measured_on ASC, id ASC;
+
+-- Best Epley e1RM per local workout day for each requested exercise,
+-- matching the working-set formula used by the exercise metrics queries.
+-- name: ListSessionBestE1rmForExercises :many
+SELECT
+    s.exercise_id,
+    (w.date AT TIME ZONE COALESCE(u.timezone, 'UTC'))::date AS workout_day,
+    MAX(COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30))::float8 AS session_best_e1rm
+FROM "set" s
+JOIN workout w ON w.id = s.workout_id AND w.user_id = s.user_id
+JOIN users u ON u.user_id = s.user_id
+WHERE s.user_id = sqlc.arg(user_id)
+  AND s.exercise_id = ANY(sqlc.arg(exercise_ids)::int[])
+  AND s.set_type = 'working'
+GROUP BY s.exercise_id, workout_day
+ORDER BY workout_day ASC, s.exercise_id ASC;
+
+-- Report queries
+
+-- name: ListReportWorkouts :many
+-- Per-workout working-set count and tonnage for one report window.
+SELECT
+    w.id,
+    w.date,
+    w.workout_focus,
+    COALESCE(SUM(COALESCE(s.weight, 0) * s.reps) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS tonnage,
+    COUNT(s.id) FILTER (WHERE s.set_type = 'working')::int AS working_sets
+FROM workout w
+LEFT JOIN "set" s ON s.workout_id = w.id AND s.user_id = w.user_id
+WHERE w.user_id = sqlc.arg(user_id)
+  AND w.date >= sqlc.arg(start_at)
+  AND w.date < sqlc.arg(end_at)
+GROUP BY w.id, w.date, w.workout_focus
+ORDER BY w.date, w.id;
+
+-- name: ListReportExerciseProgress :many
+-- Best working-set Epley e1RM per exercise trained in the report window,
+-- alongside the best from any earlier session (0 when there is none).
+SELECT
+    e.id AS exercise_id,
+    e.name AS exercise_name,
+    COUNT(s.id) FILTER (WHERE w.date >= sqlc.arg(start_at))::int AS period_sets,
+    COALESCE(MAX(COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) FILTER (WHERE w.date >= sqlc.arg(start_at)), 0)::float8 AS period_best_e1rm,
+    COALESCE(MAX(COALESCE(s.weight, 0)::numeric * (1 + s.reps::numeric / 30)) FILTER (WHERE w.date < sqlc.arg(start_at)), 0)::float8 AS prior_best_e1rm
+FROM "set" s
+JOIN workout w ON w.id = s.workout_id AND w.user_id = s.user_id
+JOIN exercise e ON e.id = s.exercise_id AND e.user_id = s.user_id
+WHERE s.user_id = sqlc.arg(user_id)
+  AND s.set_type = 'working'
+  AND w.date < sqlc.arg(end_at)
+GROUP BY e.id, e.name
+HAVING COUNT(s.id) FILTER (WHERE w.date >= sqlc.arg(start_at)) > 0
+ORDER BY e.name, e.id;
+
+-- name: ListHistorical1RMUpdatesBetween :many
+-- Exercises whose stored historical 1RM was raised inside the report window.
+SELECT
+    id,
+    name,
+    COALESCE(historical_1rm, 0)::float8 AS historical_1rm,
+    historical_1rm_updated_at,
+    historical_1rm_source_workout_id
+FROM exercise
+WHERE user_id = sqlc.arg(user_id)
+  AND historical_1rm IS NOT NULL
+  AND historical_1rm_updated_at >= sqlc.arg(start_at)
+  AND historical_1rm_updated_at < sqlc.arg(end_at)
+ORDER BY historical_1rm_updated_at, id;
+
+-- Calendar feed queries
+
+-- name: GetCalendarFeed :one
+SELECT * FROM calendar_feed
+WHERE user_id = $1;
+
+-- name: GetCalendarFeedByTokenHash :one
+-- Resolves a feed token before any user context exists. The caller sets
+-- app.current_token_hash so the select policy exposes only this row.
+SELECT * FROM calendar_feed
+WHERE token_hash = $1;
+
+-- name: UpsertCalendarFeed :one
+INSERT INTO calendar_feed (user_id, token_hash)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET token_hash = EXCLUDED.token_hash,
+    rotated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: DeleteCalendarFeed :execrows
+DELETE FROM calendar_feed
+WHERE user_id = $1;
+
+-- name: ListCalendarFeedWorkouts :many
+-- Every workout with its working-set volume, for publishing as calendar events.
+SELECT
+    w.id,
+    w.date,
+    w.workout_focus,
+    w.notes,
+    w.created_at,
+    w.updated_at,
+    COALESCE(SUM(COALESCE(s.weight, 0) * s.reps) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS tonnage,
+    COUNT(s.id) FILTER (WHERE s.set_type = 'working')::int AS working_sets
+FROM workout w
+LEFT JOIN "set" s ON s.workout_id = w.id AND s.user_id = w.user_id
+WHERE w.user_id = $1
+GROUP BY w.id
+ORDER BY w.date, w.id;
+
+-- name: ListCalendarFeedExercises :many
+-- Per-workout exercise summary lines in logged order.
+SELECT
+    s.workout_id,
+    e.name AS exercise_name,
+    MIN(s.exercise_order)::int AS exercise_order,
+    COUNT(s.id)::int AS total_sets,
+    COUNT(s.id) FILTER (WHERE s.set_type = 'working')::int AS working_sets,
+    COALESCE(MAX(s.weight) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS top_weight
+FROM "set" s
+JOIN exercise e ON e.id = s.exercise_id AND e.user_id = s.user_id
+WHERE s.user_id = $1
+GROUP BY s.workout_id, e.id, e.name
+ORDER BY s.workout_id, exercise_order, e.name;
+
+-- Workout share queries
+
+-- name: UpsertWorkoutShare :one
+-- Issues a share link for a workout the user owns. Any previous link for the
+-- workout is replaced, so re-sharing revokes the old URL.
+INSERT INTO workout_share (workout_id, user_id, token_hash, expires_at)
+SELECT w.id, w.user_id, sqlc.arg(token_hash)::text, sqlc.narg(expires_at)::timestamptz
+FROM workout w
+WHERE w.id = sqlc.arg(workout_id) AND w.user_id = sqlc.arg(user_id)
+ON CONFLICT (workout_id) DO UPDATE
+SET token_hash = EXCLUDED.token_hash,
+    expires_at = EXCLUDED.expires_at,
+    created_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: DeleteWorkoutShare :execrows
+DELETE FROM workout_share
+WHERE workout_id = $1 AND user_id = $2;
+
+-- name: GetActiveWorkoutShareByTokenHash :one
+-- Resolves a share token before any user context exists. The caller sets
+-- app.current_token_hash so the select policy exposes only this row.
+-- Expired links do not resolve.
+SELECT * FROM workout_share
+WHERE token_hash = $1
+  AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP);
+
+-- Coaching queries
+
+-- name: CreateCoachingInvitation :one
+INSERT INTO coaching_link (coach_user_id, scopes, invite_code_hash, invite_expires_at)
+VALUES (sqlc.arg(coach_user_id), sqlc.arg(scopes), sqlc.arg(invite_code_hash)::text, sqlc.arg(invite_expires_at)::timestamptz)
+RETURNING *;
+
+-- name: ListPendingCoachingInvitations :many
+SELECT * FROM coaching_link
+WHERE coach_user_id = $1
+  AND athlete_user_id IS NULL
+  AND invite_expires_at > CURRENT_TIMESTAMP
+ORDER BY created_at DESC, id DESC;
+
+-- name: AcceptCoachingInvitation :one
+-- Claims a pending, unexpired invitation for the athlete. Coaches cannot
+-- accept their own invitations. The caller sets app.current_token_hash to
+-- the code hash so the policies expose the pending row.
+UPDATE coaching_link
+SET athlete_user_id = sqlc.arg(athlete_user_id)::text,
+    accepted_at = CURRENT_TIMESTAMP,
+    invite_code_hash = NULL,
+    invite_expires_at = NULL
+WHERE invite_code_hash = sqlc.arg(invite_code_hash)::text
+  AND athlete_user_id IS NULL
+  AND invite_expires_at > CURRENT_TIMESTAMP
+  AND coach_user_id <> sqlc.arg(athlete_user_id)::text
+RETURNING *;
+
+-- name: ListCoachingAthletes :many
+SELECT * FROM coaching_link
+WHERE coach_user_id = $1 AND athlete_user_id IS NOT NULL
+ORDER BY accepted_at, id;
+
+-- name: ListCoachingCoaches :many
+SELECT * FROM coaching_link
+WHERE athlete_user_id = sqlc.arg(athlete_user_id)::text
+ORDER BY accepted_at, id;
+
+-- name: UpdateCoachingLinkScopes :one
+-- Only the athlete can change what an accepted coach may do.
+UPDATE coaching_link
+SET scopes = sqlc.arg(scopes)
+WHERE id = sqlc.arg(id) AND athlete_user_id = sqlc.arg(athlete_user_id)::text
+RETURNING *;
+
+-- name: DeleteCoachingLink :execrows
+-- Either party can end a link; coaches also use this to cancel invitations.
+DELETE FROM coaching_link
+WHERE id = sqlc.arg(id)
+  AND (coach_user_id = sqlc.arg(user_id) OR athlete_user_id = sqlc.arg(user_id));
+
+-- name: GetCoachingGrant :one
+-- Resolves an X-Act-As request before the athlete's context exists, like the
+-- Stripe customer lookup used by webhooks.
+SELECT l.scopes, u.timezone
+FROM coaching_link l
+JOIN users u ON u.user_id = l.athlete_user_id
+WHERE l.coach_user_id = sqlc.arg(coach_user_id)
+  AND l.athlete_user_id = sqlc.arg(athlete_user_id)::text;
+
+-- name: CreateCoachingSuggestion :one
+-- Only coaches the athlete has granted suggest_workouts can add suggestions.
+INSERT INTO coaching_suggestion (link_id, coach_user_id, athlete_user_id, title, notes, scheduled_on, exercises)
+SELECT l.id, l.coach_user_id, l.athlete_user_id, sqlc.arg(title)::text, sqlc.narg(notes)::text, sqlc.narg(scheduled_on)::date, sqlc.arg(exercises)::jsonb
+FROM coaching_link l
+WHERE l.coach_user_id = sqlc.arg(coach_user_id)
+  AND l.athlete_user_id = sqlc.arg(athlete_user_id)::text
+  AND 'suggest_workouts' = ANY(l.scopes)
+RETURNING *;
+
+-- name: ListCoachingSuggestions :many
+SELECT * FROM coaching_suggestion
+WHERE athlete_user_id = $1 AND dismissed_at IS NULL
+ORDER BY created_at DESC, id DESC;
+
+-- name: DismissCoachingSuggestion :execrows
+UPDATE coaching_suggestion
+SET dismissed_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND athlete_user_id = $2 AND dismissed_at IS NULL;
+
+-- name: SearchUserContent :many
+-- Each branch repeats its table's search index expression. ts_headline wraps
+-- matches in chr(2)/chr(3) so the service can escape the snippet before
+-- turning the markers into highlight tags. Set hits carry their workout_id so
+-- clients can open the workout.
+WITH search AS (
+    SELECT
+        websearch_to_tsquery('english', sqlc.arg(query)::text) AS q,
+        'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=2, MaxWords=24, MinWords=8, FragmentDelimiter=" ... "' AS options
+),
+hits AS (
+    SELECT 'workout' AS kind,
+           w.id,
+           NULL::integer AS conversation_id,
+           w.id AS workout_id,
+           COALESCE(NULLIF(btrim(w.workout_focus), ''), 'Workout') AS title,
+           ts_headline('english', concat_ws(' ', w.workout_focus, w.notes), s.q, s.options) AS snippet,
+           ts_rank(to_tsvector('english', coalesce(w.workout_focus, '') || ' ' || coalesce(w.notes, '')), s.q) AS rank,
+           w.date AS occurred_at
+    FROM workout w, search s
+    WHERE w.user_id = sqlc.arg(user_id)
+      AND to_tsvector('english', coalesce(w.workout_focus, '') || ' ' || coalesce(w.notes, '')) @@ s.q
+    UNION ALL
+    SELECT 'set',
+           st.id,
+           NULL::integer,
+           st.workout_id,
+           e.name,
+           ts_headline('english', st.notes, s.q, s.options),
+           ts_rank(to_tsvector('english', coalesce(st.notes, '')), s.q),
+           w.date
+    FROM "set" st
+    JOIN workout w ON w.id = st.workout_id AND w.user_id = st.user_id
+    JOIN exercise e ON e.id = st.exercise_id AND e.user_id = st.user_id, search s
+    WHERE st.user_id = sqlc.arg(user_id)
+      AND to_tsvector('english', coalesce(st.notes, '')) @@ s.q
+    UNION ALL
+    SELECT 'exercise',
+           e.id,
+           NULL::integer,
+           NULL::integer,
+           e.name,
+           ts_headline('english', e.name, s.q, s.options),
+           ts_rank(to_tsvector('english', e.name), s.q),
+           e.created_at
+    FROM exercise e, search s
+    WHERE e.user_id = sqlc.arg(user_id)
+      AND to_tsvector('english', e.name) @@ s.q
+    UNION ALL
+    SELECT 'conversation',
+           c.id,
+           c.id,
+           NULL::integer,
+           c.title,
+           ts_headline('english', c.title, s.q, s.options),
+           ts_rank(to_tsvector('english', coalesce(c.title, '')), s.q),
+           COALESCE(c.last_message_at, c.created_at)
+    FROM ai_chat_conversation c, search s
+    WHERE c.user_id = sqlc.arg(user_id)
+      AND to_tsvector('english', coalesce(c.title, '')) @@ s.q
+    UNION ALL
+    SELECT 'message',
+           m.id,
+           m.conversation_id,
+           NULL::integer,
+           COALESCE(c.title, 'Conversation'),
+           ts_headline('english', m.content, s.q, s.options),
+           ts_rank(to_tsvector('english', m.content), s.q),
+           m.created_at
+    FROM ai_chat_message m
+    JOIN ai_chat_conversation c ON c.id = m.conversation_id AND c.user_id = m.user_id, search s
+    WHERE m.user_id = sqlc.arg(user_id)
+      AND to_tsvector('english', m.content) @@ s.q
+)
+SELECT kind::text AS kind,
+       id::integer AS id,
+       conversation_id,
+       workout_id,
+       title::text AS title,
+       snippet::text AS snippet,
+       rank::float8 AS rank,
+       occurred_at::timestamptz AS occurred_at
+FROM hits
+WHERE sqlc.narg(kinds)::text[] IS NULL OR kind = ANY(sqlc.narg(kinds)::text[])
+ORDER BY rank DESC, occurred_at DESC, kind ASC, id DESC
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: ListWorkoutTags :many
+SELECT
+    t.id,
+    t.name,
+    COUNT(l.workout_id)::INTEGER AS workout_count,
+    t.created_at,
+    t.updated_at
+FROM workout_tag t
+LEFT JOIN workout_tag_link l ON l.tag_id = t.id
+WHERE t.user_id = $1
+GROUP BY t.id
+ORDER BY t.name;
+
+-- name: CreateWorkoutTag :one
+INSERT INTO workout_tag (user_id, name)
+VALUES ($1, $2)
+RETURNING id, user_id, name, created_at, updated_at;
+
+-- name: RenameWorkoutTag :one
+UPDATE workout_tag
+SET name = $3,
+    updated_at = NOW()
+WHERE id = $1 AND user_id = $2
+RETURNING
+    id,
+    name,
+    (SELECT COUNT(*) FROM workout_tag_link l WHERE l.tag_id = workout_tag.id)::INTEGER AS workout_count,
+    created_at,
+    updated_at;
+
+-- name: DeleteWorkoutTag :execrows
+DELETE FROM workout_tag
+WHERE id = $1 AND user_id = $2;
+
+-- name: UpsertWorkoutTag :one
+-- The no-op update lets RETURNING yield the id of an existing tag.
+INSERT INTO workout_tag (user_id, name)
+VALUES ($1, $2)
+ON CONFLICT (user_id, name) DO UPDATE SET name = EXCLUDED.name
+RETURNING id;
+
+-- name: CreateWorkoutTagLink :exec
+INSERT INTO workout_tag_link (workout_id, tag_id, user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (workout_id, tag_id) DO NOTHING;
+
+-- name: DeleteWorkoutTagLinks :exec
+DELETE FROM workout_tag_link
+WHERE workout_id = $1 AND user_id = $2;
+
+-- name: CreateExerciseNote :one
+-- Selecting from exercise keeps notes from being attached to another user's exercise.
+INSERT INTO exercise_note (exercise_id, user_id, notes, settings)
+SELECT e.id, e.user_id, sqlc.narg(notes)::text, sqlc.narg(settings)::text
+FROM exercise e
+WHERE e.id = sqlc.arg(exercise_id) AND e.user_id = sqlc.arg(user_id)
+RETURNING id, exercise_id, user_id, notes, settings, created_at;
+
+-- name: ListExerciseNoteHistory :many
+SELECT id, exercise_id, user_id, notes, settings, created_at
+FROM exercise_note
+WHERE exercise_id = $1 AND user_id = $2
+ORDER BY created_at DESC, id DESC
+LIMIT $3;
+
+-- name: ListCurrentExerciseNotes :many
+-- Latest non-empty revision per exercise, most recently edited first.
+WITH latest AS (
+    SELECT DISTINCT ON (n.exercise_id)
+        n.exercise_id,
+        n.notes,
+        n.settings,
+        n.created_at
+    FROM exercise_note n
+    WHERE n.user_id = sqlc.arg(user_id)
+    ORDER BY n.exercise_id, n.created_at DESC, n.id DESC
+)
+SELECT e.name AS exercise_name, latest.notes, latest.settings
+FROM latest
+JOIN exercise e ON e.id = latest.exercise_id
+WHERE latest.notes IS NOT NULL OR latest.settings IS NOT NULL
+ORDER BY latest.created_at DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: GetUserEquipment :one
+SELECT
+    user_id,
+    barbell_weights,
+    plates,
+    dumbbell_increment,
+    machine_stack_step,
+    created_at,
+    updated_at
+FROM user_equipment
+WHERE user_id = $1;
+
+-- name: UpsertUserEquipment :one
+INSERT INTO user_equipment (
+    user_id,
+    barbell_weights,
+    plates,
+    dumbbell_increment,
+    machine_stack_step
+)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    barbell_weights = EXCLUDED.barbell_weights,
+    plates = EXCLUDED.plates,
+    dumbbell_increment = EXCLUDED.dumbbell_increment,
+    machine_stack_step = EXCLUDED.machine_stack_step,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING
+    user_id,
+    barbell_weights,
+    plates,
+    dumbbell_increment,
+    machine_stack_step,
+    created_at,
+    updated_at;
+
+-- name: CreateGoal :one
+INSERT INTO goal (user_id, goal_type, exercise_id, target_value, start_value, target_date)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, goal_type, exercise_id, target_value, start_value, target_date, created_at;
+
+-- name: ListGoals :many
+SELECT
+    g.id,
+    g.goal_type,
+    g.exercise_id,
+    e.name AS exercise_name,
+    g.target_value,
+    g.start_value,
+    g.target_date,
+    g.created_at
+FROM goal g
+LEFT JOIN exercise e ON e.id = g.exercise_id
+WHERE g.user_id = $1
+ORDER BY g.created_at, g.id;
+
+-- name: UpdateGoal :one
+-- Changes a goal's target. The type, exercise, start value and created_at
+-- are kept so progress is still measured from when the goal was set.
+UPDATE goal
+SET target_value = sqlc.arg(target_value), target_date = sqlc.arg(target_date)
+WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(user_id)
+RETURNING id, user_id, goal_type, exercise_id, target_value, start_value, target_date, created_at;
+
+-- name: DeleteGoal :execrows
+DELETE FROM goal
+WHERE id = $1 AND user_id = $2;
+
+-- name: ListAchievementWorkoutExercises :many
+-- Per-workout, per-exercise working-set totals for achievement rules. Workouts
+-- without sets are returned once with a NULL exercise so they still count.
+SELECT
+    w.id AS workout_id,
+    w.date,
+    s.exercise_id,
+    e.name AS exercise_name,
+    COALESCE(SUM(COALESCE(s.weight, 0) * s.reps) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS volume,
+    COALESCE(MAX(s.weight) FILTER (WHERE s.set_type = 'working'), 0)::float8 AS top_weight,
+    COALESCE(MAX(s.weight) FILTER (WHERE s.set_type = 'working' AND s.reps >= 5), 0)::float8 AS top_five_rep_weight
+FROM workout w
+LEFT JOIN "set" s ON s.workout_id = w.id AND s.user_id = w.user_id
+LEFT JOIN exercise e ON e.id = s.exercise_id
+WHERE w.user_id = $1
+GROUP BY w.id, w.date, s.exercise_id, e.name
+ORDER BY w.date, w.id, s.exercise_id;
+
+-- name: ListUserAchievements :many
+SELECT achievement_key, workout_id, unlocked_at
+FROM user_achievement
+WHERE user_id = $1
+ORDER BY unlocked_at, id;
+
+-- name: CreateUserAchievement :execrows
+INSERT INTO user_achievement (user_id, achievement_key, workout_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, achievement_key) DO NOTHING;
+
+-- name: CreateNotification :one
+INSERT INTO notification (user_id, kind, title, body, data)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, user_id, kind, title, body, data, read_at, created_at;
+
+-- name: ListNotifications :many
+SELECT id, user_id, kind, title, body, data, read_at, created_at
+FROM notification
+WHERE user_id = sqlc.arg(user_id)
+  AND (NOT sqlc.arg(unread_only)::boolean OR read_at IS NULL)
+  AND (sqlc.narg(before_id)::int IS NULL OR id < sqlc.narg(before_id)::int)
+ORDER BY id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListNotificationsAfter :many
+-- Notifications newer than after_id, oldest first, for the live stream.
+SELECT id, user_id, kind, title, body, data, read_at, created_at
+FROM notification
+WHERE user_id = sqlc.arg(user_id) AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT 100;
+
+-- name: GetLatestNotificationID :one
+SELECT COALESCE(MAX(id), 0)::int
+FROM notification
+WHERE user_id = $1;
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*)
+FROM notification
+WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: MarkNotificationRead :execrows
+UPDATE notification
+SET read_at = COALESCE(read_at, CURRENT_TIMESTAMP)
+WHERE id = $1 AND user_id = $2;
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notification
+SET read_at = CURRENT_TIMESTAMP
+WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: ListWebhookEndpoints :many
+SELECT id, user_id, url, secret, event_types, active, created_at, updated_at
+FROM webhook_endpoint
+WHERE user_id = $1
+ORDER BY id;
+
+-- name: GetWebhookEndpoint :one
+SELECT id, user_id, url, secret, event_types, active, created_at, updated_at
+FROM webhook_endpoint
+WHERE id = $1 AND user_id = $2;
+
+-- name: CountWebhookEndpoints :one
+SELECT COUNT(*)
+FROM webhook_endpoint
+WHERE user_id = $1;
+
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoint (user_id, url, secret, event_types)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, url, secret, event_types, active, created_at, updated_at;
+
+-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoint
+SET url = sqlc.arg(url),
+    event_types = sqlc.arg(event_types),
+    active = sqlc.arg(active),
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(user_id)
+RETURNING id, user_id, url, secret, event_types, active, created_at, updated_at;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoint
+WHERE id = $1 AND user_id = $2;
+
+-- name: FailPendingWebhookDeliveries :execrows
+UPDATE webhook_delivery
+SET status = 'failed', last_error = sqlc.arg(last_error)
+WHERE endpoint_id = sqlc.arg(endpoint_id) AND user_id = sqlc.arg(user_id) AND status = 'pending';
+
+-- name: EnqueueWebhookDeliveries :execrows
+-- Queues one delivery of the event for each active endpoint subscribed to it.
+INSERT INTO webhook_delivery (endpoint_id, user_id, event_id, event_type, payload)
+SELECT id, user_id, sqlc.arg(event_id)::varchar, sqlc.arg(event_type)::varchar, sqlc.arg(payload)::jsonb
+FROM webhook_endpoint
+WHERE user_id = sqlc.arg(user_id)
+  AND active
+  AND sqlc.arg(event_type)::varchar = ANY(event_types);
+
+-- name: CreateWebhookDelivery :one
+-- Queues a delivery to one endpoint that the caller attempts straight away,
+-- so it starts out leased like a claimed row.
+INSERT INTO webhook_delivery (endpoint_id, user_id, event_id, event_type, payload, next_attempt_at)
+VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP + INTERVAL '5 minutes')
+RETURNING id, endpoint_id, user_id, event_id, event_type, payload, status, attempt_count, next_attempt_at, last_attempt_at, response_status, last_error, delivered_at, created_at;
+
+-- name: ListWebhookDeliveries :many
+SELECT id, endpoint_id, user_id, event_id, event_type, payload, status, attempt_count, next_attempt_at, last_attempt_at, response_status, last_error, delivered_at, created_at
+FROM webhook_delivery
+WHERE endpoint_id = sqlc.arg(endpoint_id) AND user_id = sqlc.arg(user_id)
+ORDER BY id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ClaimDueWebhookDeliveries :many
+-- Claims due deliveries across all users for the dispatcher by leasing them
+-- for five minutes, so a worker that dies mid-attempt has its rows retried.
+-- Runs before any user context exists, so it goes through the SECURITY
+-- DEFINER function from migration 00051 rather than around RLS. Deliveries
+-- for inactive endpoints are skipped, so disabling an endpoint stops anything
+-- it still has queued, including retries.
+SELECT id, endpoint_id, user_id, event_id, event_type, payload, attempt_count, url, secret
+FROM claim_due_webhook_deliveries(sqlc.arg(batch_size)::int);
+
+-- name: RecordWebhookDeliveryAttempt :one
+UPDATE webhook_delivery
+SET status = sqlc.arg(status),
+    attempt_count = attempt_count + 1,
+    last_attempt_at = CURRENT_TIMESTAMP,
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    response_status = sqlc.narg(response_status),
+    last_error = sqlc.narg(last_error),
+    delivered_at = CASE WHEN sqlc.arg(status) = 'succeeded' THEN CURRENT_TIMESTAMP END
+WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(user_id)
+RETURNING id, endpoint_id, user_id, event_id, event_type, payload, status, attempt_count, next_attempt_at, last_attempt_at, response_status, last_error, delivered_at, created_at;
+
+-- name: ListPersonalAccessTokens :many
+SELECT id, user_id, name, token_hash, token_prefix, scopes, expires_at, last_used_at, revoked_at, created_at
+FROM personal_access_token
+WHERE user_id = $1 AND revoked_at IS NULL
+ORDER BY created_at DESC, id DESC;
+
+-- name: CountActivePersonalAccessTokens :one
+SELECT COUNT(*)
+FROM personal_access_token
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > CURRENT_TIMESTAMP;
+
+-- name: CreatePersonalAccessToken :one
+INSERT INTO personal_access_token (user_id, name, token_hash, token_prefix, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, name, token_hash, token_prefix, scopes, expires_at, last_used_at, revoked_at, created_at;
+
+-- name: RevokePersonalAccessToken :execrows
+UPDATE personal_access_token
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL;
+
+-- name: GetActivePersonalAccessTokenByHash :one
+-- Resolves a bearer token before any user context exists. The caller sets
+-- app.current_token_hash so the select policy exposes only this row.
+SELECT id, user_id, scopes
+FROM personal_access_token
+WHERE token_hash = $1 AND revoked_at IS NULL AND expires_at > CURRENT_TIMESTAMP;
+
+-- name: TouchPersonalAccessToken :exec
+-- Records token use at most once a minute so busy clients do not write on
+-- every request.
+UPDATE personal_access_token
+SET last_used_at = CURRENT_TIMESTAMP
+WHERE id = $1
+  AND (last_used_at IS NULL OR last_used_at < CURRENT_TIMESTAMP - INTERVAL '1 minute');
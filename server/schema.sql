@@ -2,7 +2,9 @@
 CREATE TABLE users (
     id SERIAL PRIMARY KEY,
     user_id VARCHAR(256) UNIQUE NOT NULL,
-    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    timezone VARCHAR(64),
+    CONSTRAINT users_timezone_not_empty CHECK (timezone IS NULL OR btrim(timezone) <> '')
 );
 
 -- User feature access table
@@ -246,15 +248,48 @@ CREATE TABLE user_training_profile (
     ) ON DELETE SET NULL (source_message_id)
 );
 
+CREATE TABLE body_metric_entry (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    measured_on DATE NOT NULL,
+    bodyweight NUMERIC(6,2),
+    body_fat_percent NUMERIC(4,1),
+    neck NUMERIC(5,1),
+    chest NUMERIC(5,1),
+    waist NUMERIC(5,1),
+    hips NUMERIC(5,1),
+    arm NUMERIC(5,1),
+    thigh NUMERIC(5,1),
+    notes VARCHAR(256),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT body_metric_entry_has_measurement CHECK (
+        num_nonnulls(bodyweight, body_fat_percent, neck, chest, waist, hips, arm, thigh) > 0
+    ),
+    CONSTRAINT body_metric_entry_bodyweight_positive CHECK (bodyweight IS NULL OR bodyweight > 0),
+    CONSTRAINT body_metric_entry_body_fat_percent_bounds CHECK (
+        body_fat_percent IS NULL OR body_fat_percent BETWEEN 1 AND 75
+    ),
+    CONSTRAINT body_metric_entry_girths_positive CHECK (
+        (neck IS NULL OR neck > 0)
+        AND (chest IS NULL OR chest > 0)
+        AND (waist IS NULL OR waist > 0)
+        AND (hips IS NULL OR hips > 0)
+        AND (arm IS NULL OR arm > 0)
+        AND (thigh IS NULL OR thigh > 0)
+    )
+);
+
 -- Workouts table
 CREATE TABLE workout (
     id SERIAL PRIMARY KEY,
     date TIMESTAMPTZ NOT NULL,
-    notes VARCHAR(256),
+    notes TEXT,
     workout_focus VARCHAR(256),
     created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMPTZ,
-    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    CONSTRAINT workout_notes_length CHECK (notes IS NULL OR char_length(notes) <= 10000)
 );
 
 -- Exercises table  
@@ -283,7 +318,215 @@ CREATE TABLE "set" (
     user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
     exercise_order INTEGER NOT NULL,
     set_order INTEGER NOT NULL,
-    CONSTRAINT weight_non_negative CHECK (weight IS NULL OR weight >= 0)
+    notes TEXT,
+    CONSTRAINT weight_non_negative CHECK (weight IS NULL OR weight >= 0),
+    CONSTRAINT set_notes_length CHECK (notes IS NULL OR char_length(notes) <= 500)
+);
+
+CREATE TABLE user_strength_profile (
+    user_id VARCHAR(256) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    sex VARCHAR(8),
+    squat_exercise_id INTEGER REFERENCES exercise(id) ON DELETE SET NULL,
+    bench_exercise_id INTEGER REFERENCES exercise(id) ON DELETE SET NULL,
+    deadlift_exercise_id INTEGER REFERENCES exercise(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT user_strength_profile_sex_valid CHECK (
+        sex IS NULL OR sex IN ('male', 'female')
+    )
+);
+
+-- Calendar feed tokens table (stores only the SHA-256 of each token)
+CREATE TABLE calendar_feed (
+    user_id VARCHAR(256) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    rotated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Workout share links table (stores only the SHA-256 of each token)
+CREATE TABLE workout_share (
+    workout_id INTEGER PRIMARY KEY REFERENCES workout(id) ON DELETE CASCADE,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Coaching links: pending invitations until an athlete accepts
+CREATE TABLE coaching_link (
+    id SERIAL PRIMARY KEY,
+    coach_user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    athlete_user_id VARCHAR(256) REFERENCES users(user_id) ON DELETE CASCADE,
+    scopes TEXT[] NOT NULL,
+    invite_code_hash CHAR(64) UNIQUE,
+    invite_expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    accepted_at TIMESTAMPTZ,
+    CONSTRAINT coaching_link_scopes_valid CHECK (
+        cardinality(scopes) > 0
+        AND scopes <@ ARRAY['read_workouts', 'read_metrics', 'suggest_workouts']::TEXT[]
+    ),
+    CONSTRAINT coaching_link_not_self CHECK (athlete_user_id IS NULL OR athlete_user_id <> coach_user_id),
+    CONSTRAINT coaching_link_state CHECK (
+        (athlete_user_id IS NULL AND accepted_at IS NULL AND invite_code_hash IS NOT NULL AND invite_expires_at IS NOT NULL)
+        OR (athlete_user_id IS NOT NULL AND accepted_at IS NOT NULL AND invite_code_hash IS NULL)
+    )
+);
+
+-- Workouts coaches suggest to their athletes
+CREATE TABLE coaching_suggestion (
+    id SERIAL PRIMARY KEY,
+    link_id INTEGER NOT NULL REFERENCES coaching_link(id) ON DELETE CASCADE,
+    coach_user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    athlete_user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    title VARCHAR(256) NOT NULL,
+    notes TEXT,
+    scheduled_on DATE,
+    exercises JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    dismissed_at TIMESTAMPTZ
+);
+
+-- User-defined workout tags (names stored lowercased)
+CREATE TABLE workout_tag (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ,
+    CONSTRAINT workout_tag_user_id_name_key UNIQUE (user_id, name),
+    CONSTRAINT workout_tag_name_normalized CHECK (name <> '' AND name = lower(btrim(name)))
+);
+
+-- Workout to tag assignments
+CREATE TABLE workout_tag_link (
+    workout_id INTEGER NOT NULL REFERENCES workout(id) ON DELETE CASCADE,
+    tag_id INTEGER NOT NULL REFERENCES workout_tag(id) ON DELETE CASCADE,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (workout_id, tag_id)
+);
+
+-- Per-exercise notes, cues and equipment settings; every save is a new revision
+CREATE TABLE exercise_note (
+    id SERIAL PRIMARY KEY,
+    exercise_id INTEGER NOT NULL REFERENCES exercise(id) ON DELETE CASCADE,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    notes TEXT,
+    settings TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT exercise_note_notes_length CHECK (notes IS NULL OR char_length(notes) <= 2000),
+    CONSTRAINT exercise_note_settings_length CHECK (settings IS NULL OR char_length(settings) <= 500)
+);
+
+-- Loading equipment used to round suggested weights
+CREATE TABLE user_equipment (
+    user_id VARCHAR(256) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    barbell_weights JSONB NOT NULL,
+    plates JSONB NOT NULL,
+    dumbbell_increment NUMERIC(6,2) NOT NULL,
+    machine_stack_step NUMERIC(6,2) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT user_equipment_barbell_weights_array CHECK (jsonb_typeof(barbell_weights) = 'array'),
+    CONSTRAINT user_equipment_plates_array CHECK (jsonb_typeof(plates) = 'array'),
+    CONSTRAINT user_equipment_dumbbell_increment_positive CHECK (dumbbell_increment > 0),
+    CONSTRAINT user_equipment_machine_stack_step_positive CHECK (machine_stack_step > 0)
+);
+
+-- Training goals tracked against logged data; exercise_id is set for e1rm goals
+CREATE TABLE goal (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    goal_type VARCHAR(16) NOT NULL,
+    exercise_id INTEGER REFERENCES exercise(id) ON DELETE CASCADE,
+    target_value NUMERIC(8,2) NOT NULL,
+    start_value NUMERIC(8,2),
+    target_date DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT goal_type_valid CHECK (goal_type IN ('e1rm', 'frequency', 'bodyweight')),
+    CONSTRAINT goal_exercise_matches_type CHECK ((goal_type = 'e1rm') = (exercise_id IS NOT NULL)),
+    CONSTRAINT goal_target_value_positive CHECK (target_value > 0)
+);
+
+-- Unlocked achievements; achievement_key names a rule defined in code
+CREATE TABLE user_achievement (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    achievement_key VARCHAR(64) NOT NULL,
+    workout_id INTEGER REFERENCES workout(id) ON DELETE SET NULL,
+    unlocked_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT user_achievement_user_key UNIQUE (user_id, achievement_key),
+    CONSTRAINT user_achievement_key_not_empty CHECK (btrim(achievement_key) <> '')
+);
+
+-- In-app notifications written by other features inside their transactions
+CREATE TABLE notification (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    kind VARCHAR(64) NOT NULL,
+    title VARCHAR(256) NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    data JSONB NOT NULL DEFAULT '{}'::jsonb,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT notification_kind_not_empty CHECK (btrim(kind) <> ''),
+    CONSTRAINT notification_title_not_empty CHECK (btrim(title) <> ''),
+    CONSTRAINT notification_data_object CHECK (jsonb_typeof(data) = 'object')
+);
+
+-- Outbound webhook endpoints; the secret signs payloads so it is not hashed
+CREATE TABLE webhook_endpoint (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(128) NOT NULL,
+    event_types TEXT[] NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT webhook_endpoint_url_http CHECK (url ~ '^https?://'),
+    CONSTRAINT webhook_endpoint_event_types_not_empty CHECK (cardinality(event_types) > 0)
+);
+
+-- Webhook delivery queue and log, one row per event per endpoint
+CREATE TABLE webhook_delivery (
+    id SERIAL PRIMARY KEY,
+    endpoint_id INTEGER NOT NULL REFERENCES webhook_endpoint(id) ON DELETE CASCADE,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    event_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_attempt_at TIMESTAMPTZ,
+    response_status INTEGER,
+    last_error TEXT,
+    delivered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT webhook_delivery_status_valid CHECK (status IN ('pending', 'succeeded', 'failed')),
+    CONSTRAINT webhook_delivery_payload_object CHECK (jsonb_typeof(payload) = 'object')
+);
+
+-- Personal access tokens for the public API; only the token hash is stored
+CREATE TABLE personal_access_token (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(256) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    token_prefix VARCHAR(16) NOT NULL,
+    scopes TEXT[] NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT personal_access_token_name_not_empty CHECK (btrim(name) <> ''),
+    CONSTRAINT personal_access_token_scopes_valid CHECK (
+        cardinality(scopes) > 0
+        AND scopes <@ ARRAY['workouts:read', 'workouts:write', 'profile:read', 'profile:write', 'chat:use']::TEXT[]
+    ),
+    CONSTRAINT personal_access_token_expires_after_created CHECK (expires_at > created_at)
 );
 
 -- Indexes for foreign keys
@@ -305,3 +548,24 @@ CREATE INDEX idx_ai_chat_message_conversation ON ai_chat_message(conversation_id
 CREATE INDEX idx_ai_chat_run_conversation_created ON ai_chat_run(conversation_id, created_at DESC, id DESC);
 CREATE UNIQUE INDEX idx_ai_chat_run_active_conversation ON ai_chat_run(conversation_id) WHERE status = 'streaming';
 CREATE INDEX idx_ai_chat_stream_chunk_user_run_sequence ON ai_chat_stream_chunk(user_id, run_id, sequence ASC);
+CREATE INDEX idx_body_metric_entry_user_measured_on ON body_metric_entry(user_id, measured_on DESC, id DESC);
+CREATE INDEX idx_workout_share_user_id ON workout_share(user_id);
+CREATE UNIQUE INDEX idx_coaching_link_coach_athlete ON coaching_link(coach_user_id, athlete_user_id) WHERE athlete_user_id IS NOT NULL;
+CREATE INDEX idx_coaching_link_athlete ON coaching_link(athlete_user_id) WHERE athlete_user_id IS NOT NULL;
+CREATE INDEX idx_coaching_suggestion_athlete_created ON coaching_suggestion(athlete_user_id, created_at DESC, id DESC);
+CREATE INDEX idx_workout_tag_link_tag_id ON workout_tag_link(tag_id);
+CREATE INDEX idx_exercise_note_exercise_created ON exercise_note(exercise_id, created_at DESC, id DESC);
+CREATE INDEX idx_goal_user_created ON goal(user_id, created_at, id);
+CREATE INDEX idx_notification_user_id ON notification(user_id, id DESC);
+CREATE INDEX idx_notification_user_unread ON notification(user_id) WHERE read_at IS NULL;
+CREATE INDEX idx_webhook_endpoint_user_id ON webhook_endpoint(user_id);
+CREATE INDEX idx_webhook_delivery_endpoint_id ON webhook_delivery(endpoint_id, id DESC);
+CREATE INDEX idx_webhook_delivery_due ON webhook_delivery(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_personal_access_token_user_id ON personal_access_token(user_id, created_at DESC, id DESC);
+
+-- Full-text search indexes; search queries repeat these expressions exactly
+CREATE INDEX idx_workout_search ON workout USING GIN (to_tsvector('english', coalesce(workout_focus, '') || ' ' || coalesce(notes, '')));
+CREATE INDEX idx_exercise_search ON exercise USING GIN (to_tsvector('english', name));
+CREATE INDEX idx_set_notes_search ON "set" USING GIN (to_tsvector('english', coalesce(notes, '')));
+CREATE INDEX idx_ai_chat_conversation_search ON ai_chat_conversation USING GIN (to_tsvector('english', coalesce(title, '')));
+CREATE INDEX idx_ai_chat_message_search ON ai_chat_message USING GIN (to_tsvector('english', content));